@@ -7,6 +7,7 @@ package apidoc
 
 import (
 	"bytes"
+	"io"
 	"log"
 	"mime"
 	"net/http"
@@ -84,6 +85,8 @@ func Build(h *core.MessageHandler, o *build.Output, i ...*build.Input) error {
 // 如果是文档语法错误，则相关的错误信息会反馈给 h，由 h 处理错误信息；
 // 如果是配置项（o 和 i）有问题，则以 *core.Error 类型返回错误信息。
 //
+// 仅返回 o.Path 对应的内容，按标签拆分的文档等其它文件可以通过 o.Files 获取。
+//
 // NOTE: 如果需要从配置文件进行构建文档，可以采用 Config.Buffer
 func Buffer(h *core.MessageHandler, o *build.Output, i ...*build.Input) (*bytes.Buffer, error) {
 	return build.Buffer(h, o, i...)
@@ -94,6 +97,22 @@ func CheckSyntax(h *core.MessageHandler, i ...*build.Input) {
 	build.CheckSyntax(h, i...)
 }
 
+// Import 导入其它格式的文档并按 o 的要求输出
+//
+// t 表示导入文档的类型，可以是 build.ImportOpenapi；
+// 无法转换的内容会以警告的形式反馈给 h。
+func Import(h *core.MessageHandler, t string, path core.URI, o *build.Output) error {
+	return build.Import(h, t, path, o)
+}
+
+// Diff 比较 old 和 new 两个文档之间的差异，并以 format 指定的格式写入 w
+//
+// format 可以是 build.DiffText、build.DiffJSON 和 build.DiffMarkdown；
+// breaking 为不兼容的修改数量，可以据此判断新文档是否兼容旧文档。
+func Diff(h *core.MessageHandler, old, new core.URI, format string, w io.Writer) (breaking int, err error) {
+	return build.Diff(h, old, new, format, w)
+}
+
 // Pack 将文档内容打包成一个 Go 文件
 //
 // opt 用于指定打包的设置项，如果为空，则会使用一个默认的设置项，
@@ -8,6 +8,7 @@ import (
 
 	"github.com/caixw/apidoc/v7/core"
 	"github.com/caixw/apidoc/v7/internal/ast"
+	"github.com/caixw/apidoc/v7/internal/mock"
 )
 
 // Build 解析文档并输出文档内容
@@ -22,16 +23,17 @@ func Build(h *core.MessageHandler, o *Output, i ...*Input) error {
 		return err
 	}
 
-	buf, err := o.buffer(d)
+	buf, err := o.buffer(h, d)
 	if err != nil {
 		return err
 	}
 
-	return o.Path.WriteAll(buf.Bytes())
+	return o.write(buf)
 }
 
 // Buffer 生成文档内容并返回
 //
+// 仅返回 o.Path 对应的内容，其它文件可以通过 o.Files 获取。
 // 如果是配置文件有问题，则直接返回错误信息，文档错误则输出至 h 对象。
 func Buffer(h *core.MessageHandler, o *Output, i ...*Input) (*bytes.Buffer, error) {
 	d, err := parse(h, i...)
@@ -42,17 +44,27 @@ func Buffer(h *core.MessageHandler, o *Output, i ...*Input) (*bytes.Buffer, erro
 		return nil, err
 	}
 
-	return o.buffer(d)
+	return o.buffer(h, d)
 }
 
 // CheckSyntax 测试文档语法
 //
+// 除了语法，还会验证 JSON 和 XML 格式的示例代码是否与文档的定义相符。
 // 如果是配置文件有问题，则直接返回错误信息，文档错误则输出至 h 对象。
 func CheckSyntax(h *core.MessageHandler, i ...*Input) error {
-	_, err := parse(h, i...)
+	_, err := checkSyntax(h, i...)
 	return err
 }
 
+func checkSyntax(h *core.MessageHandler, i ...*Input) (*ast.APIDoc, error) {
+	d, err := parse(h, i...)
+	if err != nil {
+		return nil, err
+	}
+	mock.ValidExamples(h, d)
+	return d, nil
+}
+
 func parse(h *core.MessageHandler, i ...*Input) (*ast.APIDoc, error) {
 	for _, item := range i {
 		if err := item.sanitize(); err != nil {
@@ -12,6 +12,7 @@ import (
 
 	"github.com/caixw/apidoc/v7/core"
 	"github.com/caixw/apidoc/v7/internal/ast"
+	"github.com/caixw/apidoc/v7/internal/lint"
 	"github.com/caixw/apidoc/v7/internal/locale"
 )
 
@@ -40,6 +41,12 @@ type Config struct {
 
 	// 输出配置项
 	Output *Output `yaml:"output"`
+
+	// 代码检测的规则
+	//
+	// 键名为规则名称，键值为规则的级别，未指定的规则不会执行。
+	// 仅在检测语法时执行，不影响文档的生成。
+	Lint map[string]string `yaml:"lint,omitempty"`
 }
 
 // LoadConfig 加载指定目录下的配置文件
@@ -52,21 +59,26 @@ func LoadConfig(wd core.URI) (*Config, error) {
 		return nil, locale.NewError(locale.ErrInvalidURIScheme, scheme)
 	}
 
+	path, err := configPath(wd)
+	if err != nil {
+		return nil, err
+	}
+	return loadFile(wd, path)
+}
+
+// 查找 wd 目录下的配置文件
+func configPath(wd core.URI) (core.URI, error) {
 	for _, filename := range allowConfigFilenames {
 		path := wd.Append(filename)
 		if exists, err := path.Exists(); err != nil {
 			continue
 		} else if exists {
-			cfg, err := loadFile(wd, path)
-			if err != nil {
-				return nil, err
-			}
-			return cfg, nil
+			return path, nil
 		}
 	}
 
 	field := wd.Append(allowConfigFilenames[0]).String()
-	return nil, core.WithError(os.ErrNotExist).WithField(field)
+	return "", core.WithError(os.ErrNotExist).WithField(field)
 }
 
 func loadFile(wd, path core.URI) (*Config, error) {
@@ -108,6 +120,26 @@ func (cfg *Config) sanitize(wd core.URI) error {
 		return (core.Location{URI: file}).NewError(locale.ErrIsEmpty, "output").WithField("output")
 	}
 
+	if err := cfg.sanitizeInputs(file, wd); err != nil {
+		return err
+	}
+
+	if err := lint.Validate(cfg.Lint); err != nil {
+		if serr, ok := err.(*core.Error); ok {
+			serr.Location.URI = file
+			serr.Field = "lint." + serr.Field
+		}
+		return err
+	}
+
+	if cfg.Output.Path, err = abs(cfg.Output.Path, wd); err != nil {
+		return (core.Location{URI: file}).WithError(err).WithField("output.path")
+	}
+	return cfg.Output.sanitize()
+}
+
+// 检测 Inputs 中的各个元素，并将其中的路径转换为绝对路径。
+func (cfg *Config) sanitizeInputs(file, wd core.URI) (err error) {
 	for index, i := range cfg.Inputs {
 		field := "inputs[" + strconv.Itoa(index) + "]"
 
@@ -127,11 +159,7 @@ func (cfg *Config) sanitize(wd core.URI) error {
 			return err
 		}
 	}
-
-	if cfg.Output.Path, err = abs(cfg.Output.Path, wd); err != nil {
-		return (core.Location{URI: file}).WithError(err).WithField("output.path")
-	}
-	return cfg.Output.sanitize()
+	return nil
 }
 
 // Save 将内容保存至 wd 目录下的 .apidoc.yaml 文件
@@ -168,6 +196,8 @@ func (cfg *Config) Build(h *core.MessageHandler) {
 
 // Buffer 根据 wd 目录下的配置文件生成文档内容并保存至内存
 //
+// 其它文件可以通过 cfg.Output.Files 获取。
+//
 // 具体信息可参考 Buffer 函数的相关文档。
 func (cfg *Config) Buffer(h *core.MessageHandler) *bytes.Buffer {
 	buf, err := Buffer(h, cfg.Output, cfg.Inputs...)
@@ -179,8 +209,12 @@ func (cfg *Config) Buffer(h *core.MessageHandler) *bytes.Buffer {
 }
 
 // CheckSyntax 执行对语法内容的测试
+//
+// 除了 CheckSyntax 函数的检测内容，还会执行 Lint 中开启的规则。
 func (cfg *Config) CheckSyntax(h *core.MessageHandler) {
-	if err := CheckSyntax(h, cfg.Inputs...); err != nil {
+	d, err := checkSyntax(h, cfg.Inputs...)
+	if err != nil {
 		panic(err) // 由 loadConfig 保证配置项的正确，如果还出错则直接 panic
 	}
+	lint.Lint(h, d, cfg.Lint)
 }
@@ -87,6 +87,19 @@ func TestConfig_sanitize(t *testing.T) {
 	a.Error(err).
 		True(ok).
 		Equal(err2.Field, "output")
+
+	// 无效的 lint 规则
+	conf.Inputs = []*Input{{Lang: "c++", Dir: "./testdata"}}
+	conf.Output = &Output{Path: "./testdata/apidoc.xml"}
+	conf.Lint = map[string]string{"not-exists": "error"}
+	err = conf.sanitize(".")
+	err2, ok = err.(*core.Error)
+	a.Error(err).
+		True(ok).
+		Equal(err2.Field, "lint.not-exists")
+
+	conf.Lint = map[string]string{"api-id": "error"}
+	a.NotError(conf.sanitize("."))
 }
 
 func TestConfig_Save(t *testing.T) {
@@ -117,6 +130,13 @@ func TestConfig_CheckSyntax(t *testing.T) {
 	cfg.CheckSyntax(rslt.Handler)
 	rslt.Handler.Stop()
 	a.Empty(rslt.Errors)
+
+	// 开启 lint 规则
+	cfg.Lint = map[string]string{"api-id": "error"}
+	rslt = messagetest.NewMessageHandler()
+	cfg.CheckSyntax(rslt.Handler)
+	rslt.Handler.Stop()
+	a.NotEmpty(rslt.Errors)
 }
 
 func TestConfig_Build(t *testing.T) {
@@ -143,4 +163,15 @@ func TestConfig_Buffer(t *testing.T) {
 	a.Empty(rslt.Errors).
 		Empty(rslt.Successes).
 		True(buf.Len() > 0)
+
+	// 按标签拆分的文档通过 Output.Files 获取
+	o := &Output{Type: Markdown, Split: true}
+	rslt = messagetest.NewMessageHandler()
+	buf, err = Buffer(rslt.Handler, o, cfg.Inputs...)
+	rslt.Handler.Stop()
+	a.NotError(err).Empty(rslt.Errors).True(buf.Len() > 0)
+	a.NotEmpty(o.Files())
+	for name, data := range o.Files() {
+		a.Contains(buf.String(), "]("+name+")").NotEmpty(data)
+	}
 }
@@ -0,0 +1,92 @@
+// SPDX-License-Identifier: MIT
+
+package build
+
+import (
+	"io"
+
+	"github.com/caixw/apidoc/v7/core"
+	"github.com/caixw/apidoc/v7/internal/ast"
+	"github.com/caixw/apidoc/v7/internal/diff"
+	"github.com/caixw/apidoc/v7/internal/lexer"
+	"github.com/caixw/apidoc/v7/internal/locale"
+)
+
+// 比较文档差异时的输出格式
+const (
+	DiffText     = "text"
+	DiffJSON     = "json"
+	DiffMarkdown = "markdown"
+)
+
+// Diff 比较 old 和 new 两个文档，并将差异以 format 指定的格式写入 w
+//
+// old 和 new 都应该是 apidoc+xml 格式的文档，文档中的语法错误会反馈给 h，
+// 只要有一个文档存在错误，便不再比较，直接返回错误；
+// breaking 返回不兼容的修改数量。
+func Diff(h *core.MessageHandler, old, new core.URI, format string, w io.Writer) (breaking int, err error) {
+	if format != DiffText && format != DiffJSON && format != DiffMarkdown {
+		return 0, core.NewError(locale.ErrInvalidValue).WithField("format")
+	}
+
+	o, err := loadDoc(h, old)
+	if err != nil {
+		return 0, err
+	}
+	n, err := loadDoc(h, new)
+	if err != nil {
+		return 0, err
+	}
+
+	changes := diff.Diff(o, n)
+
+	var data []byte
+	switch format {
+	case DiffText:
+		data = changes.Text()
+	case DiffJSON:
+		if data, err = changes.JSON(); err != nil {
+			return 0, err
+		}
+		data = append(data, '\n')
+	case DiffMarkdown:
+		data = changes.Markdown()
+	}
+
+	if _, err = w.Write(data); err != nil {
+		return 0, err
+	}
+	return changes.Count(diff.Breaking), nil
+}
+
+func loadDoc(h *core.MessageHandler, path core.URI) (*ast.APIDoc, error) {
+	data, err := path.ReadAll(nil)
+	if err != nil {
+		return nil, (core.Location{URI: path}).WithError(err)
+	}
+
+	b := core.Block{Data: data, Location: core.Location{URI: path}}
+	p, err := lexer.BlockEndPosition(b)
+	if err != nil {
+		return nil, err
+	}
+	b.Location.Range.End = p.Position
+
+	// 解析出错的文档只有部分内容，比较的结果没有意义，
+	// 所以通过 dh 统计错误数量，同时将所有消息转发给 h。
+	var errs int
+	dh := core.NewMessageHandler(func(msg *core.Message) {
+		if msg.Type == core.Erro {
+			errs++
+		}
+		h.Message(msg.Type, msg.Message)
+	})
+	d := &ast.APIDoc{}
+	d.Parse(dh, b)
+	dh.Stop()
+
+	if errs > 0 {
+		return nil, (core.Location{URI: path}).NewError(locale.ErrIsNotAPIDoc)
+	}
+	return d, nil
+}
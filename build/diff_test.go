@@ -0,0 +1,99 @@
+// SPDX-License-Identifier: MIT
+
+package build
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/issue9/assert"
+
+	"github.com/caixw/apidoc/v7/core"
+	"github.com/caixw/apidoc/v7/core/messagetest"
+)
+
+func TestDiff(t *testing.T) {
+	a := assert.New(t)
+
+	dir, err := ioutil.TempDir("", "apidoc-diff")
+	a.NotError(err)
+	defer os.RemoveAll(dir)
+
+	oldDoc := `<apidoc version="1.0.0">
+	<title>test</title>
+	<mimetype>application/json</mimetype>
+	<api method="GET">
+		<path path="/users"><query name="page" type="number" optional="true" summary="page" /></path>
+		<response status="200" type="string" />
+	</api>
+</apidoc>`
+	newDoc := `<apidoc version="1.0.1">
+	<title>test</title>
+	<mimetype>application/json</mimetype>
+	<api method="GET">
+		<path path="/users"><query name="page" type="number" summary="page" /></path>
+		<response status="200" type="string" />
+	</api>
+</apidoc>`
+	a.NotError(ioutil.WriteFile(filepath.Join(dir, "old.xml"), []byte(oldDoc), os.ModePerm))
+	a.NotError(ioutil.WriteFile(filepath.Join(dir, "new.xml"), []byte(newDoc), os.ModePerm))
+	oldURI := core.FileURI(filepath.Join(dir, "old.xml"))
+	newURI := core.FileURI(filepath.Join(dir, "new.xml"))
+
+	rslt := messagetest.NewMessageHandler()
+	buf := new(bytes.Buffer)
+	breaking, err := Diff(rslt.Handler, oldURI, newURI, DiffJSON, buf)
+	rslt.Handler.Stop()
+	a.NotError(err).Empty(rslt.Errors).Equal(breaking, 1)
+	obj := map[string]interface{}{}
+	a.NotError(json.Unmarshal(buf.Bytes(), &obj))
+	a.Equal(obj["breaking"], 1)
+
+	rslt = messagetest.NewMessageHandler()
+	buf.Reset()
+	breaking, err = Diff(rslt.Handler, newURI, oldURI, DiffMarkdown, buf)
+	rslt.Handler.Stop()
+	a.NotError(err).Empty(rslt.Errors).Equal(breaking, 0)
+	a.Contains(buf.String(), "| GET /users | query.page |")
+
+	rslt = messagetest.NewMessageHandler()
+	buf.Reset()
+	breaking, err = Diff(rslt.Handler, oldURI, oldURI, DiffText, buf)
+	rslt.Handler.Stop()
+	a.NotError(err).Empty(rslt.Errors).Equal(breaking, 0).NotEmpty(buf.String())
+
+	rslt = messagetest.NewMessageHandler()
+	_, err = Diff(rslt.Handler, oldURI, newURI, "yaml", buf)
+	a.Error(err)
+	_, err = Diff(rslt.Handler, oldURI, core.FileURI(filepath.Join(dir, "not-exists.xml")), DiffText, buf)
+	a.Error(err)
+	rslt.Handler.Stop()
+
+	// 文档有错误时，不比较内容。
+	invalidDoc := `<apidoc version="1.0.1">
+	<title>test</title>
+	<mimetype>application/json</mimetype>
+	<api method="GET">
+		<path path="/users"><query name="page" type="object" summary="page" /></path>
+		<response status="200" type="string" />
+	</api>
+</apidoc>`
+	a.NotError(ioutil.WriteFile(filepath.Join(dir, "invalid.xml"), []byte(invalidDoc), os.ModePerm))
+	invalidURI := core.FileURI(filepath.Join(dir, "invalid.xml"))
+
+	rslt = messagetest.NewMessageHandler()
+	buf.Reset()
+	breaking, err = Diff(rslt.Handler, oldURI, invalidURI, DiffText, buf)
+	rslt.Handler.Stop()
+	a.Error(err).NotEmpty(rslt.Errors).Equal(breaking, 0).Empty(buf.String())
+
+	rslt = messagetest.NewMessageHandler()
+	buf.Reset()
+	breaking, err = Diff(rslt.Handler, invalidURI, oldURI, DiffText, buf)
+	rslt.Handler.Stop()
+	a.Error(err).NotEmpty(rslt.Errors).Equal(breaking, 0).Empty(buf.String())
+}
@@ -0,0 +1,55 @@
+// SPDX-License-Identifier: MIT
+
+package build
+
+import (
+	"github.com/caixw/apidoc/v7/core"
+	"github.com/caixw/apidoc/v7/internal/ast"
+	"github.com/caixw/apidoc/v7/internal/har"
+	"github.com/caixw/apidoc/v7/internal/locale"
+	"github.com/caixw/apidoc/v7/internal/openapi"
+	"github.com/caixw/apidoc/v7/internal/postman"
+)
+
+// 可导入的文档类型
+//
+// postman 和 har 中仅包含请求的记录，文档内容是根据这些记录推断出来的，
+// 导入之后还需要手动补充参数的描述等内容。
+const (
+	ImportOpenapi = "openapi"
+	ImportPostman = "postman"
+	ImportHAR     = "har"
+)
+
+// Import 导入其它格式的文档并按 o 的要求输出
+//
+// t 表示导入文档的类型，path 为导入文档的地址。
+// 导入过程中无法转换的内容，会以警告的形式输出到 h。
+func Import(h *core.MessageHandler, t string, path core.URI, o *Output) error {
+	if err := o.sanitize(); err != nil {
+		return err
+	}
+
+	var d *ast.APIDoc
+	var err error
+	switch t {
+	case ImportOpenapi:
+		d, err = openapi.Import(h, path)
+	case ImportPostman:
+		d, err = postman.Import(h, path)
+	case ImportHAR:
+		d, err = har.Import(h, path)
+	default:
+		return core.NewError(locale.ErrInvalidValue).WithField("type")
+	}
+	if err != nil {
+		return err
+	}
+
+	buf, err := o.buffer(h, d)
+	if err != nil {
+		return err
+	}
+
+	return o.write(buf)
+}
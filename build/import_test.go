@@ -0,0 +1,63 @@
+// SPDX-License-Identifier: MIT
+
+package build
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/issue9/assert"
+
+	"github.com/caixw/apidoc/v7/core"
+	"github.com/caixw/apidoc/v7/core/messagetest"
+	"github.com/caixw/apidoc/v7/internal/ast"
+)
+
+func TestImport(t *testing.T) {
+	a := assert.New(t)
+	src := core.FileURI("../internal/openapi/testdata/petstore.yaml")
+	dest := core.FileURI(filepath.Join(os.TempDir(), "apidoc-import.xml"))
+
+	rslt := messagetest.NewMessageHandler()
+	a.NotError(Import(rslt.Handler, ImportOpenapi, src, &Output{Path: dest}))
+	rslt.Handler.Stop()
+	a.Empty(rslt.Errors).NotEmpty(rslt.Warns)
+
+	data, err := dest.ReadAll(nil)
+	a.NotError(err).NotEmpty(data)
+	rslt = messagetest.NewMessageHandler()
+	d := &ast.APIDoc{}
+	d.Parse(rslt.Handler, core.Block{Location: core.Location{URI: dest}, Data: data})
+	rslt.Handler.Stop()
+	a.Empty(rslt.Errors).Equal(3, len(d.APIs))
+
+	rslt = messagetest.NewMessageHandler()
+	a.NotError(Import(rslt.Handler, ImportPostman, core.FileURI("../internal/postman/testdata/collection.json"), &Output{Path: dest}))
+	rslt.Handler.Stop()
+	a.Empty(rslt.Errors)
+	data, err = dest.ReadAll(nil)
+	a.NotError(err).NotEmpty(data)
+	rslt = messagetest.NewMessageHandler()
+	d = &ast.APIDoc{}
+	d.Parse(rslt.Handler, core.Block{Location: core.Location{URI: dest}, Data: data})
+	rslt.Handler.Stop()
+	a.Empty(rslt.Errors).Equal(4, len(d.APIs))
+
+	rslt = messagetest.NewMessageHandler()
+	a.NotError(Import(rslt.Handler, ImportHAR, core.FileURI("../internal/har/testdata/capture.har"), &Output{Path: dest}))
+	rslt.Handler.Stop()
+	a.Empty(rslt.Errors)
+	data, err = dest.ReadAll(nil)
+	a.NotError(err).NotEmpty(data)
+	rslt = messagetest.NewMessageHandler()
+	d = &ast.APIDoc{}
+	d.Parse(rslt.Handler, core.Block{Location: core.Location{URI: dest}, Data: data})
+	rslt.Handler.Stop()
+	a.Empty(rslt.Errors).Equal(3, len(d.APIs))
+
+	// 无效的类型
+	rslt = messagetest.NewMessageHandler()
+	a.Error(Import(rslt.Handler, "not-exists", src, &Output{Path: dest}))
+	rslt.Handler.Stop()
+}
@@ -5,6 +5,9 @@ package build
 import (
 	"bytes"
 	"encoding/xml"
+	"go/token"
+	"os"
+	"path/filepath"
 	"strings"
 	"time"
 
@@ -14,19 +17,37 @@ import (
 	"github.com/caixw/apidoc/v7/core"
 	"github.com/caixw/apidoc/v7/internal/ast"
 	"github.com/caixw/apidoc/v7/internal/docs"
+	"github.com/caixw/apidoc/v7/internal/gocode"
+	"github.com/caixw/apidoc/v7/internal/html"
+	"github.com/caixw/apidoc/v7/internal/jsonschema"
 	"github.com/caixw/apidoc/v7/internal/locale"
+	"github.com/caixw/apidoc/v7/internal/markdown"
 	"github.com/caixw/apidoc/v7/internal/openapi"
+	"github.com/caixw/apidoc/v7/internal/postman"
+	"github.com/caixw/apidoc/v7/internal/typescript"
 	"github.com/caixw/apidoc/v7/internal/xmlenc"
 )
 
 // 几种输出的类型
 const (
-	APIDocXML   = "apidoc+xml"
-	OpenapiYAML = "openapi+yaml"
-	OpenapiJSON = "openapi+json"
+	APIDocXML     = "apidoc+xml"
+	OpenapiYAML   = "openapi+yaml"
+	OpenapiJSON   = "openapi+json"
+	Openapi31YAML = "openapi3.1+yaml"
+	Openapi31JSON = "openapi3.1+json"
+	SwaggerYAML   = "swagger+yaml"
+	SwaggerJSON   = "swagger+json"
+	Markdown      = "markdown"
+	HTML          = "html"
+	PostmanJSON   = "postman+json"
+	TypeScript    = "typescript"
+	GoClient      = "go-client"
+	GoServer      = "go-server"
+	JSONSchema    = "jsonschema"
 )
 
-type marshaler func(*ast.APIDoc) ([]byte, error)
+// 转换过程中无法表达的内容以警告的形式输出到 h
+type marshaler func(*core.MessageHandler, *ast.APIDoc) ([]byte, error)
 
 // Output 指定了渲染输出的相关设置项。
 type Output struct {
@@ -65,9 +86,43 @@ type Output struct {
 	Namespace       bool   `yaml:"namespace,omitempty"`
 	NamespacePrefix string `yaml:"namespace-prefix,omitempty"`
 
-	procInst []string  // 保存所有 xml 的指令内容，包括编码信息
-	marshal  marshaler // Type 对应的转换函数
-	xml      bool      // 是否为 xml 内容
+	// 是否内联所有的 schema
+	//
+	// 默认情况下，结构相同的对象会被提取到 components.schemas 中，
+	// 并以 $ref 的形式引用，为 true 时则不作提取。
+	//
+	// NOTE: 仅针对 openapi 3.0 和 3.1 的输出类型，swagger 不会提取。
+	InlineSchema bool `yaml:"inline-schema,omitempty"`
+
+	// 是否按标签拆分文档
+	//
+	// 为 true 时，Path 指定的文件只包含文档的基本信息和未指定标签的 API，
+	// 各个标签的 API 分别保存在与 Path 相同目录下以标签名的 slug 命名的文件中，
+	// 通过 Buffer 生成文档时，这些文件可以通过 Files 获取。
+	//
+	// NOTE: 仅针对 Type = Markdown
+	Split bool `yaml:"split,omitempty"`
+
+	// 生成的 Go 代码的包名
+	//
+	// 默认取 Path 所在目录的名称，无法作为包名时采用 client 或是 server。
+	//
+	// NOTE: 仅针对 Type = GoClient 和 GoServer
+	Package string `yaml:"package,omitempty"`
+
+	procInst []string          // 保存所有 xml 的指令内容，包括编码信息
+	marshal  marshaler         // Type 对应的转换函数
+	xml      bool              // 是否为 xml 内容
+	files    map[string][]byte // 除 Path 之外还需要输出的文件，键名为以 / 分隔的相对路径。
+}
+
+// Files 返回最近一次生成文档时除 Path 之外的其它文件
+//
+// 比如 Split 为 true 时各个标签对应的文档，键名为相对于 Path 所在目录且以 / 分隔的路径。
+// Build 会将这些文件写入 Path 所在的目录，而 Buffer 仅返回 Path 的内容，
+// 调用方需要通过此方法获取其它文件。
+func (o *Output) Files() map[string][]byte {
+	return o.files
 }
 
 func (o *Output) contains(tags ...string) bool {
@@ -100,13 +155,55 @@ func (o *Output) sanitize() error {
 	case APIDocXML:
 		o.marshal = o.apidocMarshaler
 	case OpenapiJSON:
-		o.marshal = openapi.JSON
+		o.marshal = func(h *core.MessageHandler, d *ast.APIDoc) ([]byte, error) {
+			return openapi.JSON(d, !o.InlineSchema)
+		}
 	case OpenapiYAML:
-		o.marshal = openapi.YAML
+		o.marshal = func(h *core.MessageHandler, d *ast.APIDoc) ([]byte, error) {
+			return openapi.YAML(d, !o.InlineSchema)
+		}
+	case Openapi31JSON:
+		o.marshal = func(h *core.MessageHandler, d *ast.APIDoc) ([]byte, error) {
+			return openapi.JSON31(h, d, !o.InlineSchema)
+		}
+	case Openapi31YAML:
+		o.marshal = func(h *core.MessageHandler, d *ast.APIDoc) ([]byte, error) {
+			return openapi.YAML31(h, d, !o.InlineSchema)
+		}
+	case SwaggerJSON:
+		o.marshal = openapi.SwaggerJSON
+	case SwaggerYAML:
+		o.marshal = openapi.SwaggerYAML
+	case Markdown:
+		o.marshal = o.markdownMarshaler
+	case HTML:
+		o.marshal = o.htmlMarshaler
+	case PostmanJSON:
+		o.marshal = func(h *core.MessageHandler, d *ast.APIDoc) ([]byte, error) {
+			return postman.JSON(d)
+		}
+	case TypeScript:
+		o.marshal = func(h *core.MessageHandler, d *ast.APIDoc) ([]byte, error) {
+			return typescript.TypeScript(d)
+		}
+	case GoClient:
+		o.marshal = func(h *core.MessageHandler, d *ast.APIDoc) ([]byte, error) {
+			return gocode.Client(d, o.packageName("client"))
+		}
+	case GoServer:
+		o.marshal = func(h *core.MessageHandler, d *ast.APIDoc) ([]byte, error) {
+			return gocode.Server(d, o.packageName("server"))
+		}
+	case JSONSchema:
+		o.marshal = o.jsonschemaMarshaler
 	default:
 		return core.NewError(locale.ErrInvalidValue).WithField("type")
 	}
 
+	if o.Package != "" && !token.IsIdentifier(o.Package) {
+		return core.NewError(locale.ErrInvalidFormat).WithField("package")
+	}
+
 	o.xml = strings.HasSuffix(o.Type, "+xml")
 	if o.xml {
 		if o.Style == "" {
@@ -129,14 +226,94 @@ func (o *Output) sanitize() error {
 	return nil
 }
 
-func (o *Output) apidocMarshaler(d *ast.APIDoc) ([]byte, error) {
+func (o *Output) apidocMarshaler(h *core.MessageHandler, d *ast.APIDoc) ([]byte, error) {
 	if !o.Namespace {
 		return xmlenc.Encode("\t", d, "", "")
 	}
 	return xmlenc.Encode("\t", d, core.XMLNamespace, o.NamespacePrefix)
 }
 
-func (o *Output) buffer(d *ast.APIDoc) (*bytes.Buffer, error) {
+func (o *Output) markdownMarshaler(h *core.MessageHandler, d *ast.APIDoc) ([]byte, error) {
+	if !o.Split {
+		return markdown.Markdown(d)
+	}
+
+	index, err := o.indexName("index" + markdown.Ext)
+	if err != nil {
+		return nil, err
+	}
+
+	page, files, err := markdown.Split(d, index)
+	if err != nil {
+		return nil, err
+	}
+	o.files = files
+	return page, nil
+}
+
+// 各个标签的页面保存在与 Path 相同的目录下
+func (o *Output) htmlMarshaler(h *core.MessageHandler, d *ast.APIDoc) ([]byte, error) {
+	index, err := o.indexName("index" + html.Ext)
+	if err != nil {
+		return nil, err
+	}
+
+	page, files, err := html.HTML(d, index)
+	if err != nil {
+		return nil, err
+	}
+	o.files = files
+	return page, nil
+}
+
+// 各个 API 的 JSON Schema 保存在与 Path 相同目录下的子目录中，Path 为索引文件。
+func (o *Output) jsonschemaMarshaler(h *core.MessageHandler, d *ast.APIDoc) ([]byte, error) {
+	index, files, err := jsonschema.JSONSchema(d)
+	if err != nil {
+		return nil, err
+	}
+	o.files = files
+	return index, nil
+}
+
+// 返回 Path 的文件名，未指定 Path 时返回 def。
+func (o *Output) indexName(def string) (string, error) {
+	if o.Path == "" {
+		return def, nil
+	}
+
+	path, err := o.Path.File()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Base(path), nil
+}
+
+// 未指定 Package 时，以 Path 所在的目录名作为包名，无法作为包名时返回 def。
+func (o *Output) packageName(def string) string {
+	if o.Package != "" {
+		return o.Package
+	}
+
+	if o.Path != "" {
+		if path, err := o.Path.File(); err == nil {
+			name := strings.ToLower(filepath.Base(filepath.Dir(path)))
+			name = strings.Map(func(r rune) rune {
+				if r == '-' || r == '.' {
+					return -1
+				}
+				return r
+			}, name)
+			if token.IsIdentifier(name) {
+				return name
+			}
+		}
+	}
+
+	return def
+}
+
+func (o *Output) buffer(h *core.MessageHandler, d *ast.APIDoc) (*bytes.Buffer, error) {
 	filterDoc(d, o)
 
 	if o.Version != "" {
@@ -146,7 +323,8 @@ func (o *Output) buffer(d *ast.APIDoc) (*bytes.Buffer, error) {
 	d.Created = &ast.DateAttribute{Value: ast.Date{Value: time.Now()}}
 	d.APIDoc = &ast.APIDocVersionAttribute{Value: xmlenc.String{Value: ast.Version}}
 
-	data, err := o.marshal(d)
+	o.files = nil
+	data, err := o.marshal(h, d)
 	if err != nil {
 		return nil, err
 	}
@@ -165,6 +343,51 @@ func (o *Output) buffer(d *ast.APIDoc) (*bytes.Buffer, error) {
 	return &buf.Buffer, nil
 }
 
+// 将内容写入 Path，以及与 Path 相同目录下的其它文件中。
+//
+// 其它文件的路径如果位于 Path 所在目录之外，则不会写入任何内容。
+func (o *Output) write(buf *bytes.Buffer) error {
+	paths := make(map[string][]byte, len(o.files))
+	if len(o.files) > 0 {
+		path, err := o.Path.File()
+		if err != nil {
+			return err
+		}
+		dir := filepath.Dir(path)
+
+		for name, data := range o.files {
+			p := filepath.Join(dir, filepath.FromSlash(name))
+			if !inDir(dir, p) {
+				return locale.NewError(locale.ErrOutsideDir, name)
+			}
+			paths[p] = data
+		}
+	}
+
+	if err := o.Path.WriteAll(buf.Bytes()); err != nil {
+		return err
+	}
+
+	for p, data := range paths {
+		if err := os.MkdirAll(filepath.Dir(p), os.ModePerm); err != nil {
+			return err
+		}
+		if err := core.FileURI(p).WriteAll(data); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// path 是否位于 dir 之下，且不是 dir 本身。
+func inDir(dir, path string) bool {
+	rel, err := filepath.Rel(dir, path)
+	if err != nil || rel == "." || rel == ".." {
+		return false
+	}
+	return !strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
+
 func filterDoc(d *ast.APIDoc, o *Output) {
 	if len(o.Tags) == 0 {
 		return
@@ -3,11 +3,16 @@
 package build
 
 import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/issue9/assert"
 
 	"github.com/caixw/apidoc/v7/core"
+	"github.com/caixw/apidoc/v7/core/messagetest"
 	"github.com/caixw/apidoc/v7/internal/ast/asttest"
 	"github.com/caixw/apidoc/v7/internal/docs"
 )
@@ -47,10 +52,31 @@ func TestOutput_Sanitize(t *testing.T) {
 	a.NotError(o.sanitize())
 	o.Version = "1"
 	a.Error(o.sanitize())
+
+	o = &Output{Type: GoClient, Package: "1client"}
+	a.Error(o.sanitize())
+}
+
+func TestOutput_packageName(t *testing.T) {
+	a := assert.New(t)
+
+	o := &Output{Type: GoClient}
+	a.Equal(o.packageName("client"), "client")
+
+	o.Package = "users"
+	a.Equal(o.packageName("client"), "users")
+
+	o = &Output{Type: GoClient, Path: core.FileURI("./api-client/client.go")}
+	a.Equal(o.packageName("client"), "apiclient")
+
+	o.Path = core.FileURI("./1client/client.go")
+	a.Equal(o.packageName("client"), "client")
 }
 
 func TestOptions_buffer(t *testing.T) {
 	a := assert.New(t)
+	rslt := messagetest.NewMessageHandler()
+	defer rslt.Handler.Stop()
 
 	doc := asttest.Get()
 	o := &Output{
@@ -58,13 +84,100 @@ func TestOptions_buffer(t *testing.T) {
 		Path: "./openapi.json",
 	}
 	a.NotError(o.sanitize())
-	a.NotError(o.buffer(doc))
+	a.NotError(o.buffer(rslt.Handler, doc))
+
+	doc = asttest.Get()
+	o = &Output{
+		Type:         OpenapiYAML,
+		Path:         "./openapi.yaml",
+		InlineSchema: true,
+	}
+	a.NotError(o.sanitize())
+	a.NotError(o.buffer(rslt.Handler, doc))
+
+	for _, typ := range []string{Openapi31JSON, Openapi31YAML, SwaggerJSON, SwaggerYAML, Markdown, HTML, PostmanJSON, TypeScript, GoClient, GoServer, JSONSchema} {
+		doc = asttest.Get()
+		o = &Output{Type: typ}
+		a.NotError(o.sanitize())
+		buf, err := o.buffer(rslt.Handler, doc)
+		a.NotError(err).NotNil(buf)
+	}
 
 	doc = asttest.Get()
 	o = &Output{}
 	a.NotError(o.sanitize())
-	buf, err := o.buffer(doc)
+	buf, err := o.buffer(rslt.Handler, doc)
+	a.NotError(err).NotNil(buf)
+}
+
+func TestOutput_write(t *testing.T) {
+	a := assert.New(t)
+	rslt := messagetest.NewMessageHandler()
+	defer rslt.Handler.Stop()
+
+	dir, err := ioutil.TempDir("", "apidoc-output")
+	a.NotError(err)
+	defer os.RemoveAll(dir)
+
+	o := &Output{
+		Type:  Markdown,
+		Path:  core.FileURI(filepath.Join(dir, "index.md")),
+		Split: true,
+	}
+	a.NotError(o.sanitize())
+	buf, err := o.buffer(rslt.Handler, asttest.Get())
 	a.NotError(err).NotNil(buf)
+	a.NotError(o.write(buf))
+
+	for _, name := range []string{"index.md", "t1.md", "t2.md", "tag1.md"} {
+		_, err := os.Stat(filepath.Join(dir, name))
+		a.NotError(err)
+	}
+
+	o = &Output{
+		Type: HTML,
+		Path: core.FileURI(filepath.Join(dir, "apidoc.html")),
+	}
+	a.NotError(o.sanitize())
+	buf, err = o.buffer(rslt.Handler, asttest.Get())
+	a.NotError(err).NotNil(buf)
+	a.NotError(o.write(buf))
+
+	for _, name := range []string{"apidoc.html", "t1.html", "t2.html", "tag1.html"} {
+		_, err := os.Stat(filepath.Join(dir, name))
+		a.NotError(err)
+	}
+	data, err := ioutil.ReadFile(filepath.Join(dir, "t1.html"))
+	a.NotError(err).Contains(string(data), `<a href="apidoc.html">`)
+
+	o = &Output{
+		Type: JSONSchema,
+		Path: core.FileURI(filepath.Join(dir, "schemas", "index.json")),
+	}
+	a.NotError(os.Mkdir(filepath.Join(dir, "schemas"), os.ModePerm))
+	a.NotError(o.sanitize())
+	buf, err = o.buffer(rslt.Handler, asttest.Get())
+	a.NotError(err).NotNil(buf)
+	a.NotError(o.write(buf))
+
+	a.NotEmpty(o.files)
+	for name := range o.files {
+		_, err := os.Stat(filepath.Join(dir, "schemas", filepath.FromSlash(name)))
+		a.NotError(err)
+	}
+
+	// 拒绝写入 Path 所在目录之外的文件
+	for _, name := range []string{"../x.md", "a/../../x.md", "..", "."} {
+		o = &Output{
+			Type: Markdown,
+			Path: core.FileURI(filepath.Join(dir, "sub", "index.md")),
+		}
+		a.NotError(o.sanitize())
+		o.files = map[string][]byte{name: []byte("x")}
+		a.Error(o.write(bytes.NewBufferString("index")), name)
+	}
+	_, err = os.Stat(filepath.Join(dir, "x.md"))
+	a.True(os.IsNotExist(err))
 }
 
 func TestFilterDoc(t *testing.T) {
@@ -0,0 +1,97 @@
+// SPDX-License-Identifier: MIT
+
+package build
+
+import (
+	"io"
+
+	"golang.org/x/text/encoding"
+	"gopkg.in/yaml.v2"
+
+	"github.com/caixw/apidoc/v7/core"
+	"github.com/caixw/apidoc/v7/internal/locale"
+	"github.com/caixw/apidoc/v7/internal/upgrade"
+)
+
+// Upgrade 将 wd 下的配置文件以及配置文件指定的源码中的文档升级到当前版本
+//
+// 与 LoadConfig 不同，不会检测配置文件的版本号是否与当前程序兼容。
+// dryRun 为 true 时不会修改文件，而是将修改的内容以 unified diff 的格式写入 w；
+// 每个需要修改的文件都会通过 h 输出修改的摘要。
+func Upgrade(h *core.MessageHandler, wd core.URI, dryRun bool, w io.Writer) error {
+	path, err := configPath(wd)
+	if err != nil {
+		return err
+	}
+
+	data, err := path.ReadAll(nil)
+	if err != nil {
+		return (core.Location{URI: path}).WithError(err)
+	}
+
+	cfg := &Config{}
+	if err = yaml.Unmarshal(data, cfg); err != nil {
+		return (core.Location{URI: path}).WithError(err)
+	}
+	if len(cfg.Inputs) == 0 {
+		return (core.Location{URI: path}).NewError(locale.ErrIsEmpty, "inputs").WithField("inputs")
+	}
+	if err = cfg.sanitizeInputs(path, wd); err != nil {
+		return err
+	}
+
+	u := upgrade.New()
+	encodings := make(map[core.URI]encoding.Encoding, 100)
+	for _, i := range cfg.Inputs {
+		for _, p := range i.paths {
+			if _, found := encodings[p]; found { // 多个 input 可能指向相同的文件
+				continue
+			}
+			encodings[p] = i.encoding
+
+			src, err := p.ReadAll(i.encoding)
+			if err != nil {
+				return (core.Location{URI: p}).WithError(err)
+			}
+			u.Parse(h, i.Lang, p, src)
+		}
+	}
+
+	files := u.Files(h)
+	if f := upgrade.Config(path, data); f.Summary.Total() > 0 {
+		files = append(files, f)
+	}
+
+	if len(files) == 0 {
+		h.Locale(core.Info, locale.UpgradeNoChanges)
+		return nil
+	}
+
+	for _, f := range files {
+		s := f.Summary
+		h.Locale(core.Info, locale.UpgradeFileSummary, f.URI, s.TypeNone, s.XMLNS, s.Namespace, s.Version)
+
+		if dryRun {
+			name, err := rel(f.URI, wd)
+			if err != nil {
+				return err
+			}
+			if _, err = w.Write(f.Diff(string(name))); err != nil {
+				return err
+			}
+			continue
+		}
+
+		data := f.Bytes()
+		if enc := encodings[f.URI]; enc != nil {
+			if data, err = enc.NewEncoder().Bytes(data); err != nil {
+				return (core.Location{URI: f.URI}).WithError(err)
+			}
+		}
+		if err = f.URI.WriteAll(data); err != nil {
+			return (core.Location{URI: f.URI}).WithError(err)
+		}
+	}
+
+	return nil
+}
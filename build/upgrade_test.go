@@ -0,0 +1,81 @@
+// SPDX-License-Identifier: MIT
+
+package build
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/issue9/assert"
+
+	"github.com/caixw/apidoc/v7/core"
+	"github.com/caixw/apidoc/v7/core/messagetest"
+)
+
+func TestUpgrade(t *testing.T) {
+	a := assert.New(t)
+
+	dir, err := ioutil.TempDir("", "apidoc-upgrade")
+	a.NotError(err)
+	defer os.RemoveAll(dir)
+
+	config := "version: 5.0.0\ninputs:\n- lang: go\n  dir: ./\noutput:\n  path: ./apidoc.xml\n"
+	src := `package doc
+
+// <apidoc version="1.0.0">
+//     <title>test</title>
+//     <mimetype>application/json</mimetype>
+// </apidoc>
+
+// <api method="GET">
+//     <path path="/users" />
+//     <response status="204" type="none" />
+// </api>
+`
+	a.NotError(ioutil.WriteFile(filepath.Join(dir, ".apidoc.yaml"), []byte(config), os.ModePerm))
+	a.NotError(ioutil.WriteFile(filepath.Join(dir, "doc.go"), []byte(src), os.ModePerm))
+	wd := core.FileURI(dir)
+
+	_, err = LoadConfig(wd)
+	a.Error(err)
+
+	// dry-run
+	rslt := messagetest.NewMessageHandler()
+	buf := new(bytes.Buffer)
+	a.NotError(Upgrade(rslt.Handler, wd, true, buf))
+	rslt.Handler.Stop()
+	a.Empty(rslt.Errors).Equal(2, len(rslt.Infos))
+	a.Contains(buf.String(), "--- a/doc.go").
+		Contains(buf.String(), `-//     <response status="204" type="none" />`).
+		Contains(buf.String(), "--- a/.apidoc.yaml")
+	data, err := ioutil.ReadFile(filepath.Join(dir, "doc.go"))
+	a.NotError(err).Equal(string(data), src)
+
+	rslt = messagetest.NewMessageHandler()
+	buf.Reset()
+	a.NotError(Upgrade(rslt.Handler, wd, false, buf))
+	rslt.Handler.Stop()
+	a.Empty(rslt.Errors).Equal(2, len(rslt.Infos)).Empty(buf.String())
+	data, err = ioutil.ReadFile(filepath.Join(dir, "doc.go"))
+	a.NotError(err).Contains(string(data), "//     <response status=\"204\" />\n")
+
+	cfg, err := LoadConfig(wd)
+	a.NotError(err).NotNil(cfg)
+	rslt = messagetest.NewMessageHandler()
+	cfg.Build(rslt.Handler)
+	rslt.Handler.Stop()
+	a.Empty(rslt.Errors)
+
+	// 已经是最新的版本
+	rslt = messagetest.NewMessageHandler()
+	a.NotError(Upgrade(rslt.Handler, wd, false, buf))
+	rslt.Handler.Stop()
+	a.Empty(rslt.Errors).Equal(1, len(rslt.Infos))
+
+	rslt = messagetest.NewMessageHandler()
+	a.Error(Upgrade(rslt.Handler, core.FileURI(filepath.Join(dir, "not-exists")), false, buf))
+	rslt.Handler.Stop()
+}
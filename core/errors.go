@@ -22,6 +22,7 @@ type Error struct {
 	Err      error                // 具体的错误信息
 	Location Location             // 错误的详细定位
 	Field    string               // 出错的字段
+	Code     string               // 错误代码，比如 lint 规则的名称，会作为 LSP 诊断信息的 code 字段。
 	Types    []ErrorType          // 该错误的类型
 	Related  []RelatedInformation // 与此错误关联的一些信息
 }
@@ -63,8 +64,13 @@ func (err *Error) Error() string {
 		detail += ":" + err.Field
 	}
 
+	msg := err.Err.Error()
+	if err.Code != "" {
+		msg += " (" + err.Code + ")"
+	}
+
 	// ErrMessage = "%s 位次于 %s:%d"
-	return locale.Sprintf(locale.ErrMessage, err.Err.Error(), detail)
+	return locale.Sprintf(locale.ErrMessage, msg, detail)
 }
 
 // Unwrap 实现 errors.Unwrap 接口
@@ -99,6 +105,12 @@ func (err *Error) WithLocation(loc Location) *Error {
 	return err
 }
 
+// WithCode 为错误添加错误代码
+func (err *Error) WithCode(code string) *Error {
+	err.Code = code
+	return err
+}
+
 // AddTypes 为语法错误添加错误类型
 func (err *Error) AddTypes(t ...ErrorType) *Error {
 	if err.Types == nil {
@@ -19,6 +19,10 @@ func TestError(t *testing.T) {
 	err1 := NewError("msg")
 	err2 := NewError("msg").WithField("field")
 	a.NotEqual(err1.Error(), err2.Error())
+
+	err3 := NewError("msg").WithField("field").WithCode("code")
+	a.Equal(err3.Code, "code").
+		Contains(err3.Error(), "(code)")
 }
 
 func TestWithError(t *testing.T) {
@@ -28,13 +28,15 @@
 //             </param>
 //         </param>
 //         <example mimetype="application/json"><![CDATA[
-// {
-//  count: 5,
-//  list: [
-//    {id:1, name: 'name1', 'groups': [1,2]},
-//    {id:2, name: 'name2', 'groups': [1,2]}
-//  ]
-// }
+// [
+//  {
+//   "count": 5,
+//   "list": [
+//     {"id": 1, "name": "name1", "groups": ["xx1", "xx2"]},
+//     {"id": 2, "name": "name2", "groups": ["xx1"]}
+//   ]
+//  }
+// ]
 //         ]]></example>
 //     </response>
 //
@@ -51,8 +53,8 @@
 //             <example mimetype="application/json">
 //             <![CDATA[
 //             {
-//                 id:1,
-//                 sex: male,
+//                 "id": 1,
+//                 "age": 18
 //             }
 //             ]]>
 //             </example>
@@ -179,6 +179,19 @@ exclude-result-prefixes="l">
     </xsl:call-template>
 </xsl:variable>
 
+<!-- cookie -->
+<xsl:variable name="locale-cookie">
+    <xsl:call-template name="build-locale">
+        <xsl:with-param name="lang" select="'cmn-hans'" />
+        <xsl:with-param name="text" select="'Cookie'" />
+    </xsl:call-template>
+
+    <xsl:call-template name="build-locale">
+        <xsl:with-param name="lang" select="'cmn-hant'" />
+        <xsl:with-param name="text" select="'Cookie'" />
+    </xsl:call-template>
+</xsl:variable>
+
 <!-- body -->
 <xsl:variable name="locale-body">
     <xsl:call-template name="build-locale">
@@ -33,6 +33,7 @@ const (
 	TypeNone     = "" // 空值表示不输出任何内容，仅用于 Request
 	TypeBool     = "bool"
 	TypeObject   = "object"
+	TypeMap      = "map" // 键名为任意字符串的对象，值的类型由唯一的子元素描述
 	TypeNumber   = "number"
 	TypeString   = "string"
 	TypeInt      = "number.int"
@@ -43,6 +44,39 @@ const (
 	TypeDate     = "string.date"      // RFC3339 full-date
 	TypeTime     = "string.time"      // RFC3339 full-time
 	TypeDateTime = "string.date-time" // RFC3339 full-date + full-time
+	TypeBinary   = "string.binary"    // 二进制内容，比如上传的文件
+
+	// 引用 TypeDef 的类型前缀，比如 #user 表示引用名为 user 的 TypeDef。
+	typeRefPrefix = "#"
+)
+
+// LintDisableAll 在 lint-disable 注释中未指定规则名称时，表示禁用所有规则的值。
+const LintDisableAll = "*"
+
+// 禁用 lint 规则的 XML 注释内容前缀，比如 <!-- lint-disable api-id -->。
+const lintDisablePrefix = "lint-disable"
+
+// Security.Type 可用的值
+const (
+	SecurityTypeAPIKey        = "apikey"
+	SecurityTypeHTTP          = "http"
+	SecurityTypeOAuth2        = "oauth2"
+	SecurityTypeOpenIDConnect = "openidconnect"
+)
+
+// Security.In 可用的值
+const (
+	SecurityInHeader = "header"
+	SecurityInQuery  = "query"
+	SecurityInCookie = "cookie"
+)
+
+// OAuthFlow.Type 可用的值
+const (
+	OAuthFlowImplicit          = "implicit"
+	OAuthFlowPassword          = "password"
+	OAuthFlowClientCredentials = "client-credentials"
+	OAuthFlowAuthorizationCode = "authorization-code"
 )
 
 // 富文本可用的类型
@@ -32,6 +32,9 @@ func TestParseType(t *testing.T) {
 
 	p, s = ParseType(TypeInt)
 	a.Equal(p, TypeNumber).Equal(s, "int")
+
+	p, s = ParseType(TypeMap)
+	a.Equal(p, TypeMap).Empty(s)
 }
 
 func TestTrimLeftSpace(t *testing.T) {
@@ -63,6 +63,9 @@ type (
 		xmlenc.BaseAttribute
 		Value    xmlenc.String `apidoc:"-"`
 		RootName struct{}      `apidoc:"type,meta,usage-type"`
+
+		definition *Definition // 引用的 TypeDef
+		cyclic     bool        // 是否存在循环引用
 	}
 
 	// APIDocVersionAttribute 版本号属性，同时对版本号进行比较
@@ -134,6 +137,19 @@ func (num *NumberAttribute) FloatValue() float64 {
 	return num.Value.Float
 }
 
+// V 返回当前属性实际表示的值
+//
+// 不区分整数与浮点数，统一以 float64 返回。
+func (num *NumberAttribute) V() float64 {
+	if num == nil {
+		return 0
+	}
+	if num.Value.IsFloat {
+		return num.Value.Float
+	}
+	return float64(num.Value.Int)
+}
+
 // IsFloat 当前的数值类型是否为浮点型
 func (num *NumberAttribute) IsFloat() bool {
 	return num.Value.IsFloat
@@ -233,6 +249,29 @@ func (a *TypeAttribute) V() string {
 	return a.Value.Value
 }
 
+// Definition Definitioner.Definition
+func (a *TypeAttribute) Definition() *Definition {
+	return a.definition
+}
+
+// TypeDef 返回引用的类型定义
+//
+// 如果未引用 TypeDef、引用的内容不存在或是存在循环引用，则返回 nil。
+func (a *TypeAttribute) TypeDef() *TypeDef {
+	if a == nil || a.definition == nil || a.cyclic {
+		return nil
+	}
+	return a.definition.Target.(*TypeDef)
+}
+
+// 返回引用的 TypeDef 名称，如果不是引用类型，则返回空值。
+func (a *TypeAttribute) refName() string {
+	if v := a.V(); strings.HasPrefix(v, typeRefPrefix) {
+		return v[len(typeRefPrefix):]
+	}
+	return ""
+}
+
 // DecodeXMLAttr AttrDecoder.DecodeXMLAttr
 func (a *VersionAttribute) DecodeXMLAttr(p *xmlenc.Parser, attr *xmlenc.Attribute) error {
 	a.Value = attr.Value
@@ -330,6 +369,7 @@ func isValidStatus(status int) bool {
 func isValidType(t string) bool {
 	return t == TypeBool ||
 		t == TypeObject ||
+		t == TypeMap ||
 		t == TypeNumber ||
 		t == TypeInt ||
 		t == TypeFloat ||
@@ -340,7 +380,9 @@ func isValidType(t string) bool {
 		t == TypeDate ||
 		t == TypeTime ||
 		t == TypeDateTime ||
-		t == TypeNone
+		t == TypeBinary ||
+		t == TypeNone ||
+		(strings.HasPrefix(t, typeRefPrefix) && len(t) > len(typeRefPrefix))
 }
 
 func isValidVersion(v string) bool {
@@ -45,7 +45,7 @@ func TestNumberAttribute(t *testing.T) {
 	num := &NumberAttribute{}
 	attr := &xmlenc.Attribute{Value: xmlenc.String{Value: "6"}}
 	a.NotError(num.DecodeXMLAttr(p, attr))
-	a.Equal(num.IntValue(), 6).Equal(0.0, num.FloatValue())
+	a.Equal(num.IntValue(), 6).Equal(0.0, num.FloatValue()).Equal(6.0, num.V())
 	v, err := num.EncodeXMLAttr()
 	a.NotError(err).Equal(v, "6")
 	rslt.Handler.Stop()
@@ -54,7 +54,7 @@ func TestNumberAttribute(t *testing.T) {
 	num = &NumberAttribute{}
 	attr = &xmlenc.Attribute{Value: xmlenc.String{Value: "6.1"}}
 	a.NotError(num.DecodeXMLAttr(p, attr))
-	a.Equal(num.IntValue(), 0).Equal(6.1, num.FloatValue())
+	a.Equal(num.IntValue(), 0).Equal(6.1, num.FloatValue()).Equal(6.1, num.V())
 	v, err = num.EncodeXMLAttr()
 	a.NotError(err).Equal(v, "6.1")
 	rslt.Handler.Stop()
@@ -140,6 +140,20 @@ func TestTypeAttribute(t *testing.T) {
 	attr = &xmlenc.Attribute{Value: xmlenc.String{Value: "10000"}}
 	a.Error(tt.DecodeXMLAttr(p, attr))
 	rslt.Handler.Stop()
+
+	// 引用 TypeDef
+	p, rslt = newParser(a, "", "uri1")
+	tt = &TypeAttribute{}
+	attr = &xmlenc.Attribute{Value: xmlenc.String{Value: "#user"}}
+	a.NotError(tt.DecodeXMLAttr(p, attr))
+	a.Equal(tt.refName(), "user").Nil(tt.TypeDef()).Nil(tt.Definition())
+	rslt.Handler.Stop()
+
+	p, rslt = newParser(a, "", "uri1")
+	tt = &TypeAttribute{}
+	attr = &xmlenc.Attribute{Value: xmlenc.String{Value: "#"}}
+	a.Error(tt.DecodeXMLAttr(p, attr))
+	rslt.Handler.Stop()
 }
 
 func TestVersionAttribute(t *testing.T) {
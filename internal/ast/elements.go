@@ -3,6 +3,8 @@
 package ast
 
 import (
+	"regexp"
+
 	"github.com/caixw/apidoc/v7/internal/xmlenc"
 )
 
@@ -24,10 +26,14 @@ type (
 		License       *Link                   `apidoc:"license,elem,usage-apidoc-license,omitempty"`         // 版权信息
 		Tags          []*Tag                  `apidoc:"tag,elem,usage-apidoc-tags,omitempty"`                // 标签列表
 		Servers       []*Server               `apidoc:"server,elem,usage-apidoc-servers,omitempty"`          // 服务器列表
+		Types         []*TypeDef              `apidoc:"type,elem,usage-apidoc-types,omitempty"`              // 可复用的类型定义
+		Securities    []*Security             `apidoc:"security,elem,usage-apidoc-securities,omitempty"`     // 身份验证方式
 		APIs          []*API                  `apidoc:"api,elem,usage-apidoc-apis,omitempty"`                // API 列表
 		Headers       []*Param                `apidoc:"header,elem,usage-apidoc-headers,omitempty"`          // 公共报头
 		Responses     []*Request              `apidoc:"response,elem,usage-apidoc-responses,omitempty"`      // 所有 API 都有可能的返回内容
 		Mimetypes     []*Element              `apidoc:"mimetype,elem,usage-apidoc-mimetypes"`                // 所有接口都支持的 mimetypes
+
+		lintDisabled []string
 	}
 
 	// XMLNamespace 定义命名空间的相关属性
@@ -44,6 +50,8 @@ type (
 		RootName struct{} `apidoc:"api,meta,usage-api"`
 		doc      *APIDoc
 
+		lintDisabled []string
+
 		Version     *VersionAttribute `apidoc:"version,attr,usage-api-version,omitempty"`
 		Method      *MethodAttribute  `apidoc:"method,attr,usage-api-method"`
 		ID          *Attribute        `apidoc:"id,attr,usage-api-id,omitempty"`
@@ -55,8 +63,10 @@ type (
 		Callback    *Callback         `apidoc:"callback,elem,usage-api-callback,omitempty"`
 		Deprecated  *VersionAttribute `apidoc:"deprecated,attr,usage-api-deprecated,omitempty"`
 		Headers     []*Param          `apidoc:"header,elem,usage-api-headers,omitempty"`
+		Cookies     []*Param          `apidoc:"cookie,elem,usage-api-cookies,omitempty"`
 		Tags        []*TagValue       `apidoc:"tag,elem,usage-api-tags,omitempty"`
 		Servers     []*ServerValue    `apidoc:"server,elem,usage-api-servers,omitempty"`
+		Securities  []*SecurityValue  `apidoc:"security,elem,usage-api-securities,omitempty"`
 	}
 
 	// Link 表示一个链接
@@ -120,17 +130,24 @@ type (
 		RootName struct{} `apidoc:"param,meta,usage-param"`
 
 		XML
+		Constraint
 		Name        *Attribute        `apidoc:"name,attr,usage-param-name"`
 		Type        *TypeAttribute    `apidoc:"type,attr,usage-param-type"`
 		Deprecated  *VersionAttribute `apidoc:"deprecated,attr,usage-param-deprecated,omitempty"`
 		Default     *Attribute        `apidoc:"default,attr,usage-param-default,omitempty"`
 		Optional    *BoolAttribute    `apidoc:"optional,attr,usage-param-optional,omitempty"`
+		Nullable    *BoolAttribute    `apidoc:"nullable,attr,usage-param-nullable,omitempty"`
 		Array       *BoolAttribute    `apidoc:"array,attr,usage-param-array,omitempty"`
 		Items       []*Param          `apidoc:"param,elem,usage-param-items,omitempty"`
 		Summary     *Attribute        `apidoc:"summary,attr,usage-param-summary,omitempty"`
 		Enums       []*Enum           `apidoc:"enum,elem,usage-param-enums,omitempty"`
 		Description *Richtext         `apidoc:"description,elem,usage-param-description,omitempty"`
 
+		// 联合类型，OneOf 和 AnyOf 只能指定其中之一
+		OneOf         []*Param   `apidoc:"one-of,elem,usage-param-one-of,omitempty"`
+		AnyOf         []*Param   `apidoc:"any-of,elem,usage-param-any-of,omitempty"`
+		Discriminator *Attribute `apidoc:"discriminator,attr,usage-param-discriminator,omitempty"`
+
 		// 数组参数是否展开
 		//
 		// 数组可以有以下两种展示方式：
@@ -163,6 +180,7 @@ type (
 		Type        *TypeAttribute    `apidoc:"type,attr,usage-request-type,omitempty"`
 		Deprecated  *VersionAttribute `apidoc:"deprecated,attr,usage-request-deprecated,omitempty"`
 		Enums       []*Enum           `apidoc:"enum,elem,usage-request-enums,omitempty"`
+		Nullable    *BoolAttribute    `apidoc:"nullable,attr,usage-request-nullable,omitempty"`
 		Array       *BoolAttribute    `apidoc:"array,attr,usage-request-array,omitempty"`
 		Items       []*Param          `apidoc:"param,elem,usage-request-items,omitempty"`
 		Summary     *Attribute        `apidoc:"summary,attr,usage-request-summary,omitempty"`
@@ -170,7 +188,13 @@ type (
 		Mimetype    *Attribute        `apidoc:"mimetype,attr,usage-request-mimetype,omitempty"`
 		Examples    []*Example        `apidoc:"example,elem,usage-request-examples,omitempty"`
 		Headers     []*Param          `apidoc:"header,elem,usage-request-headers,omitempty"` // 当前独有的报头，公用的可以放在 API 中
+		Cookies     []*Param          `apidoc:"cookie,elem,usage-request-cookies,omitempty"` // 作为返回对象时，表示需要设置的 cookie
 		Description *Richtext         `apidoc:"description,elem,usage-request-description,omitempty"`
+
+		// 联合类型，OneOf 和 AnyOf 只能指定其中之一
+		OneOf         []*Param   `apidoc:"one-of,elem,usage-request-one-of,omitempty"`
+		AnyOf         []*Param   `apidoc:"any-of,elem,usage-request-any-of,omitempty"`
+		Discriminator *Attribute `apidoc:"discriminator,attr,usage-request-discriminator,omitempty"`
 	}
 
 	// Richtext 富文本内容
@@ -208,6 +232,92 @@ type (
 		references []*Reference
 	}
 
+	// TypeDef 可复用的类型定义
+	//
+	// Param 和 Request 可以通过 type="#name" 的形式引用该类型。
+	//
+	// TypeDef 本身不能指定 nullable，是否可以为 null 由引用方的 Param.Nullable 或
+	// Request.Nullable 决定，同一类型在不同的引用处可以有不同的设置，Param.Resolve 也会保留引用方的值。
+	TypeDef struct {
+		xmlenc.BaseTag
+		RootName struct{} `apidoc:"typedef,meta,usage-typedef"`
+
+		Constraint
+		Name        *Attribute        `apidoc:"name,attr,usage-typedef-name"`
+		Type        *TypeAttribute    `apidoc:"type,attr,usage-typedef-type"`
+		Deprecated  *VersionAttribute `apidoc:"deprecated,attr,usage-typedef-deprecated,omitempty"`
+		Items       []*Param          `apidoc:"param,elem,usage-typedef-items,omitempty"`
+		Summary     *Attribute        `apidoc:"summary,attr,usage-typedef-summary,omitempty"`
+		Enums       []*Enum           `apidoc:"enum,elem,usage-typedef-enums,omitempty"`
+		Description *Richtext         `apidoc:"description,elem,usage-typedef-description,omitempty"`
+
+		// 联合类型，OneOf 和 AnyOf 只能指定其中之一
+		OneOf         []*Param   `apidoc:"one-of,elem,usage-typedef-one-of,omitempty"`
+		AnyOf         []*Param   `apidoc:"any-of,elem,usage-typedef-any-of,omitempty"`
+		Discriminator *Attribute `apidoc:"discriminator,attr,usage-typedef-discriminator,omitempty"`
+
+		references []*Reference
+	}
+
+	// Security 身份验证方式
+	Security struct {
+		xmlenc.BaseTag
+		RootName struct{} `apidoc:"security,meta,usage-security"`
+
+		Name        *Attribute `apidoc:"name,attr,usage-security-name"` // 唯一 ID
+		Type        *Attribute `apidoc:"type,attr,usage-security-type"`
+		Summary     *Attribute `apidoc:"summary,attr,usage-security-summary,omitempty"`
+		Description *Richtext  `apidoc:"description,elem,usage-security-description,omitempty"`
+
+		// apikey
+		In  *Attribute `apidoc:"in,attr,usage-security-in,omitempty"`
+		Key *Attribute `apidoc:"key,attr,usage-security-key,omitempty"`
+
+		// http
+		Scheme       *Attribute `apidoc:"scheme,attr,usage-security-scheme,omitempty"`
+		BearerFormat *Attribute `apidoc:"bearer-format,attr,usage-security-bearer-format,omitempty"`
+
+		// oauth2
+		Flows []*OAuthFlow `apidoc:"flow,elem,usage-security-flows,omitempty"`
+
+		// openidconnect
+		OpenIDConnectURL *Attribute `apidoc:"openid-connect-url,attr,usage-security-openid-connect-url,omitempty"`
+
+		references []*Reference
+	}
+
+	// OAuthFlow oauth2 的授权流程
+	OAuthFlow struct {
+		xmlenc.BaseTag
+		RootName struct{} `apidoc:"oauth-flow,meta,usage-oauth-flow"`
+
+		Type             *Attribute `apidoc:"type,attr,usage-oauth-flow-type"`
+		AuthorizationURL *Attribute `apidoc:"authorization-url,attr,usage-oauth-flow-authorization-url,omitempty"`
+		TokenURL         *Attribute `apidoc:"token-url,attr,usage-oauth-flow-token-url,omitempty"`
+		RefreshURL       *Attribute `apidoc:"refresh-url,attr,usage-oauth-flow-refresh-url,omitempty"`
+		Scopes           []*Scope   `apidoc:"scope,elem,usage-oauth-flow-scopes,omitempty"`
+	}
+
+	// Scope oauth2 的权限范围
+	Scope struct {
+		xmlenc.BaseTag
+		RootName struct{} `apidoc:"scope,meta,usage-scope"`
+
+		Name    *Attribute `apidoc:"name,attr,usage-scope-name"`
+		Summary *Attribute `apidoc:"summary,attr,usage-scope-summary"`
+	}
+
+	// SecurityValue api.security 的类型
+	SecurityValue struct {
+		xmlenc.BaseTag
+		RootName struct{} `apidoc:"security-value,meta,usage-security-value"`
+
+		Name   *Attribute `apidoc:"name,attr,usage-security-value-name"`
+		Scopes []*Element `apidoc:"scope,elem,usage-security-value-scopes,omitempty"`
+
+		definition *Definition
+	}
+
 	// XML 仅作用于 XML 的几个属性
 	XML struct {
 		XMLAttr     *BoolAttribute `apidoc:"xml-attr,attr,usage-xml-attr,omitempty"`        // 作为父元素的 XML 属性存在
@@ -217,6 +327,19 @@ type (
 		XMLWrapped  *Attribute     `apidoc:"xml-wrapped,attr,usage-xml-wrapped,omitempty"`  // 如果当前元素是数组，是否将其包含在 wrapped 中
 	}
 
+	// Constraint 对参数值的约束
+	Constraint struct {
+		Min       *NumberAttribute `apidoc:"min,attr,usage-constraint-min,omitempty"`               // 数值的最小值
+		Max       *NumberAttribute `apidoc:"max,attr,usage-constraint-max,omitempty"`               // 数值的最大值
+		MinLength *NumberAttribute `apidoc:"min-length,attr,usage-constraint-min-length,omitempty"` // 字符串的最小长度
+		MaxLength *NumberAttribute `apidoc:"max-length,attr,usage-constraint-max-length,omitempty"` // 字符串的最大长度
+		Pattern   *Attribute       `apidoc:"pattern,attr,usage-constraint-pattern,omitempty"`       // 字符串需要匹配的正则表达式
+		MinItems  *NumberAttribute `apidoc:"min-items,attr,usage-constraint-min-items,omitempty"`   // 数组的最小长度
+		MaxItems  *NumberAttribute `apidoc:"max-items,attr,usage-constraint-max-items,omitempty"`   // 数组的最大长度
+
+		pattern *regexp.Regexp // Pattern 编译后的内容，由 sanitize 负责生成。
+	}
+
 	// Element 定义不包含子元素和属性的基本的 XML 元素
 	Element struct {
 		xmlenc.BaseTag
@@ -319,6 +442,20 @@ func (v *ExampleValue) EncodeXML() (string, error) {
 	return trimLeftSpace(v.Value.Value), nil
 }
 
+// LintDisabled 返回通过 <!-- lint-disable --> 注释禁用的 lint 规则
+//
+// apidoc 中禁用的规则作用于整个文档，包含 LintDisableAll 时表示禁用所有规则。
+func (doc *APIDoc) LintDisabled() []string {
+	return doc.lintDisabled
+}
+
+// LintDisabled 返回通过 <!-- lint-disable --> 注释禁用的 lint 规则
+//
+// 仅作用于当前 API，包含 LintDisableAll 时表示禁用所有规则。
+func (api *API) LintDisabled() []string {
+	return api.lintDisabled
+}
+
 // Param 转换成 Param 对象
 //
 // Request 可以说是 Param 的超级，两者在大部分情况下能用。
@@ -333,11 +470,16 @@ func (r *Request) Param() *Param {
 		Type:        r.Type,
 		Deprecated:  r.Deprecated,
 		Optional:    &BoolAttribute{Value: Bool{Value: true}},
+		Nullable:    r.Nullable,
 		Array:       r.Array,
 		Items:       r.Items,
 		Summary:     r.Summary,
 		Enums:       r.Enums,
 		Description: r.Description,
+
+		OneOf:         r.OneOf,
+		AnyOf:         r.AnyOf,
+		Discriminator: r.Discriminator,
 	}
 }
 
@@ -360,3 +502,155 @@ func (tag *Tag) References() []*Reference {
 func (srv *Server) References() []*Reference {
 	return srv.references
 }
+
+// References impl Referencer
+func (s *Security) References() []*Reference {
+	return s.references
+}
+
+// Definition Definitioner.Definition
+func (s *SecurityValue) Definition() *Definition {
+	return s.definition
+}
+
+// Security 返回引用的身份验证方式
+//
+// 如果引用的内容不存在，则返回 nil。
+func (s *SecurityValue) Security() *Security {
+	if s == nil || s.definition == nil {
+		return nil
+	}
+	return s.definition.Target.(*Security)
+}
+
+// References impl Referencer
+func (t *TypeDef) References() []*Reference {
+	return t.references
+}
+
+// Param 转换成 Param 对象
+func (t *TypeDef) Param() *Param {
+	if t == nil {
+		return nil
+	}
+
+	return &Param{
+		Constraint:  t.Constraint,
+		Name:        t.Name,
+		Type:        t.Type,
+		Deprecated:  t.Deprecated,
+		Items:       t.Items,
+		Summary:     t.Summary,
+		Enums:       t.Enums,
+		Description: t.Description,
+
+		OneOf:         t.OneOf,
+		AnyOf:         t.AnyOf,
+		Discriminator: t.Discriminator,
+	}
+}
+
+// Resolve 展开对 TypeDef 的引用
+//
+// 如果 p.Type 引用了 TypeDef，则返回一个以 TypeDef 的类型、子元素和枚举值
+// 替换之后的新对象，未指定的约束条件也会从 TypeDef 中继承，其它字段（包括 Nullable）保持不变；
+// 否则直接返回 p 本身。
+// 无法解析的引用会被当作 TypeNone 处理。
+func (p *Param) Resolve() *Param {
+	if p == nil || p.Type.refName() == "" {
+		return p
+	}
+
+	pp := *p
+	for pp.Type.refName() != "" {
+		t := pp.Type.TypeDef()
+		if t == nil {
+			pp.Type = nil
+			break
+		}
+
+		pp.Type = t.Type
+		pp.Items = t.Items
+		pp.OneOf, pp.AnyOf, pp.Discriminator = t.OneOf, t.AnyOf, t.Discriminator
+		if len(pp.Enums) == 0 {
+			pp.Enums = t.Enums
+		}
+		pp.Constraint.inherit(&t.Constraint)
+	}
+	return &pp
+}
+
+// Branches 返回联合类型的各个分支
+//
+// 每个分支都会与 p 中的公共字段合并成一个新的对象，如果指定了 Discriminator，
+// 则公共字段中的该字段值会被限定为分支的名称。
+// 返回值与 OneOf 或 AnyOf 中的元素一一对应，如果 p 不是联合类型，则返回 nil。
+func (p *Param) Branches() []*Param {
+	branches := p.OneOf
+	if len(branches) == 0 {
+		branches = p.AnyOf
+	}
+	if len(branches) == 0 {
+		return nil
+	}
+
+	ret := make([]*Param, 0, len(branches))
+	for _, b := range branches {
+		pp := *p
+		pp.OneOf, pp.AnyOf, pp.Discriminator = nil, nil, nil
+
+		b = b.Resolve()
+		pp.Items = make([]*Param, 0, len(p.Items)+len(b.Items))
+		for _, item := range p.Items {
+			if p.Discriminator != nil && item.Name.V() == p.Discriminator.V() {
+				d := *item
+				d.Enums = []*Enum{{Value: b.Name, Summary: b.Summary}}
+				item = &d
+			}
+			pp.Items = append(pp.Items, item)
+		}
+		pp.Items = append(pp.Items, b.Items...)
+
+		ret = append(ret, &pp)
+	}
+	return ret
+}
+
+// Regexp 返回 Pattern 编译后的正则表达式
+//
+// 通过解析得到的对象在 sanitize 阶段已经编译，直接返回该值；
+// 否则每次调用都会重新编译，出错时 panic。未指定 Pattern 时返回 nil。
+func (c *Constraint) Regexp() *regexp.Regexp {
+	if c.Pattern == nil {
+		return nil
+	}
+	if c.pattern != nil {
+		return c.pattern
+	}
+	return regexp.MustCompile(c.Pattern.V())
+}
+
+// 将 c 中未指定的约束条件以 parent 中的值填充
+func (c *Constraint) inherit(parent *Constraint) {
+	if c.Min == nil {
+		c.Min = parent.Min
+	}
+	if c.Max == nil {
+		c.Max = parent.Max
+	}
+	if c.MinLength == nil {
+		c.MinLength = parent.MinLength
+	}
+	if c.MaxLength == nil {
+		c.MaxLength = parent.MaxLength
+	}
+	if c.Pattern == nil {
+		c.Pattern, c.pattern = parent.Pattern, parent.pattern
+	}
+	if c.MinItems == nil {
+		c.MinItems = parent.MinItems
+	}
+	if c.MaxItems == nil {
+		c.MaxItems = parent.MaxItems
+	}
+}
@@ -420,6 +420,123 @@ func TestRequest_Param(t *testing.T) {
 	a.Equal(req.Type, param.Type)
 }
 
+func TestParam_Resolve(t *testing.T) {
+	a := assert.New(t)
+
+	var p *Param
+	a.Nil(p.Resolve())
+
+	p = &Param{Type: &TypeAttribute{Value: xmlenc.String{Value: TypeString}}}
+	a.Equal(p.Resolve(), p)
+
+	enum := &Enum{Value: &Attribute{Value: xmlenc.String{Value: "1"}}}
+	def := &TypeDef{
+		Constraint: Constraint{
+			Min: &NumberAttribute{Value: Number{Int: 1}},
+			Max: &NumberAttribute{Value: Number{Int: 10}},
+		},
+		Type:  &TypeAttribute{Value: xmlenc.String{Value: TypeNumber}},
+		Enums: []*Enum{enum},
+	}
+	p = &Param{
+		Constraint: Constraint{Max: &NumberAttribute{Value: Number{Int: 5}}},
+		Name:       &Attribute{Value: xmlenc.String{Value: "p1"}},
+		Type: &TypeAttribute{
+			Value:      xmlenc.String{Value: "#def"},
+			definition: &Definition{Target: def},
+		},
+	}
+	pp := p.Resolve()
+	a.NotEqual(pp, p).
+		Equal(pp.Name.V(), "p1").
+		Equal(pp.Type.V(), TypeNumber).
+		Equal(pp.Enums, []*Enum{enum}).
+		Equal(pp.Min.V(), 1.0).
+		Equal(pp.Max.V(), 5.0).
+		Equal(p.Type.V(), "#def").
+		Nil(p.Min).
+		False(pp.Nullable.V())
+
+	// nullable 由引用方决定
+	p.Nullable = &BoolAttribute{Value: Bool{Value: true}}
+	a.True(p.Resolve().Nullable.V())
+
+	// 未解析的引用
+	p = &Param{Type: &TypeAttribute{Value: xmlenc.String{Value: "#def"}}}
+	a.Equal(p.Resolve().Type.V(), TypeNone)
+}
+
+func TestConstraint_Regexp(t *testing.T) {
+	a := assert.New(t)
+
+	c := &Constraint{}
+	a.Nil(c.Regexp())
+
+	// 未经 sanitize，每次都重新编译。
+	c.Pattern = &Attribute{Value: xmlenc.String{Value: "^[a-z]+$"}}
+	a.NotNil(c.Regexp()).
+		True(c.Regexp().MatchString("abc")).
+		True(c.Regexp() != c.Regexp())
+
+	typ := &TypeAttribute{Value: xmlenc.String{Value: TypeString}}
+	a.NotError(checkConstraint(typ, false, c))
+	expr := c.Regexp()
+	a.NotNil(expr).True(expr == c.Regexp())
+
+	// 继承时同时继承编译后的内容
+	child := &Constraint{}
+	child.inherit(c)
+	a.True(child.Regexp() == expr)
+}
+
+func TestParam_Branches(t *testing.T) {
+	a := assert.New(t)
+
+	str := func(v string) xmlenc.String { return xmlenc.String{Value: v} }
+
+	p := &Param{Type: &TypeAttribute{Value: str(TypeObject)}}
+	a.Nil(p.Branches())
+
+	kind := &Param{
+		Name: &Attribute{Value: str("kind")},
+		Type: &TypeAttribute{Value: str(TypeString)},
+	}
+	dog := &TypeDef{
+		Type:  &TypeAttribute{Value: str(TypeObject)},
+		Items: []*Param{{Name: &Attribute{Value: str("bark")}, Type: &TypeAttribute{Value: str(TypeBool)}}},
+	}
+	p = &Param{
+		Type:          &TypeAttribute{Value: str(TypeObject)},
+		Items:         []*Param{kind},
+		Discriminator: &Attribute{Value: str("kind")},
+		OneOf: []*Param{
+			{
+				Name: &Attribute{Value: str("dog")},
+				Type: &TypeAttribute{Value: str("#dog"), definition: &Definition{Target: dog}},
+			},
+			{
+				Name:  &Attribute{Value: str("cat")},
+				Type:  &TypeAttribute{Value: str(TypeObject)},
+				Items: []*Param{{Name: &Attribute{Value: str("meow")}, Type: &TypeAttribute{Value: str(TypeBool)}}},
+			},
+		},
+	}
+	branches := p.Branches()
+	a.Equal(2, len(branches))
+
+	b := branches[0]
+	a.Nil(b.OneOf).Nil(b.Discriminator).
+		Equal(2, len(b.Items)).
+		Equal(b.Items[1].Name.V(), "bark").
+		Equal(b.Items[0].Enums[0].Value.V(), "dog").
+		Empty(kind.Enums) // 不会修改原始对象
+
+	b = branches[1]
+	a.Equal(2, len(b.Items)).
+		Equal(b.Items[1].Name.V(), "meow").
+		Equal(b.Items[0].Enums[0].Value.V(), "cat")
+}
+
 func TestAPIDoc_XMLNamespaces(t *testing.T) {
 	a := assert.New(t)
 
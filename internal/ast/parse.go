@@ -7,6 +7,8 @@ import (
 	"errors"
 	"io"
 	"sort"
+	"strings"
+	"unicode"
 
 	"github.com/caixw/apidoc/v7/core"
 	"github.com/caixw/apidoc/v7/internal/locale"
@@ -50,12 +52,13 @@ func (doc *APIDoc) Parse(h *core.MessageHandler, b core.Block) {
 			doc.APIs = make([]*API, 0, 100)
 		}
 
-		api := &API{doc: doc}
+		api := &API{doc: doc, lintDisabled: lintDisabled(p)}
 		xmlenc.Decode(p, api, core.XMLNamespace)
 		doc.APIs = append(doc.APIs, api)
 
 		if doc.Title.V() != "" { // apidoc 已经初始化，检测依赖于 apidoc 的字段
 			api.sanitizeTags(p)
+			api.sanitizeTypes(p)
 		}
 	case "apidoc":
 		if doc.Title != nil { // 多个 apidoc 标签
@@ -64,6 +67,7 @@ func (doc *APIDoc) Parse(h *core.MessageHandler, b core.Block) {
 			h.Error(err)
 			return
 		}
+		doc.lintDisabled = lintDisabled(p)
 		xmlenc.Decode(p, doc, core.XMLNamespace)
 	default:
 		return
@@ -106,6 +110,41 @@ func getTagName(p *xmlenc.Parser) string {
 	}
 }
 
+// 获取代码块中通过 <!-- lint-disable rule1 rule2 --> 注释禁用的 lint 规则
+//
+// 未指定规则名称的表示禁用所有规则，以 * 表示。
+func lintDisabled(p *xmlenc.Parser) []string {
+	start := p.Current()
+	defer p.Move(start)
+
+	var rules []string
+	for {
+		t, _, err := p.Token()
+		if err != nil { // 包括 io.EOF，语法错误由之后的 Decode 处理。
+			return rules
+		}
+
+		c, ok := t.(*xmlenc.Comment)
+		if !ok {
+			continue
+		}
+		v := strings.TrimSpace(c.Value.Value)
+		if !strings.HasPrefix(v, lintDisablePrefix) {
+			continue
+		}
+		v = v[len(lintDisablePrefix):]
+		if v != "" && !unicode.IsSpace(rune(v[0])) {
+			continue
+		}
+
+		names := strings.FieldsFunc(v, func(r rune) bool { return r == ',' || unicode.IsSpace(r) })
+		if len(names) == 0 {
+			names = []string{LintDisableAll}
+		}
+		rules = append(rules, names...)
+	}
+}
+
 func (doc *APIDoc) sortAPIs() {
 	sort.SliceStable(doc.APIs, func(i, j int) bool {
 		ii := doc.APIs[i]
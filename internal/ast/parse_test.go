@@ -88,6 +88,45 @@ func TestAPIDoc_Parse(t *testing.T) {
 		Equal(1, len(d.APIs))
 }
 
+func TestAPIDoc_Parse_routes(t *testing.T) {
+	a := assert.New(t)
+
+	rslt := messagetest.NewMessageHandler()
+	d := &APIDoc{}
+	d.Parse(rslt.Handler, core.Block{
+		Location: core.Location{URI: "file:///doc.xml"},
+		Data:     []byte(`<apidoc version="1.0.0"><title>title</title><mimetype>application/json</mimetype></apidoc>`),
+	})
+	d.Parse(rslt.Handler, core.Block{
+		Location: core.Location{URI: "file:///users.go"},
+		Data:     []byte(`<api method="GET"><path path="/users/{id}"><param name="id" type="number" summary="id" /></path><response status="200" /></api>`),
+	})
+	d.Parse(rslt.Handler, core.Block{
+		Location: core.Location{URI: "file:///me.go"},
+		Data:     []byte(`<api method="GET"><path path="/users/me" /><response status="200" /></api>`),
+	})
+	d.Parse(rslt.Handler, core.Block{
+		Location: core.Location{URI: "file:///user.go"},
+		Data:     []byte(`<api method="GET"><path path="/users/{uid}"><param name="uid" type="number" summary="id" /></path><response status="200" /></api>`),
+	})
+	rslt.Handler.Stop()
+
+	a.Equal(1, len(rslt.Errors))
+	err, ok := rslt.Errors[0].(*core.Error)
+	a.True(ok).
+		Equal(err.Location.URI, "file:///user.go").
+		Equal(1, len(err.Related)).
+		Equal(err.Related[0].Location.URI, "file:///users.go")
+
+	// 后解析的两个 api 都会输出歧义的警告
+	a.Equal(2, len(rslt.Warns))
+	err, ok = rslt.Warns[1].(*core.Error)
+	a.True(ok).
+		Equal(err.Location.URI, "file:///user.go").
+		Equal(1, len(err.Related)).
+		Equal(err.Related[0].Location.URI, "file:///me.go")
+}
+
 func TestGetTagName(t *testing.T) {
 	a := assert.New(t)
 
@@ -137,6 +176,39 @@ func TestGetTagName(t *testing.T) {
 	a.Empty(rslt.Errors) // io.EOF 不输出错误
 }
 
+func TestLintDisabled(t *testing.T) {
+	a := assert.New(t)
+
+	p, rslt := newParser(a, `<!-- lint-disable api-id -->
+<api method="GET">
+	<!-- lint-disable api-summary,  path-kebab-case -->
+	<!-- lint-disabled api-tag -->
+	<!-- 普通注释 -->
+	<path path="/users" />
+</api>`, "")
+	a.Equal(lintDisabled(p), []string{"api-id", "api-summary", "path-kebab-case"})
+	a.Equal(getTagName(p), "api") // 不改变 p 的位置
+	rslt.Handler.Stop()
+	a.Empty(rslt.Errors)
+
+	p, rslt = newParser(a, `<api method="GET"><!--lint-disable--><path path="/users" /></api>`, "")
+	a.Equal(lintDisabled(p), []string{LintDisableAll})
+	rslt.Handler.Stop()
+
+	p, rslt = newParser(a, `<api method="GET"><path path="/users" /></api>`, "")
+	a.Nil(lintDisabled(p))
+	rslt.Handler.Stop()
+
+	rslt = messagetest.NewMessageHandler()
+	doc := &APIDoc{}
+	doc.Parse(rslt.Handler, core.Block{Data: []byte(`<apidoc version="1.0.0"><!-- lint-disable tag-unused --><title>t</title><mimetype>application/json</mimetype></apidoc>`)})
+	doc.Parse(rslt.Handler, core.Block{Data: []byte(`<api method="GET"><!-- lint-disable --><path path="/users" /><response status="200" /></api>`)})
+	rslt.Handler.Stop()
+	a.Empty(rslt.Errors)
+	a.Equal(doc.LintDisabled(), []string{"tag-unused"}).
+		Equal(doc.APIs[0].LintDisabled(), []string{LintDisableAll})
+}
+
 func TestAPIDoc_sortAPIs(t *testing.T) {
 	a := assert.New(t)
 
@@ -3,7 +3,9 @@
 package ast
 
 import (
+	"regexp"
 	"strconv"
+	"strings"
 
 	"github.com/issue9/is"
 	"github.com/issue9/sliceutil"
@@ -16,10 +18,15 @@ import (
 // Sanitize token.Sanitizer
 func (api *API) Sanitize(p *xmlenc.Parser) {
 	for _, header := range api.Headers { // 报头不能为 object
-		if header.Type.V() == TypeObject {
+		if isObjectType(header.Type.V()) {
 			p.Error(header.Type.Location.NewError(locale.ErrInvalidValue).WithField("header"))
 		}
 	}
+	for _, cookie := range api.Cookies { // cookie 不能为 object
+		if isObjectType(cookie.Type.V()) {
+			p.Error(cookie.Type.Location.NewError(locale.ErrInvalidValue).WithField("cookie"))
+		}
+	}
 
 	// 对 Servers 和 Tags 查重
 	indexes := sliceutil.Dup(api.Servers, func(i, j int) bool { return api.Servers[i].V() == api.Servers[j].V() })
@@ -38,6 +45,14 @@ func (api *API) Sanitize(p *xmlenc.Parser) {
 		}
 		p.Error(err)
 	}
+	indexes = sliceutil.Dup(api.Securities, func(i, j int) bool { return api.Securities[i].Name.V() == api.Securities[j].Name.V() })
+	if len(indexes) > 0 {
+		err := api.Securities[indexes[0]].Location.NewError(locale.ErrDuplicateValue).WithField("security")
+		for _, i := range indexes[1:] {
+			err.Relate(api.Securities[i].Location, locale.Sprintf(locale.ErrDuplicateValue))
+		}
+		p.Error(err)
+	}
 }
 
 // Sanitize token.Sanitizer
@@ -68,12 +83,12 @@ func (p *Path) Sanitize(pp *xmlenc.Parser) {
 
 	// 路径参数和查询参数不能为 object
 	for _, item := range p.Params {
-		if item.Type.V() == TypeObject {
+		if isObjectType(item.Type.V()) {
 			pp.Error(item.Location.NewError(locale.ErrInvalidValue).WithField("type"))
 		}
 	}
 	for _, q := range p.Queries {
-		if q.Type.V() == TypeObject {
+		if isObjectType(q.Type.V()) {
 			pp.Error(q.Location.NewError(locale.ErrInvalidValue).WithField("type"))
 		}
 	}
@@ -112,12 +127,15 @@ func parsePath(path string) (params map[string]struct{}, err error) {
 
 // Sanitize token.Sanitizer
 func (r *Request) Sanitize(p *xmlenc.Parser) {
-	if r.Type.V() == TypeObject && len(r.Items) == 0 {
+	if r.Type.V() == TypeObject && len(r.Items) == 0 && len(r.OneOf) == 0 && len(r.AnyOf) == 0 {
 		p.Error(r.Location.NewError(locale.ErrIsEmpty, "param").WithField("param"))
 	}
-	if r.Type.V() == TypeNone && len(r.Items) > 0 {
+	if (r.Type.V() == TypeNone || r.Type.refName() != "") && len(r.Items) > 0 {
 		p.Error(r.Location.NewError(locale.ErrInvalidValue).WithField("type"))
 	}
+	if r.Nullable.V() && r.Type.V() == TypeNone {
+		p.Error(r.Nullable.Location.NewError(locale.ErrInvalidValue).WithField(r.Nullable.AttributeName.String()))
+	}
 
 	checkDuplicateEnum(r.Enums, p)
 
@@ -137,14 +155,25 @@ func (r *Request) Sanitize(p *xmlenc.Parser) {
 		}
 	}
 
-	// 报头不能为 object
+	// 报头和 cookie 不能为 object
 	for _, header := range r.Headers {
-		if header.Type.V() == TypeObject {
+		if isObjectType(header.Type.V()) {
 			p.Error(header.Type.Location.NewError(locale.ErrInvalidValue).WithField("type"))
 		}
 	}
+	for _, cookie := range r.Cookies {
+		if isObjectType(cookie.Type.V()) {
+			p.Error(cookie.Type.Location.NewError(locale.ErrInvalidValue).WithField("type"))
+		}
+	}
 
 	checkDuplicateItems(r.Items, p)
+
+	if err := checkMapItems(r.Location, r.Type, r.Items); err != nil {
+		p.Error(err)
+	}
+
+	checkUnion(r.Location, r.Type, r.Items, r.OneOf, r.AnyOf, r.Discriminator, p)
 }
 
 // Sanitize token.Sanitizer
@@ -152,13 +181,16 @@ func (p *Param) Sanitize(pp *xmlenc.Parser) {
 	if p.Type.V() == TypeNone {
 		pp.Error(p.Location.NewError(locale.ErrIsEmpty, "type").WithField("type"))
 	}
-	if p.Type.V() == TypeObject && len(p.Items) == 0 {
+	if p.Type.V() == TypeObject && len(p.Items) == 0 && len(p.OneOf) == 0 && len(p.AnyOf) == 0 {
 		pp.Error(p.Location.NewError(locale.ErrIsEmpty, "param").WithField("param"))
 	}
 
-	if p.Type.V() != TypeObject && len(p.Items) > 0 {
+	if !isObjectType(p.Type.V()) && len(p.Items) > 0 {
 		pp.Error(p.Type.Value.Location.NewError(locale.ErrInvalidValue).WithField("type"))
 	}
+	if err := checkMapItems(p.Location, p.Type, p.Items); err != nil {
+		pp.Error(err)
+	}
 
 	checkDuplicateEnum(p.Enums, pp)
 
@@ -172,11 +204,122 @@ func (p *Param) Sanitize(pp *xmlenc.Parser) {
 		pp.Error(err)
 	}
 
+	if err := checkConstraint(p.Type, p.Array.V(), &p.Constraint); err != nil {
+		pp.Error(err)
+	}
+
+	checkUnion(p.Location, p.Type, p.Items, p.OneOf, p.AnyOf, p.Discriminator, pp)
+
 	if p.Summary.V() == "" && p.Description.V() == "" {
 		pp.Error(p.Location.NewError(locale.ErrIsEmpty, "summary").WithField("summary"))
 	}
 }
 
+// Sanitize token.Sanitizer
+func (t *TypeDef) Sanitize(p *xmlenc.Parser) {
+	if t.Type.V() == TypeNone {
+		p.Error(t.Location.NewError(locale.ErrIsEmpty, "type").WithField("type"))
+	}
+	if t.Type.V() == TypeObject && len(t.Items) == 0 && len(t.OneOf) == 0 && len(t.AnyOf) == 0 {
+		p.Error(t.Location.NewError(locale.ErrIsEmpty, "param").WithField("param"))
+	}
+	if !isObjectType(t.Type.V()) && len(t.Items) > 0 {
+		p.Error(t.Type.Value.Location.NewError(locale.ErrInvalidValue).WithField("type"))
+	}
+	if err := checkMapItems(t.Location, t.Type, t.Items); err != nil {
+		p.Error(err)
+	}
+
+	checkDuplicateEnum(t.Enums, p)
+
+	if err := chkEnumsType(t.Type, t.Enums, p); err != nil {
+		p.Error(err)
+	}
+
+	checkDuplicateItems(t.Items, p)
+
+	if err := checkConstraint(t.Type, false, &t.Constraint); err != nil {
+		p.Error(err)
+	}
+
+	checkUnion(t.Location, t.Type, t.Items, t.OneOf, t.AnyOf, t.Discriminator, p)
+}
+
+// Sanitize token.Sanitizer
+func (s *Security) Sanitize(p *xmlenc.Parser) {
+	switch s.Type.V() {
+	case SecurityTypeAPIKey:
+		switch s.In.V() {
+		case SecurityInHeader, SecurityInQuery, SecurityInCookie:
+		case "":
+			p.Error(s.Location.NewError(locale.ErrIsEmpty, "in").WithField("in"))
+		default:
+			p.Error(s.In.Location.NewError(locale.ErrInvalidValue).WithField("in"))
+		}
+
+		if s.Key.V() == "" {
+			p.Error(s.Location.NewError(locale.ErrIsEmpty, "key").WithField("key"))
+		}
+	case SecurityTypeHTTP:
+		if s.Scheme.V() == "" {
+			p.Error(s.Location.NewError(locale.ErrIsEmpty, "scheme").WithField("scheme"))
+		}
+		if s.BearerFormat.V() != "" && !strings.EqualFold(s.Scheme.V(), "bearer") {
+			p.Error(s.BearerFormat.Location.NewError(locale.ErrInvalidValue).WithField("bearer-format"))
+		}
+	case SecurityTypeOAuth2:
+		if len(s.Flows) == 0 {
+			p.Error(s.Location.NewError(locale.ErrIsEmpty, "flow").WithField("flow"))
+		}
+
+		indexes := sliceutil.Dup(s.Flows, func(i, j int) bool { return s.Flows[i].Type.V() == s.Flows[j].Type.V() })
+		if len(indexes) > 0 {
+			err := s.Flows[indexes[0]].Location.NewError(locale.ErrDuplicateValue).WithField("flow")
+			for _, i := range indexes[1:] {
+				err.Relate(s.Flows[i].Location, locale.Sprintf(locale.ErrDuplicateValue))
+			}
+			p.Error(err)
+		}
+	case SecurityTypeOpenIDConnect:
+		if s.OpenIDConnectURL.V() == "" {
+			p.Error(s.Location.NewError(locale.ErrIsEmpty, "openid-connect-url").WithField("openid-connect-url"))
+		}
+	default:
+		p.Error(s.Type.Location.NewError(locale.ErrInvalidValue).WithField("type"))
+	}
+}
+
+// Sanitize token.Sanitizer
+func (f *OAuthFlow) Sanitize(p *xmlenc.Parser) {
+	var auth, token bool // 是否需要 authorization-url 和 token-url
+	switch f.Type.V() {
+	case OAuthFlowImplicit:
+		auth = true
+	case OAuthFlowPassword, OAuthFlowClientCredentials:
+		token = true
+	case OAuthFlowAuthorizationCode:
+		auth, token = true, true
+	default:
+		p.Error(f.Type.Location.NewError(locale.ErrInvalidValue).WithField("type"))
+	}
+
+	if auth && f.AuthorizationURL.V() == "" {
+		p.Error(f.Location.NewError(locale.ErrIsEmpty, "authorization-url").WithField("authorization-url"))
+	}
+	if token && f.TokenURL.V() == "" {
+		p.Error(f.Location.NewError(locale.ErrIsEmpty, "token-url").WithField("token-url"))
+	}
+
+	indexes := sliceutil.Dup(f.Scopes, func(i, j int) bool { return f.Scopes[i].Name.V() == f.Scopes[j].Name.V() })
+	if len(indexes) > 0 {
+		err := f.Scopes[indexes[0]].Location.NewError(locale.ErrDuplicateValue).WithField("scope")
+		for _, i := range indexes[1:] {
+			err.Relate(f.Scopes[i].Location, locale.Sprintf(locale.ErrDuplicateValue))
+		}
+		p.Error(err)
+	}
+}
+
 // 检测 enums 中的类型是否符合 t 的标准，比如 Number 要求枚举值也都是数值
 func chkEnumsType(t *TypeAttribute, enums []*Enum, p *xmlenc.Parser) error {
 	if len(enums) == 0 {
@@ -196,7 +339,7 @@ func chkEnumsType(t *TypeAttribute, enums []*Enum, p *xmlenc.Parser) error {
 				return enum.Location.NewError(locale.ErrInvalidFormat).WithField(enum.StartTag.String())
 			}
 		}
-	case TypeObject, TypeNone:
+	case TypeObject, TypeMap, TypeNone:
 		return t.Location.NewError(locale.ErrInvalidValue).WithField(t.AttributeName.String())
 	}
 
@@ -225,6 +368,27 @@ func checkDuplicateItems(items []*Param, p *xmlenc.Parser) {
 	}
 }
 
+// object 和 map 都表示由键值对组成的对象
+func isObjectType(t string) bool {
+	return t == TypeObject || t == TypeMap
+}
+
+// map 有且只能有一个子元素，用于描述值的类型
+func checkMapItems(loc core.Location, t *TypeAttribute, items []*Param) error {
+	if t.V() != TypeMap {
+		return nil
+	}
+
+	switch len(items) {
+	case 0:
+		return loc.NewError(locale.ErrIsEmpty, "param").WithField("param")
+	case 1:
+		return nil
+	default:
+		return items[1].Location.NewError(locale.ErrInvalidValue).WithField("param")
+	}
+}
+
 func checkXML(isArray, hasItems bool, xml *XML, p *xmlenc.Parser) error {
 	if xml.XMLAttr.V() {
 		if isArray || hasItems {
@@ -257,12 +421,150 @@ func checkXML(isArray, hasItems bool, xml *XML, p *xmlenc.Parser) error {
 	return nil
 }
 
+// 检测联合类型的设置是否合法
+//
+// loc 为联合类型所在元素的位置，在 t 为空时用于定位错误信息。
+func checkUnion(loc core.Location, t *TypeAttribute, items, oneOf, anyOf []*Param, discriminator *Attribute, p *xmlenc.Parser) {
+	if len(oneOf) > 0 && len(anyOf) > 0 {
+		p.Error(anyOf[0].Location.NewError(locale.ErrInvalidValue).WithField("any-of"))
+	}
+
+	branches := oneOf
+	if len(branches) == 0 {
+		branches = anyOf
+	}
+
+	if discriminator != nil {
+		if len(oneOf) == 0 {
+			p.Error(discriminator.Location.NewError(locale.ErrInvalidValue).WithField(discriminator.AttributeName.String()))
+		} else if err := checkDiscriminator(discriminator, items); err != nil {
+			p.Error(err)
+		}
+	}
+
+	if len(branches) == 0 {
+		return
+	}
+
+	if t.V() != TypeObject {
+		if t == nil {
+			p.Error(loc.NewError(locale.ErrIsEmpty, "type").WithField("type"))
+		} else {
+			p.Error(t.Location.NewError(locale.ErrInvalidValue).WithField(t.AttributeName.String()))
+		}
+	}
+
+	// 分支只能是对象或是对对象的引用
+	for _, b := range branches {
+		if b.Array.V() {
+			p.Error(b.Array.Location.NewError(locale.ErrInvalidValue).WithField(b.Array.AttributeName.String()))
+		}
+		if b.Type.V() != TypeObject && b.Type.refName() == "" {
+			p.Error(b.Type.Location.NewError(locale.ErrInvalidValue).WithField(b.Type.AttributeName.String()))
+		}
+	}
+
+	checkDuplicateItems(branches, p)
+}
+
+// discriminator 必须指向 items 中的一个字符串类型的字段
+func checkDiscriminator(discriminator *Attribute, items []*Param) error {
+	field := discriminator.AttributeName.String()
+	for _, item := range items {
+		if item.Name.V() != discriminator.V() {
+			continue
+		}
+
+		if primitive, _ := ParseType(item.Type.V()); primitive != TypeString || item.Array.V() {
+			return discriminator.Location.NewError(locale.ErrInvalidValue).WithField(field)
+		}
+		return nil
+	}
+	return discriminator.Location.NewError(locale.ErrNotFound).WithField(field)
+}
+
+// 检测约束条件是否与类型相符以及各值是否合法
+//
+// 引用类型在此时还无法确定其实际类型，所以不检测其与类型是否相符。
+func checkConstraint(t *TypeAttribute, isArray bool, c *Constraint) error {
+	primitive, _ := ParseType(t.V())
+	isRef := t.refName() != ""
+
+	if err := checkConstraintRange(c.Min, c.Max, isRef || primitive == TypeNumber); err != nil {
+		return err
+	}
+
+	isString := isRef || primitive == TypeString
+	if err := checkConstraintRange(c.MinLength, c.MaxLength, isString); err != nil {
+		return err
+	}
+	if err := checkConstraintSize(c.MinLength, c.MaxLength); err != nil {
+		return err
+	}
+
+	if c.Pattern != nil {
+		if !isString {
+			return c.Pattern.Location.NewError(locale.ErrInvalidValue).WithField(c.Pattern.AttributeName.String())
+		}
+		expr, err := regexp.Compile(c.Pattern.V())
+		if err != nil {
+			return c.Pattern.Location.WithError(err).WithField(c.Pattern.AttributeName.String())
+		}
+		c.pattern = expr
+	}
+
+	if err := checkConstraintRange(c.MinItems, c.MaxItems, isArray); err != nil {
+		return err
+	}
+	return checkConstraintSize(c.MinItems, c.MaxItems)
+}
+
+// allowed 表示当前类型是否允许指定 min 和 max
+func checkConstraintRange(min, max *NumberAttribute, allowed bool) error {
+	if !allowed {
+		if min != nil {
+			return min.Location.NewError(locale.ErrInvalidValue).WithField(min.AttributeName.String())
+		}
+		if max != nil {
+			return max.Location.NewError(locale.ErrInvalidValue).WithField(max.AttributeName.String())
+		}
+		return nil
+	}
+
+	if min != nil && max != nil && min.V() > max.V() {
+		return max.Location.NewError(locale.ErrInvalidValue).WithField(max.AttributeName.String())
+	}
+	return nil
+}
+
+// 长度类的约束必须为非负整数
+func checkConstraintSize(size ...*NumberAttribute) error {
+	for _, num := range size {
+		if num != nil && (num.IsFloat() || num.IntValue() < 0) {
+			return num.Location.NewError(locale.ErrInvalidValue).WithField(num.AttributeName.String())
+		}
+	}
+	return nil
+}
+
 // Sanitize 检测内容是否合法
 func (doc *APIDoc) Sanitize(p *xmlenc.Parser) {
 	if err := doc.checkXMLNamespaces(p); err != nil {
 		p.Error(err)
 	}
 	doc.URI = p.Location.URI
+	doc.sanitizeTypes(p)
+
+	indexes := sliceutil.Dup(doc.Securities, func(i, j int) bool {
+		return doc.Securities[i].Name.V() == doc.Securities[j].Name.V()
+	})
+	if len(indexes) > 0 {
+		err := doc.Securities[indexes[0]].Location.NewError(locale.ErrDuplicateValue).WithField("security")
+		for _, i := range indexes[1:] {
+			err.Relate(doc.Securities[i].Location, locale.Sprintf(locale.ErrDuplicateValue))
+		}
+		p.Error(err)
+	}
 
 	for _, api := range doc.APIs {
 		if api.doc == nil {
@@ -270,6 +572,7 @@ func (doc *APIDoc) Sanitize(p *xmlenc.Parser) {
 			api.URI = doc.URI
 		}
 		api.sanitizeTags(p)
+		api.sanitizeTypes(p)
 	}
 }
 
@@ -321,6 +624,15 @@ func (doc *APIDoc) findTag(tag string) *Tag {
 	return nil
 }
 
+func (doc *APIDoc) findSecurity(name string) *Security {
+	for _, s := range doc.Securities {
+		if s.Name.V() == name {
+			return s
+		}
+	}
+	return nil
+}
+
 func (doc *APIDoc) findServer(srv string) *Server {
 	for _, s := range doc.Servers {
 		if s.Name.V() == srv {
@@ -330,6 +642,163 @@ func (doc *APIDoc) findServer(srv string) *Server {
 	return nil
 }
 
+func (doc *APIDoc) findType(name string) *TypeDef {
+	for _, t := range doc.Types {
+		if t.Name.V() == name {
+			return t
+		}
+	}
+	return nil
+}
+
+// 检测 TypeDef 的合法性，并关联文档级别的元素对 TypeDef 的引用。
+func (doc *APIDoc) sanitizeTypes(p *xmlenc.Parser) {
+	indexes := sliceutil.Dup(doc.Types, func(i, j int) bool { return doc.Types[i].Name.V() == doc.Types[j].Name.V() })
+	if len(indexes) > 0 {
+		err := doc.Types[indexes[0]].Location.NewError(locale.ErrDuplicateValue).WithField("type")
+		for _, i := range indexes[1:] {
+			err.Relate(doc.Types[i].Location, locale.Sprintf(locale.ErrDuplicateValue))
+		}
+		p.Error(err)
+	}
+
+	for _, t := range doc.Types {
+		doc.resolveType(t.Type, p)
+		doc.resolveParams(t.Items, p)
+		doc.resolveParams(t.OneOf, p)
+		doc.resolveParams(t.AnyOf, p)
+	}
+	doc.checkTypeCycles(p)
+
+	doc.resolveParams(doc.Headers, p)
+	doc.resolveRequests(doc.Responses, p)
+}
+
+// 检测 TypeDef 之间是否存在循环引用
+//
+// 存在循环引用的 TypeAttribute 依然保留 definition，以便 LSP 跳转，
+// 但是 TypeAttribute.TypeDef 不再返回其引用的对象。
+func (doc *APIDoc) checkTypeCycles(p *xmlenc.Parser) {
+	const (
+		visiting = iota + 1
+		visited
+	)
+
+	states := make(map[*TypeDef]int, len(doc.Types))
+	var visit func(*TypeDef)
+	visit = func(t *TypeDef) {
+		states[t] = visiting
+		for _, ref := range t.refs() {
+			target := ref.TypeDef()
+			switch states[target] {
+			case 0:
+				visit(target)
+			case visiting:
+				ref.cyclic = true
+				p.Error(ref.Location.NewError(locale.ErrCyclicReference).
+					WithField(ref.AttributeName.String()).
+					Relate(target.Location, locale.Sprintf(locale.ErrCyclicReference)))
+			}
+		}
+		states[t] = visited
+	}
+
+	for _, t := range doc.Types {
+		if states[t] == 0 {
+			visit(t)
+		}
+	}
+}
+
+// 返回当前 TypeDef 中所有引用了其它 TypeDef 的属性
+func (t *TypeDef) refs() []*TypeAttribute {
+	refs := make([]*TypeAttribute, 0, 5)
+
+	var walk func(*TypeAttribute, ...[]*Param)
+	walk = func(typ *TypeAttribute, params ...[]*Param) {
+		if typ.TypeDef() != nil {
+			refs = append(refs, typ)
+		}
+		for _, items := range params {
+			for _, item := range items {
+				walk(item.Type, item.Items, item.OneOf, item.AnyOf)
+			}
+		}
+	}
+	walk(t.Type, t.Items, t.OneOf, t.AnyOf)
+
+	return refs
+}
+
+func (doc *APIDoc) resolveType(t *TypeAttribute, p *xmlenc.Parser) {
+	name := t.refName()
+	if name == "" {
+		return
+	}
+
+	def := doc.findType(name)
+	if def == nil {
+		p.Error(t.Value.Location.NewError(locale.ErrNotFound).WithField(t.AttributeName.String()))
+		return
+	}
+
+	t.definition = &Definition{
+		Location: def.Location,
+		Target:   def,
+	}
+	def.references = append(def.references, &Reference{
+		Location: t.Location,
+		Target:   t,
+	})
+}
+
+func (doc *APIDoc) resolveParams(params []*Param, p *xmlenc.Parser) {
+	for _, param := range params {
+		doc.resolveType(param.Type, p)
+		doc.resolveParams(param.Items, p)
+		doc.resolveParams(param.OneOf, p)
+		doc.resolveParams(param.AnyOf, p)
+	}
+}
+
+func (doc *APIDoc) resolveRequests(requests []*Request, p *xmlenc.Parser) {
+	for _, r := range requests {
+		doc.resolveType(r.Type, p)
+		doc.resolveParams(r.Items, p)
+		doc.resolveParams(r.OneOf, p)
+		doc.resolveParams(r.AnyOf, p)
+		doc.resolveParams(r.Headers, p)
+		doc.resolveParams(r.Cookies, p)
+	}
+}
+
+// 关联 api 中对 TypeDef 的引用
+func (api *API) sanitizeTypes(p *xmlenc.Parser) {
+	if api.doc == nil {
+		panic("api.doc 未获取正确的值")
+	}
+	doc := api.doc
+
+	if api.Path != nil {
+		doc.resolveParams(api.Path.Params, p)
+		doc.resolveParams(api.Path.Queries, p)
+	}
+	doc.resolveRequests(api.Requests, p)
+	doc.resolveRequests(api.Responses, p)
+	doc.resolveParams(api.Headers, p)
+	doc.resolveParams(api.Cookies, p)
+
+	if c := api.Callback; c != nil {
+		if c.Path != nil {
+			doc.resolveParams(c.Path.Params, p)
+			doc.resolveParams(c.Path.Queries, p)
+		}
+		doc.resolveRequests(c.Requests, p)
+		doc.resolveRequests(c.Responses, p)
+		doc.resolveParams(c.Headers, p)
+	}
+}
+
 func (api *API) sanitizeTags(p *xmlenc.Parser) {
 	if api.doc == nil {
 		panic("api.doc 未获取正确的值")
@@ -374,50 +843,241 @@ func (api *API) sanitizeTags(p *xmlenc.Parser) {
 			Target:   srv,
 		})
 	}
+
+	for _, sec := range api.Securities {
+		s := api.doc.findSecurity(sec.Name.V())
+		if s == nil {
+			p.Error(sec.Name.Location.NewError(locale.ErrNotFound).WithField("name"))
+			continue
+		}
+
+		sec.checkScopes(s, p)
+
+		sec.definition = &Definition{
+			Location: s.Location,
+			Target:   s,
+		}
+		s.references = append(s.references, &Reference{
+			Location: sec.Location,
+			Target:   sec,
+		})
+	}
+}
+
+// 检测引用的 scope 是否在 s 中有定义
+func (sec *SecurityValue) checkScopes(s *Security, p *xmlenc.Parser) {
+	switch s.Type.V() {
+	case SecurityTypeOAuth2:
+	SCOPES:
+		for _, scope := range sec.Scopes {
+			for _, flow := range s.Flows {
+				if sliceutil.Count(flow.Scopes, func(i int) bool { return flow.Scopes[i].Name.V() == scope.V() }) > 0 {
+					continue SCOPES
+				}
+			}
+			p.Error(scope.Location.NewError(locale.ErrNotFound).WithField("scope"))
+		}
+	case SecurityTypeOpenIDConnect: // scope 由服务端的配置决定，无法检测
+	default:
+		if len(sec.Scopes) > 0 {
+			p.Error(sec.Scopes[0].Location.NewError(locale.ErrInvalidValue).WithField("scope"))
+		}
+	}
 }
 
-// 检测当前 api 是否与 apidoc.APIs 中存在相同的值
+// 检测当前 api 是否与 apidoc.APIs 中存在相同或是有歧义的路由
+//
+// 仅在请求方法相同，且拥有相同的 server 时才会比较路由，未指定 server 的 api 之间也会比较。
+// 路由相同的以错误的形式输出，有歧义的以警告的形式输出，比如 /users/{id} 和 /users/me。
 func (api *API) checkDup(p *xmlenc.Parser) {
-	err := api.Location.NewError(locale.ErrDuplicateValue)
+	dup := api.Location.NewError(locale.ErrDuplicateValue)
+	ambiguous := api.Location.NewError(locale.ErrAmbiguousRoute)
 
 	for _, item := range api.doc.APIs {
-		if item == api {
+		if item == api || api.Method.V() != item.Method.V() || !api.sameServer(item) {
 			continue
 		}
 
-		if api.Method.V() != item.Method.V() {
-			continue
+		switch compareRoutes(api.path(), item.path()) {
+		case routeSame:
+			dup.Relate(item.Location, locale.Sprintf(locale.ErrDuplicateValue))
+		case routeAmbiguous:
+			ambiguous.Relate(item.Location, locale.Sprintf(locale.ErrAmbiguousRoute))
 		}
+	}
 
-		p := ""
-		if api.Path != nil {
-			p = api.Path.Path.V()
+	if len(dup.Related) > 0 {
+		p.Error(dup)
+	}
+	if len(ambiguous.Related) > 0 {
+		p.Warning(ambiguous)
+	}
+}
+
+func (api *API) path() string {
+	if api.Path == nil {
+		return ""
+	}
+	return api.Path.Path.V()
+}
+
+// 是否存在相同的 server，都未指定 server 也被认为是相同的。
+func (api *API) sameServer(item *API) bool {
+	if len(api.Servers) == 0 && len(item.Servers) == 0 {
+		return true
+	}
+
+	for _, srv := range api.Servers {
+		if sliceutil.Count(item.Servers, func(i int) bool { return srv.V() == item.Servers[i].V() }) > 0 {
+			return true
 		}
-		iip := ""
-		if item.Path != nil {
-			iip = item.Path.Path.V()
+	}
+	return false
+}
+
+// 路由的比较结果
+const (
+	routeDifferent = iota
+	routeSame
+	routeAmbiguous // 存在同时匹配两者的地址
+)
+
+// 比较两个路由
+//
+// 参数名称不同，但位置相同的路由被认为是相同的，比如 /users/{id} 和 /users/{uid}；
+// 存在能同时匹配两者的地址时，被认为存在歧义，比如 /users/{id} 和 /users/me，
+// 以及 /files/{name}.json 和 /files/v{version}，后者可以同时匹配 /files/v1.json。
+func compareRoutes(p1, p2 string) int {
+	if p1 == p2 {
+		return routeSame
+	}
+
+	s1, s2 := strings.Split(p1, "/"), strings.Split(p2, "/")
+	if len(s1) != len(s2) {
+		return routeDifferent
+	}
+
+	ret := routeSame
+	for i, seg := range s1 {
+		switch compareRouteSegments(seg, s2[i]) {
+		case routeDifferent:
+			return routeDifferent
+		case routeAmbiguous:
+			ret = routeAmbiguous
 		}
-		if p != iip {
-			continue
+	}
+	return ret
+}
+
+var routeParam = regexp.MustCompile(`{[^}]*}`)
+
+// 参数替换为 {} 之后的路由片段中，参数所在位置的占位符。
+const routeParamHolder = "{}"
+
+func compareRouteSegments(s1, s2 string) int {
+	n1, n2 := routeParam.ReplaceAllString(s1, routeParamHolder), routeParam.ReplaceAllString(s2, routeParamHolder)
+	p1, p2 := n1 != s1, n2 != s2 // 是否包含参数
+	switch {
+	case n1 == n2:
+		return routeSame
+	case n1 == routeParamHolder || n2 == routeParamHolder: // 整个片段都为参数，可以匹配任意非空内容。
+		return routeAmbiguous
+	case p1 && !p2 && routeSegmentMatch(n1, s2):
+		return routeAmbiguous
+	case p2 && !p1 && routeSegmentMatch(n2, s1):
+		return routeAmbiguous
+	case p1 && p2 && routeSegmentsIntersect(n1, n2):
+		return routeAmbiguous
+	default:
+		return routeDifferent
+	}
+}
+
+// 包含参数的路由片段 pattern 是否能匹配固定内容 s
+//
+// pattern 中的参数应该已经被替换为 {}，每个参数至少匹配一个字符。
+func routeSegmentMatch(pattern, s string) bool {
+	parts := strings.Split(pattern, routeParamHolder)
+	first, last := parts[0], parts[len(parts)-1]
+	if !strings.HasPrefix(s, first) || !strings.HasSuffix(s, last) {
+		return false
+	}
+
+	// 依次查找中间的固定内容，每次查找都跳过参数至少需要的一个字符。
+	pos := len(first)
+	for _, part := range parts[1 : len(parts)-1] {
+		if pos+1 > len(s) {
+			return false
 		}
+		index := strings.Index(s[pos+1:], part)
+		if index < 0 {
+			return false
+		}
+		pos += 1 + index + len(part)
+	}
+	return pos+1 <= len(s)-len(last)
+}
 
-		// 默认服务器
-		if len(api.Servers) == 0 && len(item.Servers) == 0 {
-			err.Relate(item.Location, locale.Sprintf(locale.ErrDuplicateValue))
-			continue
+// 两个都包含参数的路由片段是否能匹配相同的内容
+//
+// 参数应该已经被替换为 {}。参数匹配至少一个字符，所以被转换成 routeAnyChar 和 routeAnyString 两个元素，
+// 之后判断两者是否存在可以同时匹配的内容。
+func routeSegmentsIntersect(p1, p2 string) bool {
+	r1, r2 := routeSegmentRunes(p1), routeSegmentRunes(p2)
+
+	// matched[i][j] 表示 r1[i:] 与 r2[j:] 是否能匹配相同的内容，0 表示未计算。
+	matched := make([][]int8, len(r1)+1)
+	for i := range matched {
+		matched[i] = make([]int8, len(r2)+1)
+	}
+
+	var match func(i, j int) bool
+	match = func(i, j int) bool {
+		if matched[i][j] != 0 {
+			return matched[i][j] > 0
 		}
 
-		// 判断是否拥有相同的 server 字段
-		for _, srv := range api.Servers {
-			s := sliceutil.Count(item.Servers, func(i int) bool { return srv.V() == item.Servers[i].V() })
-			if s > 0 {
-				err.Relate(item.Location, locale.Sprintf(locale.ErrDuplicateValue))
-				continue
-			}
+		ok := false
+		switch {
+		case i == len(r1) && j == len(r2):
+			ok = true
+		case i < len(r1) && r1[i] == routeAnyString && match(i+1, j): // r1[i] 不匹配任何内容
+			ok = true
+		case j < len(r2) && r2[j] == routeAnyString && match(i, j+1): // r2[j] 不匹配任何内容
+			ok = true
+		case i == len(r1) || j == len(r2):
+		case r1[i] == routeAnyString && r2[j] != routeAnyString: // r1[i] 匹配 r2[j] 对应的字符
+			ok = match(i, j+1)
+		case r2[j] == routeAnyString && r1[i] != routeAnyString: // r2[j] 匹配 r1[i] 对应的字符
+			ok = match(i+1, j)
+		case r1[i] == r2[j] || r1[i] == routeAnyChar || r2[j] == routeAnyChar:
+			ok = match(i+1, j+1)
 		}
+
+		if ok {
+			matched[i][j] = 1
+		} else {
+			matched[i][j] = -1
+		}
+		return ok
 	}
 
-	if len(err.Related) > 0 {
-		p.Error(err)
+	return match(0, 0)
+}
+
+// 路由片段中参数转换后的元素，取值不会与正常的字符冲突。
+const (
+	routeAnyChar   rune = -1 // 匹配任意单个字符
+	routeAnyString rune = -2 // 匹配任意长度的内容，包括空内容。
+)
+
+func routeSegmentRunes(pattern string) []rune {
+	ret := make([]rune, 0, len(pattern))
+	for i, part := range strings.Split(pattern, routeParamHolder) {
+		if i > 0 {
+			ret = append(ret, routeAnyChar, routeAnyString)
+		}
+		ret = append(ret, []rune(part)...)
 	}
+	return ret
 }
@@ -21,6 +21,9 @@ var (
 	_ xmlenc.Sanitizer = &Path{}
 	_ xmlenc.Sanitizer = &Enum{}
 	_ xmlenc.Sanitizer = &XMLNamespace{}
+	_ xmlenc.Sanitizer = &TypeDef{}
+	_ xmlenc.Sanitizer = &Security{}
+	_ xmlenc.Sanitizer = &OAuthFlow{}
 )
 
 func newEmptyParser(a *assert.Assertion) *xmlenc.Parser {
@@ -68,6 +71,183 @@ func TestCheckXML(t *testing.T) {
 	a.Error(checkXML(false, false, xml, newEmptyParser(a)))
 }
 
+func TestCheckConstraint(t *testing.T) {
+	a := assert.New(t)
+
+	num := func(v int) *NumberAttribute { return &NumberAttribute{Value: Number{Int: v}} }
+	typ := func(t string) *TypeAttribute { return &TypeAttribute{Value: xmlenc.String{Value: t}} }
+
+	a.NotError(checkConstraint(typ(TypeNumber), false, &Constraint{}))
+	a.NotError(checkConstraint(typ(TypeInt), false, &Constraint{Min: num(1), Max: num(1)}))
+	a.Error(checkConstraint(typ(TypeInt), false, &Constraint{Min: num(2), Max: num(1)}))
+	a.Error(checkConstraint(typ(TypeString), false, &Constraint{Min: num(1)}))
+
+	a.NotError(checkConstraint(typ(TypeEmail), false, &Constraint{MinLength: num(1), MaxLength: num(5)}))
+	a.Error(checkConstraint(typ(TypeString), false, &Constraint{MinLength: num(6), MaxLength: num(5)}))
+	a.Error(checkConstraint(typ(TypeString), false, &Constraint{MinLength: num(-1)}))
+	a.Error(checkConstraint(typ(TypeString), false, &Constraint{MaxLength: &NumberAttribute{Value: Number{Float: 1.5, IsFloat: true}}}))
+	a.Error(checkConstraint(typ(TypeNumber), false, &Constraint{MaxLength: num(5)}))
+
+	a.NotError(checkConstraint(typ(TypeString), false, &Constraint{Pattern: &Attribute{Value: xmlenc.String{Value: "^[a-z]+$"}}}))
+	a.Error(checkConstraint(typ(TypeString), false, &Constraint{Pattern: &Attribute{Value: xmlenc.String{Value: "[a-z"}}}))
+	a.Error(checkConstraint(typ(TypeBool), false, &Constraint{Pattern: &Attribute{Value: xmlenc.String{Value: "^[a-z]+$"}}}))
+
+	a.NotError(checkConstraint(typ(TypeObject), true, &Constraint{MinItems: num(1), MaxItems: num(5)}))
+	a.Error(checkConstraint(typ(TypeObject), false, &Constraint{MinItems: num(1)}))
+	a.Error(checkConstraint(typ(TypeObject), true, &Constraint{MinItems: num(6), MaxItems: num(5)}))
+
+	// 引用类型不检测类型
+	a.NotError(checkConstraint(typ("#user"), false, &Constraint{Min: num(1), MaxLength: num(5)}))
+}
+
+func TestCheckMapItems(t *testing.T) {
+	a := assert.New(t)
+
+	typ := func(t string) *TypeAttribute { return &TypeAttribute{Value: xmlenc.String{Value: t}} }
+	value := &Param{Type: typ(TypeString)}
+
+	a.NotError(checkMapItems(core.Location{}, typ(TypeObject), nil))
+	a.NotError(checkMapItems(core.Location{}, typ(TypeMap), []*Param{value}))
+	a.Error(checkMapItems(core.Location{}, typ(TypeMap), nil))
+	a.Error(checkMapItems(core.Location{}, typ(TypeMap), []*Param{value, value}))
+
+	rslt := messagetest.NewMessageHandler()
+	doc := &APIDoc{}
+	doc.Parse(rslt.Handler, core.Block{Data: []byte(`<apidoc version="1.0.0">
+	<title>title</title>
+	<mimetype>application/json</mimetype>
+	<type name="titles" type="map" summary="titles">
+		<param name="value" type="string" summary="value" />
+	</type>
+	<api method="GET">
+		<path path="/users/{id}">
+			<param name="id" type="map" summary="id"><param name="value" type="string" summary="value" /></param>
+		</path>
+		<response status="200" type="#titles" />
+	</api>
+</apidoc>`), Location: core.Location{URI: "file:///doc.go"}})
+	rslt.Handler.Stop()
+	a.Equal(1, len(rslt.Errors)) // 路径参数不能为 map
+}
+
+func TestRequest_nullable(t *testing.T) {
+	a := assert.New(t)
+
+	rslt := messagetest.NewMessageHandler()
+	doc := &APIDoc{}
+	doc.Parse(rslt.Handler, core.Block{Data: []byte(`<apidoc version="1.0.0">
+	<title>title</title>
+	<mimetype>application/json</mimetype>
+	<api method="GET">
+		<path path="/users" />
+		<response status="200" type="string" nullable="true" />
+		<response status="404" nullable="true" />
+	</api>
+</apidoc>`), Location: core.Location{URI: "file:///doc.go"}})
+	rslt.Handler.Stop()
+	a.Equal(1, len(rslt.Errors)) // 未指定类型的 nullable
+}
+
+func TestCookies(t *testing.T) {
+	a := assert.New(t)
+
+	rslt := messagetest.NewMessageHandler()
+	doc := &APIDoc{}
+	doc.Parse(rslt.Handler, core.Block{Data: []byte(`<apidoc version="1.0.0">
+	<title>title</title>
+	<mimetype>application/json</mimetype>
+	<api method="GET">
+		<path path="/users" />
+		<cookie name="session" type="string" summary="session" />
+		<cookie name="obj" type="object" summary="obj"><param name="id" type="number" summary="id" /></cookie>
+		<response status="200" type="string">
+			<cookie name="session" type="string" summary="session" />
+			<cookie name="obj" type="map" summary="obj"><param name="value" type="number" summary="value" /></cookie>
+		</response>
+	</api>
+</apidoc>`), Location: core.Location{URI: "file:///doc.go"}})
+	rslt.Handler.Stop()
+	a.Equal(2, len(rslt.Errors)) // cookie 不能为 object 和 map
+
+	api := doc.APIs[0]
+	a.Equal(2, len(api.Cookies)).
+		Equal(api.Cookies[0].Name.V(), "session").
+		Equal(2, len(api.Responses[0].Cookies))
+}
+
+func TestCheckUnion(t *testing.T) {
+	a := assert.New(t)
+
+	parse := func(body string) *messagetest.Result {
+		rslt := messagetest.NewMessageHandler()
+		doc := &APIDoc{}
+		doc.Parse(rslt.Handler, core.Block{Data: []byte(`<apidoc version="1.0.0">
+	<title>title</title>
+	<mimetype>application/json</mimetype>
+	<type name="dog" type="object" summary="dog">
+		<param name="bark" type="bool" summary="bark" />
+	</type>
+	` + body + `
+</apidoc>`), Location: core.Location{URI: "file:///doc.go"}})
+		rslt.Handler.Stop()
+		return rslt
+	}
+
+	rslt := parse(`<type name="pet" type="object" summary="pet" discriminator="kind">
+		<param name="kind" type="string" summary="kind" />
+		<one-of name="dog" type="#dog" summary="dog" />
+		<one-of name="cat" type="object" summary="cat"><param name="meow" type="bool" summary="meow" /></one-of>
+	</type>`)
+	a.Empty(rslt.Errors)
+
+	// 同时指定 one-of 和 any-of
+	rslt = parse(`<type name="pet" type="object" summary="pet">
+		<one-of name="dog" type="#dog" summary="dog" />
+		<any-of name="cat" type="#dog" summary="cat" />
+	</type>`)
+	a.Equal(1, len(rslt.Errors))
+
+	// discriminator 不存在
+	rslt = parse(`<type name="pet" type="object" summary="pet" discriminator="kind">
+		<one-of name="dog" type="#dog" summary="dog" />
+	</type>`)
+	a.Equal(1, len(rslt.Errors))
+
+	// discriminator 不是字符串
+	rslt = parse(`<type name="pet" type="object" summary="pet" discriminator="kind">
+		<param name="kind" type="number" summary="kind" />
+		<one-of name="dog" type="#dog" summary="dog" />
+	</type>`)
+	a.Equal(1, len(rslt.Errors))
+
+	// discriminator 只能与 one-of 一起使用
+	rslt = parse(`<type name="pet" type="object" summary="pet" discriminator="kind">
+		<param name="kind" type="string" summary="kind" />
+		<any-of name="dog" type="#dog" summary="dog" />
+	</type>`)
+	a.Equal(1, len(rslt.Errors))
+
+	// 非对象
+	rslt = parse(`<type name="pet" type="string" summary="pet">
+		<one-of name="dog" type="#dog" summary="dog" />
+	</type>`)
+	a.Equal(1, len(rslt.Errors))
+
+	// 分支不能为数组或是非对象
+	rslt = parse(`<type name="pet" type="object" summary="pet">
+		<one-of name="dog" type="#dog" array="true" summary="dog" />
+		<one-of name="cat" type="string" summary="cat" />
+	</type>`)
+	a.Equal(2, len(rslt.Errors))
+
+	// 分支名称重复
+	rslt = parse(`<type name="pet" type="object" summary="pet">
+		<one-of name="dog" type="#dog" summary="dog" />
+		<one-of name="dog" type="#dog" summary="dog" />
+	</type>`)
+	a.Equal(1, len(rslt.Errors))
+}
+
 func TestAPIDoc_checkXMLNamespaces(t *testing.T) {
 	a := assert.New(t)
 
@@ -388,6 +568,162 @@ func TestAPI_sanitizeTags(t *testing.T) {
 	a.Empty(rslt.Warns)
 }
 
+func TestAPIDoc_sanitizeTypes(t *testing.T) {
+	a := assert.New(t)
+
+	parse := func(data string) (*APIDoc, *messagetest.Result) {
+		rslt := messagetest.NewMessageHandler()
+		doc := &APIDoc{}
+		doc.Parse(rslt.Handler, core.Block{Data: []byte(data), Location: core.Location{URI: "file:///doc.go"}})
+		rslt.Handler.Stop()
+		return doc, rslt
+	}
+
+	doc, rslt := parse(`<apidoc version="1.0.0">
+	<title>title</title>
+	<mimetype>application/json</mimetype>
+	<type name="user" type="object" summary="user">
+		<param name="id" type="number" summary="id" />
+		<param name="group" type="#group" summary="group" />
+	</type>
+	<type name="group" type="object" summary="group">
+		<param name="id" type="number" summary="id" />
+	</type>
+	<type name="id" type="#uid" summary="id" />
+	<type name="uid" type="number" summary="uid" />
+	<api method="GET">
+		<path path="/users/{id}"><param name="id" type="#id" summary="id" /></path>
+		<response status="200" type="#user" />
+	</api>
+</apidoc>`)
+	a.Empty(rslt.Errors)
+	user := doc.Types[0]
+	a.Equal(user.Items[1].Type.TypeDef(), doc.Types[1]).
+		Equal(1, len(user.References())).
+		Equal(1, len(doc.Types[1].References()))
+
+	api := doc.APIs[0]
+	a.Equal(api.Responses[0].Type.TypeDef(), user).
+		Equal(api.Responses[0].Type.Definition().Location, user.Location)
+	id := api.Path.Params[0].Resolve()
+	a.Equal(id.Type.V(), TypeNumber).
+		Equal(id.Name.V(), "id").
+		Equal(api.Path.Params[0].Type.V(), "#id")
+
+	// 不存在的引用
+	_, rslt = parse(`<apidoc version="1.0.0">
+	<title>title</title>
+	<mimetype>application/json</mimetype>
+	<api method="GET">
+		<path path="/users" />
+		<response status="200" type="#user" />
+	</api>
+</apidoc>`)
+	a.Equal(1, len(rslt.Errors))
+
+	// 重复的名称
+	_, rslt = parse(`<apidoc version="1.0.0">
+	<title>title</title>
+	<mimetype>application/json</mimetype>
+	<type name="id" type="number" summary="id" />
+	<type name="id" type="string" summary="id" />
+</apidoc>`)
+	a.Equal(1, len(rslt.Errors))
+
+	// 循环引用
+	doc, rslt = parse(`<apidoc version="1.0.0">
+	<title>title</title>
+	<mimetype>application/json</mimetype>
+	<type name="user" type="object" summary="user">
+		<param name="id" type="number" summary="id" />
+		<param name="group" type="#group" summary="group" />
+	</type>
+	<type name="group" type="object" summary="group">
+		<param name="user" type="#user" summary="user" />
+	</type>
+	<type name="a" type="#b" summary="a" />
+	<type name="b" type="#a" summary="b" />
+</apidoc>`)
+	a.Equal(2, len(rslt.Errors))
+	err, ok := rslt.Errors[0].(*core.Error)
+	a.True(ok).Equal(err.Location.URI, "file:///doc.go").Equal(1, len(err.Related))
+	group := doc.Types[1].Items[0]
+	a.NotNil(group.Type.Definition()).Nil(group.Type.TypeDef())
+	a.Equal(group.Resolve().Type.V(), TypeNone)
+	a.Equal(doc.Types[2].Param().Resolve().Type.V(), TypeNone)
+}
+
+func TestSecurity_Sanitize(t *testing.T) {
+	a := assert.New(t)
+
+	parse := func(security, ref string) (*APIDoc, *messagetest.Result) {
+		rslt := messagetest.NewMessageHandler()
+		doc := &APIDoc{}
+		doc.Parse(rslt.Handler, core.Block{Data: []byte(`<apidoc version="1.0.0">
+	<title>title</title>
+	<mimetype>application/json</mimetype>
+	` + security + `
+	<api method="GET">
+		<path path="/users" />
+		<response status="200" />
+		` + ref + `
+	</api>
+</apidoc>`)})
+		rslt.Handler.Stop()
+		return doc, rslt
+	}
+
+	doc, rslt := parse(`<security name="key" type="apikey" in="header" key="X-Token" />`, `<security name="key" />`)
+	a.Empty(rslt.Errors)
+	a.Equal(doc.APIs[0].Securities[0].Security(), doc.Securities[0]).
+		Equal(1, len(doc.Securities[0].References()))
+
+	_, rslt = parse(`<security name="key" type="apikey" in="body" key="X-Token" />`, "")
+	a.Equal(1, len(rslt.Errors))
+
+	_, rslt = parse(`<security name="key" type="apikey" in="header" />`, "")
+	a.Equal(1, len(rslt.Errors))
+
+	_, rslt = parse(`<security name="basic" type="http" scheme="basic" bearer-format="JWT" />`, "")
+	a.Equal(1, len(rslt.Errors))
+
+	_, rslt = parse(`<security name="oidc" type="openidconnect" />`, "")
+	a.Equal(1, len(rslt.Errors))
+
+	_, rslt = parse(`<security name="x" type="not-exists" />`, "")
+	a.Equal(1, len(rslt.Errors))
+
+	// 未定义的 security
+	_, rslt = parse("", `<security name="key" />`)
+	a.Equal(1, len(rslt.Errors))
+
+	// oauth2
+	const oauth = `<security name="oauth" type="oauth2">
+		<flow type="authorization-code" authorization-url="https://example.com/auth" token-url="https://example.com/token">
+			<scope name="read" summary="read" />
+			<scope name="write" summary="write" />
+		</flow>
+	</security>`
+	_, rslt = parse(oauth, `<security name="oauth"><scope>read</scope></security>`)
+	a.Empty(rslt.Errors)
+
+	_, rslt = parse(oauth, `<security name="oauth"><scope>delete</scope></security>`)
+	a.Equal(1, len(rslt.Errors))
+
+	_, rslt = parse(`<security name="oauth" type="oauth2">
+		<flow type="implicit" token-url="https://example.com/token" />
+		<flow type="implicit" authorization-url="https://example.com/auth" />
+	</security>`, "")
+	a.Equal(2, len(rslt.Errors))
+
+	_, rslt = parse(`<security name="oauth" type="oauth2" />`, "")
+	a.Equal(1, len(rslt.Errors))
+
+	// 非 oauth2 不能指定 scope
+	_, rslt = parse(`<security name="key" type="apikey" in="header" key="X-Token" />`, `<security name="key"><scope>read</scope></security>`)
+	a.Equal(1, len(rslt.Errors))
+}
+
 func TestAPI_checkDup(t *testing.T) {
 	a := assert.New(t)
 
@@ -530,4 +866,104 @@ func TestAPI_checkDup(t *testing.T) {
 	a.NotEmpty(rslt.Errors).Empty(rslt.Warns)
 	err, ok = rslt.Errors[0].(*core.Error)
 	a.True(ok).Equal(1, len(err.Related))
+
+	// 参数名称不同的相同路由，以及有歧义的路由
+	doc = &APIDoc{
+		APIs: []*API{
+			{
+				Method: &MethodAttribute{Value: xmlenc.String{Value: http.MethodGet}},
+				Path:   &Path{Path: &Attribute{Value: xmlenc.String{Value: "/users/{uid}"}}},
+			},
+			{
+				Method: &MethodAttribute{Value: xmlenc.String{Value: http.MethodGet}},
+				Path:   &Path{Path: &Attribute{Value: xmlenc.String{Value: "/users/me"}}},
+			},
+			{
+				Method: &MethodAttribute{Value: xmlenc.String{Value: http.MethodGet}},
+				Path:   &Path{Path: &Attribute{Value: xmlenc.String{Value: "/users/{id}.json"}}},
+			},
+			{
+				Method: &MethodAttribute{Value: xmlenc.String{Value: http.MethodPost}},
+				Path:   &Path{Path: &Attribute{Value: xmlenc.String{Value: "/users/me"}}},
+			},
+		},
+	}
+	api = &API{
+		doc:    doc,
+		Method: &MethodAttribute{Value: xmlenc.String{Value: http.MethodGet}},
+		Path:   &Path{Path: &Attribute{Value: xmlenc.String{Value: "/users/{id}"}}},
+	}
+	p, rslt = newParser(a, "", "")
+	api.checkDup(p)
+	rslt.Handler.Stop()
+	a.Equal(1, len(rslt.Errors)).Equal(1, len(rslt.Warns))
+	err, ok = rslt.Errors[0].(*core.Error)
+	a.True(ok).Equal(1, len(err.Related))
+	err, ok = rslt.Warns[0].(*core.Error)
+	a.True(ok).Equal(2, len(err.Related))
+
+	// 不同的 server
+	api.Servers = []*ServerValue{
+		{Content: Content{Value: "s1"}},
+	}
+	p, rslt = newParser(a, "", "")
+	api.checkDup(p)
+	rslt.Handler.Stop()
+	a.Empty(rslt.Errors).Empty(rslt.Warns)
+}
+
+func TestRouteSegmentMatch(t *testing.T) {
+	a := assert.New(t)
+
+	a.True(routeSegmentMatch("{}", "x"))
+	a.False(routeSegmentMatch("{}", ""))
+	a.True(routeSegmentMatch("{}.json", "a.json"))
+	a.False(routeSegmentMatch("{}.json", ".json"))
+	a.True(routeSegmentMatch("v{}.{}", "v1.2"))
+	a.True(routeSegmentMatch("v{}.{}", "v1..2"))
+	a.False(routeSegmentMatch("v{}.{}", "v1."))
+	a.False(routeSegmentMatch("v{}.{}", "v.2"))
+	a.True(routeSegmentMatch("{}-{}-{}", "a-b-c"))
+	a.True(routeSegmentMatch("{}-{}-{}", "a--b-c"))
+	a.False(routeSegmentMatch("{}-{}-{}", "a-b"))
+	a.False(routeSegmentMatch("{}{}", "a"))
+	a.True(routeSegmentMatch("{}{}", "ab"))
+}
+
+func TestCompareRoutes(t *testing.T) {
+	a := assert.New(t)
+
+	data := []*struct {
+		p1, p2 string
+		ret    int
+	}{
+		{p1: "", p2: "", ret: routeSame},
+		{p1: "/users", p2: "/users", ret: routeSame},
+		{p1: "/users/{id}", p2: "/users/{uid}", ret: routeSame},
+		{p1: "/users/{id}.json", p2: "/users/{uid}.json", ret: routeSame},
+		{p1: "/users/{id}", p2: "/users/me", ret: routeAmbiguous},
+		{p1: "/users/me", p2: "/users/{id}", ret: routeAmbiguous},
+		{p1: "/users/{id}/posts", p2: "/users/me/{pid}", ret: routeAmbiguous},
+		{p1: "/users/{id}.json", p2: "/users/me.json", ret: routeAmbiguous},
+		{p1: "/users/{id}.json", p2: "/users/{id}", ret: routeAmbiguous},
+		{p1: "/files/{name}.json", p2: "/files/v{version}", ret: routeAmbiguous},
+		{p1: "/files/v{version}", p2: "/files/{name}.json", ret: routeAmbiguous},
+		{p1: "/files/{a}-{b}", p2: "/files/x{c}y", ret: routeAmbiguous},
+		{p1: "/files/{a}.{b}", p2: "/files/{c}-{d}", ret: routeAmbiguous},
+		{p1: "/files/a{x}", p2: "/files/b{y}", ret: routeDifferent},
+		{p1: "/files/{x}.json", p2: "/files/{y}.xml", ret: routeDifferent},
+		{p1: "/files/v{x}", p2: "/files/v", ret: routeDifferent},
+		{p1: "/files/a{x}b{y}c", p2: "/files/abc", ret: routeDifferent},
+		{p1: "/files/a{x}b{y}c", p2: "/files/a1b2c", ret: routeAmbiguous},
+		{p1: "/files/a{x}b{y}c", p2: "/files/abbbc", ret: routeAmbiguous},
+		{p1: "/users/{id}.json", p2: "/users/me.xml", ret: routeDifferent},
+		{p1: "/users/{id}.json", p2: "/users/{id}.xml", ret: routeDifferent},
+		{p1: "/users/{id}", p2: "/users/{id}/posts", ret: routeDifferent},
+		{p1: "/users/me", p2: "/users/you", ret: routeDifferent},
+		{p1: "/users", p2: "/groups", ret: routeDifferent},
+	}
+
+	for _, item := range data {
+		a.Equal(compareRoutes(item.p1, item.p2), item.ret, "%s 和 %s 的比较结果不正确", item.p1, item.p2)
+	}
 }
@@ -92,6 +92,9 @@ func Init(out io.Writer) *cmdopt.CmdOpt {
 	initMock(command)
 	initStatic(command)
 	initLSP(command)
+	initImport(command)
+	initUpgrade(command)
+	initDiff(command)
 
 	return command
 }
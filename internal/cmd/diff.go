@@ -0,0 +1,44 @@
+// SPDX-License-Identifier: MIT
+
+package cmd
+
+import (
+	"flag"
+	"io"
+
+	"github.com/issue9/cmdopt"
+
+	"github.com/caixw/apidoc/v7/build"
+	"github.com/caixw/apidoc/v7/core"
+	"github.com/caixw/apidoc/v7/internal/locale"
+)
+
+var (
+	diffFormat string
+	diffFlags  *flag.FlagSet // 需要通过 Args 获取待比较的两个文档
+)
+
+func initDiff(command *cmdopt.CmdOpt) {
+	diffFlags = command.New("diff", locale.Sprintf(locale.CmdDiffUsage), doDiff)
+	diffFlags.StringVar(&diffFormat, "f", build.DiffText, locale.Sprintf(locale.FlagDiffFormatUsage))
+}
+
+func doDiff(w io.Writer) error {
+	if diffFlags.NArg() != 2 {
+		return core.NewError(locale.ErrInvalidValue).WithField("args")
+	}
+
+	h := core.NewMessageHandler(messageHandle)
+	defer h.Stop()
+
+	old, new := core.FileURI(diffFlags.Arg(0)), core.FileURI(diffFlags.Arg(1))
+	breaking, err := build.Diff(h, old, new, diffFormat, w)
+	if err != nil {
+		return err
+	}
+
+	if breaking > 0 {
+		return locale.NewError(locale.ErrBreakingChanges, breaking)
+	}
+	return nil
+}
@@ -0,0 +1,56 @@
+// SPDX-License-Identifier: MIT
+
+package cmd
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/issue9/assert"
+)
+
+func TestCmdDiff(t *testing.T) {
+	a := assert.New(t)
+
+	dir, err := ioutil.TempDir("", "apidoc-cmd-diff")
+	a.NotError(err)
+	defer os.RemoveAll(dir)
+	doc := `<apidoc version="1.0.0"><title>test</title><mimetype>application/json</mimetype>
+	<api method="GET"><path path="/users" /><response status="200" type="string" /></api>
+	<api method="POST"><path path="/users" /><response status="201" /></api>
+</apidoc>`
+	oldPath := filepath.Join(dir, "old.xml")
+	newPath := filepath.Join(dir, "new.xml")
+	a.NotError(ioutil.WriteFile(oldPath, []byte(doc), os.ModePerm))
+	a.NotError(ioutil.WriteFile(newPath, []byte(doc), os.ModePerm))
+
+	buf := new(bytes.Buffer)
+	cmd := Init(buf)
+	erro, _, _, _ := resetPrinters()
+	a.NotError(cmd.Exec([]string{"diff", oldPath, newPath}))
+	a.NotEmpty(buf.String()).Empty(erro.String())
+
+	// 删除了 API
+	doc = `<apidoc version="1.0.0"><title>test</title><mimetype>application/json</mimetype>
+	<api method="GET"><path path="/users" /><response status="200" type="string" /></api>
+</apidoc>`
+	a.NotError(ioutil.WriteFile(newPath, []byte(doc), os.ModePerm))
+	buf.Reset()
+	cmd = Init(buf)
+	resetPrinters()
+	a.Error(cmd.Exec([]string{"diff", "-f", "markdown", oldPath, newPath}))
+	a.Contains(buf.String(), "| POST /users |")
+
+	// 兼容的修改
+	buf.Reset()
+	cmd = Init(buf)
+	resetPrinters()
+	a.NotError(cmd.Exec([]string{"diff", "-f", "json", newPath, oldPath}))
+	a.Contains(buf.String(), `"kind": "api-added"`)
+
+	cmd = Init(buf)
+	a.Error(cmd.Exec([]string{"diff", oldPath}))
+}
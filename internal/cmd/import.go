@@ -0,0 +1,42 @@
+// SPDX-License-Identifier: MIT
+
+package cmd
+
+import (
+	"io"
+	"time"
+
+	"github.com/issue9/cmdopt"
+
+	"github.com/caixw/apidoc/v7/build"
+	"github.com/caixw/apidoc/v7/core"
+	"github.com/caixw/apidoc/v7/internal/locale"
+)
+
+var (
+	importType   string
+	importInput  uri
+	importOutput = uri(core.FileURI("./apidoc.xml"))
+)
+
+func initImport(command *cmdopt.CmdOpt) {
+	fs := command.New("import", locale.Sprintf(locale.CmdImportUsage), doImport)
+	fs.StringVar(&importType, "t", build.ImportOpenapi, locale.Sprintf(locale.FlagImportTypeUsage))
+	fs.Var(&importInput, "i", locale.Sprintf(locale.FlagImportInputUsage))
+	fs.Var(&importOutput, "o", locale.Sprintf(locale.FlagImportOutputUsage))
+}
+
+func doImport(io.Writer) error {
+	start := time.Now()
+
+	h := core.NewMessageHandler(messageHandle)
+	defer h.Stop()
+
+	o := &build.Output{Type: build.APIDocXML, Path: importOutput.URI()}
+	if err := build.Import(h, importType, importInput.URI(), o); err != nil {
+		return err
+	}
+
+	h.Locale(core.Info, locale.Complete, o.Path, time.Now().Sub(start))
+	return nil
+}
@@ -0,0 +1,30 @@
+// SPDX-License-Identifier: MIT
+
+package cmd
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/issue9/assert"
+)
+
+func TestCmdImport(t *testing.T) {
+	a := assert.New(t)
+
+	buf := new(bytes.Buffer)
+	cmd := Init(buf)
+	erro, warn, _, info := resetPrinters()
+	dest := filepath.Join(os.TempDir(), "apidoc-cmd-import.xml")
+	err := cmd.Exec([]string{"import", "-i", "../openapi/testdata/petstore.yaml", "-o", dest})
+	a.NotError(err)
+	a.Empty(buf.String()).
+		Empty(erro.String()).
+		NotEmpty(warn.String()).
+		NotEmpty(info.String())
+
+	_, err = os.Stat(dest)
+	a.NotError(err)
+}
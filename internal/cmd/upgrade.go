@@ -0,0 +1,31 @@
+// SPDX-License-Identifier: MIT
+
+package cmd
+
+import (
+	"io"
+
+	"github.com/issue9/cmdopt"
+
+	"github.com/caixw/apidoc/v7/build"
+	"github.com/caixw/apidoc/v7/core"
+	"github.com/caixw/apidoc/v7/internal/locale"
+)
+
+var (
+	upgradeDir    = uri("./")
+	upgradeDryRun bool
+)
+
+func initUpgrade(command *cmdopt.CmdOpt) {
+	fs := command.New("upgrade", locale.Sprintf(locale.CmdUpgradeUsage), doUpgrade)
+	fs.Var(&upgradeDir, "d", locale.Sprintf(locale.FlagUpgradeDirUsage))
+	fs.BoolVar(&upgradeDryRun, "n", false, locale.Sprintf(locale.FlagUpgradeDryRunUsage))
+}
+
+func doUpgrade(w io.Writer) error {
+	h := core.NewMessageHandler(messageHandle)
+	defer h.Stop()
+
+	return build.Upgrade(h, upgradeDir.URI(), upgradeDryRun, w)
+}
@@ -0,0 +1,44 @@
+// SPDX-License-Identifier: MIT
+
+package cmd
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/issue9/assert"
+)
+
+func TestCmdUpgrade(t *testing.T) {
+	a := assert.New(t)
+
+	dir, err := ioutil.TempDir("", "apidoc-cmd-upgrade")
+	a.NotError(err)
+	defer os.RemoveAll(dir)
+	config := "version: 5.0.0\ninputs:\n- lang: go\n  dir: ./\noutput:\n  path: ./apidoc.xml\n"
+	a.NotError(ioutil.WriteFile(filepath.Join(dir, ".apidoc.yaml"), []byte(config), os.ModePerm))
+	a.NotError(ioutil.WriteFile(filepath.Join(dir, "doc.go"), []byte("package doc\n"), os.ModePerm))
+
+	buf := new(bytes.Buffer)
+	cmd := Init(buf)
+	erro, _, _, info := resetPrinters()
+	err = cmd.Exec([]string{"upgrade", "-d", dir, "-n"})
+	a.NotError(err)
+	a.Contains(buf.String(), "--- a/.apidoc.yaml").
+		Empty(erro.String()).
+		NotEmpty(info.String())
+	data, err := ioutil.ReadFile(filepath.Join(dir, ".apidoc.yaml"))
+	a.NotError(err).Equal(string(data), config)
+
+	buf.Reset()
+	cmd = Init(buf)
+	resetPrinters()
+	err = cmd.Exec([]string{"upgrade", "-d", dir})
+	a.NotError(err)
+	a.Empty(buf.String())
+	data, err = ioutil.ReadFile(filepath.Join(dir, ".apidoc.yaml"))
+	a.NotError(err).NotEqual(string(data), config)
+}
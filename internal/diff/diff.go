@@ -0,0 +1,505 @@
+// SPDX-License-Identifier: MIT
+
+// Package diff 比较两个版本的文档之间的差异
+//
+// 所有的修改都从调用方的角度进行分类，比如请求中添加了必填的参数，
+// 或是返回中删除了字段，原有的客户端都无法正常工作，属于不兼容的修改。
+package diff
+
+import (
+	"sort"
+	"strconv"
+
+	"golang.org/x/text/message"
+
+	"github.com/caixw/apidoc/v7/internal/ast"
+	"github.com/caixw/apidoc/v7/internal/locale"
+)
+
+// Level 修改的级别
+type Level int8
+
+// 修改的级别
+const (
+	NonBreaking Level = iota // 兼容的修改
+	Deprecation              // 标记为弃用
+	Breaking                 // 不兼容的修改
+)
+
+// 修改的类型
+const (
+	KindAPIAdded          = "api-added"
+	KindAPIRemoved        = "api-removed"
+	KindAPIChanged        = "api-changed" // 通过 id 匹配的 API，其请求方法或是路径发生了变化。
+	KindDeprecated        = "deprecated"
+	KindTypeChanged       = "type-changed"
+	KindParamAdded        = "param-added"
+	KindParamRemoved      = "param-removed"
+	KindParamRequired     = "param-required"
+	KindParamOptional     = "param-optional"
+	KindEnumAdded         = "enum-added"
+	KindEnumRemoved       = "enum-removed"
+	KindStatusAdded       = "status-added"
+	KindStatusRemoved     = "status-removed"
+	KindMimetypeAdded     = "mimetype-added"
+	KindMimetypeRemoved   = "mimetype-removed"
+	KindMimetypeChanged   = "mimetype-changed"
+	KindParamNullable     = "param-nullable"
+	KindParamNotNullable  = "param-not-nullable"
+	KindBranchAdded       = "branch-added"
+	KindBranchRemoved     = "branch-removed"
+	KindConstraintAdded   = "constraint-added"
+	KindConstraintRemoved = "constraint-removed"
+	KindConstraintChanged = "constraint-changed"
+)
+
+var kindMessages = map[string]message.Reference{
+	KindAPIAdded:          locale.DiffAPIAdded,
+	KindAPIRemoved:        locale.DiffAPIRemoved,
+	KindAPIChanged:        locale.DiffAPIChanged,
+	KindDeprecated:        locale.DiffDeprecated,
+	KindTypeChanged:       locale.DiffTypeChanged,
+	KindParamAdded:        locale.DiffParamAdded,
+	KindParamRemoved:      locale.DiffParamRemoved,
+	KindParamRequired:     locale.DiffParamRequired,
+	KindParamOptional:     locale.DiffParamOptional,
+	KindEnumAdded:         locale.DiffEnumAdded,
+	KindEnumRemoved:       locale.DiffEnumRemoved,
+	KindStatusAdded:       locale.DiffStatusAdded,
+	KindStatusRemoved:     locale.DiffStatusRemoved,
+	KindMimetypeAdded:     locale.DiffMimetypeAdded,
+	KindMimetypeRemoved:   locale.DiffMimetypeRemoved,
+	KindMimetypeChanged:   locale.DiffMimetypeChanged,
+	KindParamNullable:     locale.DiffParamNullable,
+	KindParamNotNullable:  locale.DiffParamNotNullable,
+	KindBranchAdded:       locale.DiffBranchAdded,
+	KindBranchRemoved:     locale.DiffBranchRemoved,
+	KindConstraintAdded:   locale.DiffConstraintAdded,
+	KindConstraintRemoved: locale.DiffConstraintRemoved,
+	KindConstraintChanged: locale.DiffConstraintChanged,
+}
+
+// Change 表示一处修改
+type Change struct {
+	Level Level  `json:"level"`
+	Kind  string `json:"kind"`
+
+	// 修改所在的 API，由请求方法和路径组成，比如 GET /users/{id}，
+	// 为空表示文档级别的修改。
+	API string `json:"api,omitempty"`
+
+	// 修改的内容在 API 中的位置，比如 query.page 和 response[200][application/json].id，
+	// 为空表示 API 本身。
+	Target string `json:"target,omitempty"`
+
+	Old string `json:"old,omitempty"`
+	New string `json:"new,omitempty"`
+}
+
+// Changes 修改的列表
+type Changes []*Change
+
+type differ struct {
+	changes Changes
+	api     string
+}
+
+// Diff 比较 old 和 new 两个文档之间的差异
+//
+// API 优先通过 id 进行匹配，未指定 id 的则通过请求方法和路径进行匹配，
+// 联合类型的各个分支则通过分支的名称进行匹配。
+// 目前不会比较 callback 的内容。
+func Diff(old, new *ast.APIDoc) Changes {
+	d := &differ{changes: make(Changes, 0, 10)}
+
+	d.elements(KindMimetypeAdded, KindMimetypeRemoved, "mimetype", old.Mimetypes, new.Mimetypes)
+	d.params("header", old.Headers, new.Headers, true)
+	d.responses("response", old.Responses, new.Responses)
+
+	ids := make(map[string]*ast.API, len(new.APIs))
+	routes := make(map[string]*ast.API, len(new.APIs))
+	for _, api := range new.APIs {
+		if id := api.ID.V(); id != "" {
+			ids[id] = api
+		}
+		routes[route(api)] = api
+	}
+
+	matched := make(map[*ast.API]bool, len(new.APIs))
+	for _, o := range old.APIs {
+		n := ids[o.ID.V()]
+		if n == nil {
+			n = routes[route(o)]
+		}
+
+		if n == nil || matched[n] {
+			d.api = route(o)
+			d.add(Breaking, KindAPIRemoved, "", "", "")
+			continue
+		}
+		matched[n] = true
+		d.apis(o, n)
+	}
+
+	for _, n := range new.APIs {
+		if !matched[n] {
+			d.api = route(n)
+			d.add(NonBreaking, KindAPIAdded, "", "", "")
+		}
+	}
+
+	return d.changes
+}
+
+func route(api *ast.API) string {
+	return api.Method.V() + " " + api.Path.Path.V()
+}
+
+func (d *differ) add(level Level, kind, target, old, new string) {
+	d.changes = append(d.changes, &Change{
+		Level:  level,
+		Kind:   kind,
+		API:    d.api,
+		Target: target,
+		Old:    old,
+		New:    new,
+	})
+}
+
+func (d *differ) deprecated(target string, old, new *ast.VersionAttribute) {
+	if old == nil && new != nil {
+		d.add(Deprecation, KindDeprecated, target, "", new.V())
+	}
+}
+
+func (d *differ) apis(o, n *ast.API) {
+	d.api = route(o)
+
+	if r := route(n); r != d.api {
+		d.add(Breaking, KindAPIChanged, "", d.api, r)
+	}
+	d.deprecated("", o.Deprecated, n.Deprecated)
+
+	d.params("param", o.Path.Params, n.Path.Params, true)
+	d.params("query", o.Path.Queries, n.Path.Queries, true)
+	d.params("header", o.Headers, n.Headers, true)
+	d.params("cookie", o.Cookies, n.Cookies, true)
+	d.requests("request", o.Requests, n.Requests, true)
+	d.responses("response", o.Responses, n.Responses)
+}
+
+// 比较两组同级的参数
+//
+// request 表示是否为请求中的参数。请求中删除参数属于兼容的修改，
+// 但是添加必填参数属于不兼容的修改；返回中的则正好相反。
+func (d *differ) params(target string, old, new []*ast.Param, request bool) {
+	names := make(map[string]*ast.Param, len(new))
+	for _, p := range new {
+		names[p.Name.V()] = p
+	}
+
+	for _, o := range old {
+		name := o.Name.V()
+		t := target + "." + name
+
+		n, found := names[name]
+		if !found {
+			if request {
+				d.add(NonBreaking, KindParamRemoved, t, "", "")
+			} else {
+				d.add(Breaking, KindParamRemoved, t, "", "")
+			}
+			continue
+		}
+		delete(names, name)
+
+		d.deprecated(t, o.Deprecated, n.Deprecated)
+
+		switch optional := o.Optional.V(); {
+		case optional && !n.Optional.V():
+			d.add(level(request), KindParamRequired, t, "", "")
+		case !optional && n.Optional.V():
+			d.add(level(!request), KindParamOptional, t, "", "")
+		}
+
+		d.values(t, o, n, request)
+	}
+
+	for _, n := range new { // 保证输出的顺序与文档中的一致
+		if _, found := names[n.Name.V()]; !found {
+			continue
+		}
+
+		if request && !n.Optional.V() {
+			d.add(Breaking, KindParamAdded, target+"."+n.Name.V(), "", "")
+		} else {
+			d.add(NonBreaking, KindParamAdded, target+"."+n.Name.V(), "", "")
+		}
+	}
+}
+
+func level(breaking bool) Level {
+	if breaking {
+		return Breaking
+	}
+	return NonBreaking
+}
+
+// 比较参数的类型、约束条件以及子元素
+//
+// 与 params 相同，request 表示是否为请求中的参数，
+// 请求中放宽限制属于兼容的修改，收紧限制则属于不兼容的修改；返回中的则正好相反。
+func (d *differ) values(target string, o, n *ast.Param, request bool) {
+	o, n = o.Resolve(), n.Resolve()
+
+	if ot, nt := typeName(o), typeName(n); ot != nt {
+		d.add(Breaking, KindTypeChanged, target, ot, nt)
+		return
+	}
+
+	switch nullable := o.Nullable.V(); {
+	case !nullable && n.Nullable.V():
+		d.add(level(!request), KindParamNullable, target, "", "")
+	case nullable && !n.Nullable.V():
+		d.add(level(request), KindParamNotNullable, target, "", "")
+	}
+
+	d.constraints(target, &o.Constraint, &n.Constraint, request)
+
+	enums := make(map[string]*ast.Enum, len(n.Enums))
+	for _, e := range n.Enums {
+		enums[e.Value.V()] = e
+	}
+	for _, e := range o.Enums {
+		v := e.Value.V()
+		ne, found := enums[v]
+		if !found {
+			d.add(Breaking, KindEnumRemoved, target, v, "")
+			continue
+		}
+		delete(enums, v)
+		d.deprecated(target+"["+v+"]", e.Deprecated, ne.Deprecated)
+	}
+	for _, e := range n.Enums {
+		if _, found := enums[e.Value.V()]; found {
+			d.add(NonBreaking, KindEnumAdded, target, "", e.Value.V())
+		}
+	}
+
+	d.branches(target, o, n, request)
+	d.params(target, o.Items, n.Items, request)
+}
+
+// 比较联合类型的各个分支，分支中的内容以 target[name] 的形式表示。
+func (d *differ) branches(target string, o, n *ast.Param, request bool) {
+	newBranches := branches(n)
+	names := make(map[string]*ast.Param, len(newBranches))
+	for _, b := range newBranches {
+		names[b.Name.V()] = b
+	}
+
+	for _, ob := range branches(o) {
+		name := ob.Name.V()
+		nb, found := names[name]
+		if !found {
+			d.add(level(request), KindBranchRemoved, target, name, "")
+			continue
+		}
+		delete(names, name)
+
+		t := target + "[" + name + "]"
+		d.deprecated(t, ob.Deprecated, nb.Deprecated)
+		d.values(t, ob, nb, request)
+	}
+
+	for _, nb := range newBranches {
+		if _, found := names[nb.Name.V()]; found {
+			d.add(level(!request), KindBranchAdded, target, "", nb.Name.V())
+		}
+	}
+}
+
+func branches(p *ast.Param) []*ast.Param {
+	if len(p.OneOf) > 0 {
+		return p.OneOf
+	}
+	return p.AnyOf
+}
+
+func (d *differ) constraints(target string, o, n *ast.Constraint, request bool) {
+	d.bound(target, "min", o.Min, n.Min, true, request)
+	d.bound(target, "max", o.Max, n.Max, false, request)
+	d.bound(target, "min-length", o.MinLength, n.MinLength, true, request)
+	d.bound(target, "max-length", o.MaxLength, n.MaxLength, false, request)
+	d.bound(target, "min-items", o.MinItems, n.MinItems, true, request)
+	d.bound(target, "max-items", o.MaxItems, n.MaxItems, false, request)
+
+	// 无法判断两个正则表达式之间的包含关系，所以修改了 pattern 都视为不兼容。
+	switch op, np := o.Pattern.V(), n.Pattern.V(); {
+	case op == np:
+	case op == "":
+		d.add(level(request), KindConstraintAdded, target, "", "pattern="+np)
+	case np == "":
+		d.add(level(!request), KindConstraintRemoved, target, "pattern="+op, "")
+	default:
+		d.add(Breaking, KindConstraintChanged, target, "pattern="+op, "pattern="+np)
+	}
+}
+
+// 比较约束条件中的上下限
+//
+// lower 表示是否为下限，提高下限或是降低上限表示收紧了限制。
+func (d *differ) bound(target, name string, o, n *ast.NumberAttribute, lower, request bool) {
+	switch {
+	case o == nil && n == nil:
+	case o == nil:
+		d.add(level(request), KindConstraintAdded, target, "", name+"="+numberString(n))
+	case n == nil:
+		d.add(level(!request), KindConstraintRemoved, target, name+"="+numberString(o), "")
+	case o.V() != n.V():
+		tightened := (n.V() > o.V()) == lower
+		d.add(level(tightened == request), KindConstraintChanged, target, name+"="+numberString(o), name+"="+numberString(n))
+	}
+}
+
+func numberString(num *ast.NumberAttribute) string {
+	s, _ := num.EncodeXMLAttr()
+	return s
+}
+
+func typeName(p *ast.Param) string {
+	t := p.Type.V()
+	if t == "" {
+		t = ast.TypeNone
+	}
+	if p.Array.V() {
+		t += "[]"
+	}
+	return t
+}
+
+// 比较同一状态码或是请求的报文，相同 mimetype 的报文之间进行比较。
+//
+// 如果两者都只有一个报文，则直接比较，mimetype 不同视为修改了 mimetype。
+func (d *differ) requests(target string, old, new []*ast.Request, request bool) {
+	if len(old) == 1 && len(new) == 1 {
+		o, n := old[0], new[0]
+		if om, nm := o.Mimetype.V(), n.Mimetype.V(); om != nm {
+			d.add(Breaking, KindMimetypeChanged, target, om, nm)
+		}
+		d.request(target+mimetypeTarget(o), o, n, request)
+		return
+	}
+
+	mimetypes := make(map[string]*ast.Request, len(new))
+	for _, r := range new {
+		mimetypes[r.Mimetype.V()] = r
+	}
+	for _, o := range old {
+		n, found := mimetypes[o.Mimetype.V()]
+		if !found {
+			d.add(Breaking, KindMimetypeRemoved, target, o.Mimetype.V(), "")
+			continue
+		}
+		delete(mimetypes, o.Mimetype.V())
+		d.request(target+mimetypeTarget(o), o, n, request)
+	}
+	for _, n := range new {
+		if _, found := mimetypes[n.Mimetype.V()]; found {
+			d.add(NonBreaking, KindMimetypeAdded, target, "", n.Mimetype.V())
+		}
+	}
+}
+
+func mimetypeTarget(r *ast.Request) string {
+	if m := r.Mimetype.V(); m != "" {
+		return "[" + m + "]"
+	}
+	return ""
+}
+
+func (d *differ) request(target string, o, n *ast.Request, request bool) {
+	d.deprecated(target, o.Deprecated, n.Deprecated)
+	d.params(target+".header", o.Headers, n.Headers, request)
+	d.params(target+".cookie", o.Cookies, n.Cookies, request)
+	d.values(target, o.Param(), n.Param(), request)
+}
+
+func (d *differ) responses(target string, old, new []*ast.Request) {
+	olds, oldStatuses := groupByStatus(old)
+	news, newStatuses := groupByStatus(new)
+
+	for _, status := range oldStatuses {
+		s := strconv.Itoa(status)
+		if _, found := news[status]; !found {
+			d.add(Breaking, KindStatusRemoved, target, s, "")
+			continue
+		}
+		d.requests(target+"["+s+"]", olds[status], news[status], false)
+	}
+
+	for _, status := range newStatuses {
+		if _, found := olds[status]; !found {
+			d.add(NonBreaking, KindStatusAdded, target, "", strconv.Itoa(status))
+		}
+	}
+}
+
+// 按状态码对返回内容进行分组，同时返回按从小到大排序的状态码。
+func groupByStatus(responses []*ast.Request) (map[int][]*ast.Request, []int) {
+	groups := make(map[int][]*ast.Request, len(responses))
+	statuses := make([]int, 0, len(responses))
+	for _, r := range responses {
+		status := r.Status.V()
+		if _, found := groups[status]; !found {
+			statuses = append(statuses, status)
+		}
+		groups[status] = append(groups[status], r)
+	}
+	sort.Ints(statuses)
+	return groups, statuses
+}
+
+// 比较两组只包含字符串内容的元素
+func (d *differ) elements(added, removed, target string, old, new []*ast.Element) {
+	values := make(map[string]bool, len(new))
+	for _, e := range new {
+		values[e.V()] = true
+	}
+	for _, e := range old {
+		if !values[e.V()] {
+			d.add(Breaking, removed, target, e.V(), "")
+		}
+		delete(values, e.V())
+	}
+	for _, e := range new {
+		if values[e.V()] {
+			d.add(NonBreaking, added, target, "", e.V())
+		}
+	}
+}
+
+// Count 指定级别的修改数量
+func (changes Changes) Count(l Level) int {
+	var cnt int
+	for _, c := range changes {
+		if c.Level == l {
+			cnt++
+		}
+	}
+	return cnt
+}
+
+// Message 返回修改的本地化描述内容
+func (c *Change) Message() string {
+	switch c.Kind {
+	case KindAPIChanged, KindTypeChanged, KindMimetypeChanged, KindConstraintChanged:
+		return locale.Sprintf(kindMessages[c.Kind], c.Old, c.New)
+	case KindDeprecated, KindEnumAdded, KindStatusAdded, KindMimetypeAdded, KindBranchAdded, KindConstraintAdded:
+		return locale.Sprintf(kindMessages[c.Kind], c.New)
+	case KindEnumRemoved, KindStatusRemoved, KindMimetypeRemoved, KindBranchRemoved, KindConstraintRemoved:
+		return locale.Sprintf(kindMessages[c.Kind], c.Old)
+	default:
+		return locale.Sprintf(kindMessages[c.Kind])
+	}
+}
@@ -0,0 +1,249 @@
+// SPDX-License-Identifier: MIT
+
+package diff
+
+import (
+	"testing"
+
+	"github.com/issue9/assert"
+
+	"github.com/caixw/apidoc/v7/core"
+	"github.com/caixw/apidoc/v7/core/messagetest"
+	"github.com/caixw/apidoc/v7/internal/ast"
+	"github.com/caixw/apidoc/v7/internal/ast/asttest"
+)
+
+const oldDoc = `<apidoc version="1.0.0">
+	<title>title</title>
+	<mimetype>application/json</mimetype>
+	<mimetype>application/xml</mimetype>
+	<type name="user" type="object" summary="user">
+		<param name="id" type="number" summary="id" />
+		<param name="name" type="string" summary="name" />
+		<param name="sex" type="string" summary="sex">
+			<enum value="male" summary="male" />
+			<enum value="female" summary="female" />
+		</param>
+	</type>
+	<api method="GET" id="get-user">
+		<path path="/users/{id}">
+			<param name="id" type="number" summary="id" />
+			<query name="page" type="number" optional="true" summary="page" />
+			<query name="size" type="number" summary="size" />
+		</path>
+		<response status="200" type="#user" mimetype="application/json" />
+		<response status="404" type="object" mimetype="application/json">
+			<param name="message" type="string" summary="message" />
+		</response>
+	</api>
+	<api method="POST">
+		<path path="/users" />
+		<request type="#user" mimetype="application/json" />
+		<response status="201" mimetype="application/json" />
+	</api>
+	<api method="DELETE">
+		<path path="/users/{id}"><param name="id" type="number" summary="id" /></path>
+		<response status="204" />
+	</api>
+</apidoc>`
+
+const newDoc = `<apidoc version="1.0.1">
+	<title>title</title>
+	<mimetype>application/json</mimetype>
+	<type name="user" type="object" summary="user">
+		<param name="id" type="string" summary="id" />
+		<param name="sex" type="string" summary="sex" deprecated="1.0.1">
+			<enum value="male" summary="male" />
+			<enum value="unknown" summary="unknown" />
+		</param>
+		<param name="age" type="number" summary="age" />
+	</type>
+	<api method="GET" id="get-user">
+		<path path="/v1/users/{id}">
+			<param name="id" type="number" summary="id" />
+			<query name="page" type="number" summary="page" />
+			<query name="size" type="number" optional="true" summary="size" />
+			<query name="sort" type="string" summary="sort" />
+		</path>
+		<response status="200" type="#user" mimetype="application/json" />
+		<response status="500" type="object" mimetype="application/json">
+			<param name="message" type="string" summary="message" />
+		</response>
+	</api>
+	<api method="POST" deprecated="1.0.1">
+		<path path="/users" />
+		<request type="#user" mimetype="application/xml" />
+		<response status="201" mimetype="application/json" />
+	</api>
+	<api method="PUT">
+		<path path="/users/{id}"><param name="id" type="number" summary="id" /></path>
+		<response status="204" />
+	</api>
+</apidoc>`
+
+func loadDoc(a *assert.Assertion, data string) *ast.APIDoc {
+	rslt := messagetest.NewMessageHandler()
+	doc := &ast.APIDoc{}
+	doc.Parse(rslt.Handler, core.Block{Data: []byte(data)})
+	rslt.Handler.Stop()
+	a.Empty(rslt.Errors)
+	return doc
+}
+
+func TestDiff(t *testing.T) {
+	a := assert.New(t)
+
+	d := asttest.Get()
+	a.Empty(Diff(d, d))
+
+	changes := Diff(loadDoc(a, oldDoc), loadDoc(a, newDoc))
+	a.Equal(changes, Changes{
+		{Level: Breaking, Kind: KindMimetypeRemoved, Target: "mimetype", Old: "application/xml"},
+
+		{Level: Deprecation, Kind: KindDeprecated, API: "POST /users", New: "1.0.1"},
+		{Level: Breaking, Kind: KindMimetypeChanged, API: "POST /users", Target: "request", Old: "application/json", New: "application/xml"},
+		{Level: Breaking, Kind: KindTypeChanged, API: "POST /users", Target: "request[application/json].id", Old: "number", New: "string"},
+		{Level: NonBreaking, Kind: KindParamRemoved, API: "POST /users", Target: "request[application/json].name"},
+		{Level: Deprecation, Kind: KindDeprecated, API: "POST /users", Target: "request[application/json].sex", New: "1.0.1"},
+		{Level: Breaking, Kind: KindEnumRemoved, API: "POST /users", Target: "request[application/json].sex", Old: "female"},
+		{Level: NonBreaking, Kind: KindEnumAdded, API: "POST /users", Target: "request[application/json].sex", New: "unknown"},
+		{Level: Breaking, Kind: KindParamAdded, API: "POST /users", Target: "request[application/json].age"},
+		{Level: Breaking, Kind: KindAPIRemoved, API: "DELETE /users/{id}"},
+
+		{Level: Breaking, Kind: KindAPIChanged, API: "GET /users/{id}", Old: "GET /users/{id}", New: "GET /v1/users/{id}"},
+		{Level: Breaking, Kind: KindParamRequired, API: "GET /users/{id}", Target: "query.page"},
+		{Level: NonBreaking, Kind: KindParamOptional, API: "GET /users/{id}", Target: "query.size"},
+		{Level: Breaking, Kind: KindParamAdded, API: "GET /users/{id}", Target: "query.sort"},
+		{Level: Breaking, Kind: KindTypeChanged, API: "GET /users/{id}", Target: "response[200][application/json].id", Old: "number", New: "string"},
+		{Level: Breaking, Kind: KindParamRemoved, API: "GET /users/{id}", Target: "response[200][application/json].name"},
+		{Level: Deprecation, Kind: KindDeprecated, API: "GET /users/{id}", Target: "response[200][application/json].sex", New: "1.0.1"},
+		{Level: Breaking, Kind: KindEnumRemoved, API: "GET /users/{id}", Target: "response[200][application/json].sex", Old: "female"},
+		{Level: NonBreaking, Kind: KindEnumAdded, API: "GET /users/{id}", Target: "response[200][application/json].sex", New: "unknown"},
+		{Level: NonBreaking, Kind: KindParamAdded, API: "GET /users/{id}", Target: "response[200][application/json].age"},
+		{Level: Breaking, Kind: KindStatusRemoved, API: "GET /users/{id}", Target: "response", Old: "404"},
+		{Level: NonBreaking, Kind: KindStatusAdded, API: "GET /users/{id}", Target: "response", New: "500"},
+		{Level: NonBreaking, Kind: KindAPIAdded, API: "PUT /users/{id}"},
+	})
+
+	a.Equal(changes.Count(Breaking), 13).
+		Equal(changes.Count(Deprecation), 3).
+		Equal(changes.Count(NonBreaking), 7)
+}
+
+func TestDiff_requests(t *testing.T) {
+	a := assert.New(t)
+
+	o := loadDoc(a, `<apidoc version="1.0.0">
+	<title>title</title>
+	<mimetype>application/json</mimetype>
+	<api method="POST">
+		<path path="/users" />
+		<request type="string" mimetype="application/json" />
+		<request type="string" mimetype="application/xml" />
+		<response status="200" type="string" array="true" />
+	</api>
+</apidoc>`)
+	n := loadDoc(a, `<apidoc version="1.0.0">
+	<title>title</title>
+	<mimetype>application/json</mimetype>
+	<api method="POST">
+		<path path="/users" />
+		<request type="number" mimetype="application/json" />
+		<request type="string" mimetype="text/plain" />
+		<response status="200" type="string" />
+	</api>
+</apidoc>`)
+
+	a.Equal(Diff(o, n), Changes{
+		{Level: Breaking, Kind: KindTypeChanged, API: "POST /users", Target: "request[application/json]", Old: "string", New: "number"},
+		{Level: Breaking, Kind: KindMimetypeRemoved, API: "POST /users", Target: "request", Old: "application/xml"},
+		{Level: NonBreaking, Kind: KindMimetypeAdded, API: "POST /users", Target: "request", New: "text/plain"},
+		{Level: Breaking, Kind: KindTypeChanged, API: "POST /users", Target: "response[200]", Old: "string[]", New: "string"},
+	})
+}
+
+func TestDiff_values(t *testing.T) {
+	a := assert.New(t)
+
+	o := loadDoc(a, `<apidoc version="1.0.0">
+	<title>title</title>
+	<mimetype>application/json</mimetype>
+	<api method="POST">
+		<path path="/shapes">
+			<query name="page" type="number" min="1" max="100" summary="page" />
+			<query name="name" type="string" max-length="10" pattern="^[a-z]+$" nullable="true" summary="name" />
+			<query name="ids" type="number" array="true" min-items="1" summary="ids" />
+		</path>
+		<request type="object" mimetype="application/json" discriminator="kind">
+			<param name="kind" type="string" summary="kind" />
+			<one-of name="circle" type="object" summary="circle">
+				<param name="r" type="number" min="0" summary="r" />
+			</one-of>
+			<one-of name="square" type="object" summary="square">
+				<param name="side" type="number" summary="side" />
+			</one-of>
+		</request>
+		<response status="200" type="object" mimetype="application/json">
+			<param name="count" type="number" max="100" summary="count" />
+			<param name="name" type="string" summary="name" />
+			<one-of name="circle" type="object" summary="circle">
+				<param name="r" type="number" summary="r" />
+			</one-of>
+		</response>
+	</api>
+</apidoc>`)
+	n := loadDoc(a, `<apidoc version="1.0.0">
+	<title>title</title>
+	<mimetype>application/json</mimetype>
+	<api method="POST">
+		<path path="/shapes">
+			<query name="page" type="number" min="0" max="50" summary="page" />
+			<query name="name" type="string" min-length="1" pattern="^[a-z0-9]+$" summary="name" />
+			<query name="ids" type="number" array="true" summary="ids" />
+		</path>
+		<request type="object" mimetype="application/json" discriminator="kind">
+			<param name="kind" type="string" summary="kind" />
+			<one-of name="circle" type="object" summary="circle">
+				<param name="r" type="number" min="1" nullable="true" summary="r" />
+			</one-of>
+			<one-of name="triangle" type="object" summary="triangle">
+				<param name="a" type="number" summary="a" />
+			</one-of>
+		</request>
+		<response status="200" type="object" mimetype="application/json">
+			<param name="count" type="number" max="200" summary="count" />
+			<param name="name" type="string" nullable="true" summary="name" />
+			<one-of name="circle" type="object" summary="circle">
+				<param name="r" type="number" summary="r" />
+			</one-of>
+			<one-of name="square" type="object" summary="square">
+				<param name="side" type="number" summary="side" />
+			</one-of>
+		</response>
+	</api>
+</apidoc>`)
+
+	changes := Diff(o, n)
+	a.Equal(changes, Changes{
+		// 请求中放宽限制是兼容的，收紧限制是不兼容的。
+		{Level: NonBreaking, Kind: KindConstraintChanged, API: "POST /shapes", Target: "query.page", Old: "min=1", New: "min=0"},
+		{Level: Breaking, Kind: KindConstraintChanged, API: "POST /shapes", Target: "query.page", Old: "max=100", New: "max=50"},
+		{Level: Breaking, Kind: KindParamNotNullable, API: "POST /shapes", Target: "query.name"},
+		{Level: Breaking, Kind: KindConstraintAdded, API: "POST /shapes", Target: "query.name", New: "min-length=1"},
+		{Level: NonBreaking, Kind: KindConstraintRemoved, API: "POST /shapes", Target: "query.name", Old: "max-length=10"},
+		{Level: Breaking, Kind: KindConstraintChanged, API: "POST /shapes", Target: "query.name", Old: "pattern=^[a-z]+$", New: "pattern=^[a-z0-9]+$"},
+		{Level: NonBreaking, Kind: KindConstraintRemoved, API: "POST /shapes", Target: "query.ids", Old: "min-items=1"},
+
+		{Level: NonBreaking, Kind: KindParamNullable, API: "POST /shapes", Target: "request[application/json][circle].r"},
+		{Level: Breaking, Kind: KindConstraintChanged, API: "POST /shapes", Target: "request[application/json][circle].r", Old: "min=0", New: "min=1"},
+		{Level: Breaking, Kind: KindBranchRemoved, API: "POST /shapes", Target: "request[application/json]", Old: "square"},
+		{Level: NonBreaking, Kind: KindBranchAdded, API: "POST /shapes", Target: "request[application/json]", New: "triangle"},
+
+		// 返回中放宽限制是不兼容的
+		{Level: Breaking, Kind: KindBranchAdded, API: "POST /shapes", Target: "response[200][application/json]", New: "square"},
+		{Level: Breaking, Kind: KindConstraintChanged, API: "POST /shapes", Target: "response[200][application/json].count", Old: "max=100", New: "max=200"},
+		{Level: Breaking, Kind: KindParamNullable, API: "POST /shapes", Target: "response[200][application/json].name"},
+	})
+
+	a.Equal(changes[1].Message(), "约束条件由 max=100 修改为 max=50")
+}
@@ -0,0 +1,108 @@
+// SPDX-License-Identifier: MIT
+
+package diff
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/issue9/errwrap"
+	"golang.org/x/text/message"
+
+	"github.com/caixw/apidoc/v7/internal/locale"
+)
+
+// 输出时各个级别的顺序
+var levels = []Level{Breaking, Deprecation, NonBreaking}
+
+var levelStrings = map[Level]string{
+	NonBreaking: "non-breaking",
+	Deprecation: "deprecation",
+	Breaking:    "breaking",
+}
+
+var levelMessages = map[Level]message.Reference{
+	NonBreaking: locale.DiffNonBreaking,
+	Deprecation: locale.DiffDeprecation,
+	Breaking:    locale.DiffBreaking,
+}
+
+func (l Level) String() string {
+	return levelStrings[l]
+}
+
+// MarshalText encoding.TextMarshaler
+func (l Level) MarshalText() ([]byte, error) {
+	return []byte(l.String()), nil
+}
+
+// Text 以纯文本的形式输出，每行一条修改，最后一行为统计信息。
+func (changes Changes) Text() []byte {
+	var buf errwrap.Buffer
+	for _, l := range levels {
+		for _, c := range changes {
+			if c.Level == l {
+				buf.Printf("[%s] %s：%s\n", locale.Sprintf(levelMessages[l]), c.location(), c.Message())
+			}
+		}
+	}
+	buf.WString(changes.summary()).WByte('\n')
+	return buf.Bytes()
+}
+
+// JSON 以 JSON 的形式输出
+func (changes Changes) JSON() ([]byte, error) {
+	return json.MarshalIndent(&struct {
+		Breaking    int     `json:"breaking"`
+		Deprecation int     `json:"deprecation"`
+		NonBreaking int     `json:"nonBreaking"`
+		Changes     Changes `json:"changes"`
+	}{
+		Breaking:    changes.Count(Breaking),
+		Deprecation: changes.Count(Deprecation),
+		NonBreaking: changes.Count(NonBreaking),
+		Changes:     changes,
+	}, "", "\t")
+}
+
+// Markdown 以 markdown 的形式输出，每个级别的修改为一张表格。
+func (changes Changes) Markdown() []byte {
+	var buf errwrap.Buffer
+	buf.WString(changes.summary()).WString("\n")
+
+	for _, l := range levels {
+		if changes.Count(l) == 0 {
+			continue
+		}
+
+		buf.Printf("\n## %s\n\n", locale.Sprintf(levelMessages[l])).
+			Printf("| API | %s | %s |\n", locale.Sprintf(locale.DiffTarget), locale.Sprintf(locale.DiffMessage)).
+			WString("|-----|-----|-----|\n")
+		for _, c := range changes {
+			if c.Level == l {
+				buf.Printf("| %s | %s | %s |\n", escapeCell(c.API), escapeCell(c.Target), escapeCell(c.Message()))
+			}
+		}
+	}
+
+	return buf.Bytes()
+}
+
+func (changes Changes) summary() string {
+	return locale.Sprintf(locale.DiffSummary, changes.Count(Breaking), changes.Count(Deprecation), changes.Count(NonBreaking))
+}
+
+func (c *Change) location() string {
+	switch {
+	case c.API == "":
+		return c.Target
+	case c.Target == "":
+		return c.API
+	default:
+		return c.API + " " + c.Target
+	}
+}
+
+func escapeCell(s string) string {
+	return strings.ReplaceAll(s, "|", "\\|")
+}
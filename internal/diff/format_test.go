@@ -0,0 +1,47 @@
+// SPDX-License-Identifier: MIT
+
+package diff
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/issue9/assert"
+)
+
+func TestChanges_format(t *testing.T) {
+	a := assert.New(t)
+
+	changes := Changes{
+		{Level: NonBreaking, Kind: KindAPIAdded, API: "PUT /users"},
+		{Level: Breaking, Kind: KindTypeChanged, API: "GET /users", Target: "query.a|b", Old: "string", New: "number"},
+		{Level: Breaking, Kind: KindMimetypeRemoved, Target: "mimetype", Old: "application/xml"},
+	}
+
+	lines := strings.Split(strings.TrimSpace(string(changes.Text())), "\n")
+	a.Equal(4, len(lines)).
+		Contains(lines[0], "GET /users query.a|b").
+		Contains(lines[0], "number").
+		Contains(lines[1], "mimetype").
+		Contains(lines[2], "PUT /users")
+
+	data, err := changes.JSON()
+	a.NotError(err)
+	obj := map[string]interface{}{}
+	a.NotError(json.Unmarshal(data, &obj))
+	a.Equal(obj["breaking"], 2).
+		Equal(obj["deprecation"], 0).
+		Equal(obj["nonBreaking"], 1)
+	items := obj["changes"].([]interface{})
+	a.Equal(3, len(items)).
+		Equal(items[1].(map[string]interface{})["level"], "breaking").
+		Equal(items[1].(map[string]interface{})["kind"], KindTypeChanged)
+
+	md := string(changes.Markdown())
+	a.Equal(2, strings.Count(md, "\n## ")).
+		Contains(md, "| GET /users | query.a\\|b |").
+		Contains(md, "|  | mimetype |")
+
+	a.Equal(1, len(strings.Split(strings.TrimSpace(string(Changes{}.Text())), "\n")))
+}
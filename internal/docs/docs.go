@@ -76,11 +76,28 @@ func Handler(folder core.URI, stylesheet bool) http.Handler {
 	}
 }
 
-func embeddedHandler(stylesheet bool) http.Handler {
+// Embedded 获取内嵌的文件内容
+//
+// name 为相对于 docs 目录的文件名，比如 v6/apidoc.css，文件不存在时返回 nil。
+func Embedded(name string) *FileInfo {
+	for _, info := range unpack() {
+		if info.Name == name {
+			return info
+		}
+	}
+	return nil
+}
+
+func unpack() []*FileInfo {
 	var fis []*FileInfo
 	if err := pack.Unpack(data, &fis); err != nil {
 		panic(fmt.Sprintf("解包资源文件时出错：%s", err))
 	}
+	return fis
+}
+
+func embeddedHandler(stylesheet bool) http.Handler {
+	fis := unpack()
 
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		pp := r.URL.Path
@@ -41,6 +41,18 @@ func TestStylesheetURL(t *testing.T) {
 	a.Equal(StylesheetURL("https://apidoc.tools"), "https://apidoc.tools/"+ast.MajorVersion+"/apidoc.xsl")
 }
 
+func TestEmbedded(t *testing.T) {
+	a := assert.New(t)
+
+	info := Embedded(ast.MajorVersion + "/apidoc.css")
+	a.NotNil(info).
+		Equal(info.ContentType, "text/css; charset=utf-8").
+		NotEmpty(info.Content)
+
+	a.NotNil(Embedded("icon.svg"))
+	a.Nil(Embedded("not-exists"))
+}
+
 func TestEmbeddedHandler(t *testing.T) {
 	a := assert.New(t)
 
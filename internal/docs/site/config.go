@@ -37,7 +37,7 @@ func (d *doc) buildConfigItem(parent string, f reflect.StructField) error {
 	}
 
 	typeName := t.Kind().String()
-	if t.Kind() == reflect.Struct {
+	if t.Kind() == reflect.Struct || t.Kind() == reflect.Map {
 		typeName = "object"
 	}
 
@@ -49,7 +49,7 @@ func (d *doc) buildConfigItem(parent string, f reflect.StructField) error {
 		Usage:    locale.Sprintf("usage-config-" + name),
 	})
 
-	if isPrimitive(t) {
+	if isPrimitive(t) || t.Kind() == reflect.Map { // map 的键名不固定，无法列出其字段
 		return nil
 	} else if t.Kind() != reflect.Struct {
 		panic(fmt.Sprintf("字段 %s 的类型 %s 无法处理", f.Name, t.Kind()))
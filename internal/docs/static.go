@@ -2,4 +2,4 @@
 
 package docs
 
-const data = `Df+DAgEC/4QAAf+CAAAx/4EDAQL/ggABAwEETmFtZQEMAAELQ29udGVudFR5cGUBDAABB0NvbnRlbnQBCgAAAP0B+Dv/hAAPARFleGFtcGxlL2luZGV4LnhtbAEeYXBwbGljYXRpb24veG1sOyBjaGFyc2V0PXV0Zi04Af4i9zw/eG1sIHZlcnNpb249IjEuMCIgZW5jb2Rpbmc9IlVURi04Ij8+Cgo8P3htbC1zdHlsZXNoZWV0IHR5cGU9InRleHQveHNsIiBocmVmPSIuLi92Ni9hcGlkb2MueHNsIj8+CjxhcGlkb2MgYXBpZG9jPSI2LjEuMCIgY3JlYXRlZD0iMjAyMC0wOC0yNVQwMjo0MDozOCswODowMCIgdmVyc2lvbj0iMS4xLjEiPgoJPHRpdGxlPuekuuS+i+aWh+ahozwvdGl0bGU+Cgk8ZGVzY3JpcHRpb24gdHlwZT0iaHRtbCI+PCFbQ0RBVEFbCiAgICAgICA8cD7ov5nmmK/kuIDkuKrnlKjkuo7mtYvor5XnmoTmlofmoaPnlKjkvos8L3A+CiAgICAgICDnirbmgIHnoIHvvJoKICAgICAgIDx1bD4KICAgICAgICAgICA8bGk+NDAzMDA6eHh4PC9saT4KICAgICAgICAgICA8bGk+NDAzMDE6eHh4PC9saT4KICAgICAgIDwvdWw+CiAgIF1dPjwvZGVzY3JpcHRpb24+Cgk8Y29udGFjdCBuYW1lPSJuYW1lIj4KCQk8dXJsPmh0dHBzOi8vZXhhbXBsZS5jb208L3VybD4KCQk8ZW1haWw+ZXhhbXBsZUBleGFtcGxlLmNvbTwvZW1haWw+Cgk8L2NvbnRhY3Q+Cgk8bGljZW5zZSB0ZXh0PSJNSVQiIHVybD0iaHR0cHM6Ly9vcGVuc291cmNlLm9yZy9saWNlbnNlcy9NSVQiPjwvbGljZW5zZT4KCTx0YWcgbmFtZT0idDEiIHRpdGxlPSLmoIfnrb4xIj48L3RhZz4KCTx0YWcgbmFtZT0idDIiIHRpdGxlPSLmoIfnrb4yIj48L3RhZz4KCTxzZXJ2ZXIgbmFtZT0iYWRtaW4iIHVybD0iaHR0cHM6Ly9hcGkuZXhhbXBsZS5jb20vYWRtaW4iPgoJCTxkZXNjcmlwdGlvbiB0eXBlPSJodG1sIj48IVtDREFUQVsKICAgICAgIOWQjuWPsOeuoeeQhuaOpeWPo++8jDxiciAvPjxiciAvPjxiciAvPjxiciAvPjxwIHN0eWxlPSJjb2xvcjpyZWQiPmFkbWluPC9wPgogICAgICAgXV0+PC9kZXNjcmlwdGlvbj4KCTwvc2VydmVyPgoJPHNlcnZlciBuYW1lPSJvbGQtY2xpZW50IiB1cmw9Imh0dHBzOi8vYXBpLmV4YW1wbGUuY29tL2NsaWVudCIgZGVwcmVjYXRlZD0iMS4xLjEiIHN1bW1hcnk9IuWuouaIt+err+aOpeWPoyI+PC9zZXJ2ZXI+Cgk8c2VydmVyIG5hbWU9ImNsaWVudCIgdXJsPSJodHRwczovL2FwaS5leGFtcGxlLmNvbSIgc3VtbWFyeT0i5a6i5oi356uv5o6l5Y+jIj48L3NlcnZlcj4KCTxhcGkgbWV0aG9kPSJHRVQiIHN1bW1hcnk9IuiOt+WPlueUqOaItyIgZGVwcmVjYXRlZD0iMS4xLjExIj4KCQk8cGF0aCBwYXRoPSIvdXNlcnMiPgoJCQk8cXVlcnkgbmFtZT0icGFnZSIgdHlwZT0ibnVtYmVyIiBkZWZhdWx0PSIwIiBzdW1tYXJ5PSLpobXnoIEiPjwvcXVlcnk+CgkJCTxxdWVyeSBuYW1lPSJzaXplIiB0eXBlPSJudW1iZXIiIGRlZmF1bHQ9IjIwIj4KCQkJCTxkZXNjcmlwdGlvbiB0eXBlPSJtYXJrZG93biI+PCFbQ0RBVEFb5pWw6YePXV0+PC9kZXNjcmlwdGlvbj4KCQkJPC9xdWVyeT4KCQk8L3BhdGg+CgkJPGRlc2NyaXB0aW9uIHR5cGU9Im1hcmtkb3duIj48IVtDREFUQVsKICAgPHA+6L+Z5piv5YWz5LqO5o6l5Y+j55qE6K+m57uG6K+05piO5paH5qGjPC9wPjxiciAvPgogICDlj6/ku6XmmK/kuIDkuKogSFRNTCDlhoXlrrkKICAgXV0+PC9kZXNjcmlwdGlvbj4KCQk8cmVzcG9uc2UgbmFtZT0idXNlciIgdHlwZT0ib2JqZWN0IiBhcnJheT0idHJ1ZSIgc3RhdHVzPSIyMDAiPgoJCQk8cGFyYW0geG1sLWF0dHI9InRydWUiIG5hbWU9ImNvdW50IiB0eXBlPSJudW1iZXIiIG9wdGlvbmFsPSJmYWxzZSIgc3VtbWFyeT0ic3VtbWFyeSI+PC9wYXJhbT4KCQkJPHBhcmFtIHhtbC13cmFwcGVkPSJ1c2VycyIgbmFtZT0idXNlciIgdHlwZT0ib2JqZWN0IiBhcnJheT0idHJ1ZSIgc3VtbWFyeT0ibGlzdCI+CgkJCQk8cGFyYW0geG1sLWF0dHI9InRydWUiIG5hbWU9ImlkIiB0eXBlPSJudW1iZXIiIHN1bW1hcnk9IueUqOaItyBJRCI+PC9wYXJhbT4KCQkJCTxwYXJhbSB4bWwtYXR0cj0idHJ1ZSIgbmFtZT0ibmFtZSIgdHlwZT0ic3RyaW5nIiBzdW1tYXJ5PSLnlKjmiLflkI0iPjwvcGFyYW0+CgkJCQk8cGFyYW0gbmFtZT0iZ3JvdXBzIiB0eXBlPSJvYmplY3QiIG9wdGlvbmFsPSJ0cnVlIiBhcnJheT0idHJ1ZSIgc3VtbWFyeT0i55So5oi35omA5Zyo55qE5p2D6ZmQ57uEIj4KCQkJCQk8cGFyYW0gbmFtZT0iaWQiIHR5cGU9InN0cmluZyIgc3VtbWFyeT0i5p2D6ZmQ57uEIElEIj48L3BhcmFtPgoJCQkJCTxwYXJhbSBuYW1lPSJuYW1lIiB0eXBlPSJzdHJpbmciIHN1bW1hcnk9Iuadg+mZkOe7hOWQjeensCI+PC9wYXJhbT4KCQkJCTwvcGFyYW0+CgkJCQk8ZGVzY3JpcHRpb24gdHlwZT0iaHRtbCI+PCFbQ0RBVEFbPHNwYW4gc3R5bGU9ImNvbG9yOnJlZCI+bGlzdCBkZXNjcmlwdGlvbjwvc3Bhbj5dXT48L2Rlc2NyaXB0aW9uPgoJCQk8L3BhcmFtPgoJCTwvcmVzcG9uc2U+CgkJPGhlYWRlciBuYW1lPSJuYW1lIiB0eXBlPSJzdHJpbmciPgoJCQk8ZGVzY3JpcHRpb24gdHlwZT0ibWFya2Rvd24iPjwhW0NEQVRBW2Rlc2NdXT48L2Rlc2NyaXB0aW9uPgoJCTwvaGVhZGVyPgoJCTxoZWFkZXIgbmFtZT0ibmFtZTEiIHR5cGU9InN0cmluZyIgc3VtbWFyeT0ibmFtZTEgZGVzYyI+PC9oZWFkZXI+CgkJPHRhZz50MTwvdGFnPgoJCTx0YWc+dDI8L3RhZz4KCQk8c2VydmVyPmFkbWluPC9zZXJ2ZXI+Cgk8L2FwaT4KCTxhcGkgbWV0aG9kPSJQT1NUIiBzdW1tYXJ5PSLmt7vliqDnlKjmiLciPgoJCTxwYXRoIHBhdGg9Ii91c2VycyI+PC9wYXRoPgoJCTxkZXNjcmlwdGlvbiB0eXBlPSJtYXJrZG93biI+PCFbQ0RBVEFbCiAgIOi/meaYr+WFs+S6juaOpeWPo+eahOivpue7huivtOaYjuaWh+ahozxiciAvPgogICDlj6/ku6XmmK/kuIDkuKogSFRNTCDlhoXlrrkKICAgXV0+PC9kZXNjcmlwdGlvbj4KCQk8cmVxdWVzdCB0eXBlPSJvYmplY3QiPgoJCQk8cGFyYW0gbmFtZT0iY291bnQiIHR5cGU9Im51bWJlciIgb3B0aW9uYWw9ImZhbHNlIiBzdW1tYXJ5PSJzdW1tYXJ5Ij48L3BhcmFtPgoJCQk8cGFyYW0gbmFtZT0ibGlzdCIgdHlwZT0ib2JqZWN0IiBhcnJheT0idHJ1ZSIgc3VtbWFyeT0ibGlzdCI+CgkJCQk8cGFyYW0gbmFtZT0iaWQiIHR5cGU9Im51bWJlciIgc3VtbWFyeT0i55So5oi3IElEIj48L3BhcmFtPgoJCQkJPHBhcmFtIG5hbWU9Im5hbWUiIHR5cGU9InN0cmluZyIgc3VtbWFyeT0i55So5oi35ZCNIj48L3BhcmFtPgoJCQkJPHBhcmFtIG5hbWU9Imdyb3VwcyIgdHlwZT0ib2JqZWN0IiBvcHRpb25hbD0idHJ1ZSIgYXJyYXk9InRydWUiIHN1bW1hcnk9IueUqOaIt+aJgOWcqOeahOadg+mZkOe7hCI+CgkJCQkJPHBhcmFtIG5hbWU9ImlkIiB0eXBlPSJzdHJpbmciIHN1bW1hcnk9Iuadg+mZkOe7hCBJRCI+PC9wYXJhbT4KCQkJCQk8cGFyYW0gbmFtZT0ibmFtZSIgdHlwZT0ic3RyaW5nIiBzdW1tYXJ5PSLmnYPpmZDnu4TlkI3np7AiPjwvcGFyYW0+CgkJCQk8L3BhcmFtPgoJCQk8L3BhcmFtPgoJCQk8aGVhZGVyIG5hbWU9ImNvbnRlbnQtdHlwZSIgdHlwZT0ic3RyaW5nIiBzdW1tYXJ5PSJhcHBsaWNhdGlvbi9qc29uIj48L2hlYWRlcj4KCQk8L3JlcXVlc3Q+CgkJPHJlcXVlc3QgbmFtZT0idXNlcnMiIHR5cGU9Im9iamVjdCIgbWltZXR5cGU9ImFwcGxpY2F0aW9uL3htbCI+CgkJCTxwYXJhbSBuYW1lPSJjb3VudCIgdHlwZT0ibnVtYmVyIiBvcHRpb25hbD0iZmFsc2UiIHN1bW1hcnk9InN1bW1hcnkiPjwvcGFyYW0+CgkJCTxwYXJhbSBuYW1lPSJsaXN0IiB0eXBlPSJvYmplY3QiIGFycmF5PSJ0cnVlIiBzdW1tYXJ5PSJsaXN0Ij4KCQkJCTxwYXJhbSBuYW1lPSJpZCIgdHlwZT0ibnVtYmVyIiBzdW1tYXJ5PSLnlKjmiLcgSUQiPjwvcGFyYW0+CgkJCQk8cGFyYW0gbmFtZT0ibmFtZSIgdHlwZT0ic3RyaW5nIiBzdW1tYXJ5PSLnlKjmiLflkI0iPjwvcGFyYW0+CgkJCQk8cGFyYW0gbmFtZT0iZ3JvdXBzIiB0eXBlPSJvYmplY3QiIG9wdGlvbmFsPSJ0cnVlIiBhcnJheT0idHJ1ZSIgc3VtbWFyeT0i55So5oi35omA5Zyo55qE5p2D6ZmQ57uEIj4KCQkJCQk8cGFyYW0gbmFtZT0iaWQiIHR5cGU9InN0cmluZyIgc3VtbWFyeT0i5p2D6ZmQ57uEIElEIj48L3BhcmFtPgoJCQkJCTxwYXJhbSBuYW1lPSJuYW1lIiB0eXBlPSJzdHJpbmciIHN1bW1hcnk9Iuadg+mZkOe7hOWQjeensCI+PC9wYXJhbT4KCQkJCTwvcGFyYW0+CgkJCTwvcGFyYW0+CgkJCTxleGFtcGxlIG1pbWV0eXBlPSJhcHBsaWNhdGlvbi94bWwiPjwhW0NEQVRBWwo8dXNlcnMgY291bnQ9IjIwIj4KICAgIDx1c2VyIGlkPSIyMCIgbmFtZT0ieHgiPjwvdXNlcj4KICAgIDx1c2VyIGlkPSIyMSIgbmFtZT0ieHgiPjwvdXNlcj4KPC91c2Vycz4KICAgICAgICAgICBdXT48L2V4YW1wbGU+CgkJPC9yZXF1ZXN0PgoJCTxyZXNwb25zZSBhcnJheT0idHJ1ZSIgc3RhdHVzPSIyMDAiIG1pbWV0eXBlPSJhcHBsaWNhdGlvbi9qc29uIj48L3Jlc3BvbnNlPgoJCTxoZWFkZXIgbmFtZT0ibmFtZSIgdHlwZT0ic3RyaW5nIj4KCQkJPGRlc2NyaXB0aW9uIHR5cGU9Im1hcmtkb3duIj48IVtDREFUQVtkZXNjXV0+PC9kZXNjcmlwdGlvbj4KCQk8L2hlYWRlcj4KCQk8aGVhZGVyIG5hbWU9Im5hbWUxIiB0eXBlPSJzdHJpbmciIHN1bW1hcnk9Im5hbWUxIGRlc2MiPjwvaGVhZGVyPgoJCTx0YWc+dDI8L3RhZz4KCQk8c2VydmVyPmFkbWluPC9zZXJ2ZXI+CgkJPHNlcnZlcj5vbGQtY2xpZW50PC9zZXJ2ZXI+Cgk8L2FwaT4KCTxhcGkgbWV0aG9kPSJERUxFVEUiIHN1bW1hcnk9IuWIoOmZpOeUqOaItyI+CgkJPHBhdGggcGF0aD0iL3VzZXJzL3tpZH0iPgoJCQk8cGFyYW0gbmFtZT0iaWQiIHR5cGU9Im51bWJlciIgc3VtbWFyeT0i55So5oi3IElEIj48L3BhcmFtPgoJCTwvcGF0aD4KCQk8ZGVzY3JpcHRpb24gdHlwZT0ibWFya2Rvd24iPjwhW0NEQVRBWwogICDov5nmmK/lhbPkuo7mjqXlj6PnmoTor6bnu4bor7TmmI7mlofmoaM8YnIgLz4KICAg5Y+v5Lul5piv5LiA5LiqIEhUTUwg5YaF5a65PGJyIC8+CiAgIF1dPjwvZGVzY3JpcHRpb24+CgkJPHNlcnZlcj5hZG1pbjwvc2VydmVyPgoJPC9hcGk+Cgk8YXBpIG1ldGhvZD0iR0VUIiBzdW1tYXJ5PSLojrflj5bnlKjmiLfor6bmg4UiPgoJCTxwYXRoIHBhdGg9Ii91c2Vycy97aWR9Ij4KCQkJPHBhcmFtIG5hbWU9ImlkIiB0eXBlPSJudW1iZXIiIHN1bW1hcnk9IueUqOaItyBJRCI+PC9wYXJhbT4KCQkJPHF1ZXJ5IG5hbWU9InN0YXRlIiB0eXBlPSJzdHJpbmciIGRlZmF1bHQ9Iltub3JtYWwsbG9ja10iIGFycmF5PSJ0cnVlIiBzdW1tYXJ5PSJzdGF0ZSI+CgkJCQk8ZW51bSB2YWx1ZT0ibm9ybWFsIiBzdW1tYXJ5PSLmraPluLgiPjwvZW51bT4KCQkJCTxlbnVtIHZhbHVlPSJsb2NrIj4KCQkJCQk8ZGVzY3JpcHRpb24gdHlwZT0iaHRtbCI+PCFbQ0RBVEFbPHNwYW4gc3R5bGU9ImNvbG9yOnJlZCI+6ZSB5a6aPC9zcGFuPl1dPjwvZGVzY3JpcHRpb24+CgkJCQk8L2VudW0+CgkJCTwvcXVlcnk+CgkJPC9wYXRoPgoJCTxkZXNjcmlwdGlvbiB0eXBlPSJtYXJrZG93biI+PCFbQ0RBVEFbCiAgIOi/meaYr+WFs+S6juaOpeWPo+eahOivpue7huivtOaYjuaWh+ahowogICDlj6/ku6XmmK/kuIDkuKogSFRNTCDlhoXlrrkKICAgXV0+PC9kZXNjcmlwdGlvbj4KCQk8cmVzcG9uc2UgdHlwZT0ib2JqZWN0IiBhcnJheT0idHJ1ZSIgc3RhdHVzPSIyMDAiIG1pbWV0eXBlPSJhcHBsaWNhdGlvbi9qc29uIj4KCQkJPHBhcmFtIG5hbWU9ImlkIiB0eXBlPSJudW1iZXIiIHN1bW1hcnk9IueUqOaItyBJRCI+PC9wYXJhbT4KCQkJPHBhcmFtIG5hbWU9Im5hbWUiIHR5cGU9InN0cmluZyIgc3VtbWFyeT0i55So5oi35ZCNIj48L3BhcmFtPgoJCQk8cGFyYW0gbmFtZT0iZ3JvdXBzIiB0eXBlPSJvYmplY3QiIG9wdGlvbmFsPSJ0cnVlIiBzdW1tYXJ5PSLnlKjmiLfmiYDlnKjnmoTmnYPpmZDnu4QiPgoJCQkJPHBhcmFtIG5hbWU9ImlkIiB0eXBlPSJzdHJpbmciIHN1bW1hcnk9Iuadg+mZkOe7hCBJRCI+PC9wYXJhbT4KCQkJCTxwYXJhbSBuYW1lPSJuYW1lIiB0eXBlPSJzdHJpbmciIHN1bW1hcnk9Iuadg+mZkOe7hOWQjeensCI+PC9wYXJhbT4KCQkJPC9wYXJhbT4KCQk8L3Jlc3BvbnNlPgoJCTxzZXJ2ZXI+b2xkLWNsaWVudDwvc2VydmVyPgoJPC9hcGk+Cgk8YXBpIG1ldGhvZD0iR0VUIiBzdW1tYXJ5PSLojrflj5bnlKjmiLfml6Xlv5ciPgoJCTxwYXRoIHBhdGg9Ii91c2Vycy97aWR9L2xvZ3MiPgoJCQk8cGFyYW0gbmFtZT0iaWQiIHR5cGU9Im51bWJlciI+CgkJCQk8ZGVzY3JpcHRpb24gdHlwZT0ibWFya2Rvd24iPjwhW0NEQVRBW+eUqOaItyBJRF1dPjwvZGVzY3JpcHRpb24+CgkJCTwvcGFyYW0+CgkJCTxxdWVyeSBuYW1lPSJwYWdlIiB0eXBlPSJudW1iZXIiIGRlZmF1bHQ9IjAiIHN1bW1hcnk9IumhteeggSI+PC9xdWVyeT4KCQkJPHF1ZXJ5IG5hbWU9InNpemUiIHR5cGU9Im51bWJlciIgZGVmYXVsdD0iMjAiPgoJCQkJPGRlc2NyaXB0aW9uIHR5cGU9Im1hcmtkb3duIj48IVtDREFUQVvmlbDph49dXT48L2Rlc2NyaXB0aW9uPgoJCQk8L3F1ZXJ5PgoJCTwvcGF0aD4KCQk8ZGVzY3JpcHRpb24gdHlwZT0iaHRtbCI+PCFbQ0RBVEFbCiAgIDxwPui/meaYr+WFs+S6juaOpeWPo+eahOivpue7huivtOaYjuaWh+ahozwvcD4KICAgPHAgc3R5bGU9ImNvbG9yOnJlZCI+5Y+v5Lul5piv5LiA5LiqIEhUTUwg5YaF5a65PC9wPgogICBdXT48L2Rlc2NyaXB0aW9uPgoJCTxyZXNwb25zZSB0eXBlPSJvYmplY3QiIGFycmF5PSJ0cnVlIiBzdGF0dXM9IjIwMCIgbWltZXR5cGU9ImFwcGxpY2F0aW9uL2pzb24iPgoJCQk8cGFyYW0gbmFtZT0iY291bnQiIHR5cGU9Im51bWJlciIgb3B0aW9uYWw9InRydWUiIHN1bW1hcnk9InN1bW1hcnkiPjwvcGFyYW0+CgkJCTxwYXJhbSBuYW1lPSJsaXN0IiB0eXBlPSJvYmplY3QiIGFycmF5PSJ0cnVlIiBzdW1tYXJ5PSJsaXN0Ij4KCQkJCTxwYXJhbSBuYW1lPSJpZCIgdHlwZT0ibnVtYmVyIiBvcHRpb25hbD0idHJ1ZSIgc3VtbWFyeT0iZGVzYyI+PC9wYXJhbT4KCQkJCTxwYXJhbSBuYW1lPSJuYW1lIiB0eXBlPSJzdHJpbmciIG9wdGlvbmFsPSJ0cnVlIiBzdW1tYXJ5PSJkZXNjIj48L3BhcmFtPgoJCQkJPHBhcmFtIG5hbWU9Imdyb3VwcyIgdHlwZT0ic3RyaW5nIiBvcHRpb25hbD0idHJ1ZSIgYXJyYXk9InRydWUiIHN1bW1hcnk9ImRlc2MiPgoJCQkJCTxlbnVtIHZhbHVlPSJ4eDEiPgoJCQkJCQk8ZGVzY3JpcHRpb24gdHlwZT0ibWFya2Rvd24iPjwhW0NEQVRBW3h4XV0+PC9kZXNjcmlwdGlvbj4KCQkJCQk8L2VudW0+CgkJCQkJPGVudW0gdmFsdWU9Inh4MiIgc3VtbWFyeT0ieHgiPjwvZW51bT4KCQkJCTwvcGFyYW0+CgkJCTwvcGFyYW0+CgkJCTxleGFtcGxlIG1pbWV0eXBlPSJhcHBsaWNhdGlvbi9qc29uIj48IVtDREFUQVsKewogY291bnQ6IDUsCiBsaXN0OiBbCiAgIHtpZDoxLCBuYW1lOiAnbmFtZTEnLCAnZ3JvdXBzJzogWzEsMl19LAogICB7aWQ6MiwgbmFtZTogJ25hbWUyJywgJ2dyb3Vwcyc6IFsxLDJdfQogXQp9CiAgICAgICAgXV0+PC9leGFtcGxlPgoJCQk8aGVhZGVyIG5hbWU9Im5hbWUiIHR5cGU9InN0cmluZyIgc3VtbWFyeT0iZGVzYyI+PC9oZWFkZXI+CgkJCTxoZWFkZXIgbmFtZT0ibmFtZTEiIHR5cGU9InN0cmluZyIgc3VtbWFyeT0iZGVzYzEiPjwvaGVhZGVyPgoJCTwvcmVzcG9uc2U+CgkJPGNhbGxiYWNrIG1ldGhvZD0iUE9TVCIgc3VtbWFyeT0i5Zue6LCD5Ye95pWwIj4KCQkJPGRlc2NyaXB0aW9uIHR5cGU9Imh0bWwiPjwhW0NEQVRBWwogICAgICAgICAgIDxwIHN0eWxlPSJjb2xvcjpyZWQiPui/meaYr+S4gOS4quWbnuiwg+WHveaVsOeahOivpue7huivtOaYjjwvcD4KICAgICAgICAgICA8cD7kuLrkuIDkuKogaHRtbCDmlofmoaM8L3A+CiAgIF1dPjwvZGVzY3JpcHRpb24+CgkJCTxyZXNwb25zZSB0eXBlPSJzdHJpbmciIHN0YXR1cz0iMjAwIiBtaW1ldHlwZT0idGV4dC9wbGFpbiI+PC9yZXNwb25zZT4KCQkJPHJlcXVlc3QgdHlwZT0ib2JqZWN0IiBtaW1ldHlwZT0iYXBwbGljYXRpb24vanNvbiI+CgkJCQk8cGFyYW0gbmFtZT0iaWQiIHR5cGU9Im51bWJlciIgZGVmYXVsdD0iMSIgc3VtbWFyeT0iaWQiPjwvcGFyYW0+CgkJCQk8cGFyYW0gbmFtZT0iYWdlIiB0eXBlPSJudW1iZXIiIHN1bW1hcnk9ImFnZSI+PC9wYXJhbT4KCQkJCTxleGFtcGxlIG1pbWV0eXBlPSJhcHBsaWNhdGlvbi9qc29uIj48IVtDREFUQVsKewogICAgaWQ6MSwKICAgIHNleDogbWFsZSwKfQpdXT48L2V4YW1wbGU+CgkJCTwvcmVxdWVzdD4KCQk8L2NhbGxiYWNrPgoJCTxzZXJ2ZXI+Y2xpZW50PC9zZXJ2ZXI+CgkJPHNlcnZlcj5hZG1pbjwvc2VydmVyPgoJPC9hcGk+Cgk8aGVhZGVyIG5hbWU9IkF1dGhvcml6YXRpb24iIHR5cGU9InN0cmluZyIgc3VtbWFyeT0idG9rZW4g5YC8Ij48L2hlYWRlcj4KCTxoZWFkZXIgbmFtZT0iQWNjZXB0IiB0eXBlPSJzdHJpbmciIHN1bW1hcnk9IuiDveaOpeWPl+eahOWtl+espumbhiI+PC9oZWFkZXI+Cgk8cmVzcG9uc2UgbmFtZT0icmVzdWx0IiB0eXBlPSJvYmplY3QiIHN0YXR1cz0iNDAwIj4KCQk8cGFyYW0geG1sLWF0dHI9InRydWUiIG5hbWU9ImNvZGUiIHR5cGU9Im51bWJlciIgb3B0aW9uYWw9ImZhbHNlIiBzdW1tYXJ5PSLnirbmgIHnoIEiPjwvcGFyYW0+CgkJPHBhcmFtIG5hbWU9Im1lc3NhZ2UiIHR5cGU9InN0cmluZyIgb3B0aW9uYWw9ImZhbHNlIiBzdW1tYXJ5PSLplJnor6/kv6Hmga8iPjwvcGFyYW0+CgkJPHBhcmFtIG5hbWU9ImRldGFpbCIgdHlwZT0ib2JqZWN0IiBhcnJheT0idHJ1ZSIgc3VtbWFyeT0i6ZSZ6K+v5piO57uGIj4KCQkJPHBhcmFtIG5hbWU9ImlkIiB0eXBlPSJzdHJpbmciIHN1bW1hcnk9ImlkIj48L3BhcmFtPgoJCQk8cGFyYW0gbmFtZT0ibWVzc2FnZSIgdHlwZT0ic3RyaW5nIiBzdW1tYXJ5PSJtZXNzYWdlIj48L3BhcmFtPgoJCTwvcGFyYW0+Cgk8L3Jlc3BvbnNlPgoJPHJlc3BvbnNlIHN1bW1hcnk9Im5vdCBmb3VuZCIgc3RhdHVzPSI0MDQiPjwvcmVzcG9uc2U+Cgk8bWltZXR5cGU+YXBwbGljYXRpb24veG1sPC9taW1ldHlwZT4KCTxtaW1ldHlwZT5hcHBsaWNhdGlvbi9qc29uPC9taW1ldHlwZT4KPC9hcGlkb2M+AAEIaWNvbi5zdmcBHGltYWdlL3N2Zyt4bWw7IGNoYXJzZXQ9dXRmLTgB/gHgPD94bWwgdmVyc2lvbj0iMS4wIiBlbmNvZGluZz0idXRmLTgiID8+Cgo8c3ZnIHZpZXdCb3g9IjAgMCA1MTIgNTEyIiB4bWxucz0iaHR0cDovL3d3dy53My5vcmcvMjAwMC9zdmciIHN0cm9rZT0iI2Y2MCI+CiAgICA8dGl0bGU+YXBpZG9jPC90aXRsZT4KICAgIDxkZXNjPmh0dHBzOi8vYXBpZG9jLnRvb2xzIExPR088L2Rlc2M+CiAgICA8ZGVzYz7ov5nmmK/kuIDlj6ror57nlJ/kuo7nvorlubTnmoTlsI/lt6Xlhbc8L2Rlc2M+CgogICAgPGNpcmNsZSBjeD0iMjU2IiBjeT0iMjU2IiByPSIyNDAiIGZpbGwtb3BhY2l0eT0iMCIgc3Ryb2tlLXdpZHRoPSIzMiIgLz4KICAgIDxjaXJjbGUgY3g9IjUzMCIgY3k9IjM4NSIgcj0iMzAwIiBmaWxsLW9wYWNpdHk9IjAiIHN0cm9rZS13aWR0aD0iMzIiIC8+CiAgICA8Y2lyY2xlIGN4PSItMTUiIGN5PSIzODUiIHI9IjMwMCIgZmlsbC1vcGFjaXR5PSIwIiBzdHJva2Utd2lkdGg9IjMyIiAvPgo8L3N2Zz4KAAESaW5kZXguY21uLUhhbnQueG1sAR5hcHBsaWNhdGlvbi94bWw7IGNoYXJzZXQ9dXRmLTgB/h0kPD94bWwgdmVyc2lvbj0iMS4wIiBlbmNvZGluZz0idXRmLTgiPz4KPD94bWwtc3R5bGVzaGVldCB0eXBlPSJ0ZXh0L3hzbCIgaHJlZj0iLi9pbmRleC54c2wiPz4KCjwhLS0K6YCZ5piv5a6Y57ay6aaW6aCB5YWn5a6577yM5ZCM5pmC5Lmf5piv57Ch6auU5Lit5paH55qE5pys5Zyw5YyW5YWn5a6544CCCgrlhbblroPoqp7oqIDnmoTmnKzljJblnLDlhaflrrnvvIzpnIDopoHph43mlrDmlLnlr6vmnKzmlofku7bkuK3pmaToqLvph4vlpJbnmoTmiYDmnInlhaflrrnjgIIKLS0+Cgo8ZG9jcyBsYW5nPSJjbW4tSGFudCI+CiAgICA8dGl0bGU+YXBpZG9jIHwgUkVTVGZ1bCBBUEkg5paH5qqU6JmV55CG5bel5YW3PC90aXRsZT4KICAgIDxsaWNlbnNlIHVybD0iaHR0cHM6Ly9jcmVhdGl2ZWNvbW1vbnMub3JnL2xpY2Vuc2VzL2J5LzQuMC9kZWVkLnpoIj7nvbLlkI0gNC4wIOWci+mamyAoQ0MgQlkgNC4wKTwvbGljZW5zZT4KCiAgICA8IS0tIOWjueS6m+e/u+itr+avlOi8g+mbtuaVo+eahOe/u+itr+WFp+WuuSAtLT4KICAgIDxsb2NhbGVzPgogICAgICAgIDxoZWFkZXI+IDwhLS0g6KGo5qC85aCx6aCtIC0tPgogICAgICAgICAgICA8bmFtZT7lkI3nqLE8L25hbWU+CiAgICAgICAgICAgIDx0eXBlPumhnuWeizwvdHlwZT4KICAgICAgICAgICAgPHJlcXVpcmVkPuW/heWhqzwvcmVxdWlyZWQ+CiAgICAgICAgICAgIDxkZXNjcmlwdGlvbj7mj4/ov7A8L2Rlc2NyaXB0aW9uPgogICAgICAgIDwvaGVhZGVyPgogICAgICAgIDxnb3RvLXRvcD7ov5Tlm57poILpg6g8L2dvdG8tdG9wPgogICAgPC9sb2NhbGVzPgoKICAgIDxkb2MgaWQ9ImFib3V0IiB0aXRsZT0i6Zec5pa8Ij4KICAgICAgICA8cD5hcGlkb2Mg5piv5aO55YCL57Ch5Zau55qEIDxhYmJyIHRpdGxlPSJSZXByZXNlbnRhdGlvbmFsIFN0YXRlIFRyYW5zZmVyIj5SRVNUZnVsPC9hYmJyPiA8YWJiciB0aXRsZT0iQXBwbGljYXRpb24gUHJvZ3JhbW1pbmcgSW50ZXJmYWNlIj5BUEk8L2FiYnI+IOaWh+aqlOeUn+aIkOW3peWFt++8jOWug+W+nuS7o+eivOiou+mHi+S4reaPkOWPlueJueWumuagvOW8j+eahOWFp+WuueeUn+aIkOaWh+aqlOOAguaUr+aMgeiruOWmgiBHb+OAgUphdmHjgIFDKyvjgIFSdXN0IOetieWkp+mDqOWIhumWi+eZvOiqnuiogO+8jOWFt+mrlOWPr+S9v+eUqCA8Y29kZT5hcGlkb2MgbGFuZzwvY29kZT4g5ZG95Luk6KGM5p+l55yL5omA5pyJ55qE5pSv5oyB5YiX6KGo44CCPC9wPgoKICAgICAgICA8cD5hcGlkb2Mg5pOB5pyJ5Lul5LiL54m56bue77yaPC9wPgogICAgICAgIDxvbD4KICAgICAgICAgICAgPGxpPui3qOW5s+iHuu+8jGxpbnV444CBd2luZG93c+OAgW1hY09TIOetiemDveaUr+aMge+8mzwvbGk+CiAgICAgICAgICAgIDxsaT7mlK/mjIHoqp7oqIDlu6Pms5vvvIzljbPkvb/mmK/kuI3mlK/mjIHvvIzkuZ/lvojmlrnkvr/mk7TlsZXvvJs8L2xpPgogICAgICAgICAgICA8bGk+5pSv5oyB5aSa5YCL5LiN5ZCM6Kqe6KiA55qE5aSa5YCL6aCF55uu55Sf5oiQ5aO55Lu95paH5qqU77ybPC9saT4KICAgICAgICAgICAgPGxpPui8uOWHuuaooeadv+WPr+iHquWumue+qe+8mzwvbGk+CiAgICAgICAgICAgIDxsaT7moLnmk5rmlofmqpTnlJ/miJAgbW9jayDmlbjmk5rvvJs8L2xpPgogICAgICAgIDwvb2w+CgogICAgICAgIDxwPuS7peS4i+aYr+WjueauteewoeefreeahCBDIOiqnuiogOmiqOagvOS7o+eivOS4i+eahOekuuS+i++8mjwvcD4KICAgICAgICA8cHJlPjxjb2RlIGNsYXNzPSJsYW5ndWFnZS1tYXJrdXAiPjwhW0NEQVRBWy8qKgogKiA8YXBpIG1ldGhvZD0iR0VUIiBzdW1tYXJ5PSLnjbLlj5bmiYDmnInnmoTnlKjmiLbkv6Hmga8iPgogKiAgICAgPHBhdGggcGF0aD0iL3VzZXJzIj4KICogICAgICAgICA8cXVlcnkgbmFtZT0icGFnZSIgdHlwZT0ibnVtYmVyIiBkZWZhdWx0PSIwIj7poa/npLrnrKzlub7poIHnmoTlhaflrrk8L3F1ZXJ5PgogKiAgICAgICAgIDxxdWVyeSBuYW1lPSJzaXplIiB0eXBlPSJudW1iZXIiIGRlZmF1bHQ9IjIwIj7mr4/poIHpoa/npLrnmoTmlbjph488L3F1ZXJ5PgogKiAgICAgPC9wYXRoPgogKiAgICAgPHRhZz51c2VyPC90YWc+CiAqICAgICA8c2VydmVyPnVzZXJzPC9zZXJ2ZXI+CiAqICAgICA8cmVzcG9uc2Ugc3RhdHVzPSIyMDAiIHR5cGU9Im9iamVjdCIgbWltZXR5cGU9ImFwcGxpY2F0aW9uL2pzb24iPgogKiAgICAgICAgIDxwYXJhbSBuYW1lPSJjb3VudCIgdHlwZT0iaW50IiBvcHRpb25hbD0iZmFsc2UiIHN1bW1hcnk9IuespuWQiOaineS7tueahOaJgOacieeUqOaItuaVuOmHjyIgLz4KICogICAgICAgICA8cGFyYW0gbmFtZT0idXNlcnMiIHR5cGU9Im9iamVjdCIgYXJyYXk9InRydWUiIHN1bW1hcnk9IueUqOaItuWIl+ihqCI+CiAqICAgICAgICAgICAgIDxwYXJhbSBuYW1lPSJpZCIgdHlwZT0iaW50IiBzdW1tYXJ5PSLllK/lo7kgSUQiIC8+CiAqICAgICAgICAgICAgIDxwYXJhbSBuYW1lPSJuYW1lIiB0eXBlPSJzdHJpbmciIHN1bW1hcnk9IuWnk+WQjSIgLz4KICogICAgICAgICA8L3BhcmFtPgogKiAgICAgPC9yZXNwb25zZT4KICogICAgIDxyZXNwb25zZSBzdGF0dXM9IjUwMCIgbWltZXR5cGU9ImFwcGxpY2F0aW9uL2pzb24iIHR5cGU9Im9iamVjdCI+CiAqICAgICAgICAgPHBhcmFtIG5hbWU9ImNvZGUiIHR5cGU9ImludCIgc3VtbWFyeT0i6Yyv6Kqk5Luj56K8IiAvPgogKiAgICAgICAgIDxwYXJhbSBuYW1lPSJtc2ciIHR5cGU9InN0cmluZyIgc3VtbWFyeT0i6Yyv6Kqk5YWn5a65IiAvPgogKiAgICAgPC9yZXNwb25zZT4KICogPC9hcGk+CiAqL11dPjwvY29kZT48L3ByZT4KICAgIDwvZG9jPgoKICAgIDxkb2MgaWQ9InVzYWdlIiB0aXRsZT0i5L2/55SoIiAvPgoKICAgIDxkb2MgaWQ9InNwZWMiIHRpdGxlPSLmlofmqpTmoLzlvI8iPgogICAgICAgIDxwPuaWh+aqlOmHh+eUqCBYTUwg5qC85byP44CC5a2Y5Zyo5YWp5YCL6aCC57Sa5qiZ57C977yaPGNvZGU+YXBpZG9jPC9jb2RlPiDlkowgPGNvZGU+YXBpPC9jb2RlPu+8jOeUqOaWvOaPj+i/sOaVtOmrlOWFp+WuueWSjOWFt+mrlOaOpeWPo+S/oeaBr+OAgjwvcD4KCiAgICAgICAgPHA+5paH5qqU6KKr5b6e6Ki76YeL5Lit5o+Q5Y+W5LmL5b6M77yM5pyA57WC5pyD6KKr5ZCI5Lim5oiQ5aO55YCLIFhNTCDmlofku7bvvIzlnKjoqbLmlofku7bkuK0gPGNvZGU+YXBpPC9jb2RlPiDkvZzngrogPGNvZGU+YXBpZG9jPC9jb2RlPiDnmoTlo7nlgIvlrZDlhYPntKDlrZjlnKjvvIzlpoLmnpzlprPnmoTpoIXnm67kuI3mg7PmiormlofmqpTlr6vlnKjoqLvph4vkuK3vvIzkuZ/lj6/ku6Xnm7TmjqXnt6jlr6vlo7nlgIvlrozmlbTnmoQgWE1MIOaWh+S7tu+8jOWwhyA8Y29kZT5hcGk8L2NvZGU+IOS9nOeCuiA8Y29kZT5hcGlkb2M8L2NvZGU+IOeahOWjueWAi+WtkOWFg+e0oOOAgjwvcD4KCiAgICAgICAgPHA+5YW36auU5Y+v5Y+D6ICDPGEgaHJlZj0iLi9leGFtcGxlL2luZGV4LnhtbCI+56S65L6L5Luj56K844CCPC9hPjwvcD4KCiAgICAgICAgPHA+5Lul5LiL5piv5bCN5ZCE5YCLIFhNTCDlhYPntKDku6Xlj4rlj4Pmlbjku4vntLnvvIzlhbbkuK3ku6UgPGNvZGU+QDwvY29kZT4g6ZaL6aCt55qE6KGo56S6IFhNTCDlsazmgKfvvJs8Y29kZT4uPC9jb2RlPiDooajnpLrngrrnlbbliY3lhYPntKDnmoTlhaflrrnvvJvlhbblroPooajnpLrlrZDlhYPntKDjgII8L3A+CiAgICA8L2RvYz4KCiAgICA8IS0tIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyDku6XkuIvkuLrmlofmoaPlhoXlrrnnmoTlrZDpobkgIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjLS0+CgogICAgPGRvYyBpZD0iaW5zdGFsbCIgdGl0bGU9IuWuieijnSIgcGFyZW50PSJ1c2FnZSI+CiAgICAgICAgICA8cD7lj6/ku6Xnm7TmjqXlvp4gPGEgaHJlZj0iaHR0cHM6Ly9naXRodWIuY29tL2NhaXh3L2FwaWRvYy9yZWxlYXNlcyI+aHR0cHM6Ly9naXRodWIuY29tL2NhaXh3L2FwaWRvYy9yZWxlYXNlczwvYT4g5p+l5om+5aaz6ZyA6KaB55qE54mI5pys5LiL6LyJ77yM5pS+5YWlIDx2YXI+UEFUSDwvdmFyPiDkuK3ljbPlj6/kvb/nlKjjgILlpoLmnpzmspLmnInlprPpnIDopoHnmoTlubPoh7rmlofku7bvvIzliYfpnIDopoHlvp7mupDku6Pnorznt6jora/vvJo8L3A+CiAgICAgICAgPHVsPgogICAgICAgICAgICA8bGk+5LiL6LyJIDxhIGhyZWY9Imh0dHBzOi8vZ29sYW5nLm9yZy9kbC8iPkdvPC9hPu+8mzwvbGk+CiAgICAgICAgICAgIDxsaT7kuIvovInmupDku6PnorzvvIw8c2FtcD5naXQgY2xvbmUgZ2l0aHViLmNvbS9jYWl4dy9hcGlkb2M8L3NhbXA+77ybPC9saT4KICAgICAgICAgICAgPGxpPuWft+ihjOS7o+eivOS4rSA8Y29kZT5idWlsZC9idWlsZC5zaDwvY29kZT4g5oiW5pivIDxjb2RlPmJ1aWxkL2J1aWxkLmNtZDwvY29kZT4g6YCy6KGM57eo6K2v77ybPC9saT4KICAgICAgICAgICAgPGxpPue3qOitr+WlveeahOaWh+S7tuWtmOaUvuWcqCA8Y29kZT5jbWQvYXBpZG9jPC9jb2RlPiDkuIvvvIzlj6/ku6XlsIfoqbLmlofku7bmlL7nva7lnKggPHZhcj5QQVRIPC92YXI+IOebrumMhO+8mzwvbGk+CiAgICAgICAgPC91bD4KICAgIDwvZG9jPgoKICAgIDxkb2MgaWQ9ImVudiIgdGl0bGU9IueSsOWig+iuiumHjyIgcGFyZW50PSJ1c2FnZSI+CiAgICAgICAgPHA+YXBpZG9jIOacg+iugOWPliA8dmFyPkxBTkc8L3Zhcj4g55qE55Kw5aKD6K6K6YeP5L2c54K65YW25pys5Zyw5YyW55qE5L6d5pOa77yM6Iul5oOz5oyH5a6a5YW25a6D6Kqe56iu77yM5Y+v5Lul5omL5YuV5oyH5a6aIDx2YXI+TEFORzwvdmFyPiDnkrDlooPororph4/vvJo8c2FtcD5MQU5HPXpoLUhhbnQgYXBpZG9jPC9zYW1wPuOAguWcqCB3aW5kb3dzIOezu+e1seS4re+8jOiLpeS4jeWtmOWcqCA8dmFyPkxBTkc8L3Zhcj4g55Kw5aKD6K6K6YeP77yM5YmH5pyD6Kq/55SoIDxjb2RlPkdldFVzZXJEZWZhdWx0TG9jYWxlTmFtZTwvY29kZT4g5Ye95pW45L6G542y5Y+W55u45oeJ55qE6Kqe6KiA5L+h5oGv44CCPC9wPgogICAgICAgIDxwPuacrOWcsOWMlueahCBJRCDlgLzvvIznkIborrrkuIrlj6ropoEgPGEgaHJlZj0iaHR0cHM6Ly90b29scy5pZXRmLm9yZy9odG1sL2JjcDQ3Ij5CQ1A0NzwvYT4g5pSv5oyB55qE6YO96IO95q2j56Gu6K+G5Yir44CC5Lmf5pSv5oyBIExpbnV4IOS4i+W4pue8lueggeeahOagvOW8j++8jOavlOWmgu+8mjxzYW1wPnpoX0NOLlVURi04PC9zYW1wPuOAgjwvcD4KICAgIDwvZG9jPgoKICAgIDxkb2MgaWQ9ImlkZSIgdGl0bGU9IklERSDmj5Lku7YiIHBhcmVudD0idXNhZ2UiPgogICAgICAgIDxwPmFwaWRvYyDmlK/mjIHku6UgPGEgaHJlZj0iaHR0cHM6Ly9taWNyb3NvZnQuZ2l0aHViLmlvL2xhbmd1YWdlLXNlcnZlci1wcm90b2NvbC9zcGVjaWZpY2F0aW9ucy9zcGVjaWZpY2F0aW9uLWN1cnJlbnQvIj48YWJiciB0aXRsZT0ibGFuZ3VhZ2Ugc2VydmVyIHByb3RvY29sIj5MU1A8L2FiYnI+PC9hPiDmqKHlvI/pgYvooYzvvJo8c2FtcD5hcGlkb2MgbHNwIC1wPSI6ODA4MCIgLW09c3RkaW8gLWg8L3NhbXA+44CC5omA5pyJ5pSv5oyBIExTUCDnmoQgSURFIOWSjOe3qOi8r+WZqOW+iOWuueaYk+WvpuePvuWwjeaHieeahOaPkuS7tuOAguebruWJjeWumOaWueW3sue2k+aPkOS+m+S6humHneWwjSB2c2NvZGUg55qE5o+S5Lu277yaPGEgaHJlZj0iaHR0cHM6Ly9tYXJrZXRwbGFjZS52aXN1YWxzdHVkaW8uY29tL2l0ZW1zP2l0ZW1OYW1lPWNhaXh3LmFwaWRvYyI+YXBpZG9jLnZzY29kZTwvYT7jgII8L3A+CiAgICA8L2RvYz4KCiAgICA8ZG9jIGlkPSJtb2NrIiB0aXRsZT0iTW9jayIgcGFyZW50PSJ1c2FnZSI+CiAgICAgICAgPHA+bW9jayDlrZDlkb3ku6Tlj6/ku6XorpMgYXBpZG9jIOagueaTmuaWh+aqlOWLleaFi+eUn+aIkCBtb2NrIOaVuOaTmu+8jG1vY2sg5a2Q5ZG95Luk5o+Q5L6b5LqG5aSn6YeP55qE6YWN572u6aCF77yM5Lul5L2/55Sf5oiQ55qE5pW45pOa5pu05pyJ6Zqo5qmf5oCn77yM5YW36auU55qE5ZG95Luk6aCF5Y+v5Lul6YCa6YGOIDxzYW1wPmFwaWRvYyBoZWxwIG1vY2s8L3NhbXA+IOafpeeci+OAgjwvcD4KCiAgICAgICAgPHA+5aaC5p6c5pW45pOa6aGe5Z6L54K6IDx2YXI+c3RyaW5nLmltYWdlPC92YXI+77yM5pyD6Ieq5YuV55Sf5oiQ5aO55by15oyH5a6a5aSn5bCP55qE5ZyW54mH5Lim5oqK5Zyw5Z2A6L+U5Zue57Wm55So5oi277yM5YW25aSn5bCP55Sx5p+l6Kmi5Y+D5pW4IDxjb2RlPndpZHRoPC9jb2RlPiDlkowgPGNvZGU+aGVpZ2h0PC9jb2RlPiDmjIflrprvvIzmnKrmjIflrprliYfmmK8gPHZhcj41MDB4NTAwPC92YXI+44CC5ZyW54mH6aGe5Z6L5YmH6YCa6YGO55So5oi25o+Q5Lqk55qEIDxjb2RlPkFjY2VwdDwvY29kZT4g5aCx6aCt542y5Y+W77yM55uu5YmN5pSv5oyBIDx2YXI+aW1hZ2UvZ2lmPC92YXI+44CBPHZhcj5pbWFnZS9wbmc8L3Zhcj4g5ZKMIDx2YXI+aW1hZ2UvanBlZzwvdmFyPiDkuInnqK7poZ7lnovjgII8L3A+CgogICAgICAgIDxwPuS9v+eUqCBtb2NrIOS5n+acieWjueWumueahOWxgOmZkOaAp++8muWPquiDveWIpOaWt+aPkOS6pOeahOaVuOaTmuaYr+WQpuespuWQiOimgeaxgu+8jOS9huaYr+eEoeazleeQhuino+aVuOaTmuS7o+eivOeahOWQq+e+qe+8jOavlOWmgiBVUkwg5Lit5YyF5ZCrIHBhZ2U9Mu+8jOiDveWIpOaWtyBwYWdlIOeahOWAvOagvOW8j+aYr+WQpuato+eiuu+8jOS9hueEoeazlee1pueUqOaItui/lOWbnuesrOS6jOmggeeahOaVuOaTmuOAgk1vY2sg5Zyo6amX6K2J5pW45pOa5q2j56K65oCn5ZKM5Yid5pyf55So5pa85bGV56S65pW45pOa5YWn5a656YKE5piv5b6I5pyJ55So55qE44CCPC9wPgogICAgPC9kb2M+CgogICAgPGRvYyBpZD0iY2xpIiB0aXRsZT0i5ZG95Luk6KGMIiBwYXJlbnQ9InVzYWdlIj4KICAgICAgICA8cD7lj6/ku6XpgJrpgY4gPHNhbXA+YXBpZG9jIGhlbHA8L3NhbXA+IOafpeeci+WRveS7pOihjOaUr+aMgeeahOWtkOWRveS7pOOAguWMheWQq+S6huS7peS4i+W5vuWAi++8mjwvcD4KICAgIDwvZG9jPgoKICAgIDxkb2MgaWQ9ImNvbmZpZy1maWxlIiB0aXRsZT0i6YWN572u5paH5Lu2IiBwYXJlbnQ9InVzYWdlIj4KICAgICAgICA8cD7phY3nva7mlofku7blkI3lm7rlrprngrogPGNvZGU+LmFwaWRvYy55YW1sPC9jb2RlPu+8jOagvOW8j+eCuiBZQU1M77yM5Y+v5Y+D6ICDIDxhIGhyZWY9ImV4YW1wbGUvLmFwaWRvYy55YW1sIj4uYXBpZG9jLnlhbWw8L2E+44CC5paH5Lu25Y+v5Lul6YCa6YGO5ZG95LukIDxzYW1wPmFwaWRvYyBkZXRlY3Q8L3NhbXA+IOeUn+aIkOOAguS4u+imgeWMheWQq+S6huS7peW5vuWAi+mFjee9rumghe+8mjwvcD4KICAgIDwvZG9jPgoKICAgIDxmb290ZXI+CiAgICAgICAgPGxpY2Vuc2U+CiAgICAgICAgICAgIDxwPueVtuWJjemggemdouWFp+WuueaJmOeuoeaWvCA8L3A+PHA+77yM5Lim6YeH55SoPC9wPjxwPumAsuihjOioseWPr+OAgjwvcD4KICAgICAgICA8L2xpY2Vuc2U+CiAgICA8L2Zvb3Rlcj4KPC9kb2NzPgoAAQlpbmRleC5jc3MBF3RleHQvY3NzOyBjaGFyc2V0PXV0Zi04Af4OTUBjaGFyc2V0ICJ1dGYtOCI7Cgo6cm9vdCB7CiAgICAtLW1heC13aWR0aDogMTAyNHB4OwogICAgLS1wYWRkaW5nOiAxcmVtOwogICAgLS1hcnRpY2xlLXBhZGRpbmc6IGNhbGModmFyKC0tcGFkZGluZykgLyAyKTsKCiAgICAtLWNvbG9yOiBibGFjazsKICAgIC0tYWNjZW50LWNvbG9yOiAjMDA3NGQ5OwogICAgLS1iYWNrZ3JvdW5kOiB3aGl0ZTsKICAgIC0tYm9yZGVyLWNvbG9yOiAjZTBlMGUwOwp9CgpAbWVkaWEgKHByZWZlcnMtY29sb3Itc2NoZW1lOiBkYXJrKSB7CiAgICA6cm9vdCB7CiAgICAgICAgLS1jb2xvcjogI2IwYjBiMDsKICAgICAgICAtLWFjY2VudC1jb2xvcjogIzAwNzRkOTsKICAgICAgICAtLWJhY2tncm91bmQ6IGJsYWNrOwogICAgICAgIC0tYm9yZGVyLWNvbG9yOiAjMzAzMDMwOwogICAgfQp9Cgpib2R5IHsKICAgIHBhZGRpbmc6IDA7CiAgICBtYXJnaW46IDAgYXV0bzsKICAgIGNvbG9yOiB2YXIoLS1jb2xvcik7CiAgICBiYWNrZ3JvdW5kOiB2YXIoLS1iYWNrZ3JvdW5kKTsKfQoKdGFibGUgewogICAgd2lkdGg6IDEwMCU7CiAgICBib3JkZXI6IDFweCBzb2xpZCB2YXIoLS1ib3JkZXItY29sb3IpOwogICAgYm9yZGVyLXJhZGl1czogNXB4OwogICAgcGFkZGluZzogMCB2YXIoLS1hcnRpY2xlLXBhZGRpbmcpOwp9Cgp0YWJsZSB0aGVhZCB0ciB7CiAgICBoZWlnaHQ6IDIuNXJlbTsKfQoKdGFibGUgdGggewogICAgd2hpdGUtc3BhY2U6IG5vd3JhcDsKICAgIHBhZGRpbmctcmlnaHQ6IC41cmVtOwp9Cgp0YWJsZSB0aCwgdGFibGUgdGQgewogICAgdGV4dC1hbGlnbjogbGVmdDsKICAgIGJvcmRlci1ib3R0b206IDFweCBzb2xpZCB2YXIoLS1ib3JkZXItY29sb3IpOwp9Cgp0YWJsZSB0Ym9keSB0cjpsYXN0LW9mLXR5cGUgdGQsCnRhYmxlIHRib2R5IHRyOmxhc3Qtb2YtdHlwZSB0aCB7CiAgICBib3JkZXItYm90dG9tOiBub25lOwp9Cgp1bCwgb2wgewogICAgcGFkZGluZzogMDsKICAgIG1hcmdpbjogMDsKICAgIGxpc3Qtc3R5bGUtcG9zaXRpb246IGluc2lkZTsKfQoKYSB7CiAgICB0ZXh0LWRlY29yYXRpb246IG5vbmU7CiAgICBjb2xvcjogdmFyKC0tYWNjZW50LWNvbG9yKTsKfQoKYTpob3ZlciB7CiAgICBvcGFjaXR5OiAuNwp9CgpwIHsKICAgIG1hcmdpbjogMTVweCAwOwp9CgpwcmUgewogICAgYm9yZGVyOiAxcHggc29saWQgdmFyKC0tYm9yZGVyLWNvbG9yKTsKICAgIHBhZGRpbmc6IHZhcigtLWFydGljbGUtcGFkZGluZyk7CiAgICBib3JkZXItcmFkaXVzOiA1cHg7CiAgICB3aGl0ZS1zcGFjZTogcHJlLXdyYXA7Cn0KCi50eXBlIHsKICAgIGNvbG9yOiB2YXIoLS1jb2xvcikKfQoKLyoqKioqKioqKioqKioqKioqKioqKioqKioqKiBoZWFkZXIgKioqKioqKioqKioqKioqKioqKioqKiovCgpoZWFkZXIgewogICAgdG9wOiAwOwogICAgbGVmdDogMDsKICAgIHBvc2l0aW9uOiBzdGlja3k7CiAgICB6LWluZGV4OiAxMDAwOwogICAgYm94LXNpemluZzogYm9yZGVyLWJveDsKICAgIGJhY2tncm91bmQ6IHZhcigtLWJhY2tncm91bmQpOwogICAgYm94LXNoYWRvdzogMnB4IDJweCAycHggdmFyKC0tYm9yZGVyLWNvbG9yKTsKfQoKaGVhZGVyIC53cmFwIHsKICAgIG1hcmdpbjogMCBhdXRvOwogICAgdGV4dC1hbGlnbjogbGVmdDsKICAgIG1heC13aWR0aDogdmFyKC0tbWF4LXdpZHRoKTsKICAgIHBhZGRpbmc6IDAgdmFyKC0tcGFkZGluZyk7CiAgICBkaXNwbGF5OiBmbGV4OwogICAgZmxleC13cmFwOiB3cmFwOwogICAganVzdGlmeS1jb250ZW50OiBzcGFjZS1iZXR3ZWVuOwogICAgYWxpZ24taXRlbXM6IGNlbnRlcjsKfQoKaGVhZGVyIGgxIHsKICAgIGRpc3BsYXk6IGlubGluZS1ibG9jazsKICAgIG1hcmdpbjogdmFyKC0tYXJ0aWNsZS1wYWRkaW5nKSAwOwogICAgdGV4dC10cmFuc2Zvcm06IHVwcGVyY2FzZTsKfQoKaGVhZGVyIGgxIC52ZXJzaW9uIHsKICAgIGZvbnQtc2l6ZTogMXJlbTsKfQoKaGVhZGVyIGgxIGltZyB7CiAgICBoZWlnaHQ6IDEuNXJlbTsKICAgIG1hcmdpbi1yaWdodDogLjVyZW07Cn0KCmhlYWRlciAubWVudXMgewogICAgZGlzcGxheTogZmxleDsKICAgIGFsaWduLWl0ZW1zOiBiYXNlbGluZTsKfQoKaGVhZGVyIC5tZW51IHsKICAgIG1hcmdpbi1sZWZ0OiB2YXIoLS1wYWRkaW5nKTsKICAgIGNvbG9yOiB2YXIoLS1jb2xvcik7Cn0KCmhlYWRlciAubWVudTpob3ZlciB7CiAgICBvcGFjaXR5OiAuNzsKfQoKaGVhZGVyIC5kcm9wLW1lbnVzIHsKICAgIHBvc2l0aW9uOiByZWxhdGl2ZTsKICAgIGN1cnNvcjogcG9pbnRlcjsKfQoKaGVhZGVyIC5kcm9wLW1lbnVzIHVsIHsKICAgIHBvc2l0aW9uOiBhYnNvbHV0ZTsKICAgIHJpZ2h0OiAwOwogICAgZGlzcGxheTogbm9uZTsKICAgIGxpc3Qtc3R5bGU6IG5vbmU7CiAgICBiYWNrZ3JvdW5kOiB2YXIoLS1iYWNrZ3JvdW5kKTsKICAgIGJvcmRlcjogMXB4IHNvbGlkIHZhcigtLWJvcmRlci1jb2xvcik7CiAgICBwYWRkaW5nOiB2YXIoLS1hcnRpY2xlLXBhZGRpbmcpOwp9CgpoZWFkZXIgLmRyb3AtbWVudXMgYSB7CiAgICBjb2xvcjogdmFyKC0tY29sb3IpOwp9CgpoZWFkZXIgLmRyb3AtbWVudXM6aG92ZXIgdWwgewogICAgZGlzcGxheTogYmxvY2s7Cn0KCi8qKioqKioqKioqKioqKioqKioqKioqKioqKiBtYWluICoqKioqKioqKioqKioqKioqKioqKioqKioqLwoKbWFpbiB7CiAgICBtYXJnaW46IDAgYXV0bzsKICAgIG1heC13aWR0aDogdmFyKC0tbWF4LXdpZHRoKTsKICAgIHBhZGRpbmc6IHZhcigtLXBhZGRpbmcpOwp9CgptYWluIGFydGljbGUgLmxpbmsgewogICAgZm9udC1zaXplOiAuOHJlbTsKICAgIGRpc3BsYXk6IG5vbmU7Cn0KCm1haW4gYXJ0aWNsZSBoMjpob3ZlciAubGluaywKbWFpbiBhcnRpY2xlIGgzOmhvdmVyIC5saW5rIHsKICAgIGRpc3BsYXk6IGlubGluZS1ibG9jazsKfQoKLyoqKioqKioqKioqKioqKioqKioqKioqKiogZm9vdGVyICoqKioqKioqKioqKioqKioqKioqKioqKioqLwoKZm9vdGVyIHsKICAgIGJvcmRlci10b3A6IDFweCBzb2xpZCB2YXIoLS1ib3JkZXItY29sb3IpOwogICAgcGFkZGluZzogMCB2YXIoLS1wYWRkaW5nKSB2YXIoLS1wYWRkaW5nKTsKfQoKZm9vdGVyIC53cmFwIHsKICAgIG1heC13aWR0aDogdmFyKC0tbWF4LXdpZHRoKTsKICAgIG1hcmdpbjogMCBhdXRvOwp9CgouZ290by10b3AgewogICAgYm9yZGVyOiBzb2xpZCB2YXIoLS1jb2xvcik7CiAgICBib3JkZXItd2lkdGg6IDAgNXB4IDVweCAwOwogICAgZGlzcGxheTogYmxvY2s7CiAgICBwYWRkaW5nOiA1cHg7CiAgICB0cmFuc2Zvcm06IHJvdGF0ZSgtMTM1ZGVnKTsKICAgIHBvc2l0aW9uOiBmaXhlZDsKICAgIGJvdHRvbTogM3JlbTsKICAgIHJpZ2h0OiBjYWxjKCgoMTAwJSAtIHZhcigtLW1heC13aWR0aCkpIC8gMikgLSAzcmVtKTsKfQoKLyoKICog55So5LqO6K6h566XIGdvdG8tdG9wIOaMiemSrueahOS9jee9ruWni+e7iOS/neaMgeWcqOWGheWuueS4u+S9k+eahOWPs+S+p+i+ueS4iuOAggogKiAxMDI0cHgg5Li6IC0tbWF4LXdpZHRoIOeahOWAvO+8jOS9huaYryBDU1Mg5bm25LiN5pSv5oyB55u05o6l5ZyoIEBtZWRpYQogKiDkvb/nlKggdmFyKCkg5Ye95pWw44CCCiAqLwpAbWVkaWEgKG1heC13aWR0aDogY2FsYygxMDI0cHggKyA2cmVtKSkgewogICAgLmdvdG8tdG9wIHsKICAgICAgICByaWdodDogM3JlbTsKICAgIH0KfQoAAQhpbmRleC5qcwElYXBwbGljYXRpb24vamF2YXNjcmlwdDsgY2hhcnNldD11dGYtOAH+AbUndXNlIHN0cmljdCc7Cgp3aW5kb3cub25sb2FkID0gZnVuY3Rpb24oKSB7CiAgICBpbml0R290b1RvcCgpOwp9OwoKZnVuY3Rpb24gaW5pdEdvdG9Ub3AoKSB7CiAgICBjb25zdCB0b3AgPSBkb2N1bWVudC5xdWVyeVNlbGVjdG9yKCcuZ290by10b3AnKTsKCiAgICAvLyDlnKjmnIDpobbpg6jml7bvvIzpmpDol4/mjInpkq4KICAgIHdpbmRvdy5hZGRFdmVudExpc3RlbmVyKCdzY3JvbGwnLCAoZSkgPT4gewogICAgICAgIGNvbnN0IGJvZHkgPSBkb2N1bWVudC5xdWVyeVNlbGVjdG9yKCdodG1sJyk7CiAgICAgICAgaWYgKGJvZHkuc2Nyb2xsVG9wID4gNTApIHsKICAgICAgICAgICAgdG9wLnN0eWxlLmRpc3BsYXkgPSAnYmxvY2snOwogICAgICAgIH0gZWxzZSB7CiAgICAgICAgICAgIHRvcC5zdHlsZS5kaXNwbGF5ID0gJ25vbmUnOwogICAgICAgIH0KICAgIH0pOwp9CgABCWluZGV4LnhtbAEeYXBwbGljYXRpb24veG1sOyBjaGFyc2V0PXV0Zi04Af4dIjw/eG1sIHZlcnNpb249IjEuMCIgZW5jb2Rpbmc9InV0Zi04Ij8+Cjw/eG1sLXN0eWxlc2hlZXQgdHlwZT0idGV4dC94c2wiIGhyZWY9Ii4vaW5kZXgueHNsIj8+Cgo8IS0tCui/meaYr+WumOe9kemmlumhteWGheWuue+8jOWQjOaXtuS5n+aYr+eugOS9k+S4reaWh+eahOacrOWcsOWMluWGheWuueOAggoK5YW25a6D6K+t6KiA55qE5pys5YyW5Zyw5YaF5a6577yM6ZyA6KaB6YeN5paw5pS55YaZ5pys5paH5Lu25Lit6Zmk5rOo6YeK5aSW55qE5omA5pyJ5YaF5a6544CCCi0tPgoKPGRvY3MgbGFuZz0iY21uLUhhbnMiPgogICAgPHRpdGxlPmFwaWRvYyB8IFJFU1RmdWwgQVBJIOaWh+aho+WkhOeQhuW3peWFtzwvdGl0bGU+CiAgICA8bGljZW5zZSB1cmw9Imh0dHBzOi8vY3JlYXRpdmVjb21tb25zLm9yZy9saWNlbnNlcy9ieS80LjAvZGVlZC56aCI+572y5ZCNIDQuMCDlm73pmYUgKENDIEJZIDQuMCk8L2xpY2Vuc2U+CgogICAgPCEtLSDkuIDkupvnv7vor5Hmr5TovoPpm7bmlaPnmoTnv7vor5HlhoXlrrkgLS0+CiAgICA8bG9jYWxlcz4KICAgICAgICA8aGVhZGVyPiA8IS0tIOihqOagvOaKpeWktCAtLT4KICAgICAgICAgICAgPG5hbWU+5ZCN56ewPC9uYW1lPgogICAgICAgICAgICA8dHlwZT7nsbvlnos8L3R5cGU+CiAgICAgICAgICAgIDxyZXF1aXJlZD7lv4Xloas8L3JlcXVpcmVkPgogICAgICAgICAgICA8ZGVzY3JpcHRpb24+5o+P6L+wPC9kZXNjcmlwdGlvbj4KICAgICAgICA8L2hlYWRlcj4KICAgICAgICA8Z290by10b3A+6L+U5Zue6aG26YOoPC9nb3RvLXRvcD4KICAgIDwvbG9jYWxlcz4KCiAgICA8ZG9jIGlkPSJhYm91dCIgdGl0bGU9IuWFs+S6jiI+CiAgICAgICAgPHA+YXBpZG9jIOaYr+S4gOS4queugOWNleeahCA8YWJiciB0aXRsZT0iUmVwcmVzZW50YXRpb25hbCBTdGF0ZSBUcmFuc2ZlciI+UkVTVGZ1bDwvYWJicj4gPGFiYnIgdGl0bGU9IkFwcGxpY2F0aW9uIFByb2dyYW1taW5nIEludGVyZmFjZSI+QVBJPC9hYmJyPiDmlofmoaPnlJ/miJDlt6XlhbfvvIzlroPku47ku6PnoIHms6jph4rkuK3mj5Dlj5bnibnlrprmoLzlvI/nmoTlhoXlrrnnlJ/miJDmlofmoaPjgILmlK/mjIHor7jlpoIgR2/jgIFKYXZh44CBQysr44CBUnVzdCDnrYnlpKfpg6jliIblvIDlj5Hor63oqIDvvIzlhbfkvZPlj6/kvb/nlKggPGNvZGU+YXBpZG9jIGxhbmc8L2NvZGU+IOWRveS7pOihjOafpeeci+aJgOacieeahOaUr+aMgeWIl+ihqOOAgjwvcD4KCiAgICAgICAgPHA+YXBpZG9jIOaLpeacieS7peS4i+eJueeCue+8mjwvcD4KICAgICAgICA8b2w+CiAgICAgICAgICAgIDxsaT7ot6jlubPlj7DvvIxsaW51eOOAgXdpbmRvd3PjgIFtYWNPUyDnrYnpg73mlK/mjIHvvJs8L2xpPgogICAgICAgICAgICA8bGk+5pSv5oyB6K+t6KiA5bm/5rOb77yM5Y2z5L2/5piv5LiN5pSv5oyB77yM5Lmf5b6I5pa55L6/5omp5bGV77ybPC9saT4KICAgICAgICAgICAgPGxpPuaUr+aMgeWkmuS4quS4jeWQjOivreiogOeahOWkmuS4qumhueebrueUn+aIkOS4gOS7veaWh+aho++8mzwvbGk+CiAgICAgICAgICAgIDxsaT7ovpPlh7rmqKHmnb/lj6/oh6rlrprkuYnvvJs8L2xpPgogICAgICAgICAgICA8bGk+5qC55o2u5paH5qGj55Sf5oiQIG1vY2sg5pWw5o2u77ybPC9saT4KICAgICAgICA8L29sPgoKICAgICAgICA8cD7ku6XkuIvmmK/kuIDmrrXnroDnn63nmoQgQyDor63oqIDpo47moLzku6PnoIHkuIvnmoTnpLrkvovvvJo8L3A+CiAgICAgICAgPHByZT48Y29kZSBjbGFzcz0ibGFuZ3VhZ2UtbWFya3VwIj48IVtDREFUQVsvKioKICogPGFwaSBtZXRob2Q9IkdFVCIgc3VtbWFyeT0i6I635Y+W5omA5pyJ55qE55So5oi35L+h5oGvIj4KICogICAgIDxwYXRoIHBhdGg9Ii91c2VycyI+CiAqICAgICAgICAgPHF1ZXJ5IG5hbWU9InBhZ2UiIHR5cGU9Im51bWJlciIgZGVmYXVsdD0iMCI+5pi+56S656ys5Yeg6aG155qE5YaF5a65PC9xdWVyeT4KICogICAgICAgICA8cXVlcnkgbmFtZT0ic2l6ZSIgdHlwZT0ibnVtYmVyIiBkZWZhdWx0PSIyMCI+5q+P6aG15pi+56S655qE5pWw6YePPC9xdWVyeT4KICogICAgIDwvcGF0aD4KICogICAgIDx0YWc+dXNlcjwvdGFnPgogKiAgICAgPHNlcnZlcj51c2Vyczwvc2VydmVyPgogKiAgICAgPHJlc3BvbnNlIHN0YXR1cz0iMjAwIiB0eXBlPSJvYmplY3QiIG1pbWV0eXBlPSJhcHBsaWNhdGlvbi9qc29uIj4KICogICAgICAgICA8cGFyYW0gbmFtZT0iY291bnQiIHR5cGU9ImludCIgb3B0aW9uYWw9ImZhbHNlIiBzdW1tYXJ5PSLnrKblkIjmnaHku7bnmoTmiYDmnInnlKjmiLfmlbDph48iIC8+CiAqICAgICAgICAgPHBhcmFtIG5hbWU9InVzZXJzIiB0eXBlPSJvYmplY3QiIGFycmF5PSJ0cnVlIiBzdW1tYXJ5PSLnlKjmiLfliJfooagiPgogKiAgICAgICAgICAgICA8cGFyYW0gbmFtZT0iaWQiIHR5cGU9ImludCIgc3VtbWFyeT0i5ZSv5LiAIElEIiAvPgogKiAgICAgICAgICAgICA8cGFyYW0gbmFtZT0ibmFtZSIgdHlwZT0ic3RyaW5nIiBzdW1tYXJ5PSLlp5PlkI0iIC8+CiAqICAgICAgICAgPC9wYXJhbT4KICogICAgIDwvcmVzcG9uc2U+CiAqICAgICA8cmVzcG9uc2Ugc3RhdHVzPSI1MDAiIG1pbWV0eXBlPSJhcHBsaWNhdGlvbi9qc29uIiB0eXBlPSJvYmplY3QiPgogKiAgICAgICAgIDxwYXJhbSBuYW1lPSJjb2RlIiB0eXBlPSJpbnQiIHN1bW1hcnk9IumUmeivr+S7o+eggSIgLz4KICogICAgICAgICA8cGFyYW0gbmFtZT0ibXNnIiB0eXBlPSJzdHJpbmciIHN1bW1hcnk9IumUmeivr+WGheWuuSIgLz4KICogICAgIDwvcmVzcG9uc2U+CiAqIDwvYXBpPgogKi9dXT48L2NvZGU+PC9wcmU+CiAgICA8L2RvYz4KCiAgICA8ZG9jIGlkPSJ1c2FnZSIgdGl0bGU9IuS9v+eUqCIgLz4KCiAgICA8ZG9jIGlkPSJzcGVjIiB0aXRsZT0i5paH5qGj5qC85byPIj4KICAgICAgICA8cD7mlofmoaPph4fnlKggWE1MIOagvOW8j+OAguWtmOWcqOS4pOS4qumhtue6p+agh+etvu+8mjxjb2RlPmFwaWRvYzwvY29kZT4g5ZKMIDxjb2RlPmFwaTwvY29kZT7vvIznlKjkuo7mj4/ov7DmlbTkvZPlhoXlrrnlkozlhbfkvZPmjqXlj6Pkv6Hmga/jgII8L3A+CgogICAgICAgIDxwPuaWh+aho+iiq+S7juazqOmHiuS4reaPkOWPluS5i+WQju+8jOacgOe7iOS8muiiq+WQiOW5tuaIkOS4gOS4qiBYTUwg5paH5Lu277yM5Zyo6K+l5paH5Lu25LitIDxjb2RlPmFwaTwvY29kZT4g5L2c5Li6IDxjb2RlPmFwaWRvYzwvY29kZT4g55qE5LiA5Liq5a2Q5YWD57Sg5a2Y5Zyo77yM5aaC5p6c5L2g55qE6aG555uu5LiN5oOz5oqK5paH5qGj5YaZ5Zyo5rOo6YeK5Lit77yM5Lmf5Y+v5Lul55u05o6l57yW5YaZ5LiA5Liq5a6M5pW055qEIFhNTCDmlofku7bvvIzlsIYgPGNvZGU+YXBpPC9jb2RlPiDkvZzkuLogPGNvZGU+YXBpZG9jPC9jb2RlPiDnmoTkuIDkuKrlrZDlhYPntKDjgII8L3A+CgogICAgICAgIDxwPuWFt+S9k+WPr+WPguiAgzxhIGhyZWY9Ii4vZXhhbXBsZS9pbmRleC54bWwiPuekuuS+i+S7o+eggTwvYT7jgII8L3A+CgogICAgICAgIDxwPuS7peS4i+aYr+WvueWQhOS4qiBYTUwg5YWD57Sg5Lul5Y+K5Y+C5pWw5LuL57uN77yM5YW25Lit5LulIDxjb2RlPkA8L2NvZGU+IOW8gOWktOeahOihqOekuiBYTUwg5bGe5oCn77ybPGNvZGU+LjwvY29kZT4g6KGo56S65Li65b2T5YmN5YWD57Sg55qE5YaF5a6577yb5YW25a6D6KGo56S65a2Q5YWD57Sg44CCPC9wPgogICAgPC9kb2M+CgogICAgPCEtLSMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMg5Lul5LiL5Li65paH5qGj5YaF5a6555qE5a2Q6aG5ICMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIy0tPgoKICAgIDxkb2MgaWQ9Imluc3RhbGwiIHRpdGxlPSLlronoo4UiIHBhcmVudD0idXNhZ2UiPgogICAgICAgIDxwPuWPr+S7peebtOaOpeS7jiA8YSBocmVmPSJodHRwczovL2dpdGh1Yi5jb20vY2FpeHcvYXBpZG9jL3JlbGVhc2VzIj5odHRwczovL2dpdGh1Yi5jb20vY2FpeHcvYXBpZG9jL3JlbGVhc2VzPC9hPiDmn6Xmib7kvaDpnIDopoHnmoTniYjmnKzkuIvovb3vvIzmlL7lhaUgPHZhcj5QQVRIPC92YXI+IOS4reWNs+WPr+S9v+eUqOOAguWmguaenOayoeacieS9oOmcgOimgeeahOW5s+WPsOaWh+S7tu+8jOWImemcgOimgeS7jua6kOS7o+eggee8luivke+8mjwvcD4KICAgICAgICA8dWw+CiAgICAgICAgICAgIDxsaT7kuIvovb0gPGEgaHJlZj0iaHR0cHM6Ly9nb2xhbmcub3JnL2RsLyI+R288L2E+77ybPC9saT4KICAgICAgICAgICAgPGxpPuS4i+i9vea6kOS7o+egge+8jDxzYW1wPmdpdCBjbG9uZSBnaXRodWIuY29tL2NhaXh3L2FwaWRvYzwvc2FtcD7vvJs8L2xpPgogICAgICAgICAgICA8bGk+5omn6KGM5Luj56CB5LitIDxjb2RlPmJ1aWxkL2J1aWxkLnNoPC9jb2RlPiDmiJbmmK8gPGNvZGU+YnVpbGQvYnVpbGQuY21kPC9jb2RlPiDov5vooYznvJbor5HvvJs8L2xpPgogICAgICAgICAgICA8bGk+57yW6K+R5aW955qE5paH5Lu25a2Y5pS+5ZyoIDxjb2RlPmNtZC9hcGlkb2M8L2NvZGU+IOS4i++8jOWPr+S7peWwhuivpeaWh+S7tuaUvue9ruWcqCA8dmFyPlBBVEg8L3Zhcj4g55uu5b2V77ybPC9saT4KICAgICAgICA8L3VsPgogICAgPC9kb2M+CgogICAgPGRvYyBpZD0iZW52IiB0aXRsZT0i546v5aKD5Y+Y6YePIiBwYXJlbnQ9InVzYWdlIj4KICAgICAgICA8cD5hcGlkb2Mg5Lya6K+75Y+WIDx2YXI+TEFORzwvdmFyPiDnmoTnjq/looPlj5jph4/kvZzkuLrlhbbmnKzlnLDljJbnmoTkvp3mja7vvIzoi6Xmg7PmjIflrprlhbblroPor63np43vvIzlj6/ku6XmiYvliqjmjIflrpogPHZhcj5MQU5HPC92YXI+IOeOr+Wig+WPmOmHj++8mjxzYW1wPkxBTkc9emgtSGFudCBhcGlkb2M8L3NhbXA+44CC5ZyoIHdpbmRvd3Mg57O757uf5Lit77yM6Iul5LiN5a2Y5ZyoIDx2YXI+TEFORzwvdmFyPiDnjq/looPlj5jph4/vvIzliJnkvJrosIPnlKggPGNvZGU+R2V0VXNlckRlZmF1bHRMb2NhbGVOYW1lPC9jb2RlPiDlh73mlbDmnaXojrflj5bnm7jlupTnmoTor63oqIDkv6Hmga/jgII8L3A+CiAgICAgICAgPHA+5pys5Zyw5YyW55qEIElEIOWAvO+8jOeQhuiuuuS4iuWPquimgSA8YSBocmVmPSJodHRwczovL3Rvb2xzLmlldGYub3JnL2h0bWwvYmNwNDciPkJDUDQ3PC9hPiDmlK/mjIHnmoTpg73og73mraPnoa7or4bliKvjgILkuZ/mlK/mjIEgTGludXgg5LiL5bim57yW56CB55qE5qC85byP77yM5q+U5aaC77yaPHNhbXA+emhfQ04uVVRGLTg8L3NhbXA+44CCPC9wPgogICAgPC9kb2M+CgogICAgPGRvYyBpZD0iaWRlIiB0aXRsZT0iSURFIOaPkuS7tiIgcGFyZW50PSJ1c2FnZSI+CiAgICAgICAgPHA+YXBpZG9jIOaUr+aMgeS7pSA8YSBocmVmPSJodHRwczovL21pY3Jvc29mdC5naXRodWIuaW8vbGFuZ3VhZ2Utc2VydmVyLXByb3RvY29sL3NwZWNpZmljYXRpb25zL3NwZWNpZmljYXRpb24tY3VycmVudC8iPjxhYmJyIHRpdGxlPSJsYW5ndWFnZSBzZXJ2ZXIgcHJvdG9jb2wiPkxTUDwvYWJicj48L2E+IOaooeW8j+i/kOihjO+8mjxzYW1wPmFwaWRvYyBsc3AgLXA9Ijo4MDgwIiAtbT1zdGRpbyAtaDwvc2FtcD7jgILmiYDmnInmlK/mjIEgTFNQIOeahCBJREUg5ZKM57yW6L6R5Zmo5b6I5a655piT5a6e546w5a+55bqU55qE5o+S5Lu244CC55uu5YmN5a6Y5pa55bey57uP5o+Q5L6b5LqG6ZKI5a+5IHZzY29kZSDnmoTmj5Lku7bvvJo8YSBocmVmPSJodHRwczovL21hcmtldHBsYWNlLnZpc3VhbHN0dWRpby5jb20vaXRlbXM/aXRlbU5hbWU9Y2FpeHcuYXBpZG9jIj5hcGlkb2MudnNjb2RlPC9hPuOAgjwvcD4KICAgIDwvZG9jPgoKICAgIDxkb2MgaWQ9Im1vY2siIHRpdGxlPSJNb2NrIiBwYXJlbnQ9InVzYWdlIj4KICAgICAgICA8cD5tb2NrIOWtkOWRveS7pOWPr+S7peiuqSBhcGlkb2Mg5qC55o2u5paH5qGj5Yqo5oCB55Sf5oiQIG1vY2sg5pWw5o2u77yMbW9jayDlrZDlkb3ku6Tmj5DkvpvkuoblpKfph4/nmoTphY3nva7pobnvvIzku6Xkvb/nlJ/miJDnmoTmlbDmja7mm7TmnInpmo/mnLrmgKfvvIzlhbfkvZPnmoTlkb3ku6Tpobnlj6/ku6XpgJrov4cgPHNhbXA+YXBpZG9jIGhlbHAgbW9jazwvc2FtcD4g5p+l55yL44CCPC9wPgoKICAgICAgICA8cD7lpoLmnpzmlbDmja7nsbvlnovkuLogPHZhcj5zdHJpbmcuaW1hZ2U8L3Zhcj7vvIzkvJroh6rliqjnlJ/miJDkuIDlvKDmjIflrprlpKflsI/nmoTlm77niYflubbmiorlnLDlnYDov5Tlm57nu5nnlKjmiLfvvIzlhbblpKflsI/nlLHmn6Xor6Llj4LmlbAgPGNvZGU+d2lkdGg8L2NvZGU+IOWSjCA8Y29kZT5oZWlnaHQ8L2NvZGU+IOaMh+Wumu+8jOacquaMh+WumuWImeaYryA8dmFyPjUwMHg1MDA8L3Zhcj7jgILlm77niYfnsbvlnovliJnpgJrov4fnlKjmiLfmj5DkuqTnmoQgPGNvZGU+QWNjZXB0PC9jb2RlPiDmiqXlpLTojrflj5bvvIznm67liY3mlK/mjIEgPHZhcj5pbWFnZS9naWY8L3Zhcj7jgIE8dmFyPmltYWdlL3BuZzwvdmFyPiDlkowgPHZhcj5pbWFnZS9qcGVnPC92YXI+IOS4ieenjeexu+Wei+OAgjwvcD4KCiAgICAgICAgPHA+5L2/55SoIG1vY2sg5Lmf5pyJ5LiA5a6a55qE5bGA6ZmQ5oCn77ya5Y+q6IO95Yik5pat5o+Q5Lqk55qE5pWw5o2u5piv5ZCm56ym5ZCI6KaB5rGC77yM5L2G5piv5peg5rOV55CG6Kej5pWw5o2u5Luj56CB55qE5ZCr5LmJ77yM5q+U5aaCIFVSTCDkuK3ljIXlkKsgcGFnZT0y77yM6IO95Yik5patIHBhZ2Ug55qE5YC85qC85byP5piv5ZCm5q2j56Gu77yM5L2G5peg5rOV57uZ55So5oi36L+U5Zue56ys5LqM6aG155qE5pWw5o2u44CCTW9jayDlnKjpqozor4HmlbDmja7mraPnoa7mgKflkozliJ3mnJ/nlKjkuo7lsZXnpLrmlbDmja7lhoXlrrnov5jmmK/lvojmnInnlKjnmoTjgII8L3A+CiAgICA8L2RvYz4KCiAgICA8ZG9jIGlkPSJjbGkiIHRpdGxlPSLlkb3ku6TooYwiIHBhcmVudD0idXNhZ2UiPgogICAgICAgIDxwPuWPr+S7pemAmui/hyA8c2FtcD5hcGlkb2MgaGVscDwvc2FtcD4g5p+l55yL5ZG95Luk6KGM5pSv5oyB55qE5a2Q5ZG95Luk44CC5YyF5ZCr5LqG5Lul5LiL5Yeg5Liq77yaPC9wPgogICAgPC9kb2M+CgogICAgPGRvYyBpZD0iY29uZmlnLWZpbGUiIHRpdGxlPSLphY3nva7mlofku7YiIHBhcmVudD0idXNhZ2UiPgogICAgICAgIDxwPumFjee9ruaWh+S7tuWQjeWbuuWumuS4uiA8Y29kZT4uYXBpZG9jLnlhbWw8L2NvZGU+77yM5qC85byP5Li6IFlBTUzvvIzlj6/lj4LogIMgPGEgaHJlZj0iZXhhbXBsZS8uYXBpZG9jLnlhbWwiPi5hcGlkb2MueWFtbDwvYT7jgILmlofku7blj6/ku6XpgJrov4flkb3ku6QgPHNhbXA+YXBpZG9jIGRldGVjdDwvc2FtcD4g55Sf5oiQ44CC5Li76KaB5YyF5ZCr5LqG5Lul5Yeg5Liq6YWN572u6aG577yaPC9wPgogICAgPC9kb2M+CgogICAgPGZvb3Rlcj4KICAgICAgICA8bGljZW5zZT4KICAgICAgICAgICAgPHA+5b2T5YmN6aG16Z2i5YaF5a655omY566h5LqOIDwvcD48cD7vvIzlubbph4fnlKg8L3A+PHA+6L+b6KGM6K645Y+v44CCPC9wPgogICAgICAgIDwvbGljZW5zZT4KICAgIDwvZm9vdGVyPgo8L2RvY3M+CgABCWluZGV4LnhzbAEXdGV4dC94c2w7IGNoYXJzZXQ9dXRmLTgB/ikLPD94bWwgdmVyc2lvbj0iMS4wIiBlbmNvZGluZz0iVVRGLTgiPz4KCjx4c2w6c3R5bGVzaGVldCB2ZXJzaW9uPSIxLjAiIHhtbG5zOnhzbD0iaHR0cDovL3d3dy53My5vcmcvMTk5OS9YU0wvVHJhbnNmb3JtIj4KPHhzbDpvdXRwdXQgbWV0aG9kPSJodG1sIiBlbmNvZGluZz0idXRmLTgiIGluZGVudD0ieWVzIiB2ZXJzaW9uPSI1LjAiIGRvY3R5cGUtc3lzdGVtPSJhYm91dDpsZWdhY3ktY29tcGF0IiAvPgoKPHhzbDp2YXJpYWJsZSBuYW1lPSJjdXJyLWxhbmciPgogICAgPHhzbDp2YWx1ZS1vZiBzZWxlY3Q9Ii9kb2NzL0BsYW5nIiAvPgo8L3hzbDp2YXJpYWJsZT4KCjx4c2w6dmFyaWFibGUgbmFtZT0ibG9jYWxlLWZpbGUiPgogICAgPHhzbDp2YWx1ZS1vZiBzZWxlY3Q9ImRvY3VtZW50KCdzaXRlLnhtbCcpL3NpdGUvbG9jYWxlcy9sb2NhbGVbQGlkPSRjdXJyLWxhbmddL0Bkb2MiIC8+CjwveHNsOnZhcmlhYmxlPgoKPCEtLSDojrflj5blvZPliY3mlofmoaPnmoTor63oqIDlkI3np7DvvIzlpoLmnpzkuI3lrZjlnKjvvIzliJnnm7TmjqXph4fnlKggQGxhbmcg5bGe5oCnIC0tPgo8eHNsOnZhcmlhYmxlIG5hbWU9ImN1cnItbGFuZy10aXRsZSI+CiAgICA8eHNsOnZhcmlhYmxlIG5hbWU9InRpdGxlIj4KICAgICAgICA8eHNsOnZhbHVlLW9mIHNlbGVjdD0iZG9jdW1lbnQoJ3NpdGUueG1sJykvc2l0ZS9sb2NhbGVzL2xvY2FsZVtAaWQ9JGN1cnItbGFuZ10vQHRpdGxlIiAvPgogICAgPC94c2w6dmFyaWFibGU+CgogICAgPHhzbDpjaG9vc2U+CiAgICAgICAgPHhzbDp3aGVuIHRlc3Q9IiR0aXRsZT0nJyI+PHhzbDp2YWx1ZS1vZiBzZWxlY3Q9IiRjdXJyLWxhbmciIC8+PC94c2w6d2hlbj4KICAgICAgICA8eHNsOm90aGVyd2lzZT48eHNsOnZhbHVlLW9mIHNlbGVjdD0iJHRpdGxlIiAvPjwveHNsOm90aGVyd2lzZT4KICAgIDwveHNsOmNob29zZT4KPC94c2w6dmFyaWFibGU+Cgo8eHNsOnZhcmlhYmxlIG5hbWU9ImtleXdvcmRzIj4KICAgIDx4c2w6Zm9yLWVhY2ggc2VsZWN0PSJkb2N1bWVudCgnc2l0ZS54bWwnKS9zaXRlL2xhbmd1YWdlcy9sYW5ndWFnZSI+CiAgICAgICAgPHhzbDp2YWx1ZS1vZiBzZWxlY3Q9Ii4iIC8+PHhzbDp2YWx1ZS1vZiBzZWxlY3Q9IicsJyIgLz4KICAgIDwveHNsOmZvci1lYWNoPgo8L3hzbDp2YXJpYWJsZT4KCjx4c2w6dGVtcGxhdGUgbWF0Y2g9Ii8iPgogICAgPGh0bWw+CiAgICAgICAgPGhlYWQ+CiAgICAgICAgICAgIDx0aXRsZT48eHNsOnZhbHVlLW9mIHNlbGVjdD0iZG9jcy90aXRsZSIgLz48L3RpdGxlPgogICAgICAgICAgICA8bWV0YSBjaGFyc2V0PSJVVEYtOCIgLz4KICAgICAgICAgICAgPG1ldGEgbmFtZT0idmlld3BvcnQiIGNvbnRlbnQ9IndpZHRoPWRldmljZS13aWR0aCxpbml0aWFsLXNjYWxlPTEsbWF4aW11bS1zY2FsZT0xIi8+CiAgICAgICAgICAgIDxtZXRhIG5hbWU9ImtleXdvcmRzIiBjb250ZW50PSJ7JGtleXdvcmRzfVJFU1RmdWwgQVBJLGRvY3VtZW50LGFwaWRvYyIgLz4KICAgICAgICAgICAgPGxpbmsgcmVsPSJpY29uIiB0eXBlPSJpbWFnZS9zdmcreG1sIiBocmVmPSIuL2ljb24uc3ZnIiAvPgogICAgICAgICAgICA8bGluayByZWw9Im1hc2staWNvbiIgdHlwZT0iaW1hZ2Uvc3ZnK3htbCIgaHJlZj0iLi9pY29uLnN2ZyIgY29sb3I9ImJsYWNrIiAvPgogICAgICAgICAgICA8bGluayByZWw9ImNhbm9uaWNhbCIgaHJlZj0ie2RvY3VtZW50KCdzaXRlLnhtbCcpL3NpdGUvdXJsfSIgLz4KICAgICAgICAgICAgPGxpbmsgcmVsPSJzdHlsZXNoZWV0IiB0eXBlPSJ0ZXh0L2NzcyIgaHJlZj0iLi9pbmRleC5jc3MiIC8+CiAgICAgICAgICAgIDxsaW5rIHJlbD0ibGljZW5zZSIgaHJlZj0iey9kb2NzL2xpZW5zZS9AdXJsfSIgLz4KICAgICAgICAgICAgPGxpbmsgcmVsPSJzdHlsZXNoZWV0IiB0eXBlPSJ0ZXh0L2NzcyIgaHJlZj0iaHR0cHM6Ly9jZG5qcy5jbG91ZGZsYXJlLmNvbS9hamF4L2xpYnMvcHJpc20vMS4yMC4wL3RoZW1lcy9wcmlzbS10b21vcnJvdy5taW4uY3NzIiAvPgogICAgICAgICAgICA8c2NyaXB0IHNyYz0iLi9pbmRleC5qcyIgLz4KICAgICAgICA8L2hlYWQ+CgogICAgICAgIDxib2R5PgogICAgICAgICAgICA8eHNsOmNhbGwtdGVtcGxhdGUgbmFtZT0iaGVhZGVyIiAvPgoKICAgICAgICAgICAgPG1haW4+CiAgICAgICAgICAgICAgICA8eHNsOmZvci1lYWNoIHNlbGVjdD0iZG9jcy9kb2Nbbm90KEBwYXJlbnQpXSI+IDwhLS0g5LiN5a2Y5ZyoIEBwYXJlbnTvvIzooajnpLrmmK/pobbnuqfnmoQgZG9jIOWFg+e0oCAtLT4KICAgICAgICAgICAgICAgICAgICA8eHNsOmNhbGwtdGVtcGxhdGUgbmFtZT0iYXJ0aWNsZSI+CiAgICAgICAgICAgICAgICAgICAgICAgIDx4c2w6d2l0aC1wYXJhbSBuYW1lPSJkb2MiIHNlbGVjdD0iLiIgLz4KICAgICAgICAgICAgICAgICAgICA8L3hzbDpjYWxsLXRlbXBsYXRlPgogICAgICAgICAgICAgICAgPC94c2w6Zm9yLWVhY2g+CiAgICAgICAgICAgIDwvbWFpbj4KCiAgICAgICAgICAgIDxmb290ZXI+CiAgICAgICAgICAgICAgICA8ZGl2IGNsYXNzPSJ3cmFwIj4KICAgICAgICAgICAgICAgIDxwPgogICAgICAgICAgICAgICAgICAgIDx4c2w6dmFsdWUtb2Ygc2VsZWN0PSJkb2NzL2Zvb3Rlci9saWNlbnNlL3BbMV0iIC8+CiAgICAgICAgICAgICAgICAgICAgPGEgaHJlZj0ie2RvY3VtZW50KCdzaXRlLnhtbCcpL3NpdGUvcmVwb30iPkdpdGh1YjwvYT4KICAgICAgICAgICAgICAgICAgICA8eHNsOnZhbHVlLW9mIHNlbGVjdD0iZG9jcy9mb290ZXIvbGljZW5zZS9wWzJdIiAvPgogICAgICAgICAgICAgICAgICAgIDxhIGhyZWY9Intkb2NzL2xpY2Vuc2UvQHVybH0iPjx4c2w6dmFsdWUtb2Ygc2VsZWN0PSJkb2NzL2xpY2Vuc2UiIC8+PC9hPgogICAgICAgICAgICAgICAgICAgIDx4c2w6dmFsdWUtb2Ygc2VsZWN0PSJkb2NzL2Zvb3Rlci9saWNlbnNlL3BbM10iIC8+CiAgICAgICAgICAgICAgICA8L3A+CiAgICAgICAgICAgICAgICA8L2Rpdj4KICAgICAgICAgICAgICAgIDxhIGhyZWY9IiMiIGNsYXNzPSJnb3RvLXRvcCIgdGl0bGU9Intkb2NzL2xvY2FsZXMvZ290by10b3B9IiBhcmlhLWxhYmVsPSJ7ZG9jcy9sb2NhbGVzL2dvdG8tdG9wfSIgLz4KICAgICAgICAgICAgPC9mb290ZXI+CgogICAgICAgICAgICA8c2NyaXB0IHNyYz0iaHR0cHM6Ly9jZG5qcy5jbG91ZGZsYXJlLmNvbS9hamF4L2xpYnMvcHJpc20vMS4yMC4wL2NvbXBvbmVudHMvcHJpc20tY29yZS5taW4uanMiPjwvc2NyaXB0PgogICAgICAgICAgICA8c2NyaXB0IHNyYz0iaHR0cHM6Ly9jZG5qcy5jbG91ZGZsYXJlLmNvbS9hamF4L2xpYnMvcHJpc20vMS4yMC4wL3BsdWdpbnMvYXV0b2xvYWRlci9wcmlzbS1hdXRvbG9hZGVyLm1pbi5qcyI+PC9zY3JpcHQ+CiAgICAgICAgPC9ib2R5PgogICAgPC9odG1sPgo8L3hzbDp0ZW1wbGF0ZT4KCjx4c2w6dGVtcGxhdGUgbmFtZT0iaGVhZGVyIj4KICAgIDxoZWFkZXI+CiAgICAgICAgPGRpdiBjbGFzcz0id3JhcCI+CiAgICAgICAgICAgIDxoMT4KICAgICAgICAgICAgICAgIDxpbWcgYWx0PSJsb2dvIiBzcmM9Ii4vaWNvbi5zdmciIC8+CiAgICAgICAgICAgICAgICA8eHNsOnZhbHVlLW9mIHNlbGVjdD0iZG9jdW1lbnQoJ3NpdGUueG1sJykvc2l0ZS9uYW1lIiAvPgogICAgICAgICAgICAgICAgPHNwYW4gY2xhc3M9InZlcnNpb24iPiYjMTYwOyg8eHNsOnZhbHVlLW9mIHNlbGVjdD0iZG9jdW1lbnQoJ3NpdGUueG1sJykvc2l0ZS92ZXJzaW9uIiAvPik8L3NwYW4+CiAgICAgICAgICAgIDwvaDE+CgogICAgICAgICAgICA8ZGl2IGNsYXNzPSJtZW51cyIgcm9sZT0ibmF2aWdhdGlvbiI+CiAgICAgICAgICAgICAgICA8eHNsOmZvci1lYWNoIHNlbGVjdD0iZG9jcy9kb2Nbbm90KEBwYXJlbnQpXSI+CiAgICAgICAgICAgICAgICAgICAgPGEgY2xhc3M9Im1lbnUiIGhyZWY9IiN7QGlkfSI+PHhzbDp2YWx1ZS1vZiBzZWxlY3Q9IkB0aXRsZSIgLz48L2E+CiAgICAgICAgICAgICAgICA8L3hzbDpmb3ItZWFjaD4KCiAgICAgICAgICAgICAgICA8c3BhbiBjbGFzcz0iZHJvcC1tZW51cyIgcm9sZT0ibWVudSI+CiAgICAgICAgICAgICAgICAgICAgPGEgY2xhc3M9Im1lbnUiPgogICAgICAgICAgICAgICAgICAgICAgICA8eHNsOnZhbHVlLW9mIHNlbGVjdD0iJGN1cnItbGFuZy10aXRsZSIgLz4KICAgICAgICAgICAgICAgICAgICAgICAgPHNwYW4gYXJpYS1oaWRkcmVuPSJ0cnVlIj4mIzE2MDsmI3gyNWJjOzwvc3Bhbj4KICAgICAgICAgICAgICAgICAgICA8L2E+CiAgICAgICAgICAgICAgICAgICAgPHVsPgogICAgICAgICAgICAgICAgICAgICAgICA8eHNsOmZvci1lYWNoIHNlbGVjdD0iZG9jdW1lbnQoJ3NpdGUueG1sJykvc2l0ZS9sb2NhbGVzL2xvY2FsZSI+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICA8bGk+PGEgaHJlZj0ie0BocmVmfSI+PHhzbDp2YWx1ZS1vZiBzZWxlY3Q9IkB0aXRsZSIgLz48L2E+PC9saT4KICAgICAgICAgICAgICAgICAgICAgICAgPC94c2w6Zm9yLWVhY2g+CiAgICAgICAgICAgICAgICAgICAgPC91bD4KICAgICAgICAgICAgICAgIDwvc3Bhbj4KICAgICAgICAgICAgPC9kaXY+CiAgICAgICAgPC9kaXY+CiAgICA8L2hlYWRlcj4KPC94c2w6dGVtcGxhdGU+Cgo8IS0tIOWwhiBkb2Mg5YWD57Sg6L2s5o2i5oiQIGFydGljbGUgSFRNTCAtLT4KPHhzbDp0ZW1wbGF0ZSBuYW1lPSJhcnRpY2xlIj4KICAgIDx4c2w6cGFyYW0gbmFtZT0iZG9jIiAvPgoKICAgIDx4c2w6dmFyaWFibGUgbmFtZT0iaWQiIHNlbGVjdD0iJGRvYy9AaWQiIC8+CgogICAgPGFydGljbGUgaWQ9InskaWR9Ij4KICAgICAgICA8eHNsOmNob29zZT4gPCEtLSDmoLnmja7mmK/lkKblrZjlnKggJHBhcmVudO+8jOWGs+WumuaYr+eUqCBoMyDov5jmmK8gaDIg5qCH562+IC0tPgogICAgICAgICAgICA8eHNsOndoZW4gdGVzdD0iJGRvYy9AcGFyZW50Ij4KICAgICAgICAgICAgICAgIDxoMz4KICAgICAgICAgICAgICAgICAgICA8eHNsOnZhbHVlLW9mIHNlbGVjdD0iJGRvYy9AdGl0bGUiIC8+CiAgICAgICAgICAgICAgICAgICAgPGEgY2xhc3M9ImxpbmsiIGhyZWY9IiN7JGlkfSI+JiMxNjA7JiMxNjA7JiMxMjgyNzk7PC9hPgogICAgICAgICAgICAgICAgPC9oMz4KICAgICAgICAgICAgPC94c2w6d2hlbj4KICAgICAgICAgICAgPHhzbDpvdGhlcndpc2U+CiAgICAgICAgICAgICAgICA8aDI+CiAgICAgICAgICAgICAgICAgICAgPHhzbDp2YWx1ZS1vZiBzZWxlY3Q9IiRkb2MvQHRpdGxlIiAvPgogICAgICAgICAgICAgICAgICAgIDxhIGNsYXNzPSJsaW5rIiBocmVmPSIjeyRkb2MvQGlkfSI+JiMxNjA7JiMxNjA7JiMxMjgyNzk7PC9hPgogICAgICAgICAgICAgICAgPC9oMj4KICAgICAgICAgICAgPC94c2w6b3RoZXJ3aXNlPgogICAgICAgIDwveHNsOmNob29zZT4KCiAgICAgICAgPHhzbDpjb3B5LW9mIHNlbGVjdD0iJGRvYy9ub2RlKCkiIC8+CgogICAgICAgIDx4c2w6Zm9yLWVhY2ggc2VsZWN0PSIvZG9jcy9kb2NbQHBhcmVudD0kaWRdIj4KICAgICAgICAgICAgPHhzbDpjYWxsLXRlbXBsYXRlIG5hbWU9ImFydGljbGUiPgogICAgICAgICAgICAgICAgPHhzbDp3aXRoLXBhcmFtIG5hbWU9ImRvYyIgc2VsZWN0PSIuIiAvPgogICAgICAgICAgICA8L3hzbDpjYWxsLXRlbXBsYXRlPgogICAgICAgIDwveHNsOmZvci1lYWNoPgoKICAgICAgICA8eHNsOmlmIHRlc3Q9IiRpZD0nc3BlYyciPgogICAgICAgICAgICA8eHNsOmZvci1lYWNoIHNlbGVjdD0iZG9jdW1lbnQoJGxvY2FsZS1maWxlKS9sb2NhbGUvc3BlYy90eXBlIj4KICAgICAgICAgICAgICAgIDx4c2w6Y2FsbC10ZW1wbGF0ZSBuYW1lPSJ0eXBlIj4KICAgICAgICAgICAgICAgICAgICA8eHNsOndpdGgtcGFyYW0gbmFtZT0idHlwZSIgc2VsZWN0PSIuIiAvPgogICAgICAgICAgICAgICAgPC94c2w6Y2FsbC10ZW1wbGF0ZT4KICAgICAgICAgICAgPC94c2w6Zm9yLWVhY2g+CiAgICAgICAgPC94c2w6aWY+CgogICAgICAgIDx4c2w6aWYgdGVzdD0iJGlkPSdjbGknIj4KICAgICAgICAgICAgPHhzbDpjYWxsLXRlbXBsYXRlIG5hbWU9ImNvbW1hbmRzIiAvPgogICAgICAgIDwveHNsOmlmPgoKICAgICAgICA8eHNsOmlmIHRlc3Q9IiRpZD0nY29uZmlnLWZpbGUnIj4KICAgICAgICAgICAgPHhzbDpjYWxsLXRlbXBsYXRlIG5hbWU9ImFwaWRvY1lBTUwiIC8+CiAgICAgICAgPC94c2w6aWY+CiAgICA8L2FydGljbGU+CjwveHNsOnRlbXBsYXRlPgoKPCEtLSDku6XkuIvkuKTkuKrlj5jph4/ku4XnlKjkuo4gdHlwZSDlkowgY29tbWFuZHMg5qih5p2/77yM5Zyo5qih5p2/5Lit5peg5rOV55u05o6l5L2/55SoIC9kb2NzIOWFg+e0oO+8jOaJgOS7peS9v+eUqOWPmOmHj+W8leeUqCAtLT4KPHhzbDp2YXJpYWJsZSBuYW1lPSJoZWFkZXItbG9jYWxlIiBzZWxlY3Q9Ii9kb2NzL2xvY2FsZXMvaGVhZGVyIiAvPgoKPCEtLSDlsIblrZDlkb3ku6TmmL7npLrkuLrkuIDkuKogdGFibGUgLS0+Cjx4c2w6dGVtcGxhdGUgbmFtZT0iY29tbWFuZHMiPgogICAgPHRhYmxlPgogICAgICAgIDx0aGVhZD4KICAgICAgICAgICAgPHRyPgogICAgICAgICAgICAgICAgPHRoPjx4c2w6Y29weS1vZiBzZWxlY3Q9IiRoZWFkZXItbG9jYWxlL25hbWUiIC8+PC90aD4KICAgICAgICAgICAgICAgIDx0aD48eHNsOmNvcHktb2Ygc2VsZWN0PSIkaGVhZGVyLWxvY2FsZS9kZXNjcmlwdGlvbiIgLz48L3RoPgogICAgICAgICAgICA8L3RyPgogICAgICAgIDwvdGhlYWQ+CgogICAgICAgIDx0Ym9keT4KICAgICAgICAgICAgPHhzbDpmb3ItZWFjaCBzZWxlY3Q9ImRvY3VtZW50KCRsb2NhbGUtZmlsZSkvbG9jYWxlL2NvbW1hbmRzL2NvbW1hbmQiPgogICAgICAgICAgICA8dHI+CiAgICAgICAgICAgICAgICA8dGg+PHhzbDp2YWx1ZS1vZiBzZWxlY3Q9IkBuYW1lIiAvPjwvdGg+CiAgICAgICAgICAgICAgICA8dGQ+PHhzbDpjb3B5LW9mIHNlbGVjdD0ibm9kZSgpIiAvPjwvdGQ+CiAgICAgICAgICAgIDwvdHI+CiAgICAgICAgICAgIDwveHNsOmZvci1lYWNoPgogICAgICAgIDwvdGJvZHk+CiAgICA8L3RhYmxlPgo8L3hzbDp0ZW1wbGF0ZT4KCjwhLS0g5bCGIGFwaWRvYy55YW1sIOexu+Wei+ivtOaYjuaYvuekuuS4uiB0YWJsZSAtLT4KPHhzbDp0ZW1wbGF0ZSBuYW1lPSJhcGlkb2NZQU1MIj4KICAgIDx0YWJsZT4KICAgICAgICA8dGhlYWQ+CiAgICAgICAgICAgIDx0cj4KICAgICAgICAgICAgICAgIDx0aD48eHNsOmNvcHktb2Ygc2VsZWN0PSIkaGVhZGVyLWxvY2FsZS9uYW1lIiAvPjwvdGg+CiAgICAgICAgICAgICAgICA8dGg+PHhzbDpjb3B5LW9mIHNlbGVjdD0iJGhlYWRlci1sb2NhbGUvdHlwZSIgLz48L3RoPgogICAgICAgICAgICAgICAgPHRoPjx4c2w6Y29weS1vZiBzZWxlY3Q9IiRoZWFkZXItbG9jYWxlL3JlcXVpcmVkIiAvPjwvdGg+CiAgICAgICAgICAgICAgICA8dGg+PHhzbDpjb3B5LW9mIHNlbGVjdD0iJGhlYWRlci1sb2NhbGUvZGVzY3JpcHRpb24iIC8+PC90aD4KICAgICAgICAgICAgPC90cj4KICAgICAgICA8L3RoZWFkPgoKICAgICAgICA8dGJvZHk+CiAgICAgICAgICAgIDx4c2w6Zm9yLWVhY2ggc2VsZWN0PSJkb2N1bWVudCgkbG9jYWxlLWZpbGUpL2xvY2FsZS9jb25maWcvaXRlbSI+CiAgICAgICAgICAgICAgICA8dHI+CiAgICAgICAgICAgICAgICAgICAgPHRoPjx4c2w6dmFsdWUtb2Ygc2VsZWN0PSJAbmFtZSIgLz48L3RoPgogICAgICAgICAgICAgICAgICAgIDx0ZD4KICAgICAgICAgICAgICAgICAgICAgICAgPHhzbDp2YWx1ZS1vZiBzZWxlY3Q9IkB0eXBlIiAvPgogICAgICAgICAgICAgICAgICAgICAgICA8eHNsOmlmIHRlc3Q9IkBhcnJheT0ndHJ1ZSciPjx4c2w6dmFsdWUtb2Ygc2VsZWN0PSInW10nIiAvPjwveHNsOmlmPgogICAgICAgICAgICAgICAgICAgIDwvdGQ+CiAgICAgICAgICAgICAgICAgICAgPHRkPgogICAgICAgICAgICAgICAgICAgICAgICA8eHNsOmNhbGwtdGVtcGxhdGUgbmFtZT0iY2hlY2tib3giPgogICAgICAgICAgICAgICAgICAgICAgICAgICAgPHhzbDp3aXRoLXBhcmFtIG5hbWU9ImNoayIgc2VsZWN0PSJAcmVxdWlyZWQiIC8+CiAgICAgICAgICAgICAgICAgICAgICAgIDwveHNsOmNhbGwtdGVtcGxhdGU+CiAgICAgICAgICAgICAgICAgICAgPC90ZD4KICAgICAgICAgICAgICAgICAgICA8dGQ+PHhzbDpjb3B5LW9mIHNlbGVjdD0ibm9kZSgpIiAvPjwvdGQ+CiAgICAgICAgICAgICAgICA8L3RyPgogICAgICAgICAgICA8L3hzbDpmb3ItZWFjaD4KICAgICAgICA8L3Rib2R5PgogICAgPC90YWJsZT4KPC94c2w6dGVtcGxhdGU+CgoKPCEtLSDlsIbnsbvlnovmmL7npLrkuLrkuIDkuKogdGFibGUgLS0+Cjx4c2w6dGVtcGxhdGUgbmFtZT0idHlwZSI+CiAgICA8eHNsOnBhcmFtIG5hbWU9InR5cGUiIC8+CgogICAgPGFydGljbGUgaWQ9InR5cGVfeyR0eXBlL0BuYW1lfSI+CiAgICAgICAgPGgzPgogICAgICAgICAgICA8eHNsOnZhbHVlLW9mIHNlbGVjdD0iJHR5cGUvQG5hbWUiIC8+CiAgICAgICAgICAgIDxhIGNsYXNzPSJsaW5rIiBocmVmPSIjdHlwZV97JHR5cGUvQG5hbWV9Ij4mIzE2MDsmIzE2MDsmIzEyODI3OTs8L2E+CiAgICAgICAgPC9oMz4KICAgICAgICA8eHNsOmNvcHktb2Ygc2VsZWN0PSIkdHlwZS91c2FnZS9ub2RlKCkiIC8+CiAgICAgICAgPHhzbDppZiB0ZXN0PSJpdGVtIj4KICAgICAgICAgICAgPHRhYmxlPgogICAgICAgICAgICAgICAgPHRoZWFkPgogICAgICAgICAgICAgICAgICAgIDx0cj4KICAgICAgICAgICAgICAgICAgICAgICAgPHRoPjx4c2w6Y29weS1vZiBzZWxlY3Q9IiRoZWFkZXItbG9jYWxlL25hbWUiIC8+PC90aD4KICAgICAgICAgICAgICAgICAgICAgICAgPHRoPjx4c2w6Y29weS1vZiBzZWxlY3Q9IiRoZWFkZXItbG9jYWxlL3R5cGUiIC8+PC90aD4KICAgICAgICAgICAgICAgICAgICAgICAgPHRoPjx4c2w6Y29weS1vZiBzZWxlY3Q9IiRoZWFkZXItbG9jYWxlL3JlcXVpcmVkIiAvPjwvdGg+CiAgICAgICAgICAgICAgICAgICAgICAgIDx0aD48eHNsOmNvcHktb2Ygc2VsZWN0PSIkaGVhZGVyLWxvY2FsZS9kZXNjcmlwdGlvbiIgLz48L3RoPgogICAgICAgICAgICAgICAgICAgIDwvdHI+CiAgICAgICAgICAgICAgICA8L3RoZWFkPgoKICAgICAgICAgICAgICAgIDx0Ym9keT4KICAgICAgICAgICAgICAgICAgICA8eHNsOmZvci1lYWNoIHNlbGVjdD0iJHR5cGUvaXRlbSI+CiAgICAgICAgICAgICAgICAgICAgPHRyPgogICAgICAgICAgICAgICAgICAgICAgICA8dGg+PHhzbDp2YWx1ZS1vZiBzZWxlY3Q9IkBuYW1lIiAvPjwvdGg+CiAgICAgICAgICAgICAgICAgICAgICAgIDx0ZD4KICAgICAgICAgICAgICAgICAgICAgICAgICAgIDxhIGNsYXNzPSJ0eXBlIiBocmVmPSIjdHlwZV97QHR5cGV9Ij48eHNsOnZhbHVlLW9mIHNlbGVjdD0iQHR5cGUiIC8+PC9hPgogICAgICAgICAgICAgICAgICAgICAgICAgICAgPHhzbDppZiB0ZXN0PSJAYXJyYXk9J3RydWUnIj48eHNsOnZhbHVlLW9mIHNlbGVjdD0iJ1tdJyIgLz48L3hzbDppZj4KICAgICAgICAgICAgICAgICAgICAgICAgPC90ZD4KICAgICAgICAgICAgICAgICAgICAgICAgPHRkPgogICAgICAgICAgICAgICAgICAgICAgICAgICAgPHhzbDpjYWxsLXRlbXBsYXRlIG5hbWU9ImNoZWNrYm94Ij4KICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICA8eHNsOndpdGgtcGFyYW0gbmFtZT0iY2hrIiBzZWxlY3Q9IkByZXF1aXJlZCIgLz4KICAgICAgICAgICAgICAgICAgICAgICAgICAgIDwveHNsOmNhbGwtdGVtcGxhdGU+CiAgICAgICAgICAgICAgICAgICAgICAgIDwvdGQ+CiAgICAgICAgICAgICAgICAgICAgICAgIDx0ZD48eHNsOmNvcHktb2Ygc2VsZWN0PSJub2RlKCkiIC8+PC90ZD4KICAgICAgICAgICAgICAgICAgICA8L3RyPgogICAgICAgICAgICAgICAgICAgIDwveHNsOmZvci1lYWNoPgogICAgICAgICAgICAgICAgPC90Ym9keT4KICAgICAgICAgICAgPC90YWJsZT4KICAgICAgICA8L3hzbDppZj4KICAgIDwvYXJ0aWNsZT4KPC94c2w6dGVtcGxhdGU+Cgo8eHNsOnRlbXBsYXRlIG5hbWU9ImNoZWNrYm94Ij4KICAgIDx4c2w6cGFyYW0gbmFtZT0iY2hrIiAvPgogICAgPHhzbDpjaG9vc2U+CiAgICAgICAgPHhzbDp3aGVuIHRlc3Q9IiRjaGs9J3RydWUnIj4KICAgICAgICAgICAgPGxhYmVsIGFyaWEtbGFiZWw9InRydWUiPgogICAgICAgICAgICAgICAgPGlucHV0IHR5cGU9ImNoZWNrYm94IiBjaGVja2VkPSJ0cnVlIiBkaXNhYmxlZD0idHJ1ZSIgYXJpYS1oaWRkcmVuPSJ0cnVlIiAvPgogICAgICAgICAgICA8L2xhYmVsPgogICAgICAgIDwveHNsOndoZW4+CiAgICAgICAgPHhzbDpvdGhlcndpc2U+CiAgICAgICAgICAgIDxsYWJlbCBhcmlhLWxhYmVsPSJmYWxzZSI+CiAgICAgICAgICAgICAgICA8aW5wdXQgdHlwZT0iY2hlY2tib3giIGRpc2FibGVkPSJ0cnVlIiBhcmlhLWhpZGRyZW49InRydWUiIC8+CiAgICAgICAgICAgIDwvbGFiZWw+CiAgICAgICAgPC94c2w6b3RoZXJ3aXNlPgogICAgPC94c2w6Y2hvb3NlPgo8L3hzbDp0ZW1wbGF0ZT4KCjwveHNsOnN0eWxlc2hlZXQ+CgABE2xvY2FsZS5jbW4tSGFucy54bWwBHmFwcGxpY2F0aW9uL3htbDsgY2hhcnNldD11dGYtOAH+R/o8P3htbCB2ZXJzaW9uPSIxLjAiIGVuY29kaW5nPSJVVEYtOCI/PgoKPCEtLSDor6Xmlofku7bnlLHlt6Xlhbfoh6rliqjnlJ/miJDvvIzor7fli7/miYvliqjkv67mlLnvvIEgLS0+Cgo8bG9jYWxlPgoJPHNwZWM+CgkJPHR5cGUgbmFtZT0iYXBpZG9jIj4KCQkJPHVzYWdlPueUqOS6juaPj+i/sOaVtOS4quaWh+aho+eahOebuOWFs+WGheWuue+8jOWPquiDveWHuueOsOS4gOasoeOAgjwvdXNhZ2U+CgkJCTxpdGVtIG5hbWU9IkBhcGlkb2MiIHR5cGU9InN0cmluZyIgYXJyYXk9ImZhbHNlIiByZXF1aXJlZD0iZmFsc2UiPuaWh+aho+eahOeJiOacrOimgeWPtzwvaXRlbT4KCQkJPGl0ZW0gbmFtZT0iQGxhbmciIHR5cGU9InN0cmluZyIgYXJyYXk9ImZhbHNlIiByZXF1aXJlZD0iZmFsc2UiPuaWh+aho+WGheWuueeahOacrOWcsOWMliBJRO+8jOavlOWmgiA8dmFyPnpoLUhhbnM8L3Zhcj7jgIE8dmFyPmVuLVVTPC92YXI+IOetieOAgjwvaXRlbT4KCQkJPGl0ZW0gbmFtZT0iQGxvZ28iIHR5cGU9InN0cmluZyIgYXJyYXk9ImZhbHNlIiByZXF1aXJlZD0iZmFsc2UiPuaWh+aho+eahOWbvuagh++8jOS7heWPr+S9v+eUqCBTVkcg5qC85byP5Zu+5qCH44CCPC9pdGVtPgoJCQk8aXRlbSBuYW1lPSJAY3JlYXRlZCIgdHlwZT0iZGF0ZSIgYXJyYXk9ImZhbHNlIiByZXF1aXJlZD0iZmFsc2UiPuaWh+aho+eahOWIm+W7uuaXtumXtDwvaXRlbT4KCQkJPGl0ZW0gbmFtZT0iQHZlcnNpb24iIHR5cGU9InZlcnNpb24iIGFycmF5PSJmYWxzZSIgcmVxdWlyZWQ9ImZhbHNlIj7mlofmoaPnmoTniYjmnKzlj7c8L2l0ZW0+CgkJCTxpdGVtIG5hbWU9InhtbC1uYW1lc3BhY2UiIHR5cGU9InhtbC1uYW1lc3BhY2UiIGFycmF5PSJ0cnVlIiByZXF1aXJlZD0iZmFsc2UiPumSiOWvuSA8dmFyPmFwcGxpY2F0aW9uL3htbDwvdmFyPiDnsbvlnovnmoTlhoXlrrnnmoTlkb3lkI3nqbrpl7Torr7nva48L2l0ZW0+CgkJCTxpdGVtIG5hbWU9InRpdGxlIiB0eXBlPSJzdHJpbmciIGFycmF5PSJmYWxzZSIgcmVxdWlyZWQ9InRydWUiPuaWh+aho+eahOagh+mimDwvaXRlbT4KCQkJPGl0ZW0gbmFtZT0iZGVzY3JpcHRpb24iIHR5cGU9InJpY2h0ZXh0IiBhcnJheT0iZmFsc2UiIHJlcXVpcmVkPSJmYWxzZSI+5paH5qGj55qE5pW05L2T5o+P6L+w5YaF5a65PC9pdGVtPgoJCQk8aXRlbSBuYW1lPSJjb250YWN0IiB0eXBlPSJjb250YWN0IiBhcnJheT0iZmFsc2UiIHJlcXVpcmVkPSJmYWxzZSI+5paH5qGj5L2c6ICF55qE6IGU57O75pa55byPPC9pdGVtPgoJCQk8aXRlbSBuYW1lPSJsaWNlbnNlIiB0eXBlPSJsaW5rIiBhcnJheT0iZmFsc2UiIHJlcXVpcmVkPSJmYWxzZSI+5paH5qGj55qE54mI5p2D5L+h5oGvPC9pdGVtPgoJCQk8aXRlbSBuYW1lPSJ0YWciIHR5cGU9InRhZyIgYXJyYXk9InRydWUiIHJlcXVpcmVkPSJmYWxzZSI+5paH5qGj5Lit5a6a5LmJ55qE5omA5pyJ5qCH562+PC9pdGVtPgoJCQk8aXRlbSBuYW1lPSJzZXJ2ZXIiIHR5cGU9InNlcnZlciIgYXJyYXk9InRydWUiIHJlcXVpcmVkPSJmYWxzZSI+QVBJIOWfuuWcsOWdgOWIl+ihqO+8jOavj+S4qiBBUEkg5pyA5bCR5bqU6K+l5pyJ5LiA5LiqIHNlcnZlcuOAgjwvaXRlbT4KCQkJPGl0ZW0gbmFtZT0iYXBpIiB0eXBlPSJhcGkiIGFycmF5PSJ0cnVlIiByZXF1aXJlZD0iZmFsc2UiPuaWh+aho+S4reeahCBBUEkg5paH5qGjPC9pdGVtPgoJCQk8aXRlbSBuYW1lPSJoZWFkZXIiIHR5cGU9InBhcmFtIiBhcnJheT0idHJ1ZSIgcmVxdWlyZWQ9ImZhbHNlIj7mlofmoaPkuK3miYDmnIkgQVBJIOmDveWMheWQq+eahOWFrOWFseaKpeWktDwvaXRlbT4KCQkJPGl0ZW0gbmFtZT0icmVzcG9uc2UiIHR5cGU9InJlcXVlc3QiIGFycmF5PSJ0cnVlIiByZXF1aXJlZD0iZmFsc2UiPuaWh+aho+S4reaJgOaciSBBUEkg5paH5qGj6YO96ZyA6KaB5pSv5oyB55qE6L+U5Zue5YaF5a65PC9pdGVtPgoJCQk8aXRlbSBuYW1lPSJtaW1ldHlwZSIgdHlwZT0ic3RyaW5nIiBhcnJheT0idHJ1ZSIgcmVxdWlyZWQ9InRydWUiPuaWh+aho+aJgOaUr+aMgeeahCBtaW1ldHlwZTwvaXRlbT4KCQk8L3R5cGU+CgkJPHR5cGUgbmFtZT0ieG1sLW5hbWVzcGFjZSI+CgkJCTx1c2FnZT7kuLogPHZhcj5hcHBsaWNhdGlvbi94bWw8L3Zhcj4g5a6a5LmJ5ZG95ZCN56m66Ze055qE55u45YWz5bGe5oCnPC91c2FnZT4KCQkJPGl0ZW0gbmFtZT0iQHByZWZpeCIgdHlwZT0ic3RyaW5nIiBhcnJheT0iZmFsc2UiIHJlcXVpcmVkPSJmYWxzZSI+5ZG95ZCN56m66Ze055qE5YmN57yA77yM5aaC5p6c5Li656m677yM5YiZ6KGo56S65L2c5Li66buY6K6k5ZG95ZCN56m66Ze077yM5ZG95bGA5Y+q6IO95pyJ5LiA5Liq6buY6K6k5ZG95ZCN56m66Ze044CCPC9pdGVtPgoJCQk8aXRlbSBuYW1lPSJAdXJuIiB0eXBlPSJzdHJpbmciIGFycmF5PSJmYWxzZSIgcmVxdWlyZWQ9InRydWUiPuWRveWQjeepuumXtOeahOWUr+S4gOagh+ivhu+8jOmcgOimgeWFqOWxgOWUr+S4gO+8jOS4lOWMuuWIhuWkp+Wwj+WGmeOAgjwvaXRlbT4KCQk8L3R5cGU+CgkJPHR5cGUgbmFtZT0icmljaHRleHQiPgoJCQk8dXNhZ2U+5a+M5paH5pys5YaF5a65PC91c2FnZT4KCQkJPGl0ZW0gbmFtZT0iQHR5cGUiIHR5cGU9InN0cmluZyIgYXJyYXk9ImZhbHNlIiByZXF1aXJlZD0idHJ1ZSI+5oyH5a6a5a+M5paH5pys5YaF5a6555qE5qC85byP77yM55uu5YmN5pSv5oyBIDx2YXI+aHRtbDwvdmFyPiDlkowgPHZhcj5tYXJrZG93bjwvdmFyPuOAgjwvaXRlbT4KCQkJPGl0ZW0gbmFtZT0iLiIgdHlwZT0ic3RyaW5nIiBhcnJheT0iZmFsc2UiIHJlcXVpcmVkPSJ0cnVlIj7lr4zmlofmnKznmoTlrp7pmYXlhoXlrrk8L2l0ZW0+CgkJPC90eXBlPgoJCTx0eXBlIG5hbWU9ImNvbnRhY3QiPgoJCQk8dXNhZ2U+55So5LqO5o+P6L+w6IGU57O75pa55byPPC91c2FnZT4KCQkJPGl0ZW0gbmFtZT0iQG5hbWUiIHR5cGU9InN0cmluZyIgYXJyYXk9ImZhbHNlIiByZXF1aXJlZD0idHJ1ZSI+6IGU57O75Lq655qE5ZCN56ewPC9pdGVtPgoJCQk8aXRlbSBuYW1lPSJ1cmwiIHR5cGU9InN0cmluZyIgYXJyYXk9ImZhbHNlIiByZXF1aXJlZD0iZmFsc2UiPuiBlOezu+S6uueahCBVUkw8L2l0ZW0+CgkJCTxpdGVtIG5hbWU9ImVtYWlsIiB0eXBlPSJzdHJpbmciIGFycmF5PSJmYWxzZSIgcmVxdWlyZWQ9ImZhbHNlIj7ogZTns7vkurrnmoTnlLXlrZDpgq7ku7Y8L2l0ZW0+CgkJPC90eXBlPgoJCTx0eXBlIG5hbWU9ImxpbmsiPgoJCQk8dXNhZ2U+55So5LqO5o+P6L+w6ZO+5o6l5L+h5oGv77yM5LiA6Iis6L2s5o2i5Li6IEhUTUwg55qEIDxjb2RlPmE8L2NvZGU+IOagh+etvuOAgjwvdXNhZ2U+CgkJCTxpdGVtIG5hbWU9IkB0ZXh0IiB0eXBlPSJzdHJpbmciIGFycmF5PSJmYWxzZSIgcmVxdWlyZWQ9InRydWUiPumTvuaOpeeahOWtl+mdouaWh+WtlzwvaXRlbT4KCQkJPGl0ZW0gbmFtZT0iQHVybCIgdHlwZT0ic3RyaW5nIiBhcnJheT0iZmFsc2UiIHJlcXVpcmVkPSJ0cnVlIj7pk77mjqXmjIflkJHnmoTmlofmnKw8L2l0ZW0+CgkJPC90eXBlPgoJCTx0eXBlIG5hbWU9InRhZyI+CgkJCTx1c2FnZT7nlKjkuo7lr7nlkITkuKogQVBJIOi/m+ihjOWIhuexuzwvdXNhZ2U+CgkJCTxpdGVtIG5hbWU9IkBuYW1lIiB0eXBlPSJzdHJpbmciIGFycmF5PSJmYWxzZSIgcmVxdWlyZWQ9InRydWUiPuagh+etvueahOWUr+S4gCBJRDwvaXRlbT4KCQkJPGl0ZW0gbmFtZT0iQHRpdGxlIiB0eXBlPSJzdHJpbmciIGFycmF5PSJmYWxzZSIgcmVxdWlyZWQ9InRydWUiPuagh+etvueahOWtl+mdouWQjeensDwvaXRlbT4KCQkJPGl0ZW0gbmFtZT0iQGRlcHJlY2F0ZWQiIHR5cGU9InZlcnNpb24iIGFycmF5PSJmYWxzZSIgcmVxdWlyZWQ9ImZhbHNlIj7or6XmoIfnrb7lnKjlpKfkuo7or6XniYjmnKzml7booqvlvIPnlKg8L2l0ZW0+CgkJPC90eXBlPgoJCTx0eXBlIG5hbWU9InNlcnZlciI+CgkJCTx1c2FnZT7nlKjkuo7mjIflrprlkITkuKogQVBJIOeahOacjeWKoeWZqOWcsOWdgDwvdXNhZ2U+CgkJCTxpdGVtIG5hbWU9IkBuYW1lIiB0eXBlPSJzdHJpbmciIGFycmF5PSJmYWxzZSIgcmVxdWlyZWQ9InRydWUiPuacjeWKoeWUr+S4gCBJRDwvaXRlbT4KCQkJPGl0ZW0gbmFtZT0iQHVybCIgdHlwZT0ic3RyaW5nIiBhcnJheT0iZmFsc2UiIHJlcXVpcmVkPSJ0cnVlIj7mnI3liqHnmoTln7rlnLDlnYDvvIzkuI7or6XmnI3liqHlhbPogZTnmoQgQVBJ77yM6K6/6Zeu5Zyw5Z2A6YO95piv55u45a+55LqO5q2k5Zyw5Z2A55qE44CCPC9pdGVtPgoJCQk8aXRlbSBuYW1lPSJAZGVwcmVjYXRlZCIgdHlwZT0idmVyc2lvbiIgYXJyYXk9ImZhbHNlIiByZXF1aXJlZD0iZmFsc2UiPuacjeWKoeWcqOWkp+S6juivpeeJiOacrOaXtuiiq+W8g+eUqDwvaXRlbT4KCQkJPGl0ZW0gbmFtZT0iQHN1bW1hcnkiIHR5cGU9InN0cmluZyIgYXJyYXk9ImZhbHNlIiByZXF1aXJlZD0iZmFsc2UiPuacjeWKoeeahOaRmOimgeS/oeaBrzwvaXRlbT4KCQkJPGl0ZW0gbmFtZT0iZGVzY3JpcHRpb24iIHR5cGU9InJpY2h0ZXh0IiBhcnJheT0iZmFsc2UiIHJlcXVpcmVkPSJmYWxzZSI+5pyN5Yqh55qE6K+m57uG5o+P6L+wPC9pdGVtPgoJCTwvdHlwZT4KCQk8dHlwZSBuYW1lPSJhcGkiPgoJCQk8dXNhZ2U+55So5LqO5a6a5LmJ5Y2V5LiqIEFQSSDmjqXlj6PnmoTlhbfkvZPlhoXlrrk8L3VzYWdlPgoJCQk8aXRlbSBuYW1lPSJAdmVyc2lvbiIgdHlwZT0idmVyc2lvbiIgYXJyYXk9ImZhbHNlIiByZXF1aXJlZD0iZmFsc2UiPuihqOekuuatpOaOpeWPo+WcqOivpeeJiOacrOS4rea3u+WKoDwvaXRlbT4KCQkJPGl0ZW0gbmFtZT0iQG1ldGhvZCIgdHlwZT0ic3RyaW5nIiBhcnJheT0iZmFsc2UiIHJlcXVpcmVkPSJ0cnVlIj7lvZPliY3mjqXlj6PmiYDmlK/mjIHnmoTor7fmsYLmlrnms5U8L2l0ZW0+CgkJCTxpdGVtIG5hbWU9IkBpZCIgdHlwZT0ic3RyaW5nIiBhcnJheT0iZmFsc2UiIHJlcXVpcmVkPSJmYWxzZSI+5o6l5Y+j55qE5ZSv5LiAIElEPC9pdGVtPgoJCQk8aXRlbSBuYW1lPSJAc3VtbWFyeSIgdHlwZT0ic3RyaW5nIiBhcnJheT0iZmFsc2UiIHJlcXVpcmVkPSJmYWxzZSI+566A6KaB5LuL57uNPC9pdGVtPgoJCQk8aXRlbSBuYW1lPSJAZGVwcmVjYXRlZCIgdHlwZT0idmVyc2lvbiIgYXJyYXk9ImZhbHNlIiByZXF1aXJlZD0iZmFsc2UiPuWcqOatpOeJiOacrOS5i+WQjuWwhuS8muiiq+W8g+eUqDwvaXRlbT4KCQkJPGl0ZW0gbmFtZT0icGF0aCIgdHlwZT0icGF0aCIgYXJyYXk9ImZhbHNlIiByZXF1aXJlZD0idHJ1ZSI+5a6a5LmJ6Lev5b6E5L+h5oGvPC9pdGVtPgoJCQk8aXRlbSBuYW1lPSJkZXNjcmlwdGlvbiIgdHlwZT0icmljaHRleHQiIGFycmF5PSJmYWxzZSIgcmVxdWlyZWQ9ImZhbHNlIj7or6XmjqXlj6PnmoTor6bnu4bku4vnu43vvIzkuLogSFRNTCDlhoXlrrnjgII8L2l0ZW0+CgkJCTxpdGVtIG5hbWU9InJlcXVlc3QiIHR5cGU9InJlcXVlc3QiIGFycmF5PSJ0cnVlIiByZXF1aXJlZD0iZmFsc2UiPuWumuS5ieWPr+eUqOeahOivt+axguS/oeaBrzwvaXRlbT4KCQkJPGl0ZW0gbmFtZT0icmVzcG9uc2UiIHR5cGU9InJlcXVlc3QiIGFycmF5PSJ0cnVlIiByZXF1aXJlZD0iZmFsc2UiPuWumuS5ieWPr+iDveeahOi/lOWbnuS/oeaBrzwvaXRlbT4KCQkJPGl0ZW0gbmFtZT0iY2FsbGJhY2siIHR5cGU9ImNhbGxiYWNrIiBhcnJheT0iZmFsc2UiIHJlcXVpcmVkPSJmYWxzZSI+5a6a5LmJ5Zue6LCD5o6l5Y+j5YaF5a65PC9pdGVtPgoJCQk8aXRlbSBuYW1lPSJoZWFkZXIiIHR5cGU9InBhcmFtIiBhcnJheT0idHJ1ZSIgcmVxdWlyZWQ9ImZhbHNlIj7kvKDpgJLnmoTmiqXlpLTlhoXlrrnvvIzlpoLmnpzmmK/mn5DkuKogbWltZXR5cGUg5LiT55So55qE77yM5Y+v5Lul5pS+5ZyoIHJlcXVlc3Qg5YWD57Sg5Lit44CCPC9pdGVtPgoJCQk8aXRlbSBuYW1lPSJ0YWciIHR5cGU9InN0cmluZyIgYXJyYXk9InRydWUiIHJlcXVpcmVkPSJmYWxzZSI+5YWz6IGU55qE5qCH562+PC9pdGVtPgoJCQk8aXRlbSBuYW1lPSJzZXJ2ZXIiIHR5cGU9InN0cmluZyIgYXJyYXk9InRydWUiIHJlcXVpcmVkPSJmYWxzZSI+5YWz6IGU55qE5pyN5YqhPC9pdGVtPgoJCTwvdHlwZT4KCQk8dHlwZSBuYW1lPSJwYXRoIj4KCQkJPHVzYWdlPueUqOS6juWumuS5ieivt+axguaXtuS4jui3r+W+hOebuOWFs+eahOWGheWuuTwvdXNhZ2U+CgkJCTxpdGVtIG5hbWU9IkBwYXRoIiB0eXBlPSJzdHJpbmciIGFycmF5PSJmYWxzZSIgcmVxdWlyZWQ9InRydWUiPuaOpeWPo+WcsOWdgDwvaXRlbT4KCQkJPGl0ZW0gbmFtZT0icGFyYW0iIHR5cGU9InBhcmFtIiBhcnJheT0idHJ1ZSIgcmVxdWlyZWQ9ImZhbHNlIj7lnLDlnYDkuK3nmoTlj4LmlbA8L2l0ZW0+CgkJCTxpdGVtIG5hbWU9InF1ZXJ5IiB0eXBlPSJwYXJhbSIgYXJyYXk9InRydWUiIHJlcXVpcmVkPSJmYWxzZSI+5Zyw5Z2A5Lit55qE5p+l6K+i5Y+C5pWwPC9pdGVtPgoJCTwvdHlwZT4KCQk8dHlwZSBuYW1lPSJwYXJhbSI+CgkJCTx1c2FnZT7lj4LmlbDnsbvlnovvvIzln7rmnKzkuIrlj6/ku6XkvZzkuLogcmVxdWVzdCDnmoTlrZDpm4bkvb/nlKjjgII8L3VzYWdlPgoJCQk8aXRlbSBuYW1lPSJAeG1sLWF0dHIiIHR5cGU9ImJvb2wiIGFycmF5PSJmYWxzZSIgcmVxdWlyZWQ9ImZhbHNlIj7mmK/lkKbkvZzkuLrniLblhYPntKDnmoTlsZ7mgKfvvIzku4XkvZznlKjkuo4gWE1MIOWFg+e0oOOAguaYr+WQpuS9nOS4uueItuWFg+e0oOeahOWxnuaAp++8jOS7heeUqOS6jiBYTUwg55qE6K+35rGC44CCPC9pdGVtPgoJCQk8aXRlbSBuYW1lPSJAeG1sLWV4dHJhY3QiIHR5cGU9ImJvb2wiIGFycmF5PSJmYWxzZSIgcmVxdWlyZWQ9ImZhbHNlIj7lsIblvZPliY3lhYPntKDnmoTlhoXlrrnkvZzkuLrniLblhYPntKDnmoTlhoXlrrnvvIzopoHmsYLniLblhYPntKDlv4XpobvkuLogPHZhcj5vYmplY3Q8L3Zhcj7jgII8L2l0ZW0+CgkJCTxpdGVtIG5hbWU9IkB4bWwtY2RhdGEiIHR5cGU9ImJvb2wiIGFycmF5PSJmYWxzZSIgcmVxdWlyZWQ9ImZhbHNlIj7lvZPliY3lhoXlrrnkuLogQ0RBVEHvvIzkuI4gPGNvZGU+QHhtbC1hdHRyPC9jb2RlPiDkupLmlqXjgII8L2l0ZW0+CgkJCTxpdGVtIG5hbWU9IkB4bWwtbnMtcHJlZml4IiB0eXBlPSJzdHJpbmciIGFycmF5PSJmYWxzZSIgcmVxdWlyZWQ9ImZhbHNlIj5YTUwg5qCH562+55qE5ZG95ZCN56m66Ze05ZCN56ew5YmN57yAPC9pdGVtPgoJCQk8aXRlbSBuYW1lPSJAeG1sLXdyYXBwZWQiIHR5cGU9InN0cmluZyIgYXJyYXk9ImZhbHNlIiByZXF1aXJlZD0iZmFsc2UiPuWmguaenOW9k+WJjeWFg+e0oOeahCA8Y29kZT5AYXJyYXk8L2NvZGU+IOS4uiA8dmFyPnRydWU8L3Zhcj7vvIzliJnlj6/ku6XpgJrov4fmraTlgLzmjIflrprlnKggWE1MIOagvOW8j+S4reeahOWQjeensOOAggoJ5Y+v5Lul5pyJ5LiJ56eN5qC85byP77yaPHVsPgoJPGxpPjxzYW1wPm5hbWU8L3NhbXA+77ya6KGo56S65Li65pWw57uE5re75Yqg5LiA5Liq54i25YWD57Sg5ZCN56ew5Li6IDx2YXI+bmFtZTwvdmFyPu+8mzwvbGk+Cgk8bGk+PHNhbXA+bmFtZTEmZ3Q7bmFtZTI8L3NhbXA+77ya6KGo56S65pWw57uE6aG555qE5ZCN56ew5pS55Li6IDx2YXI+bmFtZTI8L3Zhcj7vvIzkuJTmt7vliqDkuIDkuKrniLblhYPntKDlkI3kuLogPHZhcj5uYW1lMTwvdmFyPu+8mzwvbGk+Cgk8bGk+PHNhbXA+Jmd0O25hbWU8L3NhbXA+77ya6KGo56S65bCG5b2T5YmN5pWw57uE5YWD57Sg55qE5ZCN56ew5pS55Li6IDx2YXI+bmFtZTwvdmFyPu+8mzwvbGk+Cgk8L3VsPjwvaXRlbT4KCQkJPGl0ZW0gbmFtZT0iQG5hbWUiIHR5cGU9InN0cmluZyIgYXJyYXk9ImZhbHNlIiByZXF1aXJlZD0idHJ1ZSI+5YC855qE5ZCN56ewPC9pdGVtPgoJCQk8aXRlbSBuYW1lPSJAdHlwZSIgdHlwZT0idHlwZSIgYXJyYXk9ImZhbHNlIiByZXF1aXJlZD0idHJ1ZSI+5YC855qE57G75Z6LPC9pdGVtPgoJCQk8aXRlbSBuYW1lPSJAZGVwcmVjYXRlZCIgdHlwZT0idmVyc2lvbiIgYXJyYXk9ImZhbHNlIiByZXF1aXJlZD0iZmFsc2UiPuihqOekuuWcqOWkp+S6juetieS6juivpeeJiOacrOWPt+aXtuS4jeWGjeWQr+S9nOeUqDwvaXRlbT4KCQkJPGl0ZW0gbmFtZT0iQGRlZmF1bHQiIHR5cGU9InN0cmluZyIgYXJyYXk9ImZhbHNlIiByZXF1aXJlZD0iZmFsc2UiPum7mOiupOWAvDwvaXRlbT4KCQkJPGl0ZW0gbmFtZT0iQG9wdGlvbmFsIiB0eXBlPSJib29sIiBhcnJheT0iZmFsc2UiIHJlcXVpcmVkPSJmYWxzZSI+5piv5ZCm5Li65Y+v6YCJ55qE5Y+C5pWwPC9pdGVtPgoJCQk8aXRlbSBuYW1lPSJAYXJyYXkiIHR5cGU9ImJvb2wiIGFycmF5PSJmYWxzZSIgcmVxdWlyZWQ9ImZhbHNlIj7mmK/lkKbkuLrmlbDnu4Q8L2l0ZW0+CgkJCTxpdGVtIG5hbWU9IkBzdW1tYXJ5IiB0eXBlPSJzdHJpbmciIGFycmF5PSJmYWxzZSIgcmVxdWlyZWQ9ImZhbHNlIj7nroDopoHku4vnu408L2l0ZW0+CgkJCTxpdGVtIG5hbWU9IkBhcnJheS1zdHlsZSIgdHlwZT0iYm9vbCIgYXJyYXk9ImZhbHNlIiByZXF1aXJlZD0iZmFsc2UiPuS7peaVsOe7hOeahOaWueW8j+WxleekuuaVsOaNrjwvaXRlbT4KCQkJPGl0ZW0gbmFtZT0icGFyYW0iIHR5cGU9InBhcmFtIiBhcnJheT0idHJ1ZSIgcmVxdWlyZWQ9ImZhbHNlIj7lrZDnsbvlnovvvIzmr5TlpoLlr7nosaHnmoTlrZDlhYPntKDjgII8L2l0ZW0+CgkJCTxpdGVtIG5hbWU9ImVudW0iIHR5cGU9ImVudW0iIGFycmF5PSJ0cnVlIiByZXF1aXJlZD0iZmFsc2UiPuW9k+WJjeWPguaVsOWPr+eUqOeahOaemuS4vuWAvDwvaXRlbT4KCQkJPGl0ZW0gbmFtZT0iZGVzY3JpcHRpb24iIHR5cGU9InJpY2h0ZXh0IiBhcnJheT0iZmFsc2UiIHJlcXVpcmVkPSJmYWxzZSI+6K+m57uG5LuL57uN77yM5Li6IEhUTUwg5YaF5a6544CCPC9pdGVtPgoJCTwvdHlwZT4KCQk8dHlwZSBuYW1lPSJlbnVtIj4KCQkJPHVzYWdlPuWumuS5ieaemuS4vuexu+Wei+eahOaVsOaJgOeahOaemuS4vuWAvDwvdXNhZ2U+CgkJCTxpdGVtIG5hbWU9IkBkZXByZWNhdGVkIiB0eXBlPSJ2ZXJzaW9uIiBhcnJheT0iZmFsc2UiIHJlcXVpcmVkPSJmYWxzZSI+6K+l5bGe5oCn5byD55So55qE54mI5pys5Y+3PC9pdGVtPgoJCQk8aXRlbSBuYW1lPSJAdmFsdWUiIHR5cGU9InN0cmluZyIgYXJyYXk9ImZhbHNlIiByZXF1aXJlZD0idHJ1ZSI+5p6a5Li+5YC8PC9pdGVtPgoJCQk8aXRlbSBuYW1lPSJAc3VtbWFyeSIgdHlwZT0ic3RyaW5nIiBhcnJheT0iZmFsc2UiIHJlcXVpcmVkPSJmYWxzZSI+5p6a5Li+5YC855qE6K+05piOPC9pdGVtPgoJCQk8aXRlbSBuYW1lPSJkZXNjcmlwdGlvbiIgdHlwZT0icmljaHRleHQiIGFycmF5PSJmYWxzZSIgcmVxdWlyZWQ9ImZhbHNlIj7mnprkuL7lgLznmoTor6bnu4bor7TmmI48L2l0ZW0+CgkJPC90eXBlPgoJCTx0eXBlIG5hbWU9InJlcXVlc3QiPgoJCQk8dXNhZ2U+5a6a5LmJ5LqG6K+35rGC5ZKM6L+U5Zue55qE55u45YWz5YaF5a65PC91c2FnZT4KCQkJPGl0ZW0gbmFtZT0iQHhtbC1hdHRyIiB0eXBlPSJib29sIiBhcnJheT0iZmFsc2UiIHJlcXVpcmVkPSJmYWxzZSI+5piv5ZCm5L2c5Li654i25YWD57Sg55qE5bGe5oCn77yM5LuF5L2c55So5LqOIFhNTCDlhYPntKDjgILmmK/lkKbkvZzkuLrniLblhYPntKDnmoTlsZ7mgKfvvIzku4XnlKjkuo4gWE1MIOeahOivt+axguOAgjwvaXRlbT4KCQkJPGl0ZW0gbmFtZT0iQHhtbC1leHRyYWN0IiB0eXBlPSJib29sIiBhcnJheT0iZmFsc2UiIHJlcXVpcmVkPSJmYWxzZSI+5bCG5b2T5YmN5YWD57Sg55qE5YaF5a655L2c5Li654i25YWD57Sg55qE5YaF5a6577yM6KaB5rGC54i25YWD57Sg5b+F6aG75Li6IDx2YXI+b2JqZWN0PC92YXI+44CCPC9pdGVtPgoJCQk8aXRlbSBuYW1lPSJAeG1sLWNkYXRhIiB0eXBlPSJib29sIiBhcnJheT0iZmFsc2UiIHJlcXVpcmVkPSJmYWxzZSI+5b2T5YmN5YaF5a655Li6IENEQVRB77yM5LiOIDxjb2RlPkB4bWwtYXR0cjwvY29kZT4g5LqS5pal44CCPC9pdGVtPgoJCQk8aXRlbSBuYW1lPSJAeG1sLW5zLXByZWZpeCIgdHlwZT0ic3RyaW5nIiBhcnJheT0iZmFsc2UiIHJlcXVpcmVkPSJmYWxzZSI+WE1MIOagh+etvueahOWRveWQjeepuumXtOWQjeensOWJjee8gDwvaXRlbT4KCQkJPGl0ZW0gbmFtZT0iQHhtbC13cmFwcGVkIiB0eXBlPSJzdHJpbmciIGFycmF5PSJmYWxzZSIgcmVxdWlyZWQ9ImZhbHNlIj7lpoLmnpzlvZPliY3lhYPntKDnmoQgPGNvZGU+QGFycmF5PC9jb2RlPiDkuLogPHZhcj50cnVlPC92YXI+77yM5YiZ5Y+v5Lul6YCa6L+H5q2k5YC85oyH5a6a5ZyoIFhNTCDmoLzlvI/kuK3nmoTlkI3np7DjgIIKCeWPr+S7peacieS4ieenjeagvOW8j++8mjx1bD4KCTxsaT48c2FtcD5uYW1lPC9zYW1wPu+8muihqOekuuS4uuaVsOe7hOa3u+WKoOS4gOS4queItuWFg+e0oOWQjeensOS4uiA8dmFyPm5hbWU8L3Zhcj7vvJs8L2xpPgoJPGxpPjxzYW1wPm5hbWUxJmd0O25hbWUyPC9zYW1wPu+8muihqOekuuaVsOe7hOmhueeahOWQjeensOaUueS4uiA8dmFyPm5hbWUyPC92YXI+77yM5LiU5re75Yqg5LiA5Liq54i25YWD57Sg5ZCN5Li6IDx2YXI+bmFtZTE8L3Zhcj7vvJs8L2xpPgoJPGxpPjxzYW1wPiZndDtuYW1lPC9zYW1wPu+8muihqOekuuWwhuW9k+WJjeaVsOe7hOWFg+e0oOeahOWQjeensOaUueS4uiA8dmFyPm5hbWU8L3Zhcj7vvJs8L2xpPgoJPC91bD48L2l0ZW0+CgkJCTxpdGVtIG5hbWU9IkBuYW1lIiB0eXBlPSJzdHJpbmciIGFycmF5PSJmYWxzZSIgcmVxdWlyZWQ9ImZhbHNlIj7lvZMgbWltZXR5cGUg5Li6IDx2YXI+YXBwbGljYXRpb24veG1sPC92YXI+IOaXtu+8jOatpOWAvOihqOekuiBYTUwg55qE6aG25bGC5YWD57Sg5ZCN56ew77yM5ZCm5YiZ5peg55So44CCPC9pdGVtPgoJCQk8aXRlbSBuYW1lPSJAdHlwZSIgdHlwZT0idHlwZSIgYXJyYXk9ImZhbHNlIiByZXF1aXJlZD0iZmFsc2UiPuWAvOeahOexu+WeizwvaXRlbT4KCQkJPGl0ZW0gbmFtZT0iQGRlcHJlY2F0ZWQiIHR5cGU9InZlcnNpb24iIGFycmF5PSJmYWxzZSIgcmVxdWlyZWQ9ImZhbHNlIj7ooajnpLrlnKjlpKfkuo7nrYnkuo7or6XniYjmnKzlj7fml7bkuI3lho3lkK/kvZznlKg8L2l0ZW0+CgkJCTxpdGVtIG5hbWU9IkBhcnJheSIgdHlwZT0iYm9vbCIgYXJyYXk9ImZhbHNlIiByZXF1aXJlZD0iZmFsc2UiPuaYr+WQpuS4uuaVsOe7hDwvaXRlbT4KCQkJPGl0ZW0gbmFtZT0iQHN1bW1hcnkiIHR5cGU9InN0cmluZyIgYXJyYXk9ImZhbHNlIiByZXF1aXJlZD0iZmFsc2UiPueugOimgeS7i+e7jTwvaXRlbT4KCQkJPGl0ZW0gbmFtZT0iQHN0YXR1cyIgdHlwZT0ibnVtYmVyIiBhcnJheT0iZmFsc2UiIHJlcXVpcmVkPSJmYWxzZSI+54q25oCB56CB44CC5ZyoIHJlcXVlc3Qg5Lit77yM6K+l5YC85LiN5Y+v55So77yM5ZCm5YiZ5Li65b+F5aGr6aG544CCPC9pdGVtPgoJCQk8aXRlbSBuYW1lPSJAbWltZXR5cGUiIHR5cGU9InN0cmluZyIgYXJyYXk9ImZhbHNlIiByZXF1aXJlZD0iZmFsc2UiPuWqkuS9k+exu+Wei++8jOavlOWmgiA8dmFyPmFwcGxpY2F0aW9uL2pzb248L3Zhcj4g562J44CCPC9pdGVtPgoJCQk8aXRlbSBuYW1lPSJlbnVtIiB0eXBlPSJlbnVtIiBhcnJheT0idHJ1ZSIgcmVxdWlyZWQ9ImZhbHNlIj7lvZPliY3lj4LmlbDlj6/nlKjnmoTmnprkuL7lgLw8L2l0ZW0+CgkJCTxpdGVtIG5hbWU9InBhcmFtIiB0eXBlPSJwYXJhbSIgYXJyYXk9InRydWUiIHJlcXVpcmVkPSJmYWxzZSI+5a2Q57G75Z6L77yM5q+U5aaC5a+56LGh55qE5a2Q5YWD57Sg44CCPC9pdGVtPgoJCQk8aXRlbSBuYW1lPSJleGFtcGxlIiB0eXBlPSJleGFtcGxlIiBhcnJheT0idHJ1ZSIgcmVxdWlyZWQ9ImZhbHNlIj7npLrkvovku6PnoIE8L2l0ZW0+CgkJCTxpdGVtIG5hbWU9ImhlYWRlciIgdHlwZT0icGFyYW0iIGFycmF5PSJ0cnVlIiByZXF1aXJlZD0iZmFsc2UiPuS8oOmAkueahOaKpeWktOWGheWuuTwvaXRlbT4KCQkJPGl0ZW0gbmFtZT0iZGVzY3JpcHRpb24iIHR5cGU9InJpY2h0ZXh0IiBhcnJheT0iZmFsc2UiIHJlcXVpcmVkPSJmYWxzZSI+6K+m57uG5LuL57uN77yM5Li6IEhUTUwg5YaF5a6544CCPC9pdGVtPgoJCTwvdHlwZT4KCQk8dHlwZSBuYW1lPSJleGFtcGxlIj4KCQkJPHVzYWdlPuekuuS+i+S7o+eggTwvdXNhZ2U+CgkJCTxpdGVtIG5hbWU9IkBtaW1ldHlwZSIgdHlwZT0ic3RyaW5nIiBhcnJheT0iZmFsc2UiIHJlcXVpcmVkPSJ0cnVlIj7nibnlrprkuo7nsbvlnovnmoTnpLrkvovku6PnoIE8L2l0ZW0+CgkJCTxpdGVtIG5hbWU9IkBzdW1tYXJ5IiB0eXBlPSJzdHJpbmciIGFycmF5PSJmYWxzZSIgcmVxdWlyZWQ9ImZhbHNlIj7npLrkvovku6PnoIHnmoTmpoLopoHkv6Hmga88L2l0ZW0+CgkJCTxpdGVtIG5hbWU9Ii4iIHR5cGU9InN0cmluZyIgYXJyYXk9ImZhbHNlIiByZXF1aXJlZD0idHJ1ZSI+56S65L6L5Luj56CB55qE5YaF5a6577yM6ZyA6KaB5L2/55SoIENEQVRBIOWMheWQq+S7o+eggeOAgjwvaXRlbT4KCQk8L3R5cGU+CgkJPHR5cGUgbmFtZT0iY2FsbGJhY2siPgoJCQk8dXNhZ2U+5a6a5LmJ5o6l5Y+j55qE5Zue6LCD5YaF5a65PC91c2FnZT4KCQkJPGl0ZW0gbmFtZT0iQG1ldGhvZCIgdHlwZT0ic3RyaW5nIiBhcnJheT0iZmFsc2UiIHJlcXVpcmVkPSJ0cnVlIj7lm57osIPnmoTor7fmsYLmlrnms5U8L2l0ZW0+CgkJCTxpdGVtIG5hbWU9IkBzdW1tYXJ5IiB0eXBlPSJzdHJpbmciIGFycmF5PSJmYWxzZSIgcmVxdWlyZWQ9ImZhbHNlIj7nroDopoHku4vnu408L2l0ZW0+CgkJCTxpdGVtIG5hbWU9IkBkZXByZWNhdGVkIiB0eXBlPSJ2ZXJzaW9uIiBhcnJheT0iZmFsc2UiIHJlcXVpcmVkPSJmYWxzZSI+5Zyo5q2k54mI5pys5LmL5ZCO5bCG5Lya6KKr5byD55SoPC9pdGVtPgoJCQk8aXRlbSBuYW1lPSJwYXRoIiB0eXBlPSJwYXRoIiBhcnJheT0iZmFsc2UiIHJlcXVpcmVkPSJmYWxzZSI+5Zue6LCD55qE6K+35rGC5Zyw5Z2APC9pdGVtPgoJCQk8aXRlbSBuYW1lPSJkZXNjcmlwdGlvbiIgdHlwZT0icmljaHRleHQiIGFycmF5PSJmYWxzZSIgcmVxdWlyZWQ9ImZhbHNlIj7lr7nkuo7lm57osIPnmoTor6bnu4bku4vnu408L2l0ZW0+CgkJCTxpdGVtIG5hbWU9InJlc3BvbnNlIiB0eXBlPSJyZXF1ZXN0IiBhcnJheT0idHJ1ZSIgcmVxdWlyZWQ9ImZhbHNlIj7lrprkuYnlj6/og73nmoTov5Tlm57kv6Hmga88L2l0ZW0+CgkJCTxpdGVtIG5hbWU9InJlcXVlc3QiIHR5cGU9InJlcXVlc3QiIGFycmF5PSJ0cnVlIiByZXF1aXJlZD0idHJ1ZSI+5a6a5LmJ5Y+v55So55qE6K+35rGC5L+h5oGvPC9pdGVtPgoJCQk8aXRlbSBuYW1lPSJoZWFkZXIiIHR5cGU9InBhcmFtIiBhcnJheT0idHJ1ZSIgcmVxdWlyZWQ9ImZhbHNlIj7kvKDpgJLnmoTmiqXlpLTlhoXlrrk8L2l0ZW0+CgkJPC90eXBlPgoJCTx0eXBlIG5hbWU9InN0cmluZyI+CgkJCTx1c2FnZT7mma7pgJrnmoTlrZfnrKbkuLLnsbvlnovvvIznibnmrorlrZfnrKbpnIDopoHkvb/nlKggWE1MIOWunuS9k++8jOavlOWmgiA8c2FtcD4mbHQ7PC9zYW1wPiDpnIDopoHkvb/nlKggPHNhbXA+JmFtcDtsdDs8L3NhbXA+IOS7o+abv+OAgjwvdXNhZ2U+CgkJPC90eXBlPgoJCTx0eXBlIG5hbWU9ImRhdGUiPgoJCQk8dXNhZ2U+6YeH55SoIDxhIGhyZWY9Imh0dHBzOi8vdG9vbHMuaWV0Zi5vcmcvaHRtbC9yZmMzMzM5Ij5SRkMzMzM5PC9hPiDmoLzlvI/ooajnpLrnmoTml7bpl7TvvIzmr5TlpoLvvJo8c2FtcD4yMDE5LTEyLTE2VDAwOjM1OjQ4KzA4OjAwPC9zYW1wPuOAgjwvdXNhZ2U+CgkJPC90eXBlPgoJCTx0eXBlIG5hbWU9InZlcnNpb24iPgoJCQk8dXNhZ2U+54mI5pys5Y+377yM5qC85byP6YG15a6IIDxhIGhyZWY9Imh0dHBzOi8vc2VtdmVyLm9yZy9sYW5nL3poLUNOLyI+c2VtdmVyPC9hPiDop4TliJnjgILmr5TlpoLvvJo8c2FtcD4xLjAuMTwvc2FtcD7jgIE8c2FtcD4xLjAuMSsyMDIwMDYxODwvc2FtcD7jgII8L3VzYWdlPgoJCTwvdHlwZT4KCQk8dHlwZSBuYW1lPSJib29sIj4KCQkJPHVzYWdlPuW4g+WwlOWAvOexu+Wei++8jOWPluWAvOS4uiA8dmFyPnRydWU8L3Zhcj4g5oiW5pivIDx2YXI+ZmFsc2U8L3Zhcj7jgII8L3VzYWdlPgoJCTwvdHlwZT4KCQk8dHlwZSBuYW1lPSJ0eXBlIj4KCQkJPHVzYWdlPueUqOS6juihqOekuuaVsOaNrueahOexu+Wei+WAvO+8jOagvOW8j+S4uiA8Y29kZT5wcmltaXRpdmVbLnN1YnR5cGVdPC9jb2RlPu+8jOWFtuS4rSA8Y29kZT5wcmltaXRpdmU8L2NvZGU+IOS4uuWfuuacrOexu+Wei++8jOiAjCA8Y29kZT5zdWJ0eXBlPC9jb2RlPiDkuLrlrZDnsbvlnovvvIznlKjkuo7lr7kgPGNvZGU+cHJpbWl0aXZlPC9jb2RlPiDov5vooYzov5vkuIDmraXnmoTnuqbmnZ/vvIzlvZPlrqLmiLfnq6/ml6Dms5XlpITnkIbmlbTkuKrnsbvlnovml7bvvIzlj6/ku6XmjInnhacgPGNvZGU+cHJpbWl0aXZlPC9jb2RlPiDnmoTnsbvlnovlpITnkIbjgII8YnIgLz4KCeebruWJjeaUr+aMgeS7peS4i+WHoOenjeexu+Wei++8mjx1bD4KCTxsaT7nqbrlgLzvvJs8L2xpPgoJPGxpPjx2YXI+Ym9vbDwvdmFyPiDluIPlsJTlgLzvvJs8L2xpPgoJPGxpPjx2YXI+b2JqZWN0PC92YXI+IOWvueixoe+8mzwvbGk+Cgk8bGk+PHZhcj5udW1iZXI8L3Zhcj4g5pWw5YC857G75Z6L77ybPC9saT4KCTxsaT48dmFyPm51bWJlci5pbnQ8L3Zhcj4g5pW05pWw57G75Z6L55qE5pWw5YC877ybPC9saT4KCTxsaT48dmFyPm51bWJlci5mbG9hdDwvdmFyPiDmta7ngrnnsbvlnovnmoTmlbDlgLzvvJs8L2xpPgoJPGxpPjx2YXI+c3RyaW5nPC92YXI+IOWtl+espuS4su+8mzwvbGk+Cgk8bGk+PHZhcj5zdHJpbmcudXJsPC92YXI+IFVSTCDnsbvlnovnmoTlrZfnrKbkuLLvvJs8L2xpPgoJPGxpPjx2YXI+c3RyaW5nLmVtYWlsPC92YXI+IGVtYWlsIOexu+Wei+eahOWtl+espuS4su+8mzwvbGk+Cgk8bGk+PHZhcj5zdHJpbmcuaW1hZ2U8L3Zhcj4g6KGo56S65Zu+54mH5Zyw5Z2A55qEIFVSTO+8mzwvbGk+Cgk8bGk+PHZhcj5zdHJpbmcuZGF0ZTwvdmFyPiDooajnpLogPGEgaHJlZj0iaHR0cHM6Ly90b29scy5pZXRmLm9yZy9odG1sL3JmYzMzMzkjc2VjdGlvbi01LjYiPlJGQzMzMzk8L2E+IOS4reeahCA8Y29kZT5mdWxsLWRhdGU8L2NvZGU+IOaXpeacn+agvOW8j++8jOavlOWmgiA8c2FtcD4yMDIwLTAxLTAyPC9zYW1wPu+8mzwvbGk+Cgk8bGk+PHZhcj5zdHJpbmcudGltZTwvdmFyPiDooajnpLogPGEgaHJlZj0iaHR0cHM6Ly90b29scy5pZXRmLm9yZy9odG1sL3JmYzMzMzkjc2VjdGlvbi01LjYiPlJGQzMzMzk8L2E+IOS4reeahCA8Y29kZT5mdWxsLXRpbWU8L2NvZGU+IOaXtumXtOagvOW8j++8jOavlOWmgiA8c2FtcD4xNToxNjoxN1o8L3NhbXA+44CBPHNhbXA+MTU6MTY6MTcrMDg6MDA8L3NhbXA+77ybPC9saT4KCTxsaT48dmFyPnN0cmluZy5kYXRlLXRpbWU8L3Zhcj4g6KGo56S6IDxhIGhyZWY9Imh0dHBzOi8vdG9vbHMuaWV0Zi5vcmcvaHRtbC9yZmMzMzM5I3NlY3Rpb24tNS42Ij5SRkMzMzM5PC9hPiDkuK3nmoQgPGNvZGU+ZGF0ZS10aW1lPC9jb2RlPiDmoLzlvI/vvIzmr5TlpoIgPHNhbXA+MjAyMC0wMS0wMlQxNToxNjoxNy0wODowMDwvc2FtcD7vvJs8L2xpPgoJPC91bD48L3VzYWdlPgoJCTwvdHlwZT4KCQk8dHlwZSBuYW1lPSJudW1iZXIiPgoJCQk8dXNhZ2U+5pmu6YCa55qE5pWw5YC857G75Z6L77yM5q+U5aaC77yaPHNhbXA+MTwvc2FtcD7jgIE8c2FtcD4tMTEuMTwvc2FtcD4g562J44CCPC91c2FnZT4KCQk8L3R5cGU+Cgk8L3NwZWM+Cgk8Y29tbWFuZHM+CgkJPGNvbW1hbmQgbmFtZT0iYnVpbGQiPueUn+aIkOaWh+aho+WGheWuuTwvY29tbWFuZD4KCQk8Y29tbWFuZCBuYW1lPSJkZXRlY3QiPuagueaNruebruW9leS4i+eahOWGheWuueeUn+aIkOmFjee9ruaWh+S7tjwvY29tbWFuZD4KCQk8Y29tbWFuZCBuYW1lPSJoZWxwIj7mmL7npLrluK7liqnkv6Hmga88L2NvbW1hbmQ+CgkJPGNvbW1hbmQgbmFtZT0ibGFuZyI+5pi+56S65omA5pyJ5pSv5oyB55qE6K+t6KiAPC9jb21tYW5kPgoJCTxjb21tYW5kIG5hbWU9ImxvY2FsZSI+5pi+56S65omA5pyJ5pSv5oyB55qE5pys5Zyw5YyW5YaF5a65PC9jb21tYW5kPgoJCTxjb21tYW5kIG5hbWU9ImxzcCI+5ZCv5YqoIGxhbmd1YWdlIHNlcnZlciBwcm90b2NvbCDmnI3liqE8L2NvbW1hbmQ+CgkJPGNvbW1hbmQgbmFtZT0ibW9jayI+5ZCv55SoIG1vY2sg5pyN5YqhPC9jb21tYW5kPgoJCTxjb21tYW5kIG5hbWU9InN0YXRpYyI+5ZCv55So6Z2Z5oCB5paH5Lu25pyN5YqhPC9jb21tYW5kPgoJCTxjb21tYW5kIG5hbWU9InN5bnRheCI+5rWL6K+V6K+t5rOV55qE5q2j56Gu5oCnPC9jb21tYW5kPgoJCTxjb21tYW5kIG5hbWU9InZlcnNpb24iPuaYvuekuueJiOacrOS/oeaBrzwvY29tbWFuZD4KCTwvY29tbWFuZHM+Cgk8Y29uZmlnPgoJCTxpdGVtIG5hbWU9InZlcnNpb24iIHR5cGU9InN0cmluZyIgYXJyYXk9ImZhbHNlIiByZXF1aXJlZD0idHJ1ZSI+5q2k6YWN572u5paH5Lu255qE5omA5L2/55So55qE5paH5qGj54mI5pysPC9pdGVtPgoJCTxpdGVtIG5hbWU9ImlucHV0cyIgdHlwZT0ib2JqZWN0IiBhcnJheT0idHJ1ZSIgcmVxdWlyZWQ9InRydWUiPuaMh+Wumui+k+WFpeeahOaVsOaNru+8jOWQjOS4gOmhueebruWPquiDveino+aekOS4gOenjeivreiogOOAgjwvaXRlbT4KCQk8aXRlbSBuYW1lPSJpbnB1dHMubGFuZyIgdHlwZT0ic3RyaW5nIiBhcnJheT0iZmFsc2UiIHJlcXVpcmVkPSJ0cnVlIj7mupDmlofku7bnmoTop6PmnpDmlrnlvI/jgILlhbfkvZPmlK/mjIHnmoTnsbvlnovlj6/pgJrov4flkb3ku6QgPHNhbXA+YXBpZG9jIGxhbmc8L3NhbXA+IOafpeeci+aUr+aMgeivreiogOOAgjwvaXRlbT4KCQk8aXRlbSBuYW1lPSJpbnB1dHMuZGlyIiB0eXBlPSJzdHJpbmciIGFycmF5PSJmYWxzZSIgcmVxdWlyZWQ9InRydWUiPumcgOimgeino+aekOeahOa6kOaWh+S7tuaJgOWcqOebruW9lTwvaXRlbT4KCQk8aXRlbSBuYW1lPSJpbnB1dHMuZXh0cyIgdHlwZT0ic3RyaW5nIiBhcnJheT0idHJ1ZSIgcmVxdWlyZWQ9ImZhbHNlIj7lj6rku47ov5nkupvmianlsZXlkI3nmoTmlofku7bkuK3mn6Xmib7mlofmoaM8L2l0ZW0+CgkJPGl0ZW0gbmFtZT0iaW5wdXRzLnJlY3Vyc2l2ZSIgdHlwZT0iYm9vbCIgYXJyYXk9ImZhbHNlIiByZXF1aXJlZD0iZmFsc2UiPuaYr+WQpuino+aekOWtkOebruW9leS4i+eahOa6kOaWh+S7tjwvaXRlbT4KCQk8aXRlbSBuYW1lPSJpbnB1dHMuZW5jb2RpbmciIHR5cGU9InN0cmluZyIgYXJyYXk9ImZhbHNlIiByZXF1aXJlZD0iZmFsc2UiPue8luegge+8jOm7mOiupOS4uiA8dmFyPnV0Zi04PC92YXI+77yM5YC85Y+v5Lul5pivIDxhIGhyZWY9Imh0dHBzOi8vd3d3LmlhbmEub3JnL2Fzc2lnbm1lbnRzL2NoYXJhY3Rlci1zZXRzL2NoYXJhY3Rlci1zZXRzLnhodG1sIj5jaGFyYWN0ZXItc2V0czwvYT4g5Lit55qE5YaF5a6544CCPC9pdGVtPgoJCTxpdGVtIG5hbWU9ImlucHV0cy5pZ25vcmVzIiB0eXBlPSJzdHJpbmciIGFycmF5PSJ0cnVlIiByZXF1aXJlZD0iZmFsc2UiPuW/veeVpeeahOaWh+S7tuaIluebruW9le+8jOavlOWmgiBub2RlX21vZHVsZXMg562J44CCPC9pdGVtPgoJCTxpdGVtIG5hbWU9Im91dHB1dCIgdHlwZT0ib2JqZWN0IiBhcnJheT0iZmFsc2UiIHJlcXVpcmVkPSJ0cnVlIj7mjqfliLbovpPlh7rooYzkuLo8L2l0ZW0+CgkJPGl0ZW0gbmFtZT0ib3V0cHV0LnR5cGUiIHR5cGU9InN0cmluZyIgYXJyYXk9ImZhbHNlIiByZXF1aXJlZD0iZmFsc2UiPui+k+WHuueahOexu+Wei++8jOebruWJjeWPr+S7pSA8dmFyPmFwaWRvYyt4bWw8L3Zhcj7jgIE8dmFyPm9wZW5hcGkranNvbjwvdmFyPiDlkowgPHZhcj5vcGVuYXBpK3lhbWw8L3Zhcj7jgII8L2l0ZW0+CgkJPGl0ZW0gbmFtZT0ib3V0cHV0LnBhdGgiIHR5cGU9InN0cmluZyIgYXJyYXk9ImZhbHNlIiByZXF1aXJlZD0idHJ1ZSI+5oyH5a6a6L6T5Ye655qE5paH5Lu25ZCN77yM5YyF5ZCr6Lev5b6E5L+h5oGv44CCPC9pdGVtPgoJCTxpdGVtIG5hbWU9Im91dHB1dC50YWdzIiB0eXBlPSJzdHJpbmciIGFycmF5PSJ0cnVlIiByZXF1aXJlZD0iZmFsc2UiPuWPqui+k+WHuuS4jui/meS6m+agh+etvuebuOWFs+iBlOeahOaWh+aho++8jOm7mOiupOS4uuWFqOmDqOOAgjwvaXRlbT4KCQk8aXRlbSBuYW1lPSJvdXRwdXQuc3R5bGUiIHR5cGU9InN0cmluZyIgYXJyYXk9ImZhbHNlIiByZXF1aXJlZD0iZmFsc2UiPuS4uiBYTUwg5paH5Lu25oyH5a6a55qEIFhTTCDmlofku7Y8L2l0ZW0+CgkJPGl0ZW0gbmFtZT0ib3V0cHV0Lm5hbWVzcGFjZSIgdHlwZT0iYm9vbCIgYXJyYXk9ImZhbHNlIiByZXF1aXJlZD0iZmFsc2UiPuaYr+WQpui+k+WHuuWRveWQjeepuumXtDwvaXRlbT4KCQk8aXRlbSBuYW1lPSJvdXRwdXQubmFtZXNwYWNlLXByZWZpeCIgdHlwZT0ic3RyaW5nIiBhcnJheT0iZmFsc2UiIHJlcXVpcmVkPSJmYWxzZSI+5aaC5p6c6L6T5Ye65LqG5ZG95ZCN56m66Ze077yM6L+Y5Y+v5Lul5oyH5a6a5ZG95ZCN56m66Ze05YmN57yA44CCPC9pdGVtPgoJPC9jb25maWc+CjwvbG9jYWxlPgoAARNsb2NhbGUuY21uLUhhbnQueG1sAR5hcHBsaWNhdGlvbi94bWw7IGNoYXJzZXQ9dXRmLTgB/kf6PD94bWwgdmVyc2lvbj0iMS4wIiBlbmNvZGluZz0iVVRGLTgiPz4KCjwhLS0g6K+l5paH5Lu255Sx5bel5YW36Ieq5Yqo55Sf5oiQ77yM6K+35Yu/5omL5Yqo5L+u5pS577yBIC0tPgoKPGxvY2FsZT4KCTxzcGVjPgoJCTx0eXBlIG5hbWU9ImFwaWRvYyI+CgkJCTx1c2FnZT7nlKjmlrzmj4/ov7DmlbTlgIvmlofmqpTnmoTnm7jpl5zlhaflrrnvvIzlj6rog73lh7rnj77lo7nmrKHjgII8L3VzYWdlPgoJCQk8aXRlbSBuYW1lPSJAYXBpZG9jIiB0eXBlPSJzdHJpbmciIGFycmF5PSJmYWxzZSIgcmVxdWlyZWQ9ImZhbHNlIj7mlofmqpTnmoTniYjmnKzopoHomZ88L2l0ZW0+CgkJCTxpdGVtIG5hbWU9IkBsYW5nIiB0eXBlPSJzdHJpbmciIGFycmF5PSJmYWxzZSIgcmVxdWlyZWQ9ImZhbHNlIj7mlofmqpTlhaflrrnnmoTmnKzlnLDljJYgSUTvvIzmr5TlpoIgPHZhcj56aC1IYW5zPC92YXI+44CBPHZhcj5lbi1VUzwvdmFyPiDnrYnjgII8L2l0ZW0+CgkJCTxpdGVtIG5hbWU9IkBsb2dvIiB0eXBlPSJzdHJpbmciIGFycmF5PSJmYWxzZSIgcmVxdWlyZWQ9ImZhbHNlIj7mlofmqpTnmoTlnJbmqJnvvIzlg4Xlj6/kvb/nlKggU1ZHIOagvOW8j+WcluaomeOAgjwvaXRlbT4KCQkJPGl0ZW0gbmFtZT0iQGNyZWF0ZWQiIHR5cGU9ImRhdGUiIGFycmF5PSJmYWxzZSIgcmVxdWlyZWQ9ImZhbHNlIj7mlofmqpTnmoTlibXlu7rmmYLplpM8L2l0ZW0+CgkJCTxpdGVtIG5hbWU9IkB2ZXJzaW9uIiB0eXBlPSJ2ZXJzaW9uIiBhcnJheT0iZmFsc2UiIHJlcXVpcmVkPSJmYWxzZSI+5paH5qqU55qE54mI5pys6JmfPC9pdGVtPgoJCQk8aXRlbSBuYW1lPSJ4bWwtbmFtZXNwYWNlIiB0eXBlPSJ4bWwtbmFtZXNwYWNlIiBhcnJheT0idHJ1ZSIgcmVxdWlyZWQ9ImZhbHNlIj7ph53lsI0gPHZhcj5hcHBsaWNhdGlvbi94bWw8L3Zhcj4g6aGe5Z6L55qE5YWn5a6555qE5ZG95ZCN56m66ZaT6Kit572uPC9pdGVtPgoJCQk8aXRlbSBuYW1lPSJ0aXRsZSIgdHlwZT0ic3RyaW5nIiBhcnJheT0iZmFsc2UiIHJlcXVpcmVkPSJ0cnVlIj7mlofmqpTnmoTmqJnpoYw8L2l0ZW0+CgkJCTxpdGVtIG5hbWU9ImRlc2NyaXB0aW9uIiB0eXBlPSJyaWNodGV4dCIgYXJyYXk9ImZhbHNlIiByZXF1aXJlZD0iZmFsc2UiPuaWh+aqlOeahOaVtOmrlOaPj+i/sOWFp+WuuTwvaXRlbT4KCQkJPGl0ZW0gbmFtZT0iY29udGFjdCIgdHlwZT0iY29udGFjdCIgYXJyYXk9ImZhbHNlIiByZXF1aXJlZD0iZmFsc2UiPuaWh+aqlOS9nOiAheeahOiBr+ezu+aWueW8jzwvaXRlbT4KCQkJPGl0ZW0gbmFtZT0ibGljZW5zZSIgdHlwZT0ibGluayIgYXJyYXk9ImZhbHNlIiByZXF1aXJlZD0iZmFsc2UiPuaWh+aqlOeahOeJiOasiuS/oeaBrzwvaXRlbT4KCQkJPGl0ZW0gbmFtZT0idGFnIiB0eXBlPSJ0YWciIGFycmF5PSJ0cnVlIiByZXF1aXJlZD0iZmFsc2UiPuaWh+aqlOS4reWumue+qeeahOaJgOacieaomeewvTwvaXRlbT4KCQkJPGl0ZW0gbmFtZT0ic2VydmVyIiB0eXBlPSJzZXJ2ZXIiIGFycmF5PSJ0cnVlIiByZXF1aXJlZD0iZmFsc2UiPkFQSSDln7rlnLDlnYDliJfooajvvIzmr4/lgIsgQVBJIOacgOWwkeaHieipsuacieWjueWAiyBzZXJ2ZXLjgII8L2l0ZW0+CgkJCTxpdGVtIG5hbWU9ImFwaSIgdHlwZT0iYXBpIiBhcnJheT0idHJ1ZSIgcmVxdWlyZWQ9ImZhbHNlIj7mlofmqpTkuK3nmoQgQVBJIOaWh+aqlDwvaXRlbT4KCQkJPGl0ZW0gbmFtZT0iaGVhZGVyIiB0eXBlPSJwYXJhbSIgYXJyYXk9InRydWUiIHJlcXVpcmVkPSJmYWxzZSI+5paH5qqU5Lit5omA5pyJIEFQSSDpg73ljIXlkKvnmoTlhazlhbHloLHpoK08L2l0ZW0+CgkJCTxpdGVtIG5hbWU9InJlc3BvbnNlIiB0eXBlPSJyZXF1ZXN0IiBhcnJheT0idHJ1ZSIgcmVxdWlyZWQ9ImZhbHNlIj7mlofmqpTkuK3miYDmnIkgQVBJIOaWh+aqlOmDvemcgOimgeaUr+aMgeeahOi/lOWbnuWFp+WuuTwvaXRlbT4KCQkJPGl0ZW0gbmFtZT0ibWltZXR5cGUiIHR5cGU9InN0cmluZyIgYXJyYXk9InRydWUiIHJlcXVpcmVkPSJ0cnVlIj7mlofmqpTmiYDmlK/mjIHnmoQgbWltZXR5cGU8L2l0ZW0+CgkJPC90eXBlPgoJCTx0eXBlIG5hbWU9InhtbC1uYW1lc3BhY2UiPgoJCQk8dXNhZ2U+54K6IDx2YXI+YXBwbGljYXRpb24veG1sPC92YXI+IOWumue+qeWRveWQjeepuumWk+eahOebuOmXnOWxrOaApzwvdXNhZ2U+CgkJCTxpdGVtIG5hbWU9IkBwcmVmaXgiIHR5cGU9InN0cmluZyIgYXJyYXk9ImZhbHNlIiByZXF1aXJlZD0iZmFsc2UiPuWRveWQjeepuumWk+eahOWJjee2tO+8jOWmguaenOeCuuepuu+8jOWJh+ihqOekuuS9nOeCuum7mOiqjeWRveWQjeepuumWk++8jOWRveWxgOWPquiDveacieWjueWAi+m7mOiqjeWRveWQjeepuumWk+OAgjwvaXRlbT4KCQkJPGl0ZW0gbmFtZT0iQHVybiIgdHlwZT0ic3RyaW5nIiBhcnJheT0iZmFsc2UiIHJlcXVpcmVkPSJ0cnVlIj7lkb3lkI3nqbrplpPnmoTllK/lo7nmqJnorZjvvIzpnIDopoHlhajlsYDllK/lo7nvvIzkuJTljYDliIblpKflsI/lr6vjgII8L2l0ZW0+CgkJPC90eXBlPgoJCTx0eXBlIG5hbWU9InJpY2h0ZXh0Ij4KCQkJPHVzYWdlPuWvjOaWh+acrOWFp+WuuTwvdXNhZ2U+CgkJCTxpdGVtIG5hbWU9IkB0eXBlIiB0eXBlPSJzdHJpbmciIGFycmF5PSJmYWxzZSIgcmVxdWlyZWQ9InRydWUiPuaMh+WumuWvjOaWh+acrOWFp+WuueeahOagvOW8j++8jOebruWJjeaUr+aMgSA8dmFyPmh0bWw8L3Zhcj4g5ZKMIDx2YXI+bWFya2Rvd248L3Zhcj7jgII8L2l0ZW0+CgkJCTxpdGVtIG5hbWU9Ii4iIHR5cGU9InN0cmluZyIgYXJyYXk9ImZhbHNlIiByZXF1aXJlZD0idHJ1ZSI+5a+M5paH5pys55qE5a+m6Zqb5YWn5a65PC9pdGVtPgoJCTwvdHlwZT4KCQk8dHlwZSBuYW1lPSJjb250YWN0Ij4KCQkJPHVzYWdlPueUqOaWvOaPj+i/sOiBr+ezu+aWueW8jzwvdXNhZ2U+CgkJCTxpdGVtIG5hbWU9IkBuYW1lIiB0eXBlPSJzdHJpbmciIGFycmF5PSJmYWxzZSIgcmVxdWlyZWQ9InRydWUiPuiBr+ezu+S6uueahOWQjeeosTwvaXRlbT4KCQkJPGl0ZW0gbmFtZT0idXJsIiB0eXBlPSJzdHJpbmciIGFycmF5PSJmYWxzZSIgcmVxdWlyZWQ9ImZhbHNlIj7oga/ns7vkurrnmoQgVVJMPC9pdGVtPgoJCQk8aXRlbSBuYW1lPSJlbWFpbCIgdHlwZT0ic3RyaW5nIiBhcnJheT0iZmFsc2UiIHJlcXVpcmVkPSJmYWxzZSI+6IGv57O75Lq655qE6Zu75a2Q6YO15Lu2PC9pdGVtPgoJCTwvdHlwZT4KCQk8dHlwZSBuYW1lPSJsaW5rIj4KCQkJPHVzYWdlPueUqOaWvOaPj+i/sOmPiOaOpeS/oeaBr++8jOWjueiIrOi9ieaPm+eCuiBIVE1MIOeahCA8Y29kZT5hPC9jb2RlPiDmqJnnsL3jgII8L3VzYWdlPgoJCQk8aXRlbSBuYW1lPSJAdGV4dCIgdHlwZT0ic3RyaW5nIiBhcnJheT0iZmFsc2UiIHJlcXVpcmVkPSJ0cnVlIj7pj4jmjqXnmoTlrZfpnaLmloflrZc8L2l0ZW0+CgkJCTxpdGVtIG5hbWU9IkB1cmwiIHR5cGU9InN0cmluZyIgYXJyYXk9ImZhbHNlIiByZXF1aXJlZD0idHJ1ZSI+6Y+I5o6l5oyH5ZCR55qE5paH5pysPC9pdGVtPgoJCTwvdHlwZT4KCQk8dHlwZSBuYW1lPSJ0YWciPgoJCQk8dXNhZ2U+55So5pa85bCN5ZCE5YCLIEFQSSDpgLLooYzliIbpoZ48L3VzYWdlPgoJCQk8aXRlbSBuYW1lPSJAbmFtZSIgdHlwZT0ic3RyaW5nIiBhcnJheT0iZmFsc2UiIHJlcXVpcmVkPSJ0cnVlIj7mqJnnsL3nmoTllK/lo7kgSUQ8L2l0ZW0+CgkJCTxpdGVtIG5hbWU9IkB0aXRsZSIgdHlwZT0ic3RyaW5nIiBhcnJheT0iZmFsc2UiIHJlcXVpcmVkPSJ0cnVlIj7mqJnnsL3nmoTlrZfpnaLlkI3nqLE8L2l0ZW0+CgkJCTxpdGVtIG5hbWU9IkBkZXByZWNhdGVkIiB0eXBlPSJ2ZXJzaW9uIiBhcnJheT0iZmFsc2UiIHJlcXVpcmVkPSJmYWxzZSI+6Kmy5qiZ57C95Zyo5aSn5pa86Kmy54mI5pys5pmC6KKr5qOE55SoPC9pdGVtPgoJCTwvdHlwZT4KCQk8dHlwZSBuYW1lPSJzZXJ2ZXIiPgoJCQk8dXNhZ2U+55So5pa85oyH5a6a5ZCE5YCLIEFQSSDnmoTmnI3li5nlmajlnLDlnYA8L3VzYWdlPgoJCQk8aXRlbSBuYW1lPSJAbmFtZSIgdHlwZT0ic3RyaW5nIiBhcnJheT0iZmFsc2UiIHJlcXVpcmVkPSJ0cnVlIj7mnI3li5nllK/lo7kgSUQ8L2l0ZW0+CgkJCTxpdGVtIG5hbWU9IkB1cmwiIHR5cGU9InN0cmluZyIgYXJyYXk9ImZhbHNlIiByZXF1aXJlZD0idHJ1ZSI+5pyN5YuZ55qE5Z+65Zyw5Z2A77yM6IiH6Kmy5pyN5YuZ6Zec6IGv55qEIEFQSe+8jOioquWVj+WcsOWdgOmDveaYr+ebuOWwjeaWvOatpOWcsOWdgOeahOOAgjwvaXRlbT4KCQkJPGl0ZW0gbmFtZT0iQGRlcHJlY2F0ZWQiIHR5cGU9InZlcnNpb24iIGFycmF5PSJmYWxzZSIgcmVxdWlyZWQ9ImZhbHNlIj7mnI3li5nlnKjlpKfmlrzoqbLniYjmnKzmmYLooqvmo4TnlKg8L2l0ZW0+CgkJCTxpdGVtIG5hbWU9IkBzdW1tYXJ5IiB0eXBlPSJzdHJpbmciIGFycmF5PSJmYWxzZSIgcmVxdWlyZWQ9ImZhbHNlIj7mnI3li5nnmoTmkZjopoHkv6Hmga88L2l0ZW0+CgkJCTxpdGVtIG5hbWU9ImRlc2NyaXB0aW9uIiB0eXBlPSJyaWNodGV4dCIgYXJyYXk9ImZhbHNlIiByZXF1aXJlZD0iZmFsc2UiPuacjeWLmeeahOips+e0sOaPj+i/sDwvaXRlbT4KCQk8L3R5cGU+CgkJPHR5cGUgbmFtZT0iYXBpIj4KCQkJPHVzYWdlPueUqOaWvOWumue+qeWWruWAiyBBUEkg5o6l5Y+j55qE5YW36auU5YWn5a65PC91c2FnZT4KCQkJPGl0ZW0gbmFtZT0iQHZlcnNpb24iIHR5cGU9InZlcnNpb24iIGFycmF5PSJmYWxzZSIgcmVxdWlyZWQ9ImZhbHNlIj7ooajnpLrmraTmjqXlj6PlnKjoqbLniYjmnKzkuK3mt7vliqA8L2l0ZW0+CgkJCTxpdGVtIG5hbWU9IkBtZXRob2QiIHR5cGU9InN0cmluZyIgYXJyYXk9ImZhbHNlIiByZXF1aXJlZD0idHJ1ZSI+55W25YmN5o6l5Y+j5omA5pSv5oyB55qE6KuL5rGC5pa55rOVPC9pdGVtPgoJCQk8aXRlbSBuYW1lPSJAaWQiIHR5cGU9InN0cmluZyIgYXJyYXk9ImZhbHNlIiByZXF1aXJlZD0iZmFsc2UiPuaOpeWPo+eahOWUr+WjuSBJRDwvaXRlbT4KCQkJPGl0ZW0gbmFtZT0iQHN1bW1hcnkiIHR5cGU9InN0cmluZyIgYXJyYXk9ImZhbHNlIiByZXF1aXJlZD0iZmFsc2UiPuewoeimgeS7i+e0uTwvaXRlbT4KCQkJPGl0ZW0gbmFtZT0iQGRlcHJlY2F0ZWQiIHR5cGU9InZlcnNpb24iIGFycmF5PSJmYWxzZSIgcmVxdWlyZWQ9ImZhbHNlIj7lnKjmraTniYjmnKzkuYvlvozlsIfmnIPooqvmo4TnlKg8L2l0ZW0+CgkJCTxpdGVtIG5hbWU9InBhdGgiIHR5cGU9InBhdGgiIGFycmF5PSJmYWxzZSIgcmVxdWlyZWQ9InRydWUiPuWumue+qei3r+W+keS/oeaBrzwvaXRlbT4KCQkJPGl0ZW0gbmFtZT0iZGVzY3JpcHRpb24iIHR5cGU9InJpY2h0ZXh0IiBhcnJheT0iZmFsc2UiIHJlcXVpcmVkPSJmYWxzZSI+6Kmy5o6l5Y+j55qE6Kmz57Sw5LuL57S577yM54K6IEhUTUwg5YWn5a6544CCPC9pdGVtPgoJCQk8aXRlbSBuYW1lPSJyZXF1ZXN0IiB0eXBlPSJyZXF1ZXN0IiBhcnJheT0idHJ1ZSIgcmVxdWlyZWQ9ImZhbHNlIj7lrprnvqnlj6/nlKjnmoToq4vmsYLkv6Hmga88L2l0ZW0+CgkJCTxpdGVtIG5hbWU9InJlc3BvbnNlIiB0eXBlPSJyZXF1ZXN0IiBhcnJheT0idHJ1ZSIgcmVxdWlyZWQ9ImZhbHNlIj7lrprnvqnlj6/og73nmoTov5Tlm57kv6Hmga88L2l0ZW0+CgkJCTxpdGVtIG5hbWU9ImNhbGxiYWNrIiB0eXBlPSJjYWxsYmFjayIgYXJyYXk9ImZhbHNlIiByZXF1aXJlZD0iZmFsc2UiPuWumue+qeWbnuiqv+aOpeWPo+WFp+WuuTwvaXRlbT4KCQkJPGl0ZW0gbmFtZT0iaGVhZGVyIiB0eXBlPSJwYXJhbSIgYXJyYXk9InRydWUiIHJlcXVpcmVkPSJmYWxzZSI+5YKz6YGe55qE5aCx6aCt5YWn5a6577yM5aaC5p6c5piv5p+Q5YCLIG1pbWV0eXBlIOWwiOeUqOeahO+8jOWPr+S7peaUvuWcqCByZXF1ZXN0IOWFg+e0oOS4reOAgjwvaXRlbT4KCQkJPGl0ZW0gbmFtZT0idGFnIiB0eXBlPSJzdHJpbmciIGFycmF5PSJ0cnVlIiByZXF1aXJlZD0iZmFsc2UiPumXnOiBr+eahOaomeewvTwvaXRlbT4KCQkJPGl0ZW0gbmFtZT0ic2VydmVyIiB0eXBlPSJzdHJpbmciIGFycmF5PSJ0cnVlIiByZXF1aXJlZD0iZmFsc2UiPumXnOiBr+eahOacjeWLmTwvaXRlbT4KCQk8L3R5cGU+CgkJPHR5cGUgbmFtZT0icGF0aCI+CgkJCTx1c2FnZT7nlKjmlrzlrprnvqnoq4vmsYLmmYLoiIfot6/lvpHnm7jpl5znmoTlhaflrrk8L3VzYWdlPgoJCQk8aXRlbSBuYW1lPSJAcGF0aCIgdHlwZT0ic3RyaW5nIiBhcnJheT0iZmFsc2UiIHJlcXVpcmVkPSJ0cnVlIj7mjqXlj6PlnLDlnYA8L2l0ZW0+CgkJCTxpdGVtIG5hbWU9InBhcmFtIiB0eXBlPSJwYXJhbSIgYXJyYXk9InRydWUiIHJlcXVpcmVkPSJmYWxzZSI+5Zyw5Z2A5Lit55qE5Y+D5pW4PC9pdGVtPgoJCQk8aXRlbSBuYW1lPSJxdWVyeSIgdHlwZT0icGFyYW0iIGFycmF5PSJ0cnVlIiByZXF1aXJlZD0iZmFsc2UiPuWcsOWdgOS4reeahOafpeipouWPg+aVuDwvaXRlbT4KCQk8L3R5cGU+CgkJPHR5cGUgbmFtZT0icGFyYW0iPgoJCQk8dXNhZ2U+5Y+D5pW46aGe5Z6L77yM5Z+65pys5LiK5Y+v5Lul5L2c54K6IHJlcXVlc3Qg55qE5a2Q6ZuG5L2/55So44CCPC91c2FnZT4KCQkJPGl0ZW0gbmFtZT0iQHhtbC1hdHRyIiB0eXBlPSJib29sIiBhcnJheT0iZmFsc2UiIHJlcXVpcmVkPSJmYWxzZSI+5piv5ZCm5L2c54K654i25YWD57Sg55qE5bGs5oCn77yM5YOF5L2c55So5pa8IFhNTCDlhYPntKDjgILmmK/lkKbkvZzngrrniLblhYPntKDnmoTlsazmgKfvvIzlg4XnlKjmlrwgWE1MIOeahOiri+axguOAgjwvaXRlbT4KCQkJPGl0ZW0gbmFtZT0iQHhtbC1leHRyYWN0IiB0eXBlPSJib29sIiBhcnJheT0iZmFsc2UiIHJlcXVpcmVkPSJmYWxzZSI+5bCH55W25YmN5YWD57Sg55qE5YWn5a655L2c54K654i25YWD57Sg55qE5YWn5a6577yM6KaB5rGC54i25YWD57Sg5b+F6aCI54K6IDx2YXI+b2JqZWN0PC92YXI+44CCPC9pdGVtPgoJCQk8aXRlbSBuYW1lPSJAeG1sLWNkYXRhIiB0eXBlPSJib29sIiBhcnJheT0iZmFsc2UiIHJlcXVpcmVkPSJmYWxzZSI+55W25YmN5YWn5a6554K6IENEQVRB77yM6IiHIDxjb2RlPkB4bWwtYXR0cjwvY29kZT4g5LqS5pal44CCPC9pdGVtPgoJCQk8aXRlbSBuYW1lPSJAeG1sLW5zLXByZWZpeCIgdHlwZT0ic3RyaW5nIiBhcnJheT0iZmFsc2UiIHJlcXVpcmVkPSJmYWxzZSI+WE1MIOaomeewveeahOWRveWQjeepuumWk+WQjeeoseWJjee2tDwvaXRlbT4KCQkJPGl0ZW0gbmFtZT0iQHhtbC13cmFwcGVkIiB0eXBlPSJzdHJpbmciIGFycmF5PSJmYWxzZSIgcmVxdWlyZWQ9ImZhbHNlIj7lpoLmnpznlbbliY3lhYPntKDnmoQgPGNvZGU+QGFycmF5PC9jb2RlPiDngrogPHZhcj50cnVlPC92YXI+77yM5YmH5Y+v5Lul6YCa6YGO5q2k5YC85oyH5a6a5ZyoIFhNTCDmoLzlvI/kuK3nmoTlkI3nqLHjgIIKCeWPr+S7peacieS4ieeoruagvOW8j++8mjx1bD4KCTxsaT48c2FtcD5uYW1lPC9zYW1wPu+8muihqOekuueCuuaVuOe1hOa3u+WKoOWjueWAi+eItuWFg+e0oOWQjeeoseeCuiA8dmFyPm5hbWU8L3Zhcj7vvJs8L2xpPgoJPGxpPjxzYW1wPm5hbWUxJmd0O25hbWUyPC9zYW1wPu+8muihqOekuuaVuOe1hOmgheeahOWQjeeoseaUueeCuiA8dmFyPm5hbWUyPC92YXI+77yM5LiU5re75Yqg5aO55YCL54i25YWD57Sg5ZCN54K6IDx2YXI+bmFtZTE8L3Zhcj7vvJs8L2xpPgoJPGxpPjxzYW1wPiZndDtuYW1lPC9zYW1wPu+8muihqOekuuWwh+eVtuWJjeaVuOe1hOWFg+e0oOeahOWQjeeoseaUueeCuiA8dmFyPm5hbWU8L3Zhcj7vvJs8L2xpPgoJPC91bD48L2l0ZW0+CgkJCTxpdGVtIG5hbWU9IkBuYW1lIiB0eXBlPSJzdHJpbmciIGFycmF5PSJmYWxzZSIgcmVxdWlyZWQ9InRydWUiPuWAvOeahOWQjeeosTwvaXRlbT4KCQkJPGl0ZW0gbmFtZT0iQHR5cGUiIHR5cGU9InR5cGUiIGFycmF5PSJmYWxzZSIgcmVxdWlyZWQ9InRydWUiPuWAvOeahOmhnuWeizwvaXRlbT4KCQkJPGl0ZW0gbmFtZT0iQGRlcHJlY2F0ZWQiIHR5cGU9InZlcnNpb24iIGFycmF5PSJmYWxzZSIgcmVxdWlyZWQ9ImZhbHNlIj7ooajnpLrlnKjlpKfmlrznrYnmlrzoqbLniYjmnKzomZ/mmYLkuI3lho3llZ/kvZznlKg8L2l0ZW0+CgkJCTxpdGVtIG5hbWU9IkBkZWZhdWx0IiB0eXBlPSJzdHJpbmciIGFycmF5PSJmYWxzZSIgcmVxdWlyZWQ9ImZhbHNlIj7pu5joqo3lgLw8L2l0ZW0+CgkJCTxpdGVtIG5hbWU9IkBvcHRpb25hbCIgdHlwZT0iYm9vbCIgYXJyYXk9ImZhbHNlIiByZXF1aXJlZD0iZmFsc2UiPuaYr+WQpueCuuWPr+mBuOeahOWPg+aVuDwvaXRlbT4KCQkJPGl0ZW0gbmFtZT0iQGFycmF5IiB0eXBlPSJib29sIiBhcnJheT0iZmFsc2UiIHJlcXVpcmVkPSJmYWxzZSI+5piv5ZCm54K65pW457WEPC9pdGVtPgoJCQk8aXRlbSBuYW1lPSJAc3VtbWFyeSIgdHlwZT0ic3RyaW5nIiBhcnJheT0iZmFsc2UiIHJlcXVpcmVkPSJmYWxzZSI+57Ch6KaB5LuL57S5PC9pdGVtPgoJCQk8aXRlbSBuYW1lPSJAYXJyYXktc3R5bGUiIHR5cGU9ImJvb2wiIGFycmF5PSJmYWxzZSIgcmVxdWlyZWQ9ImZhbHNlIj7ku6XmlbjntYTnmoTmlrnlvI/lsZXnpLrmlbjmk5o8L2l0ZW0+CgkJCTxpdGVtIG5hbWU9InBhcmFtIiB0eXBlPSJwYXJhbSIgYXJyYXk9InRydWUiIHJlcXVpcmVkPSJmYWxzZSI+5a2Q6aGe5Z6L77yM5q+U5aaC5bCN6LGh55qE5a2Q5YWD57Sg44CCPC9pdGVtPgoJCQk8aXRlbSBuYW1lPSJlbnVtIiB0eXBlPSJlbnVtIiBhcnJheT0idHJ1ZSIgcmVxdWlyZWQ9ImZhbHNlIj7nlbbliY3lj4Pmlbjlj6/nlKjnmoTmnproiInlgLw8L2l0ZW0+CgkJCTxpdGVtIG5hbWU9ImRlc2NyaXB0aW9uIiB0eXBlPSJyaWNodGV4dCIgYXJyYXk9ImZhbHNlIiByZXF1aXJlZD0iZmFsc2UiPuips+e0sOS7i+e0ue+8jOeCuiBIVE1MIOWFp+WuueOAgjwvaXRlbT4KCQk8L3R5cGU+CgkJPHR5cGUgbmFtZT0iZW51bSI+CgkJCTx1c2FnZT7lrprnvqnmnproiInpoZ7lnovnmoTmlbjmiYDnmoTmnproiInlgLw8L3VzYWdlPgoJCQk8aXRlbSBuYW1lPSJAZGVwcmVjYXRlZCIgdHlwZT0idmVyc2lvbiIgYXJyYXk9ImZhbHNlIiByZXF1aXJlZD0iZmFsc2UiPuipsuWxrOaAp+ajhOeUqOeahOeJiOacrOiZnzwvaXRlbT4KCQkJPGl0ZW0gbmFtZT0iQHZhbHVlIiB0eXBlPSJzdHJpbmciIGFycmF5PSJmYWxzZSIgcmVxdWlyZWQ9InRydWUiPuaemuiIieWAvDwvaXRlbT4KCQkJPGl0ZW0gbmFtZT0iQHN1bW1hcnkiIHR5cGU9InN0cmluZyIgYXJyYXk9ImZhbHNlIiByZXF1aXJlZD0iZmFsc2UiPuaemuiIieWAvOeahOiqquaYjjwvaXRlbT4KCQkJPGl0ZW0gbmFtZT0iZGVzY3JpcHRpb24iIHR5cGU9InJpY2h0ZXh0IiBhcnJheT0iZmFsc2UiIHJlcXVpcmVkPSJmYWxzZSI+5p6a6IiJ5YC855qE6Kmz57Sw6Kqq5piOPC9pdGVtPgoJCTwvdHlwZT4KCQk8dHlwZSBuYW1lPSJyZXF1ZXN0Ij4KCQkJPHVzYWdlPuWumue+qeS6huiri+axguWSjOi/lOWbnueahOebuOmXnOWFp+WuuTwvdXNhZ2U+CgkJCTxpdGVtIG5hbWU9IkB4bWwtYXR0ciIgdHlwZT0iYm9vbCIgYXJyYXk9ImZhbHNlIiByZXF1aXJlZD0iZmFsc2UiPuaYr+WQpuS9nOeCuueItuWFg+e0oOeahOWxrOaAp++8jOWDheS9nOeUqOaWvCBYTUwg5YWD57Sg44CC5piv5ZCm5L2c54K654i25YWD57Sg55qE5bGs5oCn77yM5YOF55So5pa8IFhNTCDnmoToq4vmsYLjgII8L2l0ZW0+CgkJCTxpdGVtIG5hbWU9IkB4bWwtZXh0cmFjdCIgdHlwZT0iYm9vbCIgYXJyYXk9ImZhbHNlIiByZXF1aXJlZD0iZmFsc2UiPuWwh+eVtuWJjeWFg+e0oOeahOWFp+WuueS9nOeCuueItuWFg+e0oOeahOWFp+Wuue+8jOimgeaxgueItuWFg+e0oOW/hemgiOeCuiA8dmFyPm9iamVjdDwvdmFyPuOAgjwvaXRlbT4KCQkJPGl0ZW0gbmFtZT0iQHhtbC1jZGF0YSIgdHlwZT0iYm9vbCIgYXJyYXk9ImZhbHNlIiByZXF1aXJlZD0iZmFsc2UiPueVtuWJjeWFp+WuueeCuiBDREFUQe+8jOiIhyA8Y29kZT5AeG1sLWF0dHI8L2NvZGU+IOS6kuaWpeOAgjwvaXRlbT4KCQkJPGl0ZW0gbmFtZT0iQHhtbC1ucy1wcmVmaXgiIHR5cGU9InN0cmluZyIgYXJyYXk9ImZhbHNlIiByZXF1aXJlZD0iZmFsc2UiPlhNTCDmqJnnsL3nmoTlkb3lkI3nqbrplpPlkI3nqLHliY3ntrQ8L2l0ZW0+CgkJCTxpdGVtIG5hbWU9IkB4bWwtd3JhcHBlZCIgdHlwZT0ic3RyaW5nIiBhcnJheT0iZmFsc2UiIHJlcXVpcmVkPSJmYWxzZSI+5aaC5p6c55W25YmN5YWD57Sg55qEIDxjb2RlPkBhcnJheTwvY29kZT4g54K6IDx2YXI+dHJ1ZTwvdmFyPu+8jOWJh+WPr+S7pemAmumBjuatpOWAvOaMh+WumuWcqCBYTUwg5qC85byP5Lit55qE5ZCN56ix44CCCgnlj6/ku6XmnInkuInnqK7moLzlvI/vvJo8dWw+Cgk8bGk+PHNhbXA+bmFtZTwvc2FtcD7vvJrooajnpLrngrrmlbjntYTmt7vliqDlo7nlgIvniLblhYPntKDlkI3nqLHngrogPHZhcj5uYW1lPC92YXI+77ybPC9saT4KCTxsaT48c2FtcD5uYW1lMSZndDtuYW1lMjwvc2FtcD7vvJrooajnpLrmlbjntYTpoIXnmoTlkI3nqLHmlLnngrogPHZhcj5uYW1lMjwvdmFyPu+8jOS4lOa3u+WKoOWjueWAi+eItuWFg+e0oOWQjeeCuiA8dmFyPm5hbWUxPC92YXI+77ybPC9saT4KCTxsaT48c2FtcD4mZ3Q7bmFtZTwvc2FtcD7vvJrooajnpLrlsIfnlbbliY3mlbjntYTlhYPntKDnmoTlkI3nqLHmlLnngrogPHZhcj5uYW1lPC92YXI+77ybPC9saT4KCTwvdWw+PC9pdGVtPgoJCQk8aXRlbSBuYW1lPSJAbmFtZSIgdHlwZT0ic3RyaW5nIiBhcnJheT0iZmFsc2UiIHJlcXVpcmVkPSJmYWxzZSI+55W2IG1pbWV0eXBlIOeCuiA8dmFyPmFwcGxpY2F0aW9uL3htbDwvdmFyPiDmmYLvvIzmraTlgLzooajnpLogWE1MIOeahOmgguWxpOWFg+e0oOWQjeeose+8jOWQpuWJh+eEoeeUqOOAgjwvaXRlbT4KCQkJPGl0ZW0gbmFtZT0iQHR5cGUiIHR5cGU9InR5cGUiIGFycmF5PSJmYWxzZSIgcmVxdWlyZWQ9ImZhbHNlIj7lgLznmoTpoZ7lnos8L2l0ZW0+CgkJCTxpdGVtIG5hbWU9IkBkZXByZWNhdGVkIiB0eXBlPSJ2ZXJzaW9uIiBhcnJheT0iZmFsc2UiIHJlcXVpcmVkPSJmYWxzZSI+6KGo56S65Zyo5aSn5pa8562J5pa86Kmy54mI5pys6Jmf5pmC5LiN5YaN5ZWf5L2c55SoPC9pdGVtPgoJCQk8aXRlbSBuYW1lPSJAYXJyYXkiIHR5cGU9ImJvb2wiIGFycmF5PSJmYWxzZSIgcmVxdWlyZWQ9ImZhbHNlIj7mmK/lkKbngrrmlbjntYQ8L2l0ZW0+CgkJCTxpdGVtIG5hbWU9IkBzdW1tYXJ5IiB0eXBlPSJzdHJpbmciIGFycmF5PSJmYWxzZSIgcmVxdWlyZWQ9ImZhbHNlIj7nsKHopoHku4vntLk8L2l0ZW0+CgkJCTxpdGVtIG5hbWU9IkBzdGF0dXMiIHR5cGU9Im51bWJlciIgYXJyYXk9ImZhbHNlIiByZXF1aXJlZD0iZmFsc2UiPueLgOaFi+eivOOAguWcqCByZXF1ZXN0IOS4re+8jOipsuWAvOS4jeWPr+eUqO+8jOWQpuWJh+eCuuW/heWhq+mgheOAgjwvaXRlbT4KCQkJPGl0ZW0gbmFtZT0iQG1pbWV0eXBlIiB0eXBlPSJzdHJpbmciIGFycmF5PSJmYWxzZSIgcmVxdWlyZWQ9ImZhbHNlIj7lqpLpq5TpoZ7lnovvvIzmr5TlpoIgPHZhcj5hcHBsaWNhdGlvbi9qc29uPC92YXI+IOetieOAgjwvaXRlbT4KCQkJPGl0ZW0gbmFtZT0iZW51bSIgdHlwZT0iZW51bSIgYXJyYXk9InRydWUiIHJlcXVpcmVkPSJmYWxzZSI+55W25YmN5Y+D5pW45Y+v55So55qE5p6a6IiJ5YC8PC9pdGVtPgoJCQk8aXRlbSBuYW1lPSJwYXJhbSIgdHlwZT0icGFyYW0iIGFycmF5PSJ0cnVlIiByZXF1aXJlZD0iZmFsc2UiPuWtkOmhnuWei++8jOavlOWmguWwjeixoeeahOWtkOWFg+e0oOOAgjwvaXRlbT4KCQkJPGl0ZW0gbmFtZT0iZXhhbXBsZSIgdHlwZT0iZXhhbXBsZSIgYXJyYXk9InRydWUiIHJlcXVpcmVkPSJmYWxzZSI+56S65L6L5Luj56K8PC9pdGVtPgoJCQk8aXRlbSBuYW1lPSJoZWFkZXIiIHR5cGU9InBhcmFtIiBhcnJheT0idHJ1ZSIgcmVxdWlyZWQ9ImZhbHNlIj7lgrPpgZ7nmoTloLHpoK3lhaflrrk8L2l0ZW0+CgkJCTxpdGVtIG5hbWU9ImRlc2NyaXB0aW9uIiB0eXBlPSJyaWNodGV4dCIgYXJyYXk9ImZhbHNlIiByZXF1aXJlZD0iZmFsc2UiPuips+e0sOS7i+e0ue+8jOeCuiBIVE1MIOWFp+WuueOAgjwvaXRlbT4KCQk8L3R5cGU+CgkJPHR5cGUgbmFtZT0iZXhhbXBsZSI+CgkJCTx1c2FnZT7npLrkvovku6Pnorw8L3VzYWdlPgoJCQk8aXRlbSBuYW1lPSJAbWltZXR5cGUiIHR5cGU9InN0cmluZyIgYXJyYXk9ImZhbHNlIiByZXF1aXJlZD0idHJ1ZSI+54m55a6a5pa86aGe5Z6L55qE56S65L6L5Luj56K8PC9pdGVtPgoJCQk8aXRlbSBuYW1lPSJAc3VtbWFyeSIgdHlwZT0ic3RyaW5nIiBhcnJheT0iZmFsc2UiIHJlcXVpcmVkPSJmYWxzZSI+56S65L6L5Luj56K855qE5qaC6KaB5L+h5oGvPC9pdGVtPgoJCQk8aXRlbSBuYW1lPSIuIiB0eXBlPSJzdHJpbmciIGFycmF5PSJmYWxzZSIgcmVxdWlyZWQ9InRydWUiPuekuuS+i+S7o+eivOeahOWFp+Wuue+8jOmcgOimgeS9v+eUqCBDREFUQSDljIXlkKvku6PnorzjgII8L2l0ZW0+CgkJPC90eXBlPgoJCTx0eXBlIG5hbWU9ImNhbGxiYWNrIj4KCQkJPHVzYWdlPuWumue+qeaOpeWPo+eahOWbnuiqv+WFp+WuuTwvdXNhZ2U+CgkJCTxpdGVtIG5hbWU9IkBtZXRob2QiIHR5cGU9InN0cmluZyIgYXJyYXk9ImZhbHNlIiByZXF1aXJlZD0idHJ1ZSI+5Zue6Kq/55qE6KuL5rGC5pa55rOVPC9pdGVtPgoJCQk8aXRlbSBuYW1lPSJAc3VtbWFyeSIgdHlwZT0ic3RyaW5nIiBhcnJheT0iZmFsc2UiIHJlcXVpcmVkPSJmYWxzZSI+57Ch6KaB5LuL57S5PC9pdGVtPgoJCQk8aXRlbSBuYW1lPSJAZGVwcmVjYXRlZCIgdHlwZT0idmVyc2lvbiIgYXJyYXk9ImZhbHNlIiByZXF1aXJlZD0iZmFsc2UiPuWcqOatpOeJiOacrOS5i+W+jOWwh+acg+iiq+ajhOeUqDwvaXRlbT4KCQkJPGl0ZW0gbmFtZT0icGF0aCIgdHlwZT0icGF0aCIgYXJyYXk9ImZhbHNlIiByZXF1aXJlZD0iZmFsc2UiPuWbnuiqv+eahOiri+axguWcsOWdgDwvaXRlbT4KCQkJPGl0ZW0gbmFtZT0iZGVzY3JpcHRpb24iIHR5cGU9InJpY2h0ZXh0IiBhcnJheT0iZmFsc2UiIHJlcXVpcmVkPSJmYWxzZSI+5bCN5pa85Zue6Kq/55qE6Kmz57Sw5LuL57S5PC9pdGVtPgoJCQk8aXRlbSBuYW1lPSJyZXNwb25zZSIgdHlwZT0icmVxdWVzdCIgYXJyYXk9InRydWUiIHJlcXVpcmVkPSJmYWxzZSI+5a6a576p5Y+v6IO955qE6L+U5Zue5L+h5oGvPC9pdGVtPgoJCQk8aXRlbSBuYW1lPSJyZXF1ZXN0IiB0eXBlPSJyZXF1ZXN0IiBhcnJheT0idHJ1ZSIgcmVxdWlyZWQ9InRydWUiPuWumue+qeWPr+eUqOeahOiri+axguS/oeaBrzwvaXRlbT4KCQkJPGl0ZW0gbmFtZT0iaGVhZGVyIiB0eXBlPSJwYXJhbSIgYXJyYXk9InRydWUiIHJlcXVpcmVkPSJmYWxzZSI+5YKz6YGe55qE5aCx6aCt5YWn5a65PC9pdGVtPgoJCTwvdHlwZT4KCQk8dHlwZSBuYW1lPSJzdHJpbmciPgoJCQk8dXNhZ2U+5pmu6YCa55qE5a2X56ym5Liy6aGe5Z6L77yM54m55q6K5a2X56ym6ZyA6KaB5L2/55SoIFhNTCDlr6bpq5TvvIzmr5TlpoIgPHNhbXA+Jmx0Ozwvc2FtcD4g6ZyA6KaB5L2/55SoIDxzYW1wPiZhbXA7bHQ7PC9zYW1wPiDku6Pmm7/jgII8L3VzYWdlPgoJCTwvdHlwZT4KCQk8dHlwZSBuYW1lPSJkYXRlIj4KCQkJPHVzYWdlPumHh+eUqCA8YSBocmVmPSJodHRwczovL3Rvb2xzLmlldGYub3JnL2h0bWwvcmZjMzMzOSI+UkZDMzMzOTwvYT4g5qC85byP6KGo56S655qE5pmC6ZaT77yM5q+U5aaC77yaPHNhbXA+MjAxOS0xMi0xNlQwMDozNTo0OCswODowMDwvc2FtcD7jgII8L3VzYWdlPgoJCTwvdHlwZT4KCQk8dHlwZSBuYW1lPSJ2ZXJzaW9uIj4KCQkJPHVzYWdlPueJiOacrOiZn++8jOagvOW8j+mBteWuiCA8YSBocmVmPSJodHRwczovL3NlbXZlci5vcmcvbGFuZy96aC1DTi8iPnNlbXZlcjwvYT4g6KaP5YmH44CC5q+U5aaC77yaPHNhbXA+MS4wLjE8L3NhbXA+44CBPHNhbXA+MS4wLjErMjAyMDA2MTg8L3NhbXA+44CCPC91c2FnZT4KCQk8L3R5cGU+CgkJPHR5cGUgbmFtZT0iYm9vbCI+CgkJCTx1c2FnZT7luIPniL7lgLzpoZ7lnovvvIzlj5blgLzngrogPHZhcj50cnVlPC92YXI+IOaIluaYryA8dmFyPmZhbHNlPC92YXI+44CCPC91c2FnZT4KCQk8L3R5cGU+CgkJPHR5cGUgbmFtZT0idHlwZSI+CgkJCTx1c2FnZT7nlKjmlrzooajnpLrmlbjmk5rnmoTpoZ7lnovlgLzvvIzmoLzlvI/ngrogPGNvZGU+cHJpbWl0aXZlWy5zdWJ0eXBlXTwvY29kZT7vvIzlhbbkuK0gPGNvZGU+cHJpbWl0aXZlPC9jb2RlPiDngrrln7rmnKzpoZ7lnovvvIzogIwgPGNvZGU+c3VidHlwZTwvY29kZT4g54K65a2Q6aGe5Z6L77yM55So5pa85bCNIDxjb2RlPnByaW1pdGl2ZTwvY29kZT4g6YCy6KGM6YCy5aO55q2l55qE57SE5p2f77yM55W25a6i5oi256uv54Sh5rOV6JmV55CG5pW05YCL6aGe5Z6L5pmC77yM5Y+v5Lul5oyJ54WnIDxjb2RlPnByaW1pdGl2ZTwvY29kZT4g55qE6aGe5Z6L6JmV55CG44CCPGJyIC8+Cgnnm67liY3mlK/mjIHku6XkuIvlub7nqK7poZ7lnovvvJo8dWw+Cgk8bGk+56m65YC877ybPC9saT4KCTxsaT48dmFyPmJvb2w8L3Zhcj4g5biD54i+5YC877ybPC9saT4KCTxsaT48dmFyPm9iamVjdDwvdmFyPiDlsI3osaHvvJs8L2xpPgoJPGxpPjx2YXI+bnVtYmVyPC92YXI+IOaVuOWAvOmhnuWei++8mzwvbGk+Cgk8bGk+PHZhcj5udW1iZXIuaW50PC92YXI+IOaVtOaVuOmhnuWei+eahOaVuOWAvO+8mzwvbGk+Cgk8bGk+PHZhcj5udW1iZXIuZmxvYXQ8L3Zhcj4g5rWu6bue6aGe5Z6L55qE5pW45YC877ybPC9saT4KCTxsaT48dmFyPnN0cmluZzwvdmFyPiDlrZfnrKbkuLLvvJs8L2xpPgoJPGxpPjx2YXI+c3RyaW5nLnVybDwvdmFyPiBVUkwg6aGe5Z6L55qE5a2X56ym5Liy77ybPC9saT4KCTxsaT48dmFyPnN0cmluZy5lbWFpbDwvdmFyPiBlbWFpbCDpoZ7lnovnmoTlrZfnrKbkuLLvvJs8L2xpPgoJPGxpPjx2YXI+c3RyaW5nLmltYWdlPC92YXI+IOihqOekuuWclueJh+WcsOWdgOeahCBVUkzvvJs8L2xpPgoJPGxpPjx2YXI+c3RyaW5nLmRhdGU8L3Zhcj4g6KGo56S6IDxhIGhyZWY9Imh0dHBzOi8vdG9vbHMuaWV0Zi5vcmcvaHRtbC9yZmMzMzM5I3NlY3Rpb24tNS42Ij5SRkMzMzM5PC9hPiDkuK3nmoQgPGNvZGU+ZnVsbC1kYXRlPC9jb2RlPiDml6XmnJ/moLzlvI/vvIzmr5TlpoIgPHNhbXA+MjAyMC0wMS0wMjwvc2FtcD7vvJs8L2xpPgoJPGxpPjx2YXI+c3RyaW5nLnRpbWU8L3Zhcj4g6KGo56S6IDxhIGhyZWY9Imh0dHBzOi8vdG9vbHMuaWV0Zi5vcmcvaHRtbC9yZmMzMzM5I3NlY3Rpb24tNS42Ij5SRkMzMzM5PC9hPiDkuK3nmoQgPGNvZGU+ZnVsbC10aW1lPC9jb2RlPiDmmYLplpPmoLzlvI/vvIzmr5TlpoIgPHNhbXA+MTU6MTY6MTdaPC9zYW1wPuOAgTxzYW1wPjE1OjE2OjE3KzA4OjAwPC9zYW1wPu+8mzwvbGk+Cgk8bGk+PHZhcj5zdHJpbmcuZGF0ZS10aW1lPC92YXI+IOihqOekuiA8YSBocmVmPSJodHRwczovL3Rvb2xzLmlldGYub3JnL2h0bWwvcmZjMzMzOSNzZWN0aW9uLTUuNiI+UkZDMzMzOTwvYT4g5Lit55qEIDxjb2RlPmRhdGUtdGltZTwvY29kZT4g5qC85byP77yM5q+U5aaCIDxzYW1wPjIwMjAtMDEtMDJUMTU6MTY6MTctMDg6MDA8L3NhbXA+77ybPC9saT4KCTwvdWw+PC91c2FnZT4KCQk8L3R5cGU+CgkJPHR5cGUgbmFtZT0ibnVtYmVyIj4KCQkJPHVzYWdlPuaZrumAmueahOaVuOWAvOmhnuWei++8jOavlOWmgu+8mjxzYW1wPjE8L3NhbXA+44CBPHNhbXA+LTExLjE8L3NhbXA+IOetieOAgjwvdXNhZ2U+CgkJPC90eXBlPgoJPC9zcGVjPgoJPGNvbW1hbmRzPgoJCTxjb21tYW5kIG5hbWU9ImJ1aWxkIj7nlJ/miJDmlofmqpTlhaflrrk8L2NvbW1hbmQ+CgkJPGNvbW1hbmQgbmFtZT0iZGV0ZWN0Ij7moLnmk5rnm67pjITkuIvnmoTlhaflrrnnlJ/miJDphY3nva7mlofku7Y8L2NvbW1hbmQ+CgkJPGNvbW1hbmQgbmFtZT0iaGVscCI+6aGv56S65bmr5Yqp5L+h5oGvPC9jb21tYW5kPgoJCTxjb21tYW5kIG5hbWU9ImxhbmciPumhr+ekuuaJgOacieaUr+aMgeeahOiqnuiogDwvY29tbWFuZD4KCQk8Y29tbWFuZCBuYW1lPSJsb2NhbGUiPumhr+ekuuaJgOacieaUr+aMgeeahOacrOWcsOWMluWFp+WuuTwvY29tbWFuZD4KCQk8Y29tbWFuZCBuYW1lPSJsc3AiPuWVn+WLlSBsYW5ndWFnZSBzZXJ2ZXIgcHJvdG9jb2wg5pyN5YuZPC9jb21tYW5kPgoJCTxjb21tYW5kIG5hbWU9Im1vY2siPuWVn+eUqCBtb2NrIOacjeWLmTwvY29tbWFuZD4KCQk8Y29tbWFuZCBuYW1lPSJzdGF0aWMiPuWVn+eUqOmdnOaFi+aWh+S7tuacjeWLmTwvY29tbWFuZD4KCQk8Y29tbWFuZCBuYW1lPSJzeW50YXgiPua4rOippuiqnuazleeahOato+eiuuaApzwvY29tbWFuZD4KCQk8Y29tbWFuZCBuYW1lPSJ2ZXJzaW9uIj7poa/npLrniYjmnKzkv6Hmga88L2NvbW1hbmQ+Cgk8L2NvbW1hbmRzPgoJPGNvbmZpZz4KCQk8aXRlbSBuYW1lPSJ2ZXJzaW9uIiB0eXBlPSJzdHJpbmciIGFycmF5PSJmYWxzZSIgcmVxdWlyZWQ9InRydWUiPuatpOmFjee9ruaWh+S7tueahOaJgOS9v+eUqOeahOaWh+aho+eJiOacrDwvaXRlbT4KCQk8aXRlbSBuYW1lPSJpbnB1dHMiIHR5cGU9Im9iamVjdCIgYXJyYXk9InRydWUiIHJlcXVpcmVkPSJ0cnVlIj7mjIflrprovLjlhaXnmoTmlbjmk5rvvIzlkIzlo7npoIXnm67lj6rog73op6PmnpDlo7nnqK7oqp7oqIDjgII8L2l0ZW0+CgkJPGl0ZW0gbmFtZT0iaW5wdXRzLmxhbmciIHR5cGU9InN0cmluZyIgYXJyYXk9ImZhbHNlIiByZXF1aXJlZD0idHJ1ZSI+5rqQ5paH5Lu255qE6Kej5p6Q5pa55byP44CC5YW36auU5pSv5oyB55qE6aGe5Z6L5Y+v6YCa6YGO5ZG95LukIDxzYW1wPmFwaWRvYyBsYW5nPC9zYW1wPiDmn6XnnIvmlK/mjIHoqp7oqIDjgII8L2l0ZW0+CgkJPGl0ZW0gbmFtZT0iaW5wdXRzLmRpciIgdHlwZT0ic3RyaW5nIiBhcnJheT0iZmFsc2UiIHJlcXVpcmVkPSJ0cnVlIj7pnIDopoHop6PmnpDnmoTmupDmlofku7bmiYDlnKjnm67pjIQ8L2l0ZW0+CgkJPGl0ZW0gbmFtZT0iaW5wdXRzLmV4dHMiIHR5cGU9InN0cmluZyIgYXJyYXk9InRydWUiIHJlcXVpcmVkPSJmYWxzZSI+5Y+q5b6e6YCZ5Lqb5pO05bGV5ZCN55qE5paH5Lu25Lit5p+l5om+5paH5qqUPC9pdGVtPgoJCTxpdGVtIG5hbWU9ImlucHV0cy5yZWN1cnNpdmUiIHR5cGU9ImJvb2wiIGFycmF5PSJmYWxzZSIgcmVxdWlyZWQ9ImZhbHNlIj7mmK/lkKbop6PmnpDlrZDnm67pjITkuIvnmoTmupDmlofku7Y8L2l0ZW0+CgkJPGl0ZW0gbmFtZT0iaW5wdXRzLmVuY29kaW5nIiB0eXBlPSJzdHJpbmciIGFycmF5PSJmYWxzZSIgcmVxdWlyZWQ9ImZhbHNlIj7nt6jnorzvvIzpu5joqo3ngrogPHZhcj51dGYtODwvdmFyPu+8jOWAvOWPr+S7peaYryA8YSBocmVmPSJodHRwczovL3d3dy5pYW5hLm9yZy9hc3NpZ25tZW50cy9jaGFyYWN0ZXItc2V0cy9jaGFyYWN0ZXItc2V0cy54aHRtbCI+Y2hhcmFjdGVyLXNldHM8L2E+IOS4reeahOWFp+WuueOAgjwvaXRlbT4KCQk8aXRlbSBuYW1lPSJpbnB1dHMuaWdub3JlcyIgdHlwZT0ic3RyaW5nIiBhcnJheT0idHJ1ZSIgcmVxdWlyZWQ9ImZhbHNlIj7lv73nlaXnmoTmlofku7bmiJbnm67pjITvvIzmr5TlpoIgbm9kZV9tb2R1bGVzIOetieOAgjwvaXRlbT4KCQk8aXRlbSBuYW1lPSJvdXRwdXQiIHR5cGU9Im9iamVjdCIgYXJyYXk9ImZhbHNlIiByZXF1aXJlZD0idHJ1ZSI+5o6n5Yi26Ly45Ye66KGM54K6PC9pdGVtPgoJCTxpdGVtIG5hbWU9Im91dHB1dC50eXBlIiB0eXBlPSJzdHJpbmciIGFycmF5PSJmYWxzZSIgcmVxdWlyZWQ9ImZhbHNlIj7ovLjlh7rnmoTpoZ7lnovvvIznm67liY3lj6/ku6UgPHZhcj5hcGlkb2MreG1sPC92YXI+44CBPHZhcj5vcGVuYXBpK2pzb248L3Zhcj4g5ZKMIDx2YXI+b3BlbmFwaSt5YW1sPC92YXI+44CCPC9pdGVtPgoJCTxpdGVtIG5hbWU9Im91dHB1dC5wYXRoIiB0eXBlPSJzdHJpbmciIGFycmF5PSJmYWxzZSIgcmVxdWlyZWQ9InRydWUiPuaMh+Wumui8uOWHuueahOaWh+S7tuWQje+8jOWMheWQq+i3r+W+keS/oeaBr+OAgjwvaXRlbT4KCQk8aXRlbSBuYW1lPSJvdXRwdXQudGFncyIgdHlwZT0ic3RyaW5nIiBhcnJheT0idHJ1ZSIgcmVxdWlyZWQ9ImZhbHNlIj7lj6rovLjlh7roiIfpgJnkupvmqJnnsL3nm7jpl5zoga/nmoTmlofmqpTvvIzpu5joqo3ngrrlhajpg6jjgII8L2l0ZW0+CgkJPGl0ZW0gbmFtZT0ib3V0cHV0LnN0eWxlIiB0eXBlPSJzdHJpbmciIGFycmF5PSJmYWxzZSIgcmVxdWlyZWQ9ImZhbHNlIj7ngrogWE1MIOaWh+S7tuaMh+WumueahCBYU0wg5paH5Lu2PC9pdGVtPgoJCTxpdGVtIG5hbWU9Im91dHB1dC5uYW1lc3BhY2UiIHR5cGU9ImJvb2wiIGFycmF5PSJmYWxzZSIgcmVxdWlyZWQ9ImZhbHNlIj7mmK/lkKbovLjlh7rlkb3lkI3nqbrplpM8L2l0ZW0+CgkJPGl0ZW0gbmFtZT0ib3V0cHV0Lm5hbWVzcGFjZS1wcmVmaXgiIHR5cGU9InN0cmluZyIgYXJyYXk9ImZhbHNlIiByZXF1aXJlZD0iZmFsc2UiPuWmguaenOi8uOWHuuS6huWRveWQjeepuumWk++8jOmChOWPr+S7peaMh+WumuWRveWQjeepuumWk+WJjee2tOOAgjwvaXRlbT4KCTwvY29uZmlnPgo8L2xvY2FsZT4KAAEIc2l0ZS54bWwBHmFwcGxpY2F0aW9uL3htbDsgY2hhcnNldD11dGYtOAH+BbA8P3htbCB2ZXJzaW9uPSIxLjAiIGVuY29kaW5nPSJVVEYtOCI/PgoKPCEtLSDor6Xmlofku7bnlLHlt6Xlhbfoh6rliqjnlJ/miJDvvIzor7fli7/miYvliqjkv67mlLnvvIEgLS0+Cgo8c2l0ZT4KCTxuYW1lPmFwaWRvYzwvbmFtZT4KCTx2ZXJzaW9uPjYuMS4wPC92ZXJzaW9uPgoJPHJlcG8+aHR0cHM6Ly9naXRodWIuY29tL2NhaXh3L2FwaWRvYzwvcmVwbz4KCTx1cmw+aHR0cHM6Ly9hcGlkb2MudG9vbHM8L3VybD4KCTxsYW5ndWFnZXM+CgkJPGxhbmd1YWdlIGlkPSJjIyI+QyM8L2xhbmd1YWdlPgoJCTxsYW5ndWFnZSBpZD0iYysrIj5DL0MrKzwvbGFuZ3VhZ2U+CgkJPGxhbmd1YWdlIGlkPSJkIj5EPC9sYW5ndWFnZT4KCQk8bGFuZ3VhZ2UgaWQ9ImRhcnQiPkRhcnQ8L2xhbmd1YWdlPgoJCTxsYW5ndWFnZSBpZD0iZXJsYW5nIj5Fcmxhbmc8L2xhbmd1YWdlPgoJCTxsYW5ndWFnZSBpZD0iZ28iPkdvPC9sYW5ndWFnZT4KCQk8bGFuZ3VhZ2UgaWQ9Imdyb292eSI+R3Jvb3Z5PC9sYW5ndWFnZT4KCQk8bGFuZ3VhZ2UgaWQ9ImphdmEiPkphdmE8L2xhbmd1YWdlPgoJCTxsYW5ndWFnZSBpZD0iamF2YXNjcmlwdCI+SmF2YVNjcmlwdDwvbGFuZ3VhZ2U+CgkJPGxhbmd1YWdlIGlkPSJqdWxpYSI+SnVsaWE8L2xhbmd1YWdlPgoJCTxsYW5ndWFnZSBpZD0ia290bGluIj5Lb3RsaW48L2xhbmd1YWdlPgoJCTxsYW5ndWFnZSBpZD0ibGlzcCI+TGlzcC9DbG9qdXJlPC9sYW5ndWFnZT4KCQk8bGFuZ3VhZ2UgaWQ9Imx1YSI+THVhPC9sYW5ndWFnZT4KCQk8bGFuZ3VhZ2UgaWQ9Im5pbSI+TmltPC9sYW5ndWFnZT4KCQk8bGFuZ3VhZ2UgaWQ9InBhc2NhbCI+UGFzY2FsL0RlbHBoaTwvbGFuZ3VhZ2U+CgkJPGxhbmd1YWdlIGlkPSJwZXJsIj5QZXJsPC9sYW5ndWFnZT4KCQk8bGFuZ3VhZ2UgaWQ9InBocCI+UEhQPC9sYW5ndWFnZT4KCQk8bGFuZ3VhZ2UgaWQ9InB5dGhvbiI+UHl0aG9uPC9sYW5ndWFnZT4KCQk8bGFuZ3VhZ2UgaWQ9InJ1YnkiPlJ1Ynk8L2xhbmd1YWdlPgoJCTxsYW5ndWFnZSBpZD0icnVzdCI+UnVzdDwvbGFuZ3VhZ2U+CgkJPGxhbmd1YWdlIGlkPSJzY2FsYSI+U2NhbGE8L2xhbmd1YWdlPgoJCTxsYW5ndWFnZSBpZD0ic3dpZnQiPlN3aWZ0PC9sYW5ndWFnZT4KCQk8bGFuZ3VhZ2UgaWQ9InR5cGVzY3JpcHQiPlR5cGVTY3JpcHQ8L2xhbmd1YWdlPgoJCTxsYW5ndWFnZSBpZD0iemlnIj5aaWc8L2xhbmd1YWdlPgoJPC9sYW5ndWFnZXM+Cgk8bG9jYWxlcz4KCQk8bG9jYWxlIGlkPSJjbW4tSGFucyIgaHJlZj0iaW5kZXgueG1sIiB0aXRsZT0i566A5L2T5Lit5paHIiBkb2M9ImxvY2FsZS5jbW4tSGFucy54bWwiPjwvbG9jYWxlPgoJCTxsb2NhbGUgaWQ9ImNtbi1IYW50IiBocmVmPSJpbmRleC5jbW4tSGFudC54bWwiIHRpdGxlPSLnuYHpq5TkuK3mlociIGRvYz0ibG9jYWxlLmNtbi1IYW50LnhtbCI+PC9sb2NhbGU+Cgk8L2xvY2FsZXM+Cjwvc2l0ZT4KAAENdjYvYXBpZG9jLmNzcwEXdGV4dC9jc3M7IGNoYXJzZXQ9dXRmLTgB/iZjQGNoYXJzZXQgInV0Zi04IjsKCjpyb290IHsKICAgIC0tbWF4LXdpZHRoOiAyMDQ4cHg7CiAgICAtLW1pbi13aWR0aDogMjAwcHg7IC8qIOW9k+WIl+eahOWuveW6puWwj+S6juatpOWAvOaXtu+8jOmDqOWIhuihjOWGheWuueS8muiiq+S7juaoquWQkeaUueWPmOe6teWQkeaOkuWIl+OAgiAqLwogICAgLS1wYWRkaW5nOiAxcmVtOwogICAgLS1hcnRpY2xlLXBhZGRpbmc6IGNhbGModmFyKC0tcGFkZGluZykgLyAyKTsKCiAgICAtLWNvbG9yOiBibGFjazsKICAgIC0tYWNjZW50LWNvbG9yOiAjMDA3NGQ5OwogICAgLS1iYWNrZ3JvdW5kOiB3aGl0ZTsKICAgIC0tYm9yZGVyLWNvbG9yOiAjZTBlMGUwOwogICAgLS1kZWxldGUtY29sb3I6IHJlZDsKCiAgICAvKiBtZXRob2QgKi8KICAgIC0tbWV0aG9kLWdldC1jb2xvcjogZ3JlZW47CiAgICAtLW1ldGhvZC1vcHRpb25zLWNvbG9yOiBncmVlbjsKICAgIC0tbWV0aG9kLXBvc3QtY29sb3I6IGRhcmtvcmFuZ2U7CiAgICAtLW1ldGhvZC1wdXQtY29sb3I6IGRhcmtvcmFuZ2U7CiAgICAtLW1ldGhvZC1wYXRjaC1jb2xvcjogZGFya29yYW5nZTsKICAgIC0tbWV0aG9kLWRlbGV0ZS1jb2xvcjogcmVkOwp9CgpAbWVkaWEgKHByZWZlcnMtY29sb3Itc2NoZW1lOiBkYXJrKSB7CiAgICA6cm9vdCB7CiAgICAgICAgLS1jb2xvcjogI2IwYjBiMDsKICAgICAgICAtLWFjY2VudC1jb2xvcjogIzAwNzRkOTsKICAgICAgICAtLWJhY2tncm91bmQ6IGJsYWNrOwogICAgICAgIC0tYm9yZGVyLWNvbG9yOiAjMzAzMDMwOwogICAgICAgIC0tZGVsZXRlLWNvbG9yOiByZWQ7CgogICAgICAgIC8qIG1ldGhvZCAqLwogICAgICAgIC0tbWV0aG9kLWdldC1jb2xvcjogZ3JlZW47CiAgICAgICAgLS1tZXRob2Qtb3B0aW9ucy1jb2xvcjogZ3JlZW47CiAgICAgICAgLS1tZXRob2QtcG9zdC1jb2xvcjogZGFya29yYW5nZTsKICAgICAgICAtLW1ldGhvZC1wdXQtY29sb3I6IGRhcmtvcmFuZ2U7CiAgICAgICAgLS1tZXRob2QtcGF0Y2gtY29sb3I6IGRhcmtvcmFuZ2U7CiAgICAgICAgLS1tZXRob2QtZGVsZXRlLWNvbG9yOiByZWQ7CiAgICB9Cn0KCmJvZHkgewogICAgcGFkZGluZzogMDsKICAgIG1hcmdpbjogMDsKICAgIGNvbG9yOiB2YXIoLS1jb2xvcik7CiAgICBiYWNrZ3JvdW5kOiB2YXIoLS1iYWNrZ3JvdW5kKTsKICAgIHRleHQtYWxpZ246IGNlbnRlcjsKfQoKdGFibGUgewogICAgd2lkdGg6IDEwMCU7Cn0KCnRhYmxlIHRyIHsKICAgIGN1cnNvcjogcG9pbnRlcjsKfQoKdGFibGUgdGgsIHRhYmxlIHRkIHsKICAgIGZvbnQtd2VpZ2h0OiBub3JtYWw7CiAgICB0ZXh0LWFsaWduOiBsZWZ0OwogICAgYm9yZGVyLWJvdHRvbTogMXB4IHNvbGlkIHRyYW5zcGFyZW50Owp9Cgp0YWJsZSB0cjpob3ZlciB0aCwKdGFibGUgdHI6aG92ZXIgdGQgewogICAgb3BhY2l0eTogLjc7Cn0KCnVsLCBvbCwgdWwgbGksIG9sIGxpIHsKICAgIHBhZGRpbmc6IDA7CiAgICBtYXJnaW46IDA7CiAgICBsaXN0LXN0eWxlLXBvc2l0aW9uOiBpbnNpZGU7Cn0KCnAgewogICAgbWFyZ2luOiAwOwp9CgpzdW1tYXJ5LCBpbnB1dCB7CiAgICBvdXRsaW5lOiBub25lOwp9CgphIHsKICAgIHRleHQtZGVjb3JhdGlvbjogbm9uZTsKICAgIGNvbG9yOiB2YXIoLS1hY2NlbnQtY29sb3IpOwp9CgphOmhvdmVyIHsKICAgIG9wYWNpdHk6IC43Owp9CgouZGVsIHsKICAgIHRleHQtZGVjb3JhdGlvbjogbGluZS10aHJvdWdoOwogICAgdGV4dC1kZWNvcmF0aW9uLWNvbG9yOiB2YXIoLS1kZWxldGUtY29sb3IpOwp9CgouaGlkZGVuIHsKICAgIGRpc3BsYXk6IG5vbmU7Cn0KCi8qKioqKioqKioqKioqKioqKioqKioqKioqKiogaGVhZGVyICoqKioqKioqKioqKioqKioqKioqKioqLwoKaGVhZGVyIHsKICAgIHBvc2l0aW9uOiBzdGlja3k7CiAgICB0b3A6IDA7CiAgICBkaXNwbGF5OiBibG9jazsKICAgIHotaW5kZXg6IDEwMDA7CiAgICBib3gtc2l6aW5nOiBib3JkZXItYm94OwogICAgYmFja2dyb3VuZDogdmFyKC0tYmFja2dyb3VuZCk7CiAgICBib3gtc2hhZG93OiAycHggMnB4IDJweCB2YXIoLS1ib3JkZXItY29sb3IpOwp9CgpoZWFkZXIgLndyYXAgewogICAgbWFyZ2luOiAwIGF1dG87CiAgICB0ZXh0LWFsaWduOiBsZWZ0OwogICAgbWF4LXdpZHRoOiB2YXIoLS1tYXgtd2lkdGgpOwogICAgcGFkZGluZzogMCB2YXIoLS1wYWRkaW5nKTsKICAgIGRpc3BsYXk6IGZsZXg7CiAgICBmbGV4LXdyYXA6IHdyYXA7CiAgICBqdXN0aWZ5LWNvbnRlbnQ6IHNwYWNlLWJldHdlZW47CiAgICBhbGlnbi1pdGVtczogY2VudGVyOwp9CgpoZWFkZXIgaDEgewogICAgbWFyZ2luOiB2YXIoLS1wYWRkaW5nKSAwIHZhcigtLWFydGljbGUtcGFkZGluZyk7CiAgICBkaXNwbGF5OiBpbmxpbmUtYmxvY2s7Cn0KCmhlYWRlciBoMSAudmVyc2lvbiB7CiAgICBmb250LXNpemU6IDFyZW07Cn0KCmhlYWRlciBoMSBpbWcgewogICAgaGVpZ2h0OiAxLjVyZW07CiAgICBtYXJnaW4tcmlnaHQ6IC41cmVtOwp9CgoKaGVhZGVyIC5tZW51cyB7CiAgICBkaXNwbGF5OiBmbGV4Owp9CgpoZWFkZXIgLm1lbnUgewogICAgY3Vyc29yOiBwb2ludGVyOwogICAgcG9zaXRpb246IHJlbGF0aXZlOwogICAgbWFyZ2luLWxlZnQ6IHZhcigtLXBhZGRpbmcpOwogICAgZGlzcGxheTogbm9uZTsgLyog6buY6K6k5LiN5Y+v6KeB77yM5Y+q5pyJ5Zyo5pyJIEpTIOeahOaDheWGteS4i++8jOmAmui/hyBKUyDmjqfliLblhbblj6/op4HmgKcgKi8KfQoKaGVhZGVyIC5tZW51OmhvdmVyIHNwYW4gewogICAgb3BhY2l0eTogLjc7Cn0KCmhlYWRlciAubWVudSB1bCB7CiAgICBwb3NpdGlvbjogYWJzb2x1dGU7CiAgICBtaW4td2lkdGg6IDRyZW07CiAgICByaWdodDogMDsKICAgIGRpc3BsYXk6IG5vbmU7CiAgICBsaXN0LXN0eWxlOiBub25lOwogICAgYmFja2dyb3VuZDogdmFyKC0tYmFja2dyb3VuZCk7CiAgICBib3JkZXI6IDFweCBzb2xpZCB2YXIoLS1ib3JkZXItY29sb3IpOwogICAgcGFkZGluZzogdmFyKC0tYXJ0aWNsZS1wYWRkaW5nKTsKfQoKaGVhZGVyIC5tZW51IHVsIGxpIHsKICAgIHBhZGRpbmctYm90dG9tOiB2YXIoLS1hcnRpY2xlLXBhZGRpbmcpOwp9CgovKiDlj6/ku6Xkv53or4EgbGFiZWwg55qE5YaF5a655Zyo5ZCM5LiA6KGMICovCmhlYWRlciAubWVudSB1bCBsaSBsYWJlbCB7CiAgICBkaXNwbGF5OiBpbmxpbmUtZmxleDsKICAgIGFsaWduLWl0ZW1zOiBiYXNlbGluZTsKICAgIHdvcmQtYnJlYWs6IGtlZXAtYWxsOwogICAgd2hpdGUtc3BhY2U6IG5vd3JhcDsKICAgIGN1cnNvcjogcG9pbnRlcjsKfQoKaGVhZGVyIC5tZW51IHVsIGxpOmhvdmVyIGxhYmVsIHsKICAgIG9wYWNpdHk6IC43Owp9CgpoZWFkZXIgLm1lbnUgdWwgbGk6bGFzdC1vZi10eXBlIHsKICAgIHBhZGRpbmctYm90dG9tOiAwOwp9CgpoZWFkZXIgLm1lbnU6aG92ZXIgdWwgewogICAgZGlzcGxheTogYmxvY2s7Cn0KCi8qKioqKioqKioqKioqKioqKioqKioqKioqKiogbWFpbiAqKioqKioqKioqKioqKioqKioqKioqKi8KCm1haW4gewogICAgcGFkZGluZzogMCB2YXIoLS1wYWRkaW5nKTsKICAgIG1hcmdpbjogMCBhdXRvOwogICAgbWF4LXdpZHRoOiB2YXIoLS1tYXgtd2lkdGgpOwogICAgdGV4dC1hbGlnbjogbGVmdDsKfQoKbWFpbiAuY29udGVudCB7CiAgICBtYXJnaW46IHZhcigtLXBhZGRpbmcpIDA7Cn0KCi8qKioqKioqKioqKioqKioqKiogLnNlcnZlcnMgKioqKioqKioqKioqKioqKioqKi8KCm1haW4gLnNlcnZlcnMgewogICAgZGlzcGxheTogZmxleDsKICAgIGZsZXgtZmxvdzogd3JhcDsKICAgIGp1c3RpZnktY29udGVudDogc3BhY2UtYmV0d2VlbjsKICAgIG1hcmdpbi1ib3R0b206IHZhcigtLXBhZGRpbmcpOwp9CgptYWluIC5zZXJ2ZXJzIC5zZXJ2ZXIgewogICAgZmxleC1ncm93OiAxOwogICAgbWluLXdpZHRoOiB2YXIoLS1taW4td2lkdGgpOwogICAgYm94LXNpemluZzogYm9yZGVyLWJveDsKICAgIGJvcmRlcjogMXB4IHNvbGlkIHZhcigtLWJvcmRlci1jb2xvcik7CiAgICBwYWRkaW5nOiB2YXIoLS1wYWRkaW5nKSB2YXIoLS1hcnRpY2xlLXBhZGRpbmcpOwp9CgptYWluIC5zZXJ2ZXJzIC5zZXJ2ZXI6aG92ZXIgewogICAgYm9yZGVyOiAxcHggc29saWQgdmFyKC0tYWNjZW50LWNvbG9yKTsKfQoKbWFpbiAuc2VydmVycyAuc2VydmVyIGg0IHsKICAgIG1hcmdpbjogMCAwIHZhcigtLXBhZGRpbmcpOwp9CgovKioqKioqKioqKioqKioqKioqKioqKiAuYXBpICoqKioqKioqKioqKioqKioqKioqKiovCgptYWluIC5hcGkgewogICAgbWFyZ2luLWJvdHRvbTogdmFyKC0tYXJ0aWNsZS1wYWRkaW5nKTsKICAgIGJvcmRlcjogMXB4IHNvbGlkIHZhcigtLWJvcmRlci1jb2xvcik7Cn0KCm1haW4gLmFwaT5zdW1tYXJ5IHsKICAgIG1hcmdpbjogMDsKICAgIHBhZGRpbmc6IHZhcigtLWFydGljbGUtcGFkZGluZyk7CiAgICBib3JkZXItYm90dG9tOiAxcHggc29saWQgdmFyKC0tYm9yZGVyLWNvbG9yKTsKICAgIGN1cnNvcjogcG9pbnRlcjsKICAgIGxpbmUtaGVpZ2h0OiAxOwogICAgYm94LXNpemluZzogYm9yZGVyLWJveDsKfQoKCm1haW4gZGV0YWlscy5hcGk6bm90KFtvcGVuXSk+c3VtbWFyeSB7CiAgICBib3JkZXI6IG5vbmU7Cn0KCgptYWluIC5hcGk+c3VtbWFyeSAuYWN0aW9uIHsKICAgIHdpZHRoOiA0MCU7CiAgICBkaXNwbGF5OiBpbmxpbmUtYmxvY2s7Cn0KCm1haW4gLmFwaT5zdW1tYXJ5IC5yaWdodCB7CiAgICB3aWR0aDogY2FsYyg2MCUgLSAycmVtKTsgLyogMnJlbSDooajnpLrlt6bkvqfnmoTnrq3lpLQgKi8KICAgIGZsb2F0OiByaWdodDsKfQoKbWFpbiAuYXBpPnN1bW1hcnkgLnJpZ2h0IC5zcnYsCm1haW4gLmFwaT5zdW1tYXJ5IC5yaWdodCAuc3VtbWFyeSB7CiAgICB3aWR0aDogNTAlOwogICAgZGlzcGxheTogaW5saW5lLWJsb2NrOwp9CgovKiAyMDBweCDkuLogLS1taW4td2lkdGgg55qE5YC8ICovCkBtZWRpYSAobWF4LXdpZHRoOiBjYWxjKDIwMHB4ICogMikpIHsKICAgIG1haW4gLmFwaT5zdW1tYXJ5IC5hY3Rpb24gewogICAgICAgIHdpZHRoOiA1MCU7CiAgICB9CgogICAgbWFpbiAuYXBpPnN1bW1hcnkgLnJpZ2h0IHsKICAgICAgICB3aWR0aDogY2FsYyg1MCUgLSAycmVtKTsKICAgIH0KCiAgICBtYWluIC5hcGk+c3VtbWFyeSAucmlnaHQgLnNydiB7CiAgICAgICAgZGlzcGxheTogbm9uZTsKICAgIH0KCiAgICBtYWluIC5hcGk+c3VtbWFyeSAucmlnaHQgLnN1bW1hcnkgewogICAgICAgIHdpZHRoOiAxMDAlOwogICAgfQp9CgptYWluIC5hcGk+c3VtbWFyeSAubGluayB7CiAgICBtYXJnaW4tcmlnaHQ6IDEwcHg7CiAgICB0ZXh0LWRlY29yYXRpb246IG5vbmU7Cn0KCm1haW4gLmFwaT5zdW1tYXJ5IC5tZXRob2QgewogICAgbWluLXdpZHRoOiA0cmVtOwogICAgZm9udC13ZWlnaHQ6IGJvbGQ7CiAgICBkaXNwbGF5OiBpbmxpbmUtYmxvY2s7CiAgICBtYXJnaW4tcmlnaHQ6IDFyZW07Cn0KCm1haW4gLmFwaSAuY2FsbGJhY2sgLnN1bW1hcnksCm1haW4gLmFwaT5zdW1tYXJ5IC5zdW1tYXJ5IHsKICAgIGZvbnQtd2VpZ2h0OiA0MDA7CiAgICBvcGFjaXR5OiAuODsKICAgIHRleHQtYWxpZ246IHJpZ2h0Owp9CgptYWluIC5hcGkgLmRlc2NyaXB0aW9uIHsKICAgIHBhZGRpbmc6IHZhcigtLWFydGljbGUtcGFkZGluZyk7CiAgICBtYXJnaW46IDA7CiAgICBib3JkZXItYm90dG9tOiAxcHggc29saWQgdmFyKC0tYm9yZGVyLWNvbG9yKTsKfQoKbWFpbiAuYXBpW2RhdGEtbWV0aG9kPSdHRVQnXVtvcGVuXSwKbWFpbiAuYXBpW2RhdGEtbWV0aG9kPSdHRVQnXTpob3ZlciwKbWFpbiAuY2FsbGJhY2tbZGF0YS1tZXRob2Q9J0dFVCddIGgzIHsKICAgIGJvcmRlcjogMXB4IHNvbGlkIHZhcigtLW1ldGhvZC1nZXQtY29sb3IpOwp9Cm1haW4gLmFwaVtkYXRhLW1ldGhvZD0nR0VUJ10+c3VtbWFyeSB7CiAgICBib3JkZXItYm90dG9tOiAxcHggc29saWQgdmFyKC0tbWV0aG9kLWdldC1jb2xvcik7Cn0KCm1haW4gLmFwaVtkYXRhLW1ldGhvZD0nUE9TVCddW29wZW5dLAptYWluIC5hcGlbZGF0YS1tZXRob2Q9J1BPU1QnXTpob3ZlciwKbWFpbiAuY2FsbGJhY2tbZGF0YS1tZXRob2Q9J1BPU1QnXSBoMyB7CiAgICBib3JkZXI6IDFweCBzb2xpZCB2YXIoLS1tZXRob2QtcG9zdC1jb2xvcik7Cn0KbWFpbiAuYXBpW2RhdGEtbWV0aG9kPSdQT1NUJ10+c3VtbWFyeSB7CiAgICBib3JkZXItYm90dG9tOiAxcHggc29saWQgdmFyKC0tbWV0aG9kLXBvc3QtY29sb3IpOwp9CgptYWluIC5hcGlbZGF0YS1tZXRob2Q9J1BVVCddW29wZW5dLAptYWluIC5hcGlbZGF0YS1tZXRob2Q9J1BVVCddOmhvdmVyLAptYWluIC5jYWxsYmFja1tkYXRhLW1ldGhvZD0nUFVUJ10gaDMgewogICAgYm9yZGVyOiAxcHggc29saWQgdmFyKC0tbWV0aG9kLXB1dC1jb2xvcik7Cn0KbWFpbiAuYXBpW2RhdGEtbWV0aG9kPSdQVVQnXT5zdW1tYXJ5IHsKICAgIGJvcmRlci1ib3R0b206IDFweCBzb2xpZCB2YXIoLS1tZXRob2QtcHV0LWNvbG9yKTsKfQoKbWFpbiAuYXBpW2RhdGEtbWV0aG9kPSdQQVRDSCddW29wZW5dLAptYWluIC5hcGlbZGF0YS1tZXRob2Q9J1BBVENIJ106aG92ZXIsCm1haW4gLmNhbGxiYWNrW2RhdGEtbWV0aG9kPSdQQVRDSCddIGgzIHsKICAgIGJvcmRlcjogMXB4IHNvbGlkIHZhcigtLW1ldGhvZC1wYXRjaC1jb2xvcik7Cn0KbWFpbiAuYXBpW2RhdGEtbWV0aG9kPSdQQVRDSCddPnN1bW1hcnkgewogICAgYm9yZGVyLWJvdHRvbTogMXB4IHNvbGlkIHZhcigtLW1ldGhvZC1wYXRjaC1jb2xvcik7Cn0KCm1haW4gLmFwaVtkYXRhLW1ldGhvZD0nREVMRVRFJ11bb3Blbl0sCm1haW4gLmFwaVtkYXRhLW1ldGhvZD0nREVMRVRFJ106aG92ZXIsCm1haW4gLmNhbGxiYWNrW2RhdGEtbWV0aG9kPSdERUxFVEUnXSBoMyB7CiAgICBib3JkZXI6IDFweCBzb2xpZCB2YXIoLS1tZXRob2QtZGVsZXRlLWNvbG9yKTsKfQptYWluIC5hcGlbZGF0YS1tZXRob2Q9J0RFTEVURSddPnN1bW1hcnkgewogICAgYm9yZGVyLWJvdHRvbTogMXB4IHNvbGlkIHZhcigtLW1ldGhvZC1kZWxldGUtY29sb3IpOwp9CgptYWluIC5hcGlbZGF0YS1tZXRob2Q9J09QVElPTlMnXVtvcGVuXSwKbWFpbiAuYXBpW2RhdGEtbWV0aG9kPSdPUFRJT05TJ106aG92ZXIsCm1haW4gLmNhbGxiYWNrW2RhdGEtbWV0aG9kPSdPUFRJT05TJ10gaDMgewogICAgYm9yZGVyOiAxcHggc29saWQgdmFyKC0tbWV0aG9kLW9wdGlvbnMtY29sb3IpOwp9Cm1haW4gLmFwaVtkYXRhLW1ldGhvZD0nT1BUSU9OUyddPnN1bW1hcnkgewogICAgYm9yZGVyLWJvdHRvbTogMXB4IHNvbGlkIHZhcigtLW1ldGhvZC1vcHRpb25zLWNvbG9yKTsKfQoKbWFpbiAuY2FsbGJhY2sgaDMgewogICAgZGlzcGxheTogZmxleDsKICAgIGp1c3RpZnktY29udGVudDogc3BhY2UtYmV0d2VlbjsKICAgIHBhZGRpbmc6IHZhcigtLWFydGljbGUtcGFkZGluZykgdmFyKC0tcGFkZGluZyk7CiAgICBtYXJnaW46IDA7CiAgICBib3JkZXItbGVmdDogbm9uZSAhaW1wb3J0YW50OwogICAgYm9yZGVyLXJpZ2h0OiBub25lICFpbXBvcnRhbnQ7CiAgICBjdXJzb3I6IHBvaW50ZXI7CiAgICBsaW5lLWhlaWdodDogMTsKfQoKbWFpbiAuYXBpIC5ib2R5IHsKICAgIGRpc3BsYXk6IGZsZXg7CiAgICBmbGV4LWZsb3c6IHdyYXA7Cn0KCm1haW4gLmFwaSAudGl0bGUsCm1haW4gLmFwaSAudGl0bGUgewogICAgbWFyZ2luOiB2YXIoLS1hcnRpY2xlLXBhZGRpbmcpIDAgMDsKICAgIGZvbnQtd2VpZ2h0OiBub3JtYWw7Cn0KCm1haW4gLmFwaSAuYm9keSAucmVzcG9uc2VzIC5zdGF0dXMgewogICAgbWFyZ2luOiB2YXIoLS1wYWRkaW5nKSAwIHZhcigtLWFydGljbGUtcGFkZGluZyk7CiAgICBib3JkZXItYm90dG9tOiAxcHggc29saWQgdmFyKC0tYm9yZGVyLWNvbG9yKTsKICAgIHBhZGRpbmctYm90dG9tOiAzcHg7Cn0KCm1haW4gLmFwaSAucGFyYW0gLnBhcmVudC10eXBlIHsKICAgIG9wYWNpdHk6IC41Owp9CgptYWluIC5hcGkgLmJvZHkgLnJlcXVlc3RzLAptYWluIC5hcGkgLmJvZHkgLnJlc3BvbnNlcyB7CiAgICBmbGV4OiAxIDEgNTAlOwogICAgYm94LXNpemluZzogYm9yZGVyLWJveDsKICAgIG1pbi13aWR0aDogdmFyKC0tbWluLXdpZHRoKTsKfQptYWluIC5hcGkgLmJvZHkgLnJlcXVlc3RzIHsKICAgIGJvcmRlci1yaWdodDogMXB4IGRvdHRlZCB2YXIoLS1ib3JkZXItY29sb3IpOwp9CgptYWluIC5hcGkgLmJvZHkgLnJlcXVlc3RzIC5oZWFkZXIsCm1haW4gLmFwaSAuYm9keSAucmVzcG9uc2VzIC5oZWFkZXIgewogICAgbWFyZ2luOiAwOwogICAgb3BhY2l0eTogLjU7CiAgICBwYWRkaW5nOiB2YXIoLS1hcnRpY2xlLXBhZGRpbmcpOwp9CgptYWluIC5hcGkgLmJvZHkgLnJlcXVlc3RzIGRldGFpbHM6bm90KFtvcGVuXSksCm1haW4gLmFwaSAuYm9keSAucmVzcG9uc2VzIGRldGFpbHM6bm90KFtvcGVuXSkgewogICAgbWFyZ2luLWJvdHRvbTogdmFyKC0tYXJ0aWNsZS1wYWRkaW5nKTsKfQoKbWFpbiAuYXBpIC5ib2R5IC5yZXF1ZXN0cz4ucGFyYW0sCm1haW4gLmFwaSAuYm9keSAucmVxdWVzdHMgZGV0YWlscyBzdW1tYXJ5IH4gKiwKbWFpbiAuYXBpIC5ib2R5IC5yZXNwb25zZXMgZGV0YWlscyBzdW1tYXJ5IH4gKiB7CiAgICBwYWRkaW5nOiAwIHZhcigtLWFydGljbGUtcGFkZGluZyk7Cn0KCm1haW4gLmFwaSAucmVzcG9uc2VzIGRldGFpbHMgc3VtbWFyeSwKbWFpbiAuYXBpIC5yZXF1ZXN0cyBkZXRhaWxzIHN1bW1hcnkgewogICAgY3Vyc29yOiBwb2ludGVyOwogICAgcGFkZGluZzogdmFyKC0tYXJ0aWNsZS1wYWRkaW5nKTsKfQoKbWFpbiAuYXBpIC5yZXNwb25zZXMgZGV0YWlsc1tvcGVuXSBzdW1tYXJ5LAptYWluIC5hcGkgLnJlcXVlc3RzIGRldGFpbHNbb3Blbl0gc3VtbWFyeSwKbWFpbiAuYXBpIC5yZXNwb25zZXMgZGV0YWlscyBzdW1tYXJ5OmhvdmVyLAptYWluIC5hcGkgLnJlcXVlc3RzIGRldGFpbHMgc3VtbWFyeTpob3ZlciB7CiAgICBjdXJzb3I6IHBvaW50ZXI7CiAgICBiYWNrZ3JvdW5kLWNvbG9yOiB2YXIoLS1ib3JkZXItY29sb3IpOwogICAgcGFkZGluZzogdmFyKC0tYXJ0aWNsZS1wYWRkaW5nKTsKfQoKLyoqKioqKioqKioqKioqKioqKioqKioqKioqKiBmb290ZXIgKioqKioqKioqKioqKioqKioqKioqKiovCgpmb290ZXIgewogICAgYm9yZGVyLXRvcDogMXB4IHNvbGlkIHZhcigtLWJvcmRlci1jb2xvcik7CiAgICBwYWRkaW5nOiB2YXIoLS1wYWRkaW5nKSB2YXIoLS1wYWRkaW5nKTsKICAgIHRleHQtYWxpZ246IGxlZnQ7CiAgICBtYXJnaW4tdG9wOiB2YXIoLS1wYWRkaW5nKTsKfQoKZm9vdGVyIC53cmFwIHsKICAgIG1hcmdpbjogMCBhdXRvOwogICAgbWF4LXdpZHRoOiB2YXIoLS1tYXgtd2lkdGgpOwp9CgouZ290by10b3AgewogICAgYm9yZGVyOiBzb2xpZCB2YXIoLS1jb2xvcik7CiAgICBib3JkZXItd2lkdGg6IDAgNXB4IDVweCAwOwogICAgZGlzcGxheTogYmxvY2s7CiAgICBwYWRkaW5nOiA1cHg7CiAgICB0cmFuc2Zvcm06IHJvdGF0ZSgtMTM1ZGVnKTsKICAgIHBvc2l0aW9uOiBmaXhlZDsKICAgIGJvdHRvbTogM3JlbTsKICAgIHJpZ2h0OiBjYWxjKCgoMTAwJSAtIHZhcigtLW1heC13aWR0aCkpIC8gMikgLSAzcmVtKTsKfQoKLyoKICog55So5LqO6K6h566XIGdvdG8tdG9wIOaMiemSrueahOS9jee9ruWni+e7iOS/neaMgeWcqOWGheWuueS4u+S9k+eahOWPs+S+p+i+ueS4iuOAggogKiAyMDQ4cHgg5Li6IC0tbWF4LXdpZHRoIOeahOWAvO+8jOS9huaYryBDU1Mg5bm25LiN5pSv5oyB55u05o6l5ZyoIEBtZWRpYQogKiDkvb/nlKggdmFyKCkg5Ye95pWw44CCCiAqLwpAbWVkaWEgKG1heC13aWR0aDogY2FsYygyMDQ4cHggKyA2cmVtKSkgewogICAgLmdvdG8tdG9wIHsKICAgICAgICByaWdodDogM3JlbTsKICAgIH0KfQoAAQx2Ni9hcGlkb2MuanMBJWFwcGxpY2F0aW9uL2phdmFzY3JpcHQ7IGNoYXJzZXQ9dXRmLTgB/hFpJ3VzZSBzdHJpY3QnOwoKd2luZG93Lm9ubG9hZCA9IGZ1bmN0aW9uICgpIHsKICAgIHJlZ2lzdGVyRmlsdGVyKCdtZXRob2QnKTsKICAgIHJlZ2lzdGVyRmlsdGVyKCdzZXJ2ZXInKTsKICAgIHJlZ2lzdGVyRmlsdGVyKCd0YWcnKTsKICAgIHJlZ2lzdGVyRXhwYW5kKCk7CiAgICByZWdpc3Rlckxhbmd1YWdlRmlsdGVyKCk7CiAgICBwcmV0dHlEZXNjcmlwdGlvbigpOwogICAgaW5pdEdvdG9Ub3AoKTsKfTsKCmNvbnN0IHVuY2F0ZWdvcml6ZWRJRCA9ICdfX19fXyc7CgpmdW5jdGlvbiByZWdpc3RlckZpbHRlcih0eXBlKSB7CiAgICBjb25zdCBtZW51ID0gZG9jdW1lbnQucXVlcnlTZWxlY3RvcignLicgKyB0eXBlICsgJy1zZWxlY3RvcicpOwogICAgaWYgKG1lbnUgPT09IG51bGwpIHsgLy8g5Y+v6IO95Li656m677yM6KGo56S65LiN5a2Y5Zyo6K+l6L+H5ruk6aG5CiAgICAgICAgcmV0dXJuOwogICAgfQoKICAgIG1lbnUuc3R5bGUuZGlzcGxheSA9ICdibG9jayc7IC8vIOaciSBKUyDnmoTmg4XlhrXkuIvvvIzlsZXnpLrov4fmu6Toj5zljZUKCiAgICBtZW51LnF1ZXJ5U2VsZWN0b3JBbGwoJ3VsPmxpJykuZm9yRWFjaCgoZSkgPT4gewogICAgICAgIGlmIChlLmdldEF0dHJpYnV0ZShgZGF0YS0ke3R5cGV9YCkgPT09ICcnKSB7CiAgICAgICAgICAgIGUuc2V0QXR0cmlidXRlKGBkYXRhLSR7dHlwZX1gLCB1bmNhdGVnb3JpemVkSUQpOwogICAgICAgIH0KICAgIH0pOwoKICAgIGNvbnN0IGFwaXMgPSBkb2N1bWVudC5xdWVyeVNlbGVjdG9yQWxsKCcuYXBpJyk7CiAgICBhcGlzLmZvckVhY2goKGFwaSkgPT4geyAvLyDlpI3liLYgZGF0YS0ke3R5cGV9IOiHsyBkYXRhLWhpZGRlbi0ke3R5cGV9CiAgICAgICAgbGV0IGF0dHIgPSBhcGkuZ2V0QXR0cmlidXRlKGBkYXRhLSR7dHlwZX1gKTsKICAgICAgICBpZiAoYXR0ciA9PT0gJycpIHsKICAgICAgICAgICAgYXR0ciA9IHVuY2F0ZWdvcml6ZWRJRDsKICAgICAgICAgICAgYXBpLnNldEF0dHJpYnV0ZShgZGF0YS0ke3R5cGV9YCwgYXR0cik7CiAgICAgICAgfQogICAgICAgIGFwaS5zZXRBdHRyaWJ1dGUoYGRhdGEtaGlkZGVuLSR7dHlwZX1gLCBhdHRyKTsKICAgIH0pOwoKICAgIG1lbnUucXVlcnlTZWxlY3RvckFsbCgnbGkgaW5wdXQnKS5mb3JFYWNoKCh2YWwpID0+IHsKICAgICAgICB2YWwuYWRkRXZlbnRMaXN0ZW5lcignY2hhbmdlJywgKGV2ZW50KSA9PiB7CiAgICAgICAgICAgIGlmIChldmVudC50YXJnZXQgPT09IG51bGwpIHsKICAgICAgICAgICAgICAgIHJldHVybjsKICAgICAgICAgICAgfQoKICAgICAgICAgICAgY29uc3QgY2hrID0gZXZlbnQudGFyZ2V0LmNoZWNrZWQ7CiAgICAgICAgICAgIGNvbnN0IHRhZyA9IGV2ZW50LnRhcmdldC5wYXJlbnROb2RlLnBhcmVudE5vZGUuZ2V0QXR0cmlidXRlKCdkYXRhLScgKyB0eXBlKTsKICAgICAgICAgICAgYXBpcy5mb3JFYWNoKChhcGkpID0+IHsKICAgICAgICAgICAgICAgIGNvbnN0IGF0dHIgPSBhcGkuZ2V0QXR0cmlidXRlKGBkYXRhLSR7dHlwZX1gKTsKICAgICAgICAgICAgICAgIGlmICghYXR0ci5zcGxpdCgnLCcpLmluY2x1ZGVzKHRhZykpIHsKICAgICAgICAgICAgICAgICAgICByZXR1cm47CiAgICAgICAgICAgICAgICB9CgogICAgICAgICAgICAgICAgY29uc3QgaEF0dHIgPSBhcGkuZ2V0QXR0cmlidXRlKGBkYXRhLWhpZGRlbi0ke3R5cGV9YCkuc3BsaXQoJywnKTsKICAgICAgICAgICAgICAgIGNvbnN0IGluZGV4ID0gaEF0dHIuaW5kZXhPZih0YWcpOwogICAgICAgICAgICAgICAgaWYgKGNoaykgewogICAgICAgICAgICAgICAgICAgIGlmICgtMSA9PT0gaW5kZXgpIHsKICAgICAgICAgICAgICAgICAgICAgICAgaEF0dHIucHVzaCh0YWcpOwogICAgICAgICAgICAgICAgICAgICAgICBhcGkuc2V0QXR0cmlidXRlKGBkYXRhLWhpZGRlbi0ke3R5cGV9YCwgaEF0dHIuam9pbignLCcpKTsKICAgICAgICAgICAgICAgICAgICB9CiAgICAgICAgICAgICAgICB9IGVsc2UgewogICAgICAgICAgICAgICAgICAgIGlmICgtMSA8IGluZGV4KSB7CiAgICAgICAgICAgICAgICAgICAgICAgIGhBdHRyLnNwbGljZShpbmRleCwgMSk7CiAgICAgICAgICAgICAgICAgICAgICAgIGFwaS5zZXRBdHRyaWJ1dGUoYGRhdGEtaGlkZGVuLSR7dHlwZX1gLCBoQXR0ci5qb2luKCcsJykpOwogICAgICAgICAgICAgICAgICAgIH0KICAgICAgICAgICAgICAgIH0KCiAgICAgICAgICAgICAgICBjb25zdCBoaWRkZW4gPSBhcGkuZ2V0QXR0cmlidXRlKCdkYXRhLWhpZGRlbi10YWcnKSA9PT0gJycgfHwKICAgICAgICAgICAgICAgICAgICBhcGkuZ2V0QXR0cmlidXRlKCdkYXRhLWhpZGRlbi1zZXJ2ZXInKSA9PT0gJycgfHwKICAgICAgICAgICAgICAgICAgICBhcGkuZ2V0QXR0cmlidXRlKCdkYXRhLWhpZGRlbi1tZXRob2QnKSA9PT0gJyc7CiAgICAgICAgICAgICAgICBhcGkuc3R5bGUuZGlzcGxheSA9IGhpZGRlbiA/ICdub25lJyA6ICdibG9jayc7CiAgICAgICAgICAgIH0pOwogICAgICAgIH0pOyAvLyBlbmQgYWRkRXZlbnRMaXN0ZW5lcignY2hhbmdlJykKICAgIH0pOyAvLyBlbmQgZm9yRWFjaCgnbGkgaW5wdXQnKQp9CgpmdW5jdGlvbiByZWdpc3Rlckxhbmd1YWdlRmlsdGVyKCkgewogICAgY29uc3QgbWVudSA9IGRvY3VtZW50LnF1ZXJ5U2VsZWN0b3IoJy5sYW5ndWFnZXMtc2VsZWN0b3InKTsKCiAgICBtZW51LnN0eWxlLmRpc3BsYXkgPSAnYmxvY2snOwoKICAgIG1lbnUucXVlcnlTZWxlY3RvckFsbCgnbGkgaW5wdXQnKS5mb3JFYWNoKCh2YWwpID0+IHsKICAgICAgICB2YWwuYWRkRXZlbnRMaXN0ZW5lcignY2hhbmdlJywgKGV2ZW50KSA9PiB7CiAgICAgICAgICAgIGlmIChldmVudC50YXJnZXQgPT09IG51bGwgfHwgIWV2ZW50LnRhcmdldC5jaGVja2VkKSB7CiAgICAgICAgICAgICAgICByZXR1cm47CiAgICAgICAgICAgIH0KCiAgICAgICAgICAgIGNvbnN0IGxhbmcgPSBldmVudC50YXJnZXQucGFyZW50Tm9kZS5wYXJlbnROb2RlLmdldEF0dHJpYnV0ZSgnbGFuZycpOwogICAgICAgICAgICBkb2N1bWVudC5xdWVyeVNlbGVjdG9yQWxsKCdbZGF0YS1sb2NhbGVdJykuZm9yRWFjaCgoZWxlbSkgPT4gewogICAgICAgICAgICAgICAgZWxlbS5jbGFzc05hbWUgPSBlbGVtLmdldEF0dHJpYnV0ZSgnbGFuZycpID09PSBsYW5nID8gJycgOiAnaGlkZGVuJzsKICAgICAgICAgICAgfSk7CiAgICAgICAgfSk7IC8vIGVuZCBhZGRFdmVudExpc3RlbmVyKCdjaGFuZ2UnKQogICAgfSk7IC8vIGVuZCBmb3JFYWNoKCdsaSBpbnB1dCcpCn0KCmZ1bmN0aW9uIHJlZ2lzdGVyRXhwYW5kKCkgewogICAgY29uc3QgZXhwYW5kID0gZG9jdW1lbnQucXVlcnlTZWxlY3RvcignLmV4cGFuZC1zZWxlY3RvcicpOwogICAgaWYgKGV4cGFuZCA9PT0gbnVsbCkgewogICAgICAgIHJldHVybjsKICAgIH0KCiAgICBleHBhbmQuc3R5bGUuZGlzcGxheSA9ICdibG9jayc7CgogICAgZXhwYW5kLnF1ZXJ5U2VsZWN0b3IoJ2lucHV0JykuYWRkRXZlbnRMaXN0ZW5lcignY2hhbmdlJywgKGV2ZW50KSA9PiB7CiAgICAgICAgY29uc3QgY2hrID0gZXZlbnQudGFyZ2V0LmNoZWNrZWQ7CiAgICAgICAgZG9jdW1lbnQucXVlcnlTZWxlY3RvckFsbCgnZGV0YWlscy5hcGknKS5mb3JFYWNoKChlbGVtKSA9PiB7CiAgICAgICAgICAgIGVsZW0ub3BlbiA9IGNoazsKICAgICAgICB9KTsKICAgIH0pOwp9CgovLyDnvo7ljJbmj4/ov7DlhoXlrrkKLy8KLy8g5Y2z5bCGIGh0bWwg5YaF5a656L2s5o2i5oiQ55yf55qEIEhUTUwg5qC85byP77yM6ICMIG1hcmtkb3duIOWImeS+neeEtuaYryBwcmUg5pi+56S644CCCmZ1bmN0aW9uIHByZXR0eURlc2NyaXB0aW9uKCkgewogICAgZG9jdW1lbnQucXVlcnlTZWxlY3RvckFsbCgnW2RhdGEtdHlwZV0nKS5mb3JFYWNoKChlbGVtKSA9PiB7CiAgICAgICAgY29uc3QgdHlwZSA9IGVsZW0uZ2V0QXR0cmlidXRlKCdkYXRhLXR5cGUnKTsKICAgICAgICBpZiAodHlwZSAhPT0gJ2h0bWwnKSB7CiAgICAgICAgICAgIHJldHVybjsKICAgICAgICB9CgogICAgICAgIGVsZW0uaW5uZXJIVE1MID0gZWxlbS5nZXRFbGVtZW50c0J5VGFnTmFtZSgncHJlJylbMF0uaW5uZXJUZXh0OwogICAgfSk7Cn0KCmZ1bmN0aW9uIGluaXRHb3RvVG9wKCkgewogICAgY29uc3QgdG9wID0gZG9jdW1lbnQucXVlcnlTZWxlY3RvcignLmdvdG8tdG9wJyk7CgogICAgLy8g5Zyo5pyA6aG26YOo5pe277yM6ZqQ6JeP5oyJ6ZKuCiAgICB3aW5kb3cuYWRkRXZlbnRMaXN0ZW5lcignc2Nyb2xsJywgKGUpID0+IHsKICAgICAgICBjb25zdCBib2R5ID0gZG9jdW1lbnQucXVlcnlTZWxlY3RvcignaHRtbCcpOwogICAgICAgIGlmIChib2R5LnNjcm9sbFRvcCA+IDUwKSB7CiAgICAgICAgICAgIHRvcC5zdHlsZS5kaXNwbGF5ID0gJ2Jsb2NrJzsKICAgICAgICB9IGVsc2UgewogICAgICAgICAgICB0b3Auc3R5bGUuZGlzcGxheSA9ICdub25lJzsKICAgICAgICB9CiAgICB9KTsKfQoAAQ12Ni9hcGlkb2MueHNsARd0ZXh0L3hzbDsgY2hhcnNldD11dGYtOAH+VT48P3htbCB2ZXJzaW9uPSIxLjAiIGVuY29kaW5nPSJVVEYtOCI/PgoKPHhzbDpzdHlsZXNoZWV0IHZlcnNpb249IjEuMCIgeG1sbnM6eHNsPSJodHRwOi8vd3d3LnczLm9yZy8xOTk5L1hTTC9UcmFuc2Zvcm0iPgo8eHNsOmltcG9ydCBocmVmPSIuL2xvY2FsZXMueHNsIiAvPgo8eHNsOm91dHB1dAogICAgbWV0aG9kPSJodG1sIgogICAgZW5jb2Rpbmc9InV0Zi04IgogICAgaW5kZW50PSJ5ZXMiCiAgICB2ZXJzaW9uPSI1LjAiCiAgICBkb2N0eXBlLXN5c3RlbT0iYWJvdXQ6bGVnYWN5LWNvbXBhdCIKLz4KCjx4c2w6dGVtcGxhdGUgbWF0Y2g9Ii8iPgo8aHRtbCBsYW5nPSJ7JGN1cnItbGFuZ30iPgogICAgPGhlYWQ+CiAgICAgICAgPHRpdGxlPjx4c2w6dmFsdWUtb2Ygc2VsZWN0PSJhcGlkb2MvdGl0bGUiIC8+PC90aXRsZT4KICAgICAgICA8bWV0YSBjaGFyc2V0PSJVVEYtOCIgLz4KICAgICAgICA8bWV0YSBuYW1lPSJ2aWV3cG9ydCIgY29udGVudD0id2lkdGg9ZGV2aWNlLXdpZHRoLGluaXRpYWwtc2NhbGU9MSxtYXhpbXVtLXNjYWxlPTEiIC8+CiAgICAgICAgPG1ldGEgbmFtZT0iZ2VuZXJhdG9yIiBjb250ZW50PSJhcGlkb2MiIC8+CiAgICAgICAgPGxpbmsgcmVsPSJpY29uIiB0eXBlPSJpbWFnZS9zdmcreG1sIiBocmVmPSJ7JGljb259IiAvPgogICAgICAgIDxsaW5rIHJlbD0ibWFzay1pY29uIiB0eXBlPSJpbWFnZS9zdmcreG1sIiBocmVmPSJ7JGljb259IiBjb2xvcj0iYmxhY2siIC8+CiAgICAgICAgPHhzbDppZiB0ZXN0PSJhcGlkb2MvbGljZW5zZSI+PGxpbmsgcmVsPSJsaWNlbnNlIiBocmVmPSJ7YXBpZG9jL2xpY2Vuc2UvQHVybH0iIC8+PC94c2w6aWY+CiAgICAgICAgPGxpbmsgcmVsPSJzdHlsZXNoZWV0IiB0eXBlPSJ0ZXh0L2NzcyIgaHJlZj0ieyRiYXNlLXVybH1hcGlkb2MuY3NzIiAvPgogICAgICAgIDxzY3JpcHQgc3JjPSJ7JGJhc2UtdXJsfWFwaWRvYy5qcyIgLz4KICAgIDwvaGVhZD4KICAgIDxib2R5PgogICAgICAgIDx4c2w6Y2FsbC10ZW1wbGF0ZSBuYW1lPSJoZWFkZXIiIC8+CgogICAgICAgIDxtYWluPgogICAgICAgICAgICA8ZGl2IGNsYXNzPSJjb250ZW50IiBkYXRhLXR5cGU9IntkZXNjcmlwdGlvbi9AdHlwZX0iPgogICAgICAgICAgICAgICAgPHByZT48eHNsOmNvcHktb2Ygc2VsZWN0PSJhcGlkb2MvZGVzY3JpcHRpb24vbm9kZSgpIiAvPjwvcHJlPgogICAgICAgICAgICA8L2Rpdj4KICAgICAgICAgICAgPGRpdiBjbGFzcz0ic2VydmVycyI+PHhzbDphcHBseS10ZW1wbGF0ZXMgc2VsZWN0PSJhcGlkb2Mvc2VydmVyIiAvPjwvZGl2PgogICAgICAgICAgICA8eHNsOmFwcGx5LXRlbXBsYXRlcyBzZWxlY3Q9ImFwaWRvYy9hcGkiIC8+CiAgICAgICAgPC9tYWluPgoKICAgICAgICA8Zm9vdGVyPgogICAgICAgIDxkaXYgY2xhc3M9IndyYXAiPgogICAgICAgICAgICA8cD48eHNsOmNvcHktb2Ygc2VsZWN0PSIkbG9jYWxlLWdlbmVyYXRvciIgLz48L3A+CiAgICAgICAgPC9kaXY+CiAgICAgICAgPGEgaHJlZj0iIyIgY2xhc3M9ImdvdG8tdG9wIiB0aXRsZT0ieyRsb2NhbGUtZ290by10b3B9IiBhcmlhLWxhYmVsPSJ7JGxvY2FsZS1nb3RvLXRvcH0iIC8+CiAgICAgICAgPC9mb290ZXI+CiAgICA8L2JvZHk+CjwvaHRtbD4KPC94c2w6dGVtcGxhdGU+CgoKPHhzbDp0ZW1wbGF0ZSBtYXRjaD0iL2FwaWRvYy9zZXJ2ZXIiPgo8ZGl2IGNsYXNzPSJzZXJ2ZXIiPgogICAgPGg0PgogICAgICAgIDx4c2w6Y2FsbC10ZW1wbGF0ZSBuYW1lPSJkZXByZWNhdGVkIj4KICAgICAgICAgICAgPHhzbDp3aXRoLXBhcmFtIG5hbWU9ImRlcHJlY2F0ZWQiIHNlbGVjdD0iQGRlcHJlY2F0ZWQiIC8+CiAgICAgICAgPC94c2w6Y2FsbC10ZW1wbGF0ZT4KICAgICAgICA8eHNsOnZhbHVlLW9mIHNlbGVjdD0iQG5hbWUiIC8+CiAgICA8L2g0PgoKICAgIDxwPjx4c2w6dmFsdWUtb2Ygc2VsZWN0PSJAdXJsIiAvPjwvcD4KICAgIDxkaXY+CiAgICAgICAgPHhzbDpjaG9vc2U+CiAgICAgICAgICAgIDx4c2w6d2hlbiB0ZXN0PSJkZXNjcmlwdGlvbiI+CiAgICAgICAgICAgICAgICA8eHNsOmF0dHJpYnV0ZSBuYW1lPSJkYXRhLXR5cGUiPgogICAgICAgICAgICAgICAgICAgIDx4c2w6dmFsdWUtb2Ygc2VsZWN0PSJkZXNjcmlwdGlvbi9AdHlwZSIgLz4KICAgICAgICAgICAgICAgIDwveHNsOmF0dHJpYnV0ZT4KICAgICAgICAgICAgICAgIDxwcmU+PHhzbDpjb3B5LW9mIHNlbGVjdD0iZGVzY3JpcHRpb24vbm9kZSgpIiAvPjwvcHJlPgogICAgICAgICAgICA8L3hzbDp3aGVuPgogICAgICAgICAgICA8eHNsOm90aGVyd2lzZT48eHNsOnZhbHVlLW9mIHNlbGVjdD0iQHN1bW1hcnkiIC8+PC94c2w6b3RoZXJ3aXNlPgogICAgICAgIDwveHNsOmNob29zZT4KICAgIDwvZGl2Pgo8L2Rpdj4KPC94c2w6dGVtcGxhdGU+CgoKPCEtLSBoZWFkZXIg55WM6Z2i5YWD57SgIC0tPgo8eHNsOnRlbXBsYXRlIG5hbWU9ImhlYWRlciI+CjxoZWFkZXI+CjxkaXYgY2xhc3M9IndyYXAiPgogICAgPGgxPgogICAgICAgIDxpbWcgYWx0PSJsb2dvIiBzcmM9InskaWNvbn0iIC8+CiAgICAgICAgPHhzbDp2YWx1ZS1vZiBzZWxlY3Q9Ii9hcGlkb2MvdGl0bGUiIC8+CiAgICAgICAgPHNwYW4gY2xhc3M9InZlcnNpb24iPiYjMTYwOyg8eHNsOnZhbHVlLW9mIHNlbGVjdD0iL2FwaWRvYy9AdmVyc2lvbiIgLz4pPC9zcGFuPgogICAgPC9oMT4KCiAgICA8ZGl2IGNsYXNzPSJtZW51cyI+CiAgICAgICAgPCEtLSBleHBhbmQgLS0+CiAgICAgICAgPGxhYmVsIGNsYXNzPSJtZW51IGV4cGFuZC1zZWxlY3RvciIgcm9sZT0iY2hlY2tib3giPgogICAgICAgICAgICA8aW5wdXQgdHlwZT0iY2hlY2tib3giIC8+PHhzbDpjb3B5LW9mIHNlbGVjdD0iJGxvY2FsZS1leHBhbmQiIC8+CiAgICAgICAgPC9sYWJlbD4KCiAgICAgICAgPCEtLSBzZXJ2ZXIgLS0+CiAgICAgICAgPHhzbDppZiB0ZXN0PSJhcGlkb2Mvc2VydmVyIj4KICAgICAgICA8ZGl2IGNsYXNzPSJtZW51IHNlcnZlci1zZWxlY3RvciIgcm9sZT0ibWVudSIgYXJpYS1oYXNwb3B1cD0idHJ1ZSI+CiAgICAgICAgICAgIDx4c2w6Y29weS1vZiBzZWxlY3Q9IiRsb2NhbGUtc2VydmVyIiAvPgogICAgICAgICAgICA8c3BhbiBhcmlhLWhpZGRyZW49InRydWUiPiYjMTYwOyYjeDI1YmM7PC9zcGFuPgogICAgICAgICAgICA8dWwgcm9sZT0ibWVudSIgYXJpYS1oaWRkcmVuPSJ0cnVlIj4KICAgICAgICAgICAgICAgIDx4c2w6Zm9yLWVhY2ggc2VsZWN0PSJhcGlkb2Mvc2VydmVyIj4KICAgICAgICAgICAgICAgIDxsaSBkYXRhLXNlcnZlcj0ie0BuYW1lfSIgcm9sZT0ibWVudWl0ZW1jaGVja2JveCI+CiAgICAgICAgICAgICAgICAgICAgPGxhYmVsPgogICAgICAgICAgICAgICAgICAgICAgICA8aW5wdXQgdHlwZT0iY2hlY2tib3giIGNoZWNrZWQ9ImNoZWNrZWQiIC8+JiMxNjA7PHhzbDp2YWx1ZS1vZiBzZWxlY3Q9IkBuYW1lIiAvPgogICAgICAgICAgICAgICAgICAgIDwvbGFiZWw+CiAgICAgICAgICAgICAgICA8L2xpPgogICAgICAgICAgICAgICAgPC94c2w6Zm9yLWVhY2g+CiAgICAgICAgICAgIDwvdWw+CiAgICAgICAgPC9kaXY+CiAgICAgICAgPC94c2w6aWY+CgogICAgICAgIDwhLS0gdGFnIC0tPgogICAgICAgIDx4c2w6aWYgdGVzdD0iYXBpZG9jL3RhZyI+CiAgICAgICAgPGRpdiBjbGFzcz0ibWVudSB0YWctc2VsZWN0b3IiIHJvbGU9Im1lbnUiIGFyaWEtaGFzcG9wdXA9InRydWUiPgogICAgICAgICAgICA8eHNsOmNvcHktb2Ygc2VsZWN0PSIkbG9jYWxlLXRhZyIgLz4KICAgICAgICAgICAgPHNwYW4gYXJpYS1oaWRkcmVuPSJ0cnVlIj4mIzE2MDsmI3gyNWJjOzwvc3Bhbj4KICAgICAgICAgICAgPHVsIHJvbGU9Im1lbnUiIGFyaWEtaGlkZHJlbj0idHJ1ZSI+CiAgICAgICAgICAgICAgICA8bGkgZGF0YS10YWc9IiIgcm9sZT0ibWVudWl0ZW1jaGVja2JveCI+CiAgICAgICAgICAgICAgICAgICAgPGxhYmVsPgogICAgICAgICAgICAgICAgICAgICAgICA8aW5wdXQgdHlwZT0iY2hlY2tib3giIGNoZWNrZWQ9ImNoZWNrZWQiIC8+JiMxNjA7PHhzbDpjb3B5LW9mIHNlbGVjdD0iJGxvY2FsZS11bmNhdGVnb3JpemVkIiAvPgogICAgICAgICAgICAgICAgICAgIDwvbGFiZWw+CiAgICAgICAgICAgICAgICA8L2xpPgogICAgICAgICAgICAgICAgPHhzbDpmb3ItZWFjaCBzZWxlY3Q9ImFwaWRvYy90YWciPgogICAgICAgICAgICAgICAgPGxpIGRhdGEtdGFnPSJ7QG5hbWV9IiByb2xlPSJtZW51aXRlbWNoZWNrYm94Ij4KICAgICAgICAgICAgICAgICAgICA8bGFiZWw+CiAgICAgICAgICAgICAgICAgICAgICAgIDxpbnB1dCB0eXBlPSJjaGVja2JveCIgY2hlY2tlZD0iY2hlY2tlZCIgLz4mIzE2MDs8eHNsOnZhbHVlLW9mIHNlbGVjdD0iQHRpdGxlIiAvPgogICAgICAgICAgICAgICAgICAgIDwvbGFiZWw+CiAgICAgICAgICAgICAgICA8L2xpPgogICAgICAgICAgICAgICAgPC94c2w6Zm9yLWVhY2g+CiAgICAgICAgICAgIDwvdWw+CiAgICAgICAgPC9kaXY+CiAgICAgICAgPC94c2w6aWY+CgogICAgICAgIDwhLS0gbWV0aG9kIC0tPgogICAgICAgIDxkaXYgY2xhc3M9Im1lbnUgbWV0aG9kLXNlbGVjdG9yIiByb2xlPSJtZW51IiBhcmlhLWhhc3BvcHVwPSJ0cnVlIj4KICAgICAgICAgICAgPHhzbDpjb3B5LW9mIHNlbGVjdD0iJGxvY2FsZS1tZXRob2QiIC8+CiAgICAgICAgICAgIDxzcGFuIGFyaWEtaGlkZHJlbj0idHJ1ZSI+JiMxNjA7JiN4MjViYzs8L3NwYW4+CiAgICAgICAgICAgIDx1bCByb2xlPSJtZW51IiBhcmlhLWhpZGRyZW49InRydWUiPgogICAgICAgICAgICAgICAgPCEtLSDmtY/op4jlmajlpb3lg4/pg73kuI3mlK/mjIEgeHBhdGggMi4w77yM5omA5Lul5peg5rOV5L2/55SoIGRpc3RpbmN0LXZhbHVlcyAtLT4KICAgICAgICAgICAgICAgIDwhLS0geHNsOmZvci1lYWNoIHNlbGVjdD0iZGlzdGluY3QtdmFsdWVzKC9hcGlkb2MvYXBpL0BtZXRob2QpIiAtLT4KICAgICAgICAgICAgICAgIDx4c2w6Zm9yLWVhY2ggc2VsZWN0PSIvYXBpZG9jL2FwaS9AbWV0aG9kW25vdCguLi9wcmVjZWRpbmctc2libGluZzo6YXBpL0BtZXRob2QgPSAuKV0iPgogICAgICAgICAgICAgICAgPGxpIGRhdGEtbWV0aG9kPSJ7Ln0iIHJvbGU9Im1lbnVpdGVtY2hlY2tib3giPgogICAgICAgICAgICAgICAgICAgIDxsYWJlbD48aW5wdXQgdHlwZT0iY2hlY2tib3giIGNoZWNrZWQ9ImNoZWNrZWQiIC8+JiMxNjA7PHhzbDp2YWx1ZS1vZiBzZWxlY3Q9Ii4iIC8+PC9sYWJlbD4KICAgICAgICAgICAgICAgIDwvbGk+CiAgICAgICAgICAgICAgICA8L3hzbDpmb3ItZWFjaD4KICAgICAgICAgICAgPC91bD4KICAgICAgICA8L2Rpdj4KCiAgICAgICAgPCEtLSBsYW5ndWFnZSAtLT4KICAgICAgICA8ZGl2IGNsYXNzPSJtZW51IGxhbmd1YWdlcy1zZWxlY3RvciIgcm9sZT0ibWVudSIgYXJpYS1oYXNwb3B1cD0idHJ1ZSI+CiAgICAgICAgICAgIDx4c2w6Y29weS1vZiBzZWxlY3Q9IiRsb2NhbGUtbGFuZ3VhZ2UiIC8+CiAgICAgICAgICAgIDxzcGFuIGFyaWEtaGlkZHJlbj0idHJ1ZSI+JiMxNjA7JiN4MjViYzs8L3NwYW4+CiAgICAgICAgICAgIDx1bCByb2xlPSJtZW51IiBhcmlhLWhpZGRyZW49InRydWUiPjx4c2w6Y2FsbC10ZW1wbGF0ZSBuYW1lPSJsYW5ndWFnZXMiIC8+PC91bD4KICAgICAgICA8L2Rpdj4KICAgIDwvZGl2PiA8IS0tIGVuZCAubWVudXMgLS0+CjwvZGl2PiA8IS0tIGVuZCAud3JhcCAtLT4KPC9oZWFkZXI+CjwveHNsOnRlbXBsYXRlPgoKCjwhLS0gYXBpIOeVjOmdouWFg+e0oCAtLT4KPHhzbDp0ZW1wbGF0ZSBtYXRjaD0iL2FwaWRvYy9hcGkiPgo8eHNsOnZhcmlhYmxlIG5hbWU9ImlkIiBzZWxlY3Q9ImNvbmNhdChzZXJ2ZXIsIEBtZXRob2QsIHRyYW5zbGF0ZShwYXRoL0BwYXRoLCAkaWQtZnJvbSwgJGlkLXRvKSkiIC8+Cgo8ZGV0YWlscyBpZD0ieyRpZH0iIGNsYXNzPSJhcGkiIGRhdGEtbWV0aG9kPSJ7QG1ldGhvZH0iPgo8eHNsOmF0dHJpYnV0ZSBuYW1lPSJkYXRhLXRhZyI+CiAgICA8eHNsOmZvci1lYWNoIHNlbGVjdD0idGFnIj48IS0tIE5PVEU6IOWmguaenOaYryB4c2wgMi4wIOWPr+S7peebtOaOpeS9v+eUqCBzdHJpbmctam9pbiDlh73mlbAgLS0+CiAgICAgICAgPHhzbDp2YWx1ZS1vZiBzZWxlY3Q9Ii4iIC8+PHhzbDppZiB0ZXN0PSJwb3NpdGlvbigpICE9IGxhc3QoKSI+LDwveHNsOmlmPgogICAgPC94c2w6Zm9yLWVhY2g+CjwveHNsOmF0dHJpYnV0ZT4KPHhzbDphdHRyaWJ1dGUgbmFtZT0iZGF0YS1zZXJ2ZXIiPgogICAgPHhzbDpmb3ItZWFjaCBzZWxlY3Q9InNlcnZlciI+CiAgICAgICAgPHhzbDp2YWx1ZS1vZiBzZWxlY3Q9Ii4iIC8+PHhzbDppZiB0ZXN0PSJwb3NpdGlvbigpICE9IGxhc3QoKSI+LDwveHNsOmlmPgogICAgPC94c2w6Zm9yLWVhY2g+CjwveHNsOmF0dHJpYnV0ZT4KCiAgICA8IS0tIAogICAgYnVnOiDlsIYgc3VtbWFyeSDkvZzkuLogZmxleCDlrrnlmajlnKggc2FmYXJpIOS8muWHuumUmeOAggogICAgaHR0cHM6Ly9idWdzLndlYmtpdC5vcmcvc2hvd19idWcuY2dpP2lkPTE5MDA2NQogICAgLS0+CiAgICA8c3VtbWFyeT4KICAgICAgICA8ZGl2IGNsYXNzPSJhY3Rpb24iPgogICAgICAgICAgICA8YSBjbGFzcz0ibGluayIgaHJlZj0iI3skaWR9Ij4mIzEyODI3OTs8L2E+IDwhLS0g6ZO+5o6l56ym5Y+3IC0tPgoKICAgICAgICAgICAgPHNwYW4gY2xhc3M9Im1ldGhvZCI+PHhzbDp2YWx1ZS1vZiBzZWxlY3Q9IkBtZXRob2QiIC8+PC9zcGFuPgogICAgICAgICAgICA8c3Bhbj4KICAgICAgICAgICAgICAgIDx4c2w6Y2FsbC10ZW1wbGF0ZSBuYW1lPSJkZXByZWNhdGVkIj4KICAgICAgICAgICAgICAgICAgICA8eHNsOndpdGgtcGFyYW0gbmFtZT0iZGVwcmVjYXRlZCIgc2VsZWN0PSJAZGVwcmVjYXRlZCIgLz4KICAgICAgICAgICAgICAgIDwveHNsOmNhbGwtdGVtcGxhdGU+CgogICAgICAgICAgICAgICAgPHhzbDp2YWx1ZS1vZiBzZWxlY3Q9InBhdGgvQHBhdGgiIC8+CiAgICAgICAgICAgIDwvc3Bhbj4KICAgICAgICA8L2Rpdj4KCiAgICAgICAgPGRpdiBjbGFzcz0icmlnaHQiPgogICAgICAgICAgICA8c3BhbiBjbGFzcz0ic3J2Ij4KICAgICAgICAgICAgICAgIDx4c2w6Zm9yLWVhY2ggc2VsZWN0PSJzZXJ2ZXIiPgogICAgICAgICAgICAgICAgICAgIDx4c2w6dmFsdWUtb2Ygc2VsZWN0PSIuIiAvPjx4c2w6aWYgdGVzdD0icG9zaXRpb24oKSAhPSBsYXN0KCkiPiwgPC94c2w6aWY+CiAgICAgICAgICAgICAgICA8L3hzbDpmb3ItZWFjaD4KICAgICAgICAgICAgPC9zcGFuPgoKICAgICAgICAgICAgPHNwYW4gY2xhc3M9InN1bW1hcnkiPjx4c2w6dmFsdWUtb2Ygc2VsZWN0PSJAc3VtbWFyeSIgLz48L3NwYW4+CiAgICAgICAgPC9kaXY+CiAgICA8L3N1bW1hcnk+CgogICAgPHhzbDppZiB0ZXN0PSJkZXNjcmlwdGlvbiI+CiAgICAgICAgPGRpdiBjbGFzcz0iZGVzY3JpcHRpb24iIGRhdGEtdHlwZT0ie2Rlc2NyaXB0aW9uL0B0eXBlfSI+CiAgICAgICAgICAgIDxwcmU+PHhzbDpjb3B5LW9mIHNlbGVjdD0iZGVzY3JpcHRpb24vbm9kZSgpIiAvPjwvcHJlPgogICAgICAgIDwvZGl2PgogICAgPC94c2w6aWY+CgogICAgPGRpdiBjbGFzcz0iYm9keSI+CiAgICAgICAgPGRpdiBjbGFzcz0icmVxdWVzdHMiPgogICAgICAgICAgICA8aDQgY2xhc3M9ImhlYWRlciI+PHhzbDpjb3B5LW9mIHNlbGVjdD0iJGxvY2FsZS1yZXF1ZXN0IiAvPjwvaDQ+CiAgICAgICAgICAgIDx4c2w6Y2FsbC10ZW1wbGF0ZSBuYW1lPSJyZXF1ZXN0cyI+CiAgICAgICAgICAgICAgICA8eHNsOndpdGgtcGFyYW0gbmFtZT0icmVxdWVzdHMiIHNlbGVjdD0icmVxdWVzdCIgLz4KICAgICAgICAgICAgICAgIDx4c2w6d2l0aC1wYXJhbSBuYW1lPSJwYXRoIiBzZWxlY3Q9InBhdGgiIC8+CiAgICAgICAgICAgICAgICA8eHNsOndpdGgtcGFyYW0gbmFtZT0iaGVhZGVycyIgc2VsZWN0PSJoZWFkZXIgfCAvYXBpZG9jL2hlYWRlciIgLz4KICAgICAgICAgICAgPC94c2w6Y2FsbC10ZW1wbGF0ZT4KICAgICAgICA8L2Rpdj4KICAgICAgICA8ZGl2IGNsYXNzPSJyZXNwb25zZXMiPgogICAgICAgICAgICA8aDQgY2xhc3M9ImhlYWRlciI+PHhzbDpjb3B5LW9mIHNlbGVjdD0iJGxvY2FsZS1yZXNwb25zZSIgLz48L2g0PgogICAgICAgICAgICA8eHNsOmNhbGwtdGVtcGxhdGUgbmFtZT0icmVzcG9uc2VzIj4KICAgICAgICAgICAgICAgIDx4c2w6d2l0aC1wYXJhbSBuYW1lPSJyZXNwb25zZXMiIHNlbGVjdD0icmVzcG9uc2UgfCAvYXBpZG9jL3Jlc3BvbnNlIiAvPgogICAgICAgICAgICA8L3hzbDpjYWxsLXRlbXBsYXRlPgogICAgICAgIDwvZGl2PgogICAgPC9kaXY+CgogICAgPHhzbDppZiB0ZXN0PSIuL2NhbGxiYWNrIj48eHNsOmFwcGx5LXRlbXBsYXRlcyBzZWxlY3Q9Ii4vY2FsbGJhY2siIC8+PC94c2w6aWY+CjwvZGV0YWlscz4KPC94c2w6dGVtcGxhdGU+CgoKPCEtLSDlm57osIPlhoXlrrkgLS0+Cjx4c2w6dGVtcGxhdGUgbWF0Y2g9Ii9hcGlkb2MvYXBpL2NhbGxiYWNrIj4KPGRpdiBjbGFzcz0iY2FsbGJhY2siIGRhdGEtbWV0aG9kPSJ7Li9AbWV0aG9kfSI+CiAgICA8aDM+CiAgICAgICAgPHhzbDpjb3B5LW9mIHNlbGVjdD0iJGxvY2FsZS1jYWxsYmFjayIgLz4KICAgICAgICA8c3BhbiBjbGFzcz0ic3VtbWFyeSI+PHhzbDp2YWx1ZS1vZiBzZWxlY3Q9IkBzdW1tYXJ5IiAvPjwvc3Bhbj4KICAgIDwvaDM+CiAgICA8eHNsOmlmIHRlc3Q9ImRlc2NyaXB0aW9uIj4KICAgICAgICA8ZGl2IGNsYXNzPSJkZXNjcmlwdGlvbiIgZGF0YS10eXBlPSJ7ZGVzY3JpcHRpb24vQHR5cGV9Ij4KICAgICAgICAgICAgPHByZT48eHNsOmNvcHktb2Ygc2VsZWN0PSJkZXNjcmlwdGlvbi9ub2RlKCkiIC8+PC9wcmU+CiAgICAgICAgPC9kaXY+CiAgICA8L3hzbDppZj4KCiAgICA8ZGl2IGNsYXNzPSJib2R5Ij4KICAgICAgICA8ZGl2IGNsYXNzPSJyZXF1ZXN0cyI+CiAgICAgICAgICAgIDxoNCBjbGFzcz0iaGVhZGVyIj48eHNsOmNvcHktb2Ygc2VsZWN0PSIkbG9jYWxlLXJlcXVlc3QiIC8+PC9oND4KICAgICAgICAgICAgPHhzbDpjYWxsLXRlbXBsYXRlIG5hbWU9InJlcXVlc3RzIj4KICAgICAgICAgICAgICAgIDx4c2w6d2l0aC1wYXJhbSBuYW1lPSJyZXF1ZXN0cyIgc2VsZWN0PSJyZXF1ZXN0IiAvPgogICAgICAgICAgICAgICAgPHhzbDp3aXRoLXBhcmFtIG5hbWU9InBhdGgiIHNlbGVjdD0icGF0aCIgLz4KICAgICAgICAgICAgICAgIDx4c2w6d2l0aC1wYXJhbSBuYW1lPSJoZWFkZXJzIiBzZWxlY3Q9ImhlYWRlciIgLz4KICAgICAgICAgICAgPC94c2w6Y2FsbC10ZW1wbGF0ZT4KICAgICAgICA8L2Rpdj4KCiAgICAgICAgPHhzbDppZiB0ZXN0PSJyZXNwb25zZSI+CiAgICAgICAgICAgIDxkaXYgY2xhc3M9InJlc3BvbnNlcyI+CiAgICAgICAgICAgICAgICA8aDQgY2xhc3M9ImhlYWRlciI+PHhzbDpjb3B5LW9mIHNlbGVjdD0iJGxvY2FsZS1yZXNwb25zZSIgLz48L2g0PgogICAgICAgICAgICAgICAgPHhzbDpjYWxsLXRlbXBsYXRlIG5hbWU9InJlc3BvbnNlcyI+CiAgICAgICAgICAgICAgICAgICAgPHhzbDp3aXRoLXBhcmFtIG5hbWU9InJlc3BvbnNlcyIgc2VsZWN0PSJyZXNwb25zZSIgLz4KICAgICAgICAgICAgICAgIDwveHNsOmNhbGwtdGVtcGxhdGU+CiAgICAgICAgICAgIDwvZGl2PgogICAgICAgIDwveHNsOmlmPgogICAgPC9kaXY+IDwhLS0gZW5kIC5ib2R5IC0tPgo8L2Rpdj4gPCEtLSBlbmQgLmNhbGxiYWNrIC0tPgo8L3hzbDp0ZW1wbGF0ZT4KCgo8IS0tIGFwaS9yZXF1ZXN0IOeahOeVjOmdouWFg+e0oCAtLT4KPHhzbDp0ZW1wbGF0ZSBuYW1lPSJyZXF1ZXN0cyI+Cjx4c2w6cGFyYW0gbmFtZT0icmVxdWVzdHMiIC8+Cjx4c2w6cGFyYW0gbmFtZT0icGF0aCIgLz4KPHhzbDpwYXJhbSBuYW1lPSJoZWFkZXJzIiAvPiA8IS0tIOWFrOeUqOeahOaKpeWktCAtLT4KPHhzbDppZiB0ZXN0PSIkcGF0aC9wYXJhbSI+CiAgICA8eHNsOmNhbGwtdGVtcGxhdGUgbmFtZT0icGFyYW0iPgogICAgICAgIDx4c2w6d2l0aC1wYXJhbSBuYW1lPSJ0aXRsZSI+CiAgICAgICAgICAgIDx4c2w6Y29weS1vZiBzZWxlY3Q9IiRsb2NhbGUtcGF0aC1wYXJhbSIgLz4KICAgICAgICA8L3hzbDp3aXRoLXBhcmFtPgogICAgICAgIDx4c2w6d2l0aC1wYXJhbSBuYW1lPSJwYXJhbSIgc2VsZWN0PSIkcGF0aC9wYXJhbSIgLz4KICAgICAgICA8eHNsOndpdGgtcGFyYW0gbmFtZT0ic2ltcGxlIiBzZWxlY3Q9Iid0cnVlJyIgLz4KICAgIDwveHNsOmNhbGwtdGVtcGxhdGU+CjwveHNsOmlmPgoKPHhzbDppZiB0ZXN0PSIkcGF0aC9xdWVyeSI+CiAgICA8eHNsOmNhbGwtdGVtcGxhdGUgbmFtZT0icGFyYW0iPgogICAgICAgIDx4c2w6d2l0aC1wYXJhbSBuYW1lPSJ0aXRsZSI+CiAgICAgICAgICAgIDx4c2w6Y29weS1vZiBzZWxlY3Q9IiRsb2NhbGUtcXVlcnkiIC8+CiAgICAgICAgPC94c2w6d2l0aC1wYXJhbT4KICAgICAgICA8eHNsOndpdGgtcGFyYW0gbmFtZT0icGFyYW0iIHNlbGVjdD0iJHBhdGgvcXVlcnkiIC8+CiAgICAgICAgPHhzbDp3aXRoLXBhcmFtIG5hbWU9InNpbXBsZSIgc2VsZWN0PSIndHJ1ZSciIC8+CiAgICA8L3hzbDpjYWxsLXRlbXBsYXRlPgo8L3hzbDppZj4KCjx4c2w6aWYgdGVzdD0iJGhlYWRlcnMiPgogICAgPHhzbDpjYWxsLXRlbXBsYXRlIG5hbWU9InBhcmFtIj4KICAgICAgICA8eHNsOndpdGgtcGFyYW0gbmFtZT0idGl0bGUiPgogICAgICAgICAgICA8eHNsOmNvcHktb2Ygc2VsZWN0PSIkbG9jYWxlLWhlYWRlciIgLz4KICAgICAgICA8L3hzbDp3aXRoLXBhcmFtPgogICAgICAgIDx4c2w6d2l0aC1wYXJhbSBuYW1lPSJwYXJhbSIgc2VsZWN0PSIkaGVhZGVycyIgLz4KICAgICAgICA8eHNsOndpdGgtcGFyYW0gbmFtZT0ic2ltcGxlIiBzZWxlY3Q9Iid0cnVlJyIgLz4KICAgIDwveHNsOmNhbGwtdGVtcGxhdGU+CjwveHNsOmlmPgoKPHhzbDp2YXJpYWJsZSBuYW1lPSJyZXF1ZXN0LWFueSIgc2VsZWN0PSIkcmVxdWVzdHNbbm90KEBtaW1ldHlwZSldIiAvPgo8eHNsOmZvci1lYWNoIHNlbGVjdD0iL2FwaWRvYy9taW1ldHlwZSB8ICRyZXF1ZXN0cy9AbWltZXR5cGVbbm90KC9hcGlkb2MvbWltZXR5cGU9LildIj4KICAgIDx4c2w6dmFyaWFibGUgbmFtZT0ibWltZXR5cGUiIHNlbGVjdD0iLiIgLz4KICAgIDx4c2w6dmFyaWFibGUgbmFtZT0icmVxdWVzdCIgc2VsZWN0PSIkcmVxdWVzdHNbQG1pbWV0eXBlPSRtaW1ldHlwZV0iIC8+CiAgICA8eHNsOmlmIHRlc3Q9IiRyZXF1ZXN0Ij4KICAgICAgICA8eHNsOmNhbGwtdGVtcGxhdGUgbmFtZT0icmVxdWVzdC1ib2R5Ij4KICAgICAgICAgICAgPHhzbDp3aXRoLXBhcmFtIG5hbWU9Im1pbWV0eXBlIiBzZWxlY3Q9IiRtaW1ldHlwZSIgLz4KICAgICAgICAgICAgPHhzbDp3aXRoLXBhcmFtIG5hbWU9InJlcXVlc3QiIHNlbGVjdD0iJHJlcXVlc3QiIC8+CiAgICAgICAgPC94c2w6Y2FsbC10ZW1wbGF0ZT4KICAgIDwveHNsOmlmPgogICAgPHhzbDppZiB0ZXN0PSJub3QoJHJlcXVlc3QpIGFuZCAkcmVxdWVzdC1hbnkiPgogICAgICAgIDx4c2w6Y2FsbC10ZW1wbGF0ZSBuYW1lPSJyZXF1ZXN0LWJvZHkiPgogICAgICAgICAgICA8eHNsOndpdGgtcGFyYW0gbmFtZT0ibWltZXR5cGUiIHNlbGVjdD0iJG1pbWV0eXBlIiAvPgogICAgICAgICAgICA8eHNsOndpdGgtcGFyYW0gbmFtZT0icmVxdWVzdCIgc2VsZWN0PSIkcmVxdWVzdC1hbnkiIC8+CiAgICAgICAgPC94c2w6Y2FsbC10ZW1wbGF0ZT4KICAgIDwveHNsOmlmPgo8L3hzbDpmb3ItZWFjaD4KPC94c2w6dGVtcGxhdGU+CgoKPHhzbDp0ZW1wbGF0ZSBuYW1lPSJyZXF1ZXN0LWJvZHkiPgo8eHNsOnBhcmFtIG5hbWU9Im1pbWV0eXBlIiAvPgo8eHNsOnBhcmFtIG5hbWU9InJlcXVlc3QiIC8+CjxkZXRhaWxzPgogICAgPHN1bW1hcnk+PHhzbDp2YWx1ZS1vZiBzZWxlY3Q9IiRtaW1ldHlwZSIgLz48L3N1bW1hcnk+CiAgICA8eHNsOmNhbGwtdGVtcGxhdGUgbmFtZT0idG9wLXBhcmFtIj4KICAgICAgICA8eHNsOndpdGgtcGFyYW0gbmFtZT0ibWltZXR5cGUiIHNlbGVjdD0iJG1pbWV0eXBlIiAvPgogICAgICAgIDx4c2w6d2l0aC1wYXJhbSBuYW1lPSJwYXJhbSIgc2VsZWN0PSIkcmVxdWVzdCIgLz4KICAgIDwveHNsOmNhbGwtdGVtcGxhdGU+CjwvZGV0YWlscz4KPC94c2w6dGVtcGxhdGU+Cgo8eHNsOnRlbXBsYXRlIG5hbWU9InJlc3BvbnNlcyI+Cjx4c2w6cGFyYW0gbmFtZT0icmVzcG9uc2VzIiAvPgo8eHNsOmZvci1lYWNoIHNlbGVjdD0iL2FwaWRvYy9taW1ldHlwZSB8ICRyZXNwb25zZXMvQG1pbWV0eXBlW25vdCgvYXBpZG9jL21pbWV0eXBlPS4pXSI+CiAgICA8eHNsOnZhcmlhYmxlIG5hbWU9Im1pbWV0eXBlIiBzZWxlY3Q9Ii4iIC8+CiAgICA8eHNsOmlmIHRlc3Q9IiRyZXNwb25zZXNbQG1pbWV0eXBlPSRtaW1ldHlwZV0gfCAkcmVzcG9uc2VzW25vdChAbWltZXR5cGUpXSI+CiAgICAgICAgPGRldGFpbHM+CiAgICAgICAgPHN1bW1hcnk+PHhzbDp2YWx1ZS1vZiBzZWxlY3Q9IiRtaW1ldHlwZSIgLz48L3N1bW1hcnk+CiAgICAgICAgPHhzbDpmb3ItZWFjaCBzZWxlY3Q9IiRyZXNwb25zZXMiPgogICAgICAgICAgICA8eHNsOnZhcmlhYmxlIG5hbWU9InJlc3AiIHNlbGVjdD0iY3VycmVudCgpW0BtaW1ldHlwZT0kbWltZXR5cGVdIiAvPgogICAgICAgICAgICA8eHNsOnZhcmlhYmxlIG5hbWU9InJlc3AtYW55IiBzZWxlY3Q9ImN1cnJlbnQoKVtub3QoQG1pbWV0eXBlKV0iIC8+CiAgICAgICAgICAgIDx4c2w6aWYgdGVzdD0iJHJlc3AiPjwhLS0g5Y+v6IO95ZCM5pe25a2Y5Zyo56ym5ZCIIHJlc3Ag5ZKMIHJlc3AtYW55IOeahOaVsOaNru+8jOS8mOWFiOWPliByZXNwIC0tPgogICAgICAgICAgICAgICAgPHhzbDpjYWxsLXRlbXBsYXRlIG5hbWU9InJlc3BvbnNlIj4KICAgICAgICAgICAgICAgICAgICA8eHNsOndpdGgtcGFyYW0gbmFtZT0icmVzcG9uc2UiIHNlbGVjdD0iJHJlc3AiIC8+CiAgICAgICAgICAgICAgICAgICAgPHhzbDp3aXRoLXBhcmFtIG5hbWU9Im1pbWV0eXBlIiBzZWxlY3Q9IiRtaW1ldHlwZSIgLz4KICAgICAgICAgICAgICAgIDwveHNsOmNhbGwtdGVtcGxhdGU+CiAgICAgICAgICAgIDwveHNsOmlmPgogICAgICAgICAgICA8eHNsOmlmIHRlc3Q9Im5vdCgkcmVzcCkgYW5kICRyZXNwLWFueSI+CiAgICAgICAgICAgICAgICA8eHNsOmNhbGwtdGVtcGxhdGUgbmFtZT0icmVzcG9uc2UiPgogICAgICAgICAgICAgICAgICAgIDx4c2w6d2l0aC1wYXJhbSBuYW1lPSJyZXNwb25zZSIgc2VsZWN0PSIkcmVzcC1hbnkiIC8+CiAgICAgICAgICAgICAgICAgICAgPHhzbDp3aXRoLXBhcmFtIG5hbWU9Im1pbWV0eXBlIiBzZWxlY3Q9IiRtaW1ldHlwZSIgLz4KICAgICAgICAgICAgICAgIDwveHNsOmNhbGwtdGVtcGxhdGU+CiAgICAgICAgICAgIDwveHNsOmlmPgogICAgICAgIDwveHNsOmZvci1lYWNoPgogICAgICAgIDwvZGV0YWlscz4KICAgIDwveHNsOmlmPgo8L3hzbDpmb3ItZWFjaD4KPC94c2w6dGVtcGxhdGU+CgoKPCEtLSBhcGkvcmVzcG9uc2Ug55qE55WM6Z2iIC0tPgo8eHNsOnRlbXBsYXRlIG5hbWU9InJlc3BvbnNlIj4KPHhzbDpwYXJhbSBuYW1lPSJyZXNwb25zZSIgLz4KPHhzbDpwYXJhbSBuYW1lPSJtaW1ldHlwZSIgLz4KCjxoNSBjbGFzcz0ic3RhdHVzIj48eHNsOnZhbHVlLW9mIHNlbGVjdD0iJHJlc3BvbnNlL0BzdGF0dXMiIC8+PC9oNT4KPGRpdj4KPHhzbDpjaG9vc2U+CiAgICA8eHNsOndoZW4gdGVzdD0iJHJlc3BvbnNlL2Rlc2NyaXB0aW9uIj4KICAgICAgICA8eHNsOmF0dHJpYnV0ZSBuYW1lPSJkYXRhLXR5cGUiPgogICAgICAgICAgICA8eHNsOnZhbHVlLW9mIHNlbGVjdD0iJHJlc3BvbnNlL2Rlc2NyaXB0aW9uL0B0eXBlIiAvPgogICAgICAgIDwveHNsOmF0dHJpYnV0ZT4KICAgICAgICA8cHJlPjx4c2w6Y29weS1vZiBzZWxlY3Q9IiRyZXNwb25zZS9kZXNjcmlwdGlvbi9ub2RlKCkiIC8+PC9wcmU+CiAgICA8L3hzbDp3aGVuPgogICAgPHhzbDpvdGhlcndpc2U+PHhzbDp2YWx1ZS1vZiBzZWxlY3Q9IiRyZXNwb25zZS9Ac3VtbWFyeSIgLz48L3hzbDpvdGhlcndpc2U+CjwveHNsOmNob29zZT4KPC9kaXY+Cjx4c2w6Y2FsbC10ZW1wbGF0ZSBuYW1lPSJ0b3AtcGFyYW0iPgogICAgPHhzbDp3aXRoLXBhcmFtIG5hbWU9Im1pbWV0eXBlIiBzZWxlY3Q9IiRtaW1ldHlwZSIgLz4KICAgIDx4c2w6d2l0aC1wYXJhbSBuYW1lPSJwYXJhbSIgc2VsZWN0PSIkcmVzcG9uc2UiIC8+CjwveHNsOmNhbGwtdGVtcGxhdGU+CjwveHNsOnRlbXBsYXRlPgoKPCEtLSByZXF1ZXN0IOWSjCByZXNwb25zZSDlj4LmlbDnmoTpobblsYLlhYPntKDosIPnlKjmqKHmnb8gLS0+Cjx4c2w6dGVtcGxhdGUgbmFtZT0idG9wLXBhcmFtIj4KPHhzbDpwYXJhbSBuYW1lPSJtaW1ldHlwZSIgLz4KPHhzbDpwYXJhbSBuYW1lPSJwYXJhbSIgLz4KPHhzbDppZiB0ZXN0PSIkcGFyYW0vaGVhZGVyIj4KICAgIDx4c2w6Y2FsbC10ZW1wbGF0ZSBuYW1lPSJwYXJhbSI+CiAgICAgICAgPHhzbDp3aXRoLXBhcmFtIG5hbWU9InRpdGxlIj4KICAgICAgICAgICAgPHhzbDpjb3B5LW9mIHNlbGVjdD0iJGxvY2FsZS1oZWFkZXIiIC8+CiAgICAgICAgPC94c2w6d2l0aC1wYXJhbT4KICAgICAgICA8eHNsOndpdGgtcGFyYW0gbmFtZT0icGFyYW0iIHNlbGVjdD0iJHBhcmFtL2hlYWRlciIgLz4KICAgICAgICA8eHNsOndpdGgtcGFyYW0gbmFtZT0ic2ltcGxlIiBzZWxlY3Q9Iid0cnVlJyIgLz4KICAgIDwveHNsOmNhbGwtdGVtcGxhdGU+CjwveHNsOmlmPgoKCjx4c2w6Y2FsbC10ZW1wbGF0ZSBuYW1lPSJwYXJhbSI+CiAgICA8eHNsOndpdGgtcGFyYW0gbmFtZT0idGl0bGUiPjx4c2w6Y29weS1vZiBzZWxlY3Q9IiRsb2NhbGUtYm9keSIgLz48L3hzbDp3aXRoLXBhcmFtPgogICAgPHhzbDp3aXRoLXBhcmFtIG5hbWU9InBhcmFtIiBzZWxlY3Q9IiRwYXJhbSIgLz4KPC94c2w6Y2FsbC10ZW1wbGF0ZT4KCjx4c2w6aWYgdGVzdD0iJHBhcmFtL2V4YW1wbGUiPgogICAgPGg0IGNsYXNzPSJ0aXRsZSI+JiN4MjdhNDsmIzE2MDs8eHNsOmNvcHktb2Ygc2VsZWN0PSIkbG9jYWxlLWV4YW1wbGUiIC8+PC9oND4KICAgIDx4c2w6Zm9yLWVhY2ggc2VsZWN0PSIkcGFyYW0vZXhhbXBsZSI+CiAgICAgICAgPHhzbDppZiB0ZXN0PSJub3QoQG1pbWV0eXBlKSBvciBAbWltZXR5cGU9JG1pbWV0eXBlIj4KICAgICAgICAgICAgPHByZSBjbGFzcz0iZXhhbXBsZSI+PHhzbDpjb3B5LW9mIHNlbGVjdD0ibm9kZSgpIiAvPjwvcHJlPgogICAgICAgIDwveHNsOmlmPgogICAgPC94c2w6Zm9yLWVhY2g+CjwveHNsOmlmPgo8L3hzbDp0ZW1wbGF0ZT4KCgo8IS0tIHBhdGggcGFyYW0sIHBhdGggcXVlcnksIGhlYWRlciDnrYnnmoTnlYzpnaIgLS0+Cjx4c2w6dGVtcGxhdGUgbmFtZT0icGFyYW0iPgo8eHNsOnBhcmFtIG5hbWU9InRpdGxlIiAvPgo8eHNsOnBhcmFtIG5hbWU9InBhcmFtIiAvPgo8eHNsOnBhcmFtIG5hbWU9InNpbXBsZSIgc2VsZWN0PSInZmFsc2UnIiAvPiA8IS0tIOeugOWNleeahOexu+Wei++8jOS4jeWtmOWcqOW1jOWll+exu+Wei++8jOS5n+S4jeS8muacieekuuS+i+S7o+eggSAtLT4KCjx4c2w6aWYgdGVzdD0iJHBhcmFtL0B0eXBlIj4KICAgIDxkaXYgY2xhc3M9InBhcmFtIj4KICAgICAgICA8aDQgY2xhc3M9InRpdGxlIj4mI3gyN2E0OyYjMTYwOzx4c2w6Y29weS1vZiBzZWxlY3Q9IiR0aXRsZSIgLz48L2g0PgoKICAgICAgICA8dGFibGUgY2xhc3M9InBhcmFtLWxpc3QiPgogICAgICAgICAgICA8dGhlYWQ+CiAgICAgICAgICAgICAgICA8dHI+CiAgICAgICAgICAgICAgICAgICAgPHRoPjx4c2w6Y29weS1vZiBzZWxlY3Q9IiRsb2NhbGUtdmFyIiAvPjwvdGg+CiAgICAgICAgICAgICAgICAgICAgPHRoPjx4c2w6Y29weS1vZiBzZWxlY3Q9IiRsb2NhbGUtdHlwZSIgLz48L3RoPgogICAgICAgICAgICAgICAgICAgIDx0aD48eHNsOmNvcHktb2Ygc2VsZWN0PSIkbG9jYWxlLXZhbHVlIiAvPjwvdGg+CiAgICAgICAgICAgICAgICAgICAgPHRoPjx4c2w6Y29weS1vZiBzZWxlY3Q9IiRsb2NhbGUtZGVzY3JpcHRpb24iIC8+PC90aD4KICAgICAgICAgICAgICAgIDwvdHI+CiAgICAgICAgICAgIDwvdGhlYWQ+CiAgICAgICAgICAgIDx0Ym9keT4KICAgICAgICAgICAgICAgIDx4c2w6Y2hvb3NlPgogICAgICAgICAgICAgICAgICAgIDx4c2w6d2hlbiB0ZXN0PSIkc2ltcGxlPSd0cnVlJyI+CiAgICAgICAgICAgICAgICAgICAgICAgIDx4c2w6Y2FsbC10ZW1wbGF0ZSBuYW1lPSJzaW1wbGUtcGFyYW0tbGlzdCI+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICA8eHNsOndpdGgtcGFyYW0gbmFtZT0icGFyYW0iIHNlbGVjdD0iJHBhcmFtIiAvPgogICAgICAgICAgICAgICAgICAgICAgICA8L3hzbDpjYWxsLXRlbXBsYXRlPgogICAgICAgICAgICAgICAgICAgIDwveHNsOndoZW4+CiAgICAgICAgICAgICAgICAgICAgPHhzbDpvdGhlcndpc2U+CiAgICAgICAgICAgICAgICAgICAgICAgIDx4c2w6Y2FsbC10ZW1wbGF0ZSBuYW1lPSJwYXJhbS1saXN0Ij4KICAgICAgICAgICAgICAgICAgICAgICAgICAgIDx4c2w6d2l0aC1wYXJhbSBuYW1lPSJwYXJhbSIgc2VsZWN0PSIkcGFyYW0iIC8+CiAgICAgICAgICAgICAgICAgICAgICAgIDwveHNsOmNhbGwtdGVtcGxhdGU+CiAgICAgICAgICAgICAgICAgICAgPC94c2w6b3RoZXJ3aXNlPgogICAgICAgICAgICAgICAgPC94c2w6Y2hvb3NlPgogICAgICAgICAgICA8L3Rib2R5PgogICAgICAgIDwvdGFibGU+CiAgICA8L2Rpdj4KPC94c2w6aWY+CjwveHNsOnRlbXBsYXRlPgoKCjwhLS0g5YiX6aG65bqP5b+F6aG76KaB5LiOIHBhcmFtIOS4reeahOebuOWQjCAtLT4KPHhzbDp0ZW1wbGF0ZSBuYW1lPSJzaW1wbGUtcGFyYW0tbGlzdCI+Cjx4c2w6cGFyYW0gbmFtZT0icGFyYW0iIC8+Cgo8eHNsOmZvci1lYWNoIHNlbGVjdD0iJHBhcmFtIj4KICAgIDx4c2w6Y2FsbC10ZW1wbGF0ZSBuYW1lPSJwYXJhbS1saXN0LXRyIj4KICAgICAgICA8eHNsOndpdGgtcGFyYW0gbmFtZT0icGFyYW0iIHNlbGVjdD0iLiIgLz4KICAgIDwveHNsOmNhbGwtdGVtcGxhdGU+CjwveHNsOmZvci1lYWNoPgo8L3hzbDp0ZW1wbGF0ZT4KCjwhLS0g5YiX6aG65bqP5b+F6aG76KaB5LiOIHBhcmFtIOS4reeahOebuOWQjCAtLT4KPHhzbDp0ZW1wbGF0ZSBuYW1lPSJwYXJhbS1saXN0Ij4KPHhzbDpwYXJhbSBuYW1lPSJwYXJhbSIgLz4KPHhzbDpwYXJhbSBuYW1lPSJwYXJlbnQiIHNlbGVjdD0iJyciIC8+IDwhLS0g5LiK5LiA57qn55qE5ZCN56ew77yM5bWM5aWX5a+56LGh5pe25Y+v55SoIC0tPgoKPHhzbDpmb3ItZWFjaCBzZWxlY3Q9IiRwYXJhbSI+CiAgICA8eHNsOmNhbGwtdGVtcGxhdGUgbmFtZT0icGFyYW0tbGlzdC10ciI+CiAgICAgICAgPHhzbDp3aXRoLXBhcmFtIG5hbWU9InBhcmFtIiBzZWxlY3Q9Ii4iIC8+CiAgICAgICAgPHhzbDp3aXRoLXBhcmFtIG5hbWU9InBhcmVudCIgc2VsZWN0PSIkcGFyZW50IiAvPgogICAgPC94c2w6Y2FsbC10ZW1wbGF0ZT4KCiAgICA8eHNsOmlmIHRlc3Q9InBhcmFtIj4KICAgICAgICA8eHNsOnZhcmlhYmxlIG5hbWU9InAiPgogICAgICAgICAgICAgICAgPHhzbDp2YWx1ZS1vZiBzZWxlY3Q9ImNvbmNhdCgkcGFyZW50LCBAbmFtZSkiIC8+CiAgICAgICAgICAgICAgICA8eHNsOmlmIHRlc3Q9IkBuYW1lIj48eHNsOnZhbHVlLW9mIHNlbGVjdD0iJy4nIiAvPjwveHNsOmlmPgogICAgICAgIDwveHNsOnZhcmlhYmxlPgoKICAgICAgICA8eHNsOmNhbGwtdGVtcGxhdGUgbmFtZT0icGFyYW0tbGlzdCI+CiAgICAgICAgICAgIDx4c2w6d2l0aC1wYXJhbSBuYW1lPSJwYXJhbSIgc2VsZWN0PSJwYXJhbSIgLz4KICAgICAgICAgICAgPHhzbDp3aXRoLXBhcmFtIG5hbWU9InBhcmVudCIgc2VsZWN0PSIkcCIgLz4KICAgICAgICA8L3hzbDpjYWxsLXRlbXBsYXRlPgogICAgPC94c2w6aWY+CjwveHNsOmZvci1lYWNoPgo8L3hzbDp0ZW1wbGF0ZT4KCgo8IS0tIOaYvuekuuS4gOihjOWPguaVsOaVsOaNriAtLT4KPHhzbDp0ZW1wbGF0ZSBuYW1lPSJwYXJhbS1saXN0LXRyIj4KPHhzbDpwYXJhbSBuYW1lPSJwYXJhbSIgLz4KPHhzbDpwYXJhbSBuYW1lPSJwYXJlbnQiIHNlbGVjdD0iJyciIC8+Cjx0cj4KICAgIDx4c2w6Y2FsbC10ZW1wbGF0ZSBuYW1lPSJkZXByZWNhdGVkIj4KICAgICAgICA8eHNsOndpdGgtcGFyYW0gbmFtZT0iZGVwcmVjYXRlZCIgc2VsZWN0PSIkcGFyYW0vQGRlcHJlY2F0ZWQiIC8+CiAgICA8L3hzbDpjYWxsLXRlbXBsYXRlPgogICAgPHRoPgogICAgICAgIDxzcGFuIGNsYXNzPSJwYXJlbnQtdHlwZSI+PHhzbDp2YWx1ZS1vZiBzZWxlY3Q9IiRwYXJlbnQiIC8+PC9zcGFuPgogICAgICAgIDx4c2w6dmFsdWUtb2Ygc2VsZWN0PSIkcGFyYW0vQG5hbWUiIC8+CiAgICA8L3RoPgoKICAgIDx0ZD4KICAgICAgICA8eHNsOnZhbHVlLW9mIHNlbGVjdD0iJHBhcmFtL0B0eXBlIiAvPgogICAgICAgIDx4c2w6aWYgdGVzdD0iJHBhcmFtL0BhcnJheT0ndHJ1ZSciPjx4c2w6dmFsdWUtb2Ygc2VsZWN0PSInW10nIiAvPjwveHNsOmlmPgogICAgPC90ZD4KCiAgICA8dGQ+CiAgICAgICAgPHhzbDpjaG9vc2U+CiAgICAgICAgICAgIDx4c2w6d2hlbiB0ZXN0PSIkcGFyYW0vQG9wdGlvbmFsPSd0cnVlJyI+PHhzbDp2YWx1ZS1vZiBzZWxlY3Q9IidPJyIgLz48L3hzbDp3aGVuPgogICAgICAgICAgICA8eHNsOm90aGVyd2lzZT48eHNsOnZhbHVlLW9mIHNlbGVjdD0iJ1InIiAvPjwveHNsOm90aGVyd2lzZT4KICAgICAgICA8L3hzbDpjaG9vc2U+CiAgICAgICAgPHhzbDp2YWx1ZS1vZiBzZWxlY3Q9ImNvbmNhdCgnICcsICRwYXJhbS9AZGVmYXVsdCkiIC8+CiAgICA8L3RkPgoKICAgIDx0ZD4KICAgICAgICA8eHNsOmNob29zZT4KICAgICAgICAgICAgPHhzbDp3aGVuIHRlc3Q9ImRlc2NyaXB0aW9uIj4KICAgICAgICAgICAgICAgIDx4c2w6YXR0cmlidXRlIG5hbWU9ImRhdGEtdHlwZSI+CiAgICAgICAgICAgICAgICAgICAgPHhzbDp2YWx1ZS1vZiBzZWxlY3Q9ImRlc2NyaXB0aW9uL0B0eXBlIiAvPgogICAgICAgICAgICAgICAgPC94c2w6YXR0cmlidXRlPgogICAgICAgICAgICAgICAgPHByZT48eHNsOmNvcHktb2Ygc2VsZWN0PSJkZXNjcmlwdGlvbi9ub2RlKCkiIC8+PC9wcmU+CiAgICAgICAgICAgIDwveHNsOndoZW4+CiAgICAgICAgICAgIDx4c2w6b3RoZXJ3aXNlPjx4c2w6dmFsdWUtb2Ygc2VsZWN0PSJAc3VtbWFyeSIgLz48L3hzbDpvdGhlcndpc2U+CiAgICAgICAgPC94c2w6Y2hvb3NlPgogICAgICAgIDx4c2w6Y2FsbC10ZW1wbGF0ZSBuYW1lPSJlbnVtIj4KICAgICAgICAgICAgPHhzbDp3aXRoLXBhcmFtIG5hbWU9ImVudW0iIHNlbGVjdD0iJHBhcmFtL2VudW0iLz4KICAgICAgICA8L3hzbDpjYWxsLXRlbXBsYXRlPgogICAgPC90ZD4KPC90cj4KPC94c2w6dGVtcGxhdGU+CgoKPCEtLSDmmL7npLrmnprkuL7nsbvlnovnmoTlhoXlrrkgLS0+Cjx4c2w6dGVtcGxhdGUgbmFtZT0iZW51bSI+Cjx4c2w6cGFyYW0gbmFtZT0iZW51bSIgLz4KPHhzbDppZiB0ZXN0PSIkZW51bSI+CiAgICA8cD48eHNsOmNvcHktb2Ygc2VsZWN0PSIkbG9jYWxlLWVudW0iIC8+PC9wPgogICAgPHVsPgogICAgPHhzbDpmb3ItZWFjaCBzZWxlY3Q9IiRlbnVtIj4KICAgICAgICA8bGk+CiAgICAgICAgPHhzbDpjYWxsLXRlbXBsYXRlIG5hbWU9ImRlcHJlY2F0ZWQiPgogICAgICAgICAgICA8eHNsOndpdGgtcGFyYW0gbmFtZT0iZGVwcmVjYXRlZCIgc2VsZWN0PSJAZGVwcmVjYXRlZCIgLz4KICAgICAgICA8L3hzbDpjYWxsLXRlbXBsYXRlPgoKICAgICAgICA8eHNsOnZhbHVlLW9mIHNlbGVjdD0iQHZhbHVlIiAvPjoKICAgICAgICA8eHNsOmNob29zZT4KICAgICAgICAgICAgPHhzbDp3aGVuIHRlc3Q9ImRlc2NyaXB0aW9uIj4KICAgICAgICAgICAgICAgIDxkaXYgZGF0YS10eXBlPSJ7ZGVzY3JpcHRpb24vQHR5cGV9Ij4KICAgICAgICAgICAgICAgICAgICA8cHJlPjx4c2w6Y29weS1vZiBzZWxlY3Q9ImRlc2NyaXB0aW9uL25vZGUoKSIgLz48L3ByZT4KICAgICAgICAgICAgICAgIDwvZGl2PgogICAgICAgICAgICA8L3hzbDp3aGVuPgogICAgICAgICAgICA8eHNsOm90aGVyd2lzZT48eHNsOnZhbHVlLW9mIHNlbGVjdD0ic3VtbWFyeSIgLz48L3hzbDpvdGhlcndpc2U+CiAgICAgICAgPC94c2w6Y2hvb3NlPgogICAgICAgIDwvbGk+CiAgICA8L3hzbDpmb3ItZWFjaD4KICAgIDwvdWw+CjwveHNsOmlmPgo8L3hzbDp0ZW1wbGF0ZT4KCgo8IS0tCue7meaMh+WumueahOWFg+e0oOa3u+WKoOW3suW8g+eUqOeahOagh+iusAoK6K+l5qih5p2/5Lya57uZ54i25YWD57Sg5re75YqgIGNsYXNzIOWSjCB0aXRsZSDlsZ7mgKfvvIwK5omA5Lul5b+F6aG76KaB5Zyo54i25YWD57Sg55qE5Lu75L2V5a2Q5YWD57Sg5LmL5YmN77yM5ZCm5YiZIGNocm9tZSDlkowgc2FmYXJpIOWPr+iDveaXoOazleato+W4uOino+aekOOAggotLT4KPHhzbDp0ZW1wbGF0ZSBuYW1lPSJkZXByZWNhdGVkIj4KPHhzbDpwYXJhbSBuYW1lPSJkZXByZWNhdGVkIiAvPgo8eHNsOmlmIHRlc3Q9IiRkZXByZWNhdGVkIj4KICAgIDx4c2w6YXR0cmlidXRlIG5hbWU9ImNsYXNzIj48eHNsOnZhbHVlLW9mIHNlbGVjdD0iJ2RlbCciIC8+PC94c2w6YXR0cmlidXRlPgogICAgPHhzbDphdHRyaWJ1dGUgbmFtZT0idGl0bGUiPgogICAgICAgIDx4c2w6dmFsdWUtb2Ygc2VsZWN0PSIkZGVwcmVjYXRlZCIgLz4KICAgIDwveHNsOmF0dHJpYnV0ZT4KPC94c2w6aWY+CjwveHNsOnRlbXBsYXRlPgoKPCEtLSDnlKjkuo7lsIYgQVBJIOWcsOWdgOi9rOaNouaIkOWQiOazleeahCBJRCDmoIforrAgLS0+Cjx4c2w6dmFyaWFibGUgbmFtZT0iaWQtZnJvbSIgc2VsZWN0PSIne30vJyIgLz4KPHhzbDp2YXJpYWJsZSBuYW1lPSJpZC10byIgc2VsZWN0PSInX18tJyIgLz4KCjwhLS0g5qC55o2u5oOF5Ya16I635Y+W55u45bqU55qE5Zu+5qCHIC0tPgo8eHNsOnZhcmlhYmxlIG5hbWU9Imljb24iPgogICAgPHhzbDpjaG9vc2U+CiAgICAgICAgPHhzbDp3aGVuIHRlc3Q9Ii9hcGlkb2MvQGxvZ28iPgogICAgICAgICAgICA8eHNsOnZhbHVlLW9mIHNlbGVjdD0iL2FwaWRvYy9AbG9nbyIgLz4KICAgICAgICA8L3hzbDp3aGVuPgogICAgICAgIDx4c2w6b3RoZXJ3aXNlPgogICAgICAgICAgICA8eHNsOnZhbHVlLW9mIHNlbGVjdD0iY29uY2F0KCRiYXNlLXVybCwgJy4uL2ljb24uc3ZnJykiIC8+CiAgICAgICAgPC94c2w6b3RoZXJ3aXNlPgogICAgPC94c2w6Y2hvb3NlPgo8L3hzbDp2YXJpYWJsZT4KCjwhLS0K6I635Y+W55u45a+55LqO5b2T5YmNIHhzbCDmlofku7bnmoTln7rlnLDlnYAKeHNsIDIuMCDlj6/ku6Xnm7TmjqXph4fnlKggYmFzZS11cmkoZG9jdW1lbnQoJycpKQotLT4KPHhzbDp2YXJpYWJsZSBuYW1lPSJiYXNlLXVybCI+CiAgICA8eHNsOmFwcGx5LXRlbXBsYXRlcyBzZWxlY3Q9InByb2Nlc3NpbmctaW5zdHJ1Y3Rpb24oJ3htbC1zdHlsZXNoZWV0JykiIC8+CjwveHNsOnZhcmlhYmxlPgoKPHhzbDp0ZW1wbGF0ZSBtYXRjaD0icHJvY2Vzc2luZy1pbnN0cnVjdGlvbigneG1sLXN0eWxlc2hlZXQnKVsxXSI+CiAgICA8eHNsOnZhcmlhYmxlIG5hbWU9InYxIiBzZWxlY3Q9InN1YnN0cmluZy1hZnRlciguLCAnaHJlZj0mcXVvdDsnKSIgLz4KICAgIDwhLS0gTk9URTog5q2k5aSE5YGH5a6a5b2T5YmN5paH5Lu25Y+r5L2cIGFwaWRvYy54c2zvvIzlpoLmnpzkuI3mmK/nmoTor53vvIzpnIDopoHlj6blpJblpITnkIbmraTku6PnoIEgLS0+CiAgICA8eHNsOnZhcmlhYmxlIG5hbWU9InYyIiBzZWxlY3Q9InN1YnN0cmluZy1iZWZvcmUoJHYxLCAnYXBpZG9jLnhzbCZxdW90OycpIiAvPgogICAgPHhzbDp2YWx1ZS1vZiBzZWxlY3Q9IiR2MiIgLz4KPC94c2w6dGVtcGxhdGU+Cgo8L3hzbDpzdHlsZXNoZWV0PgoAAQ52Ni9sb2NhbGVzLnhzbAEXdGV4dC94c2w7IGNoYXJzZXQ9dXRmLTgB/jJuPD94bWwgdmVyc2lvbj0iMS4wIiBlbmNvZGluZz0iVVRGLTgiPz4KCjwhLS0g5b2T5YmN5paH5Lu25a6e546w5LqG566A5Y2V55qE57+76K+R5Yqf6IO9IC0tPgoKPHhzbDpzdHlsZXNoZWV0CnZlcnNpb249IjEuMCIKeG1sbnM6eHNsPSJodHRwOi8vd3d3LnczLm9yZy8xOTk5L1hTTC9UcmFuc2Zvcm0iCnhtbG5zOmw9InVybjpsb2NhbGUiCmV4Y2x1ZGUtcmVzdWx0LXByZWZpeGVzPSJsIj4KCjwhLS0g5b2T5YmN5pSv5oyB55qE5pys5Zyw5YyW5YiX6KGo77yM5YW25Lit56ys5LiA5Liq5Lya6KKr5b2T5L2c6buY6K6k5YC844CCIC0tPgo8IS0tIE5PVEU6IGlkIOS4gOW+i+eUqOWwj+WGme+8jOS5i+WQjumcgOimgeeUqOWIsOavlOi+g+Wtl+espuS4su+8gSAtLT4KPGw6bG9jYWxlcz4KICAgIDxsb2NhbGUgaWQ9ImNtbi1oYW5zIj7nroDkvZPkuK3mloc8L2xvY2FsZT4KICAgIDxsb2NhbGUgaWQ9ImNtbi1oYW50Ij7nuYHpq5TkuK3mloc8L2xvY2FsZT4KPC9sOmxvY2FsZXM+Cgo8eHNsOnRlbXBsYXRlIG5hbWU9Imxhbmd1YWdlcyI+CiAgICA8eHNsOmZvci1lYWNoIHNlbGVjdD0iZG9jdW1lbnQoJycpL3hzbDpzdHlsZXNoZWV0L2w6bG9jYWxlcy9sb2NhbGUiPgogICAgPGxpIGxhbmc9IntAaWR9IiByb2xlPSJtZW51aXRlbXJhZGlvIj4KICAgICAgICA8bGFiZWw+PGlucHV0IHR5cGU9InJhZGlvIiBuYW1lPSJsYW5nIiBjaGVja2VkPSJ7JGN1cnItbGFuZz1AaWR9IiAvPiYjMTYwOzx4c2w6dmFsdWUtb2Ygc2VsZWN0PSIuIiAvPjwvbGFiZWw+CiAgICA8L2xpPgogICAgPC94c2w6Zm9yLWVhY2g+CjwveHNsOnRlbXBsYXRlPgoKPCEtLSBsYW5ndWFnZSAtLT4KPHhzbDp2YXJpYWJsZSBuYW1lPSJsb2NhbGUtbGFuZ3VhZ2UiPgogICAgPHhzbDpjYWxsLXRlbXBsYXRlIG5hbWU9ImJ1aWxkLWxvY2FsZSI+CiAgICAgICAgPHhzbDp3aXRoLXBhcmFtIG5hbWU9ImxhbmciIHNlbGVjdD0iJ2Ntbi1oYW5zJyIgLz4KICAgICAgICA8eHNsOndpdGgtcGFyYW0gbmFtZT0idGV4dCIgc2VsZWN0PSJkb2N1bWVudCgnJykveHNsOnN0eWxlc2hlZXQvbDpsb2NhbGVzL2xvY2FsZVtAaWQ9J2Ntbi1oYW5zJ10iIC8+CiAgICA8L3hzbDpjYWxsLXRlbXBsYXRlPgoKICAgIDx4c2w6Y2FsbC10ZW1wbGF0ZSBuYW1lPSJidWlsZC1sb2NhbGUiPgogICAgICAgIDx4c2w6d2l0aC1wYXJhbSBuYW1lPSJsYW5nIiBzZWxlY3Q9IidjbW4taGFudCciIC8+CiAgICAgICAgPHhzbDp3aXRoLXBhcmFtIG5hbWU9InRleHQiIHNlbGVjdD0iZG9jdW1lbnQoJycpL3hzbDpzdHlsZXNoZWV0L2w6bG9jYWxlcy9sb2NhbGVbQGlkPSdjbW4taGFudCddIiAvPgogICAgPC94c2w6Y2FsbC10ZW1wbGF0ZT4KPC94c2w6dmFyaWFibGU+Cgo8IS0tIHNlcnZlciAtLT4KPHhzbDp2YXJpYWJsZSBuYW1lPSJsb2NhbGUtc2VydmVyIj4KICAgIDx4c2w6Y2FsbC10ZW1wbGF0ZSBuYW1lPSJidWlsZC1sb2NhbGUiPgogICAgICAgIDx4c2w6d2l0aC1wYXJhbSBuYW1lPSJsYW5nIiBzZWxlY3Q9IidjbW4taGFucyciIC8+CiAgICAgICAgPHhzbDp3aXRoLXBhcmFtIG5hbWU9InRleHQiIHNlbGVjdD0iJ+acjeWKoSciIC8+CiAgICA8L3hzbDpjYWxsLXRlbXBsYXRlPgoKICAgIDx4c2w6Y2FsbC10ZW1wbGF0ZSBuYW1lPSJidWlsZC1sb2NhbGUiPgogICAgICAgIDx4c2w6d2l0aC1wYXJhbSBuYW1lPSJsYW5nIiBzZWxlY3Q9IidjbW4taGFudCciIC8+CiAgICAgICAgPHhzbDp3aXRoLXBhcmFtIG5hbWU9InRleHQiIHNlbGVjdD0iJ+acjeWLmSciIC8+CiAgICA8L3hzbDpjYWxsLXRlbXBsYXRlPgo8L3hzbDp2YXJpYWJsZT4KCjwhLS0gdGFnIC0tPgo8eHNsOnZhcmlhYmxlIG5hbWU9ImxvY2FsZS10YWciPgogICAgPHhzbDpjYWxsLXRlbXBsYXRlIG5hbWU9ImJ1aWxkLWxvY2FsZSI+CiAgICAgICAgPHhzbDp3aXRoLXBhcmFtIG5hbWU9ImxhbmciIHNlbGVjdD0iJ2Ntbi1oYW5zJyIgLz4KICAgICAgICA8eHNsOndpdGgtcGFyYW0gbmFtZT0idGV4dCIgc2VsZWN0PSIn5qCH562+JyIgLz4KICAgIDwveHNsOmNhbGwtdGVtcGxhdGU+CgogICAgPHhzbDpjYWxsLXRlbXBsYXRlIG5hbWU9ImJ1aWxkLWxvY2FsZSI+CiAgICAgICAgPHhzbDp3aXRoLXBhcmFtIG5hbWU9ImxhbmciIHNlbGVjdD0iJ2Ntbi1oYW50JyIgLz4KICAgICAgICA8eHNsOndpdGgtcGFyYW0gbmFtZT0idGV4dCIgc2VsZWN0PSIn5qiZ57C9JyIgLz4KICAgIDwveHNsOmNhbGwtdGVtcGxhdGU+CjwveHNsOnZhcmlhYmxlPgoKPCEtLSBvdGhlciAtLT4KPHhzbDp2YXJpYWJsZSBuYW1lPSJsb2NhbGUtdW5jYXRlZ29yaXplZCI+CiAgICA8eHNsOmNhbGwtdGVtcGxhdGUgbmFtZT0iYnVpbGQtbG9jYWxlIj4KICAgICAgICA8eHNsOndpdGgtcGFyYW0gbmFtZT0ibGFuZyIgc2VsZWN0PSInY21uLWhhbnMnIiAvPgogICAgICAgIDx4c2w6d2l0aC1wYXJhbSBuYW1lPSJ0ZXh0IiBzZWxlY3Q9IifmnKrliIbnsbsnIiAvPgogICAgPC94c2w6Y2FsbC10ZW1wbGF0ZT4KCiAgICA8eHNsOmNhbGwtdGVtcGxhdGUgbmFtZT0iYnVpbGQtbG9jYWxlIj4KICAgICAgICA8eHNsOndpdGgtcGFyYW0gbmFtZT0ibGFuZyIgc2VsZWN0PSInY21uLWhhbnQnIiAvPgogICAgICAgIDx4c2w6d2l0aC1wYXJhbSBuYW1lPSJ0ZXh0IiBzZWxlY3Q9IifmnKrliIbpoZ4nIiAvPgogICAgPC94c2w6Y2FsbC10ZW1wbGF0ZT4KPC94c2w6dmFyaWFibGU+Cgo8IS0tIGV4cGFuZCAtLT4KPHhzbDp2YXJpYWJsZSBuYW1lPSJsb2NhbGUtZXhwYW5kIj4KICAgIDx4c2w6Y2FsbC10ZW1wbGF0ZSBuYW1lPSJidWlsZC1sb2NhbGUiPgogICAgICAgIDx4c2w6d2l0aC1wYXJhbSBuYW1lPSJsYW5nIiBzZWxlY3Q9IidjbW4taGFucyciIC8+CiAgICAgICAgPHhzbDp3aXRoLXBhcmFtIG5hbWU9InRleHQiIHNlbGVjdD0iJ+WxleW8gCciIC8+CiAgICA8L3hzbDpjYWxsLXRlbXBsYXRlPgoKICAgIDx4c2w6Y2FsbC10ZW1wbGF0ZSBuYW1lPSJidWlsZC1sb2NhbGUiPgogICAgICAgIDx4c2w6d2l0aC1wYXJhbSBuYW1lPSJsYW5nIiBzZWxlY3Q9IidjbW4taGFudCciIC8+CiAgICAgICAgPHhzbDp3aXRoLXBhcmFtIG5hbWU9InRleHQiIHNlbGVjdD0iJ+WxlemWiyciIC8+CiAgICA8L3hzbDpjYWxsLXRlbXBsYXRlPgo8L3hzbDp2YXJpYWJsZT4KCjwhLS0gbWV0aG9kIC0tPgo8eHNsOnZhcmlhYmxlIG5hbWU9ImxvY2FsZS1tZXRob2QiPgogICAgPHhzbDpjYWxsLXRlbXBsYXRlIG5hbWU9ImJ1aWxkLWxvY2FsZSI+CiAgICAgICAgPHhzbDp3aXRoLXBhcmFtIG5hbWU9ImxhbmciIHNlbGVjdD0iJ2Ntbi1oYW5zJyIgLz4KICAgICAgICA8eHNsOndpdGgtcGFyYW0gbmFtZT0idGV4dCIgc2VsZWN0PSIn6K+35rGC5pa55rOVJyIgLz4KICAgIDwveHNsOmNhbGwtdGVtcGxhdGU+CgogICAgPHhzbDpjYWxsLXRlbXBsYXRlIG5hbWU9ImJ1aWxkLWxvY2FsZSI+CiAgICAgICAgPHhzbDp3aXRoLXBhcmFtIG5hbWU9ImxhbmciIHNlbGVjdD0iJ2Ntbi1oYW50JyIgLz4KICAgICAgICA8eHNsOndpdGgtcGFyYW0gbmFtZT0idGV4dCIgc2VsZWN0PSIn6KuL5rGC5pa55rOVJyIgLz4KICAgIDwveHNsOmNhbGwtdGVtcGxhdGU+CjwveHNsOnZhcmlhYmxlPgoKPCEtLSByZXF1ZXN0IC0tPgo8eHNsOnZhcmlhYmxlIG5hbWU9ImxvY2FsZS1yZXF1ZXN0Ij4KICAgIDx4c2w6Y2FsbC10ZW1wbGF0ZSBuYW1lPSJidWlsZC1sb2NhbGUiPgogICAgICAgIDx4c2w6d2l0aC1wYXJhbSBuYW1lPSJsYW5nIiBzZWxlY3Q9IidjbW4taGFucyciIC8+CiAgICAgICAgPHhzbDp3aXRoLXBhcmFtIG5hbWU9InRleHQiIHNlbGVjdD0iJ+ivt+axgiciIC8+CiAgICA8L3hzbDpjYWxsLXRlbXBsYXRlPgoKICAgIDx4c2w6Y2FsbC10ZW1wbGF0ZSBuYW1lPSJidWlsZC1sb2NhbGUiPgogICAgICAgIDx4c2w6d2l0aC1wYXJhbSBuYW1lPSJsYW5nIiBzZWxlY3Q9IidjbW4taGFudCciIC8+CiAgICAgICAgPHhzbDp3aXRoLXBhcmFtIG5hbWU9InRleHQiIHNlbGVjdD0iJ+iri+axgiciIC8+CiAgICA8L3hzbDpjYWxsLXRlbXBsYXRlPgo8L3hzbDp2YXJpYWJsZT4KCjwhLS0gcmVzcG9uc2UgLS0+Cjx4c2w6dmFyaWFibGUgbmFtZT0ibG9jYWxlLXJlc3BvbnNlIj4KICAgIDx4c2w6Y2FsbC10ZW1wbGF0ZSBuYW1lPSJidWlsZC1sb2NhbGUiPgogICAgICAgIDx4c2w6d2l0aC1wYXJhbSBuYW1lPSJsYW5nIiBzZWxlY3Q9IidjbW4taGFucyciIC8+CiAgICAgICAgPHhzbDp3aXRoLXBhcmFtIG5hbWU9InRleHQiIHNlbGVjdD0iJ+i/lOWbniciIC8+CiAgICA8L3hzbDpjYWxsLXRlbXBsYXRlPgoKICAgIDx4c2w6Y2FsbC10ZW1wbGF0ZSBuYW1lPSJidWlsZC1sb2NhbGUiPgogICAgICAgIDx4c2w6d2l0aC1wYXJhbSBuYW1lPSJsYW5nIiBzZWxlY3Q9IidjbW4taGFudCciIC8+CiAgICAgICAgPHhzbDp3aXRoLXBhcmFtIG5hbWU9InRleHQiIHNlbGVjdD0iJ+i/lOWbniciIC8+CiAgICA8L3hzbDpjYWxsLXRlbXBsYXRlPgo8L3hzbDp2YXJpYWJsZT4KCjwhLS0gY2FsbGJhY2sgLS0+Cjx4c2w6dmFyaWFibGUgbmFtZT0ibG9jYWxlLWNhbGxiYWNrIj4KICAgIDx4c2w6Y2FsbC10ZW1wbGF0ZSBuYW1lPSJidWlsZC1sb2NhbGUiPgogICAgICAgIDx4c2w6d2l0aC1wYXJhbSBuYW1lPSJsYW5nIiBzZWxlY3Q9IidjbW4taGFucyciIC8+CiAgICAgICAgPHhzbDp3aXRoLXBhcmFtIG5hbWU9InRleHQiIHNlbGVjdD0iJ+WbnuiwgyciIC8+CiAgICA8L3hzbDpjYWxsLXRlbXBsYXRlPgoKICAgIDx4c2w6Y2FsbC10ZW1wbGF0ZSBuYW1lPSJidWlsZC1sb2NhbGUiPgogICAgICAgIDx4c2w6d2l0aC1wYXJhbSBuYW1lPSJsYW5nIiBzZWxlY3Q9IidjbW4taGFudCciIC8+CiAgICAgICAgPHhzbDp3aXRoLXBhcmFtIG5hbWU9InRleHQiIHNlbGVjdD0iJ+WbnuiqvyciIC8+CiAgICA8L3hzbDpjYWxsLXRlbXBsYXRlPgo8L3hzbDp2YXJpYWJsZT4KCjwhLS0gcGF0aCBwYXJhbSAtLT4KPHhzbDp2YXJpYWJsZSBuYW1lPSJsb2NhbGUtcGF0aC1wYXJhbSI+CiAgICA8eHNsOmNhbGwtdGVtcGxhdGUgbmFtZT0iYnVpbGQtbG9jYWxlIj4KICAgICAgICA8eHNsOndpdGgtcGFyYW0gbmFtZT0ibGFuZyIgc2VsZWN0PSInY21uLWhhbnMnIiAvPgogICAgICAgIDx4c2w6d2l0aC1wYXJhbSBuYW1lPSJ0ZXh0IiBzZWxlY3Q9Iifot6/lvoTlj4LmlbAnIiAvPgogICAgPC94c2w6Y2FsbC10ZW1wbGF0ZT4KCiAgICA8eHNsOmNhbGwtdGVtcGxhdGUgbmFtZT0iYnVpbGQtbG9jYWxlIj4KICAgICAgICA8eHNsOndpdGgtcGFyYW0gbmFtZT0ibGFuZyIgc2VsZWN0PSInY21uLWhhbnQnIiAvPgogICAgICAgIDx4c2w6d2l0aC1wYXJhbSBuYW1lPSJ0ZXh0IiBzZWxlY3Q9Iifot6/lvpHlj4PmlbgnIiAvPgogICAgPC94c2w6Y2FsbC10ZW1wbGF0ZT4KPC94c2w6dmFyaWFibGU+Cgo8IS0tIHF1ZXJ5IC0tPgo8eHNsOnZhcmlhYmxlIG5hbWU9ImxvY2FsZS1xdWVyeSI+CiAgICA8eHNsOmNhbGwtdGVtcGxhdGUgbmFtZT0iYnVpbGQtbG9jYWxlIj4KICAgICAgICA8eHNsOndpdGgtcGFyYW0gbmFtZT0ibGFuZyIgc2VsZWN0PSInY21uLWhhbnMnIiAvPgogICAgICAgIDx4c2w6d2l0aC1wYXJhbSBuYW1lPSJ0ZXh0IiBzZWxlY3Q9Iifmn6Xor6Llj4LmlbAnIiAvPgogICAgPC94c2w6Y2FsbC10ZW1wbGF0ZT4KCiAgICA8eHNsOmNhbGwtdGVtcGxhdGUgbmFtZT0iYnVpbGQtbG9jYWxlIj4KICAgICAgICA8eHNsOndpdGgtcGFyYW0gbmFtZT0ibGFuZyIgc2VsZWN0PSInY21uLWhhbnQnIiAvPgogICAgICAgIDx4c2w6d2l0aC1wYXJhbSBuYW1lPSJ0ZXh0IiBzZWxlY3Q9Iifmn6XoqaLlj4PmlbgnIiAvPgogICAgPC94c2w6Y2FsbC10ZW1wbGF0ZT4KPC94c2w6dmFyaWFibGU+Cgo8IS0tIGhlYWRlciAtLT4KPHhzbDp2YXJpYWJsZSBuYW1lPSJsb2NhbGUtaGVhZGVyIj4KICAgIDx4c2w6Y2FsbC10ZW1wbGF0ZSBuYW1lPSJidWlsZC1sb2NhbGUiPgogICAgICAgIDx4c2w6d2l0aC1wYXJhbSBuYW1lPSJsYW5nIiBzZWxlY3Q9IidjbW4taGFucyciIC8+CiAgICAgICAgPHhzbDp3aXRoLXBhcmFtIG5hbWU9InRleHQiIHNlbGVjdD0iJ+aKpeWktCciIC8+CiAgICA8L3hzbDpjYWxsLXRlbXBsYXRlPgoKICAgIDx4c2w6Y2FsbC10ZW1wbGF0ZSBuYW1lPSJidWlsZC1sb2NhbGUiPgogICAgICAgIDx4c2w6d2l0aC1wYXJhbSBuYW1lPSJsYW5nIiBzZWxlY3Q9IidjbW4taGFudCciIC8+CiAgICAgICAgPHhzbDp3aXRoLXBhcmFtIG5hbWU9InRleHQiIHNlbGVjdD0iJ+WgsemgrSciIC8+CiAgICA8L3hzbDpjYWxsLXRlbXBsYXRlPgo8L3hzbDp2YXJpYWJsZT4KCjwhLS0gYm9keSAtLT4KPHhzbDp2YXJpYWJsZSBuYW1lPSJsb2NhbGUtYm9keSI+CiAgICA8eHNsOmNhbGwtdGVtcGxhdGUgbmFtZT0iYnVpbGQtbG9jYWxlIj4KICAgICAgICA8eHNsOndpdGgtcGFyYW0gbmFtZT0ibGFuZyIgc2VsZWN0PSInY21uLWhhbnMnIiAvPgogICAgICAgIDx4c2w6d2l0aC1wYXJhbSBuYW1lPSJ0ZXh0IiBzZWxlY3Q9IifmiqXmlocnIiAvPgogICAgPC94c2w6Y2FsbC10ZW1wbGF0ZT4KCiAgICA8eHNsOmNhbGwtdGVtcGxhdGUgbmFtZT0iYnVpbGQtbG9jYWxlIj4KICAgICAgICA8eHNsOndpdGgtcGFyYW0gbmFtZT0ibGFuZyIgc2VsZWN0PSInY21uLWhhbnQnIiAvPgogICAgICAgIDx4c2w6d2l0aC1wYXJhbSBuYW1lPSJ0ZXh0IiBzZWxlY3Q9IifloLHmlocnIiAvPgogICAgPC94c2w6Y2FsbC10ZW1wbGF0ZT4KPC94c2w6dmFyaWFibGU+Cgo8IS0tIGV4YW1wbGUgLS0+Cjx4c2w6dmFyaWFibGUgbmFtZT0ibG9jYWxlLWV4YW1wbGUiPgogICAgPHhzbDpjYWxsLXRlbXBsYXRlIG5hbWU9ImJ1aWxkLWxvY2FsZSI+CiAgICAgICAgPHhzbDp3aXRoLXBhcmFtIG5hbWU9ImxhbmciIHNlbGVjdD0iJ2Ntbi1oYW5zJyIgLz4KICAgICAgICA8eHNsOndpdGgtcGFyYW0gbmFtZT0idGV4dCIgc2VsZWN0PSIn56S65L6L5Luj56CBJyIgLz4KICAgIDwveHNsOmNhbGwtdGVtcGxhdGU+CgogICAgPHhzbDpjYWxsLXRlbXBsYXRlIG5hbWU9ImJ1aWxkLWxvY2FsZSI+CiAgICAgICAgPHhzbDp3aXRoLXBhcmFtIG5hbWU9ImxhbmciIHNlbGVjdD0iJ2Ntbi1oYW50JyIgLz4KICAgICAgICA8eHNsOndpdGgtcGFyYW0gbmFtZT0idGV4dCIgc2VsZWN0PSIn56S65L6L5Luj56K8JyIgLz4KICAgIDwveHNsOmNhbGwtdGVtcGxhdGU+CjwveHNsOnZhcmlhYmxlPgoKPCEtLSB2YXIgLS0+Cjx4c2w6dmFyaWFibGUgbmFtZT0ibG9jYWxlLXZhciI+CiAgICA8eHNsOmNhbGwtdGVtcGxhdGUgbmFtZT0iYnVpbGQtbG9jYWxlIj4KICAgICAgICA8eHNsOndpdGgtcGFyYW0gbmFtZT0ibGFuZyIgc2VsZWN0PSInY21uLWhhbnMnIiAvPgogICAgICAgIDx4c2w6d2l0aC1wYXJhbSBuYW1lPSJ0ZXh0IiBzZWxlY3Q9Iiflj5jph48nIiAvPgogICAgPC94c2w6Y2FsbC10ZW1wbGF0ZT4KCiAgICA8eHNsOmNhbGwtdGVtcGxhdGUgbmFtZT0iYnVpbGQtbG9jYWxlIj4KICAgICAgICA8eHNsOndpdGgtcGFyYW0gbmFtZT0ibGFuZyIgc2VsZWN0PSInY21uLWhhbnQnIiAvPgogICAgICAgIDx4c2w6d2l0aC1wYXJhbSBuYW1lPSJ0ZXh0IiBzZWxlY3Q9Iifororph48nIiAvPgogICAgPC94c2w6Y2FsbC10ZW1wbGF0ZT4KPC94c2w6dmFyaWFibGU+Cgo8IS0tIHR5cGUgLS0+Cjx4c2w6dmFyaWFibGUgbmFtZT0ibG9jYWxlLXR5cGUiPgogICAgPHhzbDpjYWxsLXRlbXBsYXRlIG5hbWU9ImJ1aWxkLWxvY2FsZSI+CiAgICAgICAgPHhzbDp3aXRoLXBhcmFtIG5hbWU9ImxhbmciIHNlbGVjdD0iJ2Ntbi1oYW5zJyIgLz4KICAgICAgICA8eHNsOndpdGgtcGFyYW0gbmFtZT0idGV4dCIgc2VsZWN0PSIn57G75Z6LJyIgLz4KICAgIDwveHNsOmNhbGwtdGVtcGxhdGU+CgogICAgPHhzbDpjYWxsLXRlbXBsYXRlIG5hbWU9ImJ1aWxkLWxvY2FsZSI+CiAgICAgICAgPHhzbDp3aXRoLXBhcmFtIG5hbWU9ImxhbmciIHNlbGVjdD0iJ2Ntbi1oYW50JyIgLz4KICAgICAgICA8eHNsOndpdGgtcGFyYW0gbmFtZT0idGV4dCIgc2VsZWN0PSIn6aGe5Z6LJyIgLz4KICAgIDwveHNsOmNhbGwtdGVtcGxhdGU+CjwveHNsOnZhcmlhYmxlPgoKPCEtLSB2YWx1ZSAtLT4KPHhzbDp2YXJpYWJsZSBuYW1lPSJsb2NhbGUtdmFsdWUiPgogICAgPHhzbDpjYWxsLXRlbXBsYXRlIG5hbWU9ImJ1aWxkLWxvY2FsZSI+CiAgICAgICAgPHhzbDp3aXRoLXBhcmFtIG5hbWU9ImxhbmciIHNlbGVjdD0iJ2Ntbi1oYW5zJyIgLz4KICAgICAgICA8eHNsOndpdGgtcGFyYW0gbmFtZT0idGV4dCIgc2VsZWN0PSIn5YC8JyIgLz4KICAgIDwveHNsOmNhbGwtdGVtcGxhdGU+CgogICAgPHhzbDpjYWxsLXRlbXBsYXRlIG5hbWU9ImJ1aWxkLWxvY2FsZSI+CiAgICAgICAgPHhzbDp3aXRoLXBhcmFtIG5hbWU9ImxhbmciIHNlbGVjdD0iJ2Ntbi1oYW50JyIgLz4KICAgICAgICA8eHNsOndpdGgtcGFyYW0gbmFtZT0idGV4dCIgc2VsZWN0PSIn5YC8JyIgLz4KICAgIDwveHNsOmNhbGwtdGVtcGxhdGU+CjwveHNsOnZhcmlhYmxlPgoKPCEtLSBnb3RvLXRvcCAtLT4KPHhzbDp2YXJpYWJsZSBuYW1lPSJsb2NhbGUtZ290by10b3AiPgogICAgPHhzbDpjYWxsLXRlbXBsYXRlIG5hbWU9ImJ1aWxkLWxvY2FsZSI+CiAgICAgICAgPHhzbDp3aXRoLXBhcmFtIG5hbWU9ImxhbmciIHNlbGVjdD0iJ2Ntbi1oYW5zJyIgLz4KICAgICAgICA8eHNsOndpdGgtcGFyYW0gbmFtZT0idGV4dCIgc2VsZWN0PSIn6L+U5Zue6aG26YOoJyIgLz4KICAgIDwveHNsOmNhbGwtdGVtcGxhdGU+CgogICAgPHhzbDpjYWxsLXRlbXBsYXRlIG5hbWU9ImJ1aWxkLWxvY2FsZSI+CiAgICAgICAgPHhzbDp3aXRoLXBhcmFtIG5hbWU9ImxhbmciIHNlbGVjdD0iJ2Ntbi1oYW50JyIgLz4KICAgICAgICA8eHNsOndpdGgtcGFyYW0gbmFtZT0idGV4dCIgc2VsZWN0PSIn6L+U5Zue6aCC6YOoJyIgLz4KICAgIDwveHNsOmNhbGwtdGVtcGxhdGU+CjwveHNsOnZhcmlhYmxlPgoKPCEtLSBkZXNjcmlwdGlvbiAtLT4KPHhzbDp2YXJpYWJsZSBuYW1lPSJsb2NhbGUtZGVzY3JpcHRpb24iPgogICAgPHhzbDpjYWxsLXRlbXBsYXRlIG5hbWU9ImJ1aWxkLWxvY2FsZSI+CiAgICAgICAgPHhzbDp3aXRoLXBhcmFtIG5hbWU9ImxhbmciIHNlbGVjdD0iJ2Ntbi1oYW5zJyIgLz4KICAgICAgICA8eHNsOndpdGgtcGFyYW0gbmFtZT0idGV4dCIgc2VsZWN0PSIn5o+P6L+wJyIgLz4KICAgIDwveHNsOmNhbGwtdGVtcGxhdGU+CgogICAgPHhzbDpjYWxsLXRlbXBsYXRlIG5hbWU9ImJ1aWxkLWxvY2FsZSI+CiAgICAgICAgPHhzbDp3aXRoLXBhcmFtIG5hbWU9ImxhbmciIHNlbGVjdD0iJ2Ntbi1oYW50JyIgLz4KICAgICAgICA8eHNsOndpdGgtcGFyYW0gbmFtZT0idGV4dCIgc2VsZWN0PSIn5o+P6L+wJyIgLz4KICAgIDwveHNsOmNhbGwtdGVtcGxhdGU+CjwveHNsOnZhcmlhYmxlPgoKPCEtLSBlbnVtIC0tPgo8eHNsOnZhcmlhYmxlIG5hbWU9ImxvY2FsZS1lbnVtIj4KICAgIDx4c2w6Y2FsbC10ZW1wbGF0ZSBuYW1lPSJidWlsZC1sb2NhbGUiPgogICAgICAgIDx4c2w6d2l0aC1wYXJhbSBuYW1lPSJsYW5nIiBzZWxlY3Q9IidjbW4taGFucyciIC8+CiAgICAgICAgPHhzbDp3aXRoLXBhcmFtIG5hbWU9InRleHQiIHNlbGVjdD0iJ+aemuS4viciIC8+CiAgICA8L3hzbDpjYWxsLXRlbXBsYXRlPgoKICAgIDx4c2w6Y2FsbC10ZW1wbGF0ZSBuYW1lPSJidWlsZC1sb2NhbGUiPgogICAgICAgIDx4c2w6d2l0aC1wYXJhbSBuYW1lPSJsYW5nIiBzZWxlY3Q9IidjbW4taGFudCciIC8+CiAgICAgICAgPHhzbDp3aXRoLXBhcmFtIG5hbWU9InRleHQiIHNlbGVjdD0iJ+aemuiIiSciIC8+CiAgICA8L3hzbDpjYWxsLXRlbXBsYXRlPgo8L3hzbDp2YXJpYWJsZT4KCjwhLS0gZ2VuZXJhdG9yIC0tPgo8eHNsOnZhcmlhYmxlIG5hbWU9ImxvY2FsZS1nZW5lcmF0b3IiPgogICAgPHhzbDpjYWxsLXRlbXBsYXRlIG5hbWU9ImJ1aWxkLWxvY2FsZSI+CiAgICAgICAgPHhzbDp3aXRoLXBhcmFtIG5hbWU9ImxhbmciIHNlbGVjdD0iJ2Ntbi1oYW5zJyIgLz4KICAgICAgICA8eHNsOndpdGgtcGFyYW0gbmFtZT0idGV4dCI+CiAgICAgICAgICAgIDx4c2w6aWYgdGVzdD0iYXBpZG9jL2xpY2Vuc2UiPgogICAgICAgICAgICDmlofmoaPniYjmnYPkuLogPGEgaHJlZj0ie2FwaWRvYy9saWNlbnNlL0B1cmx9Ij48eHNsOnZhbHVlLW9mIHNlbGVjdD0iYXBpZG9jL2xpY2Vuc2UvQHRleHQiIC8+PC9hPuOAggogICAgICAgICAgICA8L3hzbDppZj4KICAgICAgICAgICAg5YyF5ZCr5LqGIDx4c2w6dmFsdWUtb2Ygc2VsZWN0PSJjb3VudChhcGlkb2MvYXBpKSIgLz4g5Liq5o6l5Y+j5aOw5piO77yM55SxIDxhIGhyZWY9Imh0dHBzOi8vYXBpZG9jLnRvb2xzIj5hcGlkb2M8L2E+IOeUn+aIkOS6jiA8dGltZT48eHNsOnZhbHVlLW9mIHNlbGVjdD0iYXBpZG9jL0BjcmVhdGVkIiAvPjwvdGltZT7jgIIKICAgICAgICA8L3hzbDp3aXRoLXBhcmFtPgogICAgPC94c2w6Y2FsbC10ZW1wbGF0ZT4KCiAgICA8eHNsOmNhbGwtdGVtcGxhdGUgbmFtZT0iYnVpbGQtbG9jYWxlIj4KICAgICAgICA8eHNsOndpdGgtcGFyYW0gbmFtZT0ibGFuZyIgc2VsZWN0PSInY21uLWhhbnQnIiAvPgogICAgICAgIDx4c2w6d2l0aC1wYXJhbSBuYW1lPSJ0ZXh0Ij4KICAgICAgICAgICAgPHhzbDppZiB0ZXN0PSJhcGlkb2MvbGljZW5zZSI+CiAgICAgICAgICAgIOaWh+aqlOeJiOasiueCuiA8YSBocmVmPSJ7YXBpZG9jL2xpY2Vuc2UvQHVybH0iPjx4c2w6dmFsdWUtb2Ygc2VsZWN0PSJhcGlkb2MvbGljZW5zZS9AdGV4dCIgLz48L2E+44CCCiAgICAgICAgICAgIDwveHNsOmlmPgogICAgICAgICAgICDljIXlkKvkuoYgPHhzbDp2YWx1ZS1vZiBzZWxlY3Q9ImNvdW50KGFwaWRvYy9hcGkpIiAvPiDlgIvmjqXlj6PogbLmmI7vvIznlLEgPGEgaHJlZj0iaHR0cHM6Ly9hcGlkb2MudG9vbHMiPmFwaWRvYzwvYT4g55Sf5oiQ5pa8IDx0aW1lPjx4c2w6dmFsdWUtb2Ygc2VsZWN0PSJhcGlkb2MvQGNyZWF0ZWQiIC8+PC90aW1lPuOAggogICAgICAgIDwveHNsOndpdGgtcGFyYW0+CiAgICA8L3hzbDpjYWxsLXRlbXBsYXRlPgo8L3hzbDp2YXJpYWJsZT4KCjx4c2w6dGVtcGxhdGUgbmFtZT0iYnVpbGQtbG9jYWxlIj4KICAgIDx4c2w6cGFyYW0gbmFtZT0ibGFuZyIgLz4KICAgIDx4c2w6cGFyYW0gbmFtZT0idGV4dCIgLz4KCiAgICA8eHNsOnZhcmlhYmxlIG5hbWU9ImNsYXNzIj4KICAgICAgICA8eHNsOmNob29zZT4KICAgICAgICAgICAgPHhzbDp3aGVuIHRlc3Q9IiRjdXJyLWxhbmc9dHJhbnNsYXRlKCRsYW5nLCAkdXBwZXJjYXNlLCAkbG93ZXJjYXNlKSI+CiAgICAgICAgICAgICAgICA8eHNsOnZhbHVlLW9mIHNlbGVjdD0iJyciIC8+CiAgICAgICAgICAgIDwveHNsOndoZW4+CiAgICAgICAgICAgIDx4c2w6b3RoZXJ3aXNlPgogICAgICAgICAgICAgICAgPHhzbDp2YWx1ZS1vZiBzZWxlY3Q9IidoaWRkZW4nIiAvPgogICAgICAgICAgICA8L3hzbDpvdGhlcndpc2U+CiAgICAgICAgPC94c2w6Y2hvb3NlPgogICAgPC94c2w6dmFyaWFibGU+CgogICAgPCEtLSBkYXRhLWxvY2FsZSDlsZ7mgKfooajnpLror6XlhYPntKDmmK/kuIDkuKrmnKzlnLDljJbkv6Hmga/lhYPntKDvvIxKUyDku6PnoIHpgJrov4for6XmoIforrDliIfmjaLor63oqIDjgIIgLS0+CiAgICA8c3BhbiBkYXRhLWxvY2FsZT0idHJ1ZSIgbGFuZz0ieyRsYW5nfSIgY2xhc3M9InskY2xhc3N9Ij48eHNsOmNvcHktb2Ygc2VsZWN0PSIkdGV4dCIgLz48L3NwYW4+CjwveHNsOnRlbXBsYXRlPgoKPCEtLQrov5Tlm57lvZPliY3mlofmoaPnmoTor63oqIDvvIzkvJrovazmjaLkuLrlsI/lhpnvvIxfIOS5n+S8muiiq+i9rOaNouaIkCAtCuWmguaenOaWh+aho+aMh+WumueahOivreiogOS4jeWtmOWcqO+8jOWImeS8mumHh+WPliBsOmxvY2FsZXMg5Lit55qE56ys5LiA5Liq5YWD57Sg5L2c5Li66buY6K6k6K+t6KiA44CCCi0tPgo8eHNsOnZhcmlhYmxlIG5hbWU9ImN1cnItbGFuZyI+CiAgICA8eHNsOnZhcmlhYmxlIG5hbWU9ImN1cnIiIHNlbGVjdD0idHJhbnNsYXRlKC9hcGlkb2MvQGxhbmcsICR1cHBlcmNhc2UsICRsb3dlcmNhc2UpIiAvPgoKICAgIDx4c2w6dmFyaWFibGUgbmFtZT0icjEiPgogICAgICAgIDx4c2w6Zm9yLWVhY2ggc2VsZWN0PSJkb2N1bWVudCgnJykveHNsOnN0eWxlc2hlZXQvbDpsb2NhbGVzL2xvY2FsZSI+CiAgICAgICAgICAgIDx4c2w6aWYgdGVzdD0iQGlkPSRjdXJyIj48eHNsOnZhbHVlLW9mIHNlbGVjdD0iJGN1cnIiIC8+PC94c2w6aWY+CiAgICAgICAgPC94c2w6Zm9yLWVhY2g+CiAgICA8L3hzbDp2YXJpYWJsZT4KCiAgICA8eHNsOnZhcmlhYmxlIG5hbWU9InIyIj4KICAgIDx4c2w6Y2hvb3NlPgogICAgICAgIDx4c2w6d2hlbiB0ZXN0PSIkcjEgYW5kIG5vdCgkcjE9JycpIj4gCiAgICAgICAgICAgIDx4c2w6dmFsdWUtb2Ygc2VsZWN0PSIkcjEiIC8+CiAgICAgICAgPC94c2w6d2hlbj4KICAgICAgICA8eHNsOm90aGVyd2lzZT4KICAgICAgICAgICAgPHhzbDp2YWx1ZS1vZiBzZWxlY3Q9ImRvY3VtZW50KCcnKS94c2w6c3R5bGVzaGVldC9sOmxvY2FsZXMvbG9jYWxlWzFdL0BpZCIgLz4KICAgICAgICA8L3hzbDpvdGhlcndpc2U+CiAgICA8L3hzbDpjaG9vc2U+CiAgICA8L3hzbDp2YXJpYWJsZT4KCiAgICA8eHNsOnZhbHVlLW9mIHNlbGVjdD0iJHIyIiAvPgo8L3hzbDp2YXJpYWJsZT4KCjwhLS0g55So5LqO5a6e546wIGxvd2VyLWNhc2Ug5ZKMIHVwcGVyLWNhc2XvvIzlpoLmnpzlsIbmnaXmn5DlpKnmtY/op4jlmajmlK/mjIEgeHNsIDIuMCDkuobvvIzlj6/ku6Xnm7TmjqXph4fnlKjnm7jlhbPlh73mlbAgLS0+Cjx4c2w6dmFyaWFibGUgbmFtZT0ibG93ZXJjYXNlIiBzZWxlY3Q9IidhYmNkZWZnaGlqa2xtbm9wcXJzdHV2d3h5ei0nIiAvPgo8eHNsOnZhcmlhYmxlIG5hbWU9InVwcGVyY2FzZSIgc2VsZWN0PSInQUJDREVGR0hJSktMTU5PUFFSU1RVVldYWVpfJyIgLz4KCjwveHNsOnN0eWxlc2hlZXQ+CgABDHY2L3ZpZXcuaHRtbAEYdGV4dC9odG1sOyBjaGFyc2V0PXV0Zi04Af4ICTwhRE9DVFlQRSBodG1sPgo8aHRtbCBsYW5nPSJlbiI+Cgo8aGVhZD4KICAgIDxtZXRhIGNoYXJzZXQ9IlVURi04Ij4KICAgIDxtZXRhIG5hbWU9InZpZXdwb3J0IiBjb250ZW50PSJ3aWR0aD1kZXZpY2Utd2lkdGgsIGluaXRpYWwtc2NhbGU9MS4wIj4KICAgIDxtZXRhIGh0dHAtZXF1aXY9IlgtVUEtQ29tcGF0aWJsZSIgY29udGVudD0iaWU9ZWRnZSI+CiAgICA8dGl0bGU+RG9jdW1lbnQ8L3RpdGxlPgoKICAgIDxzdHlsZT4KICAgIDpyb290IHsKICAgICAgICAtLWJhY2tncm91bmQ6IHdoaXRlOwogICAgfQogICAgQG1lZGlhIChwcmVmZXJzLWNvbG9yLXNjaGVtZTogZGFyaykgewogICAgICAgIDpyb290IHsKICAgICAgICAgICAgLS1iYWNrZ3JvdW5kOiBibGFjazsKICAgICAgICB9CiAgICB9CgogICAgaHRtbCwKICAgIGJvZHkgewogICAgICAgIG1hcmdpbjogMDsKICAgICAgICBwYWRkaW5nOiAwOwogICAgICAgIGJhY2tncm91bmQ6IHZhcigtLWJhY2tncm91bmQpOwogICAgfQoKICAgIGlmcmFtZSB7CiAgICAgICAgYm9yZGVyOiBub25lOwogICAgICAgIHdpZHRoOiAxMDAlOwogICAgICAgIGJhY2tncm91bmQ6IHZhcigtLWJhY2tncm91bmQpOwogICAgfQogICAgPC9zdHlsZT4KPC9oZWFkPgoKPGJvZHk+CiAgICA8IS0tCiAgICBOT1RFOiB4c2x0IOS4reW8leeUqOeahCBqc++8jOWPquacieWcqCBpZnJhbWUg5Lit5omN6IO95omn6KGM77yMCiAgICDnm7TmjqXnlKggZG9jdW1lbnQucmVwbGFjZSgpIOabv+aNouW9k+WJjeWGheWuue+8jOS4jeS8muaJp+ihjOW8leeUqOeahCBKUyDku6PnoIHjgIIKICAgIC0tPgogICAgPGlmcmFtZSBpZD0iYXBpZG9jIj48L2lmcmFtZT4KICAgIDxzY3JpcHQ+CiAgICBhc3luYyBmdW5jdGlvbiBsb2FkWE1MKHBhdGgpIHsKICAgICAgICBjb25zdCBvYmogPSBhd2FpdCBmZXRjaChwYXRoKTsKICAgICAgICByZXR1cm4gKG5ldyBET01QYXJzZXIoKSkucGFyc2VGcm9tU3RyaW5nKGF3YWl0IG9iai50ZXh0KCksICJ0ZXh0L3htbCIpOwogICAgfQoKICAgIGZ1bmN0aW9uIGNoYW5nZUZyYW1lSGVpZ2h0KCkgewogICAgICAgIGNvbnN0IGlmcmFtZSA9IGRvY3VtZW50LmdldEVsZW1lbnRCeUlkKCJhcGlkb2MiKTsKICAgICAgICBpZnJhbWUuaGVpZ2h0ID0gZG9jdW1lbnQuZG9jdW1lbnRFbGVtZW50LmNsaWVudEhlaWdodDsKICAgIH0KCiAgICB3aW5kb3cub25yZXNpemUgPSBmdW5jdGlvbiAoKSB7CiAgICAgICAgY2hhbmdlRnJhbWVIZWlnaHQoKTsKICAgIH0gCgogICAgY29uc3QgcXVlcmllcyA9IG5ldyBVUkxTZWFyY2hQYXJhbXMod2luZG93LmxvY2F0aW9uLnNlYXJjaCk7CiAgICBjb25zdCB1cmwgPSBxdWVyaWVzLmdldCgndXJsJyk7CgogICAgYXN5bmMgZnVuY3Rpb24gaW5pdCgpIHsKICAgICAgICBjb25zdCBwcm9jZXNzb3IgPSBuZXcgWFNMVFByb2Nlc3NvcigpOwogICAgICAgIHByb2Nlc3Nvci5pbXBvcnRTdHlsZXNoZWV0KGF3YWl0IGxvYWRYTUwoJy4vYXBpZG9jLnhzbCcpKTsKCiAgICAgICAgY29uc3QgeG1sID0gYXdhaXQgbG9hZFhNTCh1cmwpOwogICAgICAgIGNvbnN0IGRvYyA9IHByb2Nlc3Nvci50cmFuc2Zvcm1Ub0RvY3VtZW50KHhtbCk7CiAgICAgICAgY29uc3QgaHRtbCA9IChuZXcgWE1MU2VyaWFsaXplcigpKS5zZXJpYWxpemVUb1N0cmluZyhkb2MpOwogICAgICAgIGNvbnN0IGJsb2IgPSBuZXcgQmxvYihbaHRtbF0sIHsgdHlwZTogJ3RleHQvaHRtbCcgfSkKICAgICAgICBjb25zdCBvYmogPSBVUkwuY3JlYXRlT2JqZWN0VVJMKGJsb2IpCgogICAgICAgIGNvbnN0IGlmcmFtZSA9IGRvY3VtZW50LmdldEVsZW1lbnRCeUlkKCdhcGlkb2MnKTsKICAgICAgICBpZnJhbWUuc3JjID0gb2JqOwogICAgICAgIGlmcmFtZS5hZGRFdmVudExpc3RlbmVyKCdsb2FkJywgKGUpID0+IHsKICAgICAgICAgICAgY2hhbmdlRnJhbWVIZWlnaHQoKTsKICAgICAgICB9KTsKICAgIH0KCiAgICB0cnl7CiAgICAgICAgaW5pdCgpCiAgICB9IGNhdGNoIChlKSB7CiAgICAgICAgY29uc29sZS5lcnJvcihlKQogICAgfQogICAgPC9zY3JpcHQ+CjwvYm9keT4KCjwvaHRtbD4KAA==`
+const data = `Df+BAgEC/4IAAf+AAAAwfwMBAv+AAAEDAQROYW1lAQwAAQtDb250ZW50VHlwZQEMAAEHQ29udGVudAEKAAAA/QJQKf+CAA8BEWV4YW1wbGUvaW5kZXgueG1sAR5hcHBsaWNhdGlvbi94bWw7IGNoYXJzZXQ9dXRmLTgB/iMdPD94bWwgdmVyc2lvbj0iMS4wIiBlbmNvZGluZz0iVVRGLTgiPz4KCjw/eG1sLXN0eWxlc2hlZXQgdHlwZT0idGV4dC94c2wiIGhyZWY9Ii4uL3Y2L2FwaWRvYy54c2wiPz4KPGFwaWRvYyBhcGlkb2M9IjYuMS4wIiBjcmVhdGVkPSIyMDIwLTEwLTE2VDAxOjI4OjU0KzA4OjAwIiB2ZXJzaW9uPSIxLjEuMSI+Cgk8dGl0bGU+56S65L6L5paH5qGjPC90aXRsZT4KCTxkZXNjcmlwdGlvbiB0eXBlPSJodG1sIj48IVtDREFUQVsKICAgICAgIDxwPui/meaYr+S4gOS4queUqOS6jua1i+ivleeahOaWh+aho+eUqOS+izwvcD4KICAgICAgIOeKtuaAgeegge+8mgogICAgICAgPHVsPgogICAgICAgICAgIDxsaT40MDMwMDp4eHg8L2xpPgogICAgICAgICAgIDxsaT40MDMwMTp4eHg8L2xpPgogICAgICAgPC91bD4KICAgXV0+PC9kZXNjcmlwdGlvbj4KCTxjb250YWN0IG5hbWU9Im5hbWUiPgoJCTx1cmw+aHR0cHM6Ly9leGFtcGxlLmNvbTwvdXJsPgoJCTxlbWFpbD5leGFtcGxlQGV4YW1wbGUuY29tPC9lbWFpbD4KCTwvY29udGFjdD4KCTxsaWNlbnNlIHRleHQ9Ik1JVCIgdXJsPSJodHRwczovL29wZW5zb3VyY2Uub3JnL2xpY2Vuc2VzL01JVCI+PC9saWNlbnNlPgoJPHRhZyBuYW1lPSJ0MSIgdGl0bGU9Iuagh+etvjEiPjwvdGFnPgoJPHRhZyBuYW1lPSJ0MiIgdGl0bGU9Iuagh+etvjIiPjwvdGFnPgoJPHNlcnZlciBuYW1lPSJhZG1pbiIgdXJsPSJodHRwczovL2FwaS5leGFtcGxlLmNvbS9hZG1pbiI+CgkJPGRlc2NyaXB0aW9uIHR5cGU9Imh0bWwiPjwhW0NEQVRBWwogICAgICAg5ZCO5Y+w566h55CG5o6l5Y+j77yMPGJyIC8+PGJyIC8+PGJyIC8+PGJyIC8+PHAgc3R5bGU9ImNvbG9yOnJlZCI+YWRtaW48L3A+CiAgICAgICBdXT48L2Rlc2NyaXB0aW9uPgoJPC9zZXJ2ZXI+Cgk8c2VydmVyIG5hbWU9Im9sZC1jbGllbnQiIHVybD0iaHR0cHM6Ly9hcGkuZXhhbXBsZS5jb20vY2xpZW50IiBkZXByZWNhdGVkPSIxLjEuMSIgc3VtbWFyeT0i5a6i5oi356uv5o6l5Y+jIj48L3NlcnZlcj4KCTxzZXJ2ZXIgbmFtZT0iY2xpZW50IiB1cmw9Imh0dHBzOi8vYXBpLmV4YW1wbGUuY29tIiBzdW1tYXJ5PSLlrqLmiLfnq6/mjqXlj6MiPjwvc2VydmVyPgoJPGFwaSBtZXRob2Q9IkdFVCIgc3VtbWFyeT0i6I635Y+W55So5oi3IiBkZXByZWNhdGVkPSIxLjEuMTEiPgoJCTxwYXRoIHBhdGg9Ii91c2VycyI+CgkJCTxxdWVyeSBuYW1lPSJwYWdlIiB0eXBlPSJudW1iZXIiIGRlZmF1bHQ9IjAiIHN1bW1hcnk9IumhteeggSI+PC9xdWVyeT4KCQkJPHF1ZXJ5IG5hbWU9InNpemUiIHR5cGU9Im51bWJlciIgZGVmYXVsdD0iMjAiPgoJCQkJPGRlc2NyaXB0aW9uIHR5cGU9Im1hcmtkb3duIj48IVtDREFUQVvmlbDph49dXT48L2Rlc2NyaXB0aW9uPgoJCQk8L3F1ZXJ5PgoJCTwvcGF0aD4KCQk8ZGVzY3JpcHRpb24gdHlwZT0ibWFya2Rvd24iPjwhW0NEQVRBWwogICA8cD7ov5nmmK/lhbPkuo7mjqXlj6PnmoTor6bnu4bor7TmmI7mlofmoaM8L3A+PGJyIC8+CiAgIOWPr+S7peaYr+S4gOS4qiBIVE1MIOWGheWuuQogICBdXT48L2Rlc2NyaXB0aW9uPgoJCTxyZXNwb25zZSBuYW1lPSJ1c2VyIiB0eXBlPSJvYmplY3QiIGFycmF5PSJ0cnVlIiBzdGF0dXM9IjIwMCI+CgkJCTxwYXJhbSB4bWwtYXR0cj0idHJ1ZSIgbmFtZT0iY291bnQiIHR5cGU9Im51bWJlciIgb3B0aW9uYWw9ImZhbHNlIiBzdW1tYXJ5PSJzdW1tYXJ5Ij48L3BhcmFtPgoJCQk8cGFyYW0geG1sLXdyYXBwZWQ9InVzZXJzIiBuYW1lPSJ1c2VyIiB0eXBlPSJvYmplY3QiIGFycmF5PSJ0cnVlIiBzdW1tYXJ5PSJsaXN0Ij4KCQkJCTxwYXJhbSB4bWwtYXR0cj0idHJ1ZSIgbmFtZT0iaWQiIHR5cGU9Im51bWJlciIgc3VtbWFyeT0i55So5oi3IElEIj48L3BhcmFtPgoJCQkJPHBhcmFtIHhtbC1hdHRyPSJ0cnVlIiBuYW1lPSJuYW1lIiB0eXBlPSJzdHJpbmciIHN1bW1hcnk9IueUqOaIt+WQjSI+PC9wYXJhbT4KCQkJCTxwYXJhbSBuYW1lPSJncm91cHMiIHR5cGU9Im9iamVjdCIgb3B0aW9uYWw9InRydWUiIGFycmF5PSJ0cnVlIiBzdW1tYXJ5PSLnlKjmiLfmiYDlnKjnmoTmnYPpmZDnu4QiPgoJCQkJCTxwYXJhbSBuYW1lPSJpZCIgdHlwZT0ic3RyaW5nIiBzdW1tYXJ5PSLmnYPpmZDnu4QgSUQiPjwvcGFyYW0+CgkJCQkJPHBhcmFtIG5hbWU9Im5hbWUiIHR5cGU9InN0cmluZyIgc3VtbWFyeT0i5p2D6ZmQ57uE5ZCN56ewIj48L3BhcmFtPgoJCQkJPC9wYXJhbT4KCQkJCTxkZXNjcmlwdGlvbiB0eXBlPSJodG1sIj48IVtDREFUQVs8c3BhbiBzdHlsZT0iY29sb3I6cmVkIj5saXN0IGRlc2NyaXB0aW9uPC9zcGFuPl1dPjwvZGVzY3JpcHRpb24+CgkJCTwvcGFyYW0+CgkJPC9yZXNwb25zZT4KCQk8aGVhZGVyIG5hbWU9Im5hbWUiIHR5cGU9InN0cmluZyI+CgkJCTxkZXNjcmlwdGlvbiB0eXBlPSJtYXJrZG93biI+PCFbQ0RBVEFbZGVzY11dPjwvZGVzY3JpcHRpb24+CgkJPC9oZWFkZXI+CgkJPGhlYWRlciBuYW1lPSJuYW1lMSIgdHlwZT0ic3RyaW5nIiBzdW1tYXJ5PSJuYW1lMSBkZXNjIj48L2hlYWRlcj4KCQk8dGFnPnQxPC90YWc+CgkJPHRhZz50MjwvdGFnPgoJCTxzZXJ2ZXI+YWRtaW48L3NlcnZlcj4KCTwvYXBpPgoJPGFwaSBtZXRob2Q9IlBPU1QiIHN1bW1hcnk9Iua3u+WKoOeUqOaItyI+CgkJPHBhdGggcGF0aD0iL3VzZXJzIj48L3BhdGg+CgkJPGRlc2NyaXB0aW9uIHR5cGU9Im1hcmtkb3duIj48IVtDREFUQVsKICAg6L+Z5piv5YWz5LqO5o6l5Y+j55qE6K+m57uG6K+05piO5paH5qGjPGJyIC8+CiAgIOWPr+S7peaYr+S4gOS4qiBIVE1MIOWGheWuuQogICBdXT48L2Rlc2NyaXB0aW9uPgoJCTxyZXF1ZXN0IHR5cGU9Im9iamVjdCI+CgkJCTxwYXJhbSBuYW1lPSJjb3VudCIgdHlwZT0ibnVtYmVyIiBvcHRpb25hbD0iZmFsc2UiIHN1bW1hcnk9InN1bW1hcnkiPjwvcGFyYW0+CgkJCTxwYXJhbSBuYW1lPSJsaXN0IiB0eXBlPSJvYmplY3QiIGFycmF5PSJ0cnVlIiBzdW1tYXJ5PSJsaXN0Ij4KCQkJCTxwYXJhbSBuYW1lPSJpZCIgdHlwZT0ibnVtYmVyIiBzdW1tYXJ5PSLnlKjmiLcgSUQiPjwvcGFyYW0+CgkJCQk8cGFyYW0gbmFtZT0ibmFtZSIgdHlwZT0ic3RyaW5nIiBzdW1tYXJ5PSLnlKjmiLflkI0iPjwvcGFyYW0+CgkJCQk8cGFyYW0gbmFtZT0iZ3JvdXBzIiB0eXBlPSJvYmplY3QiIG9wdGlvbmFsPSJ0cnVlIiBhcnJheT0idHJ1ZSIgc3VtbWFyeT0i55So5oi35omA5Zyo55qE5p2D6ZmQ57uEIj4KCQkJCQk8cGFyYW0gbmFtZT0iaWQiIHR5cGU9InN0cmluZyIgc3VtbWFyeT0i5p2D6ZmQ57uEIElEIj48L3BhcmFtPgoJCQkJCTxwYXJhbSBuYW1lPSJuYW1lIiB0eXBlPSJzdHJpbmciIHN1bW1hcnk9Iuadg+mZkOe7hOWQjeensCI+PC9wYXJhbT4KCQkJCTwvcGFyYW0+CgkJCTwvcGFyYW0+CgkJCTxoZWFkZXIgbmFtZT0iY29udGVudC10eXBlIiB0eXBlPSJzdHJpbmciIHN1bW1hcnk9ImFwcGxpY2F0aW9uL2pzb24iPjwvaGVhZGVyPgoJCTwvcmVxdWVzdD4KCQk8cmVxdWVzdCBuYW1lPSJ1c2VycyIgdHlwZT0ib2JqZWN0IiBtaW1ldHlwZT0iYXBwbGljYXRpb24veG1sIj4KCQkJPHBhcmFtIG5hbWU9ImNvdW50IiB0eXBlPSJudW1iZXIiIG9wdGlvbmFsPSJmYWxzZSIgc3VtbWFyeT0ic3VtbWFyeSI+PC9wYXJhbT4KCQkJPHBhcmFtIG5hbWU9Imxpc3QiIHR5cGU9Im9iamVjdCIgYXJyYXk9InRydWUiIHN1bW1hcnk9Imxpc3QiPgoJCQkJPHBhcmFtIG5hbWU9ImlkIiB0eXBlPSJudW1iZXIiIHN1bW1hcnk9IueUqOaItyBJRCI+PC9wYXJhbT4KCQkJCTxwYXJhbSBuYW1lPSJuYW1lIiB0eXBlPSJzdHJpbmciIHN1bW1hcnk9IueUqOaIt+WQjSI+PC9wYXJhbT4KCQkJCTxwYXJhbSBuYW1lPSJncm91cHMiIHR5cGU9Im9iamVjdCIgb3B0aW9uYWw9InRydWUiIGFycmF5PSJ0cnVlIiBzdW1tYXJ5PSLnlKjmiLfmiYDlnKjnmoTmnYPpmZDnu4QiPgoJCQkJCTxwYXJhbSBuYW1lPSJpZCIgdHlwZT0ic3RyaW5nIiBzdW1tYXJ5PSLmnYPpmZDnu4QgSUQiPjwvcGFyYW0+CgkJCQkJPHBhcmFtIG5hbWU9Im5hbWUiIHR5cGU9InN0cmluZyIgc3VtbWFyeT0i5p2D6ZmQ57uE5ZCN56ewIj48L3BhcmFtPgoJCQkJPC9wYXJhbT4KCQkJPC9wYXJhbT4KCQkJPGV4YW1wbGUgbWltZXR5cGU9ImFwcGxpY2F0aW9uL3htbCI+PCFbQ0RBVEFbCjx1c2VycyBjb3VudD0iMjAiPgogICAgPHVzZXIgaWQ9IjIwIiBuYW1lPSJ4eCI+PC91c2VyPgogICAgPHVzZXIgaWQ9IjIxIiBuYW1lPSJ4eCI+PC91c2VyPgo8L3VzZXJzPgogICAgICAgICAgIF1dPjwvZXhhbXBsZT4KCQk8L3JlcXVlc3Q+CgkJPHJlc3BvbnNlIGFycmF5PSJ0cnVlIiBzdGF0dXM9IjIwMCIgbWltZXR5cGU9ImFwcGxpY2F0aW9uL2pzb24iPjwvcmVzcG9uc2U+CgkJPGhlYWRlciBuYW1lPSJuYW1lIiB0eXBlPSJzdHJpbmciPgoJCQk8ZGVzY3JpcHRpb24gdHlwZT0ibWFya2Rvd24iPjwhW0NEQVRBW2Rlc2NdXT48L2Rlc2NyaXB0aW9uPgoJCTwvaGVhZGVyPgoJCTxoZWFkZXIgbmFtZT0ibmFtZTEiIHR5cGU9InN0cmluZyIgc3VtbWFyeT0ibmFtZTEgZGVzYyI+PC9oZWFkZXI+CgkJPHRhZz50MjwvdGFnPgoJCTxzZXJ2ZXI+YWRtaW48L3NlcnZlcj4KCQk8c2VydmVyPm9sZC1jbGllbnQ8L3NlcnZlcj4KCTwvYXBpPgoJPGFwaSBtZXRob2Q9IkRFTEVURSIgc3VtbWFyeT0i5Yig6Zmk55So5oi3Ij4KCQk8cGF0aCBwYXRoPSIvdXNlcnMve2lkfSI+CgkJCTxwYXJhbSBuYW1lPSJpZCIgdHlwZT0ibnVtYmVyIiBzdW1tYXJ5PSLnlKjmiLcgSUQiPjwvcGFyYW0+CgkJPC9wYXRoPgoJCTxkZXNjcmlwdGlvbiB0eXBlPSJtYXJrZG93biI+PCFbQ0RBVEFbCiAgIOi/meaYr+WFs+S6juaOpeWPo+eahOivpue7huivtOaYjuaWh+ahozxiciAvPgogICDlj6/ku6XmmK/kuIDkuKogSFRNTCDlhoXlrrk8YnIgLz4KICAgXV0+PC9kZXNjcmlwdGlvbj4KCQk8c2VydmVyPmFkbWluPC9zZXJ2ZXI+Cgk8L2FwaT4KCTxhcGkgbWV0aG9kPSJHRVQiIHN1bW1hcnk9IuiOt+WPlueUqOaIt+ivpuaDhSI+CgkJPHBhdGggcGF0aD0iL3VzZXJzL3tpZH0iPgoJCQk8cGFyYW0gbmFtZT0iaWQiIHR5cGU9Im51bWJlciIgc3VtbWFyeT0i55So5oi3IElEIj48L3BhcmFtPgoJCQk8cXVlcnkgbmFtZT0ic3RhdGUiIHR5cGU9InN0cmluZyIgZGVmYXVsdD0iW25vcm1hbCxsb2NrXSIgYXJyYXk9InRydWUiIHN1bW1hcnk9InN0YXRlIj4KCQkJCTxlbnVtIHZhbHVlPSJub3JtYWwiIHN1bW1hcnk9Iuato+W4uCI+PC9lbnVtPgoJCQkJPGVudW0gdmFsdWU9ImxvY2siPgoJCQkJCTxkZXNjcmlwdGlvbiB0eXBlPSJodG1sIj48IVtDREFUQVs8c3BhbiBzdHlsZT0iY29sb3I6cmVkIj7plIHlrpo8L3NwYW4+XV0+PC9kZXNjcmlwdGlvbj4KCQkJCTwvZW51bT4KCQkJPC9xdWVyeT4KCQk8L3BhdGg+CgkJPGRlc2NyaXB0aW9uIHR5cGU9Im1hcmtkb3duIj48IVtDREFUQVsKICAg6L+Z5piv5YWz5LqO5o6l5Y+j55qE6K+m57uG6K+05piO5paH5qGjCiAgIOWPr+S7peaYr+S4gOS4qiBIVE1MIOWGheWuuQogICBdXT48L2Rlc2NyaXB0aW9uPgoJCTxyZXNwb25zZSB0eXBlPSJvYmplY3QiIGFycmF5PSJ0cnVlIiBzdGF0dXM9IjIwMCIgbWltZXR5cGU9ImFwcGxpY2F0aW9uL2pzb24iPgoJCQk8cGFyYW0gbmFtZT0iaWQiIHR5cGU9Im51bWJlciIgc3VtbWFyeT0i55So5oi3IElEIj48L3BhcmFtPgoJCQk8cGFyYW0gbmFtZT0ibmFtZSIgdHlwZT0ic3RyaW5nIiBzdW1tYXJ5PSLnlKjmiLflkI0iPjwvcGFyYW0+CgkJCTxwYXJhbSBuYW1lPSJncm91cHMiIHR5cGU9Im9iamVjdCIgb3B0aW9uYWw9InRydWUiIHN1bW1hcnk9IueUqOaIt+aJgOWcqOeahOadg+mZkOe7hCI+CgkJCQk8cGFyYW0gbmFtZT0iaWQiIHR5cGU9InN0cmluZyIgc3VtbWFyeT0i5p2D6ZmQ57uEIElEIj48L3BhcmFtPgoJCQkJPHBhcmFtIG5hbWU9Im5hbWUiIHR5cGU9InN0cmluZyIgc3VtbWFyeT0i5p2D6ZmQ57uE5ZCN56ewIj48L3BhcmFtPgoJCQk8L3BhcmFtPgoJCTwvcmVzcG9uc2U+CgkJPHNlcnZlcj5vbGQtY2xpZW50PC9zZXJ2ZXI+Cgk8L2FwaT4KCTxhcGkgbWV0aG9kPSJHRVQiIHN1bW1hcnk9IuiOt+WPlueUqOaIt+aXpeW/lyI+CgkJPHBhdGggcGF0aD0iL3VzZXJzL3tpZH0vbG9ncyI+CgkJCTxwYXJhbSBuYW1lPSJpZCIgdHlwZT0ibnVtYmVyIj4KCQkJCTxkZXNjcmlwdGlvbiB0eXBlPSJtYXJrZG93biI+PCFbQ0RBVEFb55So5oi3IElEXV0+PC9kZXNjcmlwdGlvbj4KCQkJPC9wYXJhbT4KCQkJPHF1ZXJ5IG5hbWU9InBhZ2UiIHR5cGU9Im51bWJlciIgZGVmYXVsdD0iMCIgc3VtbWFyeT0i6aG156CBIj48L3F1ZXJ5PgoJCQk8cXVlcnkgbmFtZT0ic2l6ZSIgdHlwZT0ibnVtYmVyIiBkZWZhdWx0PSIyMCI+CgkJCQk8ZGVzY3JpcHRpb24gdHlwZT0ibWFya2Rvd24iPjwhW0NEQVRBW+aVsOmHj11dPjwvZGVzY3JpcHRpb24+CgkJCTwvcXVlcnk+CgkJPC9wYXRoPgoJCTxkZXNjcmlwdGlvbiB0eXBlPSJodG1sIj48IVtDREFUQVsKICAgPHA+6L+Z5piv5YWz5LqO5o6l5Y+j55qE6K+m57uG6K+05piO5paH5qGjPC9wPgogICA8cCBzdHlsZT0iY29sb3I6cmVkIj7lj6/ku6XmmK/kuIDkuKogSFRNTCDlhoXlrrk8L3A+CiAgIF1dPjwvZGVzY3JpcHRpb24+CgkJPHJlc3BvbnNlIHR5cGU9Im9iamVjdCIgYXJyYXk9InRydWUiIHN0YXR1cz0iMjAwIiBtaW1ldHlwZT0iYXBwbGljYXRpb24vanNvbiI+CgkJCTxwYXJhbSBuYW1lPSJjb3VudCIgdHlwZT0ibnVtYmVyIiBvcHRpb25hbD0idHJ1ZSIgc3VtbWFyeT0ic3VtbWFyeSI+PC9wYXJhbT4KCQkJPHBhcmFtIG5hbWU9Imxpc3QiIHR5cGU9Im9iamVjdCIgYXJyYXk9InRydWUiIHN1bW1hcnk9Imxpc3QiPgoJCQkJPHBhcmFtIG5hbWU9ImlkIiB0eXBlPSJudW1iZXIiIG9wdGlvbmFsPSJ0cnVlIiBzdW1tYXJ5PSJkZXNjIj48L3BhcmFtPgoJCQkJPHBhcmFtIG5hbWU9Im5hbWUiIHR5cGU9InN0cmluZyIgb3B0aW9uYWw9InRydWUiIHN1bW1hcnk9ImRlc2MiPjwvcGFyYW0+CgkJCQk8cGFyYW0gbmFtZT0iZ3JvdXBzIiB0eXBlPSJzdHJpbmciIG9wdGlvbmFsPSJ0cnVlIiBhcnJheT0idHJ1ZSIgc3VtbWFyeT0iZGVzYyI+CgkJCQkJPGVudW0gdmFsdWU9Inh4MSI+CgkJCQkJCTxkZXNjcmlwdGlvbiB0eXBlPSJtYXJrZG93biI+PCFbQ0RBVEFbeHhdXT48L2Rlc2NyaXB0aW9uPgoJCQkJCTwvZW51bT4KCQkJCQk8ZW51bSB2YWx1ZT0ieHgyIiBzdW1tYXJ5PSJ4eCI+PC9lbnVtPgoJCQkJPC9wYXJhbT4KCQkJPC9wYXJhbT4KCQkJPGV4YW1wbGUgbWltZXR5cGU9ImFwcGxpY2F0aW9uL2pzb24iPjwhW0NEQVRBWwpbCiB7CiAgImNvdW50IjogNSwKICAibGlzdCI6IFsKICAgIHsiaWQiOiAxLCAibmFtZSI6ICJuYW1lMSIsICJncm91cHMiOiBbInh4MSIsICJ4eDIiXX0sCiAgICB7ImlkIjogMiwgIm5hbWUiOiAibmFtZTIiLCAiZ3JvdXBzIjogWyJ4eDEiXX0KICBdCiB9Cl0KICAgICAgICBdXT48L2V4YW1wbGU+CgkJCTxoZWFkZXIgbmFtZT0ibmFtZSIgdHlwZT0ic3RyaW5nIiBzdW1tYXJ5PSJkZXNjIj48L2hlYWRlcj4KCQkJPGhlYWRlciBuYW1lPSJuYW1lMSIgdHlwZT0ic3RyaW5nIiBzdW1tYXJ5PSJkZXNjMSI+PC9oZWFkZXI+CgkJPC9yZXNwb25zZT4KCQk8Y2FsbGJhY2sgbWV0aG9kPSJQT1NUIiBzdW1tYXJ5PSLlm57osIPlh73mlbAiPgoJCQk8ZGVzY3JpcHRpb24gdHlwZT0iaHRtbCI+PCFbQ0RBVEFbCiAgICAgICAgICAgPHAgc3R5bGU9ImNvbG9yOnJlZCI+6L+Z5piv5LiA5Liq5Zue6LCD5Ye95pWw55qE6K+m57uG6K+05piOPC9wPgogICAgICAgICAgIDxwPuS4uuS4gOS4qiBodG1sIOaWh+ahozwvcD4KICAgXV0+PC9kZXNjcmlwdGlvbj4KCQkJPHJlc3BvbnNlIHR5cGU9InN0cmluZyIgc3RhdHVzPSIyMDAiIG1pbWV0eXBlPSJ0ZXh0L3BsYWluIj48L3Jlc3BvbnNlPgoJCQk8cmVxdWVzdCB0eXBlPSJvYmplY3QiIG1pbWV0eXBlPSJhcHBsaWNhdGlvbi9qc29uIj4KCQkJCTxwYXJhbSBuYW1lPSJpZCIgdHlwZT0ibnVtYmVyIiBkZWZhdWx0PSIxIiBzdW1tYXJ5PSJpZCI+PC9wYXJhbT4KCQkJCTxwYXJhbSBuYW1lPSJhZ2UiIHR5cGU9Im51bWJlciIgc3VtbWFyeT0iYWdlIj48L3BhcmFtPgoJCQkJPGV4YW1wbGUgbWltZXR5cGU9ImFwcGxpY2F0aW9uL2pzb24iPjwhW0NEQVRBWwp7CiAgICAiaWQiOiAxLAogICAgImFnZSI6IDE4Cn0KXV0+PC9leGFtcGxlPgoJCQk8L3JlcXVlc3Q+CgkJPC9jYWxsYmFjaz4KCQk8c2VydmVyPmNsaWVudDwvc2VydmVyPgoJCTxzZXJ2ZXI+YWRtaW48L3NlcnZlcj4KCTwvYXBpPgoJPGhlYWRlciBuYW1lPSJBdXRob3JpemF0aW9uIiB0eXBlPSJzdHJpbmciIHN1bW1hcnk9InRva2VuIOWAvCI+PC9oZWFkZXI+Cgk8aGVhZGVyIG5hbWU9IkFjY2VwdCIgdHlwZT0ic3RyaW5nIiBzdW1tYXJ5PSLog73mjqXlj5fnmoTlrZfnrKbpm4YiPjwvaGVhZGVyPgoJPHJlc3BvbnNlIG5hbWU9InJlc3VsdCIgdHlwZT0ib2JqZWN0IiBzdGF0dXM9IjQwMCI+CgkJPHBhcmFtIHhtbC1hdHRyPSJ0cnVlIiBuYW1lPSJjb2RlIiB0eXBlPSJudW1iZXIiIG9wdGlvbmFsPSJmYWxzZSIgc3VtbWFyeT0i54q25oCB56CBIj48L3BhcmFtPgoJCTxwYXJhbSBuYW1lPSJtZXNzYWdlIiB0eXBlPSJzdHJpbmciIG9wdGlvbmFsPSJmYWxzZSIgc3VtbWFyeT0i6ZSZ6K+v5L+h5oGvIj48L3BhcmFtPgoJCTxwYXJhbSBuYW1lPSJkZXRhaWwiIHR5cGU9Im9iamVjdCIgYXJyYXk9InRydWUiIHN1bW1hcnk9IumUmeivr+aYjue7hiI+CgkJCTxwYXJhbSBuYW1lPSJpZCIgdHlwZT0ic3RyaW5nIiBzdW1tYXJ5PSJpZCI+PC9wYXJhbT4KCQkJPHBhcmFtIG5hbWU9Im1lc3NhZ2UiIHR5cGU9InN0cmluZyIgc3VtbWFyeT0ibWVzc2FnZSI+PC9wYXJhbT4KCQk8L3BhcmFtPgoJPC9yZXNwb25zZT4KCTxyZXNwb25zZSBzdW1tYXJ5PSJub3QgZm91bmQiIHN0YXR1cz0iNDA0Ij48L3Jlc3BvbnNlPgoJPG1pbWV0eXBlPmFwcGxpY2F0aW9uL3htbDwvbWltZXR5cGU+Cgk8bWltZXR5cGU+YXBwbGljYXRpb24vanNvbjwvbWltZXR5cGU+CjwvYXBpZG9jPgABCGljb24uc3ZnARxpbWFnZS9zdmcreG1sOyBjaGFyc2V0PXV0Zi04Af4B4Dw/eG1sIHZlcnNpb249IjEuMCIgZW5jb2Rpbmc9InV0Zi04IiA/PgoKPHN2ZyB2aWV3Qm94PSIwIDAgNTEyIDUxMiIgeG1sbnM9Imh0dHA6Ly93d3cudzMub3JnLzIwMDAvc3ZnIiBzdHJva2U9IiNmNjAiPgogICAgPHRpdGxlPmFwaWRvYzwvdGl0bGU+CiAgICA8ZGVzYz5odHRwczovL2FwaWRvYy50b29scyBMT0dPPC9kZXNjPgogICAgPGRlc2M+6L+Z5piv5LiA5Y+q6K+e55Sf5LqO576K5bm055qE5bCP5bel5YW3PC9kZXNjPgoKICAgIDxjaXJjbGUgY3g9IjI1NiIgY3k9IjI1NiIgcj0iMjQwIiBmaWxsLW9wYWNpdHk9IjAiIHN0cm9rZS13aWR0aD0iMzIiIC8+CiAgICA8Y2lyY2xlIGN4PSI1MzAiIGN5PSIzODUiIHI9IjMwMCIgZmlsbC1vcGFjaXR5PSIwIiBzdHJva2Utd2lkdGg9IjMyIiAvPgogICAgPGNpcmNsZSBjeD0iLTE1IiBjeT0iMzg1IiByPSIzMDAiIGZpbGwtb3BhY2l0eT0iMCIgc3Ryb2tlLXdpZHRoPSIzMiIgLz4KPC9zdmc+CgABEmluZGV4LmNtbi1IYW50LnhtbAEeYXBwbGljYXRpb24veG1sOyBjaGFyc2V0PXV0Zi04Af4dJDw/eG1sIHZlcnNpb249IjEuMCIgZW5jb2Rpbmc9InV0Zi04Ij8+Cjw/eG1sLXN0eWxlc2hlZXQgdHlwZT0idGV4dC94c2wiIGhyZWY9Ii4vaW5kZXgueHNsIj8+Cgo8IS0tCumAmeaYr+WumOe2summlumggeWFp+Wuue+8jOWQjOaZguS5n+aYr+ewoemrlOS4reaWh+eahOacrOWcsOWMluWFp+WuueOAggoK5YW25a6D6Kqe6KiA55qE5pys5YyW5Zyw5YWn5a6577yM6ZyA6KaB6YeN5paw5pS55a+r5pys5paH5Lu25Lit6Zmk6Ki76YeL5aSW55qE5omA5pyJ5YWn5a6544CCCi0tPgoKPGRvY3MgbGFuZz0iY21uLUhhbnQiPgogICAgPHRpdGxlPmFwaWRvYyB8IFJFU1RmdWwgQVBJIOaWh+aqlOiZleeQhuW3peWFtzwvdGl0bGU+CiAgICA8bGljZW5zZSB1cmw9Imh0dHBzOi8vY3JlYXRpdmVjb21tb25zLm9yZy9saWNlbnNlcy9ieS80LjAvZGVlZC56aCI+572y5ZCNIDQuMCDlnIvpmpsgKENDIEJZIDQuMCk8L2xpY2Vuc2U+CgogICAgPCEtLSDlo7nkupvnv7vora/mr5TovIPpm7bmlaPnmoTnv7vora/lhaflrrkgLS0+CiAgICA8bG9jYWxlcz4KICAgICAgICA8aGVhZGVyPiA8IS0tIOihqOagvOWgsemgrSAtLT4KICAgICAgICAgICAgPG5hbWU+5ZCN56ixPC9uYW1lPgogICAgICAgICAgICA8dHlwZT7poZ7lnos8L3R5cGU+CiAgICAgICAgICAgIDxyZXF1aXJlZD7lv4Xloas8L3JlcXVpcmVkPgogICAgICAgICAgICA8ZGVzY3JpcHRpb24+5o+P6L+wPC9kZXNjcmlwdGlvbj4KICAgICAgICA8L2hlYWRlcj4KICAgICAgICA8Z290by10b3A+6L+U5Zue6aCC6YOoPC9nb3RvLXRvcD4KICAgIDwvbG9jYWxlcz4KCiAgICA8ZG9jIGlkPSJhYm91dCIgdGl0bGU9IumXnOaWvCI+CiAgICAgICAgPHA+YXBpZG9jIOaYr+WjueWAi+ewoeWWrueahCA8YWJiciB0aXRsZT0iUmVwcmVzZW50YXRpb25hbCBTdGF0ZSBUcmFuc2ZlciI+UkVTVGZ1bDwvYWJicj4gPGFiYnIgdGl0bGU9IkFwcGxpY2F0aW9uIFByb2dyYW1taW5nIEludGVyZmFjZSI+QVBJPC9hYmJyPiDmlofmqpTnlJ/miJDlt6XlhbfvvIzlroPlvp7ku6PnorzoqLvph4vkuK3mj5Dlj5bnibnlrprmoLzlvI/nmoTlhaflrrnnlJ/miJDmlofmqpTjgILmlK/mjIHoq7jlpoIgR2/jgIFKYXZh44CBQysr44CBUnVzdCDnrYnlpKfpg6jliIbplovnmbzoqp7oqIDvvIzlhbfpq5Tlj6/kvb/nlKggPGNvZGU+YXBpZG9jIGxhbmc8L2NvZGU+IOWRveS7pOihjOafpeeci+aJgOacieeahOaUr+aMgeWIl+ihqOOAgjwvcD4KCiAgICAgICAgPHA+YXBpZG9jIOaTgeacieS7peS4i+eJuem7nu+8mjwvcD4KICAgICAgICA8b2w+CiAgICAgICAgICAgIDxsaT7ot6jlubPoh7rvvIxsaW51eOOAgXdpbmRvd3PjgIFtYWNPUyDnrYnpg73mlK/mjIHvvJs8L2xpPgogICAgICAgICAgICA8bGk+5pSv5oyB6Kqe6KiA5buj5rOb77yM5Y2z5L2/5piv5LiN5pSv5oyB77yM5Lmf5b6I5pa55L6/5pO05bGV77ybPC9saT4KICAgICAgICAgICAgPGxpPuaUr+aMgeWkmuWAi+S4jeWQjOiqnuiogOeahOWkmuWAi+mgheebrueUn+aIkOWjueS7veaWh+aqlO+8mzwvbGk+CiAgICAgICAgICAgIDxsaT7ovLjlh7rmqKHmnb/lj6/oh6rlrprnvqnvvJs8L2xpPgogICAgICAgICAgICA8bGk+5qC55pOa5paH5qqU55Sf5oiQIG1vY2sg5pW45pOa77ybPC9saT4KICAgICAgICA8L29sPgoKICAgICAgICA8cD7ku6XkuIvmmK/lo7nmrrXnsKHnn63nmoQgQyDoqp7oqIDpoqjmoLzku6PnorzkuIvnmoTnpLrkvovvvJo8L3A+CiAgICAgICAgPHByZT48Y29kZSBjbGFzcz0ibGFuZ3VhZ2UtbWFya3VwIj48IVtDREFUQVsvKioKICogPGFwaSBtZXRob2Q9IkdFVCIgc3VtbWFyeT0i542y5Y+W5omA5pyJ55qE55So5oi25L+h5oGvIj4KICogICAgIDxwYXRoIHBhdGg9Ii91c2VycyI+CiAqICAgICAgICAgPHF1ZXJ5IG5hbWU9InBhZ2UiIHR5cGU9Im51bWJlciIgZGVmYXVsdD0iMCI+6aGv56S656ys5bm+6aCB55qE5YWn5a65PC9xdWVyeT4KICogICAgICAgICA8cXVlcnkgbmFtZT0ic2l6ZSIgdHlwZT0ibnVtYmVyIiBkZWZhdWx0PSIyMCI+5q+P6aCB6aGv56S655qE5pW46YePPC9xdWVyeT4KICogICAgIDwvcGF0aD4KICogICAgIDx0YWc+dXNlcjwvdGFnPgogKiAgICAgPHNlcnZlcj51c2Vyczwvc2VydmVyPgogKiAgICAgPHJlc3BvbnNlIHN0YXR1cz0iMjAwIiB0eXBlPSJvYmplY3QiIG1pbWV0eXBlPSJhcHBsaWNhdGlvbi9qc29uIj4KICogICAgICAgICA8cGFyYW0gbmFtZT0iY291bnQiIHR5cGU9ImludCIgb3B0aW9uYWw9ImZhbHNlIiBzdW1tYXJ5PSLnrKblkIjmop3ku7bnmoTmiYDmnInnlKjmiLbmlbjph48iIC8+CiAqICAgICAgICAgPHBhcmFtIG5hbWU9InVzZXJzIiB0eXBlPSJvYmplY3QiIGFycmF5PSJ0cnVlIiBzdW1tYXJ5PSLnlKjmiLbliJfooagiPgogKiAgICAgICAgICAgICA8cGFyYW0gbmFtZT0iaWQiIHR5cGU9ImludCIgc3VtbWFyeT0i5ZSv5aO5IElEIiAvPgogKiAgICAgICAgICAgICA8cGFyYW0gbmFtZT0ibmFtZSIgdHlwZT0ic3RyaW5nIiBzdW1tYXJ5PSLlp5PlkI0iIC8+CiAqICAgICAgICAgPC9wYXJhbT4KICogICAgIDwvcmVzcG9uc2U+CiAqICAgICA8cmVzcG9uc2Ugc3RhdHVzPSI1MDAiIG1pbWV0eXBlPSJhcHBsaWNhdGlvbi9qc29uIiB0eXBlPSJvYmplY3QiPgogKiAgICAgICAgIDxwYXJhbSBuYW1lPSJjb2RlIiB0eXBlPSJpbnQiIHN1bW1hcnk9IumMr+iqpOS7o+eivCIgLz4KICogICAgICAgICA8cGFyYW0gbmFtZT0ibXNnIiB0eXBlPSJzdHJpbmciIHN1bW1hcnk9IumMr+iqpOWFp+WuuSIgLz4KICogICAgIDwvcmVzcG9uc2U+CiAqIDwvYXBpPgogKi9dXT48L2NvZGU+PC9wcmU+CiAgICA8L2RvYz4KCiAgICA8ZG9jIGlkPSJ1c2FnZSIgdGl0bGU9IuS9v+eUqCIgLz4KCiAgICA8ZG9jIGlkPSJzcGVjIiB0aXRsZT0i5paH5qqU5qC85byPIj4KICAgICAgICA8cD7mlofmqpTph4fnlKggWE1MIOagvOW8j+OAguWtmOWcqOWFqeWAi+mggue0muaomeewve+8mjxjb2RlPmFwaWRvYzwvY29kZT4g5ZKMIDxjb2RlPmFwaTwvY29kZT7vvIznlKjmlrzmj4/ov7DmlbTpq5Tlhaflrrnlkozlhbfpq5TmjqXlj6Pkv6Hmga/jgII8L3A+CgogICAgICAgIDxwPuaWh+aqlOiiq+W+nuiou+mHi+S4reaPkOWPluS5i+W+jO+8jOacgOe1guacg+iiq+WQiOS4puaIkOWjueWAiyBYTUwg5paH5Lu277yM5Zyo6Kmy5paH5Lu25LitIDxjb2RlPmFwaTwvY29kZT4g5L2c54K6IDxjb2RlPmFwaWRvYzwvY29kZT4g55qE5aO55YCL5a2Q5YWD57Sg5a2Y5Zyo77yM5aaC5p6c5aaz55qE6aCF55uu5LiN5oOz5oqK5paH5qqU5a+r5Zyo6Ki76YeL5Lit77yM5Lmf5Y+v5Lul55u05o6l57eo5a+r5aO55YCL5a6M5pW055qEIFhNTCDmlofku7bvvIzlsIcgPGNvZGU+YXBpPC9jb2RlPiDkvZzngrogPGNvZGU+YXBpZG9jPC9jb2RlPiDnmoTlo7nlgIvlrZDlhYPntKDjgII8L3A+CgogICAgICAgIDxwPuWFt+mrlOWPr+WPg+iAgzxhIGhyZWY9Ii4vZXhhbXBsZS9pbmRleC54bWwiPuekuuS+i+S7o+eivOOAgjwvYT48L3A+CgogICAgICAgIDxwPuS7peS4i+aYr+WwjeWQhOWAiyBYTUwg5YWD57Sg5Lul5Y+K5Y+D5pW45LuL57S577yM5YW25Lit5LulIDxjb2RlPkA8L2NvZGU+IOmWi+mgreeahOihqOekuiBYTUwg5bGs5oCn77ybPGNvZGU+LjwvY29kZT4g6KGo56S654K655W25YmN5YWD57Sg55qE5YWn5a6577yb5YW25a6D6KGo56S65a2Q5YWD57Sg44CCPC9wPgogICAgPC9kb2M+CgogICAgPCEtLSMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMg5Lul5LiL5Li65paH5qGj5YaF5a6555qE5a2Q6aG5ICMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIy0tPgoKICAgIDxkb2MgaWQ9Imluc3RhbGwiIHRpdGxlPSLlronoo50iIHBhcmVudD0idXNhZ2UiPgogICAgICAgICAgPHA+5Y+v5Lul55u05o6l5b6eIDxhIGhyZWY9Imh0dHBzOi8vZ2l0aHViLmNvbS9jYWl4dy9hcGlkb2MvcmVsZWFzZXMiPmh0dHBzOi8vZ2l0aHViLmNvbS9jYWl4dy9hcGlkb2MvcmVsZWFzZXM8L2E+IOafpeaJvuWms+mcgOimgeeahOeJiOacrOS4i+i8ie+8jOaUvuWFpSA8dmFyPlBBVEg8L3Zhcj4g5Lit5Y2z5Y+v5L2/55So44CC5aaC5p6c5rKS5pyJ5aaz6ZyA6KaB55qE5bmz6Ie65paH5Lu277yM5YmH6ZyA6KaB5b6e5rqQ5Luj56K857eo6K2v77yaPC9wPgogICAgICAgIDx1bD4KICAgICAgICAgICAgPGxpPuS4i+i8iSA8YSBocmVmPSJodHRwczovL2dvbGFuZy5vcmcvZGwvIj5HbzwvYT7vvJs8L2xpPgogICAgICAgICAgICA8bGk+5LiL6LyJ5rqQ5Luj56K877yMPHNhbXA+Z2l0IGNsb25lIGdpdGh1Yi5jb20vY2FpeHcvYXBpZG9jPC9zYW1wPu+8mzwvbGk+CiAgICAgICAgICAgIDxsaT7ln7fooYzku6PnorzkuK0gPGNvZGU+YnVpbGQvYnVpbGQuc2g8L2NvZGU+IOaIluaYryA8Y29kZT5idWlsZC9idWlsZC5jbWQ8L2NvZGU+IOmAsuihjOe3qOitr++8mzwvbGk+CiAgICAgICAgICAgIDxsaT7nt6jora/lpb3nmoTmlofku7blrZjmlL7lnKggPGNvZGU+Y21kL2FwaWRvYzwvY29kZT4g5LiL77yM5Y+v5Lul5bCH6Kmy5paH5Lu25pS+572u5ZyoIDx2YXI+UEFUSDwvdmFyPiDnm67pjITvvJs8L2xpPgogICAgICAgIDwvdWw+CiAgICA8L2RvYz4KCiAgICA8ZG9jIGlkPSJlbnYiIHRpdGxlPSLnkrDlooPororph48iIHBhcmVudD0idXNhZ2UiPgogICAgICAgIDxwPmFwaWRvYyDmnIPoroDlj5YgPHZhcj5MQU5HPC92YXI+IOeahOeSsOWig+iuiumHj+S9nOeCuuWFtuacrOWcsOWMlueahOS+neaTmu+8jOiLpeaDs+aMh+WumuWFtuWug+iqnueoru+8jOWPr+S7peaJi+WLleaMh+WumiA8dmFyPkxBTkc8L3Zhcj4g55Kw5aKD6K6K6YeP77yaPHNhbXA+TEFORz16aC1IYW50IGFwaWRvYzwvc2FtcD7jgILlnKggd2luZG93cyDns7vntbHkuK3vvIzoi6XkuI3lrZjlnKggPHZhcj5MQU5HPC92YXI+IOeSsOWig+iuiumHj++8jOWJh+acg+iqv+eUqCA8Y29kZT5HZXRVc2VyRGVmYXVsdExvY2FsZU5hbWU8L2NvZGU+IOWHveaVuOS+hueNsuWPluebuOaHieeahOiqnuiogOS/oeaBr+OAgjwvcD4KICAgICAgICA8cD7mnKzlnLDljJbnmoQgSUQg5YC877yM55CG6K665LiK5Y+q6KaBIDxhIGhyZWY9Imh0dHBzOi8vdG9vbHMuaWV0Zi5vcmcvaHRtbC9iY3A0NyI+QkNQNDc8L2E+IOaUr+aMgeeahOmDveiDveato+ehruivhuWIq+OAguS5n+aUr+aMgSBMaW51eCDkuIvluKbnvJbnoIHnmoTmoLzlvI/vvIzmr5TlpoLvvJo8c2FtcD56aF9DTi5VVEYtODwvc2FtcD7jgII8L3A+CiAgICA8L2RvYz4KCiAgICA8ZG9jIGlkPSJpZGUiIHRpdGxlPSJJREUg5o+S5Lu2IiBwYXJlbnQ9InVzYWdlIj4KICAgICAgICA8cD5hcGlkb2Mg5pSv5oyB5LulIDxhIGhyZWY9Imh0dHBzOi8vbWljcm9zb2Z0LmdpdGh1Yi5pby9sYW5ndWFnZS1zZXJ2ZXItcHJvdG9jb2wvc3BlY2lmaWNhdGlvbnMvc3BlY2lmaWNhdGlvbi1jdXJyZW50LyI+PGFiYnIgdGl0bGU9Imxhbmd1YWdlIHNlcnZlciBwcm90b2NvbCI+TFNQPC9hYmJyPjwvYT4g5qih5byP6YGL6KGM77yaPHNhbXA+YXBpZG9jIGxzcCAtcD0iOjgwODAiIC1tPXN0ZGlvIC1oPC9zYW1wPuOAguaJgOacieaUr+aMgSBMU1Ag55qEIElERSDlkoznt6jovK/lmajlvojlrrnmmJPlr6bnj77lsI3mh4nnmoTmj5Lku7bjgILnm67liY3lrpjmlrnlt7LntpPmj5Dkvpvkuobph53lsI0gdnNjb2RlIOeahOaPkuS7tu+8mjxhIGhyZWY9Imh0dHBzOi8vbWFya2V0cGxhY2UudmlzdWFsc3R1ZGlvLmNvbS9pdGVtcz9pdGVtTmFtZT1jYWl4dy5hcGlkb2MiPmFwaWRvYy52c2NvZGU8L2E+44CCPC9wPgogICAgPC9kb2M+CgogICAgPGRvYyBpZD0ibW9jayIgdGl0bGU9Ik1vY2siIHBhcmVudD0idXNhZ2UiPgogICAgICAgIDxwPm1vY2sg5a2Q5ZG95Luk5Y+v5Lul6K6TIGFwaWRvYyDmoLnmk5rmlofmqpTli5XmhYvnlJ/miJAgbW9jayDmlbjmk5rvvIxtb2NrIOWtkOWRveS7pOaPkOS+m+S6huWkp+mHj+eahOmFjee9rumghe+8jOS7peS9v+eUn+aIkOeahOaVuOaTmuabtOaciemaqOapn+aAp++8jOWFt+mrlOeahOWRveS7pOmgheWPr+S7pemAmumBjiA8c2FtcD5hcGlkb2MgaGVscCBtb2NrPC9zYW1wPiDmn6XnnIvjgII8L3A+CgogICAgICAgIDxwPuWmguaenOaVuOaTmumhnuWei+eCuiA8dmFyPnN0cmluZy5pbWFnZTwvdmFyPu+8jOacg+iHquWLleeUn+aIkOWjueW8teaMh+WumuWkp+Wwj+eahOWclueJh+S4puaKiuWcsOWdgOi/lOWbnue1pueUqOaItu+8jOWFtuWkp+Wwj+eUseafpeipouWPg+aVuCA8Y29kZT53aWR0aDwvY29kZT4g5ZKMIDxjb2RlPmhlaWdodDwvY29kZT4g5oyH5a6a77yM5pyq5oyH5a6a5YmH5pivIDx2YXI+NTAweDUwMDwvdmFyPuOAguWclueJh+mhnuWei+WJh+mAmumBjueUqOaItuaPkOS6pOeahCA8Y29kZT5BY2NlcHQ8L2NvZGU+IOWgsemgreeNsuWPlu+8jOebruWJjeaUr+aMgSA8dmFyPmltYWdlL2dpZjwvdmFyPuOAgTx2YXI+aW1hZ2UvcG5nPC92YXI+IOWSjCA8dmFyPmltYWdlL2pwZWc8L3Zhcj4g5LiJ56iu6aGe5Z6L44CCPC9wPgoKICAgICAgICA8cD7kvb/nlKggbW9jayDkuZ/mnInlo7nlrprnmoTlsYDpmZDmgKfvvJrlj6rog73liKTmlrfmj5DkuqTnmoTmlbjmk5rmmK/lkKbnrKblkIjopoHmsYLvvIzkvYbmmK/nhKHms5XnkIbop6Pmlbjmk5rku6PnorznmoTlkKvnvqnvvIzmr5TlpoIgVVJMIOS4reWMheWQqyBwYWdlPTLvvIzog73liKTmlrcgcGFnZSDnmoTlgLzmoLzlvI/mmK/lkKbmraPnorrvvIzkvYbnhKHms5XntabnlKjmiLbov5Tlm57nrKzkuozpoIHnmoTmlbjmk5rjgIJNb2NrIOWcqOmpl+itieaVuOaTmuato+eiuuaAp+WSjOWIneacn+eUqOaWvOWxleekuuaVuOaTmuWFp+WuuemChOaYr+W+iOacieeUqOeahOOAgjwvcD4KICAgIDwvZG9jPgoKICAgIDxkb2MgaWQ9ImNsaSIgdGl0bGU9IuWRveS7pOihjCIgcGFyZW50PSJ1c2FnZSI+CiAgICAgICAgPHA+5Y+v5Lul6YCa6YGOIDxzYW1wPmFwaWRvYyBoZWxwPC9zYW1wPiDmn6XnnIvlkb3ku6TooYzmlK/mjIHnmoTlrZDlkb3ku6TjgILljIXlkKvkuobku6XkuIvlub7lgIvvvJo8L3A+CiAgICA8L2RvYz4KCiAgICA8ZG9jIGlkPSJjb25maWctZmlsZSIgdGl0bGU9IumFjee9ruaWh+S7tiIgcGFyZW50PSJ1c2FnZSI+CiAgICAgICAgPHA+6YWN572u5paH5Lu25ZCN5Zu65a6a54K6IDxjb2RlPi5hcGlkb2MueWFtbDwvY29kZT7vvIzmoLzlvI/ngrogWUFNTO+8jOWPr+WPg+iAgyA8YSBocmVmPSJleGFtcGxlLy5hcGlkb2MueWFtbCI+LmFwaWRvYy55YW1sPC9hPuOAguaWh+S7tuWPr+S7pemAmumBjuWRveS7pCA8c2FtcD5hcGlkb2MgZGV0ZWN0PC9zYW1wPiDnlJ/miJDjgILkuLvopoHljIXlkKvkuobku6Xlub7lgIvphY3nva7poIXvvJo8L3A+CiAgICA8L2RvYz4KCiAgICA8Zm9vdGVyPgogICAgICAgIDxsaWNlbnNlPgogICAgICAgICAgICA8cD7nlbbliY3poIHpnaLlhaflrrnmiZjnrqHmlrwgPC9wPjxwPu+8jOS4pumHh+eUqDwvcD48cD7pgLLooYzoqLHlj6/jgII8L3A+CiAgICAgICAgPC9saWNlbnNlPgogICAgPC9mb290ZXI+CjwvZG9jcz4KAAEJaW5kZXguY3NzARd0ZXh0L2NzczsgY2hhcnNldD11dGYtOAH+Dk1AY2hhcnNldCAidXRmLTgiOwoKOnJvb3QgewogICAgLS1tYXgtd2lkdGg6IDEwMjRweDsKICAgIC0tcGFkZGluZzogMXJlbTsKICAgIC0tYXJ0aWNsZS1wYWRkaW5nOiBjYWxjKHZhcigtLXBhZGRpbmcpIC8gMik7CgogICAgLS1jb2xvcjogYmxhY2s7CiAgICAtLWFjY2VudC1jb2xvcjogIzAwNzRkOTsKICAgIC0tYmFja2dyb3VuZDogd2hpdGU7CiAgICAtLWJvcmRlci1jb2xvcjogI2UwZTBlMDsKfQoKQG1lZGlhIChwcmVmZXJzLWNvbG9yLXNjaGVtZTogZGFyaykgewogICAgOnJvb3QgewogICAgICAgIC0tY29sb3I6ICNiMGIwYjA7CiAgICAgICAgLS1hY2NlbnQtY29sb3I6ICMwMDc0ZDk7CiAgICAgICAgLS1iYWNrZ3JvdW5kOiBibGFjazsKICAgICAgICAtLWJvcmRlci1jb2xvcjogIzMwMzAzMDsKICAgIH0KfQoKYm9keSB7CiAgICBwYWRkaW5nOiAwOwogICAgbWFyZ2luOiAwIGF1dG87CiAgICBjb2xvcjogdmFyKC0tY29sb3IpOwogICAgYmFja2dyb3VuZDogdmFyKC0tYmFja2dyb3VuZCk7Cn0KCnRhYmxlIHsKICAgIHdpZHRoOiAxMDAlOwogICAgYm9yZGVyOiAxcHggc29saWQgdmFyKC0tYm9yZGVyLWNvbG9yKTsKICAgIGJvcmRlci1yYWRpdXM6IDVweDsKICAgIHBhZGRpbmc6IDAgdmFyKC0tYXJ0aWNsZS1wYWRkaW5nKTsKfQoKdGFibGUgdGhlYWQgdHIgewogICAgaGVpZ2h0OiAyLjVyZW07Cn0KCnRhYmxlIHRoIHsKICAgIHdoaXRlLXNwYWNlOiBub3dyYXA7CiAgICBwYWRkaW5nLXJpZ2h0OiAuNXJlbTsKfQoKdGFibGUgdGgsIHRhYmxlIHRkIHsKICAgIHRleHQtYWxpZ246IGxlZnQ7CiAgICBib3JkZXItYm90dG9tOiAxcHggc29saWQgdmFyKC0tYm9yZGVyLWNvbG9yKTsKfQoKdGFibGUgdGJvZHkgdHI6bGFzdC1vZi10eXBlIHRkLAp0YWJsZSB0Ym9keSB0cjpsYXN0LW9mLXR5cGUgdGggewogICAgYm9yZGVyLWJvdHRvbTogbm9uZTsKfQoKdWwsIG9sIHsKICAgIHBhZGRpbmc6IDA7CiAgICBtYXJnaW46IDA7CiAgICBsaXN0LXN0eWxlLXBvc2l0aW9uOiBpbnNpZGU7Cn0KCmEgewogICAgdGV4dC1kZWNvcmF0aW9uOiBub25lOwogICAgY29sb3I6IHZhcigtLWFjY2VudC1jb2xvcik7Cn0KCmE6aG92ZXIgewogICAgb3BhY2l0eTogLjcKfQoKcCB7CiAgICBtYXJnaW46IDE1cHggMDsKfQoKcHJlIHsKICAgIGJvcmRlcjogMXB4IHNvbGlkIHZhcigtLWJvcmRlci1jb2xvcik7CiAgICBwYWRkaW5nOiB2YXIoLS1hcnRpY2xlLXBhZGRpbmcpOwogICAgYm9yZGVyLXJhZGl1czogNXB4OwogICAgd2hpdGUtc3BhY2U6IHByZS13cmFwOwp9CgoudHlwZSB7CiAgICBjb2xvcjogdmFyKC0tY29sb3IpCn0KCi8qKioqKioqKioqKioqKioqKioqKioqKioqKiogaGVhZGVyICoqKioqKioqKioqKioqKioqKioqKioqLwoKaGVhZGVyIHsKICAgIHRvcDogMDsKICAgIGxlZnQ6IDA7CiAgICBwb3NpdGlvbjogc3RpY2t5OwogICAgei1pbmRleDogMTAwMDsKICAgIGJveC1zaXppbmc6IGJvcmRlci1ib3g7CiAgICBiYWNrZ3JvdW5kOiB2YXIoLS1iYWNrZ3JvdW5kKTsKICAgIGJveC1zaGFkb3c6IDJweCAycHggMnB4IHZhcigtLWJvcmRlci1jb2xvcik7Cn0KCmhlYWRlciAud3JhcCB7CiAgICBtYXJnaW46IDAgYXV0bzsKICAgIHRleHQtYWxpZ246IGxlZnQ7CiAgICBtYXgtd2lkdGg6IHZhcigtLW1heC13aWR0aCk7CiAgICBwYWRkaW5nOiAwIHZhcigtLXBhZGRpbmcpOwogICAgZGlzcGxheTogZmxleDsKICAgIGZsZXgtd3JhcDogd3JhcDsKICAgIGp1c3RpZnktY29udGVudDogc3BhY2UtYmV0d2VlbjsKICAgIGFsaWduLWl0ZW1zOiBjZW50ZXI7Cn0KCmhlYWRlciBoMSB7CiAgICBkaXNwbGF5OiBpbmxpbmUtYmxvY2s7CiAgICBtYXJnaW46IHZhcigtLWFydGljbGUtcGFkZGluZykgMDsKICAgIHRleHQtdHJhbnNmb3JtOiB1cHBlcmNhc2U7Cn0KCmhlYWRlciBoMSAudmVyc2lvbiB7CiAgICBmb250LXNpemU6IDFyZW07Cn0KCmhlYWRlciBoMSBpbWcgewogICAgaGVpZ2h0OiAxLjVyZW07CiAgICBtYXJnaW4tcmlnaHQ6IC41cmVtOwp9CgpoZWFkZXIgLm1lbnVzIHsKICAgIGRpc3BsYXk6IGZsZXg7CiAgICBhbGlnbi1pdGVtczogYmFzZWxpbmU7Cn0KCmhlYWRlciAubWVudSB7CiAgICBtYXJnaW4tbGVmdDogdmFyKC0tcGFkZGluZyk7CiAgICBjb2xvcjogdmFyKC0tY29sb3IpOwp9CgpoZWFkZXIgLm1lbnU6aG92ZXIgewogICAgb3BhY2l0eTogLjc7Cn0KCmhlYWRlciAuZHJvcC1tZW51cyB7CiAgICBwb3NpdGlvbjogcmVsYXRpdmU7CiAgICBjdXJzb3I6IHBvaW50ZXI7Cn0KCmhlYWRlciAuZHJvcC1tZW51cyB1bCB7CiAgICBwb3NpdGlvbjogYWJzb2x1dGU7CiAgICByaWdodDogMDsKICAgIGRpc3BsYXk6IG5vbmU7CiAgICBsaXN0LXN0eWxlOiBub25lOwogICAgYmFja2dyb3VuZDogdmFyKC0tYmFja2dyb3VuZCk7CiAgICBib3JkZXI6IDFweCBzb2xpZCB2YXIoLS1ib3JkZXItY29sb3IpOwogICAgcGFkZGluZzogdmFyKC0tYXJ0aWNsZS1wYWRkaW5nKTsKfQoKaGVhZGVyIC5kcm9wLW1lbnVzIGEgewogICAgY29sb3I6IHZhcigtLWNvbG9yKTsKfQoKaGVhZGVyIC5kcm9wLW1lbnVzOmhvdmVyIHVsIHsKICAgIGRpc3BsYXk6IGJsb2NrOwp9CgovKioqKioqKioqKioqKioqKioqKioqKioqKiogbWFpbiAqKioqKioqKioqKioqKioqKioqKioqKioqKi8KCm1haW4gewogICAgbWFyZ2luOiAwIGF1dG87CiAgICBtYXgtd2lkdGg6IHZhcigtLW1heC13aWR0aCk7CiAgICBwYWRkaW5nOiB2YXIoLS1wYWRkaW5nKTsKfQoKbWFpbiBhcnRpY2xlIC5saW5rIHsKICAgIGZvbnQtc2l6ZTogLjhyZW07CiAgICBkaXNwbGF5OiBub25lOwp9CgptYWluIGFydGljbGUgaDI6aG92ZXIgLmxpbmssCm1haW4gYXJ0aWNsZSBoMzpob3ZlciAubGluayB7CiAgICBkaXNwbGF5OiBpbmxpbmUtYmxvY2s7Cn0KCi8qKioqKioqKioqKioqKioqKioqKioqKioqIGZvb3RlciAqKioqKioqKioqKioqKioqKioqKioqKioqKi8KCmZvb3RlciB7CiAgICBib3JkZXItdG9wOiAxcHggc29saWQgdmFyKC0tYm9yZGVyLWNvbG9yKTsKICAgIHBhZGRpbmc6IDAgdmFyKC0tcGFkZGluZykgdmFyKC0tcGFkZGluZyk7Cn0KCmZvb3RlciAud3JhcCB7CiAgICBtYXgtd2lkdGg6IHZhcigtLW1heC13aWR0aCk7CiAgICBtYXJnaW46IDAgYXV0bzsKfQoKLmdvdG8tdG9wIHsKICAgIGJvcmRlcjogc29saWQgdmFyKC0tY29sb3IpOwogICAgYm9yZGVyLXdpZHRoOiAwIDVweCA1cHggMDsKICAgIGRpc3BsYXk6IGJsb2NrOwogICAgcGFkZGluZzogNXB4OwogICAgdHJhbnNmb3JtOiByb3RhdGUoLTEzNWRlZyk7CiAgICBwb3NpdGlvbjogZml4ZWQ7CiAgICBib3R0b206IDNyZW07CiAgICByaWdodDogY2FsYygoKDEwMCUgLSB2YXIoLS1tYXgtd2lkdGgpKSAvIDIpIC0gM3JlbSk7Cn0KCi8qCiAqIOeUqOS6juiuoeeulyBnb3RvLXRvcCDmjInpkq7nmoTkvY3nva7lp4vnu4jkv53mjIHlnKjlhoXlrrnkuLvkvZPnmoTlj7PkvqfovrnkuIrjgIIKICogMTAyNHB4IOS4uiAtLW1heC13aWR0aCDnmoTlgLzvvIzkvYbmmK8gQ1NTIOW5tuS4jeaUr+aMgeebtOaOpeWcqCBAbWVkaWEKICog5L2/55SoIHZhcigpIOWHveaVsOOAggogKi8KQG1lZGlhIChtYXgtd2lkdGg6IGNhbGMoMTAyNHB4ICsgNnJlbSkpIHsKICAgIC5nb3RvLXRvcCB7CiAgICAgICAgcmlnaHQ6IDNyZW07CiAgICB9Cn0KAAEIaW5kZXguanMBJWFwcGxpY2F0aW9uL2phdmFzY3JpcHQ7IGNoYXJzZXQ9dXRmLTgB/gG1J3VzZSBzdHJpY3QnOwoKd2luZG93Lm9ubG9hZCA9IGZ1bmN0aW9uKCkgewogICAgaW5pdEdvdG9Ub3AoKTsKfTsKCmZ1bmN0aW9uIGluaXRHb3RvVG9wKCkgewogICAgY29uc3QgdG9wID0gZG9jdW1lbnQucXVlcnlTZWxlY3RvcignLmdvdG8tdG9wJyk7CgogICAgLy8g5Zyo5pyA6aG26YOo5pe277yM6ZqQ6JeP5oyJ6ZKuCiAgICB3aW5kb3cuYWRkRXZlbnRMaXN0ZW5lcignc2Nyb2xsJywgKGUpID0+IHsKICAgICAgICBjb25zdCBib2R5ID0gZG9jdW1lbnQucXVlcnlTZWxlY3RvcignaHRtbCcpOwogICAgICAgIGlmIChib2R5LnNjcm9sbFRvcCA+IDUwKSB7CiAgICAgICAgICAgIHRvcC5zdHlsZS5kaXNwbGF5ID0gJ2Jsb2NrJzsKICAgICAgICB9IGVsc2UgewogICAgICAgICAgICB0b3Auc3R5bGUuZGlzcGxheSA9ICdub25lJzsKICAgICAgICB9CiAgICB9KTsKfQoAAQlpbmRleC54bWwBHmFwcGxpY2F0aW9uL3htbDsgY2hhcnNldD11dGYtOAH+HSI8P3htbCB2ZXJzaW9uPSIxLjAiIGVuY29kaW5nPSJ1dGYtOCI/Pgo8P3htbC1zdHlsZXNoZWV0IHR5cGU9InRleHQveHNsIiBocmVmPSIuL2luZGV4LnhzbCI/PgoKPCEtLQrov5nmmK/lrpjnvZHpppbpobXlhoXlrrnvvIzlkIzml7bkuZ/mmK/nroDkvZPkuK3mlofnmoTmnKzlnLDljJblhoXlrrnjgIIKCuWFtuWug+ivreiogOeahOacrOWMluWcsOWGheWuue+8jOmcgOimgemHjeaWsOaUueWGmeacrOaWh+S7tuS4remZpOazqOmHiuWklueahOaJgOacieWGheWuueOAggotLT4KCjxkb2NzIGxhbmc9ImNtbi1IYW5zIj4KICAgIDx0aXRsZT5hcGlkb2MgfCBSRVNUZnVsIEFQSSDmlofmoaPlpITnkIblt6Xlhbc8L3RpdGxlPgogICAgPGxpY2Vuc2UgdXJsPSJodHRwczovL2NyZWF0aXZlY29tbW9ucy5vcmcvbGljZW5zZXMvYnkvNC4wL2RlZWQuemgiPue9suWQjSA0LjAg5Zu96ZmFIChDQyBCWSA0LjApPC9saWNlbnNlPgoKICAgIDwhLS0g5LiA5Lqb57+76K+R5q+U6L6D6Zu25pWj55qE57+76K+R5YaF5a65IC0tPgogICAgPGxvY2FsZXM+CiAgICAgICAgPGhlYWRlcj4gPCEtLSDooajmoLzmiqXlpLQgLS0+CiAgICAgICAgICAgIDxuYW1lPuWQjeensDwvbmFtZT4KICAgICAgICAgICAgPHR5cGU+57G75Z6LPC90eXBlPgogICAgICAgICAgICA8cmVxdWlyZWQ+5b+F5aGrPC9yZXF1aXJlZD4KICAgICAgICAgICAgPGRlc2NyaXB0aW9uPuaPj+i/sDwvZGVzY3JpcHRpb24+CiAgICAgICAgPC9oZWFkZXI+CiAgICAgICAgPGdvdG8tdG9wPui/lOWbnumhtumDqDwvZ290by10b3A+CiAgICA8L2xvY2FsZXM+CgogICAgPGRvYyBpZD0iYWJvdXQiIHRpdGxlPSLlhbPkuo4iPgogICAgICAgIDxwPmFwaWRvYyDmmK/kuIDkuKrnroDljZXnmoQgPGFiYnIgdGl0bGU9IlJlcHJlc2VudGF0aW9uYWwgU3RhdGUgVHJhbnNmZXIiPlJFU1RmdWw8L2FiYnI+IDxhYmJyIHRpdGxlPSJBcHBsaWNhdGlvbiBQcm9ncmFtbWluZyBJbnRlcmZhY2UiPkFQSTwvYWJicj4g5paH5qGj55Sf5oiQ5bel5YW377yM5a6D5LuO5Luj56CB5rOo6YeK5Lit5o+Q5Y+W54m55a6a5qC85byP55qE5YaF5a6555Sf5oiQ5paH5qGj44CC5pSv5oyB6K+45aaCIEdv44CBSmF2YeOAgUMrK+OAgVJ1c3Qg562J5aSn6YOo5YiG5byA5Y+R6K+t6KiA77yM5YW35L2T5Y+v5L2/55SoIDxjb2RlPmFwaWRvYyBsYW5nPC9jb2RlPiDlkb3ku6TooYzmn6XnnIvmiYDmnInnmoTmlK/mjIHliJfooajjgII8L3A+CgogICAgICAgIDxwPmFwaWRvYyDmi6XmnInku6XkuIvnibnngrnvvJo8L3A+CiAgICAgICAgPG9sPgogICAgICAgICAgICA8bGk+6Leo5bmz5Y+w77yMbGludXjjgIF3aW5kb3dz44CBbWFjT1Mg562J6YO95pSv5oyB77ybPC9saT4KICAgICAgICAgICAgPGxpPuaUr+aMgeivreiogOW5v+azm++8jOWNs+S9v+aYr+S4jeaUr+aMge+8jOS5n+W+iOaWueS+v+aJqeWxle+8mzwvbGk+CiAgICAgICAgICAgIDxsaT7mlK/mjIHlpJrkuKrkuI3lkIzor63oqIDnmoTlpJrkuKrpobnnm67nlJ/miJDkuIDku73mlofmoaPvvJs8L2xpPgogICAgICAgICAgICA8bGk+6L6T5Ye65qih5p2/5Y+v6Ieq5a6a5LmJ77ybPC9saT4KICAgICAgICAgICAgPGxpPuagueaNruaWh+aho+eUn+aIkCBtb2NrIOaVsOaNru+8mzwvbGk+CiAgICAgICAgPC9vbD4KCiAgICAgICAgPHA+5Lul5LiL5piv5LiA5q61566A55+t55qEIEMg6K+t6KiA6aOO5qC85Luj56CB5LiL55qE56S65L6L77yaPC9wPgogICAgICAgIDxwcmU+PGNvZGUgY2xhc3M9Imxhbmd1YWdlLW1hcmt1cCI+PCFbQ0RBVEFbLyoqCiAqIDxhcGkgbWV0aG9kPSJHRVQiIHN1bW1hcnk9IuiOt+WPluaJgOacieeahOeUqOaIt+S/oeaBryI+CiAqICAgICA8cGF0aCBwYXRoPSIvdXNlcnMiPgogKiAgICAgICAgIDxxdWVyeSBuYW1lPSJwYWdlIiB0eXBlPSJudW1iZXIiIGRlZmF1bHQ9IjAiPuaYvuekuuesrOWHoOmhteeahOWGheWuuTwvcXVlcnk+CiAqICAgICAgICAgPHF1ZXJ5IG5hbWU9InNpemUiIHR5cGU9Im51bWJlciIgZGVmYXVsdD0iMjAiPuavj+mhteaYvuekuueahOaVsOmHjzwvcXVlcnk+CiAqICAgICA8L3BhdGg+CiAqICAgICA8dGFnPnVzZXI8L3RhZz4KICogICAgIDxzZXJ2ZXI+dXNlcnM8L3NlcnZlcj4KICogICAgIDxyZXNwb25zZSBzdGF0dXM9IjIwMCIgdHlwZT0ib2JqZWN0IiBtaW1ldHlwZT0iYXBwbGljYXRpb24vanNvbiI+CiAqICAgICAgICAgPHBhcmFtIG5hbWU9ImNvdW50IiB0eXBlPSJpbnQiIG9wdGlvbmFsPSJmYWxzZSIgc3VtbWFyeT0i56ym5ZCI5p2h5Lu255qE5omA5pyJ55So5oi35pWw6YePIiAvPgogKiAgICAgICAgIDxwYXJhbSBuYW1lPSJ1c2VycyIgdHlwZT0ib2JqZWN0IiBhcnJheT0idHJ1ZSIgc3VtbWFyeT0i55So5oi35YiX6KGoIj4KICogICAgICAgICAgICAgPHBhcmFtIG5hbWU9ImlkIiB0eXBlPSJpbnQiIHN1bW1hcnk9IuWUr+S4gCBJRCIgLz4KICogICAgICAgICAgICAgPHBhcmFtIG5hbWU9Im5hbWUiIHR5cGU9InN0cmluZyIgc3VtbWFyeT0i5aeT5ZCNIiAvPgogKiAgICAgICAgIDwvcGFyYW0+CiAqICAgICA8L3Jlc3BvbnNlPgogKiAgICAgPHJlc3BvbnNlIHN0YXR1cz0iNTAwIiBtaW1ldHlwZT0iYXBwbGljYXRpb24vanNvbiIgdHlwZT0ib2JqZWN0Ij4KICogICAgICAgICA8cGFyYW0gbmFtZT0iY29kZSIgdHlwZT0iaW50IiBzdW1tYXJ5PSLplJnor6/ku6PnoIEiIC8+CiAqICAgICAgICAgPHBhcmFtIG5hbWU9Im1zZyIgdHlwZT0ic3RyaW5nIiBzdW1tYXJ5PSLplJnor6/lhoXlrrkiIC8+CiAqICAgICA8L3Jlc3BvbnNlPgogKiA8L2FwaT4KICovXV0+PC9jb2RlPjwvcHJlPgogICAgPC9kb2M+CgogICAgPGRvYyBpZD0idXNhZ2UiIHRpdGxlPSLkvb/nlKgiIC8+CgogICAgPGRvYyBpZD0ic3BlYyIgdGl0bGU9IuaWh+aho+agvOW8jyI+CiAgICAgICAgPHA+5paH5qGj6YeH55SoIFhNTCDmoLzlvI/jgILlrZjlnKjkuKTkuKrpobbnuqfmoIfnrb7vvJo8Y29kZT5hcGlkb2M8L2NvZGU+IOWSjCA8Y29kZT5hcGk8L2NvZGU+77yM55So5LqO5o+P6L+w5pW05L2T5YaF5a655ZKM5YW35L2T5o6l5Y+j5L+h5oGv44CCPC9wPgoKICAgICAgICA8cD7mlofmoaPooqvku47ms6jph4rkuK3mj5Dlj5bkuYvlkI7vvIzmnIDnu4jkvJrooqvlkIjlubbmiJDkuIDkuKogWE1MIOaWh+S7tu+8jOWcqOivpeaWh+S7tuS4rSA8Y29kZT5hcGk8L2NvZGU+IOS9nOS4uiA8Y29kZT5hcGlkb2M8L2NvZGU+IOeahOS4gOS4quWtkOWFg+e0oOWtmOWcqO+8jOWmguaenOS9oOeahOmhueebruS4jeaDs+aKiuaWh+aho+WGmeWcqOazqOmHiuS4re+8jOS5n+WPr+S7peebtOaOpee8luWGmeS4gOS4quWujOaVtOeahCBYTUwg5paH5Lu277yM5bCGIDxjb2RlPmFwaTwvY29kZT4g5L2c5Li6IDxjb2RlPmFwaWRvYzwvY29kZT4g55qE5LiA5Liq5a2Q5YWD57Sg44CCPC9wPgoKICAgICAgICA8cD7lhbfkvZPlj6/lj4LogIM8YSBocmVmPSIuL2V4YW1wbGUvaW5kZXgueG1sIj7npLrkvovku6PnoIE8L2E+44CCPC9wPgoKICAgICAgICA8cD7ku6XkuIvmmK/lr7nlkITkuKogWE1MIOWFg+e0oOS7peWPiuWPguaVsOS7i+e7je+8jOWFtuS4reS7pSA8Y29kZT5APC9jb2RlPiDlvIDlpLTnmoTooajnpLogWE1MIOWxnuaAp++8mzxjb2RlPi48L2NvZGU+IOihqOekuuS4uuW9k+WJjeWFg+e0oOeahOWGheWuue+8m+WFtuWug+ihqOekuuWtkOWFg+e0oOOAgjwvcD4KICAgIDwvZG9jPgoKICAgIDwhLS0jIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIOS7peS4i+S4uuaWh+aho+WGheWuueeahOWtkOmhuSAjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMtLT4KCiAgICA8ZG9jIGlkPSJpbnN0YWxsIiB0aXRsZT0i5a6J6KOFIiBwYXJlbnQ9InVzYWdlIj4KICAgICAgICA8cD7lj6/ku6Xnm7TmjqXku44gPGEgaHJlZj0iaHR0cHM6Ly9naXRodWIuY29tL2NhaXh3L2FwaWRvYy9yZWxlYXNlcyI+aHR0cHM6Ly9naXRodWIuY29tL2NhaXh3L2FwaWRvYy9yZWxlYXNlczwvYT4g5p+l5om+5L2g6ZyA6KaB55qE54mI5pys5LiL6L2977yM5pS+5YWlIDx2YXI+UEFUSDwvdmFyPiDkuK3ljbPlj6/kvb/nlKjjgILlpoLmnpzmsqHmnInkvaDpnIDopoHnmoTlubPlj7Dmlofku7bvvIzliJnpnIDopoHku47mupDku6PnoIHnvJbor5HvvJo8L3A+CiAgICAgICAgPHVsPgogICAgICAgICAgICA8bGk+5LiL6L29IDxhIGhyZWY9Imh0dHBzOi8vZ29sYW5nLm9yZy9kbC8iPkdvPC9hPu+8mzwvbGk+CiAgICAgICAgICAgIDxsaT7kuIvovb3mupDku6PnoIHvvIw8c2FtcD5naXQgY2xvbmUgZ2l0aHViLmNvbS9jYWl4dy9hcGlkb2M8L3NhbXA+77ybPC9saT4KICAgICAgICAgICAgPGxpPuaJp+ihjOS7o+eggeS4rSA8Y29kZT5idWlsZC9idWlsZC5zaDwvY29kZT4g5oiW5pivIDxjb2RlPmJ1aWxkL2J1aWxkLmNtZDwvY29kZT4g6L+b6KGM57yW6K+R77ybPC9saT4KICAgICAgICAgICAgPGxpPue8luivkeWlveeahOaWh+S7tuWtmOaUvuWcqCA8Y29kZT5jbWQvYXBpZG9jPC9jb2RlPiDkuIvvvIzlj6/ku6XlsIbor6Xmlofku7bmlL7nva7lnKggPHZhcj5QQVRIPC92YXI+IOebruW9le+8mzwvbGk+CiAgICAgICAgPC91bD4KICAgIDwvZG9jPgoKICAgIDxkb2MgaWQ9ImVudiIgdGl0bGU9IueOr+Wig+WPmOmHjyIgcGFyZW50PSJ1c2FnZSI+CiAgICAgICAgPHA+YXBpZG9jIOS8muivu+WPliA8dmFyPkxBTkc8L3Zhcj4g55qE546v5aKD5Y+Y6YeP5L2c5Li65YW25pys5Zyw5YyW55qE5L6d5o2u77yM6Iul5oOz5oyH5a6a5YW25a6D6K+t56eN77yM5Y+v5Lul5omL5Yqo5oyH5a6aIDx2YXI+TEFORzwvdmFyPiDnjq/looPlj5jph4/vvJo8c2FtcD5MQU5HPXpoLUhhbnQgYXBpZG9jPC9zYW1wPuOAguWcqCB3aW5kb3dzIOezu+e7n+S4re+8jOiLpeS4jeWtmOWcqCA8dmFyPkxBTkc8L3Zhcj4g546v5aKD5Y+Y6YeP77yM5YiZ5Lya6LCD55SoIDxjb2RlPkdldFVzZXJEZWZhdWx0TG9jYWxlTmFtZTwvY29kZT4g5Ye95pWw5p2l6I635Y+W55u45bqU55qE6K+t6KiA5L+h5oGv44CCPC9wPgogICAgICAgIDxwPuacrOWcsOWMlueahCBJRCDlgLzvvIznkIborrrkuIrlj6ropoEgPGEgaHJlZj0iaHR0cHM6Ly90b29scy5pZXRmLm9yZy9odG1sL2JjcDQ3Ij5CQ1A0NzwvYT4g5pSv5oyB55qE6YO96IO95q2j56Gu6K+G5Yir44CC5Lmf5pSv5oyBIExpbnV4IOS4i+W4pue8lueggeeahOagvOW8j++8jOavlOWmgu+8mjxzYW1wPnpoX0NOLlVURi04PC9zYW1wPuOAgjwvcD4KICAgIDwvZG9jPgoKICAgIDxkb2MgaWQ9ImlkZSIgdGl0bGU9IklERSDmj5Lku7YiIHBhcmVudD0idXNhZ2UiPgogICAgICAgIDxwPmFwaWRvYyDmlK/mjIHku6UgPGEgaHJlZj0iaHR0cHM6Ly9taWNyb3NvZnQuZ2l0aHViLmlvL2xhbmd1YWdlLXNlcnZlci1wcm90b2NvbC9zcGVjaWZpY2F0aW9ucy9zcGVjaWZpY2F0aW9uLWN1cnJlbnQvIj48YWJiciB0aXRsZT0ibGFuZ3VhZ2Ugc2VydmVyIHByb3RvY29sIj5MU1A8L2FiYnI+PC9hPiDmqKHlvI/ov5DooYzvvJo8c2FtcD5hcGlkb2MgbHNwIC1wPSI6ODA4MCIgLW09c3RkaW8gLWg8L3NhbXA+44CC5omA5pyJ5pSv5oyBIExTUCDnmoQgSURFIOWSjOe8lui+keWZqOW+iOWuueaYk+WunueOsOWvueW6lOeahOaPkuS7tuOAguebruWJjeWumOaWueW3sue7j+aPkOS+m+S6humSiOWvuSB2c2NvZGUg55qE5o+S5Lu277yaPGEgaHJlZj0iaHR0cHM6Ly9tYXJrZXRwbGFjZS52aXN1YWxzdHVkaW8uY29tL2l0ZW1zP2l0ZW1OYW1lPWNhaXh3LmFwaWRvYyI+YXBpZG9jLnZzY29kZTwvYT7jgII8L3A+CiAgICA8L2RvYz4KCiAgICA8ZG9jIGlkPSJtb2NrIiB0aXRsZT0iTW9jayIgcGFyZW50PSJ1c2FnZSI+CiAgICAgICAgPHA+bW9jayDlrZDlkb3ku6Tlj6/ku6XorqkgYXBpZG9jIOagueaNruaWh+aho+WKqOaAgeeUn+aIkCBtb2NrIOaVsOaNru+8jG1vY2sg5a2Q5ZG95Luk5o+Q5L6b5LqG5aSn6YeP55qE6YWN572u6aG577yM5Lul5L2/55Sf5oiQ55qE5pWw5o2u5pu05pyJ6ZqP5py65oCn77yM5YW35L2T55qE5ZG95Luk6aG55Y+v5Lul6YCa6L+HIDxzYW1wPmFwaWRvYyBoZWxwIG1vY2s8L3NhbXA+IOafpeeci+OAgjwvcD4KCiAgICAgICAgPHA+5aaC5p6c5pWw5o2u57G75Z6L5Li6IDx2YXI+c3RyaW5nLmltYWdlPC92YXI+77yM5Lya6Ieq5Yqo55Sf5oiQ5LiA5byg5oyH5a6a5aSn5bCP55qE5Zu+54mH5bm25oqK5Zyw5Z2A6L+U5Zue57uZ55So5oi377yM5YW25aSn5bCP55Sx5p+l6K+i5Y+C5pWwIDxjb2RlPndpZHRoPC9jb2RlPiDlkowgPGNvZGU+aGVpZ2h0PC9jb2RlPiDmjIflrprvvIzmnKrmjIflrprliJnmmK8gPHZhcj41MDB4NTAwPC92YXI+44CC5Zu+54mH57G75Z6L5YiZ6YCa6L+H55So5oi35o+Q5Lqk55qEIDxjb2RlPkFjY2VwdDwvY29kZT4g5oql5aS06I635Y+W77yM55uu5YmN5pSv5oyBIDx2YXI+aW1hZ2UvZ2lmPC92YXI+44CBPHZhcj5pbWFnZS9wbmc8L3Zhcj4g5ZKMIDx2YXI+aW1hZ2UvanBlZzwvdmFyPiDkuInnp43nsbvlnovjgII8L3A+CgogICAgICAgIDxwPuS9v+eUqCBtb2NrIOS5n+acieS4gOWumueahOWxgOmZkOaAp++8muWPquiDveWIpOaWreaPkOS6pOeahOaVsOaNruaYr+WQpuespuWQiOimgeaxgu+8jOS9huaYr+aXoOazleeQhuino+aVsOaNruS7o+eggeeahOWQq+S5ie+8jOavlOWmgiBVUkwg5Lit5YyF5ZCrIHBhZ2U9Mu+8jOiDveWIpOaWrSBwYWdlIOeahOWAvOagvOW8j+aYr+WQpuato+ehru+8jOS9huaXoOazlee7meeUqOaIt+i/lOWbnuesrOS6jOmhteeahOaVsOaNruOAgk1vY2sg5Zyo6aqM6K+B5pWw5o2u5q2j56Gu5oCn5ZKM5Yid5pyf55So5LqO5bGV56S65pWw5o2u5YaF5a656L+Y5piv5b6I5pyJ55So55qE44CCPC9wPgogICAgPC9kb2M+CgogICAgPGRvYyBpZD0iY2xpIiB0aXRsZT0i5ZG95Luk6KGMIiBwYXJlbnQ9InVzYWdlIj4KICAgICAgICA8cD7lj6/ku6XpgJrov4cgPHNhbXA+YXBpZG9jIGhlbHA8L3NhbXA+IOafpeeci+WRveS7pOihjOaUr+aMgeeahOWtkOWRveS7pOOAguWMheWQq+S6huS7peS4i+WHoOS4qu+8mjwvcD4KICAgIDwvZG9jPgoKICAgIDxkb2MgaWQ9ImNvbmZpZy1maWxlIiB0aXRsZT0i6YWN572u5paH5Lu2IiBwYXJlbnQ9InVzYWdlIj4KICAgICAgICA8cD7phY3nva7mlofku7blkI3lm7rlrprkuLogPGNvZGU+LmFwaWRvYy55YW1sPC9jb2RlPu+8jOagvOW8j+S4uiBZQU1M77yM5Y+v5Y+C6ICDIDxhIGhyZWY9ImV4YW1wbGUvLmFwaWRvYy55YW1sIj4uYXBpZG9jLnlhbWw8L2E+44CC5paH5Lu25Y+v5Lul6YCa6L+H5ZG95LukIDxzYW1wPmFwaWRvYyBkZXRlY3Q8L3NhbXA+IOeUn+aIkOOAguS4u+imgeWMheWQq+S6huS7peWHoOS4qumFjee9rumhue+8mjwvcD4KICAgIDwvZG9jPgoKICAgIDxmb290ZXI+CiAgICAgICAgPGxpY2Vuc2U+CiAgICAgICAgICAgIDxwPuW9k+WJjemhtemdouWGheWuueaJmOeuoeS6jiA8L3A+PHA+77yM5bm26YeH55SoPC9wPjxwPui/m+ihjOiuuOWPr+OAgjwvcD4KICAgICAgICA8L2xpY2Vuc2U+CiAgICA8L2Zvb3Rlcj4KPC9kb2NzPgoAAQlpbmRleC54c2wBF3RleHQveHNsOyBjaGFyc2V0PXV0Zi04Af4pCzw/eG1sIHZlcnNpb249IjEuMCIgZW5jb2Rpbmc9IlVURi04Ij8+Cgo8eHNsOnN0eWxlc2hlZXQgdmVyc2lvbj0iMS4wIiB4bWxuczp4c2w9Imh0dHA6Ly93d3cudzMub3JnLzE5OTkvWFNML1RyYW5zZm9ybSI+Cjx4c2w6b3V0cHV0IG1ldGhvZD0iaHRtbCIgZW5jb2Rpbmc9InV0Zi04IiBpbmRlbnQ9InllcyIgdmVyc2lvbj0iNS4wIiBkb2N0eXBlLXN5c3RlbT0iYWJvdXQ6bGVnYWN5LWNvbXBhdCIgLz4KCjx4c2w6dmFyaWFibGUgbmFtZT0iY3Vyci1sYW5nIj4KICAgIDx4c2w6dmFsdWUtb2Ygc2VsZWN0PSIvZG9jcy9AbGFuZyIgLz4KPC94c2w6dmFyaWFibGU+Cgo8eHNsOnZhcmlhYmxlIG5hbWU9ImxvY2FsZS1maWxlIj4KICAgIDx4c2w6dmFsdWUtb2Ygc2VsZWN0PSJkb2N1bWVudCgnc2l0ZS54bWwnKS9zaXRlL2xvY2FsZXMvbG9jYWxlW0BpZD0kY3Vyci1sYW5nXS9AZG9jIiAvPgo8L3hzbDp2YXJpYWJsZT4KCjwhLS0g6I635Y+W5b2T5YmN5paH5qGj55qE6K+t6KiA5ZCN56ew77yM5aaC5p6c5LiN5a2Y5Zyo77yM5YiZ55u05o6l6YeH55SoIEBsYW5nIOWxnuaApyAtLT4KPHhzbDp2YXJpYWJsZSBuYW1lPSJjdXJyLWxhbmctdGl0bGUiPgogICAgPHhzbDp2YXJpYWJsZSBuYW1lPSJ0aXRsZSI+CiAgICAgICAgPHhzbDp2YWx1ZS1vZiBzZWxlY3Q9ImRvY3VtZW50KCdzaXRlLnhtbCcpL3NpdGUvbG9jYWxlcy9sb2NhbGVbQGlkPSRjdXJyLWxhbmddL0B0aXRsZSIgLz4KICAgIDwveHNsOnZhcmlhYmxlPgoKICAgIDx4c2w6Y2hvb3NlPgogICAgICAgIDx4c2w6d2hlbiB0ZXN0PSIkdGl0bGU9JyciPjx4c2w6dmFsdWUtb2Ygc2VsZWN0PSIkY3Vyci1sYW5nIiAvPjwveHNsOndoZW4+CiAgICAgICAgPHhzbDpvdGhlcndpc2U+PHhzbDp2YWx1ZS1vZiBzZWxlY3Q9IiR0aXRsZSIgLz48L3hzbDpvdGhlcndpc2U+CiAgICA8L3hzbDpjaG9vc2U+CjwveHNsOnZhcmlhYmxlPgoKPHhzbDp2YXJpYWJsZSBuYW1lPSJrZXl3b3JkcyI+CiAgICA8eHNsOmZvci1lYWNoIHNlbGVjdD0iZG9jdW1lbnQoJ3NpdGUueG1sJykvc2l0ZS9sYW5ndWFnZXMvbGFuZ3VhZ2UiPgogICAgICAgIDx4c2w6dmFsdWUtb2Ygc2VsZWN0PSIuIiAvPjx4c2w6dmFsdWUtb2Ygc2VsZWN0PSInLCciIC8+CiAgICA8L3hzbDpmb3ItZWFjaD4KPC94c2w6dmFyaWFibGU+Cgo8eHNsOnRlbXBsYXRlIG1hdGNoPSIvIj4KICAgIDxodG1sPgogICAgICAgIDxoZWFkPgogICAgICAgICAgICA8dGl0bGU+PHhzbDp2YWx1ZS1vZiBzZWxlY3Q9ImRvY3MvdGl0bGUiIC8+PC90aXRsZT4KICAgICAgICAgICAgPG1ldGEgY2hhcnNldD0iVVRGLTgiIC8+CiAgICAgICAgICAgIDxtZXRhIG5hbWU9InZpZXdwb3J0IiBjb250ZW50PSJ3aWR0aD1kZXZpY2Utd2lkdGgsaW5pdGlhbC1zY2FsZT0xLG1heGltdW0tc2NhbGU9MSIvPgogICAgICAgICAgICA8bWV0YSBuYW1lPSJrZXl3b3JkcyIgY29udGVudD0ieyRrZXl3b3Jkc31SRVNUZnVsIEFQSSxkb2N1bWVudCxhcGlkb2MiIC8+CiAgICAgICAgICAgIDxsaW5rIHJlbD0iaWNvbiIgdHlwZT0iaW1hZ2Uvc3ZnK3htbCIgaHJlZj0iLi9pY29uLnN2ZyIgLz4KICAgICAgICAgICAgPGxpbmsgcmVsPSJtYXNrLWljb24iIHR5cGU9ImltYWdlL3N2Zyt4bWwiIGhyZWY9Ii4vaWNvbi5zdmciIGNvbG9yPSJibGFjayIgLz4KICAgICAgICAgICAgPGxpbmsgcmVsPSJjYW5vbmljYWwiIGhyZWY9Intkb2N1bWVudCgnc2l0ZS54bWwnKS9zaXRlL3VybH0iIC8+CiAgICAgICAgICAgIDxsaW5rIHJlbD0ic3R5bGVzaGVldCIgdHlwZT0idGV4dC9jc3MiIGhyZWY9Ii4vaW5kZXguY3NzIiAvPgogICAgICAgICAgICA8bGluayByZWw9ImxpY2Vuc2UiIGhyZWY9InsvZG9jcy9saWVuc2UvQHVybH0iIC8+CiAgICAgICAgICAgIDxsaW5rIHJlbD0ic3R5bGVzaGVldCIgdHlwZT0idGV4dC9jc3MiIGhyZWY9Imh0dHBzOi8vY2RuanMuY2xvdWRmbGFyZS5jb20vYWpheC9saWJzL3ByaXNtLzEuMjAuMC90aGVtZXMvcHJpc20tdG9tb3Jyb3cubWluLmNzcyIgLz4KICAgICAgICAgICAgPHNjcmlwdCBzcmM9Ii4vaW5kZXguanMiIC8+CiAgICAgICAgPC9oZWFkPgoKICAgICAgICA8Ym9keT4KICAgICAgICAgICAgPHhzbDpjYWxsLXRlbXBsYXRlIG5hbWU9ImhlYWRlciIgLz4KCiAgICAgICAgICAgIDxtYWluPgogICAgICAgICAgICAgICAgPHhzbDpmb3ItZWFjaCBzZWxlY3Q9ImRvY3MvZG9jW25vdChAcGFyZW50KV0iPiA8IS0tIOS4jeWtmOWcqCBAcGFyZW5077yM6KGo56S65piv6aG257qn55qEIGRvYyDlhYPntKAgLS0+CiAgICAgICAgICAgICAgICAgICAgPHhzbDpjYWxsLXRlbXBsYXRlIG5hbWU9ImFydGljbGUiPgogICAgICAgICAgICAgICAgICAgICAgICA8eHNsOndpdGgtcGFyYW0gbmFtZT0iZG9jIiBzZWxlY3Q9Ii4iIC8+CiAgICAgICAgICAgICAgICAgICAgPC94c2w6Y2FsbC10ZW1wbGF0ZT4KICAgICAgICAgICAgICAgIDwveHNsOmZvci1lYWNoPgogICAgICAgICAgICA8L21haW4+CgogICAgICAgICAgICA8Zm9vdGVyPgogICAgICAgICAgICAgICAgPGRpdiBjbGFzcz0id3JhcCI+CiAgICAgICAgICAgICAgICA8cD4KICAgICAgICAgICAgICAgICAgICA8eHNsOnZhbHVlLW9mIHNlbGVjdD0iZG9jcy9mb290ZXIvbGljZW5zZS9wWzFdIiAvPgogICAgICAgICAgICAgICAgICAgIDxhIGhyZWY9Intkb2N1bWVudCgnc2l0ZS54bWwnKS9zaXRlL3JlcG99Ij5HaXRodWI8L2E+CiAgICAgICAgICAgICAgICAgICAgPHhzbDp2YWx1ZS1vZiBzZWxlY3Q9ImRvY3MvZm9vdGVyL2xpY2Vuc2UvcFsyXSIgLz4KICAgICAgICAgICAgICAgICAgICA8YSBocmVmPSJ7ZG9jcy9saWNlbnNlL0B1cmx9Ij48eHNsOnZhbHVlLW9mIHNlbGVjdD0iZG9jcy9saWNlbnNlIiAvPjwvYT4KICAgICAgICAgICAgICAgICAgICA8eHNsOnZhbHVlLW9mIHNlbGVjdD0iZG9jcy9mb290ZXIvbGljZW5zZS9wWzNdIiAvPgogICAgICAgICAgICAgICAgPC9wPgogICAgICAgICAgICAgICAgPC9kaXY+CiAgICAgICAgICAgICAgICA8YSBocmVmPSIjIiBjbGFzcz0iZ290by10b3AiIHRpdGxlPSJ7ZG9jcy9sb2NhbGVzL2dvdG8tdG9wfSIgYXJpYS1sYWJlbD0ie2RvY3MvbG9jYWxlcy9nb3RvLXRvcH0iIC8+CiAgICAgICAgICAgIDwvZm9vdGVyPgoKICAgICAgICAgICAgPHNjcmlwdCBzcmM9Imh0dHBzOi8vY2RuanMuY2xvdWRmbGFyZS5jb20vYWpheC9saWJzL3ByaXNtLzEuMjAuMC9jb21wb25lbnRzL3ByaXNtLWNvcmUubWluLmpzIj48L3NjcmlwdD4KICAgICAgICAgICAgPHNjcmlwdCBzcmM9Imh0dHBzOi8vY2RuanMuY2xvdWRmbGFyZS5jb20vYWpheC9saWJzL3ByaXNtLzEuMjAuMC9wbHVnaW5zL2F1dG9sb2FkZXIvcHJpc20tYXV0b2xvYWRlci5taW4uanMiPjwvc2NyaXB0PgogICAgICAgIDwvYm9keT4KICAgIDwvaHRtbD4KPC94c2w6dGVtcGxhdGU+Cgo8eHNsOnRlbXBsYXRlIG5hbWU9ImhlYWRlciI+CiAgICA8aGVhZGVyPgogICAgICAgIDxkaXYgY2xhc3M9IndyYXAiPgogICAgICAgICAgICA8aDE+CiAgICAgICAgICAgICAgICA8aW1nIGFsdD0ibG9nbyIgc3JjPSIuL2ljb24uc3ZnIiAvPgogICAgICAgICAgICAgICAgPHhzbDp2YWx1ZS1vZiBzZWxlY3Q9ImRvY3VtZW50KCdzaXRlLnhtbCcpL3NpdGUvbmFtZSIgLz4KICAgICAgICAgICAgICAgIDxzcGFuIGNsYXNzPSJ2ZXJzaW9uIj4mIzE2MDsoPHhzbDp2YWx1ZS1vZiBzZWxlY3Q9ImRvY3VtZW50KCdzaXRlLnhtbCcpL3NpdGUvdmVyc2lvbiIgLz4pPC9zcGFuPgogICAgICAgICAgICA8L2gxPgoKICAgICAgICAgICAgPGRpdiBjbGFzcz0ibWVudXMiIHJvbGU9Im5hdmlnYXRpb24iPgogICAgICAgICAgICAgICAgPHhzbDpmb3ItZWFjaCBzZWxlY3Q9ImRvY3MvZG9jW25vdChAcGFyZW50KV0iPgogICAgICAgICAgICAgICAgICAgIDxhIGNsYXNzPSJtZW51IiBocmVmPSIje0BpZH0iPjx4c2w6dmFsdWUtb2Ygc2VsZWN0PSJAdGl0bGUiIC8+PC9hPgogICAgICAgICAgICAgICAgPC94c2w6Zm9yLWVhY2g+CgogICAgICAgICAgICAgICAgPHNwYW4gY2xhc3M9ImRyb3AtbWVudXMiIHJvbGU9Im1lbnUiPgogICAgICAgICAgICAgICAgICAgIDxhIGNsYXNzPSJtZW51Ij4KICAgICAgICAgICAgICAgICAgICAgICAgPHhzbDp2YWx1ZS1vZiBzZWxlY3Q9IiRjdXJyLWxhbmctdGl0bGUiIC8+CiAgICAgICAgICAgICAgICAgICAgICAgIDxzcGFuIGFyaWEtaGlkZHJlbj0idHJ1ZSI+JiMxNjA7JiN4MjViYzs8L3NwYW4+CiAgICAgICAgICAgICAgICAgICAgPC9hPgogICAgICAgICAgICAgICAgICAgIDx1bD4KICAgICAgICAgICAgICAgICAgICAgICAgPHhzbDpmb3ItZWFjaCBzZWxlY3Q9ImRvY3VtZW50KCdzaXRlLnhtbCcpL3NpdGUvbG9jYWxlcy9sb2NhbGUiPgogICAgICAgICAgICAgICAgICAgICAgICAgICAgPGxpPjxhIGhyZWY9IntAaHJlZn0iPjx4c2w6dmFsdWUtb2Ygc2VsZWN0PSJAdGl0bGUiIC8+PC9hPjwvbGk+CiAgICAgICAgICAgICAgICAgICAgICAgIDwveHNsOmZvci1lYWNoPgogICAgICAgICAgICAgICAgICAgIDwvdWw+CiAgICAgICAgICAgICAgICA8L3NwYW4+CiAgICAgICAgICAgIDwvZGl2PgogICAgICAgIDwvZGl2PgogICAgPC9oZWFkZXI+CjwveHNsOnRlbXBsYXRlPgoKPCEtLSDlsIYgZG9jIOWFg+e0oOi9rOaNouaIkCBhcnRpY2xlIEhUTUwgLS0+Cjx4c2w6dGVtcGxhdGUgbmFtZT0iYXJ0aWNsZSI+CiAgICA8eHNsOnBhcmFtIG5hbWU9ImRvYyIgLz4KCiAgICA8eHNsOnZhcmlhYmxlIG5hbWU9ImlkIiBzZWxlY3Q9IiRkb2MvQGlkIiAvPgoKICAgIDxhcnRpY2xlIGlkPSJ7JGlkfSI+CiAgICAgICAgPHhzbDpjaG9vc2U+IDwhLS0g5qC55o2u5piv5ZCm5a2Y5ZyoICRwYXJlbnTvvIzlhrPlrprmmK/nlKggaDMg6L+Y5pivIGgyIOagh+etviAtLT4KICAgICAgICAgICAgPHhzbDp3aGVuIHRlc3Q9IiRkb2MvQHBhcmVudCI+CiAgICAgICAgICAgICAgICA8aDM+CiAgICAgICAgICAgICAgICAgICAgPHhzbDp2YWx1ZS1vZiBzZWxlY3Q9IiRkb2MvQHRpdGxlIiAvPgogICAgICAgICAgICAgICAgICAgIDxhIGNsYXNzPSJsaW5rIiBocmVmPSIjeyRpZH0iPiYjMTYwOyYjMTYwOyYjMTI4Mjc5OzwvYT4KICAgICAgICAgICAgICAgIDwvaDM+CiAgICAgICAgICAgIDwveHNsOndoZW4+CiAgICAgICAgICAgIDx4c2w6b3RoZXJ3aXNlPgogICAgICAgICAgICAgICAgPGgyPgogICAgICAgICAgICAgICAgICAgIDx4c2w6dmFsdWUtb2Ygc2VsZWN0PSIkZG9jL0B0aXRsZSIgLz4KICAgICAgICAgICAgICAgICAgICA8YSBjbGFzcz0ibGluayIgaHJlZj0iI3skZG9jL0BpZH0iPiYjMTYwOyYjMTYwOyYjMTI4Mjc5OzwvYT4KICAgICAgICAgICAgICAgIDwvaDI+CiAgICAgICAgICAgIDwveHNsOm90aGVyd2lzZT4KICAgICAgICA8L3hzbDpjaG9vc2U+CgogICAgICAgIDx4c2w6Y29weS1vZiBzZWxlY3Q9IiRkb2Mvbm9kZSgpIiAvPgoKICAgICAgICA8eHNsOmZvci1lYWNoIHNlbGVjdD0iL2RvY3MvZG9jW0BwYXJlbnQ9JGlkXSI+CiAgICAgICAgICAgIDx4c2w6Y2FsbC10ZW1wbGF0ZSBuYW1lPSJhcnRpY2xlIj4KICAgICAgICAgICAgICAgIDx4c2w6d2l0aC1wYXJhbSBuYW1lPSJkb2MiIHNlbGVjdD0iLiIgLz4KICAgICAgICAgICAgPC94c2w6Y2FsbC10ZW1wbGF0ZT4KICAgICAgICA8L3hzbDpmb3ItZWFjaD4KCiAgICAgICAgPHhzbDppZiB0ZXN0PSIkaWQ9J3NwZWMnIj4KICAgICAgICAgICAgPHhzbDpmb3ItZWFjaCBzZWxlY3Q9ImRvY3VtZW50KCRsb2NhbGUtZmlsZSkvbG9jYWxlL3NwZWMvdHlwZSI+CiAgICAgICAgICAgICAgICA8eHNsOmNhbGwtdGVtcGxhdGUgbmFtZT0idHlwZSI+CiAgICAgICAgICAgICAgICAgICAgPHhzbDp3aXRoLXBhcmFtIG5hbWU9InR5cGUiIHNlbGVjdD0iLiIgLz4KICAgICAgICAgICAgICAgIDwveHNsOmNhbGwtdGVtcGxhdGU+CiAgICAgICAgICAgIDwveHNsOmZvci1lYWNoPgogICAgICAgIDwveHNsOmlmPgoKICAgICAgICA8eHNsOmlmIHRlc3Q9IiRpZD0nY2xpJyI+CiAgICAgICAgICAgIDx4c2w6Y2FsbC10ZW1wbGF0ZSBuYW1lPSJjb21tYW5kcyIgLz4KICAgICAgICA8L3hzbDppZj4KCiAgICAgICAgPHhzbDppZiB0ZXN0PSIkaWQ9J2NvbmZpZy1maWxlJyI+CiAgICAgICAgICAgIDx4c2w6Y2FsbC10ZW1wbGF0ZSBuYW1lPSJhcGlkb2NZQU1MIiAvPgogICAgICAgIDwveHNsOmlmPgogICAgPC9hcnRpY2xlPgo8L3hzbDp0ZW1wbGF0ZT4KCjwhLS0g5Lul5LiL5Lik5Liq5Y+Y6YeP5LuF55So5LqOIHR5cGUg5ZKMIGNvbW1hbmRzIOaooeadv++8jOWcqOaooeadv+S4reaXoOazleebtOaOpeS9v+eUqCAvZG9jcyDlhYPntKDvvIzmiYDku6Xkvb/nlKjlj5jph4/lvJXnlKggLS0+Cjx4c2w6dmFyaWFibGUgbmFtZT0iaGVhZGVyLWxvY2FsZSIgc2VsZWN0PSIvZG9jcy9sb2NhbGVzL2hlYWRlciIgLz4KCjwhLS0g5bCG5a2Q5ZG95Luk5pi+56S65Li65LiA5LiqIHRhYmxlIC0tPgo8eHNsOnRlbXBsYXRlIG5hbWU9ImNvbW1hbmRzIj4KICAgIDx0YWJsZT4KICAgICAgICA8dGhlYWQ+CiAgICAgICAgICAgIDx0cj4KICAgICAgICAgICAgICAgIDx0aD48eHNsOmNvcHktb2Ygc2VsZWN0PSIkaGVhZGVyLWxvY2FsZS9uYW1lIiAvPjwvdGg+CiAgICAgICAgICAgICAgICA8dGg+PHhzbDpjb3B5LW9mIHNlbGVjdD0iJGhlYWRlci1sb2NhbGUvZGVzY3JpcHRpb24iIC8+PC90aD4KICAgICAgICAgICAgPC90cj4KICAgICAgICA8L3RoZWFkPgoKICAgICAgICA8dGJvZHk+CiAgICAgICAgICAgIDx4c2w6Zm9yLWVhY2ggc2VsZWN0PSJkb2N1bWVudCgkbG9jYWxlLWZpbGUpL2xvY2FsZS9jb21tYW5kcy9jb21tYW5kIj4KICAgICAgICAgICAgPHRyPgogICAgICAgICAgICAgICAgPHRoPjx4c2w6dmFsdWUtb2Ygc2VsZWN0PSJAbmFtZSIgLz48L3RoPgogICAgICAgICAgICAgICAgPHRkPjx4c2w6Y29weS1vZiBzZWxlY3Q9Im5vZGUoKSIgLz48L3RkPgogICAgICAgICAgICA8L3RyPgogICAgICAgICAgICA8L3hzbDpmb3ItZWFjaD4KICAgICAgICA8L3Rib2R5PgogICAgPC90YWJsZT4KPC94c2w6dGVtcGxhdGU+Cgo8IS0tIOWwhiBhcGlkb2MueWFtbCDnsbvlnovor7TmmI7mmL7npLrkuLogdGFibGUgLS0+Cjx4c2w6dGVtcGxhdGUgbmFtZT0iYXBpZG9jWUFNTCI+CiAgICA8dGFibGU+CiAgICAgICAgPHRoZWFkPgogICAgICAgICAgICA8dHI+CiAgICAgICAgICAgICAgICA8dGg+PHhzbDpjb3B5LW9mIHNlbGVjdD0iJGhlYWRlci1sb2NhbGUvbmFtZSIgLz48L3RoPgogICAgICAgICAgICAgICAgPHRoPjx4c2w6Y29weS1vZiBzZWxlY3Q9IiRoZWFkZXItbG9jYWxlL3R5cGUiIC8+PC90aD4KICAgICAgICAgICAgICAgIDx0aD48eHNsOmNvcHktb2Ygc2VsZWN0PSIkaGVhZGVyLWxvY2FsZS9yZXF1aXJlZCIgLz48L3RoPgogICAgICAgICAgICAgICAgPHRoPjx4c2w6Y29weS1vZiBzZWxlY3Q9IiRoZWFkZXItbG9jYWxlL2Rlc2NyaXB0aW9uIiAvPjwvdGg+CiAgICAgICAgICAgIDwvdHI+CiAgICAgICAgPC90aGVhZD4KCiAgICAgICAgPHRib2R5PgogICAgICAgICAgICA8eHNsOmZvci1lYWNoIHNlbGVjdD0iZG9jdW1lbnQoJGxvY2FsZS1maWxlKS9sb2NhbGUvY29uZmlnL2l0ZW0iPgogICAgICAgICAgICAgICAgPHRyPgogICAgICAgICAgICAgICAgICAgIDx0aD48eHNsOnZhbHVlLW9mIHNlbGVjdD0iQG5hbWUiIC8+PC90aD4KICAgICAgICAgICAgICAgICAgICA8dGQ+CiAgICAgICAgICAgICAgICAgICAgICAgIDx4c2w6dmFsdWUtb2Ygc2VsZWN0PSJAdHlwZSIgLz4KICAgICAgICAgICAgICAgICAgICAgICAgPHhzbDppZiB0ZXN0PSJAYXJyYXk9J3RydWUnIj48eHNsOnZhbHVlLW9mIHNlbGVjdD0iJ1tdJyIgLz48L3hzbDppZj4KICAgICAgICAgICAgICAgICAgICA8L3RkPgogICAgICAgICAgICAgICAgICAgIDx0ZD4KICAgICAgICAgICAgICAgICAgICAgICAgPHhzbDpjYWxsLXRlbXBsYXRlIG5hbWU9ImNoZWNrYm94Ij4KICAgICAgICAgICAgICAgICAgICAgICAgICAgIDx4c2w6d2l0aC1wYXJhbSBuYW1lPSJjaGsiIHNlbGVjdD0iQHJlcXVpcmVkIiAvPgogICAgICAgICAgICAgICAgICAgICAgICA8L3hzbDpjYWxsLXRlbXBsYXRlPgogICAgICAgICAgICAgICAgICAgIDwvdGQ+CiAgICAgICAgICAgICAgICAgICAgPHRkPjx4c2w6Y29weS1vZiBzZWxlY3Q9Im5vZGUoKSIgLz48L3RkPgogICAgICAgICAgICAgICAgPC90cj4KICAgICAgICAgICAgPC94c2w6Zm9yLWVhY2g+CiAgICAgICAgPC90Ym9keT4KICAgIDwvdGFibGU+CjwveHNsOnRlbXBsYXRlPgoKCjwhLS0g5bCG57G75Z6L5pi+56S65Li65LiA5LiqIHRhYmxlIC0tPgo8eHNsOnRlbXBsYXRlIG5hbWU9InR5cGUiPgogICAgPHhzbDpwYXJhbSBuYW1lPSJ0eXBlIiAvPgoKICAgIDxhcnRpY2xlIGlkPSJ0eXBlX3skdHlwZS9AbmFtZX0iPgogICAgICAgIDxoMz4KICAgICAgICAgICAgPHhzbDp2YWx1ZS1vZiBzZWxlY3Q9IiR0eXBlL0BuYW1lIiAvPgogICAgICAgICAgICA8YSBjbGFzcz0ibGluayIgaHJlZj0iI3R5cGVfeyR0eXBlL0BuYW1lfSI+JiMxNjA7JiMxNjA7JiMxMjgyNzk7PC9hPgogICAgICAgIDwvaDM+CiAgICAgICAgPHhzbDpjb3B5LW9mIHNlbGVjdD0iJHR5cGUvdXNhZ2Uvbm9kZSgpIiAvPgogICAgICAgIDx4c2w6aWYgdGVzdD0iaXRlbSI+CiAgICAgICAgICAgIDx0YWJsZT4KICAgICAgICAgICAgICAgIDx0aGVhZD4KICAgICAgICAgICAgICAgICAgICA8dHI+CiAgICAgICAgICAgICAgICAgICAgICAgIDx0aD48eHNsOmNvcHktb2Ygc2VsZWN0PSIkaGVhZGVyLWxvY2FsZS9uYW1lIiAvPjwvdGg+CiAgICAgICAgICAgICAgICAgICAgICAgIDx0aD48eHNsOmNvcHktb2Ygc2VsZWN0PSIkaGVhZGVyLWxvY2FsZS90eXBlIiAvPjwvdGg+CiAgICAgICAgICAgICAgICAgICAgICAgIDx0aD48eHNsOmNvcHktb2Ygc2VsZWN0PSIkaGVhZGVyLWxvY2FsZS9yZXF1aXJlZCIgLz48L3RoPgogICAgICAgICAgICAgICAgICAgICAgICA8dGg+PHhzbDpjb3B5LW9mIHNlbGVjdD0iJGhlYWRlci1sb2NhbGUvZGVzY3JpcHRpb24iIC8+PC90aD4KICAgICAgICAgICAgICAgICAgICA8L3RyPgogICAgICAgICAgICAgICAgPC90aGVhZD4KCiAgICAgICAgICAgICAgICA8dGJvZHk+CiAgICAgICAgICAgICAgICAgICAgPHhzbDpmb3ItZWFjaCBzZWxlY3Q9IiR0eXBlL2l0ZW0iPgogICAgICAgICAgICAgICAgICAgIDx0cj4KICAgICAgICAgICAgICAgICAgICAgICAgPHRoPjx4c2w6dmFsdWUtb2Ygc2VsZWN0PSJAbmFtZSIgLz48L3RoPgogICAgICAgICAgICAgICAgICAgICAgICA8dGQ+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICA8YSBjbGFzcz0idHlwZSIgaHJlZj0iI3R5cGVfe0B0eXBlfSI+PHhzbDp2YWx1ZS1vZiBzZWxlY3Q9IkB0eXBlIiAvPjwvYT4KICAgICAgICAgICAgICAgICAgICAgICAgICAgIDx4c2w6aWYgdGVzdD0iQGFycmF5PSd0cnVlJyI+PHhzbDp2YWx1ZS1vZiBzZWxlY3Q9IidbXSciIC8+PC94c2w6aWY+CiAgICAgICAgICAgICAgICAgICAgICAgIDwvdGQ+CiAgICAgICAgICAgICAgICAgICAgICAgIDx0ZD4KICAgICAgICAgICAgICAgICAgICAgICAgICAgIDx4c2w6Y2FsbC10ZW1wbGF0ZSBuYW1lPSJjaGVja2JveCI+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgPHhzbDp3aXRoLXBhcmFtIG5hbWU9ImNoayIgc2VsZWN0PSJAcmVxdWlyZWQiIC8+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICA8L3hzbDpjYWxsLXRlbXBsYXRlPgogICAgICAgICAgICAgICAgICAgICAgICA8L3RkPgogICAgICAgICAgICAgICAgICAgICAgICA8dGQ+PHhzbDpjb3B5LW9mIHNlbGVjdD0ibm9kZSgpIiAvPjwvdGQ+CiAgICAgICAgICAgICAgICAgICAgPC90cj4KICAgICAgICAgICAgICAgICAgICA8L3hzbDpmb3ItZWFjaD4KICAgICAgICAgICAgICAgIDwvdGJvZHk+CiAgICAgICAgICAgIDwvdGFibGU+CiAgICAgICAgPC94c2w6aWY+CiAgICA8L2FydGljbGU+CjwveHNsOnRlbXBsYXRlPgoKPHhzbDp0ZW1wbGF0ZSBuYW1lPSJjaGVja2JveCI+CiAgICA8eHNsOnBhcmFtIG5hbWU9ImNoayIgLz4KICAgIDx4c2w6Y2hvb3NlPgogICAgICAgIDx4c2w6d2hlbiB0ZXN0PSIkY2hrPSd0cnVlJyI+CiAgICAgICAgICAgIDxsYWJlbCBhcmlhLWxhYmVsPSJ0cnVlIj4KICAgICAgICAgICAgICAgIDxpbnB1dCB0eXBlPSJjaGVja2JveCIgY2hlY2tlZD0idHJ1ZSIgZGlzYWJsZWQ9InRydWUiIGFyaWEtaGlkZHJlbj0idHJ1ZSIgLz4KICAgICAgICAgICAgPC9sYWJlbD4KICAgICAgICA8L3hzbDp3aGVuPgogICAgICAgIDx4c2w6b3RoZXJ3aXNlPgogICAgICAgICAgICA8bGFiZWwgYXJpYS1sYWJlbD0iZmFsc2UiPgogICAgICAgICAgICAgICAgPGlucHV0IHR5cGU9ImNoZWNrYm94IiBkaXNhYmxlZD0idHJ1ZSIgYXJpYS1oaWRkcmVuPSJ0cnVlIiAvPgogICAgICAgICAgICA8L2xhYmVsPgogICAgICAgIDwveHNsOm90aGVyd2lzZT4KICAgIDwveHNsOmNob29zZT4KPC94c2w6dGVtcGxhdGU+Cgo8L3hzbDpzdHlsZXNoZWV0PgoAARNsb2NhbGUuY21uLUhhbnMueG1sAR5hcHBsaWNhdGlvbi94bWw7IGNoYXJzZXQ9dXRmLTgB/nMCPD94bWwgdmVyc2lvbj0iMS4wIiBlbmNvZGluZz0iVVRGLTgiPz4KCjwhLS0g6K+l5paH5Lu255Sx5bel5YW36Ieq5Yqo55Sf5oiQ77yM6K+35Yu/5omL5Yqo5L+u5pS577yBIC0tPgoKPGxvY2FsZT4KCTxzcGVjPgoJCTx0eXBlIG5hbWU9ImFwaWRvYyI+CgkJCTx1c2FnZT7nlKjkuo7mj4/ov7DmlbTkuKrmlofmoaPnmoTnm7jlhbPlhoXlrrnvvIzlj6rog73lh7rnjrDkuIDmrKHjgII8L3VzYWdlPgoJCQk8aXRlbSBuYW1lPSJAYXBpZG9jIiB0eXBlPSJzdHJpbmciIGFycmF5PSJmYWxzZSIgcmVxdWlyZWQ9ImZhbHNlIj7mlofmoaPnmoTniYjmnKzopoHlj7c8L2l0ZW0+CgkJCTxpdGVtIG5hbWU9IkBsYW5nIiB0eXBlPSJzdHJpbmciIGFycmF5PSJmYWxzZSIgcmVxdWlyZWQ9ImZhbHNlIj7mlofmoaPlhoXlrrnnmoTmnKzlnLDljJYgSUTvvIzmr5TlpoIgPHZhcj56aC1IYW5zPC92YXI+44CBPHZhcj5lbi1VUzwvdmFyPiDnrYnjgII8L2l0ZW0+CgkJCTxpdGVtIG5hbWU9IkBsb2dvIiB0eXBlPSJzdHJpbmciIGFycmF5PSJmYWxzZSIgcmVxdWlyZWQ9ImZhbHNlIj7mlofmoaPnmoTlm77moIfvvIzku4Xlj6/kvb/nlKggU1ZHIOagvOW8j+Wbvuagh+OAgjwvaXRlbT4KCQkJPGl0ZW0gbmFtZT0iQGNyZWF0ZWQiIHR5cGU9ImRhdGUiIGFycmF5PSJmYWxzZSIgcmVxdWlyZWQ9ImZhbHNlIj7mlofmoaPnmoTliJvlu7rml7bpl7Q8L2l0ZW0+CgkJCTxpdGVtIG5hbWU9IkB2ZXJzaW9uIiB0eXBlPSJ2ZXJzaW9uIiBhcnJheT0iZmFsc2UiIHJlcXVpcmVkPSJmYWxzZSI+5paH5qGj55qE54mI5pys5Y+3PC9pdGVtPgoJCQk8aXRlbSBuYW1lPSJ4bWwtbmFtZXNwYWNlIiB0eXBlPSJ4bWwtbmFtZXNwYWNlIiBhcnJheT0idHJ1ZSIgcmVxdWlyZWQ9ImZhbHNlIj7pkojlr7kgPHZhcj5hcHBsaWNhdGlvbi94bWw8L3Zhcj4g57G75Z6L55qE5YaF5a6555qE5ZG95ZCN56m66Ze06K6+572uPC9pdGVtPgoJCQk8aXRlbSBuYW1lPSJ0aXRsZSIgdHlwZT0ic3RyaW5nIiBhcnJheT0iZmFsc2UiIHJlcXVpcmVkPSJ0cnVlIj7mlofmoaPnmoTmoIfpopg8L2l0ZW0+CgkJCTxpdGVtIG5hbWU9ImRlc2NyaXB0aW9uIiB0eXBlPSJyaWNodGV4dCIgYXJyYXk9ImZhbHNlIiByZXF1aXJlZD0iZmFsc2UiPuaWh+aho+eahOaVtOS9k+aPj+i/sOWGheWuuTwvaXRlbT4KCQkJPGl0ZW0gbmFtZT0iY29udGFjdCIgdHlwZT0iY29udGFjdCIgYXJyYXk9ImZhbHNlIiByZXF1aXJlZD0iZmFsc2UiPuaWh+aho+S9nOiAheeahOiBlOezu+aWueW8jzwvaXRlbT4KCQkJPGl0ZW0gbmFtZT0ibGljZW5zZSIgdHlwZT0ibGluayIgYXJyYXk9ImZhbHNlIiByZXF1aXJlZD0iZmFsc2UiPuaWh+aho+eahOeJiOadg+S/oeaBrzwvaXRlbT4KCQkJPGl0ZW0gbmFtZT0idGFnIiB0eXBlPSJ0YWciIGFycmF5PSJ0cnVlIiByZXF1aXJlZD0iZmFsc2UiPuaWh+aho+S4reWumuS5ieeahOaJgOacieagh+etvjwvaXRlbT4KCQkJPGl0ZW0gbmFtZT0ic2VydmVyIiB0eXBlPSJzZXJ2ZXIiIGFycmF5PSJ0cnVlIiByZXF1aXJlZD0iZmFsc2UiPkFQSSDln7rlnLDlnYDliJfooajvvIzmr4/kuKogQVBJIOacgOWwkeW6lOivpeacieS4gOS4qiBzZXJ2ZXLjgII8L2l0ZW0+CgkJCTxpdGVtIG5hbWU9InR5cGUiIHR5cGU9InR5cGVkZWYiIGFycmF5PSJ0cnVlIiByZXF1aXJlZD0iZmFsc2UiPuaWh+aho+S4reWumuS5ieeahOWPr+WkjeeUqOexu+Wei++8jOWPr+mAmui/hyA8c2FtcD4jbmFtZTwvc2FtcD4g55qE5b2i5byP5ZyoIDx2YXI+dHlwZTwvdmFyPiDlsZ7mgKfkuK3lvJXnlKjjgII8L2l0ZW0+CgkJCTxpdGVtIG5hbWU9InNlY3VyaXR5IiB0eXBlPSJzZWN1cml0eSIgYXJyYXk9InRydWUiIHJlcXVpcmVkPSJmYWxzZSI+5paH5qGj5Lit5a6a5LmJ55qE5omA5pyJ6Lqr5Lu96aqM6K+B5pa55byPPC9pdGVtPgoJCQk8aXRlbSBuYW1lPSJhcGkiIHR5cGU9ImFwaSIgYXJyYXk9InRydWUiIHJlcXVpcmVkPSJmYWxzZSI+5paH5qGj5Lit55qEIEFQSSDmlofmoaM8L2l0ZW0+CgkJCTxpdGVtIG5hbWU9ImhlYWRlciIgdHlwZT0icGFyYW0iIGFycmF5PSJ0cnVlIiByZXF1aXJlZD0iZmFsc2UiPuaWh+aho+S4reaJgOaciSBBUEkg6YO95YyF5ZCr55qE5YWs5YWx5oql5aS0PC9pdGVtPgoJCQk8aXRlbSBuYW1lPSJyZXNwb25zZSIgdHlwZT0icmVxdWVzdCIgYXJyYXk9InRydWUiIHJlcXVpcmVkPSJmYWxzZSI+5paH5qGj5Lit5omA5pyJIEFQSSDmlofmoaPpg73pnIDopoHmlK/mjIHnmoTov5Tlm57lhoXlrrk8L2l0ZW0+CgkJCTxpdGVtIG5hbWU9Im1pbWV0eXBlIiB0eXBlPSJzdHJpbmciIGFycmF5PSJ0cnVlIiByZXF1aXJlZD0idHJ1ZSI+5paH5qGj5omA5pSv5oyB55qEIG1pbWV0eXBlPC9pdGVtPgoJCTwvdHlwZT4KCQk8dHlwZSBuYW1lPSJ4bWwtbmFtZXNwYWNlIj4KCQkJPHVzYWdlPuS4uiA8dmFyPmFwcGxpY2F0aW9uL3htbDwvdmFyPiDlrprkuYnlkb3lkI3nqbrpl7TnmoTnm7jlhbPlsZ7mgKc8L3VzYWdlPgoJCQk8aXRlbSBuYW1lPSJAcHJlZml4IiB0eXBlPSJzdHJpbmciIGFycmF5PSJmYWxzZSIgcmVxdWlyZWQ9ImZhbHNlIj7lkb3lkI3nqbrpl7TnmoTliY3nvIDvvIzlpoLmnpzkuLrnqbrvvIzliJnooajnpLrkvZzkuLrpu5jorqTlkb3lkI3nqbrpl7TvvIzlkb3lsYDlj6rog73mnInkuIDkuKrpu5jorqTlkb3lkI3nqbrpl7TjgII8L2l0ZW0+CgkJCTxpdGVtIG5hbWU9IkB1cm4iIHR5cGU9InN0cmluZyIgYXJyYXk9ImZhbHNlIiByZXF1aXJlZD0idHJ1ZSI+5ZG95ZCN56m66Ze055qE5ZSv5LiA5qCH6K+G77yM6ZyA6KaB5YWo5bGA5ZSv5LiA77yM5LiU5Yy65YiG5aSn5bCP5YaZ44CCPC9pdGVtPgoJCTwvdHlwZT4KCQk8dHlwZSBuYW1lPSJyaWNodGV4dCI+CgkJCTx1c2FnZT7lr4zmlofmnKzlhoXlrrk8L3VzYWdlPgoJCQk8aXRlbSBuYW1lPSJAdHlwZSIgdHlwZT0ic3RyaW5nIiBhcnJheT0iZmFsc2UiIHJlcXVpcmVkPSJ0cnVlIj7mjIflrprlr4zmlofmnKzlhoXlrrnnmoTmoLzlvI/vvIznm67liY3mlK/mjIEgPHZhcj5odG1sPC92YXI+IOWSjCA8dmFyPm1hcmtkb3duPC92YXI+44CCPC9pdGVtPgoJCQk8aXRlbSBuYW1lPSIuIiB0eXBlPSJzdHJpbmciIGFycmF5PSJmYWxzZSIgcmVxdWlyZWQ9InRydWUiPuWvjOaWh+acrOeahOWunumZheWGheWuuTwvaXRlbT4KCQk8L3R5cGU+CgkJPHR5cGUgbmFtZT0iY29udGFjdCI+CgkJCTx1c2FnZT7nlKjkuo7mj4/ov7DogZTns7vmlrnlvI88L3VzYWdlPgoJCQk8aXRlbSBuYW1lPSJAbmFtZSIgdHlwZT0ic3RyaW5nIiBhcnJheT0iZmFsc2UiIHJlcXVpcmVkPSJ0cnVlIj7ogZTns7vkurrnmoTlkI3np7A8L2l0ZW0+CgkJCTxpdGVtIG5hbWU9InVybCIgdHlwZT0ic3RyaW5nIiBhcnJheT0iZmFsc2UiIHJlcXVpcmVkPSJmYWxzZSI+6IGU57O75Lq655qEIFVSTDwvaXRlbT4KCQkJPGl0ZW0gbmFtZT0iZW1haWwiIHR5cGU9InN0cmluZyIgYXJyYXk9ImZhbHNlIiByZXF1aXJlZD0iZmFsc2UiPuiBlOezu+S6uueahOeUteWtkOmCruS7tjwvaXRlbT4KCQk8L3R5cGU+CgkJPHR5cGUgbmFtZT0ibGluayI+CgkJCTx1c2FnZT7nlKjkuo7mj4/ov7Dpk77mjqXkv6Hmga/vvIzkuIDoiKzovazmjaLkuLogSFRNTCDnmoQgPGNvZGU+YTwvY29kZT4g5qCH562+44CCPC91c2FnZT4KCQkJPGl0ZW0gbmFtZT0iQHRleHQiIHR5cGU9InN0cmluZyIgYXJyYXk9ImZhbHNlIiByZXF1aXJlZD0idHJ1ZSI+6ZO+5o6l55qE5a2X6Z2i5paH5a2XPC9pdGVtPgoJCQk8aXRlbSBuYW1lPSJAdXJsIiB0eXBlPSJzdHJpbmciIGFycmF5PSJmYWxzZSIgcmVxdWlyZWQ9InRydWUiPumTvuaOpeaMh+WQkeeahOaWh+acrDwvaXRlbT4KCQk8L3R5cGU+CgkJPHR5cGUgbmFtZT0idGFnIj4KCQkJPHVzYWdlPueUqOS6juWvueWQhOS4qiBBUEkg6L+b6KGM5YiG57G7PC91c2FnZT4KCQkJPGl0ZW0gbmFtZT0iQG5hbWUiIHR5cGU9InN0cmluZyIgYXJyYXk9ImZhbHNlIiByZXF1aXJlZD0idHJ1ZSI+5qCH562+55qE5ZSv5LiAIElEPC9pdGVtPgoJCQk8aXRlbSBuYW1lPSJAdGl0bGUiIHR5cGU9InN0cmluZyIgYXJyYXk9ImZhbHNlIiByZXF1aXJlZD0idHJ1ZSI+5qCH562+55qE5a2X6Z2i5ZCN56ewPC9pdGVtPgoJCQk8aXRlbSBuYW1lPSJAZGVwcmVjYXRlZCIgdHlwZT0idmVyc2lvbiIgYXJyYXk9ImZhbHNlIiByZXF1aXJlZD0iZmFsc2UiPuivpeagh+etvuWcqOWkp+S6juivpeeJiOacrOaXtuiiq+W8g+eUqDwvaXRlbT4KCQk8L3R5cGU+CgkJPHR5cGUgbmFtZT0ic2VydmVyIj4KCQkJPHVzYWdlPueUqOS6juaMh+WumuWQhOS4qiBBUEkg55qE5pyN5Yqh5Zmo5Zyw5Z2APC91c2FnZT4KCQkJPGl0ZW0gbmFtZT0iQG5hbWUiIHR5cGU9InN0cmluZyIgYXJyYXk9ImZhbHNlIiByZXF1aXJlZD0idHJ1ZSI+5pyN5Yqh5ZSv5LiAIElEPC9pdGVtPgoJCQk8aXRlbSBuYW1lPSJAdXJsIiB0eXBlPSJzdHJpbmciIGFycmF5PSJmYWxzZSIgcmVxdWlyZWQ9InRydWUiPuacjeWKoeeahOWfuuWcsOWdgO+8jOS4juivpeacjeWKoeWFs+iBlOeahCBBUEnvvIzorr/pl67lnLDlnYDpg73mmK/nm7jlr7nkuo7mraTlnLDlnYDnmoTjgII8L2l0ZW0+CgkJCTxpdGVtIG5hbWU9IkBkZXByZWNhdGVkIiB0eXBlPSJ2ZXJzaW9uIiBhcnJheT0iZmFsc2UiIHJlcXVpcmVkPSJmYWxzZSI+5pyN5Yqh5Zyo5aSn5LqO6K+l54mI5pys5pe26KKr5byD55SoPC9pdGVtPgoJCQk8aXRlbSBuYW1lPSJAc3VtbWFyeSIgdHlwZT0ic3RyaW5nIiBhcnJheT0iZmFsc2UiIHJlcXVpcmVkPSJmYWxzZSI+5pyN5Yqh55qE5pGY6KaB5L+h5oGvPC9pdGVtPgoJCQk8aXRlbSBuYW1lPSJkZXNjcmlwdGlvbiIgdHlwZT0icmljaHRleHQiIGFycmF5PSJmYWxzZSIgcmVxdWlyZWQ9ImZhbHNlIj7mnI3liqHnmoTor6bnu4bmj4/ov7A8L2l0ZW0+CgkJPC90eXBlPgoJCTx0eXBlIG5hbWU9InR5cGVkZWYiPgoJCQk8dXNhZ2U+5Y+v5aSN55So55qE57G75Z6L5a6a5LmJPC91c2FnZT4KCQkJPGl0ZW0gbmFtZT0iQG1pbiIgdHlwZT0ibnVtYmVyIiBhcnJheT0iZmFsc2UiIHJlcXVpcmVkPSJmYWxzZSI+5pWw5YC855qE5pyA5bCP5YC877yM5LuF5L2c55So5LqOIDx2YXI+bnVtYmVyPC92YXI+IOexu+Wei+OAgjwvaXRlbT4KCQkJPGl0ZW0gbmFtZT0iQG1heCIgdHlwZT0ibnVtYmVyIiBhcnJheT0iZmFsc2UiIHJlcXVpcmVkPSJmYWxzZSI+5pWw5YC855qE5pyA5aSn5YC877yM5LuF5L2c55So5LqOIDx2YXI+bnVtYmVyPC92YXI+IOexu+Wei+OAgjwvaXRlbT4KCQkJPGl0ZW0gbmFtZT0iQG1pbi1sZW5ndGgiIHR5cGU9Im51bWJlciIgYXJyYXk9ImZhbHNlIiByZXF1aXJlZD0iZmFsc2UiPuWtl+espuS4sueahOacgOWwj+mVv+W6pu+8jOS7heS9nOeUqOS6jiA8dmFyPnN0cmluZzwvdmFyPiDnsbvlnovjgII8L2l0ZW0+CgkJCTxpdGVtIG5hbWU9IkBtYXgtbGVuZ3RoIiB0eXBlPSJudW1iZXIiIGFycmF5PSJmYWxzZSIgcmVxdWlyZWQ9ImZhbHNlIj7lrZfnrKbkuLLnmoTmnIDlpKfplb/luqbvvIzku4XkvZznlKjkuo4gPHZhcj5zdHJpbmc8L3Zhcj4g57G75Z6L44CCPC9pdGVtPgoJCQk8aXRlbSBuYW1lPSJAcGF0dGVybiIgdHlwZT0ic3RyaW5nIiBhcnJheT0iZmFsc2UiIHJlcXVpcmVkPSJmYWxzZSI+5a2X56ym5Liy6ZyA6KaB5Yy56YWN55qE5q2j5YiZ6KGo6L6+5byP77yM5LuF5L2c55So5LqOIDx2YXI+c3RyaW5nPC92YXI+IOexu+Wei+OAgjwvaXRlbT4KCQkJPGl0ZW0gbmFtZT0iQG1pbi1pdGVtcyIgdHlwZT0ibnVtYmVyIiBhcnJheT0iZmFsc2UiIHJlcXVpcmVkPSJmYWxzZSI+5pWw57uE55qE5pyA5bCP6ZW/5bqm77yM5LuF5ZyoIDxjb2RlPkBhcnJheTwvY29kZT4g5Li6IDx2YXI+dHJ1ZTwvdmFyPiDml7bmnInmlYjjgII8L2l0ZW0+CgkJCTxpdGVtIG5hbWU9IkBtYXgtaXRlbXMiIHR5cGU9Im51bWJlciIgYXJyYXk9ImZhbHNlIiByZXF1aXJlZD0iZmFsc2UiPuaVsOe7hOeahOacgOWkp+mVv+W6pu+8jOS7heWcqCA8Y29kZT5AYXJyYXk8L2NvZGU+IOS4uiA8dmFyPnRydWU8L3Zhcj4g5pe25pyJ5pWI44CCPC9pdGVtPgoJCQk8aXRlbSBuYW1lPSJAbmFtZSIgdHlwZT0ic3RyaW5nIiBhcnJheT0iZmFsc2UiIHJlcXVpcmVkPSJ0cnVlIj7nsbvlnovnmoTllK/kuIDlkI3np7DvvIzlvJXnlKjml7bpnIDopoHliqDkuIogPHNhbXA+Izwvc2FtcD4g5YmN57yA44CCPC9pdGVtPgoJCQk8aXRlbSBuYW1lPSJAdHlwZSIgdHlwZT0idHlwZSIgYXJyYXk9ImZhbHNlIiByZXF1aXJlZD0idHJ1ZSI+5YC855qE57G75Z6LPC9pdGVtPgoJCQk8aXRlbSBuYW1lPSJAZGVwcmVjYXRlZCIgdHlwZT0idmVyc2lvbiIgYXJyYXk9ImZhbHNlIiByZXF1aXJlZD0iZmFsc2UiPuihqOekuuWcqOWkp+S6juetieS6juivpeeJiOacrOWPt+aXtuS4jeWGjeWQr+S9nOeUqDwvaXRlbT4KCQkJPGl0ZW0gbmFtZT0iQHN1bW1hcnkiIHR5cGU9InN0cmluZyIgYXJyYXk9ImZhbHNlIiByZXF1aXJlZD0iZmFsc2UiPueugOimgeS7i+e7jTwvaXRlbT4KCQkJPGl0ZW0gbmFtZT0iQGRpc2NyaW1pbmF0b3IiIHR5cGU9InN0cmluZyIgYXJyYXk9ImZhbHNlIiByZXF1aXJlZD0iZmFsc2UiPueUqOS6juWMuuWIhiBvbmUtb2Yg5YiG5pSv55qE5a2X5q615ZCN77yM6K+l5a2X5q6155qE5YC85Y2z5Li65YiG5pSv55qEIG5hbWUg5YC844CCPC9pdGVtPgoJCQk8aXRlbSBuYW1lPSJwYXJhbSIgdHlwZT0icGFyYW0iIGFycmF5PSJ0cnVlIiByZXF1aXJlZD0iZmFsc2UiPuWtkOexu+Wei++8jOavlOWmguWvueixoeeahOWtkOWFg+e0oOOAgjwvaXRlbT4KCQkJPGl0ZW0gbmFtZT0iZW51bSIgdHlwZT0iZW51bSIgYXJyYXk9InRydWUiIHJlcXVpcmVkPSJmYWxzZSI+5b2T5YmN57G75Z6L5Y+v55So55qE5p6a5Li+5YC8PC9pdGVtPgoJCQk8aXRlbSBuYW1lPSJkZXNjcmlwdGlvbiIgdHlwZT0icmljaHRleHQiIGFycmF5PSJmYWxzZSIgcmVxdWlyZWQ9ImZhbHNlIj7or6bnu4bku4vnu43vvIzkuLogSFRNTCDlhoXlrrnjgII8L2l0ZW0+CgkJCTxpdGVtIG5hbWU9Im9uZS1vZiIgdHlwZT0icGFyYW0iIGFycmF5PSJ0cnVlIiByZXF1aXJlZD0iZmFsc2UiPuiBlOWQiOexu+Wei+eahOWQhOS4quWIhuaUr++8jOWPquiDveWMuemFjeWFtuS4reS5i+S4gO+8jOS4jeiDveS4jiBhbnktb2Yg5ZCM5pe25L2/55So44CCPC9pdGVtPgoJCQk8aXRlbSBuYW1lPSJhbnktb2YiIHR5cGU9InBhcmFtIiBhcnJheT0idHJ1ZSIgcmVxdWlyZWQ9ImZhbHNlIj7ogZTlkIjnsbvlnovnmoTlkITkuKrliIbmlK/vvIzoh7PlsJHljLnphY3lhbbkuK3kuYvkuIDvvIzkuI3og73kuI4gb25lLW9mIOWQjOaXtuS9v+eUqOOAgjwvaXRlbT4KCQk8L3R5cGU+CgkJPHR5cGUgbmFtZT0icGFyYW0iPgoJCQk8dXNhZ2U+5Y+C5pWw57G75Z6L77yM5Z+65pys5LiK5Y+v5Lul5L2c5Li6IHJlcXVlc3Qg55qE5a2Q6ZuG5L2/55So44CCPC91c2FnZT4KCQkJPGl0ZW0gbmFtZT0iQHhtbC1hdHRyIiB0eXBlPSJib29sIiBhcnJheT0iZmFsc2UiIHJlcXVpcmVkPSJmYWxzZSI+5piv5ZCm5L2c5Li654i25YWD57Sg55qE5bGe5oCn77yM5LuF5L2c55So5LqOIFhNTCDlhYPntKDjgILmmK/lkKbkvZzkuLrniLblhYPntKDnmoTlsZ7mgKfvvIzku4XnlKjkuo4gWE1MIOeahOivt+axguOAgjwvaXRlbT4KCQkJPGl0ZW0gbmFtZT0iQHhtbC1leHRyYWN0IiB0eXBlPSJib29sIiBhcnJheT0iZmFsc2UiIHJlcXVpcmVkPSJmYWxzZSI+5bCG5b2T5YmN5YWD57Sg55qE5YaF5a655L2c5Li654i25YWD57Sg55qE5YaF5a6577yM6KaB5rGC54i25YWD57Sg5b+F6aG75Li6IDx2YXI+b2JqZWN0PC92YXI+44CCPC9pdGVtPgoJCQk8aXRlbSBuYW1lPSJAeG1sLWNkYXRhIiB0eXBlPSJib29sIiBhcnJheT0iZmFsc2UiIHJlcXVpcmVkPSJmYWxzZSI+5b2T5YmN5YaF5a655Li6IENEQVRB77yM5LiOIDxjb2RlPkB4bWwtYXR0cjwvY29kZT4g5LqS5pal44CCPC9pdGVtPgoJCQk8aXRlbSBuYW1lPSJAeG1sLW5zLXByZWZpeCIgdHlwZT0ic3RyaW5nIiBhcnJheT0iZmFsc2UiIHJlcXVpcmVkPSJmYWxzZSI+WE1MIOagh+etvueahOWRveWQjeepuumXtOWQjeensOWJjee8gDwvaXRlbT4KCQkJPGl0ZW0gbmFtZT0iQHhtbC13cmFwcGVkIiB0eXBlPSJzdHJpbmciIGFycmF5PSJmYWxzZSIgcmVxdWlyZWQ9ImZhbHNlIj7lpoLmnpzlvZPliY3lhYPntKDnmoQgPGNvZGU+QGFycmF5PC9jb2RlPiDkuLogPHZhcj50cnVlPC92YXI+77yM5YiZ5Y+v5Lul6YCa6L+H5q2k5YC85oyH5a6a5ZyoIFhNTCDmoLzlvI/kuK3nmoTlkI3np7DjgIIKCeWPr+S7peacieS4ieenjeagvOW8j++8mjx1bD4KCTxsaT48c2FtcD5uYW1lPC9zYW1wPu+8muihqOekuuS4uuaVsOe7hOa3u+WKoOS4gOS4queItuWFg+e0oOWQjeensOS4uiA8dmFyPm5hbWU8L3Zhcj7vvJs8L2xpPgoJPGxpPjxzYW1wPm5hbWUxJmd0O25hbWUyPC9zYW1wPu+8muihqOekuuaVsOe7hOmhueeahOWQjeensOaUueS4uiA8dmFyPm5hbWUyPC92YXI+77yM5LiU5re75Yqg5LiA5Liq54i25YWD57Sg5ZCN5Li6IDx2YXI+bmFtZTE8L3Zhcj7vvJs8L2xpPgoJPGxpPjxzYW1wPiZndDtuYW1lPC9zYW1wPu+8muihqOekuuWwhuW9k+WJjeaVsOe7hOWFg+e0oOeahOWQjeensOaUueS4uiA8dmFyPm5hbWU8L3Zhcj7vvJs8L2xpPgoJPC91bD48L2l0ZW0+CgkJCTxpdGVtIG5hbWU9IkBtaW4iIHR5cGU9Im51bWJlciIgYXJyYXk9ImZhbHNlIiByZXF1aXJlZD0iZmFsc2UiPuaVsOWAvOeahOacgOWwj+WAvO+8jOS7heS9nOeUqOS6jiA8dmFyPm51bWJlcjwvdmFyPiDnsbvlnovjgII8L2l0ZW0+CgkJCTxpdGVtIG5hbWU9IkBtYXgiIHR5cGU9Im51bWJlciIgYXJyYXk9ImZhbHNlIiByZXF1aXJlZD0iZmFsc2UiPuaVsOWAvOeahOacgOWkp+WAvO+8jOS7heS9nOeUqOS6jiA8dmFyPm51bWJlcjwvdmFyPiDnsbvlnovjgII8L2l0ZW0+CgkJCTxpdGVtIG5hbWU9IkBtaW4tbGVuZ3RoIiB0eXBlPSJudW1iZXIiIGFycmF5PSJmYWxzZSIgcmVxdWlyZWQ9ImZhbHNlIj7lrZfnrKbkuLLnmoTmnIDlsI/plb/luqbvvIzku4XkvZznlKjkuo4gPHZhcj5zdHJpbmc8L3Zhcj4g57G75Z6L44CCPC9pdGVtPgoJCQk8aXRlbSBuYW1lPSJAbWF4LWxlbmd0aCIgdHlwZT0ibnVtYmVyIiBhcnJheT0iZmFsc2UiIHJlcXVpcmVkPSJmYWxzZSI+5a2X56ym5Liy55qE5pyA5aSn6ZW/5bqm77yM5LuF5L2c55So5LqOIDx2YXI+c3RyaW5nPC92YXI+IOexu+Wei+OAgjwvaXRlbT4KCQkJPGl0ZW0gbmFtZT0iQHBhdHRlcm4iIHR5cGU9InN0cmluZyIgYXJyYXk9ImZhbHNlIiByZXF1aXJlZD0iZmFsc2UiPuWtl+espuS4sumcgOimgeWMuemFjeeahOato+WImeihqOi+vuW8j++8jOS7heS9nOeUqOS6jiA8dmFyPnN0cmluZzwvdmFyPiDnsbvlnovjgII8L2l0ZW0+CgkJCTxpdGVtIG5hbWU9IkBtaW4taXRlbXMiIHR5cGU9Im51bWJlciIgYXJyYXk9ImZhbHNlIiByZXF1aXJlZD0iZmFsc2UiPuaVsOe7hOeahOacgOWwj+mVv+W6pu+8jOS7heWcqCA8Y29kZT5AYXJyYXk8L2NvZGU+IOS4uiA8dmFyPnRydWU8L3Zhcj4g5pe25pyJ5pWI44CCPC9pdGVtPgoJCQk8aXRlbSBuYW1lPSJAbWF4LWl0ZW1zIiB0eXBlPSJudW1iZXIiIGFycmF5PSJmYWxzZSIgcmVxdWlyZWQ9ImZhbHNlIj7mlbDnu4TnmoTmnIDlpKfplb/luqbvvIzku4XlnKggPGNvZGU+QGFycmF5PC9jb2RlPiDkuLogPHZhcj50cnVlPC92YXI+IOaXtuacieaViOOAgjwvaXRlbT4KCQkJPGl0ZW0gbmFtZT0iQG5hbWUiIHR5cGU9InN0cmluZyIgYXJyYXk9ImZhbHNlIiByZXF1aXJlZD0idHJ1ZSI+5YC855qE5ZCN56ewPC9pdGVtPgoJCQk8aXRlbSBuYW1lPSJAdHlwZSIgdHlwZT0idHlwZSIgYXJyYXk9ImZhbHNlIiByZXF1aXJlZD0idHJ1ZSI+5YC855qE57G75Z6LPC9pdGVtPgoJCQk8aXRlbSBuYW1lPSJAZGVwcmVjYXRlZCIgdHlwZT0idmVyc2lvbiIgYXJyYXk9ImZhbHNlIiByZXF1aXJlZD0iZmFsc2UiPuihqOekuuWcqOWkp+S6juetieS6juivpeeJiOacrOWPt+aXtuS4jeWGjeWQr+S9nOeUqDwvaXRlbT4KCQkJPGl0ZW0gbmFtZT0iQGRlZmF1bHQiIHR5cGU9InN0cmluZyIgYXJyYXk9ImZhbHNlIiByZXF1aXJlZD0iZmFsc2UiPum7mOiupOWAvDwvaXRlbT4KCQkJPGl0ZW0gbmFtZT0iQG9wdGlvbmFsIiB0eXBlPSJib29sIiBhcnJheT0iZmFsc2UiIHJlcXVpcmVkPSJmYWxzZSI+5piv5ZCm5Li65Y+v6YCJ55qE5Y+C5pWwPC9pdGVtPgoJCQk8aXRlbSBuYW1lPSJAbnVsbGFibGUiIHR5cGU9ImJvb2wiIGFycmF5PSJmYWxzZSIgcmVxdWlyZWQ9ImZhbHNlIj7mmK/lkKblj6/ku6XkuLogbnVsbO+8jOS7heWvuSBKU09OIOetieaUr+aMgSBudWxsIOWAvOeahOagvOW8j+acieaViOOAguWmguaenOaYr+aVsOe7hO+8jOihqOekuuaVsOe7hOacrOi6q+WPr+S7peS4uiBudWxs77yM6ICM5LiN5piv5YW25YWD57Sg44CCPC9pdGVtPgoJCQk8aXRlbSBuYW1lPSJAYXJyYXkiIHR5cGU9ImJvb2wiIGFycmF5PSJmYWxzZSIgcmVxdWlyZWQ9ImZhbHNlIj7mmK/lkKbkuLrmlbDnu4Q8L2l0ZW0+CgkJCTxpdGVtIG5hbWU9IkBzdW1tYXJ5IiB0eXBlPSJzdHJpbmciIGFycmF5PSJmYWxzZSIgcmVxdWlyZWQ9ImZhbHNlIj7nroDopoHku4vnu408L2l0ZW0+CgkJCTxpdGVtIG5hbWU9IkBkaXNjcmltaW5hdG9yIiB0eXBlPSJzdHJpbmciIGFycmF5PSJmYWxzZSIgcmVxdWlyZWQ9ImZhbHNlIj7nlKjkuo7ljLrliIYgb25lLW9mIOWIhuaUr+eahOWtl+auteWQje+8jOivpeWtl+auteeahOWAvOWNs+S4uuWIhuaUr+eahCBuYW1lIOWAvOOAgjwvaXRlbT4KCQkJPGl0ZW0gbmFtZT0iQGFycmF5LXN0eWxlIiB0eXBlPSJib29sIiBhcnJheT0iZmFsc2UiIHJlcXVpcmVkPSJmYWxzZSI+5Lul5pWw57uE55qE5pa55byP5bGV56S65pWw5o2uPC9pdGVtPgoJCQk8aXRlbSBuYW1lPSJwYXJhbSIgdHlwZT0icGFyYW0iIGFycmF5PSJ0cnVlIiByZXF1aXJlZD0iZmFsc2UiPuWtkOexu+Wei++8jOavlOWmguWvueixoeeahOWtkOWFg+e0oOOAgjwvaXRlbT4KCQkJPGl0ZW0gbmFtZT0iZW51bSIgdHlwZT0iZW51bSIgYXJyYXk9InRydWUiIHJlcXVpcmVkPSJmYWxzZSI+5b2T5YmN5Y+C5pWw5Y+v55So55qE5p6a5Li+5YC8PC9pdGVtPgoJCQk8aXRlbSBuYW1lPSJkZXNjcmlwdGlvbiIgdHlwZT0icmljaHRleHQiIGFycmF5PSJmYWxzZSIgcmVxdWlyZWQ9ImZhbHNlIj7or6bnu4bku4vnu43vvIzkuLogSFRNTCDlhoXlrrnjgII8L2l0ZW0+CgkJCTxpdGVtIG5hbWU9Im9uZS1vZiIgdHlwZT0icGFyYW0iIGFycmF5PSJ0cnVlIiByZXF1aXJlZD0iZmFsc2UiPuiBlOWQiOexu+Wei+eahOWQhOS4quWIhuaUr++8jOWPquiDveWMuemFjeWFtuS4reS5i+S4gO+8jOS4jeiDveS4jiBhbnktb2Yg5ZCM5pe25L2/55So44CCPC9pdGVtPgoJCQk8aXRlbSBuYW1lPSJhbnktb2YiIHR5cGU9InBhcmFtIiBhcnJheT0idHJ1ZSIgcmVxdWlyZWQ9ImZhbHNlIj7ogZTlkIjnsbvlnovnmoTlkITkuKrliIbmlK/vvIzoh7PlsJHljLnphY3lhbbkuK3kuYvkuIDvvIzkuI3og73kuI4gb25lLW9mIOWQjOaXtuS9v+eUqOOAgjwvaXRlbT4KCQk8L3R5cGU+CgkJPHR5cGUgbmFtZT0iZW51bSI+CgkJCTx1c2FnZT7lrprkuYnmnprkuL7nsbvlnovnmoTmlbDmiYDnmoTmnprkuL7lgLw8L3VzYWdlPgoJCQk8aXRlbSBuYW1lPSJAZGVwcmVjYXRlZCIgdHlwZT0idmVyc2lvbiIgYXJyYXk9ImZhbHNlIiByZXF1aXJlZD0iZmFsc2UiPuivpeWxnuaAp+W8g+eUqOeahOeJiOacrOWPtzwvaXRlbT4KCQkJPGl0ZW0gbmFtZT0iQHZhbHVlIiB0eXBlPSJzdHJpbmciIGFycmF5PSJmYWxzZSIgcmVxdWlyZWQ9InRydWUiPuaemuS4vuWAvDwvaXRlbT4KCQkJPGl0ZW0gbmFtZT0iQHN1bW1hcnkiIHR5cGU9InN0cmluZyIgYXJyYXk9ImZhbHNlIiByZXF1aXJlZD0iZmFsc2UiPuaemuS4vuWAvOeahOivtOaYjjwvaXRlbT4KCQkJPGl0ZW0gbmFtZT0iZGVzY3JpcHRpb24iIHR5cGU9InJpY2h0ZXh0IiBhcnJheT0iZmFsc2UiIHJlcXVpcmVkPSJmYWxzZSI+5p6a5Li+5YC855qE6K+m57uG6K+05piOPC9pdGVtPgoJCTwvdHlwZT4KCQk8dHlwZSBuYW1lPSJzZWN1cml0eSI+CgkJCTx1c2FnZT7lrprkuYnouqvku73pqozor4HnmoTmlrnlvI88L3VzYWdlPgoJCQk8aXRlbSBuYW1lPSJAbmFtZSIgdHlwZT0ic3RyaW5nIiBhcnJheT0iZmFsc2UiIHJlcXVpcmVkPSJ0cnVlIj7ouqvku73pqozor4HmlrnlvI/nmoTllK/kuIAgSUQ8L2l0ZW0+CgkJCTxpdGVtIG5hbWU9IkB0eXBlIiB0eXBlPSJzdHJpbmciIGFycmF5PSJmYWxzZSIgcmVxdWlyZWQ9InRydWUiPui6q+S7vemqjOivgeeahOexu+Wei++8jOWPr+S7peaYryA8dmFyPmFwaWtleTwvdmFyPuOAgTx2YXI+aHR0cDwvdmFyPuOAgTx2YXI+b2F1dGgyPC92YXI+IOWSjCA8dmFyPm9wZW5pZGNvbm5lY3Q8L3Zhcj7jgII8L2l0ZW0+CgkJCTxpdGVtIG5hbWU9IkBzdW1tYXJ5IiB0eXBlPSJzdHJpbmciIGFycmF5PSJmYWxzZSIgcmVxdWlyZWQ9ImZhbHNlIj7nroDopoHku4vnu408L2l0ZW0+CgkJCTxpdGVtIG5hbWU9IkBpbiIgdHlwZT0ic3RyaW5nIiBhcnJheT0iZmFsc2UiIHJlcXVpcmVkPSJmYWxzZSI+PHZhcj5hcGlrZXk8L3Zhcj4g5omA5Zyo55qE5L2N572u77yM5Y+v5Lul5pivIDx2YXI+aGVhZGVyPC92YXI+44CBPHZhcj5xdWVyeTwvdmFyPiDlkowgPHZhcj5jb29raWU8L3Zhcj7jgII8L2l0ZW0+CgkJCTxpdGVtIG5hbWU9IkBrZXkiIHR5cGU9InN0cmluZyIgYXJyYXk9ImZhbHNlIiByZXF1aXJlZD0iZmFsc2UiPjx2YXI+YXBpa2V5PC92YXI+IOWvueW6lOeahOWPguaVsOWQjeensDwvaXRlbT4KCQkJPGl0ZW0gbmFtZT0iQHNjaGVtZSIgdHlwZT0ic3RyaW5nIiBhcnJheT0iZmFsc2UiIHJlcXVpcmVkPSJmYWxzZSI+PHZhcj5odHRwPC92YXI+IOmqjOivgeaWueW8j+eahOWQjeensO+8jOavlOWmgiA8dmFyPmJhc2ljPC92YXI+44CBPHZhcj5iZWFyZXI8L3Zhcj4g562J44CCPC9pdGVtPgoJCQk8aXRlbSBuYW1lPSJAYmVhcmVyLWZvcm1hdCIgdHlwZT0ic3RyaW5nIiBhcnJheT0iZmFsc2UiIHJlcXVpcmVkPSJmYWxzZSI+PHZhcj5iZWFyZXI8L3Zhcj4g5Luk54mM55qE5qC85byP77yM5q+U5aaCIDx2YXI+SldUPC92YXI+44CCPC9pdGVtPgoJCQk8aXRlbSBuYW1lPSJAb3BlbmlkLWNvbm5lY3QtdXJsIiB0eXBlPSJzdHJpbmciIGFycmF5PSJmYWxzZSIgcmVxdWlyZWQ9ImZhbHNlIj48dmFyPm9wZW5pZGNvbm5lY3Q8L3Zhcj4g55qE6YWN572u5Zyw5Z2APC9pdGVtPgoJCQk8aXRlbSBuYW1lPSJkZXNjcmlwdGlvbiIgdHlwZT0icmljaHRleHQiIGFycmF5PSJmYWxzZSIgcmVxdWlyZWQ9ImZhbHNlIj7or6bnu4bku4vnu43vvIzkuLogSFRNTCDlhoXlrrnjgII8L2l0ZW0+CgkJCTxpdGVtIG5hbWU9ImZsb3ciIHR5cGU9Im9hdXRoLWZsb3ciIGFycmF5PSJ0cnVlIiByZXF1aXJlZD0iZmFsc2UiPjx2YXI+b2F1dGgyPC92YXI+IOaUr+aMgeeahOaOiOadg+a1geeoizwvaXRlbT4KCQk8L3R5cGU+CgkJPHR5cGUgbmFtZT0ib2F1dGgtZmxvdyI+CgkJCTx1c2FnZT48dmFyPm9hdXRoMjwvdmFyPiDnmoTmjojmnYPmtYHnqIs8L3VzYWdlPgoJCQk8aXRlbSBuYW1lPSJAdHlwZSIgdHlwZT0ic3RyaW5nIiBhcnJheT0iZmFsc2UiIHJlcXVpcmVkPSJ0cnVlIj7mjojmnYPmtYHnqIvnmoTnsbvlnovvvIzlj6/ku6XmmK8gPHZhcj5pbXBsaWNpdDwvdmFyPuOAgTx2YXI+cGFzc3dvcmQ8L3Zhcj7jgIE8dmFyPmNsaWVudC1jcmVkZW50aWFsczwvdmFyPiDlkowgPHZhcj5hdXRob3JpemF0aW9uLWNvZGU8L3Zhcj7jgII8L2l0ZW0+CgkJCTxpdGVtIG5hbWU9IkBhdXRob3JpemF0aW9uLXVybCIgdHlwZT0ic3RyaW5nIiBhcnJheT0iZmFsc2UiIHJlcXVpcmVkPSJmYWxzZSI+5o6I5p2D5Zyw5Z2A77yMPHZhcj5pbXBsaWNpdDwvdmFyPiDlkowgPHZhcj5hdXRob3JpemF0aW9uLWNvZGU8L3Zhcj4g5b+F6aG75oyH5a6a44CCPC9pdGVtPgoJCQk8aXRlbSBuYW1lPSJAdG9rZW4tdXJsIiB0eXBlPSJzdHJpbmciIGFycmF5PSJmYWxzZSIgcmVxdWlyZWQ9ImZhbHNlIj7ojrflj5bku6TniYznmoTlnLDlnYDvvIw8dmFyPnBhc3N3b3JkPC92YXI+44CBPHZhcj5jbGllbnQtY3JlZGVudGlhbHM8L3Zhcj4g5ZKMIDx2YXI+YXV0aG9yaXphdGlvbi1jb2RlPC92YXI+IOW/hemhu+aMh+WumuOAgjwvaXRlbT4KCQkJPGl0ZW0gbmFtZT0iQHJlZnJlc2gtdXJsIiB0eXBlPSJzdHJpbmciIGFycmF5PSJmYWxzZSIgcmVxdWlyZWQ9ImZhbHNlIj7liLfmlrDku6TniYznmoTlnLDlnYA8L2l0ZW0+CgkJCTxpdGVtIG5hbWU9InNjb3BlIiB0eXBlPSJzY29wZSIgYXJyYXk9InRydWUiIHJlcXVpcmVkPSJmYWxzZSI+5Y+v55So55qE5p2D6ZmQ6IyD5Zu0PC9pdGVtPgoJCTwvdHlwZT4KCQk8dHlwZSBuYW1lPSJzY29wZSI+CgkJCTx1c2FnZT7mnYPpmZDojIPlm7Q8L3VzYWdlPgoJCQk8aXRlbSBuYW1lPSJAbmFtZSIgdHlwZT0ic3RyaW5nIiBhcnJheT0iZmFsc2UiIHJlcXVpcmVkPSJ0cnVlIj7mnYPpmZDojIPlm7TnmoTlkI3np7A8L2l0ZW0+CgkJCTxpdGVtIG5hbWU9IkBzdW1tYXJ5IiB0eXBlPSJzdHJpbmciIGFycmF5PSJmYWxzZSIgcmVxdWlyZWQ9InRydWUiPueugOimgeS7i+e7jTwvaXRlbT4KCQk8L3R5cGU+CgkJPHR5cGUgbmFtZT0iYXBpIj4KCQkJPHVzYWdlPueUqOS6juWumuS5ieWNleS4qiBBUEkg5o6l5Y+j55qE5YW35L2T5YaF5a65PC91c2FnZT4KCQkJPGl0ZW0gbmFtZT0iQHZlcnNpb24iIHR5cGU9InZlcnNpb24iIGFycmF5PSJmYWxzZSIgcmVxdWlyZWQ9ImZhbHNlIj7ooajnpLrmraTmjqXlj6PlnKjor6XniYjmnKzkuK3mt7vliqA8L2l0ZW0+CgkJCTxpdGVtIG5hbWU9IkBtZXRob2QiIHR5cGU9InN0cmluZyIgYXJyYXk9ImZhbHNlIiByZXF1aXJlZD0idHJ1ZSI+5b2T5YmN5o6l5Y+j5omA5pSv5oyB55qE6K+35rGC5pa55rOVPC9pdGVtPgoJCQk8aXRlbSBuYW1lPSJAaWQiIHR5cGU9InN0cmluZyIgYXJyYXk9ImZhbHNlIiByZXF1aXJlZD0iZmFsc2UiPuaOpeWPo+eahOWUr+S4gCBJRDwvaXRlbT4KCQkJPGl0ZW0gbmFtZT0iQHN1bW1hcnkiIHR5cGU9InN0cmluZyIgYXJyYXk9ImZhbHNlIiByZXF1aXJlZD0iZmFsc2UiPueugOimgeS7i+e7jTwvaXRlbT4KCQkJPGl0ZW0gbmFtZT0iQGRlcHJlY2F0ZWQiIHR5cGU9InZlcnNpb24iIGFycmF5PSJmYWxzZSIgcmVxdWlyZWQ9ImZhbHNlIj7lnKjmraTniYjmnKzkuYvlkI7lsIbkvJrooqvlvIPnlKg8L2l0ZW0+CgkJCTxpdGVtIG5hbWU9InBhdGgiIHR5cGU9InBhdGgiIGFycmF5PSJmYWxzZSIgcmVxdWlyZWQ9InRydWUiPuWumuS5iei3r+W+hOS/oeaBrzwvaXRlbT4KCQkJPGl0ZW0gbmFtZT0iZGVzY3JpcHRpb24iIHR5cGU9InJpY2h0ZXh0IiBhcnJheT0iZmFsc2UiIHJlcXVpcmVkPSJmYWxzZSI+6K+l5o6l5Y+j55qE6K+m57uG5LuL57uN77yM5Li6IEhUTUwg5YaF5a6544CCPC9pdGVtPgoJCQk8aXRlbSBuYW1lPSJyZXF1ZXN0IiB0eXBlPSJyZXF1ZXN0IiBhcnJheT0idHJ1ZSIgcmVxdWlyZWQ9ImZhbHNlIj7lrprkuYnlj6/nlKjnmoTor7fmsYLkv6Hmga88L2l0ZW0+CgkJCTxpdGVtIG5hbWU9InJlc3BvbnNlIiB0eXBlPSJyZXF1ZXN0IiBhcnJheT0idHJ1ZSIgcmVxdWlyZWQ9ImZhbHNlIj7lrprkuYnlj6/og73nmoTov5Tlm57kv6Hmga88L2l0ZW0+CgkJCTxpdGVtIG5hbWU9ImNhbGxiYWNrIiB0eXBlPSJjYWxsYmFjayIgYXJyYXk9ImZhbHNlIiByZXF1aXJlZD0iZmFsc2UiPuWumuS5ieWbnuiwg+aOpeWPo+WGheWuuTwvaXRlbT4KCQkJPGl0ZW0gbmFtZT0iaGVhZGVyIiB0eXBlPSJwYXJhbSIgYXJyYXk9InRydWUiIHJlcXVpcmVkPSJmYWxzZSI+5Lyg6YCS55qE5oql5aS05YaF5a6577yM5aaC5p6c5piv5p+Q5LiqIG1pbWV0eXBlIOS4k+eUqOeahO+8jOWPr+S7peaUvuWcqCByZXF1ZXN0IOWFg+e0oOS4reOAgjwvaXRlbT4KCQkJPGl0ZW0gbmFtZT0iY29va2llIiB0eXBlPSJwYXJhbSIgYXJyYXk9InRydWUiIHJlcXVpcmVkPSJmYWxzZSI+5Lyg6YCS55qEIGNvb2tpZSDlhoXlrrnvvIzku4XmlK/mjIHnroDljZXnsbvlnovjgII8L2l0ZW0+CgkJCTxpdGVtIG5hbWU9InRhZyIgdHlwZT0ic3RyaW5nIiBhcnJheT0idHJ1ZSIgcmVxdWlyZWQ9ImZhbHNlIj7lhbPogZTnmoTmoIfnrb48L2l0ZW0+CgkJCTxpdGVtIG5hbWU9InNlcnZlciIgdHlwZT0ic3RyaW5nIiBhcnJheT0idHJ1ZSIgcmVxdWlyZWQ9ImZhbHNlIj7lhbPogZTnmoTmnI3liqE8L2l0ZW0+CgkJCTxpdGVtIG5hbWU9InNlY3VyaXR5IiB0eXBlPSJzZWN1cml0eS12YWx1ZSIgYXJyYXk9InRydWUiIHJlcXVpcmVkPSJmYWxzZSI+6K6/6Zeu6K+l5o6l5Y+j6ZyA6KaB55qE6Lqr5Lu96aqM6K+B5pa55byP77yM5ruh6Laz5YW25Lit5Lu75oSP5LiA6aG55Y2z5Y+v44CCPC9pdGVtPgoJCTwvdHlwZT4KCQk8dHlwZSBuYW1lPSJwYXRoIj4KCQkJPHVzYWdlPueUqOS6juWumuS5ieivt+axguaXtuS4jui3r+W+hOebuOWFs+eahOWGheWuuTwvdXNhZ2U+CgkJCTxpdGVtIG5hbWU9IkBwYXRoIiB0eXBlPSJzdHJpbmciIGFycmF5PSJmYWxzZSIgcmVxdWlyZWQ9InRydWUiPuaOpeWPo+WcsOWdgDwvaXRlbT4KCQkJPGl0ZW0gbmFtZT0icGFyYW0iIHR5cGU9InBhcmFtIiBhcnJheT0idHJ1ZSIgcmVxdWlyZWQ9ImZhbHNlIj7lnLDlnYDkuK3nmoTlj4LmlbA8L2l0ZW0+CgkJCTxpdGVtIG5hbWU9InF1ZXJ5IiB0eXBlPSJwYXJhbSIgYXJyYXk9InRydWUiIHJlcXVpcmVkPSJmYWxzZSI+5Zyw5Z2A5Lit55qE5p+l6K+i5Y+C5pWwPC9pdGVtPgoJCTwvdHlwZT4KCQk8dHlwZSBuYW1lPSJyZXF1ZXN0Ij4KCQkJPHVzYWdlPuWumuS5ieS6huivt+axguWSjOi/lOWbnueahOebuOWFs+WGheWuuTwvdXNhZ2U+CgkJCTxpdGVtIG5hbWU9IkB4bWwtYXR0ciIgdHlwZT0iYm9vbCIgYXJyYXk9ImZhbHNlIiByZXF1aXJlZD0iZmFsc2UiPuaYr+WQpuS9nOS4uueItuWFg+e0oOeahOWxnuaAp++8jOS7heS9nOeUqOS6jiBYTUwg5YWD57Sg44CC5piv5ZCm5L2c5Li654i25YWD57Sg55qE5bGe5oCn77yM5LuF55So5LqOIFhNTCDnmoTor7fmsYLjgII8L2l0ZW0+CgkJCTxpdGVtIG5hbWU9IkB4bWwtZXh0cmFjdCIgdHlwZT0iYm9vbCIgYXJyYXk9ImZhbHNlIiByZXF1aXJlZD0iZmFsc2UiPuWwhuW9k+WJjeWFg+e0oOeahOWGheWuueS9nOS4uueItuWFg+e0oOeahOWGheWuue+8jOimgeaxgueItuWFg+e0oOW/hemhu+S4uiA8dmFyPm9iamVjdDwvdmFyPuOAgjwvaXRlbT4KCQkJPGl0ZW0gbmFtZT0iQHhtbC1jZGF0YSIgdHlwZT0iYm9vbCIgYXJyYXk9ImZhbHNlIiByZXF1aXJlZD0iZmFsc2UiPuW9k+WJjeWGheWuueS4uiBDREFUQe+8jOS4jiA8Y29kZT5AeG1sLWF0dHI8L2NvZGU+IOS6kuaWpeOAgjwvaXRlbT4KCQkJPGl0ZW0gbmFtZT0iQHhtbC1ucy1wcmVmaXgiIHR5cGU9InN0cmluZyIgYXJyYXk9ImZhbHNlIiByZXF1aXJlZD0iZmFsc2UiPlhNTCDmoIfnrb7nmoTlkb3lkI3nqbrpl7TlkI3np7DliY3nvIA8L2l0ZW0+CgkJCTxpdGVtIG5hbWU9IkB4bWwtd3JhcHBlZCIgdHlwZT0ic3RyaW5nIiBhcnJheT0iZmFsc2UiIHJlcXVpcmVkPSJmYWxzZSI+5aaC5p6c5b2T5YmN5YWD57Sg55qEIDxjb2RlPkBhcnJheTwvY29kZT4g5Li6IDx2YXI+dHJ1ZTwvdmFyPu+8jOWImeWPr+S7pemAmui/h+atpOWAvOaMh+WumuWcqCBYTUwg5qC85byP5Lit55qE5ZCN56ew44CCCgnlj6/ku6XmnInkuInnp43moLzlvI/vvJo8dWw+Cgk8bGk+PHNhbXA+bmFtZTwvc2FtcD7vvJrooajnpLrkuLrmlbDnu4Tmt7vliqDkuIDkuKrniLblhYPntKDlkI3np7DkuLogPHZhcj5uYW1lPC92YXI+77ybPC9saT4KCTxsaT48c2FtcD5uYW1lMSZndDtuYW1lMjwvc2FtcD7vvJrooajnpLrmlbDnu4TpobnnmoTlkI3np7DmlLnkuLogPHZhcj5uYW1lMjwvdmFyPu+8jOS4lOa3u+WKoOS4gOS4queItuWFg+e0oOWQjeS4uiA8dmFyPm5hbWUxPC92YXI+77ybPC9saT4KCTxsaT48c2FtcD4mZ3Q7bmFtZTwvc2FtcD7vvJrooajnpLrlsIblvZPliY3mlbDnu4TlhYPntKDnmoTlkI3np7DmlLnkuLogPHZhcj5uYW1lPC92YXI+77ybPC9saT4KCTwvdWw+PC9pdGVtPgoJCQk8aXRlbSBuYW1lPSJAbmFtZSIgdHlwZT0ic3RyaW5nIiBhcnJheT0iZmFsc2UiIHJlcXVpcmVkPSJmYWxzZSI+5b2TIG1pbWV0eXBlIOS4uiA8dmFyPmFwcGxpY2F0aW9uL3htbDwvdmFyPiDml7bvvIzmraTlgLzooajnpLogWE1MIOeahOmhtuWxguWFg+e0oOWQjeensO+8jOWQpuWImeaXoOeUqOOAgjwvaXRlbT4KCQkJPGl0ZW0gbmFtZT0iQHR5cGUiIHR5cGU9InR5cGUiIGFycmF5PSJmYWxzZSIgcmVxdWlyZWQ9ImZhbHNlIj7lgLznmoTnsbvlnos8L2l0ZW0+CgkJCTxpdGVtIG5hbWU9IkBkZXByZWNhdGVkIiB0eXBlPSJ2ZXJzaW9uIiBhcnJheT0iZmFsc2UiIHJlcXVpcmVkPSJmYWxzZSI+6KGo56S65Zyo5aSn5LqO562J5LqO6K+l54mI5pys5Y+35pe25LiN5YaN5ZCv5L2c55SoPC9pdGVtPgoJCQk8aXRlbSBuYW1lPSJAbnVsbGFibGUiIHR5cGU9ImJvb2wiIGFycmF5PSJmYWxzZSIgcmVxdWlyZWQ9ImZhbHNlIj7mmK/lkKblj6/ku6XkuLogbnVsbO+8jOS7heWvuSBKU09OIOetieaUr+aMgSBudWxsIOWAvOeahOagvOW8j+acieaViOOAguWmguaenOaYr+aVsOe7hO+8jOihqOekuuaVsOe7hOacrOi6q+WPr+S7peS4uiBudWxs77yM6ICM5LiN5piv5YW25YWD57Sg44CCPC9pdGVtPgoJCQk8aXRlbSBuYW1lPSJAYXJyYXkiIHR5cGU9ImJvb2wiIGFycmF5PSJmYWxzZSIgcmVxdWlyZWQ9ImZhbHNlIj7mmK/lkKbkuLrmlbDnu4Q8L2l0ZW0+CgkJCTxpdGVtIG5hbWU9IkBzdW1tYXJ5IiB0eXBlPSJzdHJpbmciIGFycmF5PSJmYWxzZSIgcmVxdWlyZWQ9ImZhbHNlIj7nroDopoHku4vnu408L2l0ZW0+CgkJCTxpdGVtIG5hbWU9IkBzdGF0dXMiIHR5cGU9Im51bWJlciIgYXJyYXk9ImZhbHNlIiByZXF1aXJlZD0iZmFsc2UiPueKtuaAgeeggeOAguWcqCByZXF1ZXN0IOS4re+8jOivpeWAvOS4jeWPr+eUqO+8jOWQpuWImeS4uuW/heWhq+mhueOAgjwvaXRlbT4KCQkJPGl0ZW0gbmFtZT0iQG1pbWV0eXBlIiB0eXBlPSJzdHJpbmciIGFycmF5PSJmYWxzZSIgcmVxdWlyZWQ9ImZhbHNlIj7lqpLkvZPnsbvlnovvvIzmr5TlpoIgPHZhcj5hcHBsaWNhdGlvbi9qc29uPC92YXI+IOetieOAgjwvaXRlbT4KCQkJPGl0ZW0gbmFtZT0iQGRpc2NyaW1pbmF0b3IiIHR5cGU9InN0cmluZyIgYXJyYXk9ImZhbHNlIiByZXF1aXJlZD0iZmFsc2UiPueUqOS6juWMuuWIhiBvbmUtb2Yg5YiG5pSv55qE5a2X5q615ZCN77yM6K+l5a2X5q6155qE5YC85Y2z5Li65YiG5pSv55qEIG5hbWUg5YC844CCPC9pdGVtPgoJCQk8aXRlbSBuYW1lPSJlbnVtIiB0eXBlPSJlbnVtIiBhcnJheT0idHJ1ZSIgcmVxdWlyZWQ9ImZhbHNlIj7lvZPliY3lj4LmlbDlj6/nlKjnmoTmnprkuL7lgLw8L2l0ZW0+CgkJCTxpdGVtIG5hbWU9InBhcmFtIiB0eXBlPSJwYXJhbSIgYXJyYXk9InRydWUiIHJlcXVpcmVkPSJmYWxzZSI+5a2Q57G75Z6L77yM5q+U5aaC5a+56LGh55qE5a2Q5YWD57Sg44CCPC9pdGVtPgoJCQk8aXRlbSBuYW1lPSJleGFtcGxlIiB0eXBlPSJleGFtcGxlIiBhcnJheT0idHJ1ZSIgcmVxdWlyZWQ9ImZhbHNlIj7npLrkvovku6PnoIE8L2l0ZW0+CgkJCTxpdGVtIG5hbWU9ImhlYWRlciIgdHlwZT0icGFyYW0iIGFycmF5PSJ0cnVlIiByZXF1aXJlZD0iZmFsc2UiPuS8oOmAkueahOaKpeWktOWGheWuuTwvaXRlbT4KCQkJPGl0ZW0gbmFtZT0iY29va2llIiB0eXBlPSJwYXJhbSIgYXJyYXk9InRydWUiIHJlcXVpcmVkPSJmYWxzZSI+5Lyg6YCS55qEIGNvb2tpZSDlhoXlrrnvvIzku4XmlK/mjIHnroDljZXnsbvlnovjgILlpoLmnpzmmK/ov5Tlm57lr7nosaHvvIzooajnpLrpnIDopoHorr7nva7nmoQgY29va2ll44CCPC9pdGVtPgoJCQk8aXRlbSBuYW1lPSJkZXNjcmlwdGlvbiIgdHlwZT0icmljaHRleHQiIGFycmF5PSJmYWxzZSIgcmVxdWlyZWQ9ImZhbHNlIj7or6bnu4bku4vnu43vvIzkuLogSFRNTCDlhoXlrrnjgII8L2l0ZW0+CgkJCTxpdGVtIG5hbWU9Im9uZS1vZiIgdHlwZT0icGFyYW0iIGFycmF5PSJ0cnVlIiByZXF1aXJlZD0iZmFsc2UiPuiBlOWQiOexu+Wei+eahOWQhOS4quWIhuaUr++8jOWPquiDveWMuemFjeWFtuS4reS5i+S4gO+8jOS4jeiDveS4jiBhbnktb2Yg5ZCM5pe25L2/55So44CCPC9pdGVtPgoJCQk8aXRlbSBuYW1lPSJhbnktb2YiIHR5cGU9InBhcmFtIiBhcnJheT0idHJ1ZSIgcmVxdWlyZWQ9ImZhbHNlIj7ogZTlkIjnsbvlnovnmoTlkITkuKrliIbmlK/vvIzoh7PlsJHljLnphY3lhbbkuK3kuYvkuIDvvIzkuI3og73kuI4gb25lLW9mIOWQjOaXtuS9v+eUqOOAgjwvaXRlbT4KCQk8L3R5cGU+CgkJPHR5cGUgbmFtZT0iZXhhbXBsZSI+CgkJCTx1c2FnZT7npLrkvovku6PnoIE8L3VzYWdlPgoJCQk8aXRlbSBuYW1lPSJAbWltZXR5cGUiIHR5cGU9InN0cmluZyIgYXJyYXk9ImZhbHNlIiByZXF1aXJlZD0idHJ1ZSI+54m55a6a5LqO57G75Z6L55qE56S65L6L5Luj56CBPC9pdGVtPgoJCQk8aXRlbSBuYW1lPSJAc3VtbWFyeSIgdHlwZT0ic3RyaW5nIiBhcnJheT0iZmFsc2UiIHJlcXVpcmVkPSJmYWxzZSI+56S65L6L5Luj56CB55qE5qaC6KaB5L+h5oGvPC9pdGVtPgoJCQk8aXRlbSBuYW1lPSIuIiB0eXBlPSJzdHJpbmciIGFycmF5PSJmYWxzZSIgcmVxdWlyZWQ9InRydWUiPuekuuS+i+S7o+eggeeahOWGheWuue+8jOmcgOimgeS9v+eUqCBDREFUQSDljIXlkKvku6PnoIHjgII8L2l0ZW0+CgkJPC90eXBlPgoJCTx0eXBlIG5hbWU9ImNhbGxiYWNrIj4KCQkJPHVzYWdlPuWumuS5ieaOpeWPo+eahOWbnuiwg+WGheWuuTwvdXNhZ2U+CgkJCTxpdGVtIG5hbWU9IkBtZXRob2QiIHR5cGU9InN0cmluZyIgYXJyYXk9ImZhbHNlIiByZXF1aXJlZD0idHJ1ZSI+5Zue6LCD55qE6K+35rGC5pa55rOVPC9pdGVtPgoJCQk8aXRlbSBuYW1lPSJAc3VtbWFyeSIgdHlwZT0ic3RyaW5nIiBhcnJheT0iZmFsc2UiIHJlcXVpcmVkPSJmYWxzZSI+566A6KaB5LuL57uNPC9pdGVtPgoJCQk8aXRlbSBuYW1lPSJAZGVwcmVjYXRlZCIgdHlwZT0idmVyc2lvbiIgYXJyYXk9ImZhbHNlIiByZXF1aXJlZD0iZmFsc2UiPuWcqOatpOeJiOacrOS5i+WQjuWwhuS8muiiq+W8g+eUqDwvaXRlbT4KCQkJPGl0ZW0gbmFtZT0icGF0aCIgdHlwZT0icGF0aCIgYXJyYXk9ImZhbHNlIiByZXF1aXJlZD0iZmFsc2UiPuWbnuiwg+eahOivt+axguWcsOWdgDwvaXRlbT4KCQkJPGl0ZW0gbmFtZT0iZGVzY3JpcHRpb24iIHR5cGU9InJpY2h0ZXh0IiBhcnJheT0iZmFsc2UiIHJlcXVpcmVkPSJmYWxzZSI+5a+55LqO5Zue6LCD55qE6K+m57uG5LuL57uNPC9pdGVtPgoJCQk8aXRlbSBuYW1lPSJyZXNwb25zZSIgdHlwZT0icmVxdWVzdCIgYXJyYXk9InRydWUiIHJlcXVpcmVkPSJmYWxzZSI+5a6a5LmJ5Y+v6IO955qE6L+U5Zue5L+h5oGvPC9pdGVtPgoJCQk8aXRlbSBuYW1lPSJyZXF1ZXN0IiB0eXBlPSJyZXF1ZXN0IiBhcnJheT0idHJ1ZSIgcmVxdWlyZWQ9InRydWUiPuWumuS5ieWPr+eUqOeahOivt+axguS/oeaBrzwvaXRlbT4KCQkJPGl0ZW0gbmFtZT0iaGVhZGVyIiB0eXBlPSJwYXJhbSIgYXJyYXk9InRydWUiIHJlcXVpcmVkPSJmYWxzZSI+5Lyg6YCS55qE5oql5aS05YaF5a65PC9pdGVtPgoJCTwvdHlwZT4KCQk8dHlwZSBuYW1lPSJzZWN1cml0eS12YWx1ZSI+CgkJCTx1c2FnZT7lvJXnlKggPHZhcj5hcGlkb2M8L3Zhcj4g5Lit5a6a5LmJ55qE6Lqr5Lu96aqM6K+B5pa55byPPC91c2FnZT4KCQkJPGl0ZW0gbmFtZT0iQG5hbWUiIHR5cGU9InN0cmluZyIgYXJyYXk9ImZhbHNlIiByZXF1aXJlZD0idHJ1ZSI+6Lqr5Lu96aqM6K+B5pa55byP55qEIElEPC9pdGVtPgoJCQk8aXRlbSBuYW1lPSJzY29wZSIgdHlwZT0ic3RyaW5nIiBhcnJheT0idHJ1ZSIgcmVxdWlyZWQ9ImZhbHNlIj7pnIDopoHnmoTmnYPpmZDojIPlm7TvvIzku4Xlr7kgPHZhcj5vYXV0aDI8L3Zhcj4g5ZKMIDx2YXI+b3BlbmlkY29ubmVjdDwvdmFyPiDmnInmlYjjgII8L2l0ZW0+CgkJPC90eXBlPgoJCTx0eXBlIG5hbWU9InN0cmluZyI+CgkJCTx1c2FnZT7mma7pgJrnmoTlrZfnrKbkuLLnsbvlnovvvIznibnmrorlrZfnrKbpnIDopoHkvb/nlKggWE1MIOWunuS9k++8jOavlOWmgiA8c2FtcD4mbHQ7PC9zYW1wPiDpnIDopoHkvb/nlKggPHNhbXA+JmFtcDtsdDs8L3NhbXA+IOS7o+abv+OAgjwvdXNhZ2U+CgkJPC90eXBlPgoJCTx0eXBlIG5hbWU9ImRhdGUiPgoJCQk8dXNhZ2U+6YeH55SoIDxhIGhyZWY9Imh0dHBzOi8vdG9vbHMuaWV0Zi5vcmcvaHRtbC9yZmMzMzM5Ij5SRkMzMzM5PC9hPiDmoLzlvI/ooajnpLrnmoTml7bpl7TvvIzmr5TlpoLvvJo8c2FtcD4yMDE5LTEyLTE2VDAwOjM1OjQ4KzA4OjAwPC9zYW1wPuOAgjwvdXNhZ2U+CgkJPC90eXBlPgoJCTx0eXBlIG5hbWU9InZlcnNpb24iPgoJCQk8dXNhZ2U+54mI5pys5Y+377yM5qC85byP6YG15a6IIDxhIGhyZWY9Imh0dHBzOi8vc2VtdmVyLm9yZy9sYW5nL3poLUNOLyI+c2VtdmVyPC9hPiDop4TliJnjgILmr5TlpoLvvJo8c2FtcD4xLjAuMTwvc2FtcD7jgIE8c2FtcD4xLjAuMSsyMDIwMDYxODwvc2FtcD7jgII8L3VzYWdlPgoJCTwvdHlwZT4KCQk8dHlwZSBuYW1lPSJudW1iZXIiPgoJCQk8dXNhZ2U+5pmu6YCa55qE5pWw5YC857G75Z6L77yM5q+U5aaC77yaPHNhbXA+MTwvc2FtcD7jgIE8c2FtcD4tMTEuMTwvc2FtcD4g562J44CCPC91c2FnZT4KCQk8L3R5cGU+CgkJPHR5cGUgbmFtZT0idHlwZSI+CgkJCTx1c2FnZT7nlKjkuo7ooajnpLrmlbDmja7nmoTnsbvlnovlgLzvvIzmoLzlvI/kuLogPGNvZGU+cHJpbWl0aXZlWy5zdWJ0eXBlXTwvY29kZT7vvIzlhbbkuK0gPGNvZGU+cHJpbWl0aXZlPC9jb2RlPiDkuLrln7rmnKznsbvlnovvvIzogIwgPGNvZGU+c3VidHlwZTwvY29kZT4g5Li65a2Q57G75Z6L77yM55So5LqO5a+5IDxjb2RlPnByaW1pdGl2ZTwvY29kZT4g6L+b6KGM6L+b5LiA5q2l55qE57qm5p2f77yM5b2T5a6i5oi356uv5peg5rOV5aSE55CG5pW05Liq57G75Z6L5pe277yM5Y+v5Lul5oyJ54WnIDxjb2RlPnByaW1pdGl2ZTwvY29kZT4g55qE57G75Z6L5aSE55CG44CCPGJyIC8+Cgnnm67liY3mlK/mjIHku6XkuIvlh6Dnp43nsbvlnovvvJo8dWw+Cgk8bGk+56m65YC877ybPC9saT4KCTxsaT48dmFyPmJvb2w8L3Zhcj4g5biD5bCU5YC877ybPC9saT4KCTxsaT48dmFyPm9iamVjdDwvdmFyPiDlr7nosaHvvJs8L2xpPgoJPGxpPjx2YXI+bWFwPC92YXI+IOmUruWQjeS4uuS7u+aEj+Wtl+espuS4sueahOWvueixoe+8jOWAvOeahOexu+Wei+eUseWUr+S4gOeahOWtkOWFg+e0oCA8Y29kZT5wYXJhbTwvY29kZT4g5o+P6L+w77ybPC9saT4KCTxsaT48dmFyPm51bWJlcjwvdmFyPiDmlbDlgLznsbvlnovvvJs8L2xpPgoJPGxpPjx2YXI+bnVtYmVyLmludDwvdmFyPiDmlbTmlbDnsbvlnovnmoTmlbDlgLzvvJs8L2xpPgoJPGxpPjx2YXI+bnVtYmVyLmZsb2F0PC92YXI+IOa1rueCueexu+Wei+eahOaVsOWAvO+8mzwvbGk+Cgk8bGk+PHZhcj5zdHJpbmc8L3Zhcj4g5a2X56ym5Liy77ybPC9saT4KCTxsaT48dmFyPnN0cmluZy51cmw8L3Zhcj4gVVJMIOexu+Wei+eahOWtl+espuS4su+8mzwvbGk+Cgk8bGk+PHZhcj5zdHJpbmcuZW1haWw8L3Zhcj4gZW1haWwg57G75Z6L55qE5a2X56ym5Liy77ybPC9saT4KCTxsaT48dmFyPnN0cmluZy5pbWFnZTwvdmFyPiDooajnpLrlm77niYflnLDlnYDnmoQgVVJM77ybPC9saT4KCTxsaT48dmFyPnN0cmluZy5kYXRlPC92YXI+IOihqOekuiA8YSBocmVmPSJodHRwczovL3Rvb2xzLmlldGYub3JnL2h0bWwvcmZjMzMzOSNzZWN0aW9uLTUuNiI+UkZDMzMzOTwvYT4g5Lit55qEIDxjb2RlPmZ1bGwtZGF0ZTwvY29kZT4g5pel5pyf5qC85byP77yM5q+U5aaCIDxzYW1wPjIwMjAtMDEtMDI8L3NhbXA+77ybPC9saT4KCTxsaT48dmFyPnN0cmluZy50aW1lPC92YXI+IOihqOekuiA8YSBocmVmPSJodHRwczovL3Rvb2xzLmlldGYub3JnL2h0bWwvcmZjMzMzOSNzZWN0aW9uLTUuNiI+UkZDMzMzOTwvYT4g5Lit55qEIDxjb2RlPmZ1bGwtdGltZTwvY29kZT4g5pe26Ze05qC85byP77yM5q+U5aaCIDxzYW1wPjE1OjE2OjE3Wjwvc2FtcD7jgIE8c2FtcD4xNToxNjoxNyswODowMDwvc2FtcD7vvJs8L2xpPgoJPGxpPjx2YXI+c3RyaW5nLmRhdGUtdGltZTwvdmFyPiDooajnpLogPGEgaHJlZj0iaHR0cHM6Ly90b29scy5pZXRmLm9yZy9odG1sL3JmYzMzMzkjc2VjdGlvbi01LjYiPlJGQzMzMzk8L2E+IOS4reeahCA8Y29kZT5kYXRlLXRpbWU8L2NvZGU+IOagvOW8j++8jOavlOWmgiA8c2FtcD4yMDIwLTAxLTAyVDE1OjE2OjE3LTA4OjAwPC9zYW1wPu+8mzwvbGk+Cgk8bGk+PHZhcj5zdHJpbmcuYmluYXJ5PC92YXI+IOS6jOi/m+WItuWGheWuue+8jOavlOWmgiA8Y29kZT5tdWx0aXBhcnQvZm9ybS1kYXRhPC9jb2RlPiDkuK3kuIrkvKDnmoTmlofku7bvvJs8L2xpPgoJPGxpPjx2YXI+I25hbWU8L3Zhcj4g5byV55So5paH5qGj5Lit55SxIDxjb2RlPnR5cGU8L2NvZGU+IOWFg+e0oOWumuS5ieeahOexu+Wei++8mzwvbGk+Cgk8L3VsPjwvdXNhZ2U+CgkJPC90eXBlPgoJCTx0eXBlIG5hbWU9ImJvb2wiPgoJCQk8dXNhZ2U+5biD5bCU5YC857G75Z6L77yM5Y+W5YC85Li6IDx2YXI+dHJ1ZTwvdmFyPiDmiJbmmK8gPHZhcj5mYWxzZTwvdmFyPuOAgjwvdXNhZ2U+CgkJPC90eXBlPgoJPC9zcGVjPgoJPGNvbW1hbmRzPgoJCTxjb21tYW5kIG5hbWU9ImJ1aWxkIj7nlJ/miJDmlofmoaPlhoXlrrk8L2NvbW1hbmQ+CgkJPGNvbW1hbmQgbmFtZT0iZGV0ZWN0Ij7moLnmja7nm67lvZXkuIvnmoTlhoXlrrnnlJ/miJDphY3nva7mlofku7Y8L2NvbW1hbmQ+CgkJPGNvbW1hbmQgbmFtZT0iZGlmZiI+5q+U6L6D5Lik5Liq5paH5qGj5LmL6Ze055qE5beu5byCPC9jb21tYW5kPgoJCTxjb21tYW5kIG5hbWU9ImhlbHAiPuaYvuekuuW4ruWKqeS/oeaBrzwvY29tbWFuZD4KCQk8Y29tbWFuZCBuYW1lPSJpbXBvcnQiPuWwhuWFtuWug+agvOW8j+eahOaWh+aho+WvvOWFpeS4uiBhcGlkb2Mg5paH5qGjPC9jb21tYW5kPgoJCTxjb21tYW5kIG5hbWU9ImxhbmciPuaYvuekuuaJgOacieaUr+aMgeeahOivreiogDwvY29tbWFuZD4KCQk8Y29tbWFuZCBuYW1lPSJsb2NhbGUiPuaYvuekuuaJgOacieaUr+aMgeeahOacrOWcsOWMluWGheWuuTwvY29tbWFuZD4KCQk8Y29tbWFuZCBuYW1lPSJsc3AiPuWQr+WKqCBsYW5ndWFnZSBzZXJ2ZXIgcHJvdG9jb2wg5pyN5YqhPC9jb21tYW5kPgoJCTxjb21tYW5kIG5hbWU9Im1vY2siPuWQr+eUqCBtb2NrIOacjeWKoTwvY29tbWFuZD4KCQk8Y29tbWFuZCBuYW1lPSJzdGF0aWMiPuWQr+eUqOmdmeaAgeaWh+S7tuacjeWKoTwvY29tbWFuZD4KCQk8Y29tbWFuZCBuYW1lPSJzeW50YXgiPua1i+ivleivreazleeahOato+ehruaApzwvY29tbWFuZD4KCQk8Y29tbWFuZCBuYW1lPSJ1cGdyYWRlIj7lsIbml6fniYjmnKznmoTmlofmoaPlkozphY3nva7mlofku7bljYfnuqfliLDlvZPliY3niYjmnKw8L2NvbW1hbmQ+CgkJPGNvbW1hbmQgbmFtZT0idmVyc2lvbiI+5pi+56S654mI5pys5L+h5oGvPC9jb21tYW5kPgoJPC9jb21tYW5kcz4KCTxjb25maWc+CgkJPGl0ZW0gbmFtZT0idmVyc2lvbiIgdHlwZT0ic3RyaW5nIiBhcnJheT0iZmFsc2UiIHJlcXVpcmVkPSJ0cnVlIj7mraTphY3nva7mlofku7bnmoTmiYDkvb/nlKjnmoTmlofmoaPniYjmnKw8L2l0ZW0+CgkJPGl0ZW0gbmFtZT0iaW5wdXRzIiB0eXBlPSJvYmplY3QiIGFycmF5PSJ0cnVlIiByZXF1aXJlZD0idHJ1ZSI+5oyH5a6a6L6T5YWl55qE5pWw5o2u77yM5ZCM5LiA6aG555uu5Y+q6IO96Kej5p6Q5LiA56eN6K+t6KiA44CCPC9pdGVtPgoJCTxpdGVtIG5hbWU9ImlucHV0cy5sYW5nIiB0eXBlPSJzdHJpbmciIGFycmF5PSJmYWxzZSIgcmVxdWlyZWQ9InRydWUiPua6kOaWh+S7tueahOino+aekOaWueW8j+OAguWFt+S9k+aUr+aMgeeahOexu+Wei+WPr+mAmui/h+WRveS7pCA8c2FtcD5hcGlkb2MgbGFuZzwvc2FtcD4g5p+l55yL5pSv5oyB6K+t6KiA44CCPC9pdGVtPgoJCTxpdGVtIG5hbWU9ImlucHV0cy5kaXIiIHR5cGU9InN0cmluZyIgYXJyYXk9ImZhbHNlIiByZXF1aXJlZD0idHJ1ZSI+6ZyA6KaB6Kej5p6Q55qE5rqQ5paH5Lu25omA5Zyo55uu5b2VPC9pdGVtPgoJCTxpdGVtIG5hbWU9ImlucHV0cy5leHRzIiB0eXBlPSJzdHJpbmciIGFycmF5PSJ0cnVlIiByZXF1aXJlZD0iZmFsc2UiPuWPquS7jui/meS6m+aJqeWxleWQjeeahOaWh+S7tuS4reafpeaJvuaWh+ahozwvaXRlbT4KCQk8aXRlbSBuYW1lPSJpbnB1dHMucmVjdXJzaXZlIiB0eXBlPSJib29sIiBhcnJheT0iZmFsc2UiIHJlcXVpcmVkPSJmYWxzZSI+5piv5ZCm6Kej5p6Q5a2Q55uu5b2V5LiL55qE5rqQ5paH5Lu2PC9pdGVtPgoJCTxpdGVtIG5hbWU9ImlucHV0cy5lbmNvZGluZyIgdHlwZT0ic3RyaW5nIiBhcnJheT0iZmFsc2UiIHJlcXVpcmVkPSJmYWxzZSI+57yW56CB77yM6buY6K6k5Li6IDx2YXI+dXRmLTg8L3Zhcj7vvIzlgLzlj6/ku6XmmK8gPGEgaHJlZj0iaHR0cHM6Ly93d3cuaWFuYS5vcmcvYXNzaWdubWVudHMvY2hhcmFjdGVyLXNldHMvY2hhcmFjdGVyLXNldHMueGh0bWwiPmNoYXJhY3Rlci1zZXRzPC9hPiDkuK3nmoTlhoXlrrnjgII8L2l0ZW0+CgkJPGl0ZW0gbmFtZT0iaW5wdXRzLmlnbm9yZXMiIHR5cGU9InN0cmluZyIgYXJyYXk9InRydWUiIHJlcXVpcmVkPSJmYWxzZSI+5b+955Wl55qE5paH5Lu25oiW55uu5b2V77yM5q+U5aaCIG5vZGVfbW9kdWxlcyDnrYnjgII8L2l0ZW0+CgkJPGl0ZW0gbmFtZT0ib3V0cHV0IiB0eXBlPSJvYmplY3QiIGFycmF5PSJmYWxzZSIgcmVxdWlyZWQ9InRydWUiPuaOp+WItui+k+WHuuihjOS4ujwvaXRlbT4KCQk8aXRlbSBuYW1lPSJvdXRwdXQudHlwZSIgdHlwZT0ic3RyaW5nIiBhcnJheT0iZmFsc2UiIHJlcXVpcmVkPSJmYWxzZSI+6L6T5Ye655qE57G75Z6L77yM55uu5YmN5Y+v5LulIDx2YXI+YXBpZG9jK3htbDwvdmFyPuOAgTx2YXI+b3BlbmFwaStqc29uPC92YXI+44CBPHZhcj5vcGVuYXBpK3lhbWw8L3Zhcj7jgIE8dmFyPm9wZW5hcGkzLjEranNvbjwvdmFyPuOAgTx2YXI+b3BlbmFwaTMuMSt5YW1sPC92YXI+44CBPHZhcj5zd2FnZ2VyK2pzb248L3Zhcj7jgIE8dmFyPnN3YWdnZXIreWFtbDwvdmFyPuOAgTx2YXI+bWFya2Rvd248L3Zhcj7jgIE8dmFyPmh0bWw8L3Zhcj7jgIE8dmFyPnBvc3RtYW4ranNvbjwvdmFyPuOAgTx2YXI+dHlwZXNjcmlwdDwvdmFyPuOAgTx2YXI+Z28tY2xpZW50PC92YXI+44CBPHZhcj5nby1zZXJ2ZXI8L3Zhcj4g5ZKMIDx2YXI+anNvbnNjaGVtYTwvdmFyPuOAgjwvaXRlbT4KCQk8aXRlbSBuYW1lPSJvdXRwdXQucGF0aCIgdHlwZT0ic3RyaW5nIiBhcnJheT0iZmFsc2UiIHJlcXVpcmVkPSJ0cnVlIj7mjIflrprovpPlh7rnmoTmlofku7blkI3vvIzljIXlkKvot6/lvoTkv6Hmga/jgII8L2l0ZW0+CgkJPGl0ZW0gbmFtZT0ib3V0cHV0LnRhZ3MiIHR5cGU9InN0cmluZyIgYXJyYXk9InRydWUiIHJlcXVpcmVkPSJmYWxzZSI+5Y+q6L6T5Ye65LiO6L+Z5Lqb5qCH562+55u45YWz6IGU55qE5paH5qGj77yM6buY6K6k5Li65YWo6YOo44CCPC9pdGVtPgoJCTxpdGVtIG5hbWU9Im91dHB1dC5zdHlsZSIgdHlwZT0ic3RyaW5nIiBhcnJheT0iZmFsc2UiIHJlcXVpcmVkPSJmYWxzZSI+5Li6IFhNTCDmlofku7bmjIflrprnmoQgWFNMIOaWh+S7tjwvaXRlbT4KCQk8aXRlbSBuYW1lPSJvdXRwdXQubmFtZXNwYWNlIiB0eXBlPSJib29sIiBhcnJheT0iZmFsc2UiIHJlcXVpcmVkPSJmYWxzZSI+5piv5ZCm6L6T5Ye65ZG95ZCN56m66Ze0PC9pdGVtPgoJCTxpdGVtIG5hbWU9Im91dHB1dC5uYW1lc3BhY2UtcHJlZml4IiB0eXBlPSJzdHJpbmciIGFycmF5PSJmYWxzZSIgcmVxdWlyZWQ9ImZhbHNlIj7lpoLmnpzovpPlh7rkuoblkb3lkI3nqbrpl7TvvIzov5jlj6/ku6XmjIflrprlkb3lkI3nqbrpl7TliY3nvIDjgII8L2l0ZW0+CgkJPGl0ZW0gbmFtZT0ib3V0cHV0LmlubGluZS1zY2hlbWEiIHR5cGU9ImJvb2wiIGFycmF5PSJmYWxzZSIgcmVxdWlyZWQ9ImZhbHNlIj7mmK/lkKblhoXogZQgb3BlbmFwaSDkuK3miYDmnInnmoQgc2NoZW1h77yM5a+5IHN3YWdnZXIg5peg5pWI77yM6buY6K6k5Lya5bCG57uT5p6E55u45ZCM55qE5a+56LGh5o+Q5Y+W5YiwIGNvbXBvbmVudHMuc2NoZW1hcyDkuK3lubbku6UgJHJlZiDlvJXnlKjjgII8L2l0ZW0+CgkJPGl0ZW0gbmFtZT0ib3V0cHV0LnNwbGl0IiB0eXBlPSJib29sIiBhcnJheT0iZmFsc2UiIHJlcXVpcmVkPSJmYWxzZSI+5piv5ZCm5oyJ5qCH562+5bCG5paH5qGj5ouG5YiG5oiQ5aSa5Liq5paH5Lu277yM5LuF5a+5IDx2YXI+bWFya2Rvd248L3Zhcj4g5pyJ5pWI44CC5Li6IHRydWUg5pe277yMcGF0aCDmjIflrprnmoTmlofku7blj6rljIXlkKvmlofmoaPnmoTln7rmnKzkv6Hmga/lkozmnKrmjIflrprmoIfnrb7nmoQgQVBJ77yM5ZCE5Liq5qCH562+55qEIEFQSSDkv53lrZjlnKjlkIznm67lvZXkuIvku6XmoIfnrb7lkI3lkb3lkI3nmoTmlofku7bkuK3jgII8L2l0ZW0+CgkJPGl0ZW0gbmFtZT0ib3V0cHV0LnBhY2thZ2UiIHR5cGU9InN0cmluZyIgYXJyYXk9ImZhbHNlIiByZXF1aXJlZD0iZmFsc2UiPueUn+aIkOeahCBHbyDku6PnoIHnmoTljIXlkI3vvIzku4Xlr7kgPHZhcj5nby1jbGllbnQ8L3Zhcj4g5ZKMIDx2YXI+Z28tc2VydmVyPC92YXI+IOacieaViOOAgum7mOiupOWPliBwYXRoIOaJgOWcqOebruW9leeahOWQjeensO+8jOaXoOazleS9nOS4uuWMheWQjeaXtumHh+eUqCBjbGllbnQg5oiW5pivIHNlcnZlcuOAgjwvaXRlbT4KCQk8aXRlbSBuYW1lPSJsaW50IiB0eXBlPSJvYmplY3QiIGFycmF5PSJmYWxzZSIgcmVxdWlyZWQ9ImZhbHNlIj7ku6PnoIHmo4Dmn6XnmoTop4TliJnlj4rlhbbnuqfliKvvvIzplK7lkI3kuLrop4TliJnlkI3np7DvvIzplK7lgLzlj6/ku6XmmK8gPHZhcj5lcnJvcjwvdmFyPuOAgTx2YXI+d2FybmluZzwvdmFyPuOAgTx2YXI+aW5mbzwvdmFyPiDlkowgPHZhcj5vZmY8L3Zhcj7vvIzmnKrmjIflrprnmoTop4TliJnlnYfkuLogPHZhcj5vZmY8L3Zhcj7jgILlj6/nlKjnmoTop4TliJnmnInvvJo8dmFyPmFwaS1zdW1tYXJ5PC92YXI+44CBPHZhcj5hcGktaWQ8L3Zhcj7jgIE8dmFyPmFwaS1pZC11bmlxdWU8L3Zhcj7jgIE8dmFyPnBhdGgta2ViYWItY2FzZTwvdmFyPuOAgTx2YXI+YXBpLTR4eC1yZXNwb25zZTwvdmFyPuOAgTx2YXI+ZGVwcmVjYXRlZC1kZXNjcmlwdGlvbjwvdmFyPuOAgTx2YXI+dGFnLXVudXNlZDwvdmFyPiDlkowgPHZhcj5xdWVyeS1jYXNlPC92YXI+44CC5paH5qGj5Lit5Y+v5Lul6YCa6L+HIDxjb2RlPiZsdDshLS0gbGludC1kaXNhYmxlIGFwaS1pZCAtLSZndDs8L2NvZGU+IOW9ouW8j+eahOazqOmHiuemgeeUqOaMh+WumueahOinhOWIme+8jOacquaMh+WumuinhOWImeWQjeensOaXtuemgeeUqOaJgOacieinhOWImeOAguWcqCBhcGkg5Lit55qE5rOo6YeK5LuF5a+55b2T5YmNIEFQSSDmnInmlYjvvIzlnKggYXBpZG9jIOS4reWImeWvueaVtOS4quaWh+aho+acieaViOOAgjwvaXRlbT4KCTwvY29uZmlnPgo8L2xvY2FsZT4KAAETbG9jYWxlLmNtbi1IYW50LnhtbAEeYXBwbGljYXRpb24veG1sOyBjaGFyc2V0PXV0Zi04Af5zAjw/eG1sIHZlcnNpb249IjEuMCIgZW5jb2Rpbmc9IlVURi04Ij8+Cgo8IS0tIOivpeaWh+S7tueUseW3peWFt+iHquWKqOeUn+aIkO+8jOivt+WLv+aJi+WKqOS/ruaUue+8gSAtLT4KCjxsb2NhbGU+Cgk8c3BlYz4KCQk8dHlwZSBuYW1lPSJhcGlkb2MiPgoJCQk8dXNhZ2U+55So5pa85o+P6L+w5pW05YCL5paH5qqU55qE55u46Zec5YWn5a6577yM5Y+q6IO95Ye654++5aO55qyh44CCPC91c2FnZT4KCQkJPGl0ZW0gbmFtZT0iQGFwaWRvYyIgdHlwZT0ic3RyaW5nIiBhcnJheT0iZmFsc2UiIHJlcXVpcmVkPSJmYWxzZSI+5paH5qqU55qE54mI5pys6KaB6JmfPC9pdGVtPgoJCQk8aXRlbSBuYW1lPSJAbGFuZyIgdHlwZT0ic3RyaW5nIiBhcnJheT0iZmFsc2UiIHJlcXVpcmVkPSJmYWxzZSI+5paH5qqU5YWn5a6555qE5pys5Zyw5YyWIElE77yM5q+U5aaCIDx2YXI+emgtSGFuczwvdmFyPuOAgTx2YXI+ZW4tVVM8L3Zhcj4g562J44CCPC9pdGVtPgoJCQk8aXRlbSBuYW1lPSJAbG9nbyIgdHlwZT0ic3RyaW5nIiBhcnJheT0iZmFsc2UiIHJlcXVpcmVkPSJmYWxzZSI+5paH5qqU55qE5ZyW5qiZ77yM5YOF5Y+v5L2/55SoIFNWRyDmoLzlvI/lnJbmqJnjgII8L2l0ZW0+CgkJCTxpdGVtIG5hbWU9IkBjcmVhdGVkIiB0eXBlPSJkYXRlIiBhcnJheT0iZmFsc2UiIHJlcXVpcmVkPSJmYWxzZSI+5paH5qqU55qE5Ym15bu65pmC6ZaTPC9pdGVtPgoJCQk8aXRlbSBuYW1lPSJAdmVyc2lvbiIgdHlwZT0idmVyc2lvbiIgYXJyYXk9ImZhbHNlIiByZXF1aXJlZD0iZmFsc2UiPuaWh+aqlOeahOeJiOacrOiZnzwvaXRlbT4KCQkJPGl0ZW0gbmFtZT0ieG1sLW5hbWVzcGFjZSIgdHlwZT0ieG1sLW5hbWVzcGFjZSIgYXJyYXk9InRydWUiIHJlcXVpcmVkPSJmYWxzZSI+6Yed5bCNIDx2YXI+YXBwbGljYXRpb24veG1sPC92YXI+IOmhnuWei+eahOWFp+WuueeahOWRveWQjeepuumWk+ioree9rjwvaXRlbT4KCQkJPGl0ZW0gbmFtZT0idGl0bGUiIHR5cGU9InN0cmluZyIgYXJyYXk9ImZhbHNlIiByZXF1aXJlZD0idHJ1ZSI+5paH5qqU55qE5qiZ6aGMPC9pdGVtPgoJCQk8aXRlbSBuYW1lPSJkZXNjcmlwdGlvbiIgdHlwZT0icmljaHRleHQiIGFycmF5PSJmYWxzZSIgcmVxdWlyZWQ9ImZhbHNlIj7mlofmqpTnmoTmlbTpq5Tmj4/ov7Dlhaflrrk8L2l0ZW0+CgkJCTxpdGVtIG5hbWU9ImNvbnRhY3QiIHR5cGU9ImNvbnRhY3QiIGFycmF5PSJmYWxzZSIgcmVxdWlyZWQ9ImZhbHNlIj7mlofmqpTkvZzogIXnmoToga/ns7vmlrnlvI88L2l0ZW0+CgkJCTxpdGVtIG5hbWU9ImxpY2Vuc2UiIHR5cGU9ImxpbmsiIGFycmF5PSJmYWxzZSIgcmVxdWlyZWQ9ImZhbHNlIj7mlofmqpTnmoTniYjmrIrkv6Hmga88L2l0ZW0+CgkJCTxpdGVtIG5hbWU9InRhZyIgdHlwZT0idGFnIiBhcnJheT0idHJ1ZSIgcmVxdWlyZWQ9ImZhbHNlIj7mlofmqpTkuK3lrprnvqnnmoTmiYDmnInmqJnnsL08L2l0ZW0+CgkJCTxpdGVtIG5hbWU9InNlcnZlciIgdHlwZT0ic2VydmVyIiBhcnJheT0idHJ1ZSIgcmVxdWlyZWQ9ImZhbHNlIj5BUEkg5Z+65Zyw5Z2A5YiX6KGo77yM5q+P5YCLIEFQSSDmnIDlsJHmh4noqbLmnInlo7nlgIsgc2VydmVy44CCPC9pdGVtPgoJCQk8aXRlbSBuYW1lPSJ0eXBlIiB0eXBlPSJ0eXBlZGVmIiBhcnJheT0idHJ1ZSIgcmVxdWlyZWQ9ImZhbHNlIj7mlofmqpTkuK3lrprnvqnnmoTlj6/lvqnnlKjpoZ7lnovvvIzlj6/pgJrpgY4gPHNhbXA+I25hbWU8L3NhbXA+IOeahOW9ouW8j+WcqCA8dmFyPnR5cGU8L3Zhcj4g5bGs5oCn5Lit5byV55So44CCPC9pdGVtPgoJCQk8aXRlbSBuYW1lPSJzZWN1cml0eSIgdHlwZT0ic2VjdXJpdHkiIGFycmF5PSJ0cnVlIiByZXF1aXJlZD0iZmFsc2UiPuaWh+aqlOS4reWumue+qeeahOaJgOaciei6q+S7vempl+itieaWueW8jzwvaXRlbT4KCQkJPGl0ZW0gbmFtZT0iYXBpIiB0eXBlPSJhcGkiIGFycmF5PSJ0cnVlIiByZXF1aXJlZD0iZmFsc2UiPuaWh+aqlOS4reeahCBBUEkg5paH5qqUPC9pdGVtPgoJCQk8aXRlbSBuYW1lPSJoZWFkZXIiIHR5cGU9InBhcmFtIiBhcnJheT0idHJ1ZSIgcmVxdWlyZWQ9ImZhbHNlIj7mlofmqpTkuK3miYDmnIkgQVBJIOmDveWMheWQq+eahOWFrOWFseWgsemgrTwvaXRlbT4KCQkJPGl0ZW0gbmFtZT0icmVzcG9uc2UiIHR5cGU9InJlcXVlc3QiIGFycmF5PSJ0cnVlIiByZXF1aXJlZD0iZmFsc2UiPuaWh+aqlOS4reaJgOaciSBBUEkg5paH5qqU6YO96ZyA6KaB5pSv5oyB55qE6L+U5Zue5YWn5a65PC9pdGVtPgoJCQk8aXRlbSBuYW1lPSJtaW1ldHlwZSIgdHlwZT0ic3RyaW5nIiBhcnJheT0idHJ1ZSIgcmVxdWlyZWQ9InRydWUiPuaWh+aqlOaJgOaUr+aMgeeahCBtaW1ldHlwZTwvaXRlbT4KCQk8L3R5cGU+CgkJPHR5cGUgbmFtZT0ieG1sLW5hbWVzcGFjZSI+CgkJCTx1c2FnZT7ngrogPHZhcj5hcHBsaWNhdGlvbi94bWw8L3Zhcj4g5a6a576p5ZG95ZCN56m66ZaT55qE55u46Zec5bGs5oCnPC91c2FnZT4KCQkJPGl0ZW0gbmFtZT0iQHByZWZpeCIgdHlwZT0ic3RyaW5nIiBhcnJheT0iZmFsc2UiIHJlcXVpcmVkPSJmYWxzZSI+5ZG95ZCN56m66ZaT55qE5YmN57a077yM5aaC5p6c54K656m677yM5YmH6KGo56S65L2c54K66buY6KqN5ZG95ZCN56m66ZaT77yM5ZG95bGA5Y+q6IO95pyJ5aO55YCL6buY6KqN5ZG95ZCN56m66ZaT44CCPC9pdGVtPgoJCQk8aXRlbSBuYW1lPSJAdXJuIiB0eXBlPSJzdHJpbmciIGFycmF5PSJmYWxzZSIgcmVxdWlyZWQ9InRydWUiPuWRveWQjeepuumWk+eahOWUr+WjueaomeitmO+8jOmcgOimgeWFqOWxgOWUr+Wjue+8jOS4lOWNgOWIhuWkp+Wwj+Wvq+OAgjwvaXRlbT4KCQk8L3R5cGU+CgkJPHR5cGUgbmFtZT0icmljaHRleHQiPgoJCQk8dXNhZ2U+5a+M5paH5pys5YWn5a65PC91c2FnZT4KCQkJPGl0ZW0gbmFtZT0iQHR5cGUiIHR5cGU9InN0cmluZyIgYXJyYXk9ImZhbHNlIiByZXF1aXJlZD0idHJ1ZSI+5oyH5a6a5a+M5paH5pys5YWn5a6555qE5qC85byP77yM55uu5YmN5pSv5oyBIDx2YXI+aHRtbDwvdmFyPiDlkowgPHZhcj5tYXJrZG93bjwvdmFyPuOAgjwvaXRlbT4KCQkJPGl0ZW0gbmFtZT0iLiIgdHlwZT0ic3RyaW5nIiBhcnJheT0iZmFsc2UiIHJlcXVpcmVkPSJ0cnVlIj7lr4zmlofmnKznmoTlr6bpmpvlhaflrrk8L2l0ZW0+CgkJPC90eXBlPgoJCTx0eXBlIG5hbWU9ImNvbnRhY3QiPgoJCQk8dXNhZ2U+55So5pa85o+P6L+w6IGv57O75pa55byPPC91c2FnZT4KCQkJPGl0ZW0gbmFtZT0iQG5hbWUiIHR5cGU9InN0cmluZyIgYXJyYXk9ImZhbHNlIiByZXF1aXJlZD0idHJ1ZSI+6IGv57O75Lq655qE5ZCN56ixPC9pdGVtPgoJCQk8aXRlbSBuYW1lPSJ1cmwiIHR5cGU9InN0cmluZyIgYXJyYXk9ImZhbHNlIiByZXF1aXJlZD0iZmFsc2UiPuiBr+ezu+S6uueahCBVUkw8L2l0ZW0+CgkJCTxpdGVtIG5hbWU9ImVtYWlsIiB0eXBlPSJzdHJpbmciIGFycmF5PSJmYWxzZSIgcmVxdWlyZWQ9ImZhbHNlIj7oga/ns7vkurrnmoTpm7vlrZDpg7Xku7Y8L2l0ZW0+CgkJPC90eXBlPgoJCTx0eXBlIG5hbWU9ImxpbmsiPgoJCQk8dXNhZ2U+55So5pa85o+P6L+w6Y+I5o6l5L+h5oGv77yM5aO56Iis6L2J5o+b54K6IEhUTUwg55qEIDxjb2RlPmE8L2NvZGU+IOaomeewveOAgjwvdXNhZ2U+CgkJCTxpdGVtIG5hbWU9IkB0ZXh0IiB0eXBlPSJzdHJpbmciIGFycmF5PSJmYWxzZSIgcmVxdWlyZWQ9InRydWUiPumPiOaOpeeahOWtl+mdouaWh+WtlzwvaXRlbT4KCQkJPGl0ZW0gbmFtZT0iQHVybCIgdHlwZT0ic3RyaW5nIiBhcnJheT0iZmFsc2UiIHJlcXVpcmVkPSJ0cnVlIj7pj4jmjqXmjIflkJHnmoTmlofmnKw8L2l0ZW0+CgkJPC90eXBlPgoJCTx0eXBlIG5hbWU9InRhZyI+CgkJCTx1c2FnZT7nlKjmlrzlsI3lkITlgIsgQVBJIOmAsuihjOWIhumhnjwvdXNhZ2U+CgkJCTxpdGVtIG5hbWU9IkBuYW1lIiB0eXBlPSJzdHJpbmciIGFycmF5PSJmYWxzZSIgcmVxdWlyZWQ9InRydWUiPuaomeewveeahOWUr+WjuSBJRDwvaXRlbT4KCQkJPGl0ZW0gbmFtZT0iQHRpdGxlIiB0eXBlPSJzdHJpbmciIGFycmF5PSJmYWxzZSIgcmVxdWlyZWQ9InRydWUiPuaomeewveeahOWtl+mdouWQjeeosTwvaXRlbT4KCQkJPGl0ZW0gbmFtZT0iQGRlcHJlY2F0ZWQiIHR5cGU9InZlcnNpb24iIGFycmF5PSJmYWxzZSIgcmVxdWlyZWQ9ImZhbHNlIj7oqbLmqJnnsL3lnKjlpKfmlrzoqbLniYjmnKzmmYLooqvmo4TnlKg8L2l0ZW0+CgkJPC90eXBlPgoJCTx0eXBlIG5hbWU9InNlcnZlciI+CgkJCTx1c2FnZT7nlKjmlrzmjIflrprlkITlgIsgQVBJIOeahOacjeWLmeWZqOWcsOWdgDwvdXNhZ2U+CgkJCTxpdGVtIG5hbWU9IkBuYW1lIiB0eXBlPSJzdHJpbmciIGFycmF5PSJmYWxzZSIgcmVxdWlyZWQ9InRydWUiPuacjeWLmeWUr+WjuSBJRDwvaXRlbT4KCQkJPGl0ZW0gbmFtZT0iQHVybCIgdHlwZT0ic3RyaW5nIiBhcnJheT0iZmFsc2UiIHJlcXVpcmVkPSJ0cnVlIj7mnI3li5nnmoTln7rlnLDlnYDvvIzoiIfoqbLmnI3li5npl5zoga/nmoQgQVBJ77yM6Kiq5ZWP5Zyw5Z2A6YO95piv55u45bCN5pa85q2k5Zyw5Z2A55qE44CCPC9pdGVtPgoJCQk8aXRlbSBuYW1lPSJAZGVwcmVjYXRlZCIgdHlwZT0idmVyc2lvbiIgYXJyYXk9ImZhbHNlIiByZXF1aXJlZD0iZmFsc2UiPuacjeWLmeWcqOWkp+aWvOipsueJiOacrOaZguiiq+ajhOeUqDwvaXRlbT4KCQkJPGl0ZW0gbmFtZT0iQHN1bW1hcnkiIHR5cGU9InN0cmluZyIgYXJyYXk9ImZhbHNlIiByZXF1aXJlZD0iZmFsc2UiPuacjeWLmeeahOaRmOimgeS/oeaBrzwvaXRlbT4KCQkJPGl0ZW0gbmFtZT0iZGVzY3JpcHRpb24iIHR5cGU9InJpY2h0ZXh0IiBhcnJheT0iZmFsc2UiIHJlcXVpcmVkPSJmYWxzZSI+5pyN5YuZ55qE6Kmz57Sw5o+P6L+wPC9pdGVtPgoJCTwvdHlwZT4KCQk8dHlwZSBuYW1lPSJ0eXBlZGVmIj4KCQkJPHVzYWdlPuWPr+W+qeeUqOeahOmhnuWei+Wumue+qTwvdXNhZ2U+CgkJCTxpdGVtIG5hbWU9IkBtaW4iIHR5cGU9Im51bWJlciIgYXJyYXk9ImZhbHNlIiByZXF1aXJlZD0iZmFsc2UiPuaVuOWAvOeahOacgOWwj+WAvO+8jOWDheS9nOeUqOaWvCA8dmFyPm51bWJlcjwvdmFyPiDpoZ7lnovjgII8L2l0ZW0+CgkJCTxpdGVtIG5hbWU9IkBtYXgiIHR5cGU9Im51bWJlciIgYXJyYXk9ImZhbHNlIiByZXF1aXJlZD0iZmFsc2UiPuaVuOWAvOeahOacgOWkp+WAvO+8jOWDheS9nOeUqOaWvCA8dmFyPm51bWJlcjwvdmFyPiDpoZ7lnovjgII8L2l0ZW0+CgkJCTxpdGVtIG5hbWU9IkBtaW4tbGVuZ3RoIiB0eXBlPSJudW1iZXIiIGFycmF5PSJmYWxzZSIgcmVxdWlyZWQ9ImZhbHNlIj7lrZfnrKbkuLLnmoTmnIDlsI/plbfluqbvvIzlg4XkvZznlKjmlrwgPHZhcj5zdHJpbmc8L3Zhcj4g6aGe5Z6L44CCPC9pdGVtPgoJCQk8aXRlbSBuYW1lPSJAbWF4LWxlbmd0aCIgdHlwZT0ibnVtYmVyIiBhcnJheT0iZmFsc2UiIHJlcXVpcmVkPSJmYWxzZSI+5a2X56ym5Liy55qE5pyA5aSn6ZW35bqm77yM5YOF5L2c55So5pa8IDx2YXI+c3RyaW5nPC92YXI+IOmhnuWei+OAgjwvaXRlbT4KCQkJPGl0ZW0gbmFtZT0iQHBhdHRlcm4iIHR5cGU9InN0cmluZyIgYXJyYXk9ImZhbHNlIiByZXF1aXJlZD0iZmFsc2UiPuWtl+espuS4sumcgOimgeWMuemFjeeahOato+WJh+ihqOmBlOW8j++8jOWDheS9nOeUqOaWvCA8dmFyPnN0cmluZzwvdmFyPiDpoZ7lnovjgII8L2l0ZW0+CgkJCTxpdGVtIG5hbWU9IkBtaW4taXRlbXMiIHR5cGU9Im51bWJlciIgYXJyYXk9ImZhbHNlIiByZXF1aXJlZD0iZmFsc2UiPuaVuOe1hOeahOacgOWwj+mVt+W6pu+8jOWDheWcqCA8Y29kZT5AYXJyYXk8L2NvZGU+IOeCuiA8dmFyPnRydWU8L3Zhcj4g5pmC5pyJ5pWI44CCPC9pdGVtPgoJCQk8aXRlbSBuYW1lPSJAbWF4LWl0ZW1zIiB0eXBlPSJudW1iZXIiIGFycmF5PSJmYWxzZSIgcmVxdWlyZWQ9ImZhbHNlIj7mlbjntYTnmoTmnIDlpKfplbfluqbvvIzlg4XlnKggPGNvZGU+QGFycmF5PC9jb2RlPiDngrogPHZhcj50cnVlPC92YXI+IOaZguacieaViOOAgjwvaXRlbT4KCQkJPGl0ZW0gbmFtZT0iQG5hbWUiIHR5cGU9InN0cmluZyIgYXJyYXk9ImZhbHNlIiByZXF1aXJlZD0idHJ1ZSI+6aGe5Z6L55qE5ZSv5LiA5ZCN56ix77yM5byV55So5pmC6ZyA6KaB5Yqg5LiKIDxzYW1wPiM8L3NhbXA+IOWJjee2tOOAgjwvaXRlbT4KCQkJPGl0ZW0gbmFtZT0iQHR5cGUiIHR5cGU9InR5cGUiIGFycmF5PSJmYWxzZSIgcmVxdWlyZWQ9InRydWUiPuWAvOeahOmhnuWeizwvaXRlbT4KCQkJPGl0ZW0gbmFtZT0iQGRlcHJlY2F0ZWQiIHR5cGU9InZlcnNpb24iIGFycmF5PSJmYWxzZSIgcmVxdWlyZWQ9ImZhbHNlIj7ooajnpLrlnKjlpKfmlrznrYnmlrzoqbLniYjmnKzomZ/mmYLkuI3lho3llZ/kvZznlKg8L2l0ZW0+CgkJCTxpdGVtIG5hbWU9IkBzdW1tYXJ5IiB0eXBlPSJzdHJpbmciIGFycmF5PSJmYWxzZSIgcmVxdWlyZWQ9ImZhbHNlIj7nsKHopoHku4vntLk8L2l0ZW0+CgkJCTxpdGVtIG5hbWU9IkBkaXNjcmltaW5hdG9yIiB0eXBlPSJzdHJpbmciIGFycmF5PSJmYWxzZSIgcmVxdWlyZWQ9ImZhbHNlIj7nlKjmlrzljYDliIYgb25lLW9mIOWIhuaUr+eahOWtl+auteWQje+8jOipsuWtl+auteeahOWAvOWNs+eCuuWIhuaUr+eahCBuYW1lIOWAvOOAgjwvaXRlbT4KCQkJPGl0ZW0gbmFtZT0icGFyYW0iIHR5cGU9InBhcmFtIiBhcnJheT0idHJ1ZSIgcmVxdWlyZWQ9ImZhbHNlIj7lrZDpoZ7lnovvvIzmr5TlpoLlsI3osaHnmoTlrZDlhYPntKDjgII8L2l0ZW0+CgkJCTxpdGVtIG5hbWU9ImVudW0iIHR5cGU9ImVudW0iIGFycmF5PSJ0cnVlIiByZXF1aXJlZD0iZmFsc2UiPueVtuWJjemhnuWei+WPr+eUqOeahOaemuiIieWAvDwvaXRlbT4KCQkJPGl0ZW0gbmFtZT0iZGVzY3JpcHRpb24iIHR5cGU9InJpY2h0ZXh0IiBhcnJheT0iZmFsc2UiIHJlcXVpcmVkPSJmYWxzZSI+6Kmz57Sw5LuL57S577yM54K6IEhUTUwg5YWn5a6544CCPC9pdGVtPgoJCQk8aXRlbSBuYW1lPSJvbmUtb2YiIHR5cGU9InBhcmFtIiBhcnJheT0idHJ1ZSIgcmVxdWlyZWQ9ImZhbHNlIj7oga/lkIjpoZ7lnovnmoTlkITlgIvliIbmlK/vvIzlj6rog73ljLnphY3lhbbkuK3kuYvlo7nvvIzkuI3og73oiIcgYW55LW9mIOWQjOaZguS9v+eUqOOAgjwvaXRlbT4KCQkJPGl0ZW0gbmFtZT0iYW55LW9mIiB0eXBlPSJwYXJhbSIgYXJyYXk9InRydWUiIHJlcXVpcmVkPSJmYWxzZSI+6IGv5ZCI6aGe5Z6L55qE5ZCE5YCL5YiG5pSv77yM6Iez5bCR5Yy56YWN5YW25Lit5LmL5aO577yM5LiN6IO96IiHIG9uZS1vZiDlkIzmmYLkvb/nlKjjgII8L2l0ZW0+CgkJPC90eXBlPgoJCTx0eXBlIG5hbWU9InBhcmFtIj4KCQkJPHVzYWdlPuWPg+aVuOmhnuWei++8jOWfuuacrOS4iuWPr+S7peS9nOeCuiByZXF1ZXN0IOeahOWtkOmbhuS9v+eUqOOAgjwvdXNhZ2U+CgkJCTxpdGVtIG5hbWU9IkB4bWwtYXR0ciIgdHlwZT0iYm9vbCIgYXJyYXk9ImZhbHNlIiByZXF1aXJlZD0iZmFsc2UiPuaYr+WQpuS9nOeCuueItuWFg+e0oOeahOWxrOaAp++8jOWDheS9nOeUqOaWvCBYTUwg5YWD57Sg44CC5piv5ZCm5L2c54K654i25YWD57Sg55qE5bGs5oCn77yM5YOF55So5pa8IFhNTCDnmoToq4vmsYLjgII8L2l0ZW0+CgkJCTxpdGVtIG5hbWU9IkB4bWwtZXh0cmFjdCIgdHlwZT0iYm9vbCIgYXJyYXk9ImZhbHNlIiByZXF1aXJlZD0iZmFsc2UiPuWwh+eVtuWJjeWFg+e0oOeahOWFp+WuueS9nOeCuueItuWFg+e0oOeahOWFp+Wuue+8jOimgeaxgueItuWFg+e0oOW/hemgiOeCuiA8dmFyPm9iamVjdDwvdmFyPuOAgjwvaXRlbT4KCQkJPGl0ZW0gbmFtZT0iQHhtbC1jZGF0YSIgdHlwZT0iYm9vbCIgYXJyYXk9ImZhbHNlIiByZXF1aXJlZD0iZmFsc2UiPueVtuWJjeWFp+WuueeCuiBDREFUQe+8jOiIhyA8Y29kZT5AeG1sLWF0dHI8L2NvZGU+IOS6kuaWpeOAgjwvaXRlbT4KCQkJPGl0ZW0gbmFtZT0iQHhtbC1ucy1wcmVmaXgiIHR5cGU9InN0cmluZyIgYXJyYXk9ImZhbHNlIiByZXF1aXJlZD0iZmFsc2UiPlhNTCDmqJnnsL3nmoTlkb3lkI3nqbrplpPlkI3nqLHliY3ntrQ8L2l0ZW0+CgkJCTxpdGVtIG5hbWU9IkB4bWwtd3JhcHBlZCIgdHlwZT0ic3RyaW5nIiBhcnJheT0iZmFsc2UiIHJlcXVpcmVkPSJmYWxzZSI+5aaC5p6c55W25YmN5YWD57Sg55qEIDxjb2RlPkBhcnJheTwvY29kZT4g54K6IDx2YXI+dHJ1ZTwvdmFyPu+8jOWJh+WPr+S7pemAmumBjuatpOWAvOaMh+WumuWcqCBYTUwg5qC85byP5Lit55qE5ZCN56ix44CCCgnlj6/ku6XmnInkuInnqK7moLzlvI/vvJo8dWw+Cgk8bGk+PHNhbXA+bmFtZTwvc2FtcD7vvJrooajnpLrngrrmlbjntYTmt7vliqDlo7nlgIvniLblhYPntKDlkI3nqLHngrogPHZhcj5uYW1lPC92YXI+77ybPC9saT4KCTxsaT48c2FtcD5uYW1lMSZndDtuYW1lMjwvc2FtcD7vvJrooajnpLrmlbjntYTpoIXnmoTlkI3nqLHmlLnngrogPHZhcj5uYW1lMjwvdmFyPu+8jOS4lOa3u+WKoOWjueWAi+eItuWFg+e0oOWQjeeCuiA8dmFyPm5hbWUxPC92YXI+77ybPC9saT4KCTxsaT48c2FtcD4mZ3Q7bmFtZTwvc2FtcD7vvJrooajnpLrlsIfnlbbliY3mlbjntYTlhYPntKDnmoTlkI3nqLHmlLnngrogPHZhcj5uYW1lPC92YXI+77ybPC9saT4KCTwvdWw+PC9pdGVtPgoJCQk8aXRlbSBuYW1lPSJAbWluIiB0eXBlPSJudW1iZXIiIGFycmF5PSJmYWxzZSIgcmVxdWlyZWQ9ImZhbHNlIj7mlbjlgLznmoTmnIDlsI/lgLzvvIzlg4XkvZznlKjmlrwgPHZhcj5udW1iZXI8L3Zhcj4g6aGe5Z6L44CCPC9pdGVtPgoJCQk8aXRlbSBuYW1lPSJAbWF4IiB0eXBlPSJudW1iZXIiIGFycmF5PSJmYWxzZSIgcmVxdWlyZWQ9ImZhbHNlIj7mlbjlgLznmoTmnIDlpKflgLzvvIzlg4XkvZznlKjmlrwgPHZhcj5udW1iZXI8L3Zhcj4g6aGe5Z6L44CCPC9pdGVtPgoJCQk8aXRlbSBuYW1lPSJAbWluLWxlbmd0aCIgdHlwZT0ibnVtYmVyIiBhcnJheT0iZmFsc2UiIHJlcXVpcmVkPSJmYWxzZSI+5a2X56ym5Liy55qE5pyA5bCP6ZW35bqm77yM5YOF5L2c55So5pa8IDx2YXI+c3RyaW5nPC92YXI+IOmhnuWei+OAgjwvaXRlbT4KCQkJPGl0ZW0gbmFtZT0iQG1heC1sZW5ndGgiIHR5cGU9Im51bWJlciIgYXJyYXk9ImZhbHNlIiByZXF1aXJlZD0iZmFsc2UiPuWtl+espuS4sueahOacgOWkp+mVt+W6pu+8jOWDheS9nOeUqOaWvCA8dmFyPnN0cmluZzwvdmFyPiDpoZ7lnovjgII8L2l0ZW0+CgkJCTxpdGVtIG5hbWU9IkBwYXR0ZXJuIiB0eXBlPSJzdHJpbmciIGFycmF5PSJmYWxzZSIgcmVxdWlyZWQ9ImZhbHNlIj7lrZfnrKbkuLLpnIDopoHljLnphY3nmoTmraPliYfooajpgZTlvI/vvIzlg4XkvZznlKjmlrwgPHZhcj5zdHJpbmc8L3Zhcj4g6aGe5Z6L44CCPC9pdGVtPgoJCQk8aXRlbSBuYW1lPSJAbWluLWl0ZW1zIiB0eXBlPSJudW1iZXIiIGFycmF5PSJmYWxzZSIgcmVxdWlyZWQ9ImZhbHNlIj7mlbjntYTnmoTmnIDlsI/plbfluqbvvIzlg4XlnKggPGNvZGU+QGFycmF5PC9jb2RlPiDngrogPHZhcj50cnVlPC92YXI+IOaZguacieaViOOAgjwvaXRlbT4KCQkJPGl0ZW0gbmFtZT0iQG1heC1pdGVtcyIgdHlwZT0ibnVtYmVyIiBhcnJheT0iZmFsc2UiIHJlcXVpcmVkPSJmYWxzZSI+5pW457WE55qE5pyA5aSn6ZW35bqm77yM5YOF5ZyoIDxjb2RlPkBhcnJheTwvY29kZT4g54K6IDx2YXI+dHJ1ZTwvdmFyPiDmmYLmnInmlYjjgII8L2l0ZW0+CgkJCTxpdGVtIG5hbWU9IkBuYW1lIiB0eXBlPSJzdHJpbmciIGFycmF5PSJmYWxzZSIgcmVxdWlyZWQ9InRydWUiPuWAvOeahOWQjeeosTwvaXRlbT4KCQkJPGl0ZW0gbmFtZT0iQHR5cGUiIHR5cGU9InR5cGUiIGFycmF5PSJmYWxzZSIgcmVxdWlyZWQ9InRydWUiPuWAvOeahOmhnuWeizwvaXRlbT4KCQkJPGl0ZW0gbmFtZT0iQGRlcHJlY2F0ZWQiIHR5cGU9InZlcnNpb24iIGFycmF5PSJmYWxzZSIgcmVxdWlyZWQ9ImZhbHNlIj7ooajnpLrlnKjlpKfmlrznrYnmlrzoqbLniYjmnKzomZ/mmYLkuI3lho3llZ/kvZznlKg8L2l0ZW0+CgkJCTxpdGVtIG5hbWU9IkBkZWZhdWx0IiB0eXBlPSJzdHJpbmciIGFycmF5PSJmYWxzZSIgcmVxdWlyZWQ9ImZhbHNlIj7pu5joqo3lgLw8L2l0ZW0+CgkJCTxpdGVtIG5hbWU9IkBvcHRpb25hbCIgdHlwZT0iYm9vbCIgYXJyYXk9ImZhbHNlIiByZXF1aXJlZD0iZmFsc2UiPuaYr+WQpueCuuWPr+mBuOeahOWPg+aVuDwvaXRlbT4KCQkJPGl0ZW0gbmFtZT0iQG51bGxhYmxlIiB0eXBlPSJib29sIiBhcnJheT0iZmFsc2UiIHJlcXVpcmVkPSJmYWxzZSI+5piv5ZCm5Y+v5Lul54K6IG51bGzvvIzlg4XlsI0gSlNPTiDnrYnmlK/mjIEgbnVsbCDlgLznmoTmoLzlvI/mnInmlYjjgILlpoLmnpzmmK/mlbjntYTvvIzooajnpLrmlbjntYTmnKzouqvlj6/ku6XngrogbnVsbO+8jOiAjOS4jeaYr+WFtuWFg+e0oOOAgjwvaXRlbT4KCQkJPGl0ZW0gbmFtZT0iQGFycmF5IiB0eXBlPSJib29sIiBhcnJheT0iZmFsc2UiIHJlcXVpcmVkPSJmYWxzZSI+5piv5ZCm54K65pW457WEPC9pdGVtPgoJCQk8aXRlbSBuYW1lPSJAc3VtbWFyeSIgdHlwZT0ic3RyaW5nIiBhcnJheT0iZmFsc2UiIHJlcXVpcmVkPSJmYWxzZSI+57Ch6KaB5LuL57S5PC9pdGVtPgoJCQk8aXRlbSBuYW1lPSJAZGlzY3JpbWluYXRvciIgdHlwZT0ic3RyaW5nIiBhcnJheT0iZmFsc2UiIHJlcXVpcmVkPSJmYWxzZSI+55So5pa85Y2A5YiGIG9uZS1vZiDliIbmlK/nmoTlrZfmrrXlkI3vvIzoqbLlrZfmrrXnmoTlgLzljbPngrrliIbmlK/nmoQgbmFtZSDlgLzjgII8L2l0ZW0+CgkJCTxpdGVtIG5hbWU9IkBhcnJheS1zdHlsZSIgdHlwZT0iYm9vbCIgYXJyYXk9ImZhbHNlIiByZXF1aXJlZD0iZmFsc2UiPuS7peaVuOe1hOeahOaWueW8j+WxleekuuaVuOaTmjwvaXRlbT4KCQkJPGl0ZW0gbmFtZT0icGFyYW0iIHR5cGU9InBhcmFtIiBhcnJheT0idHJ1ZSIgcmVxdWlyZWQ9ImZhbHNlIj7lrZDpoZ7lnovvvIzmr5TlpoLlsI3osaHnmoTlrZDlhYPntKDjgII8L2l0ZW0+CgkJCTxpdGVtIG5hbWU9ImVudW0iIHR5cGU9ImVudW0iIGFycmF5PSJ0cnVlIiByZXF1aXJlZD0iZmFsc2UiPueVtuWJjeWPg+aVuOWPr+eUqOeahOaemuiIieWAvDwvaXRlbT4KCQkJPGl0ZW0gbmFtZT0iZGVzY3JpcHRpb24iIHR5cGU9InJpY2h0ZXh0IiBhcnJheT0iZmFsc2UiIHJlcXVpcmVkPSJmYWxzZSI+6Kmz57Sw5LuL57S577yM54K6IEhUTUwg5YWn5a6544CCPC9pdGVtPgoJCQk8aXRlbSBuYW1lPSJvbmUtb2YiIHR5cGU9InBhcmFtIiBhcnJheT0idHJ1ZSIgcmVxdWlyZWQ9ImZhbHNlIj7oga/lkIjpoZ7lnovnmoTlkITlgIvliIbmlK/vvIzlj6rog73ljLnphY3lhbbkuK3kuYvlo7nvvIzkuI3og73oiIcgYW55LW9mIOWQjOaZguS9v+eUqOOAgjwvaXRlbT4KCQkJPGl0ZW0gbmFtZT0iYW55LW9mIiB0eXBlPSJwYXJhbSIgYXJyYXk9InRydWUiIHJlcXVpcmVkPSJmYWxzZSI+6IGv5ZCI6aGe5Z6L55qE5ZCE5YCL5YiG5pSv77yM6Iez5bCR5Yy56YWN5YW25Lit5LmL5aO577yM5LiN6IO96IiHIG9uZS1vZiDlkIzmmYLkvb/nlKjjgII8L2l0ZW0+CgkJPC90eXBlPgoJCTx0eXBlIG5hbWU9ImVudW0iPgoJCQk8dXNhZ2U+5a6a576p5p6a6IiJ6aGe5Z6L55qE5pW45omA55qE5p6a6IiJ5YC8PC91c2FnZT4KCQkJPGl0ZW0gbmFtZT0iQGRlcHJlY2F0ZWQiIHR5cGU9InZlcnNpb24iIGFycmF5PSJmYWxzZSIgcmVxdWlyZWQ9ImZhbHNlIj7oqbLlsazmgKfmo4TnlKjnmoTniYjmnKzomZ88L2l0ZW0+CgkJCTxpdGVtIG5hbWU9IkB2YWx1ZSIgdHlwZT0ic3RyaW5nIiBhcnJheT0iZmFsc2UiIHJlcXVpcmVkPSJ0cnVlIj7mnproiInlgLw8L2l0ZW0+CgkJCTxpdGVtIG5hbWU9IkBzdW1tYXJ5IiB0eXBlPSJzdHJpbmciIGFycmF5PSJmYWxzZSIgcmVxdWlyZWQ9ImZhbHNlIj7mnproiInlgLznmoToqqrmmI48L2l0ZW0+CgkJCTxpdGVtIG5hbWU9ImRlc2NyaXB0aW9uIiB0eXBlPSJyaWNodGV4dCIgYXJyYXk9ImZhbHNlIiByZXF1aXJlZD0iZmFsc2UiPuaemuiIieWAvOeahOips+e0sOiqquaYjjwvaXRlbT4KCQk8L3R5cGU+CgkJPHR5cGUgbmFtZT0ic2VjdXJpdHkiPgoJCQk8dXNhZ2U+5a6a576p6Lqr5Lu96amX6K2J55qE5pa55byPPC91c2FnZT4KCQkJPGl0ZW0gbmFtZT0iQG5hbWUiIHR5cGU9InN0cmluZyIgYXJyYXk9ImZhbHNlIiByZXF1aXJlZD0idHJ1ZSI+6Lqr5Lu96amX6K2J5pa55byP55qE5ZSv5aO5IElEPC9pdGVtPgoJCQk8aXRlbSBuYW1lPSJAdHlwZSIgdHlwZT0ic3RyaW5nIiBhcnJheT0iZmFsc2UiIHJlcXVpcmVkPSJ0cnVlIj7ouqvku73pqZforYnnmoTpoZ7lnovvvIzlj6/ku6XmmK8gPHZhcj5hcGlrZXk8L3Zhcj7jgIE8dmFyPmh0dHA8L3Zhcj7jgIE8dmFyPm9hdXRoMjwvdmFyPiDlkowgPHZhcj5vcGVuaWRjb25uZWN0PC92YXI+44CCPC9pdGVtPgoJCQk8aXRlbSBuYW1lPSJAc3VtbWFyeSIgdHlwZT0ic3RyaW5nIiBhcnJheT0iZmFsc2UiIHJlcXVpcmVkPSJmYWxzZSI+57Ch6KaB5LuL57S5PC9pdGVtPgoJCQk8aXRlbSBuYW1lPSJAaW4iIHR5cGU9InN0cmluZyIgYXJyYXk9ImZhbHNlIiByZXF1aXJlZD0iZmFsc2UiPjx2YXI+YXBpa2V5PC92YXI+IOaJgOWcqOeahOS9jee9ru+8jOWPr+S7peaYryA8dmFyPmhlYWRlcjwvdmFyPuOAgTx2YXI+cXVlcnk8L3Zhcj4g5ZKMIDx2YXI+Y29va2llPC92YXI+44CCPC9pdGVtPgoJCQk8aXRlbSBuYW1lPSJAa2V5IiB0eXBlPSJzdHJpbmciIGFycmF5PSJmYWxzZSIgcmVxdWlyZWQ9ImZhbHNlIj48dmFyPmFwaWtleTwvdmFyPiDlsI3mh4nnmoTlj4PmlbjlkI3nqLE8L2l0ZW0+CgkJCTxpdGVtIG5hbWU9IkBzY2hlbWUiIHR5cGU9InN0cmluZyIgYXJyYXk9ImZhbHNlIiByZXF1aXJlZD0iZmFsc2UiPjx2YXI+aHR0cDwvdmFyPiDpqZforYnmlrnlvI/nmoTlkI3nqLHvvIzmr5TlpoIgPHZhcj5iYXNpYzwvdmFyPuOAgTx2YXI+YmVhcmVyPC92YXI+IOetieOAgjwvaXRlbT4KCQkJPGl0ZW0gbmFtZT0iQGJlYXJlci1mb3JtYXQiIHR5cGU9InN0cmluZyIgYXJyYXk9ImZhbHNlIiByZXF1aXJlZD0iZmFsc2UiPjx2YXI+YmVhcmVyPC92YXI+IOS7pOeJjOeahOagvOW8j++8jOavlOWmgiA8dmFyPkpXVDwvdmFyPuOAgjwvaXRlbT4KCQkJPGl0ZW0gbmFtZT0iQG9wZW5pZC1jb25uZWN0LXVybCIgdHlwZT0ic3RyaW5nIiBhcnJheT0iZmFsc2UiIHJlcXVpcmVkPSJmYWxzZSI+PHZhcj5vcGVuaWRjb25uZWN0PC92YXI+IOeahOmFjee9ruWcsOWdgDwvaXRlbT4KCQkJPGl0ZW0gbmFtZT0iZGVzY3JpcHRpb24iIHR5cGU9InJpY2h0ZXh0IiBhcnJheT0iZmFsc2UiIHJlcXVpcmVkPSJmYWxzZSI+6Kmz57Sw5LuL57S577yM54K6IEhUTUwg5YWn5a6544CCPC9pdGVtPgoJCQk8aXRlbSBuYW1lPSJmbG93IiB0eXBlPSJvYXV0aC1mbG93IiBhcnJheT0idHJ1ZSIgcmVxdWlyZWQ9ImZhbHNlIj48dmFyPm9hdXRoMjwvdmFyPiDmlK/mjIHnmoTmjojmrIrmtYHnqIs8L2l0ZW0+CgkJPC90eXBlPgoJCTx0eXBlIG5hbWU9Im9hdXRoLWZsb3ciPgoJCQk8dXNhZ2U+PHZhcj5vYXV0aDI8L3Zhcj4g55qE5o6I5qyK5rWB56iLPC91c2FnZT4KCQkJPGl0ZW0gbmFtZT0iQHR5cGUiIHR5cGU9InN0cmluZyIgYXJyYXk9ImZhbHNlIiByZXF1aXJlZD0idHJ1ZSI+5o6I5qyK5rWB56iL55qE6aGe5Z6L77yM5Y+v5Lul5pivIDx2YXI+aW1wbGljaXQ8L3Zhcj7jgIE8dmFyPnBhc3N3b3JkPC92YXI+44CBPHZhcj5jbGllbnQtY3JlZGVudGlhbHM8L3Zhcj4g5ZKMIDx2YXI+YXV0aG9yaXphdGlvbi1jb2RlPC92YXI+44CCPC9pdGVtPgoJCQk8aXRlbSBuYW1lPSJAYXV0aG9yaXphdGlvbi11cmwiIHR5cGU9InN0cmluZyIgYXJyYXk9ImZhbHNlIiByZXF1aXJlZD0iZmFsc2UiPuaOiOasiuWcsOWdgO+8jDx2YXI+aW1wbGljaXQ8L3Zhcj4g5ZKMIDx2YXI+YXV0aG9yaXphdGlvbi1jb2RlPC92YXI+IOW/hemgiOaMh+WumuOAgjwvaXRlbT4KCQkJPGl0ZW0gbmFtZT0iQHRva2VuLXVybCIgdHlwZT0ic3RyaW5nIiBhcnJheT0iZmFsc2UiIHJlcXVpcmVkPSJmYWxzZSI+542y5Y+W5Luk54mM55qE5Zyw5Z2A77yMPHZhcj5wYXNzd29yZDwvdmFyPuOAgTx2YXI+Y2xpZW50LWNyZWRlbnRpYWxzPC92YXI+IOWSjCA8dmFyPmF1dGhvcml6YXRpb24tY29kZTwvdmFyPiDlv4XpoIjmjIflrprjgII8L2l0ZW0+CgkJCTxpdGVtIG5hbWU9IkByZWZyZXNoLXVybCIgdHlwZT0ic3RyaW5nIiBhcnJheT0iZmFsc2UiIHJlcXVpcmVkPSJmYWxzZSI+5Yi35paw5Luk54mM55qE5Zyw5Z2APC9pdGVtPgoJCQk8aXRlbSBuYW1lPSJzY29wZSIgdHlwZT0ic2NvcGUiIGFycmF5PSJ0cnVlIiByZXF1aXJlZD0iZmFsc2UiPuWPr+eUqOeahOasiumZkOevhOWcjTwvaXRlbT4KCQk8L3R5cGU+CgkJPHR5cGUgbmFtZT0ic2NvcGUiPgoJCQk8dXNhZ2U+5qyK6ZmQ56+E5ZyNPC91c2FnZT4KCQkJPGl0ZW0gbmFtZT0iQG5hbWUiIHR5cGU9InN0cmluZyIgYXJyYXk9ImZhbHNlIiByZXF1aXJlZD0idHJ1ZSI+5qyK6ZmQ56+E5ZyN55qE5ZCN56ixPC9pdGVtPgoJCQk8aXRlbSBuYW1lPSJAc3VtbWFyeSIgdHlwZT0ic3RyaW5nIiBhcnJheT0iZmFsc2UiIHJlcXVpcmVkPSJ0cnVlIj7nsKHopoHku4vntLk8L2l0ZW0+CgkJPC90eXBlPgoJCTx0eXBlIG5hbWU9ImFwaSI+CgkJCTx1c2FnZT7nlKjmlrzlrprnvqnllq7lgIsgQVBJIOaOpeWPo+eahOWFt+mrlOWFp+WuuTwvdXNhZ2U+CgkJCTxpdGVtIG5hbWU9IkB2ZXJzaW9uIiB0eXBlPSJ2ZXJzaW9uIiBhcnJheT0iZmFsc2UiIHJlcXVpcmVkPSJmYWxzZSI+6KGo56S65q2k5o6l5Y+j5Zyo6Kmy54mI5pys5Lit5re75YqgPC9pdGVtPgoJCQk8aXRlbSBuYW1lPSJAbWV0aG9kIiB0eXBlPSJzdHJpbmciIGFycmF5PSJmYWxzZSIgcmVxdWlyZWQ9InRydWUiPueVtuWJjeaOpeWPo+aJgOaUr+aMgeeahOiri+axguaWueazlTwvaXRlbT4KCQkJPGl0ZW0gbmFtZT0iQGlkIiB0eXBlPSJzdHJpbmciIGFycmF5PSJmYWxzZSIgcmVxdWlyZWQ9ImZhbHNlIj7mjqXlj6PnmoTllK/lo7kgSUQ8L2l0ZW0+CgkJCTxpdGVtIG5hbWU9IkBzdW1tYXJ5IiB0eXBlPSJzdHJpbmciIGFycmF5PSJmYWxzZSIgcmVxdWlyZWQ9ImZhbHNlIj7nsKHopoHku4vntLk8L2l0ZW0+CgkJCTxpdGVtIG5hbWU9IkBkZXByZWNhdGVkIiB0eXBlPSJ2ZXJzaW9uIiBhcnJheT0iZmFsc2UiIHJlcXVpcmVkPSJmYWxzZSI+5Zyo5q2k54mI5pys5LmL5b6M5bCH5pyD6KKr5qOE55SoPC9pdGVtPgoJCQk8aXRlbSBuYW1lPSJwYXRoIiB0eXBlPSJwYXRoIiBhcnJheT0iZmFsc2UiIHJlcXVpcmVkPSJ0cnVlIj7lrprnvqnot6/lvpHkv6Hmga88L2l0ZW0+CgkJCTxpdGVtIG5hbWU9ImRlc2NyaXB0aW9uIiB0eXBlPSJyaWNodGV4dCIgYXJyYXk9ImZhbHNlIiByZXF1aXJlZD0iZmFsc2UiPuipsuaOpeWPo+eahOips+e0sOS7i+e0ue+8jOeCuiBIVE1MIOWFp+WuueOAgjwvaXRlbT4KCQkJPGl0ZW0gbmFtZT0icmVxdWVzdCIgdHlwZT0icmVxdWVzdCIgYXJyYXk9InRydWUiIHJlcXVpcmVkPSJmYWxzZSI+5a6a576p5Y+v55So55qE6KuL5rGC5L+h5oGvPC9pdGVtPgoJCQk8aXRlbSBuYW1lPSJyZXNwb25zZSIgdHlwZT0icmVxdWVzdCIgYXJyYXk9InRydWUiIHJlcXVpcmVkPSJmYWxzZSI+5a6a576p5Y+v6IO955qE6L+U5Zue5L+h5oGvPC9pdGVtPgoJCQk8aXRlbSBuYW1lPSJjYWxsYmFjayIgdHlwZT0iY2FsbGJhY2siIGFycmF5PSJmYWxzZSIgcmVxdWlyZWQ9ImZhbHNlIj7lrprnvqnlm57oqr/mjqXlj6Plhaflrrk8L2l0ZW0+CgkJCTxpdGVtIG5hbWU9ImhlYWRlciIgdHlwZT0icGFyYW0iIGFycmF5PSJ0cnVlIiByZXF1aXJlZD0iZmFsc2UiPuWCs+mBnueahOWgsemgreWFp+Wuue+8jOWmguaenOaYr+afkOWAiyBtaW1ldHlwZSDlsIjnlKjnmoTvvIzlj6/ku6XmlL7lnKggcmVxdWVzdCDlhYPntKDkuK3jgII8L2l0ZW0+CgkJCTxpdGVtIG5hbWU9ImNvb2tpZSIgdHlwZT0icGFyYW0iIGFycmF5PSJ0cnVlIiByZXF1aXJlZD0iZmFsc2UiPuWCs+mBnueahCBjb29raWUg5YWn5a6577yM5YOF5pSv5oyB57Ch5Zau6aGe5Z6L44CCPC9pdGVtPgoJCQk8aXRlbSBuYW1lPSJ0YWciIHR5cGU9InN0cmluZyIgYXJyYXk9InRydWUiIHJlcXVpcmVkPSJmYWxzZSI+6Zec6IGv55qE5qiZ57C9PC9pdGVtPgoJCQk8aXRlbSBuYW1lPSJzZXJ2ZXIiIHR5cGU9InN0cmluZyIgYXJyYXk9InRydWUiIHJlcXVpcmVkPSJmYWxzZSI+6Zec6IGv55qE5pyN5YuZPC9pdGVtPgoJCQk8aXRlbSBuYW1lPSJzZWN1cml0eSIgdHlwZT0ic2VjdXJpdHktdmFsdWUiIGFycmF5PSJ0cnVlIiByZXF1aXJlZD0iZmFsc2UiPuioquWVj+ipsuaOpeWPo+mcgOimgeeahOi6q+S7vempl+itieaWueW8j++8jOa7v+i2s+WFtuS4reS7u+aEj+WjuemgheWNs+WPr+OAgjwvaXRlbT4KCQk8L3R5cGU+CgkJPHR5cGUgbmFtZT0icGF0aCI+CgkJCTx1c2FnZT7nlKjmlrzlrprnvqnoq4vmsYLmmYLoiIfot6/lvpHnm7jpl5znmoTlhaflrrk8L3VzYWdlPgoJCQk8aXRlbSBuYW1lPSJAcGF0aCIgdHlwZT0ic3RyaW5nIiBhcnJheT0iZmFsc2UiIHJlcXVpcmVkPSJ0cnVlIj7mjqXlj6PlnLDlnYA8L2l0ZW0+CgkJCTxpdGVtIG5hbWU9InBhcmFtIiB0eXBlPSJwYXJhbSIgYXJyYXk9InRydWUiIHJlcXVpcmVkPSJmYWxzZSI+5Zyw5Z2A5Lit55qE5Y+D5pW4PC9pdGVtPgoJCQk8aXRlbSBuYW1lPSJxdWVyeSIgdHlwZT0icGFyYW0iIGFycmF5PSJ0cnVlIiByZXF1aXJlZD0iZmFsc2UiPuWcsOWdgOS4reeahOafpeipouWPg+aVuDwvaXRlbT4KCQk8L3R5cGU+CgkJPHR5cGUgbmFtZT0icmVxdWVzdCI+CgkJCTx1c2FnZT7lrprnvqnkuoboq4vmsYLlkozov5Tlm57nmoTnm7jpl5zlhaflrrk8L3VzYWdlPgoJCQk8aXRlbSBuYW1lPSJAeG1sLWF0dHIiIHR5cGU9ImJvb2wiIGFycmF5PSJmYWxzZSIgcmVxdWlyZWQ9ImZhbHNlIj7mmK/lkKbkvZzngrrniLblhYPntKDnmoTlsazmgKfvvIzlg4XkvZznlKjmlrwgWE1MIOWFg+e0oOOAguaYr+WQpuS9nOeCuueItuWFg+e0oOeahOWxrOaAp++8jOWDheeUqOaWvCBYTUwg55qE6KuL5rGC44CCPC9pdGVtPgoJCQk8aXRlbSBuYW1lPSJAeG1sLWV4dHJhY3QiIHR5cGU9ImJvb2wiIGFycmF5PSJmYWxzZSIgcmVxdWlyZWQ9ImZhbHNlIj7lsIfnlbbliY3lhYPntKDnmoTlhaflrrnkvZzngrrniLblhYPntKDnmoTlhaflrrnvvIzopoHmsYLniLblhYPntKDlv4XpoIjngrogPHZhcj5vYmplY3Q8L3Zhcj7jgII8L2l0ZW0+CgkJCTxpdGVtIG5hbWU9IkB4bWwtY2RhdGEiIHR5cGU9ImJvb2wiIGFycmF5PSJmYWxzZSIgcmVxdWlyZWQ9ImZhbHNlIj7nlbbliY3lhaflrrnngrogQ0RBVEHvvIzoiIcgPGNvZGU+QHhtbC1hdHRyPC9jb2RlPiDkupLmlqXjgII8L2l0ZW0+CgkJCTxpdGVtIG5hbWU9IkB4bWwtbnMtcHJlZml4IiB0eXBlPSJzdHJpbmciIGFycmF5PSJmYWxzZSIgcmVxdWlyZWQ9ImZhbHNlIj5YTUwg5qiZ57C955qE5ZG95ZCN56m66ZaT5ZCN56ix5YmN57a0PC9pdGVtPgoJCQk8aXRlbSBuYW1lPSJAeG1sLXdyYXBwZWQiIHR5cGU9InN0cmluZyIgYXJyYXk9ImZhbHNlIiByZXF1aXJlZD0iZmFsc2UiPuWmguaenOeVtuWJjeWFg+e0oOeahCA8Y29kZT5AYXJyYXk8L2NvZGU+IOeCuiA8dmFyPnRydWU8L3Zhcj7vvIzliYflj6/ku6XpgJrpgY7mraTlgLzmjIflrprlnKggWE1MIOagvOW8j+S4reeahOWQjeeoseOAggoJ5Y+v5Lul5pyJ5LiJ56iu5qC85byP77yaPHVsPgoJPGxpPjxzYW1wPm5hbWU8L3NhbXA+77ya6KGo56S654K65pW457WE5re75Yqg5aO55YCL54i25YWD57Sg5ZCN56ix54K6IDx2YXI+bmFtZTwvdmFyPu+8mzwvbGk+Cgk8bGk+PHNhbXA+bmFtZTEmZ3Q7bmFtZTI8L3NhbXA+77ya6KGo56S65pW457WE6aCF55qE5ZCN56ix5pS554K6IDx2YXI+bmFtZTI8L3Zhcj7vvIzkuJTmt7vliqDlo7nlgIvniLblhYPntKDlkI3ngrogPHZhcj5uYW1lMTwvdmFyPu+8mzwvbGk+Cgk8bGk+PHNhbXA+Jmd0O25hbWU8L3NhbXA+77ya6KGo56S65bCH55W25YmN5pW457WE5YWD57Sg55qE5ZCN56ix5pS554K6IDx2YXI+bmFtZTwvdmFyPu+8mzwvbGk+Cgk8L3VsPjwvaXRlbT4KCQkJPGl0ZW0gbmFtZT0iQG5hbWUiIHR5cGU9InN0cmluZyIgYXJyYXk9ImZhbHNlIiByZXF1aXJlZD0iZmFsc2UiPueVtiBtaW1ldHlwZSDngrogPHZhcj5hcHBsaWNhdGlvbi94bWw8L3Zhcj4g5pmC77yM5q2k5YC86KGo56S6IFhNTCDnmoTpoILlsaTlhYPntKDlkI3nqLHvvIzlkKbliYfnhKHnlKjjgII8L2l0ZW0+CgkJCTxpdGVtIG5hbWU9IkB0eXBlIiB0eXBlPSJ0eXBlIiBhcnJheT0iZmFsc2UiIHJlcXVpcmVkPSJmYWxzZSI+5YC855qE6aGe5Z6LPC9pdGVtPgoJCQk8aXRlbSBuYW1lPSJAZGVwcmVjYXRlZCIgdHlwZT0idmVyc2lvbiIgYXJyYXk9ImZhbHNlIiByZXF1aXJlZD0iZmFsc2UiPuihqOekuuWcqOWkp+aWvOetieaWvOipsueJiOacrOiZn+aZguS4jeWGjeWVn+S9nOeUqDwvaXRlbT4KCQkJPGl0ZW0gbmFtZT0iQG51bGxhYmxlIiB0eXBlPSJib29sIiBhcnJheT0iZmFsc2UiIHJlcXVpcmVkPSJmYWxzZSI+5piv5ZCm5Y+v5Lul54K6IG51bGzvvIzlg4XlsI0gSlNPTiDnrYnmlK/mjIEgbnVsbCDlgLznmoTmoLzlvI/mnInmlYjjgILlpoLmnpzmmK/mlbjntYTvvIzooajnpLrmlbjntYTmnKzouqvlj6/ku6XngrogbnVsbO+8jOiAjOS4jeaYr+WFtuWFg+e0oOOAgjwvaXRlbT4KCQkJPGl0ZW0gbmFtZT0iQGFycmF5IiB0eXBlPSJib29sIiBhcnJheT0iZmFsc2UiIHJlcXVpcmVkPSJmYWxzZSI+5piv5ZCm54K65pW457WEPC9pdGVtPgoJCQk8aXRlbSBuYW1lPSJAc3VtbWFyeSIgdHlwZT0ic3RyaW5nIiBhcnJheT0iZmFsc2UiIHJlcXVpcmVkPSJmYWxzZSI+57Ch6KaB5LuL57S5PC9pdGVtPgoJCQk8aXRlbSBuYW1lPSJAc3RhdHVzIiB0eXBlPSJudW1iZXIiIGFycmF5PSJmYWxzZSIgcmVxdWlyZWQ9ImZhbHNlIj7ni4DmhYvnorzjgILlnKggcmVxdWVzdCDkuK3vvIzoqbLlgLzkuI3lj6/nlKjvvIzlkKbliYfngrrlv4XloavpoIXjgII8L2l0ZW0+CgkJCTxpdGVtIG5hbWU9IkBtaW1ldHlwZSIgdHlwZT0ic3RyaW5nIiBhcnJheT0iZmFsc2UiIHJlcXVpcmVkPSJmYWxzZSI+5aqS6auU6aGe5Z6L77yM5q+U5aaCIDx2YXI+YXBwbGljYXRpb24vanNvbjwvdmFyPiDnrYnjgII8L2l0ZW0+CgkJCTxpdGVtIG5hbWU9IkBkaXNjcmltaW5hdG9yIiB0eXBlPSJzdHJpbmciIGFycmF5PSJmYWxzZSIgcmVxdWlyZWQ9ImZhbHNlIj7nlKjmlrzljYDliIYgb25lLW9mIOWIhuaUr+eahOWtl+auteWQje+8jOipsuWtl+auteeahOWAvOWNs+eCuuWIhuaUr+eahCBuYW1lIOWAvOOAgjwvaXRlbT4KCQkJPGl0ZW0gbmFtZT0iZW51bSIgdHlwZT0iZW51bSIgYXJyYXk9InRydWUiIHJlcXVpcmVkPSJmYWxzZSI+55W25YmN5Y+D5pW45Y+v55So55qE5p6a6IiJ5YC8PC9pdGVtPgoJCQk8aXRlbSBuYW1lPSJwYXJhbSIgdHlwZT0icGFyYW0iIGFycmF5PSJ0cnVlIiByZXF1aXJlZD0iZmFsc2UiPuWtkOmhnuWei++8jOavlOWmguWwjeixoeeahOWtkOWFg+e0oOOAgjwvaXRlbT4KCQkJPGl0ZW0gbmFtZT0iZXhhbXBsZSIgdHlwZT0iZXhhbXBsZSIgYXJyYXk9InRydWUiIHJlcXVpcmVkPSJmYWxzZSI+56S65L6L5Luj56K8PC9pdGVtPgoJCQk8aXRlbSBuYW1lPSJoZWFkZXIiIHR5cGU9InBhcmFtIiBhcnJheT0idHJ1ZSIgcmVxdWlyZWQ9ImZhbHNlIj7lgrPpgZ7nmoTloLHpoK3lhaflrrk8L2l0ZW0+CgkJCTxpdGVtIG5hbWU9ImNvb2tpZSIgdHlwZT0icGFyYW0iIGFycmF5PSJ0cnVlIiByZXF1aXJlZD0iZmFsc2UiPuWCs+mBnueahCBjb29raWUg5YWn5a6577yM5YOF5pSv5oyB57Ch5Zau6aGe5Z6L44CC5aaC5p6c5piv6L+U5Zue5bCN6LGh77yM6KGo56S66ZyA6KaB6Kit572u55qEIGNvb2tpZeOAgjwvaXRlbT4KCQkJPGl0ZW0gbmFtZT0iZGVzY3JpcHRpb24iIHR5cGU9InJpY2h0ZXh0IiBhcnJheT0iZmFsc2UiIHJlcXVpcmVkPSJmYWxzZSI+6Kmz57Sw5LuL57S577yM54K6IEhUTUwg5YWn5a6544CCPC9pdGVtPgoJCQk8aXRlbSBuYW1lPSJvbmUtb2YiIHR5cGU9InBhcmFtIiBhcnJheT0idHJ1ZSIgcmVxdWlyZWQ9ImZhbHNlIj7oga/lkIjpoZ7lnovnmoTlkITlgIvliIbmlK/vvIzlj6rog73ljLnphY3lhbbkuK3kuYvlo7nvvIzkuI3og73oiIcgYW55LW9mIOWQjOaZguS9v+eUqOOAgjwvaXRlbT4KCQkJPGl0ZW0gbmFtZT0iYW55LW9mIiB0eXBlPSJwYXJhbSIgYXJyYXk9InRydWUiIHJlcXVpcmVkPSJmYWxzZSI+6IGv5ZCI6aGe5Z6L55qE5ZCE5YCL5YiG5pSv77yM6Iez5bCR5Yy56YWN5YW25Lit5LmL5aO577yM5LiN6IO96IiHIG9uZS1vZiDlkIzmmYLkvb/nlKjjgII8L2l0ZW0+CgkJPC90eXBlPgoJCTx0eXBlIG5hbWU9ImV4YW1wbGUiPgoJCQk8dXNhZ2U+56S65L6L5Luj56K8PC91c2FnZT4KCQkJPGl0ZW0gbmFtZT0iQG1pbWV0eXBlIiB0eXBlPSJzdHJpbmciIGFycmF5PSJmYWxzZSIgcmVxdWlyZWQ9InRydWUiPueJueWumuaWvOmhnuWei+eahOekuuS+i+S7o+eivDwvaXRlbT4KCQkJPGl0ZW0gbmFtZT0iQHN1bW1hcnkiIHR5cGU9InN0cmluZyIgYXJyYXk9ImZhbHNlIiByZXF1aXJlZD0iZmFsc2UiPuekuuS+i+S7o+eivOeahOamguimgeS/oeaBrzwvaXRlbT4KCQkJPGl0ZW0gbmFtZT0iLiIgdHlwZT0ic3RyaW5nIiBhcnJheT0iZmFsc2UiIHJlcXVpcmVkPSJ0cnVlIj7npLrkvovku6PnorznmoTlhaflrrnvvIzpnIDopoHkvb/nlKggQ0RBVEEg5YyF5ZCr5Luj56K844CCPC9pdGVtPgoJCTwvdHlwZT4KCQk8dHlwZSBuYW1lPSJjYWxsYmFjayI+CgkJCTx1c2FnZT7lrprnvqnmjqXlj6PnmoTlm57oqr/lhaflrrk8L3VzYWdlPgoJCQk8aXRlbSBuYW1lPSJAbWV0aG9kIiB0eXBlPSJzdHJpbmciIGFycmF5PSJmYWxzZSIgcmVxdWlyZWQ9InRydWUiPuWbnuiqv+eahOiri+axguaWueazlTwvaXRlbT4KCQkJPGl0ZW0gbmFtZT0iQHN1bW1hcnkiIHR5cGU9InN0cmluZyIgYXJyYXk9ImZhbHNlIiByZXF1aXJlZD0iZmFsc2UiPuewoeimgeS7i+e0uTwvaXRlbT4KCQkJPGl0ZW0gbmFtZT0iQGRlcHJlY2F0ZWQiIHR5cGU9InZlcnNpb24iIGFycmF5PSJmYWxzZSIgcmVxdWlyZWQ9ImZhbHNlIj7lnKjmraTniYjmnKzkuYvlvozlsIfmnIPooqvmo4TnlKg8L2l0ZW0+CgkJCTxpdGVtIG5hbWU9InBhdGgiIHR5cGU9InBhdGgiIGFycmF5PSJmYWxzZSIgcmVxdWlyZWQ9ImZhbHNlIj7lm57oqr/nmoToq4vmsYLlnLDlnYA8L2l0ZW0+CgkJCTxpdGVtIG5hbWU9ImRlc2NyaXB0aW9uIiB0eXBlPSJyaWNodGV4dCIgYXJyYXk9ImZhbHNlIiByZXF1aXJlZD0iZmFsc2UiPuWwjeaWvOWbnuiqv+eahOips+e0sOS7i+e0uTwvaXRlbT4KCQkJPGl0ZW0gbmFtZT0icmVzcG9uc2UiIHR5cGU9InJlcXVlc3QiIGFycmF5PSJ0cnVlIiByZXF1aXJlZD0iZmFsc2UiPuWumue+qeWPr+iDveeahOi/lOWbnuS/oeaBrzwvaXRlbT4KCQkJPGl0ZW0gbmFtZT0icmVxdWVzdCIgdHlwZT0icmVxdWVzdCIgYXJyYXk9InRydWUiIHJlcXVpcmVkPSJ0cnVlIj7lrprnvqnlj6/nlKjnmoToq4vmsYLkv6Hmga88L2l0ZW0+CgkJCTxpdGVtIG5hbWU9ImhlYWRlciIgdHlwZT0icGFyYW0iIGFycmF5PSJ0cnVlIiByZXF1aXJlZD0iZmFsc2UiPuWCs+mBnueahOWgsemgreWFp+WuuTwvaXRlbT4KCQk8L3R5cGU+CgkJPHR5cGUgbmFtZT0ic2VjdXJpdHktdmFsdWUiPgoJCQk8dXNhZ2U+5byV55SoIDx2YXI+YXBpZG9jPC92YXI+IOS4reWumue+qeeahOi6q+S7vempl+itieaWueW8jzwvdXNhZ2U+CgkJCTxpdGVtIG5hbWU9IkBuYW1lIiB0eXBlPSJzdHJpbmciIGFycmF5PSJmYWxzZSIgcmVxdWlyZWQ9InRydWUiPui6q+S7vempl+itieaWueW8j+eahCBJRDwvaXRlbT4KCQkJPGl0ZW0gbmFtZT0ic2NvcGUiIHR5cGU9InN0cmluZyIgYXJyYXk9InRydWUiIHJlcXVpcmVkPSJmYWxzZSI+6ZyA6KaB55qE5qyK6ZmQ56+E5ZyN77yM5YOF5bCNIDx2YXI+b2F1dGgyPC92YXI+IOWSjCA8dmFyPm9wZW5pZGNvbm5lY3Q8L3Zhcj4g5pyJ5pWI44CCPC9pdGVtPgoJCTwvdHlwZT4KCQk8dHlwZSBuYW1lPSJzdHJpbmciPgoJCQk8dXNhZ2U+5pmu6YCa55qE5a2X56ym5Liy6aGe5Z6L77yM54m55q6K5a2X56ym6ZyA6KaB5L2/55SoIFhNTCDlr6bpq5TvvIzmr5TlpoIgPHNhbXA+Jmx0Ozwvc2FtcD4g6ZyA6KaB5L2/55SoIDxzYW1wPiZhbXA7bHQ7PC9zYW1wPiDku6Pmm7/jgII8L3VzYWdlPgoJCTwvdHlwZT4KCQk8dHlwZSBuYW1lPSJkYXRlIj4KCQkJPHVzYWdlPumHh+eUqCA8YSBocmVmPSJodHRwczovL3Rvb2xzLmlldGYub3JnL2h0bWwvcmZjMzMzOSI+UkZDMzMzOTwvYT4g5qC85byP6KGo56S655qE5pmC6ZaT77yM5q+U5aaC77yaPHNhbXA+MjAxOS0xMi0xNlQwMDozNTo0OCswODowMDwvc2FtcD7jgII8L3VzYWdlPgoJCTwvdHlwZT4KCQk8dHlwZSBuYW1lPSJ2ZXJzaW9uIj4KCQkJPHVzYWdlPueJiOacrOiZn++8jOagvOW8j+mBteWuiCA8YSBocmVmPSJodHRwczovL3NlbXZlci5vcmcvbGFuZy96aC1DTi8iPnNlbXZlcjwvYT4g6KaP5YmH44CC5q+U5aaC77yaPHNhbXA+MS4wLjE8L3NhbXA+44CBPHNhbXA+MS4wLjErMjAyMDA2MTg8L3NhbXA+44CCPC91c2FnZT4KCQk8L3R5cGU+CgkJPHR5cGUgbmFtZT0ibnVtYmVyIj4KCQkJPHVzYWdlPuaZrumAmueahOaVuOWAvOmhnuWei++8jOavlOWmgu+8mjxzYW1wPjE8L3NhbXA+44CBPHNhbXA+LTExLjE8L3NhbXA+IOetieOAgjwvdXNhZ2U+CgkJPC90eXBlPgoJCTx0eXBlIG5hbWU9InR5cGUiPgoJCQk8dXNhZ2U+55So5pa86KGo56S65pW45pOa55qE6aGe5Z6L5YC877yM5qC85byP54K6IDxjb2RlPnByaW1pdGl2ZVsuc3VidHlwZV08L2NvZGU+77yM5YW25LitIDxjb2RlPnByaW1pdGl2ZTwvY29kZT4g54K65Z+65pys6aGe5Z6L77yM6ICMIDxjb2RlPnN1YnR5cGU8L2NvZGU+IOeCuuWtkOmhnuWei++8jOeUqOaWvOWwjSA8Y29kZT5wcmltaXRpdmU8L2NvZGU+IOmAsuihjOmAsuWjueatpeeahOe0hOadn++8jOeVtuWuouaItuerr+eEoeazleiZleeQhuaVtOWAi+mhnuWei+aZgu+8jOWPr+S7peaMieeFpyA8Y29kZT5wcmltaXRpdmU8L2NvZGU+IOeahOmhnuWei+iZleeQhuOAgjxiciAvPgoJ55uu5YmN5pSv5oyB5Lul5LiL5bm+56iu6aGe5Z6L77yaPHVsPgoJPGxpPuepuuWAvO+8mzwvbGk+Cgk8bGk+PHZhcj5ib29sPC92YXI+IOW4g+eIvuWAvO+8mzwvbGk+Cgk8bGk+PHZhcj5vYmplY3Q8L3Zhcj4g5bCN6LGh77ybPC9saT4KCTxsaT48dmFyPm1hcDwvdmFyPiDpjbXlkI3ngrrku7vmhI/lrZfnrKbkuLLnmoTlsI3osaHvvIzlgLznmoTpoZ7lnovnlLHllK/lo7nnmoTlrZDlhYPntKAgPGNvZGU+cGFyYW08L2NvZGU+IOaPj+i/sO+8mzwvbGk+Cgk8bGk+PHZhcj5udW1iZXI8L3Zhcj4g5pW45YC86aGe5Z6L77ybPC9saT4KCTxsaT48dmFyPm51bWJlci5pbnQ8L3Zhcj4g5pW05pW46aGe5Z6L55qE5pW45YC877ybPC9saT4KCTxsaT48dmFyPm51bWJlci5mbG9hdDwvdmFyPiDmta7pu57poZ7lnovnmoTmlbjlgLzvvJs8L2xpPgoJPGxpPjx2YXI+c3RyaW5nPC92YXI+IOWtl+espuS4su+8mzwvbGk+Cgk8bGk+PHZhcj5zdHJpbmcudXJsPC92YXI+IFVSTCDpoZ7lnovnmoTlrZfnrKbkuLLvvJs8L2xpPgoJPGxpPjx2YXI+c3RyaW5nLmVtYWlsPC92YXI+IGVtYWlsIOmhnuWei+eahOWtl+espuS4su+8mzwvbGk+Cgk8bGk+PHZhcj5zdHJpbmcuaW1hZ2U8L3Zhcj4g6KGo56S65ZyW54mH5Zyw5Z2A55qEIFVSTO+8mzwvbGk+Cgk8bGk+PHZhcj5zdHJpbmcuZGF0ZTwvdmFyPiDooajnpLogPGEgaHJlZj0iaHR0cHM6Ly90b29scy5pZXRmLm9yZy9odG1sL3JmYzMzMzkjc2VjdGlvbi01LjYiPlJGQzMzMzk8L2E+IOS4reeahCA8Y29kZT5mdWxsLWRhdGU8L2NvZGU+IOaXpeacn+agvOW8j++8jOavlOWmgiA8c2FtcD4yMDIwLTAxLTAyPC9zYW1wPu+8mzwvbGk+Cgk8bGk+PHZhcj5zdHJpbmcudGltZTwvdmFyPiDooajnpLogPGEgaHJlZj0iaHR0cHM6Ly90b29scy5pZXRmLm9yZy9odG1sL3JmYzMzMzkjc2VjdGlvbi01LjYiPlJGQzMzMzk8L2E+IOS4reeahCA8Y29kZT5mdWxsLXRpbWU8L2NvZGU+IOaZgumWk+agvOW8j++8jOavlOWmgiA8c2FtcD4xNToxNjoxN1o8L3NhbXA+44CBPHNhbXA+MTU6MTY6MTcrMDg6MDA8L3NhbXA+77ybPC9saT4KCTxsaT48dmFyPnN0cmluZy5kYXRlLXRpbWU8L3Zhcj4g6KGo56S6IDxhIGhyZWY9Imh0dHBzOi8vdG9vbHMuaWV0Zi5vcmcvaHRtbC9yZmMzMzM5I3NlY3Rpb24tNS42Ij5SRkMzMzM5PC9hPiDkuK3nmoQgPGNvZGU+ZGF0ZS10aW1lPC9jb2RlPiDmoLzlvI/vvIzmr5TlpoIgPHNhbXA+MjAyMC0wMS0wMlQxNToxNjoxNy0wODowMDwvc2FtcD7vvJs8L2xpPgoJPGxpPjx2YXI+c3RyaW5nLmJpbmFyeTwvdmFyPiDkuozpgLLliLblhaflrrnvvIzmr5TlpoIgPGNvZGU+bXVsdGlwYXJ0L2Zvcm0tZGF0YTwvY29kZT4g5Lit5LiK5YKz55qE5paH5Lu277ybPC9saT4KCTxsaT48dmFyPiNuYW1lPC92YXI+IOW8leeUqOaWh+aqlOS4reeUsSA8Y29kZT50eXBlPC9jb2RlPiDlhYPntKDlrprnvqnnmoTpoZ7lnovvvJs8L2xpPgoJPC91bD48L3VzYWdlPgoJCTwvdHlwZT4KCQk8dHlwZSBuYW1lPSJib29sIj4KCQkJPHVzYWdlPuW4g+eIvuWAvOmhnuWei++8jOWPluWAvOeCuiA8dmFyPnRydWU8L3Zhcj4g5oiW5pivIDx2YXI+ZmFsc2U8L3Zhcj7jgII8L3VzYWdlPgoJCTwvdHlwZT4KCTwvc3BlYz4KCTxjb21tYW5kcz4KCQk8Y29tbWFuZCBuYW1lPSJidWlsZCI+55Sf5oiQ5paH5qqU5YWn5a65PC9jb21tYW5kPgoJCTxjb21tYW5kIG5hbWU9ImRldGVjdCI+5qC55pOa55uu6YyE5LiL55qE5YWn5a6555Sf5oiQ6YWN572u5paH5Lu2PC9jb21tYW5kPgoJCTxjb21tYW5kIG5hbWU9ImRpZmYiPuavlOi8g+WFqeWAi+aWh+aqlOS5i+mWk+eahOW3rueVsDwvY29tbWFuZD4KCQk8Y29tbWFuZCBuYW1lPSJoZWxwIj7poa/npLrluavliqnkv6Hmga88L2NvbW1hbmQ+CgkJPGNvbW1hbmQgbmFtZT0iaW1wb3J0Ij7lsIflhbblroPmoLzlvI/nmoTmlofmqpTlsI7lhaXngrogYXBpZG9jIOaWh+aqlDwvY29tbWFuZD4KCQk8Y29tbWFuZCBuYW1lPSJsYW5nIj7poa/npLrmiYDmnInmlK/mjIHnmoToqp7oqIA8L2NvbW1hbmQ+CgkJPGNvbW1hbmQgbmFtZT0ibG9jYWxlIj7poa/npLrmiYDmnInmlK/mjIHnmoTmnKzlnLDljJblhaflrrk8L2NvbW1hbmQ+CgkJPGNvbW1hbmQgbmFtZT0ibHNwIj7llZ/li5UgbGFuZ3VhZ2Ugc2VydmVyIHByb3RvY29sIOacjeWLmTwvY29tbWFuZD4KCQk8Y29tbWFuZCBuYW1lPSJtb2NrIj7llZ/nlKggbW9jayDmnI3li5k8L2NvbW1hbmQ+CgkJPGNvbW1hbmQgbmFtZT0ic3RhdGljIj7llZ/nlKjpnZzmhYvmlofku7bmnI3li5k8L2NvbW1hbmQ+CgkJPGNvbW1hbmQgbmFtZT0ic3ludGF4Ij7muKzoqaboqp7ms5XnmoTmraPnorrmgKc8L2NvbW1hbmQ+CgkJPGNvbW1hbmQgbmFtZT0idXBncmFkZSI+5bCH6IiK54mI5pys55qE5paH5qqU5ZKM6YWN572u5paH5Lu25Y2H57Sa5Yiw55W25YmN54mI5pysPC9jb21tYW5kPgoJCTxjb21tYW5kIG5hbWU9InZlcnNpb24iPumhr+ekuueJiOacrOS/oeaBrzwvY29tbWFuZD4KCTwvY29tbWFuZHM+Cgk8Y29uZmlnPgoJCTxpdGVtIG5hbWU9InZlcnNpb24iIHR5cGU9InN0cmluZyIgYXJyYXk9ImZhbHNlIiByZXF1aXJlZD0idHJ1ZSI+5q2k6YWN572u5paH5Lu255qE5omA5L2/55So55qE5paH5qGj54mI5pysPC9pdGVtPgoJCTxpdGVtIG5hbWU9ImlucHV0cyIgdHlwZT0ib2JqZWN0IiBhcnJheT0idHJ1ZSIgcmVxdWlyZWQ9InRydWUiPuaMh+Wumui8uOWFpeeahOaVuOaTmu+8jOWQjOWjuemgheebruWPquiDveino+aekOWjueeoruiqnuiogOOAgjwvaXRlbT4KCQk8aXRlbSBuYW1lPSJpbnB1dHMubGFuZyIgdHlwZT0ic3RyaW5nIiBhcnJheT0iZmFsc2UiIHJlcXVpcmVkPSJ0cnVlIj7mupDmlofku7bnmoTop6PmnpDmlrnlvI/jgILlhbfpq5TmlK/mjIHnmoTpoZ7lnovlj6/pgJrpgY7lkb3ku6QgPHNhbXA+YXBpZG9jIGxhbmc8L3NhbXA+IOafpeeci+aUr+aMgeiqnuiogOOAgjwvaXRlbT4KCQk8aXRlbSBuYW1lPSJpbnB1dHMuZGlyIiB0eXBlPSJzdHJpbmciIGFycmF5PSJmYWxzZSIgcmVxdWlyZWQ9InRydWUiPumcgOimgeino+aekOeahOa6kOaWh+S7tuaJgOWcqOebrumMhDwvaXRlbT4KCQk8aXRlbSBuYW1lPSJpbnB1dHMuZXh0cyIgdHlwZT0ic3RyaW5nIiBhcnJheT0idHJ1ZSIgcmVxdWlyZWQ9ImZhbHNlIj7lj6rlvp7pgJnkupvmk7TlsZXlkI3nmoTmlofku7bkuK3mn6Xmib7mlofmqpQ8L2l0ZW0+CgkJPGl0ZW0gbmFtZT0iaW5wdXRzLnJlY3Vyc2l2ZSIgdHlwZT0iYm9vbCIgYXJyYXk9ImZhbHNlIiByZXF1aXJlZD0iZmFsc2UiPuaYr+WQpuino+aekOWtkOebrumMhOS4i+eahOa6kOaWh+S7tjwvaXRlbT4KCQk8aXRlbSBuYW1lPSJpbnB1dHMuZW5jb2RpbmciIHR5cGU9InN0cmluZyIgYXJyYXk9ImZhbHNlIiByZXF1aXJlZD0iZmFsc2UiPue3qOeivO+8jOm7mOiqjeeCuiA8dmFyPnV0Zi04PC92YXI+77yM5YC85Y+v5Lul5pivIDxhIGhyZWY9Imh0dHBzOi8vd3d3LmlhbmEub3JnL2Fzc2lnbm1lbnRzL2NoYXJhY3Rlci1zZXRzL2NoYXJhY3Rlci1zZXRzLnhodG1sIj5jaGFyYWN0ZXItc2V0czwvYT4g5Lit55qE5YWn5a6544CCPC9pdGVtPgoJCTxpdGVtIG5hbWU9ImlucHV0cy5pZ25vcmVzIiB0eXBlPSJzdHJpbmciIGFycmF5PSJ0cnVlIiByZXF1aXJlZD0iZmFsc2UiPuW/veeVpeeahOaWh+S7tuaIluebrumMhO+8jOavlOWmgiBub2RlX21vZHVsZXMg562J44CCPC9pdGVtPgoJCTxpdGVtIG5hbWU9Im91dHB1dCIgdHlwZT0ib2JqZWN0IiBhcnJheT0iZmFsc2UiIHJlcXVpcmVkPSJ0cnVlIj7mjqfliLbovLjlh7rooYzngro8L2l0ZW0+CgkJPGl0ZW0gbmFtZT0ib3V0cHV0LnR5cGUiIHR5cGU9InN0cmluZyIgYXJyYXk9ImZhbHNlIiByZXF1aXJlZD0iZmFsc2UiPui8uOWHuueahOmhnuWei++8jOebruWJjeWPr+S7pSA8dmFyPmFwaWRvYyt4bWw8L3Zhcj7jgIE8dmFyPm9wZW5hcGkranNvbjwvdmFyPuOAgTx2YXI+b3BlbmFwaSt5YW1sPC92YXI+44CBPHZhcj5vcGVuYXBpMy4xK2pzb248L3Zhcj7jgIE8dmFyPm9wZW5hcGkzLjEreWFtbDwvdmFyPuOAgTx2YXI+c3dhZ2dlcitqc29uPC92YXI+44CBPHZhcj5zd2FnZ2VyK3lhbWw8L3Zhcj7jgIE8dmFyPm1hcmtkb3duPC92YXI+44CBPHZhcj5odG1sPC92YXI+44CBPHZhcj5wb3N0bWFuK2pzb248L3Zhcj7jgIE8dmFyPnR5cGVzY3JpcHQ8L3Zhcj7jgIE8dmFyPmdvLWNsaWVudDwvdmFyPuOAgTx2YXI+Z28tc2VydmVyPC92YXI+IOWSjCA8dmFyPmpzb25zY2hlbWE8L3Zhcj7jgII8L2l0ZW0+CgkJPGl0ZW0gbmFtZT0ib3V0cHV0LnBhdGgiIHR5cGU9InN0cmluZyIgYXJyYXk9ImZhbHNlIiByZXF1aXJlZD0idHJ1ZSI+5oyH5a6a6Ly45Ye655qE5paH5Lu25ZCN77yM5YyF5ZCr6Lev5b6R5L+h5oGv44CCPC9pdGVtPgoJCTxpdGVtIG5hbWU9Im91dHB1dC50YWdzIiB0eXBlPSJzdHJpbmciIGFycmF5PSJ0cnVlIiByZXF1aXJlZD0iZmFsc2UiPuWPqui8uOWHuuiIh+mAmeS6m+aomeewveebuOmXnOiBr+eahOaWh+aqlO+8jOm7mOiqjeeCuuWFqOmDqOOAgjwvaXRlbT4KCQk8aXRlbSBuYW1lPSJvdXRwdXQuc3R5bGUiIHR5cGU9InN0cmluZyIgYXJyYXk9ImZhbHNlIiByZXF1aXJlZD0iZmFsc2UiPueCuiBYTUwg5paH5Lu25oyH5a6a55qEIFhTTCDmlofku7Y8L2l0ZW0+CgkJPGl0ZW0gbmFtZT0ib3V0cHV0Lm5hbWVzcGFjZSIgdHlwZT0iYm9vbCIgYXJyYXk9ImZhbHNlIiByZXF1aXJlZD0iZmFsc2UiPuaYr+WQpui8uOWHuuWRveWQjeepuumWkzwvaXRlbT4KCQk8aXRlbSBuYW1lPSJvdXRwdXQubmFtZXNwYWNlLXByZWZpeCIgdHlwZT0ic3RyaW5nIiBhcnJheT0iZmFsc2UiIHJlcXVpcmVkPSJmYWxzZSI+5aaC5p6c6Ly45Ye65LqG5ZG95ZCN56m66ZaT77yM6YKE5Y+v5Lul5oyH5a6a5ZG95ZCN56m66ZaT5YmN57a044CCPC9pdGVtPgoJCTxpdGVtIG5hbWU9Im91dHB1dC5pbmxpbmUtc2NoZW1hIiB0eXBlPSJib29sIiBhcnJheT0iZmFsc2UiIHJlcXVpcmVkPSJmYWxzZSI+5piv5ZCm5YWn6IGvIG9wZW5hcGkg5Lit5omA5pyJ55qEIHNjaGVtYe+8jOWwjSBzd2FnZ2VyIOeEoeaViO+8jOm7mOiqjeacg+Wwh+e1kOani+ebuOWQjOeahOWwjeixoeaPkOWPluWIsCBjb21wb25lbnRzLnNjaGVtYXMg5Lit5Lim5LulICRyZWYg5byV55So44CCPC9pdGVtPgoJCTxpdGVtIG5hbWU9Im91dHB1dC5zcGxpdCIgdHlwZT0iYm9vbCIgYXJyYXk9ImZhbHNlIiByZXF1aXJlZD0iZmFsc2UiPuaYr+WQpuaMieaomeewveWwh+aWh+aqlOaLhuWIhuaIkOWkmuWAi+aWh+S7tu+8jOWDheWwjSA8dmFyPm1hcmtkb3duPC92YXI+IOacieaViOOAgueCuiB0cnVlIOaZgu+8jHBhdGgg5oyH5a6a55qE5paH5Lu25Y+q5YyF5ZCr5paH5qqU55qE5Z+65pys5L+h5oGv5ZKM5pyq5oyH5a6a5qiZ57C955qEIEFQSe+8jOWQhOWAi+aomeewveeahCBBUEkg5L+d5a2Y5Zyo5ZCM55uu6YyE5LiL5Lul5qiZ57C95ZCN5ZG95ZCN55qE5paH5Lu25Lit44CCPC9pdGVtPgoJCTxpdGVtIG5hbWU9Im91dHB1dC5wYWNrYWdlIiB0eXBlPSJzdHJpbmciIGFycmF5PSJmYWxzZSIgcmVxdWlyZWQ9ImZhbHNlIj7nlJ/miJDnmoQgR28g5Luj56K855qE5YyF5ZCN77yM5YOF5bCNIDx2YXI+Z28tY2xpZW50PC92YXI+IOWSjCA8dmFyPmdvLXNlcnZlcjwvdmFyPiDmnInmlYjjgILpu5joqo3lj5YgcGF0aCDmiYDlnKjnm67pjITnmoTlkI3nqLHvvIznhKHms5XkvZzngrrljIXlkI3mmYLph4fnlKggY2xpZW50IOaIluaYryBzZXJ2ZXLjgII8L2l0ZW0+CgkJPGl0ZW0gbmFtZT0ibGludCIgdHlwZT0ib2JqZWN0IiBhcnJheT0iZmFsc2UiIHJlcXVpcmVkPSJmYWxzZSI+5Luj56K85qqi5p+l55qE6KaP5YmH5Y+K5YW257Sa5Yil77yM6Y215ZCN54K66KaP5YmH5ZCN56ix77yM6Y215YC85Y+v5Lul5pivIDx2YXI+ZXJyb3I8L3Zhcj7jgIE8dmFyPndhcm5pbmc8L3Zhcj7jgIE8dmFyPmluZm88L3Zhcj4g5ZKMIDx2YXI+b2ZmPC92YXI+77yM5pyq5oyH5a6a55qE6KaP5YmH5Z2H54K6IDx2YXI+b2ZmPC92YXI+44CC5Y+v55So55qE6KaP5YmH5pyJ77yaPHZhcj5hcGktc3VtbWFyeTwvdmFyPuOAgTx2YXI+YXBpLWlkPC92YXI+44CBPHZhcj5hcGktaWQtdW5pcXVlPC92YXI+44CBPHZhcj5wYXRoLWtlYmFiLWNhc2U8L3Zhcj7jgIE8dmFyPmFwaS00eHgtcmVzcG9uc2U8L3Zhcj7jgIE8dmFyPmRlcHJlY2F0ZWQtZGVzY3JpcHRpb248L3Zhcj7jgIE8dmFyPnRhZy11bnVzZWQ8L3Zhcj4g5ZKMIDx2YXI+cXVlcnktY2FzZTwvdmFyPuOAguaWh+aqlOS4reWPr+S7pemAmumBjiA8Y29kZT4mbHQ7IS0tIGxpbnQtZGlzYWJsZSBhcGktaWQgLS0mZ3Q7PC9jb2RlPiDlvaLlvI/nmoTms6jph4vnpoHnlKjmjIflrprnmoTopo/liYfvvIzmnKrmjIflrpropo/liYflkI3nqLHmmYLnpoHnlKjmiYDmnInopo/liYfjgILlnKggYXBpIOS4reeahOazqOmHi+WDheWwjeeVtuWJjSBBUEkg5pyJ5pWI77yM5ZyoIGFwaWRvYyDkuK3liYflsI3mlbTlgIvmlofmqpTmnInmlYjjgII8L2l0ZW0+Cgk8L2NvbmZpZz4KPC9sb2NhbGU+CgABCHNpdGUueG1sAR5hcHBsaWNhdGlvbi94bWw7IGNoYXJzZXQ9dXRmLTgB/gWwPD94bWwgdmVyc2lvbj0iMS4wIiBlbmNvZGluZz0iVVRGLTgiPz4KCjwhLS0g6K+l5paH5Lu255Sx5bel5YW36Ieq5Yqo55Sf5oiQ77yM6K+35Yu/5omL5Yqo5L+u5pS577yBIC0tPgoKPHNpdGU+Cgk8bmFtZT5hcGlkb2M8L25hbWU+Cgk8dmVyc2lvbj42LjEuMDwvdmVyc2lvbj4KCTxyZXBvPmh0dHBzOi8vZ2l0aHViLmNvbS9jYWl4dy9hcGlkb2M8L3JlcG8+Cgk8dXJsPmh0dHBzOi8vYXBpZG9jLnRvb2xzPC91cmw+Cgk8bGFuZ3VhZ2VzPgoJCTxsYW5ndWFnZSBpZD0iYyMiPkMjPC9sYW5ndWFnZT4KCQk8bGFuZ3VhZ2UgaWQ9ImMrKyI+Qy9DKys8L2xhbmd1YWdlPgoJCTxsYW5ndWFnZSBpZD0iZCI+RDwvbGFuZ3VhZ2U+CgkJPGxhbmd1YWdlIGlkPSJkYXJ0Ij5EYXJ0PC9sYW5ndWFnZT4KCQk8bGFuZ3VhZ2UgaWQ9ImVybGFuZyI+RXJsYW5nPC9sYW5ndWFnZT4KCQk8bGFuZ3VhZ2UgaWQ9ImdvIj5HbzwvbGFuZ3VhZ2U+CgkJPGxhbmd1YWdlIGlkPSJncm9vdnkiPkdyb292eTwvbGFuZ3VhZ2U+CgkJPGxhbmd1YWdlIGlkPSJqYXZhIj5KYXZhPC9sYW5ndWFnZT4KCQk8bGFuZ3VhZ2UgaWQ9ImphdmFzY3JpcHQiPkphdmFTY3JpcHQ8L2xhbmd1YWdlPgoJCTxsYW5ndWFnZSBpZD0ianVsaWEiPkp1bGlhPC9sYW5ndWFnZT4KCQk8bGFuZ3VhZ2UgaWQ9ImtvdGxpbiI+S290bGluPC9sYW5ndWFnZT4KCQk8bGFuZ3VhZ2UgaWQ9Imxpc3AiPkxpc3AvQ2xvanVyZTwvbGFuZ3VhZ2U+CgkJPGxhbmd1YWdlIGlkPSJsdWEiPkx1YTwvbGFuZ3VhZ2U+CgkJPGxhbmd1YWdlIGlkPSJuaW0iPk5pbTwvbGFuZ3VhZ2U+CgkJPGxhbmd1YWdlIGlkPSJwYXNjYWwiPlBhc2NhbC9EZWxwaGk8L2xhbmd1YWdlPgoJCTxsYW5ndWFnZSBpZD0icGVybCI+UGVybDwvbGFuZ3VhZ2U+CgkJPGxhbmd1YWdlIGlkPSJwaHAiPlBIUDwvbGFuZ3VhZ2U+CgkJPGxhbmd1YWdlIGlkPSJweXRob24iPlB5dGhvbjwvbGFuZ3VhZ2U+CgkJPGxhbmd1YWdlIGlkPSJydWJ5Ij5SdWJ5PC9sYW5ndWFnZT4KCQk8bGFuZ3VhZ2UgaWQ9InJ1c3QiPlJ1c3Q8L2xhbmd1YWdlPgoJCTxsYW5ndWFnZSBpZD0ic2NhbGEiPlNjYWxhPC9sYW5ndWFnZT4KCQk8bGFuZ3VhZ2UgaWQ9InN3aWZ0Ij5Td2lmdDwvbGFuZ3VhZ2U+CgkJPGxhbmd1YWdlIGlkPSJ0eXBlc2NyaXB0Ij5UeXBlU2NyaXB0PC9sYW5ndWFnZT4KCQk8bGFuZ3VhZ2UgaWQ9InppZyI+WmlnPC9sYW5ndWFnZT4KCTwvbGFuZ3VhZ2VzPgoJPGxvY2FsZXM+CgkJPGxvY2FsZSBpZD0iY21uLUhhbnMiIGhyZWY9ImluZGV4LnhtbCIgdGl0bGU9IueugOS9k+S4reaWhyIgZG9jPSJsb2NhbGUuY21uLUhhbnMueG1sIj48L2xvY2FsZT4KCQk8bG9jYWxlIGlkPSJjbW4tSGFudCIgaHJlZj0iaW5kZXguY21uLUhhbnQueG1sIiB0aXRsZT0i57mB6auU5Lit5paHIiBkb2M9ImxvY2FsZS5jbW4tSGFudC54bWwiPjwvbG9jYWxlPgoJPC9sb2NhbGVzPgo8L3NpdGU+CgABDXY2L2FwaWRvYy5jc3MBF3RleHQvY3NzOyBjaGFyc2V0PXV0Zi04Af4mY0BjaGFyc2V0ICJ1dGYtOCI7Cgo6cm9vdCB7CiAgICAtLW1heC13aWR0aDogMjA0OHB4OwogICAgLS1taW4td2lkdGg6IDIwMHB4OyAvKiDlvZPliJfnmoTlrr3luqblsI/kuo7mraTlgLzml7bvvIzpg6jliIbooYzlhoXlrrnkvJrooqvku47mqKrlkJHmlLnlj5jnurXlkJHmjpLliJfjgIIgKi8KICAgIC0tcGFkZGluZzogMXJlbTsKICAgIC0tYXJ0aWNsZS1wYWRkaW5nOiBjYWxjKHZhcigtLXBhZGRpbmcpIC8gMik7CgogICAgLS1jb2xvcjogYmxhY2s7CiAgICAtLWFjY2VudC1jb2xvcjogIzAwNzRkOTsKICAgIC0tYmFja2dyb3VuZDogd2hpdGU7CiAgICAtLWJvcmRlci1jb2xvcjogI2UwZTBlMDsKICAgIC0tZGVsZXRlLWNvbG9yOiByZWQ7CgogICAgLyogbWV0aG9kICovCiAgICAtLW1ldGhvZC1nZXQtY29sb3I6IGdyZWVuOwogICAgLS1tZXRob2Qtb3B0aW9ucy1jb2xvcjogZ3JlZW47CiAgICAtLW1ldGhvZC1wb3N0LWNvbG9yOiBkYXJrb3JhbmdlOwogICAgLS1tZXRob2QtcHV0LWNvbG9yOiBkYXJrb3JhbmdlOwogICAgLS1tZXRob2QtcGF0Y2gtY29sb3I6IGRhcmtvcmFuZ2U7CiAgICAtLW1ldGhvZC1kZWxldGUtY29sb3I6IHJlZDsKfQoKQG1lZGlhIChwcmVmZXJzLWNvbG9yLXNjaGVtZTogZGFyaykgewogICAgOnJvb3QgewogICAgICAgIC0tY29sb3I6ICNiMGIwYjA7CiAgICAgICAgLS1hY2NlbnQtY29sb3I6ICMwMDc0ZDk7CiAgICAgICAgLS1iYWNrZ3JvdW5kOiBibGFjazsKICAgICAgICAtLWJvcmRlci1jb2xvcjogIzMwMzAzMDsKICAgICAgICAtLWRlbGV0ZS1jb2xvcjogcmVkOwoKICAgICAgICAvKiBtZXRob2QgKi8KICAgICAgICAtLW1ldGhvZC1nZXQtY29sb3I6IGdyZWVuOwogICAgICAgIC0tbWV0aG9kLW9wdGlvbnMtY29sb3I6IGdyZWVuOwogICAgICAgIC0tbWV0aG9kLXBvc3QtY29sb3I6IGRhcmtvcmFuZ2U7CiAgICAgICAgLS1tZXRob2QtcHV0LWNvbG9yOiBkYXJrb3JhbmdlOwogICAgICAgIC0tbWV0aG9kLXBhdGNoLWNvbG9yOiBkYXJrb3JhbmdlOwogICAgICAgIC0tbWV0aG9kLWRlbGV0ZS1jb2xvcjogcmVkOwogICAgfQp9Cgpib2R5IHsKICAgIHBhZGRpbmc6IDA7CiAgICBtYXJnaW46IDA7CiAgICBjb2xvcjogdmFyKC0tY29sb3IpOwogICAgYmFja2dyb3VuZDogdmFyKC0tYmFja2dyb3VuZCk7CiAgICB0ZXh0LWFsaWduOiBjZW50ZXI7Cn0KCnRhYmxlIHsKICAgIHdpZHRoOiAxMDAlOwp9Cgp0YWJsZSB0ciB7CiAgICBjdXJzb3I6IHBvaW50ZXI7Cn0KCnRhYmxlIHRoLCB0YWJsZSB0ZCB7CiAgICBmb250LXdlaWdodDogbm9ybWFsOwogICAgdGV4dC1hbGlnbjogbGVmdDsKICAgIGJvcmRlci1ib3R0b206IDFweCBzb2xpZCB0cmFuc3BhcmVudDsKfQoKdGFibGUgdHI6aG92ZXIgdGgsCnRhYmxlIHRyOmhvdmVyIHRkIHsKICAgIG9wYWNpdHk6IC43Owp9Cgp1bCwgb2wsIHVsIGxpLCBvbCBsaSB7CiAgICBwYWRkaW5nOiAwOwogICAgbWFyZ2luOiAwOwogICAgbGlzdC1zdHlsZS1wb3NpdGlvbjogaW5zaWRlOwp9CgpwIHsKICAgIG1hcmdpbjogMDsKfQoKc3VtbWFyeSwgaW5wdXQgewogICAgb3V0bGluZTogbm9uZTsKfQoKYSB7CiAgICB0ZXh0LWRlY29yYXRpb246IG5vbmU7CiAgICBjb2xvcjogdmFyKC0tYWNjZW50LWNvbG9yKTsKfQoKYTpob3ZlciB7CiAgICBvcGFjaXR5OiAuNzsKfQoKLmRlbCB7CiAgICB0ZXh0LWRlY29yYXRpb246IGxpbmUtdGhyb3VnaDsKICAgIHRleHQtZGVjb3JhdGlvbi1jb2xvcjogdmFyKC0tZGVsZXRlLWNvbG9yKTsKfQoKLmhpZGRlbiB7CiAgICBkaXNwbGF5OiBub25lOwp9CgovKioqKioqKioqKioqKioqKioqKioqKioqKioqIGhlYWRlciAqKioqKioqKioqKioqKioqKioqKioqKi8KCmhlYWRlciB7CiAgICBwb3NpdGlvbjogc3RpY2t5OwogICAgdG9wOiAwOwogICAgZGlzcGxheTogYmxvY2s7CiAgICB6LWluZGV4OiAxMDAwOwogICAgYm94LXNpemluZzogYm9yZGVyLWJveDsKICAgIGJhY2tncm91bmQ6IHZhcigtLWJhY2tncm91bmQpOwogICAgYm94LXNoYWRvdzogMnB4IDJweCAycHggdmFyKC0tYm9yZGVyLWNvbG9yKTsKfQoKaGVhZGVyIC53cmFwIHsKICAgIG1hcmdpbjogMCBhdXRvOwogICAgdGV4dC1hbGlnbjogbGVmdDsKICAgIG1heC13aWR0aDogdmFyKC0tbWF4LXdpZHRoKTsKICAgIHBhZGRpbmc6IDAgdmFyKC0tcGFkZGluZyk7CiAgICBkaXNwbGF5OiBmbGV4OwogICAgZmxleC13cmFwOiB3cmFwOwogICAganVzdGlmeS1jb250ZW50OiBzcGFjZS1iZXR3ZWVuOwogICAgYWxpZ24taXRlbXM6IGNlbnRlcjsKfQoKaGVhZGVyIGgxIHsKICAgIG1hcmdpbjogdmFyKC0tcGFkZGluZykgMCB2YXIoLS1hcnRpY2xlLXBhZGRpbmcpOwogICAgZGlzcGxheTogaW5saW5lLWJsb2NrOwp9CgpoZWFkZXIgaDEgLnZlcnNpb24gewogICAgZm9udC1zaXplOiAxcmVtOwp9CgpoZWFkZXIgaDEgaW1nIHsKICAgIGhlaWdodDogMS41cmVtOwogICAgbWFyZ2luLXJpZ2h0OiAuNXJlbTsKfQoKCmhlYWRlciAubWVudXMgewogICAgZGlzcGxheTogZmxleDsKfQoKaGVhZGVyIC5tZW51IHsKICAgIGN1cnNvcjogcG9pbnRlcjsKICAgIHBvc2l0aW9uOiByZWxhdGl2ZTsKICAgIG1hcmdpbi1sZWZ0OiB2YXIoLS1wYWRkaW5nKTsKICAgIGRpc3BsYXk6IG5vbmU7IC8qIOm7mOiupOS4jeWPr+inge+8jOWPquacieWcqOaciSBKUyDnmoTmg4XlhrXkuIvvvIzpgJrov4cgSlMg5o6n5Yi25YW25Y+v6KeB5oCnICovCn0KCmhlYWRlciAubWVudTpob3ZlciBzcGFuIHsKICAgIG9wYWNpdHk6IC43Owp9CgpoZWFkZXIgLm1lbnUgdWwgewogICAgcG9zaXRpb246IGFic29sdXRlOwogICAgbWluLXdpZHRoOiA0cmVtOwogICAgcmlnaHQ6IDA7CiAgICBkaXNwbGF5OiBub25lOwogICAgbGlzdC1zdHlsZTogbm9uZTsKICAgIGJhY2tncm91bmQ6IHZhcigtLWJhY2tncm91bmQpOwogICAgYm9yZGVyOiAxcHggc29saWQgdmFyKC0tYm9yZGVyLWNvbG9yKTsKICAgIHBhZGRpbmc6IHZhcigtLWFydGljbGUtcGFkZGluZyk7Cn0KCmhlYWRlciAubWVudSB1bCBsaSB7CiAgICBwYWRkaW5nLWJvdHRvbTogdmFyKC0tYXJ0aWNsZS1wYWRkaW5nKTsKfQoKLyog5Y+v5Lul5L+d6K+BIGxhYmVsIOeahOWGheWuueWcqOWQjOS4gOihjCAqLwpoZWFkZXIgLm1lbnUgdWwgbGkgbGFiZWwgewogICAgZGlzcGxheTogaW5saW5lLWZsZXg7CiAgICBhbGlnbi1pdGVtczogYmFzZWxpbmU7CiAgICB3b3JkLWJyZWFrOiBrZWVwLWFsbDsKICAgIHdoaXRlLXNwYWNlOiBub3dyYXA7CiAgICBjdXJzb3I6IHBvaW50ZXI7Cn0KCmhlYWRlciAubWVudSB1bCBsaTpob3ZlciBsYWJlbCB7CiAgICBvcGFjaXR5OiAuNzsKfQoKaGVhZGVyIC5tZW51IHVsIGxpOmxhc3Qtb2YtdHlwZSB7CiAgICBwYWRkaW5nLWJvdHRvbTogMDsKfQoKaGVhZGVyIC5tZW51OmhvdmVyIHVsIHsKICAgIGRpc3BsYXk6IGJsb2NrOwp9CgovKioqKioqKioqKioqKioqKioqKioqKioqKioqIG1haW4gKioqKioqKioqKioqKioqKioqKioqKiovCgptYWluIHsKICAgIHBhZGRpbmc6IDAgdmFyKC0tcGFkZGluZyk7CiAgICBtYXJnaW46IDAgYXV0bzsKICAgIG1heC13aWR0aDogdmFyKC0tbWF4LXdpZHRoKTsKICAgIHRleHQtYWxpZ246IGxlZnQ7Cn0KCm1haW4gLmNvbnRlbnQgewogICAgbWFyZ2luOiB2YXIoLS1wYWRkaW5nKSAwOwp9CgovKioqKioqKioqKioqKioqKioqIC5zZXJ2ZXJzICoqKioqKioqKioqKioqKioqKiovCgptYWluIC5zZXJ2ZXJzIHsKICAgIGRpc3BsYXk6IGZsZXg7CiAgICBmbGV4LWZsb3c6IHdyYXA7CiAgICBqdXN0aWZ5LWNvbnRlbnQ6IHNwYWNlLWJldHdlZW47CiAgICBtYXJnaW4tYm90dG9tOiB2YXIoLS1wYWRkaW5nKTsKfQoKbWFpbiAuc2VydmVycyAuc2VydmVyIHsKICAgIGZsZXgtZ3JvdzogMTsKICAgIG1pbi13aWR0aDogdmFyKC0tbWluLXdpZHRoKTsKICAgIGJveC1zaXppbmc6IGJvcmRlci1ib3g7CiAgICBib3JkZXI6IDFweCBzb2xpZCB2YXIoLS1ib3JkZXItY29sb3IpOwogICAgcGFkZGluZzogdmFyKC0tcGFkZGluZykgdmFyKC0tYXJ0aWNsZS1wYWRkaW5nKTsKfQoKbWFpbiAuc2VydmVycyAuc2VydmVyOmhvdmVyIHsKICAgIGJvcmRlcjogMXB4IHNvbGlkIHZhcigtLWFjY2VudC1jb2xvcik7Cn0KCm1haW4gLnNlcnZlcnMgLnNlcnZlciBoNCB7CiAgICBtYXJnaW46IDAgMCB2YXIoLS1wYWRkaW5nKTsKfQoKLyoqKioqKioqKioqKioqKioqKioqKiogLmFwaSAqKioqKioqKioqKioqKioqKioqKioqLwoKbWFpbiAuYXBpIHsKICAgIG1hcmdpbi1ib3R0b206IHZhcigtLWFydGljbGUtcGFkZGluZyk7CiAgICBib3JkZXI6IDFweCBzb2xpZCB2YXIoLS1ib3JkZXItY29sb3IpOwp9CgptYWluIC5hcGk+c3VtbWFyeSB7CiAgICBtYXJnaW46IDA7CiAgICBwYWRkaW5nOiB2YXIoLS1hcnRpY2xlLXBhZGRpbmcpOwogICAgYm9yZGVyLWJvdHRvbTogMXB4IHNvbGlkIHZhcigtLWJvcmRlci1jb2xvcik7CiAgICBjdXJzb3I6IHBvaW50ZXI7CiAgICBsaW5lLWhlaWdodDogMTsKICAgIGJveC1zaXppbmc6IGJvcmRlci1ib3g7Cn0KCgptYWluIGRldGFpbHMuYXBpOm5vdChbb3Blbl0pPnN1bW1hcnkgewogICAgYm9yZGVyOiBub25lOwp9CgoKbWFpbiAuYXBpPnN1bW1hcnkgLmFjdGlvbiB7CiAgICB3aWR0aDogNDAlOwogICAgZGlzcGxheTogaW5saW5lLWJsb2NrOwp9CgptYWluIC5hcGk+c3VtbWFyeSAucmlnaHQgewogICAgd2lkdGg6IGNhbGMoNjAlIC0gMnJlbSk7IC8qIDJyZW0g6KGo56S65bem5L6n55qE566t5aS0ICovCiAgICBmbG9hdDogcmlnaHQ7Cn0KCm1haW4gLmFwaT5zdW1tYXJ5IC5yaWdodCAuc3J2LAptYWluIC5hcGk+c3VtbWFyeSAucmlnaHQgLnN1bW1hcnkgewogICAgd2lkdGg6IDUwJTsKICAgIGRpc3BsYXk6IGlubGluZS1ibG9jazsKfQoKLyogMjAwcHgg5Li6IC0tbWluLXdpZHRoIOeahOWAvCAqLwpAbWVkaWEgKG1heC13aWR0aDogY2FsYygyMDBweCAqIDIpKSB7CiAgICBtYWluIC5hcGk+c3VtbWFyeSAuYWN0aW9uIHsKICAgICAgICB3aWR0aDogNTAlOwogICAgfQoKICAgIG1haW4gLmFwaT5zdW1tYXJ5IC5yaWdodCB7CiAgICAgICAgd2lkdGg6IGNhbGMoNTAlIC0gMnJlbSk7CiAgICB9CgogICAgbWFpbiAuYXBpPnN1bW1hcnkgLnJpZ2h0IC5zcnYgewogICAgICAgIGRpc3BsYXk6IG5vbmU7CiAgICB9CgogICAgbWFpbiAuYXBpPnN1bW1hcnkgLnJpZ2h0IC5zdW1tYXJ5IHsKICAgICAgICB3aWR0aDogMTAwJTsKICAgIH0KfQoKbWFpbiAuYXBpPnN1bW1hcnkgLmxpbmsgewogICAgbWFyZ2luLXJpZ2h0OiAxMHB4OwogICAgdGV4dC1kZWNvcmF0aW9uOiBub25lOwp9CgptYWluIC5hcGk+c3VtbWFyeSAubWV0aG9kIHsKICAgIG1pbi13aWR0aDogNHJlbTsKICAgIGZvbnQtd2VpZ2h0OiBib2xkOwogICAgZGlzcGxheTogaW5saW5lLWJsb2NrOwogICAgbWFyZ2luLXJpZ2h0OiAxcmVtOwp9CgptYWluIC5hcGkgLmNhbGxiYWNrIC5zdW1tYXJ5LAptYWluIC5hcGk+c3VtbWFyeSAuc3VtbWFyeSB7CiAgICBmb250LXdlaWdodDogNDAwOwogICAgb3BhY2l0eTogLjg7CiAgICB0ZXh0LWFsaWduOiByaWdodDsKfQoKbWFpbiAuYXBpIC5kZXNjcmlwdGlvbiB7CiAgICBwYWRkaW5nOiB2YXIoLS1hcnRpY2xlLXBhZGRpbmcpOwogICAgbWFyZ2luOiAwOwogICAgYm9yZGVyLWJvdHRvbTogMXB4IHNvbGlkIHZhcigtLWJvcmRlci1jb2xvcik7Cn0KCm1haW4gLmFwaVtkYXRhLW1ldGhvZD0nR0VUJ11bb3Blbl0sCm1haW4gLmFwaVtkYXRhLW1ldGhvZD0nR0VUJ106aG92ZXIsCm1haW4gLmNhbGxiYWNrW2RhdGEtbWV0aG9kPSdHRVQnXSBoMyB7CiAgICBib3JkZXI6IDFweCBzb2xpZCB2YXIoLS1tZXRob2QtZ2V0LWNvbG9yKTsKfQptYWluIC5hcGlbZGF0YS1tZXRob2Q9J0dFVCddPnN1bW1hcnkgewogICAgYm9yZGVyLWJvdHRvbTogMXB4IHNvbGlkIHZhcigtLW1ldGhvZC1nZXQtY29sb3IpOwp9CgptYWluIC5hcGlbZGF0YS1tZXRob2Q9J1BPU1QnXVtvcGVuXSwKbWFpbiAuYXBpW2RhdGEtbWV0aG9kPSdQT1NUJ106aG92ZXIsCm1haW4gLmNhbGxiYWNrW2RhdGEtbWV0aG9kPSdQT1NUJ10gaDMgewogICAgYm9yZGVyOiAxcHggc29saWQgdmFyKC0tbWV0aG9kLXBvc3QtY29sb3IpOwp9Cm1haW4gLmFwaVtkYXRhLW1ldGhvZD0nUE9TVCddPnN1bW1hcnkgewogICAgYm9yZGVyLWJvdHRvbTogMXB4IHNvbGlkIHZhcigtLW1ldGhvZC1wb3N0LWNvbG9yKTsKfQoKbWFpbiAuYXBpW2RhdGEtbWV0aG9kPSdQVVQnXVtvcGVuXSwKbWFpbiAuYXBpW2RhdGEtbWV0aG9kPSdQVVQnXTpob3ZlciwKbWFpbiAuY2FsbGJhY2tbZGF0YS1tZXRob2Q9J1BVVCddIGgzIHsKICAgIGJvcmRlcjogMXB4IHNvbGlkIHZhcigtLW1ldGhvZC1wdXQtY29sb3IpOwp9Cm1haW4gLmFwaVtkYXRhLW1ldGhvZD0nUFVUJ10+c3VtbWFyeSB7CiAgICBib3JkZXItYm90dG9tOiAxcHggc29saWQgdmFyKC0tbWV0aG9kLXB1dC1jb2xvcik7Cn0KCm1haW4gLmFwaVtkYXRhLW1ldGhvZD0nUEFUQ0gnXVtvcGVuXSwKbWFpbiAuYXBpW2RhdGEtbWV0aG9kPSdQQVRDSCddOmhvdmVyLAptYWluIC5jYWxsYmFja1tkYXRhLW1ldGhvZD0nUEFUQ0gnXSBoMyB7CiAgICBib3JkZXI6IDFweCBzb2xpZCB2YXIoLS1tZXRob2QtcGF0Y2gtY29sb3IpOwp9Cm1haW4gLmFwaVtkYXRhLW1ldGhvZD0nUEFUQ0gnXT5zdW1tYXJ5IHsKICAgIGJvcmRlci1ib3R0b206IDFweCBzb2xpZCB2YXIoLS1tZXRob2QtcGF0Y2gtY29sb3IpOwp9CgptYWluIC5hcGlbZGF0YS1tZXRob2Q9J0RFTEVURSddW29wZW5dLAptYWluIC5hcGlbZGF0YS1tZXRob2Q9J0RFTEVURSddOmhvdmVyLAptYWluIC5jYWxsYmFja1tkYXRhLW1ldGhvZD0nREVMRVRFJ10gaDMgewogICAgYm9yZGVyOiAxcHggc29saWQgdmFyKC0tbWV0aG9kLWRlbGV0ZS1jb2xvcik7Cn0KbWFpbiAuYXBpW2RhdGEtbWV0aG9kPSdERUxFVEUnXT5zdW1tYXJ5IHsKICAgIGJvcmRlci1ib3R0b206IDFweCBzb2xpZCB2YXIoLS1tZXRob2QtZGVsZXRlLWNvbG9yKTsKfQoKbWFpbiAuYXBpW2RhdGEtbWV0aG9kPSdPUFRJT05TJ11bb3Blbl0sCm1haW4gLmFwaVtkYXRhLW1ldGhvZD0nT1BUSU9OUyddOmhvdmVyLAptYWluIC5jYWxsYmFja1tkYXRhLW1ldGhvZD0nT1BUSU9OUyddIGgzIHsKICAgIGJvcmRlcjogMXB4IHNvbGlkIHZhcigtLW1ldGhvZC1vcHRpb25zLWNvbG9yKTsKfQptYWluIC5hcGlbZGF0YS1tZXRob2Q9J09QVElPTlMnXT5zdW1tYXJ5IHsKICAgIGJvcmRlci1ib3R0b206IDFweCBzb2xpZCB2YXIoLS1tZXRob2Qtb3B0aW9ucy1jb2xvcik7Cn0KCm1haW4gLmNhbGxiYWNrIGgzIHsKICAgIGRpc3BsYXk6IGZsZXg7CiAgICBqdXN0aWZ5LWNvbnRlbnQ6IHNwYWNlLWJldHdlZW47CiAgICBwYWRkaW5nOiB2YXIoLS1hcnRpY2xlLXBhZGRpbmcpIHZhcigtLXBhZGRpbmcpOwogICAgbWFyZ2luOiAwOwogICAgYm9yZGVyLWxlZnQ6IG5vbmUgIWltcG9ydGFudDsKICAgIGJvcmRlci1yaWdodDogbm9uZSAhaW1wb3J0YW50OwogICAgY3Vyc29yOiBwb2ludGVyOwogICAgbGluZS1oZWlnaHQ6IDE7Cn0KCm1haW4gLmFwaSAuYm9keSB7CiAgICBkaXNwbGF5OiBmbGV4OwogICAgZmxleC1mbG93OiB3cmFwOwp9CgptYWluIC5hcGkgLnRpdGxlLAptYWluIC5hcGkgLnRpdGxlIHsKICAgIG1hcmdpbjogdmFyKC0tYXJ0aWNsZS1wYWRkaW5nKSAwIDA7CiAgICBmb250LXdlaWdodDogbm9ybWFsOwp9CgptYWluIC5hcGkgLmJvZHkgLnJlc3BvbnNlcyAuc3RhdHVzIHsKICAgIG1hcmdpbjogdmFyKC0tcGFkZGluZykgMCB2YXIoLS1hcnRpY2xlLXBhZGRpbmcpOwogICAgYm9yZGVyLWJvdHRvbTogMXB4IHNvbGlkIHZhcigtLWJvcmRlci1jb2xvcik7CiAgICBwYWRkaW5nLWJvdHRvbTogM3B4Owp9CgptYWluIC5hcGkgLnBhcmFtIC5wYXJlbnQtdHlwZSB7CiAgICBvcGFjaXR5OiAuNTsKfQoKbWFpbiAuYXBpIC5ib2R5IC5yZXF1ZXN0cywKbWFpbiAuYXBpIC5ib2R5IC5yZXNwb25zZXMgewogICAgZmxleDogMSAxIDUwJTsKICAgIGJveC1zaXppbmc6IGJvcmRlci1ib3g7CiAgICBtaW4td2lkdGg6IHZhcigtLW1pbi13aWR0aCk7Cn0KbWFpbiAuYXBpIC5ib2R5IC5yZXF1ZXN0cyB7CiAgICBib3JkZXItcmlnaHQ6IDFweCBkb3R0ZWQgdmFyKC0tYm9yZGVyLWNvbG9yKTsKfQoKbWFpbiAuYXBpIC5ib2R5IC5yZXF1ZXN0cyAuaGVhZGVyLAptYWluIC5hcGkgLmJvZHkgLnJlc3BvbnNlcyAuaGVhZGVyIHsKICAgIG1hcmdpbjogMDsKICAgIG9wYWNpdHk6IC41OwogICAgcGFkZGluZzogdmFyKC0tYXJ0aWNsZS1wYWRkaW5nKTsKfQoKbWFpbiAuYXBpIC5ib2R5IC5yZXF1ZXN0cyBkZXRhaWxzOm5vdChbb3Blbl0pLAptYWluIC5hcGkgLmJvZHkgLnJlc3BvbnNlcyBkZXRhaWxzOm5vdChbb3Blbl0pIHsKICAgIG1hcmdpbi1ib3R0b206IHZhcigtLWFydGljbGUtcGFkZGluZyk7Cn0KCm1haW4gLmFwaSAuYm9keSAucmVxdWVzdHM+LnBhcmFtLAptYWluIC5hcGkgLmJvZHkgLnJlcXVlc3RzIGRldGFpbHMgc3VtbWFyeSB+ICosCm1haW4gLmFwaSAuYm9keSAucmVzcG9uc2VzIGRldGFpbHMgc3VtbWFyeSB+ICogewogICAgcGFkZGluZzogMCB2YXIoLS1hcnRpY2xlLXBhZGRpbmcpOwp9CgptYWluIC5hcGkgLnJlc3BvbnNlcyBkZXRhaWxzIHN1bW1hcnksCm1haW4gLmFwaSAucmVxdWVzdHMgZGV0YWlscyBzdW1tYXJ5IHsKICAgIGN1cnNvcjogcG9pbnRlcjsKICAgIHBhZGRpbmc6IHZhcigtLWFydGljbGUtcGFkZGluZyk7Cn0KCm1haW4gLmFwaSAucmVzcG9uc2VzIGRldGFpbHNbb3Blbl0gc3VtbWFyeSwKbWFpbiAuYXBpIC5yZXF1ZXN0cyBkZXRhaWxzW29wZW5dIHN1bW1hcnksCm1haW4gLmFwaSAucmVzcG9uc2VzIGRldGFpbHMgc3VtbWFyeTpob3ZlciwKbWFpbiAuYXBpIC5yZXF1ZXN0cyBkZXRhaWxzIHN1bW1hcnk6aG92ZXIgewogICAgY3Vyc29yOiBwb2ludGVyOwogICAgYmFja2dyb3VuZC1jb2xvcjogdmFyKC0tYm9yZGVyLWNvbG9yKTsKICAgIHBhZGRpbmc6IHZhcigtLWFydGljbGUtcGFkZGluZyk7Cn0KCi8qKioqKioqKioqKioqKioqKioqKioqKioqKiogZm9vdGVyICoqKioqKioqKioqKioqKioqKioqKioqLwoKZm9vdGVyIHsKICAgIGJvcmRlci10b3A6IDFweCBzb2xpZCB2YXIoLS1ib3JkZXItY29sb3IpOwogICAgcGFkZGluZzogdmFyKC0tcGFkZGluZykgdmFyKC0tcGFkZGluZyk7CiAgICB0ZXh0LWFsaWduOiBsZWZ0OwogICAgbWFyZ2luLXRvcDogdmFyKC0tcGFkZGluZyk7Cn0KCmZvb3RlciAud3JhcCB7CiAgICBtYXJnaW46IDAgYXV0bzsKICAgIG1heC13aWR0aDogdmFyKC0tbWF4LXdpZHRoKTsKfQoKLmdvdG8tdG9wIHsKICAgIGJvcmRlcjogc29saWQgdmFyKC0tY29sb3IpOwogICAgYm9yZGVyLXdpZHRoOiAwIDVweCA1cHggMDsKICAgIGRpc3BsYXk6IGJsb2NrOwogICAgcGFkZGluZzogNXB4OwogICAgdHJhbnNmb3JtOiByb3RhdGUoLTEzNWRlZyk7CiAgICBwb3NpdGlvbjogZml4ZWQ7CiAgICBib3R0b206IDNyZW07CiAgICByaWdodDogY2FsYygoKDEwMCUgLSB2YXIoLS1tYXgtd2lkdGgpKSAvIDIpIC0gM3JlbSk7Cn0KCi8qCiAqIOeUqOS6juiuoeeulyBnb3RvLXRvcCDmjInpkq7nmoTkvY3nva7lp4vnu4jkv53mjIHlnKjlhoXlrrnkuLvkvZPnmoTlj7PkvqfovrnkuIrjgIIKICogMjA0OHB4IOS4uiAtLW1heC13aWR0aCDnmoTlgLzvvIzkvYbmmK8gQ1NTIOW5tuS4jeaUr+aMgeebtOaOpeWcqCBAbWVkaWEKICog5L2/55SoIHZhcigpIOWHveaVsOOAggogKi8KQG1lZGlhIChtYXgtd2lkdGg6IGNhbGMoMjA0OHB4ICsgNnJlbSkpIHsKICAgIC5nb3RvLXRvcCB7CiAgICAgICAgcmlnaHQ6IDNyZW07CiAgICB9Cn0KAAEMdjYvYXBpZG9jLmpzASVhcHBsaWNhdGlvbi9qYXZhc2NyaXB0OyBjaGFyc2V0PXV0Zi04Af4RaSd1c2Ugc3RyaWN0JzsKCndpbmRvdy5vbmxvYWQgPSBmdW5jdGlvbiAoKSB7CiAgICByZWdpc3RlckZpbHRlcignbWV0aG9kJyk7CiAgICByZWdpc3RlckZpbHRlcignc2VydmVyJyk7CiAgICByZWdpc3RlckZpbHRlcigndGFnJyk7CiAgICByZWdpc3RlckV4cGFuZCgpOwogICAgcmVnaXN0ZXJMYW5ndWFnZUZpbHRlcigpOwogICAgcHJldHR5RGVzY3JpcHRpb24oKTsKICAgIGluaXRHb3RvVG9wKCk7Cn07Cgpjb25zdCB1bmNhdGVnb3JpemVkSUQgPSAnX19fX18nOwoKZnVuY3Rpb24gcmVnaXN0ZXJGaWx0ZXIodHlwZSkgewogICAgY29uc3QgbWVudSA9IGRvY3VtZW50LnF1ZXJ5U2VsZWN0b3IoJy4nICsgdHlwZSArICctc2VsZWN0b3InKTsKICAgIGlmIChtZW51ID09PSBudWxsKSB7IC8vIOWPr+iDveS4uuepuu+8jOihqOekuuS4jeWtmOWcqOivpei/h+a7pOmhuQogICAgICAgIHJldHVybjsKICAgIH0KCiAgICBtZW51LnN0eWxlLmRpc3BsYXkgPSAnYmxvY2snOyAvLyDmnIkgSlMg55qE5oOF5Ya15LiL77yM5bGV56S66L+H5ruk6I+c5Y2VCgogICAgbWVudS5xdWVyeVNlbGVjdG9yQWxsKCd1bD5saScpLmZvckVhY2goKGUpID0+IHsKICAgICAgICBpZiAoZS5nZXRBdHRyaWJ1dGUoYGRhdGEtJHt0eXBlfWApID09PSAnJykgewogICAgICAgICAgICBlLnNldEF0dHJpYnV0ZShgZGF0YS0ke3R5cGV9YCwgdW5jYXRlZ29yaXplZElEKTsKICAgICAgICB9CiAgICB9KTsKCiAgICBjb25zdCBhcGlzID0gZG9jdW1lbnQucXVlcnlTZWxlY3RvckFsbCgnLmFwaScpOwogICAgYXBpcy5mb3JFYWNoKChhcGkpID0+IHsgLy8g5aSN5Yi2IGRhdGEtJHt0eXBlfSDoh7MgZGF0YS1oaWRkZW4tJHt0eXBlfQogICAgICAgIGxldCBhdHRyID0gYXBpLmdldEF0dHJpYnV0ZShgZGF0YS0ke3R5cGV9YCk7CiAgICAgICAgaWYgKGF0dHIgPT09ICcnKSB7CiAgICAgICAgICAgIGF0dHIgPSB1bmNhdGVnb3JpemVkSUQ7CiAgICAgICAgICAgIGFwaS5zZXRBdHRyaWJ1dGUoYGRhdGEtJHt0eXBlfWAsIGF0dHIpOwogICAgICAgIH0KICAgICAgICBhcGkuc2V0QXR0cmlidXRlKGBkYXRhLWhpZGRlbi0ke3R5cGV9YCwgYXR0cik7CiAgICB9KTsKCiAgICBtZW51LnF1ZXJ5U2VsZWN0b3JBbGwoJ2xpIGlucHV0JykuZm9yRWFjaCgodmFsKSA9PiB7CiAgICAgICAgdmFsLmFkZEV2ZW50TGlzdGVuZXIoJ2NoYW5nZScsIChldmVudCkgPT4gewogICAgICAgICAgICBpZiAoZXZlbnQudGFyZ2V0ID09PSBudWxsKSB7CiAgICAgICAgICAgICAgICByZXR1cm47CiAgICAgICAgICAgIH0KCiAgICAgICAgICAgIGNvbnN0IGNoayA9IGV2ZW50LnRhcmdldC5jaGVja2VkOwogICAgICAgICAgICBjb25zdCB0YWcgPSBldmVudC50YXJnZXQucGFyZW50Tm9kZS5wYXJlbnROb2RlLmdldEF0dHJpYnV0ZSgnZGF0YS0nICsgdHlwZSk7CiAgICAgICAgICAgIGFwaXMuZm9yRWFjaCgoYXBpKSA9PiB7CiAgICAgICAgICAgICAgICBjb25zdCBhdHRyID0gYXBpLmdldEF0dHJpYnV0ZShgZGF0YS0ke3R5cGV9YCk7CiAgICAgICAgICAgICAgICBpZiAoIWF0dHIuc3BsaXQoJywnKS5pbmNsdWRlcyh0YWcpKSB7CiAgICAgICAgICAgICAgICAgICAgcmV0dXJuOwogICAgICAgICAgICAgICAgfQoKICAgICAgICAgICAgICAgIGNvbnN0IGhBdHRyID0gYXBpLmdldEF0dHJpYnV0ZShgZGF0YS1oaWRkZW4tJHt0eXBlfWApLnNwbGl0KCcsJyk7CiAgICAgICAgICAgICAgICBjb25zdCBpbmRleCA9IGhBdHRyLmluZGV4T2YodGFnKTsKICAgICAgICAgICAgICAgIGlmIChjaGspIHsKICAgICAgICAgICAgICAgICAgICBpZiAoLTEgPT09IGluZGV4KSB7CiAgICAgICAgICAgICAgICAgICAgICAgIGhBdHRyLnB1c2godGFnKTsKICAgICAgICAgICAgICAgICAgICAgICAgYXBpLnNldEF0dHJpYnV0ZShgZGF0YS1oaWRkZW4tJHt0eXBlfWAsIGhBdHRyLmpvaW4oJywnKSk7CiAgICAgICAgICAgICAgICAgICAgfQogICAgICAgICAgICAgICAgfSBlbHNlIHsKICAgICAgICAgICAgICAgICAgICBpZiAoLTEgPCBpbmRleCkgewogICAgICAgICAgICAgICAgICAgICAgICBoQXR0ci5zcGxpY2UoaW5kZXgsIDEpOwogICAgICAgICAgICAgICAgICAgICAgICBhcGkuc2V0QXR0cmlidXRlKGBkYXRhLWhpZGRlbi0ke3R5cGV9YCwgaEF0dHIuam9pbignLCcpKTsKICAgICAgICAgICAgICAgICAgICB9CiAgICAgICAgICAgICAgICB9CgogICAgICAgICAgICAgICAgY29uc3QgaGlkZGVuID0gYXBpLmdldEF0dHJpYnV0ZSgnZGF0YS1oaWRkZW4tdGFnJykgPT09ICcnIHx8CiAgICAgICAgICAgICAgICAgICAgYXBpLmdldEF0dHJpYnV0ZSgnZGF0YS1oaWRkZW4tc2VydmVyJykgPT09ICcnIHx8CiAgICAgICAgICAgICAgICAgICAgYXBpLmdldEF0dHJpYnV0ZSgnZGF0YS1oaWRkZW4tbWV0aG9kJykgPT09ICcnOwogICAgICAgICAgICAgICAgYXBpLnN0eWxlLmRpc3BsYXkgPSBoaWRkZW4gPyAnbm9uZScgOiAnYmxvY2snOwogICAgICAgICAgICB9KTsKICAgICAgICB9KTsgLy8gZW5kIGFkZEV2ZW50TGlzdGVuZXIoJ2NoYW5nZScpCiAgICB9KTsgLy8gZW5kIGZvckVhY2goJ2xpIGlucHV0JykKfQoKZnVuY3Rpb24gcmVnaXN0ZXJMYW5ndWFnZUZpbHRlcigpIHsKICAgIGNvbnN0IG1lbnUgPSBkb2N1bWVudC5xdWVyeVNlbGVjdG9yKCcubGFuZ3VhZ2VzLXNlbGVjdG9yJyk7CgogICAgbWVudS5zdHlsZS5kaXNwbGF5ID0gJ2Jsb2NrJzsKCiAgICBtZW51LnF1ZXJ5U2VsZWN0b3JBbGwoJ2xpIGlucHV0JykuZm9yRWFjaCgodmFsKSA9PiB7CiAgICAgICAgdmFsLmFkZEV2ZW50TGlzdGVuZXIoJ2NoYW5nZScsIChldmVudCkgPT4gewogICAgICAgICAgICBpZiAoZXZlbnQudGFyZ2V0ID09PSBudWxsIHx8ICFldmVudC50YXJnZXQuY2hlY2tlZCkgewogICAgICAgICAgICAgICAgcmV0dXJuOwogICAgICAgICAgICB9CgogICAgICAgICAgICBjb25zdCBsYW5nID0gZXZlbnQudGFyZ2V0LnBhcmVudE5vZGUucGFyZW50Tm9kZS5nZXRBdHRyaWJ1dGUoJ2xhbmcnKTsKICAgICAgICAgICAgZG9jdW1lbnQucXVlcnlTZWxlY3RvckFsbCgnW2RhdGEtbG9jYWxlXScpLmZvckVhY2goKGVsZW0pID0+IHsKICAgICAgICAgICAgICAgIGVsZW0uY2xhc3NOYW1lID0gZWxlbS5nZXRBdHRyaWJ1dGUoJ2xhbmcnKSA9PT0gbGFuZyA/ICcnIDogJ2hpZGRlbic7CiAgICAgICAgICAgIH0pOwogICAgICAgIH0pOyAvLyBlbmQgYWRkRXZlbnRMaXN0ZW5lcignY2hhbmdlJykKICAgIH0pOyAvLyBlbmQgZm9yRWFjaCgnbGkgaW5wdXQnKQp9CgpmdW5jdGlvbiByZWdpc3RlckV4cGFuZCgpIHsKICAgIGNvbnN0IGV4cGFuZCA9IGRvY3VtZW50LnF1ZXJ5U2VsZWN0b3IoJy5leHBhbmQtc2VsZWN0b3InKTsKICAgIGlmIChleHBhbmQgPT09IG51bGwpIHsKICAgICAgICByZXR1cm47CiAgICB9CgogICAgZXhwYW5kLnN0eWxlLmRpc3BsYXkgPSAnYmxvY2snOwoKICAgIGV4cGFuZC5xdWVyeVNlbGVjdG9yKCdpbnB1dCcpLmFkZEV2ZW50TGlzdGVuZXIoJ2NoYW5nZScsIChldmVudCkgPT4gewogICAgICAgIGNvbnN0IGNoayA9IGV2ZW50LnRhcmdldC5jaGVja2VkOwogICAgICAgIGRvY3VtZW50LnF1ZXJ5U2VsZWN0b3JBbGwoJ2RldGFpbHMuYXBpJykuZm9yRWFjaCgoZWxlbSkgPT4gewogICAgICAgICAgICBlbGVtLm9wZW4gPSBjaGs7CiAgICAgICAgfSk7CiAgICB9KTsKfQoKLy8g576O5YyW5o+P6L+w5YaF5a65Ci8vCi8vIOWNs+WwhiBodG1sIOWGheWuuei9rOaNouaIkOecn+eahCBIVE1MIOagvOW8j++8jOiAjCBtYXJrZG93biDliJnkvp3nhLbmmK8gcHJlIOaYvuekuuOAggpmdW5jdGlvbiBwcmV0dHlEZXNjcmlwdGlvbigpIHsKICAgIGRvY3VtZW50LnF1ZXJ5U2VsZWN0b3JBbGwoJ1tkYXRhLXR5cGVdJykuZm9yRWFjaCgoZWxlbSkgPT4gewogICAgICAgIGNvbnN0IHR5cGUgPSBlbGVtLmdldEF0dHJpYnV0ZSgnZGF0YS10eXBlJyk7CiAgICAgICAgaWYgKHR5cGUgIT09ICdodG1sJykgewogICAgICAgICAgICByZXR1cm47CiAgICAgICAgfQoKICAgICAgICBlbGVtLmlubmVySFRNTCA9IGVsZW0uZ2V0RWxlbWVudHNCeVRhZ05hbWUoJ3ByZScpWzBdLmlubmVyVGV4dDsKICAgIH0pOwp9CgpmdW5jdGlvbiBpbml0R290b1RvcCgpIHsKICAgIGNvbnN0IHRvcCA9IGRvY3VtZW50LnF1ZXJ5U2VsZWN0b3IoJy5nb3RvLXRvcCcpOwoKICAgIC8vIOWcqOacgOmhtumDqOaXtu+8jOmakOiXj+aMiemSrgogICAgd2luZG93LmFkZEV2ZW50TGlzdGVuZXIoJ3Njcm9sbCcsIChlKSA9PiB7CiAgICAgICAgY29uc3QgYm9keSA9IGRvY3VtZW50LnF1ZXJ5U2VsZWN0b3IoJ2h0bWwnKTsKICAgICAgICBpZiAoYm9keS5zY3JvbGxUb3AgPiA1MCkgewogICAgICAgICAgICB0b3Auc3R5bGUuZGlzcGxheSA9ICdibG9jayc7CiAgICAgICAgfSBlbHNlIHsKICAgICAgICAgICAgdG9wLnN0eWxlLmRpc3BsYXkgPSAnbm9uZSc7CiAgICAgICAgfQogICAgfSk7Cn0KAAENdjYvYXBpZG9jLnhzbAEXdGV4dC94c2w7IGNoYXJzZXQ9dXRmLTgB/lU+PD94bWwgdmVyc2lvbj0iMS4wIiBlbmNvZGluZz0iVVRGLTgiPz4KCjx4c2w6c3R5bGVzaGVldCB2ZXJzaW9uPSIxLjAiIHhtbG5zOnhzbD0iaHR0cDovL3d3dy53My5vcmcvMTk5OS9YU0wvVHJhbnNmb3JtIj4KPHhzbDppbXBvcnQgaHJlZj0iLi9sb2NhbGVzLnhzbCIgLz4KPHhzbDpvdXRwdXQKICAgIG1ldGhvZD0iaHRtbCIKICAgIGVuY29kaW5nPSJ1dGYtOCIKICAgIGluZGVudD0ieWVzIgogICAgdmVyc2lvbj0iNS4wIgogICAgZG9jdHlwZS1zeXN0ZW09ImFib3V0OmxlZ2FjeS1jb21wYXQiCi8+Cgo8eHNsOnRlbXBsYXRlIG1hdGNoPSIvIj4KPGh0bWwgbGFuZz0ieyRjdXJyLWxhbmd9Ij4KICAgIDxoZWFkPgogICAgICAgIDx0aXRsZT48eHNsOnZhbHVlLW9mIHNlbGVjdD0iYXBpZG9jL3RpdGxlIiAvPjwvdGl0bGU+CiAgICAgICAgPG1ldGEgY2hhcnNldD0iVVRGLTgiIC8+CiAgICAgICAgPG1ldGEgbmFtZT0idmlld3BvcnQiIGNvbnRlbnQ9IndpZHRoPWRldmljZS13aWR0aCxpbml0aWFsLXNjYWxlPTEsbWF4aW11bS1zY2FsZT0xIiAvPgogICAgICAgIDxtZXRhIG5hbWU9ImdlbmVyYXRvciIgY29udGVudD0iYXBpZG9jIiAvPgogICAgICAgIDxsaW5rIHJlbD0iaWNvbiIgdHlwZT0iaW1hZ2Uvc3ZnK3htbCIgaHJlZj0ieyRpY29ufSIgLz4KICAgICAgICA8bGluayByZWw9Im1hc2staWNvbiIgdHlwZT0iaW1hZ2Uvc3ZnK3htbCIgaHJlZj0ieyRpY29ufSIgY29sb3I9ImJsYWNrIiAvPgogICAgICAgIDx4c2w6aWYgdGVzdD0iYXBpZG9jL2xpY2Vuc2UiPjxsaW5rIHJlbD0ibGljZW5zZSIgaHJlZj0ie2FwaWRvYy9saWNlbnNlL0B1cmx9IiAvPjwveHNsOmlmPgogICAgICAgIDxsaW5rIHJlbD0ic3R5bGVzaGVldCIgdHlwZT0idGV4dC9jc3MiIGhyZWY9InskYmFzZS11cmx9YXBpZG9jLmNzcyIgLz4KICAgICAgICA8c2NyaXB0IHNyYz0ieyRiYXNlLXVybH1hcGlkb2MuanMiIC8+CiAgICA8L2hlYWQ+CiAgICA8Ym9keT4KICAgICAgICA8eHNsOmNhbGwtdGVtcGxhdGUgbmFtZT0iaGVhZGVyIiAvPgoKICAgICAgICA8bWFpbj4KICAgICAgICAgICAgPGRpdiBjbGFzcz0iY29udGVudCIgZGF0YS10eXBlPSJ7ZGVzY3JpcHRpb24vQHR5cGV9Ij4KICAgICAgICAgICAgICAgIDxwcmU+PHhzbDpjb3B5LW9mIHNlbGVjdD0iYXBpZG9jL2Rlc2NyaXB0aW9uL25vZGUoKSIgLz48L3ByZT4KICAgICAgICAgICAgPC9kaXY+CiAgICAgICAgICAgIDxkaXYgY2xhc3M9InNlcnZlcnMiPjx4c2w6YXBwbHktdGVtcGxhdGVzIHNlbGVjdD0iYXBpZG9jL3NlcnZlciIgLz48L2Rpdj4KICAgICAgICAgICAgPHhzbDphcHBseS10ZW1wbGF0ZXMgc2VsZWN0PSJhcGlkb2MvYXBpIiAvPgogICAgICAgIDwvbWFpbj4KCiAgICAgICAgPGZvb3Rlcj4KICAgICAgICA8ZGl2IGNsYXNzPSJ3cmFwIj4KICAgICAgICAgICAgPHA+PHhzbDpjb3B5LW9mIHNlbGVjdD0iJGxvY2FsZS1nZW5lcmF0b3IiIC8+PC9wPgogICAgICAgIDwvZGl2PgogICAgICAgIDxhIGhyZWY9IiMiIGNsYXNzPSJnb3RvLXRvcCIgdGl0bGU9InskbG9jYWxlLWdvdG8tdG9wfSIgYXJpYS1sYWJlbD0ieyRsb2NhbGUtZ290by10b3B9IiAvPgogICAgICAgIDwvZm9vdGVyPgogICAgPC9ib2R5Pgo8L2h0bWw+CjwveHNsOnRlbXBsYXRlPgoKCjx4c2w6dGVtcGxhdGUgbWF0Y2g9Ii9hcGlkb2Mvc2VydmVyIj4KPGRpdiBjbGFzcz0ic2VydmVyIj4KICAgIDxoND4KICAgICAgICA8eHNsOmNhbGwtdGVtcGxhdGUgbmFtZT0iZGVwcmVjYXRlZCI+CiAgICAgICAgICAgIDx4c2w6d2l0aC1wYXJhbSBuYW1lPSJkZXByZWNhdGVkIiBzZWxlY3Q9IkBkZXByZWNhdGVkIiAvPgogICAgICAgIDwveHNsOmNhbGwtdGVtcGxhdGU+CiAgICAgICAgPHhzbDp2YWx1ZS1vZiBzZWxlY3Q9IkBuYW1lIiAvPgogICAgPC9oND4KCiAgICA8cD48eHNsOnZhbHVlLW9mIHNlbGVjdD0iQHVybCIgLz48L3A+CiAgICA8ZGl2PgogICAgICAgIDx4c2w6Y2hvb3NlPgogICAgICAgICAgICA8eHNsOndoZW4gdGVzdD0iZGVzY3JpcHRpb24iPgogICAgICAgICAgICAgICAgPHhzbDphdHRyaWJ1dGUgbmFtZT0iZGF0YS10eXBlIj4KICAgICAgICAgICAgICAgICAgICA8eHNsOnZhbHVlLW9mIHNlbGVjdD0iZGVzY3JpcHRpb24vQHR5cGUiIC8+CiAgICAgICAgICAgICAgICA8L3hzbDphdHRyaWJ1dGU+CiAgICAgICAgICAgICAgICA8cHJlPjx4c2w6Y29weS1vZiBzZWxlY3Q9ImRlc2NyaXB0aW9uL25vZGUoKSIgLz48L3ByZT4KICAgICAgICAgICAgPC94c2w6d2hlbj4KICAgICAgICAgICAgPHhzbDpvdGhlcndpc2U+PHhzbDp2YWx1ZS1vZiBzZWxlY3Q9IkBzdW1tYXJ5IiAvPjwveHNsOm90aGVyd2lzZT4KICAgICAgICA8L3hzbDpjaG9vc2U+CiAgICA8L2Rpdj4KPC9kaXY+CjwveHNsOnRlbXBsYXRlPgoKCjwhLS0gaGVhZGVyIOeVjOmdouWFg+e0oCAtLT4KPHhzbDp0ZW1wbGF0ZSBuYW1lPSJoZWFkZXIiPgo8aGVhZGVyPgo8ZGl2IGNsYXNzPSJ3cmFwIj4KICAgIDxoMT4KICAgICAgICA8aW1nIGFsdD0ibG9nbyIgc3JjPSJ7JGljb259IiAvPgogICAgICAgIDx4c2w6dmFsdWUtb2Ygc2VsZWN0PSIvYXBpZG9jL3RpdGxlIiAvPgogICAgICAgIDxzcGFuIGNsYXNzPSJ2ZXJzaW9uIj4mIzE2MDsoPHhzbDp2YWx1ZS1vZiBzZWxlY3Q9Ii9hcGlkb2MvQHZlcnNpb24iIC8+KTwvc3Bhbj4KICAgIDwvaDE+CgogICAgPGRpdiBjbGFzcz0ibWVudXMiPgogICAgICAgIDwhLS0gZXhwYW5kIC0tPgogICAgICAgIDxsYWJlbCBjbGFzcz0ibWVudSBleHBhbmQtc2VsZWN0b3IiIHJvbGU9ImNoZWNrYm94Ij4KICAgICAgICAgICAgPGlucHV0IHR5cGU9ImNoZWNrYm94IiAvPjx4c2w6Y29weS1vZiBzZWxlY3Q9IiRsb2NhbGUtZXhwYW5kIiAvPgogICAgICAgIDwvbGFiZWw+CgogICAgICAgIDwhLS0gc2VydmVyIC0tPgogICAgICAgIDx4c2w6aWYgdGVzdD0iYXBpZG9jL3NlcnZlciI+CiAgICAgICAgPGRpdiBjbGFzcz0ibWVudSBzZXJ2ZXItc2VsZWN0b3IiIHJvbGU9Im1lbnUiIGFyaWEtaGFzcG9wdXA9InRydWUiPgogICAgICAgICAgICA8eHNsOmNvcHktb2Ygc2VsZWN0PSIkbG9jYWxlLXNlcnZlciIgLz4KICAgICAgICAgICAgPHNwYW4gYXJpYS1oaWRkcmVuPSJ0cnVlIj4mIzE2MDsmI3gyNWJjOzwvc3Bhbj4KICAgICAgICAgICAgPHVsIHJvbGU9Im1lbnUiIGFyaWEtaGlkZHJlbj0idHJ1ZSI+CiAgICAgICAgICAgICAgICA8eHNsOmZvci1lYWNoIHNlbGVjdD0iYXBpZG9jL3NlcnZlciI+CiAgICAgICAgICAgICAgICA8bGkgZGF0YS1zZXJ2ZXI9IntAbmFtZX0iIHJvbGU9Im1lbnVpdGVtY2hlY2tib3giPgogICAgICAgICAgICAgICAgICAgIDxsYWJlbD4KICAgICAgICAgICAgICAgICAgICAgICAgPGlucHV0IHR5cGU9ImNoZWNrYm94IiBjaGVja2VkPSJjaGVja2VkIiAvPiYjMTYwOzx4c2w6dmFsdWUtb2Ygc2VsZWN0PSJAbmFtZSIgLz4KICAgICAgICAgICAgICAgICAgICA8L2xhYmVsPgogICAgICAgICAgICAgICAgPC9saT4KICAgICAgICAgICAgICAgIDwveHNsOmZvci1lYWNoPgogICAgICAgICAgICA8L3VsPgogICAgICAgIDwvZGl2PgogICAgICAgIDwveHNsOmlmPgoKICAgICAgICA8IS0tIHRhZyAtLT4KICAgICAgICA8eHNsOmlmIHRlc3Q9ImFwaWRvYy90YWciPgogICAgICAgIDxkaXYgY2xhc3M9Im1lbnUgdGFnLXNlbGVjdG9yIiByb2xlPSJtZW51IiBhcmlhLWhhc3BvcHVwPSJ0cnVlIj4KICAgICAgICAgICAgPHhzbDpjb3B5LW9mIHNlbGVjdD0iJGxvY2FsZS10YWciIC8+CiAgICAgICAgICAgIDxzcGFuIGFyaWEtaGlkZHJlbj0idHJ1ZSI+JiMxNjA7JiN4MjViYzs8L3NwYW4+CiAgICAgICAgICAgIDx1bCByb2xlPSJtZW51IiBhcmlhLWhpZGRyZW49InRydWUiPgogICAgICAgICAgICAgICAgPGxpIGRhdGEtdGFnPSIiIHJvbGU9Im1lbnVpdGVtY2hlY2tib3giPgogICAgICAgICAgICAgICAgICAgIDxsYWJlbD4KICAgICAgICAgICAgICAgICAgICAgICAgPGlucHV0IHR5cGU9ImNoZWNrYm94IiBjaGVja2VkPSJjaGVja2VkIiAvPiYjMTYwOzx4c2w6Y29weS1vZiBzZWxlY3Q9IiRsb2NhbGUtdW5jYXRlZ29yaXplZCIgLz4KICAgICAgICAgICAgICAgICAgICA8L2xhYmVsPgogICAgICAgICAgICAgICAgPC9saT4KICAgICAgICAgICAgICAgIDx4c2w6Zm9yLWVhY2ggc2VsZWN0PSJhcGlkb2MvdGFnIj4KICAgICAgICAgICAgICAgIDxsaSBkYXRhLXRhZz0ie0BuYW1lfSIgcm9sZT0ibWVudWl0ZW1jaGVja2JveCI+CiAgICAgICAgICAgICAgICAgICAgPGxhYmVsPgogICAgICAgICAgICAgICAgICAgICAgICA8aW5wdXQgdHlwZT0iY2hlY2tib3giIGNoZWNrZWQ9ImNoZWNrZWQiIC8+JiMxNjA7PHhzbDp2YWx1ZS1vZiBzZWxlY3Q9IkB0aXRsZSIgLz4KICAgICAgICAgICAgICAgICAgICA8L2xhYmVsPgogICAgICAgICAgICAgICAgPC9saT4KICAgICAgICAgICAgICAgIDwveHNsOmZvci1lYWNoPgogICAgICAgICAgICA8L3VsPgogICAgICAgIDwvZGl2PgogICAgICAgIDwveHNsOmlmPgoKICAgICAgICA8IS0tIG1ldGhvZCAtLT4KICAgICAgICA8ZGl2IGNsYXNzPSJtZW51IG1ldGhvZC1zZWxlY3RvciIgcm9sZT0ibWVudSIgYXJpYS1oYXNwb3B1cD0idHJ1ZSI+CiAgICAgICAgICAgIDx4c2w6Y29weS1vZiBzZWxlY3Q9IiRsb2NhbGUtbWV0aG9kIiAvPgogICAgICAgICAgICA8c3BhbiBhcmlhLWhpZGRyZW49InRydWUiPiYjMTYwOyYjeDI1YmM7PC9zcGFuPgogICAgICAgICAgICA8dWwgcm9sZT0ibWVudSIgYXJpYS1oaWRkcmVuPSJ0cnVlIj4KICAgICAgICAgICAgICAgIDwhLS0g5rWP6KeI5Zmo5aW95YOP6YO95LiN5pSv5oyBIHhwYXRoIDIuMO+8jOaJgOS7peaXoOazleS9v+eUqCBkaXN0aW5jdC12YWx1ZXMgLS0+CiAgICAgICAgICAgICAgICA8IS0tIHhzbDpmb3ItZWFjaCBzZWxlY3Q9ImRpc3RpbmN0LXZhbHVlcygvYXBpZG9jL2FwaS9AbWV0aG9kKSIgLS0+CiAgICAgICAgICAgICAgICA8eHNsOmZvci1lYWNoIHNlbGVjdD0iL2FwaWRvYy9hcGkvQG1ldGhvZFtub3QoLi4vcHJlY2VkaW5nLXNpYmxpbmc6OmFwaS9AbWV0aG9kID0gLildIj4KICAgICAgICAgICAgICAgIDxsaSBkYXRhLW1ldGhvZD0iey59IiByb2xlPSJtZW51aXRlbWNoZWNrYm94Ij4KICAgICAgICAgICAgICAgICAgICA8bGFiZWw+PGlucHV0IHR5cGU9ImNoZWNrYm94IiBjaGVja2VkPSJjaGVja2VkIiAvPiYjMTYwOzx4c2w6dmFsdWUtb2Ygc2VsZWN0PSIuIiAvPjwvbGFiZWw+CiAgICAgICAgICAgICAgICA8L2xpPgogICAgICAgICAgICAgICAgPC94c2w6Zm9yLWVhY2g+CiAgICAgICAgICAgIDwvdWw+CiAgICAgICAgPC9kaXY+CgogICAgICAgIDwhLS0gbGFuZ3VhZ2UgLS0+CiAgICAgICAgPGRpdiBjbGFzcz0ibWVudSBsYW5ndWFnZXMtc2VsZWN0b3IiIHJvbGU9Im1lbnUiIGFyaWEtaGFzcG9wdXA9InRydWUiPgogICAgICAgICAgICA8eHNsOmNvcHktb2Ygc2VsZWN0PSIkbG9jYWxlLWxhbmd1YWdlIiAvPgogICAgICAgICAgICA8c3BhbiBhcmlhLWhpZGRyZW49InRydWUiPiYjMTYwOyYjeDI1YmM7PC9zcGFuPgogICAgICAgICAgICA8dWwgcm9sZT0ibWVudSIgYXJpYS1oaWRkcmVuPSJ0cnVlIj48eHNsOmNhbGwtdGVtcGxhdGUgbmFtZT0ibGFuZ3VhZ2VzIiAvPjwvdWw+CiAgICAgICAgPC9kaXY+CiAgICA8L2Rpdj4gPCEtLSBlbmQgLm1lbnVzIC0tPgo8L2Rpdj4gPCEtLSBlbmQgLndyYXAgLS0+CjwvaGVhZGVyPgo8L3hzbDp0ZW1wbGF0ZT4KCgo8IS0tIGFwaSDnlYzpnaLlhYPntKAgLS0+Cjx4c2w6dGVtcGxhdGUgbWF0Y2g9Ii9hcGlkb2MvYXBpIj4KPHhzbDp2YXJpYWJsZSBuYW1lPSJpZCIgc2VsZWN0PSJjb25jYXQoc2VydmVyLCBAbWV0aG9kLCB0cmFuc2xhdGUocGF0aC9AcGF0aCwgJGlkLWZyb20sICRpZC10bykpIiAvPgoKPGRldGFpbHMgaWQ9InskaWR9IiBjbGFzcz0iYXBpIiBkYXRhLW1ldGhvZD0ie0BtZXRob2R9Ij4KPHhzbDphdHRyaWJ1dGUgbmFtZT0iZGF0YS10YWciPgogICAgPHhzbDpmb3ItZWFjaCBzZWxlY3Q9InRhZyI+PCEtLSBOT1RFOiDlpoLmnpzmmK8geHNsIDIuMCDlj6/ku6Xnm7TmjqXkvb/nlKggc3RyaW5nLWpvaW4g5Ye95pWwIC0tPgogICAgICAgIDx4c2w6dmFsdWUtb2Ygc2VsZWN0PSIuIiAvPjx4c2w6aWYgdGVzdD0icG9zaXRpb24oKSAhPSBsYXN0KCkiPiw8L3hzbDppZj4KICAgIDwveHNsOmZvci1lYWNoPgo8L3hzbDphdHRyaWJ1dGU+Cjx4c2w6YXR0cmlidXRlIG5hbWU9ImRhdGEtc2VydmVyIj4KICAgIDx4c2w6Zm9yLWVhY2ggc2VsZWN0PSJzZXJ2ZXIiPgogICAgICAgIDx4c2w6dmFsdWUtb2Ygc2VsZWN0PSIuIiAvPjx4c2w6aWYgdGVzdD0icG9zaXRpb24oKSAhPSBsYXN0KCkiPiw8L3hzbDppZj4KICAgIDwveHNsOmZvci1lYWNoPgo8L3hzbDphdHRyaWJ1dGU+CgogICAgPCEtLSAKICAgIGJ1Zzog5bCGIHN1bW1hcnkg5L2c5Li6IGZsZXgg5a655Zmo5ZyoIHNhZmFyaSDkvJrlh7rplJnjgIIKICAgIGh0dHBzOi8vYnVncy53ZWJraXQub3JnL3Nob3dfYnVnLmNnaT9pZD0xOTAwNjUKICAgIC0tPgogICAgPHN1bW1hcnk+CiAgICAgICAgPGRpdiBjbGFzcz0iYWN0aW9uIj4KICAgICAgICAgICAgPGEgY2xhc3M9ImxpbmsiIGhyZWY9IiN7JGlkfSI+JiMxMjgyNzk7PC9hPiA8IS0tIOmTvuaOpeespuWPtyAtLT4KCiAgICAgICAgICAgIDxzcGFuIGNsYXNzPSJtZXRob2QiPjx4c2w6dmFsdWUtb2Ygc2VsZWN0PSJAbWV0aG9kIiAvPjwvc3Bhbj4KICAgICAgICAgICAgPHNwYW4+CiAgICAgICAgICAgICAgICA8eHNsOmNhbGwtdGVtcGxhdGUgbmFtZT0iZGVwcmVjYXRlZCI+CiAgICAgICAgICAgICAgICAgICAgPHhzbDp3aXRoLXBhcmFtIG5hbWU9ImRlcHJlY2F0ZWQiIHNlbGVjdD0iQGRlcHJlY2F0ZWQiIC8+CiAgICAgICAgICAgICAgICA8L3hzbDpjYWxsLXRlbXBsYXRlPgoKICAgICAgICAgICAgICAgIDx4c2w6dmFsdWUtb2Ygc2VsZWN0PSJwYXRoL0BwYXRoIiAvPgogICAgICAgICAgICA8L3NwYW4+CiAgICAgICAgPC9kaXY+CgogICAgICAgIDxkaXYgY2xhc3M9InJpZ2h0Ij4KICAgICAgICAgICAgPHNwYW4gY2xhc3M9InNydiI+CiAgICAgICAgICAgICAgICA8eHNsOmZvci1lYWNoIHNlbGVjdD0ic2VydmVyIj4KICAgICAgICAgICAgICAgICAgICA8eHNsOnZhbHVlLW9mIHNlbGVjdD0iLiIgLz48eHNsOmlmIHRlc3Q9InBvc2l0aW9uKCkgIT0gbGFzdCgpIj4sIDwveHNsOmlmPgogICAgICAgICAgICAgICAgPC94c2w6Zm9yLWVhY2g+CiAgICAgICAgICAgIDwvc3Bhbj4KCiAgICAgICAgICAgIDxzcGFuIGNsYXNzPSJzdW1tYXJ5Ij48eHNsOnZhbHVlLW9mIHNlbGVjdD0iQHN1bW1hcnkiIC8+PC9zcGFuPgogICAgICAgIDwvZGl2PgogICAgPC9zdW1tYXJ5PgoKICAgIDx4c2w6aWYgdGVzdD0iZGVzY3JpcHRpb24iPgogICAgICAgIDxkaXYgY2xhc3M9ImRlc2NyaXB0aW9uIiBkYXRhLXR5cGU9IntkZXNjcmlwdGlvbi9AdHlwZX0iPgogICAgICAgICAgICA8cHJlPjx4c2w6Y29weS1vZiBzZWxlY3Q9ImRlc2NyaXB0aW9uL25vZGUoKSIgLz48L3ByZT4KICAgICAgICA8L2Rpdj4KICAgIDwveHNsOmlmPgoKICAgIDxkaXYgY2xhc3M9ImJvZHkiPgogICAgICAgIDxkaXYgY2xhc3M9InJlcXVlc3RzIj4KICAgICAgICAgICAgPGg0IGNsYXNzPSJoZWFkZXIiPjx4c2w6Y29weS1vZiBzZWxlY3Q9IiRsb2NhbGUtcmVxdWVzdCIgLz48L2g0PgogICAgICAgICAgICA8eHNsOmNhbGwtdGVtcGxhdGUgbmFtZT0icmVxdWVzdHMiPgogICAgICAgICAgICAgICAgPHhzbDp3aXRoLXBhcmFtIG5hbWU9InJlcXVlc3RzIiBzZWxlY3Q9InJlcXVlc3QiIC8+CiAgICAgICAgICAgICAgICA8eHNsOndpdGgtcGFyYW0gbmFtZT0icGF0aCIgc2VsZWN0PSJwYXRoIiAvPgogICAgICAgICAgICAgICAgPHhzbDp3aXRoLXBhcmFtIG5hbWU9ImhlYWRlcnMiIHNlbGVjdD0iaGVhZGVyIHwgL2FwaWRvYy9oZWFkZXIiIC8+CiAgICAgICAgICAgIDwveHNsOmNhbGwtdGVtcGxhdGU+CiAgICAgICAgPC9kaXY+CiAgICAgICAgPGRpdiBjbGFzcz0icmVzcG9uc2VzIj4KICAgICAgICAgICAgPGg0IGNsYXNzPSJoZWFkZXIiPjx4c2w6Y29weS1vZiBzZWxlY3Q9IiRsb2NhbGUtcmVzcG9uc2UiIC8+PC9oND4KICAgICAgICAgICAgPHhzbDpjYWxsLXRlbXBsYXRlIG5hbWU9InJlc3BvbnNlcyI+CiAgICAgICAgICAgICAgICA8eHNsOndpdGgtcGFyYW0gbmFtZT0icmVzcG9uc2VzIiBzZWxlY3Q9InJlc3BvbnNlIHwgL2FwaWRvYy9yZXNwb25zZSIgLz4KICAgICAgICAgICAgPC94c2w6Y2FsbC10ZW1wbGF0ZT4KICAgICAgICA8L2Rpdj4KICAgIDwvZGl2PgoKICAgIDx4c2w6aWYgdGVzdD0iLi9jYWxsYmFjayI+PHhzbDphcHBseS10ZW1wbGF0ZXMgc2VsZWN0PSIuL2NhbGxiYWNrIiAvPjwveHNsOmlmPgo8L2RldGFpbHM+CjwveHNsOnRlbXBsYXRlPgoKCjwhLS0g5Zue6LCD5YaF5a65IC0tPgo8eHNsOnRlbXBsYXRlIG1hdGNoPSIvYXBpZG9jL2FwaS9jYWxsYmFjayI+CjxkaXYgY2xhc3M9ImNhbGxiYWNrIiBkYXRhLW1ldGhvZD0iey4vQG1ldGhvZH0iPgogICAgPGgzPgogICAgICAgIDx4c2w6Y29weS1vZiBzZWxlY3Q9IiRsb2NhbGUtY2FsbGJhY2siIC8+CiAgICAgICAgPHNwYW4gY2xhc3M9InN1bW1hcnkiPjx4c2w6dmFsdWUtb2Ygc2VsZWN0PSJAc3VtbWFyeSIgLz48L3NwYW4+CiAgICA8L2gzPgogICAgPHhzbDppZiB0ZXN0PSJkZXNjcmlwdGlvbiI+CiAgICAgICAgPGRpdiBjbGFzcz0iZGVzY3JpcHRpb24iIGRhdGEtdHlwZT0ie2Rlc2NyaXB0aW9uL0B0eXBlfSI+CiAgICAgICAgICAgIDxwcmU+PHhzbDpjb3B5LW9mIHNlbGVjdD0iZGVzY3JpcHRpb24vbm9kZSgpIiAvPjwvcHJlPgogICAgICAgIDwvZGl2PgogICAgPC94c2w6aWY+CgogICAgPGRpdiBjbGFzcz0iYm9keSI+CiAgICAgICAgPGRpdiBjbGFzcz0icmVxdWVzdHMiPgogICAgICAgICAgICA8aDQgY2xhc3M9ImhlYWRlciI+PHhzbDpjb3B5LW9mIHNlbGVjdD0iJGxvY2FsZS1yZXF1ZXN0IiAvPjwvaDQ+CiAgICAgICAgICAgIDx4c2w6Y2FsbC10ZW1wbGF0ZSBuYW1lPSJyZXF1ZXN0cyI+CiAgICAgICAgICAgICAgICA8eHNsOndpdGgtcGFyYW0gbmFtZT0icmVxdWVzdHMiIHNlbGVjdD0icmVxdWVzdCIgLz4KICAgICAgICAgICAgICAgIDx4c2w6d2l0aC1wYXJhbSBuYW1lPSJwYXRoIiBzZWxlY3Q9InBhdGgiIC8+CiAgICAgICAgICAgICAgICA8eHNsOndpdGgtcGFyYW0gbmFtZT0iaGVhZGVycyIgc2VsZWN0PSJoZWFkZXIiIC8+CiAgICAgICAgICAgIDwveHNsOmNhbGwtdGVtcGxhdGU+CiAgICAgICAgPC9kaXY+CgogICAgICAgIDx4c2w6aWYgdGVzdD0icmVzcG9uc2UiPgogICAgICAgICAgICA8ZGl2IGNsYXNzPSJyZXNwb25zZXMiPgogICAgICAgICAgICAgICAgPGg0IGNsYXNzPSJoZWFkZXIiPjx4c2w6Y29weS1vZiBzZWxlY3Q9IiRsb2NhbGUtcmVzcG9uc2UiIC8+PC9oND4KICAgICAgICAgICAgICAgIDx4c2w6Y2FsbC10ZW1wbGF0ZSBuYW1lPSJyZXNwb25zZXMiPgogICAgICAgICAgICAgICAgICAgIDx4c2w6d2l0aC1wYXJhbSBuYW1lPSJyZXNwb25zZXMiIHNlbGVjdD0icmVzcG9uc2UiIC8+CiAgICAgICAgICAgICAgICA8L3hzbDpjYWxsLXRlbXBsYXRlPgogICAgICAgICAgICA8L2Rpdj4KICAgICAgICA8L3hzbDppZj4KICAgIDwvZGl2PiA8IS0tIGVuZCAuYm9keSAtLT4KPC9kaXY+IDwhLS0gZW5kIC5jYWxsYmFjayAtLT4KPC94c2w6dGVtcGxhdGU+CgoKPCEtLSBhcGkvcmVxdWVzdCDnmoTnlYzpnaLlhYPntKAgLS0+Cjx4c2w6dGVtcGxhdGUgbmFtZT0icmVxdWVzdHMiPgo8eHNsOnBhcmFtIG5hbWU9InJlcXVlc3RzIiAvPgo8eHNsOnBhcmFtIG5hbWU9InBhdGgiIC8+Cjx4c2w6cGFyYW0gbmFtZT0iaGVhZGVycyIgLz4gPCEtLSDlhaznlKjnmoTmiqXlpLQgLS0+Cjx4c2w6aWYgdGVzdD0iJHBhdGgvcGFyYW0iPgogICAgPHhzbDpjYWxsLXRlbXBsYXRlIG5hbWU9InBhcmFtIj4KICAgICAgICA8eHNsOndpdGgtcGFyYW0gbmFtZT0idGl0bGUiPgogICAgICAgICAgICA8eHNsOmNvcHktb2Ygc2VsZWN0PSIkbG9jYWxlLXBhdGgtcGFyYW0iIC8+CiAgICAgICAgPC94c2w6d2l0aC1wYXJhbT4KICAgICAgICA8eHNsOndpdGgtcGFyYW0gbmFtZT0icGFyYW0iIHNlbGVjdD0iJHBhdGgvcGFyYW0iIC8+CiAgICAgICAgPHhzbDp3aXRoLXBhcmFtIG5hbWU9InNpbXBsZSIgc2VsZWN0PSIndHJ1ZSciIC8+CiAgICA8L3hzbDpjYWxsLXRlbXBsYXRlPgo8L3hzbDppZj4KCjx4c2w6aWYgdGVzdD0iJHBhdGgvcXVlcnkiPgogICAgPHhzbDpjYWxsLXRlbXBsYXRlIG5hbWU9InBhcmFtIj4KICAgICAgICA8eHNsOndpdGgtcGFyYW0gbmFtZT0idGl0bGUiPgogICAgICAgICAgICA8eHNsOmNvcHktb2Ygc2VsZWN0PSIkbG9jYWxlLXF1ZXJ5IiAvPgogICAgICAgIDwveHNsOndpdGgtcGFyYW0+CiAgICAgICAgPHhzbDp3aXRoLXBhcmFtIG5hbWU9InBhcmFtIiBzZWxlY3Q9IiRwYXRoL3F1ZXJ5IiAvPgogICAgICAgIDx4c2w6d2l0aC1wYXJhbSBuYW1lPSJzaW1wbGUiIHNlbGVjdD0iJ3RydWUnIiAvPgogICAgPC94c2w6Y2FsbC10ZW1wbGF0ZT4KPC94c2w6aWY+Cgo8eHNsOmlmIHRlc3Q9IiRoZWFkZXJzIj4KICAgIDx4c2w6Y2FsbC10ZW1wbGF0ZSBuYW1lPSJwYXJhbSI+CiAgICAgICAgPHhzbDp3aXRoLXBhcmFtIG5hbWU9InRpdGxlIj4KICAgICAgICAgICAgPHhzbDpjb3B5LW9mIHNlbGVjdD0iJGxvY2FsZS1oZWFkZXIiIC8+CiAgICAgICAgPC94c2w6d2l0aC1wYXJhbT4KICAgICAgICA8eHNsOndpdGgtcGFyYW0gbmFtZT0icGFyYW0iIHNlbGVjdD0iJGhlYWRlcnMiIC8+CiAgICAgICAgPHhzbDp3aXRoLXBhcmFtIG5hbWU9InNpbXBsZSIgc2VsZWN0PSIndHJ1ZSciIC8+CiAgICA8L3hzbDpjYWxsLXRlbXBsYXRlPgo8L3hzbDppZj4KCjx4c2w6dmFyaWFibGUgbmFtZT0icmVxdWVzdC1hbnkiIHNlbGVjdD0iJHJlcXVlc3RzW25vdChAbWltZXR5cGUpXSIgLz4KPHhzbDpmb3ItZWFjaCBzZWxlY3Q9Ii9hcGlkb2MvbWltZXR5cGUgfCAkcmVxdWVzdHMvQG1pbWV0eXBlW25vdCgvYXBpZG9jL21pbWV0eXBlPS4pXSI+CiAgICA8eHNsOnZhcmlhYmxlIG5hbWU9Im1pbWV0eXBlIiBzZWxlY3Q9Ii4iIC8+CiAgICA8eHNsOnZhcmlhYmxlIG5hbWU9InJlcXVlc3QiIHNlbGVjdD0iJHJlcXVlc3RzW0BtaW1ldHlwZT0kbWltZXR5cGVdIiAvPgogICAgPHhzbDppZiB0ZXN0PSIkcmVxdWVzdCI+CiAgICAgICAgPHhzbDpjYWxsLXRlbXBsYXRlIG5hbWU9InJlcXVlc3QtYm9keSI+CiAgICAgICAgICAgIDx4c2w6d2l0aC1wYXJhbSBuYW1lPSJtaW1ldHlwZSIgc2VsZWN0PSIkbWltZXR5cGUiIC8+CiAgICAgICAgICAgIDx4c2w6d2l0aC1wYXJhbSBuYW1lPSJyZXF1ZXN0IiBzZWxlY3Q9IiRyZXF1ZXN0IiAvPgogICAgICAgIDwveHNsOmNhbGwtdGVtcGxhdGU+CiAgICA8L3hzbDppZj4KICAgIDx4c2w6aWYgdGVzdD0ibm90KCRyZXF1ZXN0KSBhbmQgJHJlcXVlc3QtYW55Ij4KICAgICAgICA8eHNsOmNhbGwtdGVtcGxhdGUgbmFtZT0icmVxdWVzdC1ib2R5Ij4KICAgICAgICAgICAgPHhzbDp3aXRoLXBhcmFtIG5hbWU9Im1pbWV0eXBlIiBzZWxlY3Q9IiRtaW1ldHlwZSIgLz4KICAgICAgICAgICAgPHhzbDp3aXRoLXBhcmFtIG5hbWU9InJlcXVlc3QiIHNlbGVjdD0iJHJlcXVlc3QtYW55IiAvPgogICAgICAgIDwveHNsOmNhbGwtdGVtcGxhdGU+CiAgICA8L3hzbDppZj4KPC94c2w6Zm9yLWVhY2g+CjwveHNsOnRlbXBsYXRlPgoKCjx4c2w6dGVtcGxhdGUgbmFtZT0icmVxdWVzdC1ib2R5Ij4KPHhzbDpwYXJhbSBuYW1lPSJtaW1ldHlwZSIgLz4KPHhzbDpwYXJhbSBuYW1lPSJyZXF1ZXN0IiAvPgo8ZGV0YWlscz4KICAgIDxzdW1tYXJ5Pjx4c2w6dmFsdWUtb2Ygc2VsZWN0PSIkbWltZXR5cGUiIC8+PC9zdW1tYXJ5PgogICAgPHhzbDpjYWxsLXRlbXBsYXRlIG5hbWU9InRvcC1wYXJhbSI+CiAgICAgICAgPHhzbDp3aXRoLXBhcmFtIG5hbWU9Im1pbWV0eXBlIiBzZWxlY3Q9IiRtaW1ldHlwZSIgLz4KICAgICAgICA8eHNsOndpdGgtcGFyYW0gbmFtZT0icGFyYW0iIHNlbGVjdD0iJHJlcXVlc3QiIC8+CiAgICA8L3hzbDpjYWxsLXRlbXBsYXRlPgo8L2RldGFpbHM+CjwveHNsOnRlbXBsYXRlPgoKPHhzbDp0ZW1wbGF0ZSBuYW1lPSJyZXNwb25zZXMiPgo8eHNsOnBhcmFtIG5hbWU9InJlc3BvbnNlcyIgLz4KPHhzbDpmb3ItZWFjaCBzZWxlY3Q9Ii9hcGlkb2MvbWltZXR5cGUgfCAkcmVzcG9uc2VzL0BtaW1ldHlwZVtub3QoL2FwaWRvYy9taW1ldHlwZT0uKV0iPgogICAgPHhzbDp2YXJpYWJsZSBuYW1lPSJtaW1ldHlwZSIgc2VsZWN0PSIuIiAvPgogICAgPHhzbDppZiB0ZXN0PSIkcmVzcG9uc2VzW0BtaW1ldHlwZT0kbWltZXR5cGVdIHwgJHJlc3BvbnNlc1tub3QoQG1pbWV0eXBlKV0iPgogICAgICAgIDxkZXRhaWxzPgogICAgICAgIDxzdW1tYXJ5Pjx4c2w6dmFsdWUtb2Ygc2VsZWN0PSIkbWltZXR5cGUiIC8+PC9zdW1tYXJ5PgogICAgICAgIDx4c2w6Zm9yLWVhY2ggc2VsZWN0PSIkcmVzcG9uc2VzIj4KICAgICAgICAgICAgPHhzbDp2YXJpYWJsZSBuYW1lPSJyZXNwIiBzZWxlY3Q9ImN1cnJlbnQoKVtAbWltZXR5cGU9JG1pbWV0eXBlXSIgLz4KICAgICAgICAgICAgPHhzbDp2YXJpYWJsZSBuYW1lPSJyZXNwLWFueSIgc2VsZWN0PSJjdXJyZW50KClbbm90KEBtaW1ldHlwZSldIiAvPgogICAgICAgICAgICA8eHNsOmlmIHRlc3Q9IiRyZXNwIj48IS0tIOWPr+iDveWQjOaXtuWtmOWcqOespuWQiCByZXNwIOWSjCByZXNwLWFueSDnmoTmlbDmja7vvIzkvJjlhYjlj5YgcmVzcCAtLT4KICAgICAgICAgICAgICAgIDx4c2w6Y2FsbC10ZW1wbGF0ZSBuYW1lPSJyZXNwb25zZSI+CiAgICAgICAgICAgICAgICAgICAgPHhzbDp3aXRoLXBhcmFtIG5hbWU9InJlc3BvbnNlIiBzZWxlY3Q9IiRyZXNwIiAvPgogICAgICAgICAgICAgICAgICAgIDx4c2w6d2l0aC1wYXJhbSBuYW1lPSJtaW1ldHlwZSIgc2VsZWN0PSIkbWltZXR5cGUiIC8+CiAgICAgICAgICAgICAgICA8L3hzbDpjYWxsLXRlbXBsYXRlPgogICAgICAgICAgICA8L3hzbDppZj4KICAgICAgICAgICAgPHhzbDppZiB0ZXN0PSJub3QoJHJlc3ApIGFuZCAkcmVzcC1hbnkiPgogICAgICAgICAgICAgICAgPHhzbDpjYWxsLXRlbXBsYXRlIG5hbWU9InJlc3BvbnNlIj4KICAgICAgICAgICAgICAgICAgICA8eHNsOndpdGgtcGFyYW0gbmFtZT0icmVzcG9uc2UiIHNlbGVjdD0iJHJlc3AtYW55IiAvPgogICAgICAgICAgICAgICAgICAgIDx4c2w6d2l0aC1wYXJhbSBuYW1lPSJtaW1ldHlwZSIgc2VsZWN0PSIkbWltZXR5cGUiIC8+CiAgICAgICAgICAgICAgICA8L3hzbDpjYWxsLXRlbXBsYXRlPgogICAgICAgICAgICA8L3hzbDppZj4KICAgICAgICA8L3hzbDpmb3ItZWFjaD4KICAgICAgICA8L2RldGFpbHM+CiAgICA8L3hzbDppZj4KPC94c2w6Zm9yLWVhY2g+CjwveHNsOnRlbXBsYXRlPgoKCjwhLS0gYXBpL3Jlc3BvbnNlIOeahOeVjOmdoiAtLT4KPHhzbDp0ZW1wbGF0ZSBuYW1lPSJyZXNwb25zZSI+Cjx4c2w6cGFyYW0gbmFtZT0icmVzcG9uc2UiIC8+Cjx4c2w6cGFyYW0gbmFtZT0ibWltZXR5cGUiIC8+Cgo8aDUgY2xhc3M9InN0YXR1cyI+PHhzbDp2YWx1ZS1vZiBzZWxlY3Q9IiRyZXNwb25zZS9Ac3RhdHVzIiAvPjwvaDU+CjxkaXY+Cjx4c2w6Y2hvb3NlPgogICAgPHhzbDp3aGVuIHRlc3Q9IiRyZXNwb25zZS9kZXNjcmlwdGlvbiI+CiAgICAgICAgPHhzbDphdHRyaWJ1dGUgbmFtZT0iZGF0YS10eXBlIj4KICAgICAgICAgICAgPHhzbDp2YWx1ZS1vZiBzZWxlY3Q9IiRyZXNwb25zZS9kZXNjcmlwdGlvbi9AdHlwZSIgLz4KICAgICAgICA8L3hzbDphdHRyaWJ1dGU+CiAgICAgICAgPHByZT48eHNsOmNvcHktb2Ygc2VsZWN0PSIkcmVzcG9uc2UvZGVzY3JpcHRpb24vbm9kZSgpIiAvPjwvcHJlPgogICAgPC94c2w6d2hlbj4KICAgIDx4c2w6b3RoZXJ3aXNlPjx4c2w6dmFsdWUtb2Ygc2VsZWN0PSIkcmVzcG9uc2UvQHN1bW1hcnkiIC8+PC94c2w6b3RoZXJ3aXNlPgo8L3hzbDpjaG9vc2U+CjwvZGl2Pgo8eHNsOmNhbGwtdGVtcGxhdGUgbmFtZT0idG9wLXBhcmFtIj4KICAgIDx4c2w6d2l0aC1wYXJhbSBuYW1lPSJtaW1ldHlwZSIgc2VsZWN0PSIkbWltZXR5cGUiIC8+CiAgICA8eHNsOndpdGgtcGFyYW0gbmFtZT0icGFyYW0iIHNlbGVjdD0iJHJlc3BvbnNlIiAvPgo8L3hzbDpjYWxsLXRlbXBsYXRlPgo8L3hzbDp0ZW1wbGF0ZT4KCjwhLS0gcmVxdWVzdCDlkowgcmVzcG9uc2Ug5Y+C5pWw55qE6aG25bGC5YWD57Sg6LCD55So5qih5p2/IC0tPgo8eHNsOnRlbXBsYXRlIG5hbWU9InRvcC1wYXJhbSI+Cjx4c2w6cGFyYW0gbmFtZT0ibWltZXR5cGUiIC8+Cjx4c2w6cGFyYW0gbmFtZT0icGFyYW0iIC8+Cjx4c2w6aWYgdGVzdD0iJHBhcmFtL2hlYWRlciI+CiAgICA8eHNsOmNhbGwtdGVtcGxhdGUgbmFtZT0icGFyYW0iPgogICAgICAgIDx4c2w6d2l0aC1wYXJhbSBuYW1lPSJ0aXRsZSI+CiAgICAgICAgICAgIDx4c2w6Y29weS1vZiBzZWxlY3Q9IiRsb2NhbGUtaGVhZGVyIiAvPgogICAgICAgIDwveHNsOndpdGgtcGFyYW0+CiAgICAgICAgPHhzbDp3aXRoLXBhcmFtIG5hbWU9InBhcmFtIiBzZWxlY3Q9IiRwYXJhbS9oZWFkZXIiIC8+CiAgICAgICAgPHhzbDp3aXRoLXBhcmFtIG5hbWU9InNpbXBsZSIgc2VsZWN0PSIndHJ1ZSciIC8+CiAgICA8L3hzbDpjYWxsLXRlbXBsYXRlPgo8L3hzbDppZj4KCgo8eHNsOmNhbGwtdGVtcGxhdGUgbmFtZT0icGFyYW0iPgogICAgPHhzbDp3aXRoLXBhcmFtIG5hbWU9InRpdGxlIj48eHNsOmNvcHktb2Ygc2VsZWN0PSIkbG9jYWxlLWJvZHkiIC8+PC94c2w6d2l0aC1wYXJhbT4KICAgIDx4c2w6d2l0aC1wYXJhbSBuYW1lPSJwYXJhbSIgc2VsZWN0PSIkcGFyYW0iIC8+CjwveHNsOmNhbGwtdGVtcGxhdGU+Cgo8eHNsOmlmIHRlc3Q9IiRwYXJhbS9leGFtcGxlIj4KICAgIDxoNCBjbGFzcz0idGl0bGUiPiYjeDI3YTQ7JiMxNjA7PHhzbDpjb3B5LW9mIHNlbGVjdD0iJGxvY2FsZS1leGFtcGxlIiAvPjwvaDQ+CiAgICA8eHNsOmZvci1lYWNoIHNlbGVjdD0iJHBhcmFtL2V4YW1wbGUiPgogICAgICAgIDx4c2w6aWYgdGVzdD0ibm90KEBtaW1ldHlwZSkgb3IgQG1pbWV0eXBlPSRtaW1ldHlwZSI+CiAgICAgICAgICAgIDxwcmUgY2xhc3M9ImV4YW1wbGUiPjx4c2w6Y29weS1vZiBzZWxlY3Q9Im5vZGUoKSIgLz48L3ByZT4KICAgICAgICA8L3hzbDppZj4KICAgIDwveHNsOmZvci1lYWNoPgo8L3hzbDppZj4KPC94c2w6dGVtcGxhdGU+CgoKPCEtLSBwYXRoIHBhcmFtLCBwYXRoIHF1ZXJ5LCBoZWFkZXIg562J55qE55WM6Z2iIC0tPgo8eHNsOnRlbXBsYXRlIG5hbWU9InBhcmFtIj4KPHhzbDpwYXJhbSBuYW1lPSJ0aXRsZSIgLz4KPHhzbDpwYXJhbSBuYW1lPSJwYXJhbSIgLz4KPHhzbDpwYXJhbSBuYW1lPSJzaW1wbGUiIHNlbGVjdD0iJ2ZhbHNlJyIgLz4gPCEtLSDnroDljZXnmoTnsbvlnovvvIzkuI3lrZjlnKjltYzlpZfnsbvlnovvvIzkuZ/kuI3kvJrmnInnpLrkvovku6PnoIEgLS0+Cgo8eHNsOmlmIHRlc3Q9IiRwYXJhbS9AdHlwZSI+CiAgICA8ZGl2IGNsYXNzPSJwYXJhbSI+CiAgICAgICAgPGg0IGNsYXNzPSJ0aXRsZSI+JiN4MjdhNDsmIzE2MDs8eHNsOmNvcHktb2Ygc2VsZWN0PSIkdGl0bGUiIC8+PC9oND4KCiAgICAgICAgPHRhYmxlIGNsYXNzPSJwYXJhbS1saXN0Ij4KICAgICAgICAgICAgPHRoZWFkPgogICAgICAgICAgICAgICAgPHRyPgogICAgICAgICAgICAgICAgICAgIDx0aD48eHNsOmNvcHktb2Ygc2VsZWN0PSIkbG9jYWxlLXZhciIgLz48L3RoPgogICAgICAgICAgICAgICAgICAgIDx0aD48eHNsOmNvcHktb2Ygc2VsZWN0PSIkbG9jYWxlLXR5cGUiIC8+PC90aD4KICAgICAgICAgICAgICAgICAgICA8dGg+PHhzbDpjb3B5LW9mIHNlbGVjdD0iJGxvY2FsZS12YWx1ZSIgLz48L3RoPgogICAgICAgICAgICAgICAgICAgIDx0aD48eHNsOmNvcHktb2Ygc2VsZWN0PSIkbG9jYWxlLWRlc2NyaXB0aW9uIiAvPjwvdGg+CiAgICAgICAgICAgICAgICA8L3RyPgogICAgICAgICAgICA8L3RoZWFkPgogICAgICAgICAgICA8dGJvZHk+CiAgICAgICAgICAgICAgICA8eHNsOmNob29zZT4KICAgICAgICAgICAgICAgICAgICA8eHNsOndoZW4gdGVzdD0iJHNpbXBsZT0ndHJ1ZSciPgogICAgICAgICAgICAgICAgICAgICAgICA8eHNsOmNhbGwtdGVtcGxhdGUgbmFtZT0ic2ltcGxlLXBhcmFtLWxpc3QiPgogICAgICAgICAgICAgICAgICAgICAgICAgICAgPHhzbDp3aXRoLXBhcmFtIG5hbWU9InBhcmFtIiBzZWxlY3Q9IiRwYXJhbSIgLz4KICAgICAgICAgICAgICAgICAgICAgICAgPC94c2w6Y2FsbC10ZW1wbGF0ZT4KICAgICAgICAgICAgICAgICAgICA8L3hzbDp3aGVuPgogICAgICAgICAgICAgICAgICAgIDx4c2w6b3RoZXJ3aXNlPgogICAgICAgICAgICAgICAgICAgICAgICA8eHNsOmNhbGwtdGVtcGxhdGUgbmFtZT0icGFyYW0tbGlzdCI+CiAgICAgICAgICAgICAgICAgICAgICAgICAgICA8eHNsOndpdGgtcGFyYW0gbmFtZT0icGFyYW0iIHNlbGVjdD0iJHBhcmFtIiAvPgogICAgICAgICAgICAgICAgICAgICAgICA8L3hzbDpjYWxsLXRlbXBsYXRlPgogICAgICAgICAgICAgICAgICAgIDwveHNsOm90aGVyd2lzZT4KICAgICAgICAgICAgICAgIDwveHNsOmNob29zZT4KICAgICAgICAgICAgPC90Ym9keT4KICAgICAgICA8L3RhYmxlPgogICAgPC9kaXY+CjwveHNsOmlmPgo8L3hzbDp0ZW1wbGF0ZT4KCgo8IS0tIOWIl+mhuuW6j+W/hemhu+imgeS4jiBwYXJhbSDkuK3nmoTnm7jlkIwgLS0+Cjx4c2w6dGVtcGxhdGUgbmFtZT0ic2ltcGxlLXBhcmFtLWxpc3QiPgo8eHNsOnBhcmFtIG5hbWU9InBhcmFtIiAvPgoKPHhzbDpmb3ItZWFjaCBzZWxlY3Q9IiRwYXJhbSI+CiAgICA8eHNsOmNhbGwtdGVtcGxhdGUgbmFtZT0icGFyYW0tbGlzdC10ciI+CiAgICAgICAgPHhzbDp3aXRoLXBhcmFtIG5hbWU9InBhcmFtIiBzZWxlY3Q9Ii4iIC8+CiAgICA8L3hzbDpjYWxsLXRlbXBsYXRlPgo8L3hzbDpmb3ItZWFjaD4KPC94c2w6dGVtcGxhdGU+Cgo8IS0tIOWIl+mhuuW6j+W/hemhu+imgeS4jiBwYXJhbSDkuK3nmoTnm7jlkIwgLS0+Cjx4c2w6dGVtcGxhdGUgbmFtZT0icGFyYW0tbGlzdCI+Cjx4c2w6cGFyYW0gbmFtZT0icGFyYW0iIC8+Cjx4c2w6cGFyYW0gbmFtZT0icGFyZW50IiBzZWxlY3Q9IicnIiAvPiA8IS0tIOS4iuS4gOe6p+eahOWQjeensO+8jOW1jOWll+WvueixoeaXtuWPr+eUqCAtLT4KCjx4c2w6Zm9yLWVhY2ggc2VsZWN0PSIkcGFyYW0iPgogICAgPHhzbDpjYWxsLXRlbXBsYXRlIG5hbWU9InBhcmFtLWxpc3QtdHIiPgogICAgICAgIDx4c2w6d2l0aC1wYXJhbSBuYW1lPSJwYXJhbSIgc2VsZWN0PSIuIiAvPgogICAgICAgIDx4c2w6d2l0aC1wYXJhbSBuYW1lPSJwYXJlbnQiIHNlbGVjdD0iJHBhcmVudCIgLz4KICAgIDwveHNsOmNhbGwtdGVtcGxhdGU+CgogICAgPHhzbDppZiB0ZXN0PSJwYXJhbSI+CiAgICAgICAgPHhzbDp2YXJpYWJsZSBuYW1lPSJwIj4KICAgICAgICAgICAgICAgIDx4c2w6dmFsdWUtb2Ygc2VsZWN0PSJjb25jYXQoJHBhcmVudCwgQG5hbWUpIiAvPgogICAgICAgICAgICAgICAgPHhzbDppZiB0ZXN0PSJAbmFtZSI+PHhzbDp2YWx1ZS1vZiBzZWxlY3Q9IicuJyIgLz48L3hzbDppZj4KICAgICAgICA8L3hzbDp2YXJpYWJsZT4KCiAgICAgICAgPHhzbDpjYWxsLXRlbXBsYXRlIG5hbWU9InBhcmFtLWxpc3QiPgogICAgICAgICAgICA8eHNsOndpdGgtcGFyYW0gbmFtZT0icGFyYW0iIHNlbGVjdD0icGFyYW0iIC8+CiAgICAgICAgICAgIDx4c2w6d2l0aC1wYXJhbSBuYW1lPSJwYXJlbnQiIHNlbGVjdD0iJHAiIC8+CiAgICAgICAgPC94c2w6Y2FsbC10ZW1wbGF0ZT4KICAgIDwveHNsOmlmPgo8L3hzbDpmb3ItZWFjaD4KPC94c2w6dGVtcGxhdGU+CgoKPCEtLSDmmL7npLrkuIDooYzlj4LmlbDmlbDmja4gLS0+Cjx4c2w6dGVtcGxhdGUgbmFtZT0icGFyYW0tbGlzdC10ciI+Cjx4c2w6cGFyYW0gbmFtZT0icGFyYW0iIC8+Cjx4c2w6cGFyYW0gbmFtZT0icGFyZW50IiBzZWxlY3Q9IicnIiAvPgo8dHI+CiAgICA8eHNsOmNhbGwtdGVtcGxhdGUgbmFtZT0iZGVwcmVjYXRlZCI+CiAgICAgICAgPHhzbDp3aXRoLXBhcmFtIG5hbWU9ImRlcHJlY2F0ZWQiIHNlbGVjdD0iJHBhcmFtL0BkZXByZWNhdGVkIiAvPgogICAgPC94c2w6Y2FsbC10ZW1wbGF0ZT4KICAgIDx0aD4KICAgICAgICA8c3BhbiBjbGFzcz0icGFyZW50LXR5cGUiPjx4c2w6dmFsdWUtb2Ygc2VsZWN0PSIkcGFyZW50IiAvPjwvc3Bhbj4KICAgICAgICA8eHNsOnZhbHVlLW9mIHNlbGVjdD0iJHBhcmFtL0BuYW1lIiAvPgogICAgPC90aD4KCiAgICA8dGQ+CiAgICAgICAgPHhzbDp2YWx1ZS1vZiBzZWxlY3Q9IiRwYXJhbS9AdHlwZSIgLz4KICAgICAgICA8eHNsOmlmIHRlc3Q9IiRwYXJhbS9AYXJyYXk9J3RydWUnIj48eHNsOnZhbHVlLW9mIHNlbGVjdD0iJ1tdJyIgLz48L3hzbDppZj4KICAgIDwvdGQ+CgogICAgPHRkPgogICAgICAgIDx4c2w6Y2hvb3NlPgogICAgICAgICAgICA8eHNsOndoZW4gdGVzdD0iJHBhcmFtL0BvcHRpb25hbD0ndHJ1ZSciPjx4c2w6dmFsdWUtb2Ygc2VsZWN0PSInTyciIC8+PC94c2w6d2hlbj4KICAgICAgICAgICAgPHhzbDpvdGhlcndpc2U+PHhzbDp2YWx1ZS1vZiBzZWxlY3Q9IidSJyIgLz48L3hzbDpvdGhlcndpc2U+CiAgICAgICAgPC94c2w6Y2hvb3NlPgogICAgICAgIDx4c2w6dmFsdWUtb2Ygc2VsZWN0PSJjb25jYXQoJyAnLCAkcGFyYW0vQGRlZmF1bHQpIiAvPgogICAgPC90ZD4KCiAgICA8dGQ+CiAgICAgICAgPHhzbDpjaG9vc2U+CiAgICAgICAgICAgIDx4c2w6d2hlbiB0ZXN0PSJkZXNjcmlwdGlvbiI+CiAgICAgICAgICAgICAgICA8eHNsOmF0dHJpYnV0ZSBuYW1lPSJkYXRhLXR5cGUiPgogICAgICAgICAgICAgICAgICAgIDx4c2w6dmFsdWUtb2Ygc2VsZWN0PSJkZXNjcmlwdGlvbi9AdHlwZSIgLz4KICAgICAgICAgICAgICAgIDwveHNsOmF0dHJpYnV0ZT4KICAgICAgICAgICAgICAgIDxwcmU+PHhzbDpjb3B5LW9mIHNlbGVjdD0iZGVzY3JpcHRpb24vbm9kZSgpIiAvPjwvcHJlPgogICAgICAgICAgICA8L3hzbDp3aGVuPgogICAgICAgICAgICA8eHNsOm90aGVyd2lzZT48eHNsOnZhbHVlLW9mIHNlbGVjdD0iQHN1bW1hcnkiIC8+PC94c2w6b3RoZXJ3aXNlPgogICAgICAgIDwveHNsOmNob29zZT4KICAgICAgICA8eHNsOmNhbGwtdGVtcGxhdGUgbmFtZT0iZW51bSI+CiAgICAgICAgICAgIDx4c2w6d2l0aC1wYXJhbSBuYW1lPSJlbnVtIiBzZWxlY3Q9IiRwYXJhbS9lbnVtIi8+CiAgICAgICAgPC94c2w6Y2FsbC10ZW1wbGF0ZT4KICAgIDwvdGQ+CjwvdHI+CjwveHNsOnRlbXBsYXRlPgoKCjwhLS0g5pi+56S65p6a5Li+57G75Z6L55qE5YaF5a65IC0tPgo8eHNsOnRlbXBsYXRlIG5hbWU9ImVudW0iPgo8eHNsOnBhcmFtIG5hbWU9ImVudW0iIC8+Cjx4c2w6aWYgdGVzdD0iJGVudW0iPgogICAgPHA+PHhzbDpjb3B5LW9mIHNlbGVjdD0iJGxvY2FsZS1lbnVtIiAvPjwvcD4KICAgIDx1bD4KICAgIDx4c2w6Zm9yLWVhY2ggc2VsZWN0PSIkZW51bSI+CiAgICAgICAgPGxpPgogICAgICAgIDx4c2w6Y2FsbC10ZW1wbGF0ZSBuYW1lPSJkZXByZWNhdGVkIj4KICAgICAgICAgICAgPHhzbDp3aXRoLXBhcmFtIG5hbWU9ImRlcHJlY2F0ZWQiIHNlbGVjdD0iQGRlcHJlY2F0ZWQiIC8+CiAgICAgICAgPC94c2w6Y2FsbC10ZW1wbGF0ZT4KCiAgICAgICAgPHhzbDp2YWx1ZS1vZiBzZWxlY3Q9IkB2YWx1ZSIgLz46CiAgICAgICAgPHhzbDpjaG9vc2U+CiAgICAgICAgICAgIDx4c2w6d2hlbiB0ZXN0PSJkZXNjcmlwdGlvbiI+CiAgICAgICAgICAgICAgICA8ZGl2IGRhdGEtdHlwZT0ie2Rlc2NyaXB0aW9uL0B0eXBlfSI+CiAgICAgICAgICAgICAgICAgICAgPHByZT48eHNsOmNvcHktb2Ygc2VsZWN0PSJkZXNjcmlwdGlvbi9ub2RlKCkiIC8+PC9wcmU+CiAgICAgICAgICAgICAgICA8L2Rpdj4KICAgICAgICAgICAgPC94c2w6d2hlbj4KICAgICAgICAgICAgPHhzbDpvdGhlcndpc2U+PHhzbDp2YWx1ZS1vZiBzZWxlY3Q9InN1bW1hcnkiIC8+PC94c2w6b3RoZXJ3aXNlPgogICAgICAgIDwveHNsOmNob29zZT4KICAgICAgICA8L2xpPgogICAgPC94c2w6Zm9yLWVhY2g+CiAgICA8L3VsPgo8L3hzbDppZj4KPC94c2w6dGVtcGxhdGU+CgoKPCEtLQrnu5nmjIflrprnmoTlhYPntKDmt7vliqDlt7LlvIPnlKjnmoTmoIforrAKCuivpeaooeadv+S8mue7meeItuWFg+e0oOa3u+WKoCBjbGFzcyDlkowgdGl0bGUg5bGe5oCn77yMCuaJgOS7peW/hemhu+imgeWcqOeItuWFg+e0oOeahOS7u+S9leWtkOWFg+e0oOS5i+WJje+8jOWQpuWImSBjaHJvbWUg5ZKMIHNhZmFyaSDlj6/og73ml6Dms5XmraPluLjop6PmnpDjgIIKLS0+Cjx4c2w6dGVtcGxhdGUgbmFtZT0iZGVwcmVjYXRlZCI+Cjx4c2w6cGFyYW0gbmFtZT0iZGVwcmVjYXRlZCIgLz4KPHhzbDppZiB0ZXN0PSIkZGVwcmVjYXRlZCI+CiAgICA8eHNsOmF0dHJpYnV0ZSBuYW1lPSJjbGFzcyI+PHhzbDp2YWx1ZS1vZiBzZWxlY3Q9IidkZWwnIiAvPjwveHNsOmF0dHJpYnV0ZT4KICAgIDx4c2w6YXR0cmlidXRlIG5hbWU9InRpdGxlIj4KICAgICAgICA8eHNsOnZhbHVlLW9mIHNlbGVjdD0iJGRlcHJlY2F0ZWQiIC8+CiAgICA8L3hzbDphdHRyaWJ1dGU+CjwveHNsOmlmPgo8L3hzbDp0ZW1wbGF0ZT4KCjwhLS0g55So5LqO5bCGIEFQSSDlnLDlnYDovazmjaLmiJDlkIjms5XnmoQgSUQg5qCH6K6wIC0tPgo8eHNsOnZhcmlhYmxlIG5hbWU9ImlkLWZyb20iIHNlbGVjdD0iJ3t9LyciIC8+Cjx4c2w6dmFyaWFibGUgbmFtZT0iaWQtdG8iIHNlbGVjdD0iJ19fLSciIC8+Cgo8IS0tIOagueaNruaDheWGteiOt+WPluebuOW6lOeahOWbvuaghyAtLT4KPHhzbDp2YXJpYWJsZSBuYW1lPSJpY29uIj4KICAgIDx4c2w6Y2hvb3NlPgogICAgICAgIDx4c2w6d2hlbiB0ZXN0PSIvYXBpZG9jL0Bsb2dvIj4KICAgICAgICAgICAgPHhzbDp2YWx1ZS1vZiBzZWxlY3Q9Ii9hcGlkb2MvQGxvZ28iIC8+CiAgICAgICAgPC94c2w6d2hlbj4KICAgICAgICA8eHNsOm90aGVyd2lzZT4KICAgICAgICAgICAgPHhzbDp2YWx1ZS1vZiBzZWxlY3Q9ImNvbmNhdCgkYmFzZS11cmwsICcuLi9pY29uLnN2ZycpIiAvPgogICAgICAgIDwveHNsOm90aGVyd2lzZT4KICAgIDwveHNsOmNob29zZT4KPC94c2w6dmFyaWFibGU+Cgo8IS0tCuiOt+WPluebuOWvueS6juW9k+WJjSB4c2wg5paH5Lu255qE5Z+65Zyw5Z2ACnhzbCAyLjAg5Y+v5Lul55u05o6l6YeH55SoIGJhc2UtdXJpKGRvY3VtZW50KCcnKSkKLS0+Cjx4c2w6dmFyaWFibGUgbmFtZT0iYmFzZS11cmwiPgogICAgPHhzbDphcHBseS10ZW1wbGF0ZXMgc2VsZWN0PSJwcm9jZXNzaW5nLWluc3RydWN0aW9uKCd4bWwtc3R5bGVzaGVldCcpIiAvPgo8L3hzbDp2YXJpYWJsZT4KCjx4c2w6dGVtcGxhdGUgbWF0Y2g9InByb2Nlc3NpbmctaW5zdHJ1Y3Rpb24oJ3htbC1zdHlsZXNoZWV0JylbMV0iPgogICAgPHhzbDp2YXJpYWJsZSBuYW1lPSJ2MSIgc2VsZWN0PSJzdWJzdHJpbmctYWZ0ZXIoLiwgJ2hyZWY9JnF1b3Q7JykiIC8+CiAgICA8IS0tIE5PVEU6IOatpOWkhOWBh+WumuW9k+WJjeaWh+S7tuWPq+S9nCBhcGlkb2MueHNs77yM5aaC5p6c5LiN5piv55qE6K+d77yM6ZyA6KaB5Y+m5aSW5aSE55CG5q2k5Luj56CBIC0tPgogICAgPHhzbDp2YXJpYWJsZSBuYW1lPSJ2MiIgc2VsZWN0PSJzdWJzdHJpbmctYmVmb3JlKCR2MSwgJ2FwaWRvYy54c2wmcXVvdDsnKSIgLz4KICAgIDx4c2w6dmFsdWUtb2Ygc2VsZWN0PSIkdjIiIC8+CjwveHNsOnRlbXBsYXRlPgoKPC94c2w6c3R5bGVzaGVldD4KAAEOdjYvbG9jYWxlcy54c2wBF3RleHQveHNsOyBjaGFyc2V0PXV0Zi04Af40Jjw/eG1sIHZlcnNpb249IjEuMCIgZW5jb2Rpbmc9IlVURi04Ij8+Cgo8IS0tIOW9k+WJjeaWh+S7tuWunueOsOS6hueugOWNleeahOe/u+ivkeWKn+iDvSAtLT4KCjx4c2w6c3R5bGVzaGVldAp2ZXJzaW9uPSIxLjAiCnhtbG5zOnhzbD0iaHR0cDovL3d3dy53My5vcmcvMTk5OS9YU0wvVHJhbnNmb3JtIgp4bWxuczpsPSJ1cm46bG9jYWxlIgpleGNsdWRlLXJlc3VsdC1wcmVmaXhlcz0ibCI+Cgo8IS0tIOW9k+WJjeaUr+aMgeeahOacrOWcsOWMluWIl+ihqO+8jOWFtuS4reesrOS4gOS4quS8muiiq+W9k+S9nOm7mOiupOWAvOOAgiAtLT4KPCEtLSBOT1RFOiBpZCDkuIDlvovnlKjlsI/lhpnvvIzkuYvlkI7pnIDopoHnlKjliLDmr5TovoPlrZfnrKbkuLLvvIEgLS0+CjxsOmxvY2FsZXM+CiAgICA8bG9jYWxlIGlkPSJjbW4taGFucyI+566A5L2T5Lit5paHPC9sb2NhbGU+CiAgICA8bG9jYWxlIGlkPSJjbW4taGFudCI+57mB6auU5Lit5paHPC9sb2NhbGU+CjwvbDpsb2NhbGVzPgoKPHhzbDp0ZW1wbGF0ZSBuYW1lPSJsYW5ndWFnZXMiPgogICAgPHhzbDpmb3ItZWFjaCBzZWxlY3Q9ImRvY3VtZW50KCcnKS94c2w6c3R5bGVzaGVldC9sOmxvY2FsZXMvbG9jYWxlIj4KICAgIDxsaSBsYW5nPSJ7QGlkfSIgcm9sZT0ibWVudWl0ZW1yYWRpbyI+CiAgICAgICAgPGxhYmVsPjxpbnB1dCB0eXBlPSJyYWRpbyIgbmFtZT0ibGFuZyIgY2hlY2tlZD0ieyRjdXJyLWxhbmc9QGlkfSIgLz4mIzE2MDs8eHNsOnZhbHVlLW9mIHNlbGVjdD0iLiIgLz48L2xhYmVsPgogICAgPC9saT4KICAgIDwveHNsOmZvci1lYWNoPgo8L3hzbDp0ZW1wbGF0ZT4KCjwhLS0gbGFuZ3VhZ2UgLS0+Cjx4c2w6dmFyaWFibGUgbmFtZT0ibG9jYWxlLWxhbmd1YWdlIj4KICAgIDx4c2w6Y2FsbC10ZW1wbGF0ZSBuYW1lPSJidWlsZC1sb2NhbGUiPgogICAgICAgIDx4c2w6d2l0aC1wYXJhbSBuYW1lPSJsYW5nIiBzZWxlY3Q9IidjbW4taGFucyciIC8+CiAgICAgICAgPHhzbDp3aXRoLXBhcmFtIG5hbWU9InRleHQiIHNlbGVjdD0iZG9jdW1lbnQoJycpL3hzbDpzdHlsZXNoZWV0L2w6bG9jYWxlcy9sb2NhbGVbQGlkPSdjbW4taGFucyddIiAvPgogICAgPC94c2w6Y2FsbC10ZW1wbGF0ZT4KCiAgICA8eHNsOmNhbGwtdGVtcGxhdGUgbmFtZT0iYnVpbGQtbG9jYWxlIj4KICAgICAgICA8eHNsOndpdGgtcGFyYW0gbmFtZT0ibGFuZyIgc2VsZWN0PSInY21uLWhhbnQnIiAvPgogICAgICAgIDx4c2w6d2l0aC1wYXJhbSBuYW1lPSJ0ZXh0IiBzZWxlY3Q9ImRvY3VtZW50KCcnKS94c2w6c3R5bGVzaGVldC9sOmxvY2FsZXMvbG9jYWxlW0BpZD0nY21uLWhhbnQnXSIgLz4KICAgIDwveHNsOmNhbGwtdGVtcGxhdGU+CjwveHNsOnZhcmlhYmxlPgoKPCEtLSBzZXJ2ZXIgLS0+Cjx4c2w6dmFyaWFibGUgbmFtZT0ibG9jYWxlLXNlcnZlciI+CiAgICA8eHNsOmNhbGwtdGVtcGxhdGUgbmFtZT0iYnVpbGQtbG9jYWxlIj4KICAgICAgICA8eHNsOndpdGgtcGFyYW0gbmFtZT0ibGFuZyIgc2VsZWN0PSInY21uLWhhbnMnIiAvPgogICAgICAgIDx4c2w6d2l0aC1wYXJhbSBuYW1lPSJ0ZXh0IiBzZWxlY3Q9IifmnI3liqEnIiAvPgogICAgPC94c2w6Y2FsbC10ZW1wbGF0ZT4KCiAgICA8eHNsOmNhbGwtdGVtcGxhdGUgbmFtZT0iYnVpbGQtbG9jYWxlIj4KICAgICAgICA8eHNsOndpdGgtcGFyYW0gbmFtZT0ibGFuZyIgc2VsZWN0PSInY21uLWhhbnQnIiAvPgogICAgICAgIDx4c2w6d2l0aC1wYXJhbSBuYW1lPSJ0ZXh0IiBzZWxlY3Q9IifmnI3li5knIiAvPgogICAgPC94c2w6Y2FsbC10ZW1wbGF0ZT4KPC94c2w6dmFyaWFibGU+Cgo8IS0tIHRhZyAtLT4KPHhzbDp2YXJpYWJsZSBuYW1lPSJsb2NhbGUtdGFnIj4KICAgIDx4c2w6Y2FsbC10ZW1wbGF0ZSBuYW1lPSJidWlsZC1sb2NhbGUiPgogICAgICAgIDx4c2w6d2l0aC1wYXJhbSBuYW1lPSJsYW5nIiBzZWxlY3Q9IidjbW4taGFucyciIC8+CiAgICAgICAgPHhzbDp3aXRoLXBhcmFtIG5hbWU9InRleHQiIHNlbGVjdD0iJ+agh+etviciIC8+CiAgICA8L3hzbDpjYWxsLXRlbXBsYXRlPgoKICAgIDx4c2w6Y2FsbC10ZW1wbGF0ZSBuYW1lPSJidWlsZC1sb2NhbGUiPgogICAgICAgIDx4c2w6d2l0aC1wYXJhbSBuYW1lPSJsYW5nIiBzZWxlY3Q9IidjbW4taGFudCciIC8+CiAgICAgICAgPHhzbDp3aXRoLXBhcmFtIG5hbWU9InRleHQiIHNlbGVjdD0iJ+aomeewvSciIC8+CiAgICA8L3hzbDpjYWxsLXRlbXBsYXRlPgo8L3hzbDp2YXJpYWJsZT4KCjwhLS0gb3RoZXIgLS0+Cjx4c2w6dmFyaWFibGUgbmFtZT0ibG9jYWxlLXVuY2F0ZWdvcml6ZWQiPgogICAgPHhzbDpjYWxsLXRlbXBsYXRlIG5hbWU9ImJ1aWxkLWxvY2FsZSI+CiAgICAgICAgPHhzbDp3aXRoLXBhcmFtIG5hbWU9ImxhbmciIHNlbGVjdD0iJ2Ntbi1oYW5zJyIgLz4KICAgICAgICA8eHNsOndpdGgtcGFyYW0gbmFtZT0idGV4dCIgc2VsZWN0PSIn5pyq5YiG57G7JyIgLz4KICAgIDwveHNsOmNhbGwtdGVtcGxhdGU+CgogICAgPHhzbDpjYWxsLXRlbXBsYXRlIG5hbWU9ImJ1aWxkLWxvY2FsZSI+CiAgICAgICAgPHhzbDp3aXRoLXBhcmFtIG5hbWU9ImxhbmciIHNlbGVjdD0iJ2Ntbi1oYW50JyIgLz4KICAgICAgICA8eHNsOndpdGgtcGFyYW0gbmFtZT0idGV4dCIgc2VsZWN0PSIn5pyq5YiG6aGeJyIgLz4KICAgIDwveHNsOmNhbGwtdGVtcGxhdGU+CjwveHNsOnZhcmlhYmxlPgoKPCEtLSBleHBhbmQgLS0+Cjx4c2w6dmFyaWFibGUgbmFtZT0ibG9jYWxlLWV4cGFuZCI+CiAgICA8eHNsOmNhbGwtdGVtcGxhdGUgbmFtZT0iYnVpbGQtbG9jYWxlIj4KICAgICAgICA8eHNsOndpdGgtcGFyYW0gbmFtZT0ibGFuZyIgc2VsZWN0PSInY21uLWhhbnMnIiAvPgogICAgICAgIDx4c2w6d2l0aC1wYXJhbSBuYW1lPSJ0ZXh0IiBzZWxlY3Q9IiflsZXlvIAnIiAvPgogICAgPC94c2w6Y2FsbC10ZW1wbGF0ZT4KCiAgICA8eHNsOmNhbGwtdGVtcGxhdGUgbmFtZT0iYnVpbGQtbG9jYWxlIj4KICAgICAgICA8eHNsOndpdGgtcGFyYW0gbmFtZT0ibGFuZyIgc2VsZWN0PSInY21uLWhhbnQnIiAvPgogICAgICAgIDx4c2w6d2l0aC1wYXJhbSBuYW1lPSJ0ZXh0IiBzZWxlY3Q9IiflsZXplosnIiAvPgogICAgPC94c2w6Y2FsbC10ZW1wbGF0ZT4KPC94c2w6dmFyaWFibGU+Cgo8IS0tIG1ldGhvZCAtLT4KPHhzbDp2YXJpYWJsZSBuYW1lPSJsb2NhbGUtbWV0aG9kIj4KICAgIDx4c2w6Y2FsbC10ZW1wbGF0ZSBuYW1lPSJidWlsZC1sb2NhbGUiPgogICAgICAgIDx4c2w6d2l0aC1wYXJhbSBuYW1lPSJsYW5nIiBzZWxlY3Q9IidjbW4taGFucyciIC8+CiAgICAgICAgPHhzbDp3aXRoLXBhcmFtIG5hbWU9InRleHQiIHNlbGVjdD0iJ+ivt+axguaWueazlSciIC8+CiAgICA8L3hzbDpjYWxsLXRlbXBsYXRlPgoKICAgIDx4c2w6Y2FsbC10ZW1wbGF0ZSBuYW1lPSJidWlsZC1sb2NhbGUiPgogICAgICAgIDx4c2w6d2l0aC1wYXJhbSBuYW1lPSJsYW5nIiBzZWxlY3Q9IidjbW4taGFudCciIC8+CiAgICAgICAgPHhzbDp3aXRoLXBhcmFtIG5hbWU9InRleHQiIHNlbGVjdD0iJ+iri+axguaWueazlSciIC8+CiAgICA8L3hzbDpjYWxsLXRlbXBsYXRlPgo8L3hzbDp2YXJpYWJsZT4KCjwhLS0gcmVxdWVzdCAtLT4KPHhzbDp2YXJpYWJsZSBuYW1lPSJsb2NhbGUtcmVxdWVzdCI+CiAgICA8eHNsOmNhbGwtdGVtcGxhdGUgbmFtZT0iYnVpbGQtbG9jYWxlIj4KICAgICAgICA8eHNsOndpdGgtcGFyYW0gbmFtZT0ibGFuZyIgc2VsZWN0PSInY21uLWhhbnMnIiAvPgogICAgICAgIDx4c2w6d2l0aC1wYXJhbSBuYW1lPSJ0ZXh0IiBzZWxlY3Q9Iifor7fmsYInIiAvPgogICAgPC94c2w6Y2FsbC10ZW1wbGF0ZT4KCiAgICA8eHNsOmNhbGwtdGVtcGxhdGUgbmFtZT0iYnVpbGQtbG9jYWxlIj4KICAgICAgICA8eHNsOndpdGgtcGFyYW0gbmFtZT0ibGFuZyIgc2VsZWN0PSInY21uLWhhbnQnIiAvPgogICAgICAgIDx4c2w6d2l0aC1wYXJhbSBuYW1lPSJ0ZXh0IiBzZWxlY3Q9Iifoq4vmsYInIiAvPgogICAgPC94c2w6Y2FsbC10ZW1wbGF0ZT4KPC94c2w6dmFyaWFibGU+Cgo8IS0tIHJlc3BvbnNlIC0tPgo8eHNsOnZhcmlhYmxlIG5hbWU9ImxvY2FsZS1yZXNwb25zZSI+CiAgICA8eHNsOmNhbGwtdGVtcGxhdGUgbmFtZT0iYnVpbGQtbG9jYWxlIj4KICAgICAgICA8eHNsOndpdGgtcGFyYW0gbmFtZT0ibGFuZyIgc2VsZWN0PSInY21uLWhhbnMnIiAvPgogICAgICAgIDx4c2w6d2l0aC1wYXJhbSBuYW1lPSJ0ZXh0IiBzZWxlY3Q9Iifov5Tlm54nIiAvPgogICAgPC94c2w6Y2FsbC10ZW1wbGF0ZT4KCiAgICA8eHNsOmNhbGwtdGVtcGxhdGUgbmFtZT0iYnVpbGQtbG9jYWxlIj4KICAgICAgICA8eHNsOndpdGgtcGFyYW0gbmFtZT0ibGFuZyIgc2VsZWN0PSInY21uLWhhbnQnIiAvPgogICAgICAgIDx4c2w6d2l0aC1wYXJhbSBuYW1lPSJ0ZXh0IiBzZWxlY3Q9Iifov5Tlm54nIiAvPgogICAgPC94c2w6Y2FsbC10ZW1wbGF0ZT4KPC94c2w6dmFyaWFibGU+Cgo8IS0tIGNhbGxiYWNrIC0tPgo8eHNsOnZhcmlhYmxlIG5hbWU9ImxvY2FsZS1jYWxsYmFjayI+CiAgICA8eHNsOmNhbGwtdGVtcGxhdGUgbmFtZT0iYnVpbGQtbG9jYWxlIj4KICAgICAgICA8eHNsOndpdGgtcGFyYW0gbmFtZT0ibGFuZyIgc2VsZWN0PSInY21uLWhhbnMnIiAvPgogICAgICAgIDx4c2w6d2l0aC1wYXJhbSBuYW1lPSJ0ZXh0IiBzZWxlY3Q9Iiflm57osIMnIiAvPgogICAgPC94c2w6Y2FsbC10ZW1wbGF0ZT4KCiAgICA8eHNsOmNhbGwtdGVtcGxhdGUgbmFtZT0iYnVpbGQtbG9jYWxlIj4KICAgICAgICA8eHNsOndpdGgtcGFyYW0gbmFtZT0ibGFuZyIgc2VsZWN0PSInY21uLWhhbnQnIiAvPgogICAgICAgIDx4c2w6d2l0aC1wYXJhbSBuYW1lPSJ0ZXh0IiBzZWxlY3Q9Iiflm57oqr8nIiAvPgogICAgPC94c2w6Y2FsbC10ZW1wbGF0ZT4KPC94c2w6dmFyaWFibGU+Cgo8IS0tIHBhdGggcGFyYW0gLS0+Cjx4c2w6dmFyaWFibGUgbmFtZT0ibG9jYWxlLXBhdGgtcGFyYW0iPgogICAgPHhzbDpjYWxsLXRlbXBsYXRlIG5hbWU9ImJ1aWxkLWxvY2FsZSI+CiAgICAgICAgPHhzbDp3aXRoLXBhcmFtIG5hbWU9ImxhbmciIHNlbGVjdD0iJ2Ntbi1oYW5zJyIgLz4KICAgICAgICA8eHNsOndpdGgtcGFyYW0gbmFtZT0idGV4dCIgc2VsZWN0PSIn6Lev5b6E5Y+C5pWwJyIgLz4KICAgIDwveHNsOmNhbGwtdGVtcGxhdGU+CgogICAgPHhzbDpjYWxsLXRlbXBsYXRlIG5hbWU9ImJ1aWxkLWxvY2FsZSI+CiAgICAgICAgPHhzbDp3aXRoLXBhcmFtIG5hbWU9ImxhbmciIHNlbGVjdD0iJ2Ntbi1oYW50JyIgLz4KICAgICAgICA8eHNsOndpdGgtcGFyYW0gbmFtZT0idGV4dCIgc2VsZWN0PSIn6Lev5b6R5Y+D5pW4JyIgLz4KICAgIDwveHNsOmNhbGwtdGVtcGxhdGU+CjwveHNsOnZhcmlhYmxlPgoKPCEtLSBxdWVyeSAtLT4KPHhzbDp2YXJpYWJsZSBuYW1lPSJsb2NhbGUtcXVlcnkiPgogICAgPHhzbDpjYWxsLXRlbXBsYXRlIG5hbWU9ImJ1aWxkLWxvY2FsZSI+CiAgICAgICAgPHhzbDp3aXRoLXBhcmFtIG5hbWU9ImxhbmciIHNlbGVjdD0iJ2Ntbi1oYW5zJyIgLz4KICAgICAgICA8eHNsOndpdGgtcGFyYW0gbmFtZT0idGV4dCIgc2VsZWN0PSIn5p+l6K+i5Y+C5pWwJyIgLz4KICAgIDwveHNsOmNhbGwtdGVtcGxhdGU+CgogICAgPHhzbDpjYWxsLXRlbXBsYXRlIG5hbWU9ImJ1aWxkLWxvY2FsZSI+CiAgICAgICAgPHhzbDp3aXRoLXBhcmFtIG5hbWU9ImxhbmciIHNlbGVjdD0iJ2Ntbi1oYW50JyIgLz4KICAgICAgICA8eHNsOndpdGgtcGFyYW0gbmFtZT0idGV4dCIgc2VsZWN0PSIn5p+l6Kmi5Y+D5pW4JyIgLz4KICAgIDwveHNsOmNhbGwtdGVtcGxhdGU+CjwveHNsOnZhcmlhYmxlPgoKPCEtLSBoZWFkZXIgLS0+Cjx4c2w6dmFyaWFibGUgbmFtZT0ibG9jYWxlLWhlYWRlciI+CiAgICA8eHNsOmNhbGwtdGVtcGxhdGUgbmFtZT0iYnVpbGQtbG9jYWxlIj4KICAgICAgICA8eHNsOndpdGgtcGFyYW0gbmFtZT0ibGFuZyIgc2VsZWN0PSInY21uLWhhbnMnIiAvPgogICAgICAgIDx4c2w6d2l0aC1wYXJhbSBuYW1lPSJ0ZXh0IiBzZWxlY3Q9IifmiqXlpLQnIiAvPgogICAgPC94c2w6Y2FsbC10ZW1wbGF0ZT4KCiAgICA8eHNsOmNhbGwtdGVtcGxhdGUgbmFtZT0iYnVpbGQtbG9jYWxlIj4KICAgICAgICA8eHNsOndpdGgtcGFyYW0gbmFtZT0ibGFuZyIgc2VsZWN0PSInY21uLWhhbnQnIiAvPgogICAgICAgIDx4c2w6d2l0aC1wYXJhbSBuYW1lPSJ0ZXh0IiBzZWxlY3Q9IifloLHpoK0nIiAvPgogICAgPC94c2w6Y2FsbC10ZW1wbGF0ZT4KPC94c2w6dmFyaWFibGU+Cgo8IS0tIGNvb2tpZSAtLT4KPHhzbDp2YXJpYWJsZSBuYW1lPSJsb2NhbGUtY29va2llIj4KICAgIDx4c2w6Y2FsbC10ZW1wbGF0ZSBuYW1lPSJidWlsZC1sb2NhbGUiPgogICAgICAgIDx4c2w6d2l0aC1wYXJhbSBuYW1lPSJsYW5nIiBzZWxlY3Q9IidjbW4taGFucyciIC8+CiAgICAgICAgPHhzbDp3aXRoLXBhcmFtIG5hbWU9InRleHQiIHNlbGVjdD0iJ0Nvb2tpZSciIC8+CiAgICA8L3hzbDpjYWxsLXRlbXBsYXRlPgoKICAgIDx4c2w6Y2FsbC10ZW1wbGF0ZSBuYW1lPSJidWlsZC1sb2NhbGUiPgogICAgICAgIDx4c2w6d2l0aC1wYXJhbSBuYW1lPSJsYW5nIiBzZWxlY3Q9IidjbW4taGFudCciIC8+CiAgICAgICAgPHhzbDp3aXRoLXBhcmFtIG5hbWU9InRleHQiIHNlbGVjdD0iJ0Nvb2tpZSciIC8+CiAgICA8L3hzbDpjYWxsLXRlbXBsYXRlPgo8L3hzbDp2YXJpYWJsZT4KCjwhLS0gYm9keSAtLT4KPHhzbDp2YXJpYWJsZSBuYW1lPSJsb2NhbGUtYm9keSI+CiAgICA8eHNsOmNhbGwtdGVtcGxhdGUgbmFtZT0iYnVpbGQtbG9jYWxlIj4KICAgICAgICA8eHNsOndpdGgtcGFyYW0gbmFtZT0ibGFuZyIgc2VsZWN0PSInY21uLWhhbnMnIiAvPgogICAgICAgIDx4c2w6d2l0aC1wYXJhbSBuYW1lPSJ0ZXh0IiBzZWxlY3Q9IifmiqXmlocnIiAvPgogICAgPC94c2w6Y2FsbC10ZW1wbGF0ZT4KCiAgICA8eHNsOmNhbGwtdGVtcGxhdGUgbmFtZT0iYnVpbGQtbG9jYWxlIj4KICAgICAgICA8eHNsOndpdGgtcGFyYW0gbmFtZT0ibGFuZyIgc2VsZWN0PSInY21uLWhhbnQnIiAvPgogICAgICAgIDx4c2w6d2l0aC1wYXJhbSBuYW1lPSJ0ZXh0IiBzZWxlY3Q9IifloLHmlocnIiAvPgogICAgPC94c2w6Y2FsbC10ZW1wbGF0ZT4KPC94c2w6dmFyaWFibGU+Cgo8IS0tIGV4YW1wbGUgLS0+Cjx4c2w6dmFyaWFibGUgbmFtZT0ibG9jYWxlLWV4YW1wbGUiPgogICAgPHhzbDpjYWxsLXRlbXBsYXRlIG5hbWU9ImJ1aWxkLWxvY2FsZSI+CiAgICAgICAgPHhzbDp3aXRoLXBhcmFtIG5hbWU9ImxhbmciIHNlbGVjdD0iJ2Ntbi1oYW5zJyIgLz4KICAgICAgICA8eHNsOndpdGgtcGFyYW0gbmFtZT0idGV4dCIgc2VsZWN0PSIn56S65L6L5Luj56CBJyIgLz4KICAgIDwveHNsOmNhbGwtdGVtcGxhdGU+CgogICAgPHhzbDpjYWxsLXRlbXBsYXRlIG5hbWU9ImJ1aWxkLWxvY2FsZSI+CiAgICAgICAgPHhzbDp3aXRoLXBhcmFtIG5hbWU9ImxhbmciIHNlbGVjdD0iJ2Ntbi1oYW50JyIgLz4KICAgICAgICA8eHNsOndpdGgtcGFyYW0gbmFtZT0idGV4dCIgc2VsZWN0PSIn56S65L6L5Luj56K8JyIgLz4KICAgIDwveHNsOmNhbGwtdGVtcGxhdGU+CjwveHNsOnZhcmlhYmxlPgoKPCEtLSB2YXIgLS0+Cjx4c2w6dmFyaWFibGUgbmFtZT0ibG9jYWxlLXZhciI+CiAgICA8eHNsOmNhbGwtdGVtcGxhdGUgbmFtZT0iYnVpbGQtbG9jYWxlIj4KICAgICAgICA8eHNsOndpdGgtcGFyYW0gbmFtZT0ibGFuZyIgc2VsZWN0PSInY21uLWhhbnMnIiAvPgogICAgICAgIDx4c2w6d2l0aC1wYXJhbSBuYW1lPSJ0ZXh0IiBzZWxlY3Q9Iiflj5jph48nIiAvPgogICAgPC94c2w6Y2FsbC10ZW1wbGF0ZT4KCiAgICA8eHNsOmNhbGwtdGVtcGxhdGUgbmFtZT0iYnVpbGQtbG9jYWxlIj4KICAgICAgICA8eHNsOndpdGgtcGFyYW0gbmFtZT0ibGFuZyIgc2VsZWN0PSInY21uLWhhbnQnIiAvPgogICAgICAgIDx4c2w6d2l0aC1wYXJhbSBuYW1lPSJ0ZXh0IiBzZWxlY3Q9Iifororph48nIiAvPgogICAgPC94c2w6Y2FsbC10ZW1wbGF0ZT4KPC94c2w6dmFyaWFibGU+Cgo8IS0tIHR5cGUgLS0+Cjx4c2w6dmFyaWFibGUgbmFtZT0ibG9jYWxlLXR5cGUiPgogICAgPHhzbDpjYWxsLXRlbXBsYXRlIG5hbWU9ImJ1aWxkLWxvY2FsZSI+CiAgICAgICAgPHhzbDp3aXRoLXBhcmFtIG5hbWU9ImxhbmciIHNlbGVjdD0iJ2Ntbi1oYW5zJyIgLz4KICAgICAgICA8eHNsOndpdGgtcGFyYW0gbmFtZT0idGV4dCIgc2VsZWN0PSIn57G75Z6LJyIgLz4KICAgIDwveHNsOmNhbGwtdGVtcGxhdGU+CgogICAgPHhzbDpjYWxsLXRlbXBsYXRlIG5hbWU9ImJ1aWxkLWxvY2FsZSI+CiAgICAgICAgPHhzbDp3aXRoLXBhcmFtIG5hbWU9ImxhbmciIHNlbGVjdD0iJ2Ntbi1oYW50JyIgLz4KICAgICAgICA8eHNsOndpdGgtcGFyYW0gbmFtZT0idGV4dCIgc2VsZWN0PSIn6aGe5Z6LJyIgLz4KICAgIDwveHNsOmNhbGwtdGVtcGxhdGU+CjwveHNsOnZhcmlhYmxlPgoKPCEtLSB2YWx1ZSAtLT4KPHhzbDp2YXJpYWJsZSBuYW1lPSJsb2NhbGUtdmFsdWUiPgogICAgPHhzbDpjYWxsLXRlbXBsYXRlIG5hbWU9ImJ1aWxkLWxvY2FsZSI+CiAgICAgICAgPHhzbDp3aXRoLXBhcmFtIG5hbWU9ImxhbmciIHNlbGVjdD0iJ2Ntbi1oYW5zJyIgLz4KICAgICAgICA8eHNsOndpdGgtcGFyYW0gbmFtZT0idGV4dCIgc2VsZWN0PSIn5YC8JyIgLz4KICAgIDwveHNsOmNhbGwtdGVtcGxhdGU+CgogICAgPHhzbDpjYWxsLXRlbXBsYXRlIG5hbWU9ImJ1aWxkLWxvY2FsZSI+CiAgICAgICAgPHhzbDp3aXRoLXBhcmFtIG5hbWU9ImxhbmciIHNlbGVjdD0iJ2Ntbi1oYW50JyIgLz4KICAgICAgICA8eHNsOndpdGgtcGFyYW0gbmFtZT0idGV4dCIgc2VsZWN0PSIn5YC8JyIgLz4KICAgIDwveHNsOmNhbGwtdGVtcGxhdGU+CjwveHNsOnZhcmlhYmxlPgoKPCEtLSBnb3RvLXRvcCAtLT4KPHhzbDp2YXJpYWJsZSBuYW1lPSJsb2NhbGUtZ290by10b3AiPgogICAgPHhzbDpjYWxsLXRlbXBsYXRlIG5hbWU9ImJ1aWxkLWxvY2FsZSI+CiAgICAgICAgPHhzbDp3aXRoLXBhcmFtIG5hbWU9ImxhbmciIHNlbGVjdD0iJ2Ntbi1oYW5zJyIgLz4KICAgICAgICA8eHNsOndpdGgtcGFyYW0gbmFtZT0idGV4dCIgc2VsZWN0PSIn6L+U5Zue6aG26YOoJyIgLz4KICAgIDwveHNsOmNhbGwtdGVtcGxhdGU+CgogICAgPHhzbDpjYWxsLXRlbXBsYXRlIG5hbWU9ImJ1aWxkLWxvY2FsZSI+CiAgICAgICAgPHhzbDp3aXRoLXBhcmFtIG5hbWU9ImxhbmciIHNlbGVjdD0iJ2Ntbi1oYW50JyIgLz4KICAgICAgICA8eHNsOndpdGgtcGFyYW0gbmFtZT0idGV4dCIgc2VsZWN0PSIn6L+U5Zue6aCC6YOoJyIgLz4KICAgIDwveHNsOmNhbGwtdGVtcGxhdGU+CjwveHNsOnZhcmlhYmxlPgoKPCEtLSBkZXNjcmlwdGlvbiAtLT4KPHhzbDp2YXJpYWJsZSBuYW1lPSJsb2NhbGUtZGVzY3JpcHRpb24iPgogICAgPHhzbDpjYWxsLXRlbXBsYXRlIG5hbWU9ImJ1aWxkLWxvY2FsZSI+CiAgICAgICAgPHhzbDp3aXRoLXBhcmFtIG5hbWU9ImxhbmciIHNlbGVjdD0iJ2Ntbi1oYW5zJyIgLz4KICAgICAgICA8eHNsOndpdGgtcGFyYW0gbmFtZT0idGV4dCIgc2VsZWN0PSIn5o+P6L+wJyIgLz4KICAgIDwveHNsOmNhbGwtdGVtcGxhdGU+CgogICAgPHhzbDpjYWxsLXRlbXBsYXRlIG5hbWU9ImJ1aWxkLWxvY2FsZSI+CiAgICAgICAgPHhzbDp3aXRoLXBhcmFtIG5hbWU9ImxhbmciIHNlbGVjdD0iJ2Ntbi1oYW50JyIgLz4KICAgICAgICA8eHNsOndpdGgtcGFyYW0gbmFtZT0idGV4dCIgc2VsZWN0PSIn5o+P6L+wJyIgLz4KICAgIDwveHNsOmNhbGwtdGVtcGxhdGU+CjwveHNsOnZhcmlhYmxlPgoKPCEtLSBlbnVtIC0tPgo8eHNsOnZhcmlhYmxlIG5hbWU9ImxvY2FsZS1lbnVtIj4KICAgIDx4c2w6Y2FsbC10ZW1wbGF0ZSBuYW1lPSJidWlsZC1sb2NhbGUiPgogICAgICAgIDx4c2w6d2l0aC1wYXJhbSBuYW1lPSJsYW5nIiBzZWxlY3Q9IidjbW4taGFucyciIC8+CiAgICAgICAgPHhzbDp3aXRoLXBhcmFtIG5hbWU9InRleHQiIHNlbGVjdD0iJ+aemuS4viciIC8+CiAgICA8L3hzbDpjYWxsLXRlbXBsYXRlPgoKICAgIDx4c2w6Y2FsbC10ZW1wbGF0ZSBuYW1lPSJidWlsZC1sb2NhbGUiPgogICAgICAgIDx4c2w6d2l0aC1wYXJhbSBuYW1lPSJsYW5nIiBzZWxlY3Q9IidjbW4taGFudCciIC8+CiAgICAgICAgPHhzbDp3aXRoLXBhcmFtIG5hbWU9InRleHQiIHNlbGVjdD0iJ+aemuiIiSciIC8+CiAgICA8L3hzbDpjYWxsLXRlbXBsYXRlPgo8L3hzbDp2YXJpYWJsZT4KCjwhLS0gZ2VuZXJhdG9yIC0tPgo8eHNsOnZhcmlhYmxlIG5hbWU9ImxvY2FsZS1nZW5lcmF0b3IiPgogICAgPHhzbDpjYWxsLXRlbXBsYXRlIG5hbWU9ImJ1aWxkLWxvY2FsZSI+CiAgICAgICAgPHhzbDp3aXRoLXBhcmFtIG5hbWU9ImxhbmciIHNlbGVjdD0iJ2Ntbi1oYW5zJyIgLz4KICAgICAgICA8eHNsOndpdGgtcGFyYW0gbmFtZT0idGV4dCI+CiAgICAgICAgICAgIDx4c2w6aWYgdGVzdD0iYXBpZG9jL2xpY2Vuc2UiPgogICAgICAgICAgICDmlofmoaPniYjmnYPkuLogPGEgaHJlZj0ie2FwaWRvYy9saWNlbnNlL0B1cmx9Ij48eHNsOnZhbHVlLW9mIHNlbGVjdD0iYXBpZG9jL2xpY2Vuc2UvQHRleHQiIC8+PC9hPuOAggogICAgICAgICAgICA8L3hzbDppZj4KICAgICAgICAgICAg5YyF5ZCr5LqGIDx4c2w6dmFsdWUtb2Ygc2VsZWN0PSJjb3VudChhcGlkb2MvYXBpKSIgLz4g5Liq5o6l5Y+j5aOw5piO77yM55SxIDxhIGhyZWY9Imh0dHBzOi8vYXBpZG9jLnRvb2xzIj5hcGlkb2M8L2E+IOeUn+aIkOS6jiA8dGltZT48eHNsOnZhbHVlLW9mIHNlbGVjdD0iYXBpZG9jL0BjcmVhdGVkIiAvPjwvdGltZT7jgIIKICAgICAgICA8L3hzbDp3aXRoLXBhcmFtPgogICAgPC94c2w6Y2FsbC10ZW1wbGF0ZT4KCiAgICA8eHNsOmNhbGwtdGVtcGxhdGUgbmFtZT0iYnVpbGQtbG9jYWxlIj4KICAgICAgICA8eHNsOndpdGgtcGFyYW0gbmFtZT0ibGFuZyIgc2VsZWN0PSInY21uLWhhbnQnIiAvPgogICAgICAgIDx4c2w6d2l0aC1wYXJhbSBuYW1lPSJ0ZXh0Ij4KICAgICAgICAgICAgPHhzbDppZiB0ZXN0PSJhcGlkb2MvbGljZW5zZSI+CiAgICAgICAgICAgIOaWh+aqlOeJiOasiueCuiA8YSBocmVmPSJ7YXBpZG9jL2xpY2Vuc2UvQHVybH0iPjx4c2w6dmFsdWUtb2Ygc2VsZWN0PSJhcGlkb2MvbGljZW5zZS9AdGV4dCIgLz48L2E+44CCCiAgICAgICAgICAgIDwveHNsOmlmPgogICAgICAgICAgICDljIXlkKvkuoYgPHhzbDp2YWx1ZS1vZiBzZWxlY3Q9ImNvdW50KGFwaWRvYy9hcGkpIiAvPiDlgIvmjqXlj6PogbLmmI7vvIznlLEgPGEgaHJlZj0iaHR0cHM6Ly9hcGlkb2MudG9vbHMiPmFwaWRvYzwvYT4g55Sf5oiQ5pa8IDx0aW1lPjx4c2w6dmFsdWUtb2Ygc2VsZWN0PSJhcGlkb2MvQGNyZWF0ZWQiIC8+PC90aW1lPuOAggogICAgICAgIDwveHNsOndpdGgtcGFyYW0+CiAgICA8L3hzbDpjYWxsLXRlbXBsYXRlPgo8L3hzbDp2YXJpYWJsZT4KCjx4c2w6dGVtcGxhdGUgbmFtZT0iYnVpbGQtbG9jYWxlIj4KICAgIDx4c2w6cGFyYW0gbmFtZT0ibGFuZyIgLz4KICAgIDx4c2w6cGFyYW0gbmFtZT0idGV4dCIgLz4KCiAgICA8eHNsOnZhcmlhYmxlIG5hbWU9ImNsYXNzIj4KICAgICAgICA8eHNsOmNob29zZT4KICAgICAgICAgICAgPHhzbDp3aGVuIHRlc3Q9IiRjdXJyLWxhbmc9dHJhbnNsYXRlKCRsYW5nLCAkdXBwZXJjYXNlLCAkbG93ZXJjYXNlKSI+CiAgICAgICAgICAgICAgICA8eHNsOnZhbHVlLW9mIHNlbGVjdD0iJyciIC8+CiAgICAgICAgICAgIDwveHNsOndoZW4+CiAgICAgICAgICAgIDx4c2w6b3RoZXJ3aXNlPgogICAgICAgICAgICAgICAgPHhzbDp2YWx1ZS1vZiBzZWxlY3Q9IidoaWRkZW4nIiAvPgogICAgICAgICAgICA8L3hzbDpvdGhlcndpc2U+CiAgICAgICAgPC94c2w6Y2hvb3NlPgogICAgPC94c2w6dmFyaWFibGU+CgogICAgPCEtLSBkYXRhLWxvY2FsZSDlsZ7mgKfooajnpLror6XlhYPntKDmmK/kuIDkuKrmnKzlnLDljJbkv6Hmga/lhYPntKDvvIxKUyDku6PnoIHpgJrov4for6XmoIforrDliIfmjaLor63oqIDjgIIgLS0+CiAgICA8c3BhbiBkYXRhLWxvY2FsZT0idHJ1ZSIgbGFuZz0ieyRsYW5nfSIgY2xhc3M9InskY2xhc3N9Ij48eHNsOmNvcHktb2Ygc2VsZWN0PSIkdGV4dCIgLz48L3NwYW4+CjwveHNsOnRlbXBsYXRlPgoKPCEtLQrov5Tlm57lvZPliY3mlofmoaPnmoTor63oqIDvvIzkvJrovazmjaLkuLrlsI/lhpnvvIxfIOS5n+S8muiiq+i9rOaNouaIkCAtCuWmguaenOaWh+aho+aMh+WumueahOivreiogOS4jeWtmOWcqO+8jOWImeS8mumHh+WPliBsOmxvY2FsZXMg5Lit55qE56ys5LiA5Liq5YWD57Sg5L2c5Li66buY6K6k6K+t6KiA44CCCi0tPgo8eHNsOnZhcmlhYmxlIG5hbWU9ImN1cnItbGFuZyI+CiAgICA8eHNsOnZhcmlhYmxlIG5hbWU9ImN1cnIiIHNlbGVjdD0idHJhbnNsYXRlKC9hcGlkb2MvQGxhbmcsICR1cHBlcmNhc2UsICRsb3dlcmNhc2UpIiAvPgoKICAgIDx4c2w6dmFyaWFibGUgbmFtZT0icjEiPgogICAgICAgIDx4c2w6Zm9yLWVhY2ggc2VsZWN0PSJkb2N1bWVudCgnJykveHNsOnN0eWxlc2hlZXQvbDpsb2NhbGVzL2xvY2FsZSI+CiAgICAgICAgICAgIDx4c2w6aWYgdGVzdD0iQGlkPSRjdXJyIj48eHNsOnZhbHVlLW9mIHNlbGVjdD0iJGN1cnIiIC8+PC94c2w6aWY+CiAgICAgICAgPC94c2w6Zm9yLWVhY2g+CiAgICA8L3hzbDp2YXJpYWJsZT4KCiAgICA8eHNsOnZhcmlhYmxlIG5hbWU9InIyIj4KICAgIDx4c2w6Y2hvb3NlPgogICAgICAgIDx4c2w6d2hlbiB0ZXN0PSIkcjEgYW5kIG5vdCgkcjE9JycpIj4gCiAgICAgICAgICAgIDx4c2w6dmFsdWUtb2Ygc2VsZWN0PSIkcjEiIC8+CiAgICAgICAgPC94c2w6d2hlbj4KICAgICAgICA8eHNsOm90aGVyd2lzZT4KICAgICAgICAgICAgPHhzbDp2YWx1ZS1vZiBzZWxlY3Q9ImRvY3VtZW50KCcnKS94c2w6c3R5bGVzaGVldC9sOmxvY2FsZXMvbG9jYWxlWzFdL0BpZCIgLz4KICAgICAgICA8L3hzbDpvdGhlcndpc2U+CiAgICA8L3hzbDpjaG9vc2U+CiAgICA8L3hzbDp2YXJpYWJsZT4KCiAgICA8eHNsOnZhbHVlLW9mIHNlbGVjdD0iJHIyIiAvPgo8L3hzbDp2YXJpYWJsZT4KCjwhLS0g55So5LqO5a6e546wIGxvd2VyLWNhc2Ug5ZKMIHVwcGVyLWNhc2XvvIzlpoLmnpzlsIbmnaXmn5DlpKnmtY/op4jlmajmlK/mjIEgeHNsIDIuMCDkuobvvIzlj6/ku6Xnm7TmjqXph4fnlKjnm7jlhbPlh73mlbAgLS0+Cjx4c2w6dmFyaWFibGUgbmFtZT0ibG93ZXJjYXNlIiBzZWxlY3Q9IidhYmNkZWZnaGlqa2xtbm9wcXJzdHV2d3h5ei0nIiAvPgo8eHNsOnZhcmlhYmxlIG5hbWU9InVwcGVyY2FzZSIgc2VsZWN0PSInQUJDREVGR0hJSktMTU5PUFFSU1RVVldYWVpfJyIgLz4KCjwveHNsOnN0eWxlc2hlZXQ+CgABDHY2L3ZpZXcuaHRtbAEYdGV4dC9odG1sOyBjaGFyc2V0PXV0Zi04Af4ICTwhRE9DVFlQRSBodG1sPgo8aHRtbCBsYW5nPSJlbiI+Cgo8aGVhZD4KICAgIDxtZXRhIGNoYXJzZXQ9IlVURi04Ij4KICAgIDxtZXRhIG5hbWU9InZpZXdwb3J0IiBjb250ZW50PSJ3aWR0aD1kZXZpY2Utd2lkdGgsIGluaXRpYWwtc2NhbGU9MS4wIj4KICAgIDxtZXRhIGh0dHAtZXF1aXY9IlgtVUEtQ29tcGF0aWJsZSIgY29udGVudD0iaWU9ZWRnZSI+CiAgICA8dGl0bGU+RG9jdW1lbnQ8L3RpdGxlPgoKICAgIDxzdHlsZT4KICAgIDpyb290IHsKICAgICAgICAtLWJhY2tncm91bmQ6IHdoaXRlOwogICAgfQogICAgQG1lZGlhIChwcmVmZXJzLWNvbG9yLXNjaGVtZTogZGFyaykgewogICAgICAgIDpyb290IHsKICAgICAgICAgICAgLS1iYWNrZ3JvdW5kOiBibGFjazsKICAgICAgICB9CiAgICB9CgogICAgaHRtbCwKICAgIGJvZHkgewogICAgICAgIG1hcmdpbjogMDsKICAgICAgICBwYWRkaW5nOiAwOwogICAgICAgIGJhY2tncm91bmQ6IHZhcigtLWJhY2tncm91bmQpOwogICAgfQoKICAgIGlmcmFtZSB7CiAgICAgICAgYm9yZGVyOiBub25lOwogICAgICAgIHdpZHRoOiAxMDAlOwogICAgICAgIGJhY2tncm91bmQ6IHZhcigtLWJhY2tncm91bmQpOwogICAgfQogICAgPC9zdHlsZT4KPC9oZWFkPgoKPGJvZHk+CiAgICA8IS0tCiAgICBOT1RFOiB4c2x0IOS4reW8leeUqOeahCBqc++8jOWPquacieWcqCBpZnJhbWUg5Lit5omN6IO95omn6KGM77yMCiAgICDnm7TmjqXnlKggZG9jdW1lbnQucmVwbGFjZSgpIOabv+aNouW9k+WJjeWGheWuue+8jOS4jeS8muaJp+ihjOW8leeUqOeahCBKUyDku6PnoIHjgIIKICAgIC0tPgogICAgPGlmcmFtZSBpZD0iYXBpZG9jIj48L2lmcmFtZT4KICAgIDxzY3JpcHQ+CiAgICBhc3luYyBmdW5jdGlvbiBsb2FkWE1MKHBhdGgpIHsKICAgICAgICBjb25zdCBvYmogPSBhd2FpdCBmZXRjaChwYXRoKTsKICAgICAgICByZXR1cm4gKG5ldyBET01QYXJzZXIoKSkucGFyc2VGcm9tU3RyaW5nKGF3YWl0IG9iai50ZXh0KCksICJ0ZXh0L3htbCIpOwogICAgfQoKICAgIGZ1bmN0aW9uIGNoYW5nZUZyYW1lSGVpZ2h0KCkgewogICAgICAgIGNvbnN0IGlmcmFtZSA9IGRvY3VtZW50LmdldEVsZW1lbnRCeUlkKCJhcGlkb2MiKTsKICAgICAgICBpZnJhbWUuaGVpZ2h0ID0gZG9jdW1lbnQuZG9jdW1lbnRFbGVtZW50LmNsaWVudEhlaWdodDsKICAgIH0KCiAgICB3aW5kb3cub25yZXNpemUgPSBmdW5jdGlvbiAoKSB7CiAgICAgICAgY2hhbmdlRnJhbWVIZWlnaHQoKTsKICAgIH0gCgogICAgY29uc3QgcXVlcmllcyA9IG5ldyBVUkxTZWFyY2hQYXJhbXMod2luZG93LmxvY2F0aW9uLnNlYXJjaCk7CiAgICBjb25zdCB1cmwgPSBxdWVyaWVzLmdldCgndXJsJyk7CgogICAgYXN5bmMgZnVuY3Rpb24gaW5pdCgpIHsKICAgICAgICBjb25zdCBwcm9jZXNzb3IgPSBuZXcgWFNMVFByb2Nlc3NvcigpOwogICAgICAgIHByb2Nlc3Nvci5pbXBvcnRTdHlsZXNoZWV0KGF3YWl0IGxvYWRYTUwoJy4vYXBpZG9jLnhzbCcpKTsKCiAgICAgICAgY29uc3QgeG1sID0gYXdhaXQgbG9hZFhNTCh1cmwpOwogICAgICAgIGNvbnN0IGRvYyA9IHByb2Nlc3Nvci50cmFuc2Zvcm1Ub0RvY3VtZW50KHhtbCk7CiAgICAgICAgY29uc3QgaHRtbCA9IChuZXcgWE1MU2VyaWFsaXplcigpKS5zZXJpYWxpemVUb1N0cmluZyhkb2MpOwogICAgICAgIGNvbnN0IGJsb2IgPSBuZXcgQmxvYihbaHRtbF0sIHsgdHlwZTogJ3RleHQvaHRtbCcgfSkKICAgICAgICBjb25zdCBvYmogPSBVUkwuY3JlYXRlT2JqZWN0VVJMKGJsb2IpCgogICAgICAgIGNvbnN0IGlmcmFtZSA9IGRvY3VtZW50LmdldEVsZW1lbnRCeUlkKCdhcGlkb2MnKTsKICAgICAgICBpZnJhbWUuc3JjID0gb2JqOwogICAgICAgIGlmcmFtZS5hZGRFdmVudExpc3RlbmVyKCdsb2FkJywgKGUpID0+IHsKICAgICAgICAgICAgY2hhbmdlRnJhbWVIZWlnaHQoKTsKICAgICAgICB9KTsKICAgIH0KCiAgICB0cnl7CiAgICAgICAgaW5pdCgpCiAgICB9IGNhdGNoIChlKSB7CiAgICAgICAgY29uc29sZS5lcnJvcihlKQogICAgfQogICAgPC9zY3JpcHQ+CjwvYm9keT4KCjwvaHRtbD4KAA==`
@@ -0,0 +1,308 @@
+// SPDX-License-Identifier: MIT
+
+package gocode
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/issue9/errwrap"
+
+	"github.com/caixw/apidoc/v7/internal/ast"
+)
+
+var clientImports = []string{
+	"bytes",
+	"context",
+	"encoding/json",
+	"encoding/xml",
+	"fmt",
+	"io",
+	"net/http",
+	"net/url",
+	"strings",
+}
+
+// 生成的客户端中，与 API 无关的代码
+const clientCode = `// Client is the client of the API
+type Client struct {
+	// BaseURL is the address of the server, e.g. DefaultServer.
+	BaseURL string
+
+	// HTTPClient is used to send requests, http.DefaultClient is used if nil.
+	HTTPClient *http.Client
+
+	// Header is added to every request, e.g. the authorization header.
+	Header http.Header
+}
+
+// Error is returned when the status code is not documented
+type Error struct {
+	StatusCode int
+	Body       []byte
+}
+
+// New returns a Client with the base URL
+func New(baseURL string) *Client {
+	return &Client{
+		BaseURL:    baseURL,
+		HTTPClient: http.DefaultClient,
+		Header:     http.Header{},
+	}
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("%d %s", e.StatusCode, http.StatusText(e.StatusCode))
+}
+
+func (c *Client) do(ctx context.Context, method, path string, query url.Values, mimetype string, body interface{}, accept string) (*http.Response, []byte, error) {
+	var r io.Reader
+	if body != nil {
+		var data []byte
+		var err error
+		if strings.Contains(mimetype, "xml") {
+			data, err = xml.Marshal(body)
+		} else {
+			data, err = json.Marshal(body)
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+		r = bytes.NewReader(data)
+	}
+
+	u := strings.TrimSuffix(c.BaseURL, "/") + path
+	if len(query) > 0 {
+		u += "?" + query.Encode()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, u, r)
+	if err != nil {
+		return nil, nil, err
+	}
+	for k, v := range c.Header {
+		req.Header[k] = v
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", mimetype)
+	}
+	if accept != "" {
+		req.Header.Set("Accept", accept)
+	}
+
+	client := c.HTTPClient
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, nil, err
+	}
+	return resp, data, nil
+}
+
+// decode decodes the body according to the content type of the response
+func decode(resp *http.Response, data []byte, v interface{}) error {
+	if len(data) == 0 {
+		return nil
+	}
+	if strings.Contains(resp.Header.Get("Content-Type"), "xml") {
+		return xml.Unmarshal(data, v)
+	}
+	return json.Unmarshal(data, v)
+}
+
+`
+
+type client struct {
+	*generator
+	code errwrap.Buffer
+}
+
+// Client 生成 Go 语言的客户端代码
+//
+// pkg 为生成代码的包名。每个 API 生成一个 Client 的方法，路径参数作为方法的参数，
+// 查询参数则合并成一个结构体；请求和返回的报文根据 mimetype 采用 JSON 或 XML 编码；
+// 文档中定义的非 2xx 状态码都会生成相应的错误类型，其它状态码则返回 *Error。
+func Client(doc *ast.APIDoc, pkg string) ([]byte, error) {
+	c := &client{
+		generator: newGenerator(doc, "Client", "Error", "New", "DefaultServer"),
+	}
+
+	c.writeServers()
+	c.code.WString(clientCode)
+	c.writeNewError()
+	for _, api := range doc.APIs {
+		c.writeAPI(api)
+	}
+
+	if c.code.Err != nil {
+		return nil, c.code.Err
+	}
+	return c.source(pkg, "", clientImports, c.code.String())
+}
+
+// 每个 server 生成一个常量，第一个同时作为 DefaultServer。
+func (c *client) writeServers() {
+	if len(c.doc.Servers) == 0 {
+		return
+	}
+
+	c.code.WString("// Servers of the API\nconst (\n")
+	for _, srv := range c.doc.Servers {
+		name := c.unique("Server" + exportedName(srv.Name.V()))
+		writeComment(&c.code, "\t", name, summary(srv.Summary, srv.Description), srv.Deprecated)
+		c.code.Printf("\t%s = %q\n", name, srv.URL.V())
+	}
+	c.code.Printf("\n\tDefaultServer = %q\n", c.doc.Servers[0].URL.V())
+	c.code.WString(")\n\n")
+}
+
+// 文档中公共的返回内容，适用于所有的 API。
+func (c *client) writeNewError() {
+	c.code.WString("func newError(resp *http.Response, data []byte) error {\n")
+
+	if responses := c.errorResponses(); len(responses) > 0 {
+		c.code.WString("\tswitch resp.StatusCode {\n")
+		for _, resp := range responses {
+			c.writeErrorCase(resp, "")
+		}
+		c.code.WString("\t}\n")
+	}
+
+	c.code.WString("\treturn &Error{StatusCode: resp.StatusCode, Body: data}\n}\n\n")
+}
+
+func (c *client) writeAPI(api *ast.API) {
+	m := c.newMethod(api)
+	zero := m.zero()
+
+	writeComment(&c.code, "", m.name, m.title(), api.Deprecated)
+	c.code.Printf("func (c *Client) %s {\n", m.signature())
+
+	c.code.Printf("\tpath := %s\n", c.path(m))
+	queryArg := "nil"
+	if m.query != "" {
+		queryArg = "q"
+		c.writeQueryValues(m.fields)
+	}
+	bodyArg := "nil"
+	if m.body != "" {
+		bodyArg = "body"
+	}
+	c.code.Printf("\tresp, data, err := c.do(ctx, %q, path, %s, %q, %s, %q)\n",
+		api.Method.V(), queryArg, m.mimetype, bodyArg, c.accept(api.Responses))
+	c.code.Printf("\tif err != nil {\n\t\treturn %serr\n\t}\n\n", zero)
+
+	cases := make([]*response, 0, len(m.responses))
+	for _, resp := range m.responses {
+		if resp.err != "" || (resp.typ != "" && resp.typ == m.ret) {
+			cases = append(cases, resp)
+		}
+	}
+	if len(cases) > 0 {
+		c.code.WString("\tswitch resp.StatusCode {\n")
+		for _, resp := range cases {
+			if resp.err != "" {
+				c.writeErrorCase(resp, zero)
+				continue
+			}
+
+			c.code.Printf("\tcase %d:\n", resp.status)
+			if nillable(m.ret) {
+				c.code.Printf("\t\tvar v %s\n", m.ret)
+				c.code.Printf("\t\tif err := decode(resp, data, &v); err != nil {\n\t\t\treturn nil, err\n\t\t}\n")
+			} else {
+				c.code.Printf("\t\tv := new(%s)\n", m.ret[1:])
+				c.code.Printf("\t\tif err := decode(resp, data, v); err != nil {\n\t\t\treturn nil, err\n\t\t}\n")
+			}
+			c.code.WString("\t\treturn v, nil\n")
+		}
+		c.code.WString("\t}\n\n")
+	}
+
+	c.code.Printf("\tif resp.StatusCode >= 200 && resp.StatusCode < 300 {\n\t\treturn %snil\n\t}\n", zero)
+	c.code.Printf("\treturn %snewError(resp, data)\n}\n\n", zero)
+}
+
+// 生成请求路径的表达式
+func (c *client) path(m *method) string {
+	exprs := make([]string, 0, len(m.segments))
+	for _, seg := range m.segments {
+		if seg.arg == "" {
+			exprs = append(exprs, strconv.Quote(seg.text))
+		} else {
+			exprs = append(exprs, "url.PathEscape(fmt.Sprint("+seg.arg+"))")
+		}
+	}
+	return strings.Join(exprs, " + ")
+}
+
+// 将查询参数的结构体转换成 url.Values
+func (c *client) writeQueryValues(fields []*field) {
+	c.code.WString("\tq := url.Values{}\n\tif query != nil {\n")
+	for _, f := range fields {
+		field := "query." + f.name
+		name := strconv.Quote(f.param.Name.V())
+		t := f.typ
+
+		switch {
+		case strings.HasPrefix(t, "[]") && f.param.ArrayStyle.V():
+			c.code.Printf("\t\tif len(%s) > 0 {\n", field)
+			c.code.Printf("\t\t\tvs := make([]string, 0, len(%s))\n", field)
+			c.code.Printf("\t\t\tfor _, v := range %s {\n\t\t\t\tvs = append(vs, fmt.Sprint(v))\n\t\t\t}\n", field)
+			c.code.Printf("\t\t\tq.Set(%s, strings.Join(vs, \",\"))\n\t\t}\n", name)
+		case strings.HasPrefix(t, "[]"):
+			c.code.Printf("\t\tfor _, v := range %s {\n\t\t\tq.Add(%s, fmt.Sprint(v))\n\t\t}\n", field, name)
+		case strings.HasPrefix(t, "*"):
+			c.code.Printf("\t\tif %s != nil {\n\t\t\tq.Set(%s, fmt.Sprint(*%s))\n\t\t}\n", field, name, field)
+		default:
+			c.code.Printf("\t\tq.Set(%s, fmt.Sprint(%s))\n", name, field)
+		}
+	}
+	c.code.WString("\t}\n")
+}
+
+// zero 为返回错误时，错误之前的返回值，为空表示仅返回错误。
+func (c *client) writeErrorCase(resp *response, zero string) {
+	c.code.Printf("\tcase %d:\n", resp.status)
+	if resp.typ == "" {
+		c.code.Printf("\t\treturn %s&%s{}\n", zero, resp.err)
+		return
+	}
+
+	c.code.Printf("\t\te := &%s{}\n", resp.err)
+	c.code.Printf("\t\tif err := decode(resp, data, &e.Body); err != nil {\n\t\t\treturn %serr\n\t\t}\n", zero)
+	c.code.Printf("\t\treturn %se\n", zero)
+}
+
+// 返回内容可接受的 mimetype，未指定时采用文档中的 mimetype。
+func (c *client) accept(responses []*ast.Request) string {
+	mimetypes := make([]string, 0, len(responses))
+	for _, r := range responses {
+		if mt := r.Mimetype.V(); mt != "" && !containsString(mimetypes, mt) {
+			mimetypes = append(mimetypes, mt)
+		}
+	}
+	if len(mimetypes) == 0 {
+		for _, mt := range c.doc.Mimetypes {
+			mimetypes = append(mimetypes, mt.V())
+		}
+	}
+	return strings.Join(mimetypes, ", ")
+}
+
+func containsString(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
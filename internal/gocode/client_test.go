@@ -0,0 +1,53 @@
+// SPDX-License-Identifier: MIT
+
+package gocode
+
+import (
+	"go/types"
+	"testing"
+
+	"github.com/issue9/assert"
+
+	"github.com/caixw/apidoc/v7/internal/ast/asttest"
+)
+
+func TestClient(t *testing.T) {
+	a := assert.New(t)
+
+	data, err := Client(asttest.Get(), "client")
+	a.NotError(err).NotEmpty(data)
+	check(a, data)
+
+	data, err = Client(loadDoc(a), "users")
+	a.NotError(err).NotEmpty(data)
+	scope := check(a, data)
+
+	a.Equal(scope.Lookup("DefaultServer").(*types.Const).Val().String(), `"https://example.com"`)
+	a.NotNil(scope.Lookup("ServerDev"))
+
+	client := scope.Lookup("Client").Type()
+	method := func(name string) string {
+		obj, _, _ := types.LookupFieldOrMethod(client, true, nil, name)
+		a.NotNil(obj, name)
+		return obj.Type().String()
+	}
+	a.Equal(method("GetUsers"), "func(ctx context.Context, query *users.GetUsersQuery) ([]users.User, error)")
+	a.Equal(method("GetUsersByIDPetsByType"), "func(ctx context.Context, id int64, typeParam string) (*users.Pet, error)")
+	a.Equal(method("CreateUser"), "func(ctx context.Context, body *users.CreateUserRequest) (*int64, error)")
+	a.Equal(method("DeleteUsersByID"), "func(ctx context.Context, id int64) error")
+
+	// 联合类型合并了所有分支的字段
+	pet := scope.Lookup("Pet").Type().Underlying().(*types.Struct)
+	a.Equal(3, pet.NumFields()).
+		Equal(pet.Field(2).Type().String(), "*bool")
+
+	// 错误类型
+	a.NotNil(scope.Lookup("GetUsersByIDPetsByType404Error"))
+	e := scope.Lookup("CreateUser400Error").Type().Underlying().(*types.Struct)
+	a.Equal(e.Field(0).Type().String(), "*users.CreateUserResponse400")
+	a.NotNil(scope.Lookup("Status500Error"))
+
+	req := scope.Lookup("CreateUserRequest").Type().Underlying().(*types.Struct)
+	a.Equal(req.Field(0).Name(), "XMLName").
+		Equal(req.Tag(0), `json:"-" xml:"user"`)
+}
@@ -0,0 +1,424 @@
+// SPDX-License-Identifier: MIT
+
+// Package gocode 根据文档生成 Go 代码
+package gocode
+
+import (
+	"go/format"
+	"net/http"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/issue9/errwrap"
+
+	"github.com/caixw/apidoc/v7/core"
+	"github.com/caixw/apidoc/v7/internal/ast"
+)
+
+// 采用全大写形式的缩写
+var initialisms = map[string]bool{
+	"API":  true,
+	"HTML": true,
+	"HTTP": true,
+	"ID":   true,
+	"IP":   true,
+	"JSON": true,
+	"SQL":  true,
+	"URI":  true,
+	"URL":  true,
+	"UUID": true,
+	"XML":  true,
+}
+
+var keywords = map[string]bool{
+	"break": true, "case": true, "chan": true, "const": true, "continue": true,
+	"default": true, "defer": true, "else": true, "fallthrough": true, "for": true,
+	"func": true, "go": true, "goto": true, "if": true, "import": true,
+	"interface": true, "map": true, "package": true, "range": true, "return": true,
+	"select": true, "struct": true, "switch": true, "type": true, "var": true,
+}
+
+// 生成代码的公共部分，包括类型定义以及名称的管理。
+type generator struct {
+	doc      *ast.APIDoc
+	names    map[string]bool         // 已经使用的类型名称
+	typedefs map[*ast.TypeDef]string // TypeDef 对应的类型名称
+	decls    []string                // 所有的类型声明，按生成的顺序保存。
+	errors   []*response             // 所有的错误类型
+}
+
+func newGenerator(doc *ast.APIDoc, reserved ...string) *generator {
+	g := &generator{
+		doc:      doc,
+		names:    make(map[string]bool, len(doc.Types)+len(doc.APIs)*3+len(reserved)),
+		typedefs: make(map[*ast.TypeDef]string, len(doc.Types)),
+		decls:    make([]string, 0, len(doc.Types)+len(doc.APIs)*2),
+	}
+	for _, name := range reserved {
+		g.names[name] = true
+	}
+
+	// 先确定所有 TypeDef 的名称，声明时可能引用后面的类型。
+	for _, t := range doc.Types {
+		g.typedefs[t] = g.unique(exportedName(t.Name.V()))
+	}
+	for _, t := range doc.Types {
+		g.writeTypeDef(t)
+	}
+
+	return g
+}
+
+// 输出完整的 Go 文件内容
+//
+// head 为输出在包声明之前的内容，不会作为包的注释；
+// imports 中的元素可以是 "name path" 的形式，表示带别名的导入；
+// code 为类型声明之前的代码，返回的内容会经过 gofmt 格式化。
+func (g *generator) source(pkg, head string, imports []string, code string) ([]byte, error) {
+	var buf errwrap.Buffer
+	buf.Printf("// Code generated by %s. DO NOT EDIT.\n\n", core.Name)
+	if head != "" {
+		buf.WString(head).WByte('\n')
+	}
+	buf.Printf("// Package %s %s %s\n", pkg, g.doc.Title.V(), g.doc.Version.V())
+	buf.Printf("package %s\n\n", pkg)
+
+	// 标准库与第三方的包分为两组，组内的排序由 gofmt 完成。
+	var std, others errwrap.Buffer
+	for _, imp := range imports {
+		w := &std
+		if path := imp[strings.IndexByte(imp, ' ')+1:]; strings.Contains(path, ".") {
+			w = &others
+		}
+
+		if index := strings.IndexByte(imp, ' '); index > 0 {
+			w.Printf("\t%s %q\n", imp[:index], imp[index+1:])
+		} else {
+			w.Printf("\t%q\n", imp)
+		}
+	}
+	buf.WString("import (\n").WBytes(std.Bytes())
+	if std.Len() > 0 && others.Len() > 0 {
+		buf.WByte('\n')
+	}
+	buf.WBytes(others.Bytes()).WString(")\n\n")
+
+	buf.WString(code)
+	for _, decl := range g.decls {
+		buf.WString(decl)
+	}
+	if buf.Err != nil {
+		return nil, buf.Err
+	}
+
+	return format.Source(buf.Bytes())
+}
+
+// 在 name 已经被使用的情况下，添加数字后缀。
+func (g *generator) unique(name string) string {
+	n := name
+	for i := 2; g.names[n]; i++ {
+		n = name + strconv.Itoa(i)
+	}
+	g.names[n] = true
+	return n
+}
+
+func (g *generator) writeTypeDef(t *ast.TypeDef) {
+	name := g.typedefs[t]
+	p := t.Param()
+
+	if isStruct(p) {
+		g.writeStruct(name, "", "", p, t.Deprecated)
+		return
+	}
+
+	var buf errwrap.Buffer
+	writeComment(&buf, "", name, summary(t.Summary, t.Description), t.Deprecated)
+	buf.Printf("type %s %s\n\n", name, g.goType(name, p))
+	g.decls = append(g.decls, buf.String())
+}
+
+// 声明名为 name 的结构体
+//
+// root 不为空时，表示该结构体作为报文的顶层元素，在 XML 中的名称为 root；
+// text 为 p 未指定描述信息时采用的注释内容。
+func (g *generator) writeStruct(name, root, text string, p *ast.Param, deprecated *ast.VersionAttribute) {
+	index := len(g.decls) // 先占位，保证父元素在子元素之前声明。
+	g.decls = append(g.decls, "")
+
+	var buf errwrap.Buffer
+	if s := summary(p.Summary, p.Description); s != "" {
+		text = s
+	}
+	writeComment(&buf, "", name, text, deprecated)
+	buf.Printf("type %s struct {\n", name)
+	if root != "" {
+		buf.Printf("\tXMLName xml.Name `json:\"-\" xml:%q`\n", g.xmlName(p.XMLNSPrefix.V(), root))
+	}
+
+	names := make(map[string]bool, len(p.Items))
+	for _, item := range structItems(p) {
+		field := exportedName(item.Name.V())
+		for i := 2; names[field]; i++ {
+			field = exportedName(item.Name.V()) + strconv.Itoa(i)
+		}
+		names[field] = true
+
+		writeComment(&buf, "\t", "", summary(item.Summary, item.Description), item.Deprecated)
+		buf.Printf("\t%s %s %s\n", field, g.fieldType(name+field, item), g.tags(item))
+	}
+	buf.WString("}\n\n")
+
+	g.decls[index] = buf.String()
+}
+
+// 返回 p 对应的 Go 类型，对象类型会以 name 作为名称声明结构体。
+func (g *generator) goType(name string, p *ast.Param) string {
+	var t string
+	switch {
+	case p.Type.TypeDef() != nil:
+		t = g.typedefs[p.Type.TypeDef()]
+	case isStruct(p):
+		name = g.unique(name)
+		g.writeStruct(name, "", "", p, nil)
+		t = name
+	case p.Type.V() == ast.TypeMap && len(p.Items) > 0:
+		t = "map[string]" + g.goType(name+"Value", p.Items[0])
+	default:
+		t = primitive(p.Type.V())
+	}
+
+	if p.Array.V() {
+		t = "[]" + t
+	}
+	return t
+}
+
+// 可选或是可以为 null 的字段采用指针类型，本身就可以为 nil 的类型除外。
+func (g *generator) fieldType(name string, p *ast.Param) string {
+	t := g.goType(name, p)
+	if (p.Optional.V() || p.Nullable.V()) && !nillable(t) {
+		t = "*" + t
+	}
+	return t
+}
+
+// 生成字段的 json 和 xml 标签
+func (g *generator) tags(p *ast.Param) string {
+	name := p.Name.V()
+	prefix := p.XMLNSPrefix.V()
+
+	var x string
+	switch {
+	case p.Resolve().Type.V() == ast.TypeMap: // encoding/xml 不支持 map
+		x = "-"
+	case p.XMLAttr.V():
+		x = g.xmlName(prefix, name) + ",attr"
+	case p.XMLExtract.V() && p.XMLCData.V():
+		x = ",cdata"
+	case p.XMLExtract.V():
+		x = ",chardata"
+	case p.Array.V():
+		// 与 xml-wrapped 的三种格式相对应：parent、parent>child 和 >child
+		v := p.XMLWrapped.V()
+		switch index := strings.IndexByte(v, '>'); {
+		case v == "":
+			x = g.xmlName(prefix, name)
+		case index == 0:
+			x = g.xmlName(prefix, v[1:])
+		case index < 0:
+			x = g.xmlName(prefix, v+">"+name)
+		default:
+			x = g.xmlName(prefix, v)
+		}
+	default:
+		x = g.xmlName(prefix, name)
+	}
+
+	j := name
+	if p.Optional.V() {
+		j += ",omitempty"
+		if !p.XMLExtract.V() && x != "-" {
+			x += ",omitempty"
+		}
+	}
+
+	return "`json:" + strconv.Quote(j) + " xml:" + strconv.Quote(x) + "`"
+}
+
+// encoding/xml 以命名空间的 URN 加上空格作为名称的前缀
+func (g *generator) xmlName(prefix, name string) string {
+	if prefix == "" {
+		return name
+	}
+	if ns := g.doc.XMLNamespace(prefix); ns != nil {
+		return ns.URN.V() + " " + name
+	}
+	return name
+}
+
+// 结构体的字段
+//
+// 联合类型会将所有分支的字段合并到同一个结构体中，仅出现在分支中的字段都是可选的。
+func structItems(p *ast.Param) []*ast.Param {
+	branches := p.Branches()
+	if len(branches) == 0 {
+		return p.Items
+	}
+
+	items := make([]*ast.Param, 0, len(p.Items)*2)
+	items = append(items, p.Items...)
+	for _, b := range branches {
+		for _, item := range b.Items[len(p.Items):] {
+			if !hasItem(items, item.Name.V()) {
+				pp := *item
+				pp.Optional = &ast.BoolAttribute{Value: ast.Bool{Value: true}}
+				items = append(items, &pp)
+			}
+		}
+	}
+	return items
+}
+
+func hasItem(items []*ast.Param, name string) bool {
+	for _, item := range items {
+		if item.Name.V() == name {
+			return true
+		}
+	}
+	return false
+}
+
+func isStruct(p *ast.Param) bool {
+	return p.Type.V() == ast.TypeObject || len(p.OneOf) > 0 || len(p.AnyOf) > 0
+}
+
+func nillable(t string) bool {
+	return strings.HasPrefix(t, "[]") || strings.HasPrefix(t, "map[") || t == "interface{}"
+}
+
+func primitive(typ string) string {
+	switch {
+	case typ == ast.TypeBool:
+		return "bool"
+	case typ == ast.TypeInt:
+		return "int64"
+	case typ == ast.TypeNumber || strings.HasPrefix(typ, ast.TypeNumber+"."):
+		return "float64"
+	case typ == ast.TypeBinary:
+		return "[]byte"
+	case typ == ast.TypeString || strings.HasPrefix(typ, ast.TypeString+"."):
+		return "string"
+	case typ == ast.TypeMap:
+		return "map[string]interface{}"
+	default:
+		return "interface{}"
+	}
+}
+
+// 由 api.id 或是请求方法和路径组成 API 的名称
+//
+// 路径中的参数以 By 作为前缀，比如 GET /users/{id} 转换成 GetUsersByID。
+func apiName(api *ast.API) string {
+	if id := api.ID.V(); id != "" {
+		return exportedName(id)
+	}
+
+	s := strings.ToLower(api.Method.V())
+	for _, seg := range strings.Split(api.Path.Path.V(), "/") {
+		if len(seg) > 2 && seg[0] == '{' && seg[len(seg)-1] == '}' {
+			seg = "by-" + seg[1:len(seg)-1]
+		}
+		s += "-" + seg
+	}
+	return exportedName(s)
+}
+
+// 将任意字符串转换成可导出的标识符
+//
+// 非字母和数字的字符被当作分隔符，分隔之后的每一段首字母大写，
+// 如果是常用的缩写，则全部大写。
+func exportedName(s string) string {
+	var b strings.Builder
+	for _, word := range words(s) {
+		if upper := strings.ToUpper(word); initialisms[upper] {
+			b.WriteString(upper)
+			continue
+		}
+
+		rs := []rune(word)
+		rs[0] = unicode.ToUpper(rs[0])
+		b.WriteString(string(rs))
+	}
+
+	name := b.String()
+	if name == "" || unicode.IsDigit([]rune(name)[0]) {
+		name = "T" + name
+	}
+	return name
+}
+
+// 转换成不可导出的标识符，与关键字相同时添加 Param 后缀。
+func unexportedName(s string) string {
+	name := exportedName(s)
+	ws := words(s)
+	if len(ws) > 0 && initialisms[strings.ToUpper(ws[0])] {
+		name = strings.ToLower(ws[0]) + name[len(ws[0]):]
+	} else {
+		rs := []rune(name)
+		rs[0] = unicode.ToLower(rs[0])
+		name = string(rs)
+	}
+
+	if keywords[name] {
+		name += "Param"
+	}
+	return name
+}
+
+func words(s string) []string {
+	return strings.FieldsFunc(s, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+// 输出注释，name 不为空时，会作为注释的开头。
+func writeComment(buf *errwrap.Buffer, prefix, name, text string, deprecated *ast.VersionAttribute) {
+	if name != "" {
+		text = strings.TrimSpace(name + " " + text)
+	}
+
+	if text != "" {
+		for _, line := range strings.Split(text, "\n") {
+			buf.WString(prefix).WString("//")
+			if line = strings.TrimRightFunc(line, unicode.IsSpace); line != "" {
+				buf.WByte(' ').WString(line)
+			}
+			buf.WByte('\n')
+		}
+	}
+
+	if deprecated != nil && deprecated.V() != "" {
+		if text != "" {
+			buf.WString(prefix).WString("//\n")
+		}
+		buf.WString(prefix).WString("// Deprecated: ").WString(deprecated.V()).WByte('\n')
+	}
+}
+
+// 优先采用 summary，否则采用 markdown 格式的描述内容。
+func summary(s *ast.Attribute, desc *ast.Richtext) string {
+	if v := s.V(); v != "" {
+		return v
+	}
+	if desc != nil && desc.Type.V() == ast.RichtextTypeMarkdown {
+		return strings.TrimSpace(desc.V())
+	}
+	return ""
+}
+
+func isSuccess(status int) bool {
+	return status >= http.StatusOK && status < http.StatusMultipleChoices
+}
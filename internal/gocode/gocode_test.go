@@ -0,0 +1,185 @@
+// SPDX-License-Identifier: MIT
+
+package gocode
+
+import (
+	"go/ast"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"io"
+	"os"
+	"os/exec"
+	"strings"
+	"testing"
+
+	"github.com/issue9/assert"
+	"github.com/issue9/errwrap"
+
+	"github.com/caixw/apidoc/v7/core"
+	"github.com/caixw/apidoc/v7/core/messagetest"
+	apidoc "github.com/caixw/apidoc/v7/internal/ast"
+)
+
+// 用于测试的文档，包含了各种类型的参数以及 XML 的相关属性。
+const testDoc = `<apidoc version="1.0.0">
+	<title>users</title>
+	<mimetype>application/json</mimetype>
+	<mimetype>application/xml</mimetype>
+	<xml-namespace prefix="u" urn="urn:user" />
+	<server name="prod" url="https://example.com" summary="prod" />
+	<server name="dev" url="https://dev.example.com" summary="dev" />
+	<type name="user" type="object" summary="user">
+		<param name="id" type="number.int" xml-attr="true" summary="id" />
+		<param name="name" type="string" xml-ns-prefix="u" summary="name" deprecated="1.0.1" />
+		<param name="tags" type="string" array="true" xml-wrapped="tags>tag" optional="true" summary="tags" />
+		<param name="avatar" type="string.binary" nullable="true" summary="avatar" />
+		<param name="attrs" type="map" optional="true" summary="attrs"><param name="value" type="number" summary="value" /></param>
+		<param name="profile" type="object" optional="true" summary="profile">
+			<param name="type" type="string" summary="type" />
+			<param name="text" type="string" xml-extract="true" xml-cdata="true" summary="text" />
+		</param>
+	</type>
+	<type name="pet" type="object" summary="pet" discriminator="kind">
+		<param name="kind" type="string" summary="kind" />
+		<one-of name="dog" type="object" summary="dog"><param name="bark" type="bool" summary="bark" /></one-of>
+		<one-of name="cat" type="object" summary="cat"><param name="meow" type="bool" summary="meow" /></one-of>
+	</type>
+	<response status="500" type="object" mimetype="application/json">
+		<param name="message" type="string" summary="message" />
+	</response>
+	<api method="GET" summary="list users">
+		<path path="/users">
+			<query name="page" type="number.int" summary="page" />
+			<query name="size" type="number.int" optional="true" summary="size" />
+			<query name="type" type="string" array="true" array-style="true" summary="type" />
+			<query name="id" type="number.int" array="true" summary="id" />
+		</path>
+		<response status="200" type="#user" array="true" mimetype="application/json" />
+	</api>
+	<api method="GET" summary="get user" deprecated="1.0.2">
+		<path path="/users/{id}/pets/{type}">
+			<param name="id" type="number.int" summary="id" />
+			<param name="type" type="string" summary="type" />
+		</path>
+		<response status="200" type="#pet" mimetype="application/json" />
+		<response status="404" mimetype="application/json" />
+	</api>
+	<api method="POST" id="create-user">
+		<path path="/users" />
+		<request name="user" type="object" mimetype="application/xml">
+			<param name="name" type="string" summary="name" />
+			<param name="items" type="object" array="true" xml-wrapped="list" summary="items">
+				<param name="id" type="number" summary="id" />
+			</param>
+		</request>
+		<response status="201" type="number.int" mimetype="application/json" />
+		<response status="400" type="object" mimetype="application/json">
+			<param name="fields" type="map" summary="fields"><param name="value" type="string" summary="value" /></param>
+		</response>
+	</api>
+	<api method="DELETE">
+		<path path="/users/{id}"><param name="id" type="number.int" summary="id" /></path>
+		<response status="204" />
+	</api>
+</apidoc>`
+
+func loadDoc(a *assert.Assertion) *apidoc.APIDoc {
+	rslt := messagetest.NewMessageHandler()
+	doc := &apidoc.APIDoc{}
+	doc.Parse(rslt.Handler, core.Block{Data: []byte(testDoc)})
+	rslt.Handler.Stop()
+	a.Empty(rslt.Errors)
+	return doc
+}
+
+// 各个包的导出数据所在的文件
+var exports = map[string]string{}
+
+// 通过 go list 获取包的导出数据，生成的服务端代码会引用当前模块中的包。
+func lookup(path string) (io.ReadCloser, error) {
+	if file, found := exports[path]; found {
+		return os.Open(file)
+	}
+
+	out, err := exec.Command("go", "list", "-export", "-f", "{{.Export}}", path).Output()
+	if err != nil {
+		return nil, err
+	}
+	exports[path] = strings.TrimSpace(string(out))
+	return os.Open(exports[path])
+}
+
+// 检测生成的代码是否能通过类型检查，并返回其中声明的顶层对象。
+func check(a *assert.Assertion, data []byte) *types.Scope {
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, "gen.go", data, parser.ParseComments)
+	a.NotError(err)
+
+	conf := &types.Config{Importer: importer.ForCompiler(fset, "gc", lookup)}
+	pkg, err := conf.Check(f.Name.Name, fset, []*ast.File{f}, nil)
+	a.NotError(err, string(data))
+	return pkg.Scope()
+}
+
+func TestGenerator_tags(t *testing.T) {
+	a := assert.New(t)
+	g := newGenerator(loadDoc(a))
+
+	user := g.doc.Types[0]
+	a.Equal(g.tags(user.Items[0]), "`json:\"id\" xml:\"id,attr\"`").
+		Equal(g.tags(user.Items[1]), "`json:\"name\" xml:\"urn:user name\"`").
+		Equal(g.tags(user.Items[2]), "`json:\"tags,omitempty\" xml:\"tags>tag,omitempty\"`").
+		Equal(g.tags(user.Items[4]), "`json:\"attrs,omitempty\" xml:\"-\"`").
+		Equal(g.tags(user.Items[5].Items[1]), "`json:\"text\" xml:\",cdata\"`")
+
+	for _, api := range g.doc.APIs {
+		if api.ID.V() == "create-user" {
+			items := api.Requests[0].Items[1]
+			a.Equal(g.tags(items), "`json:\"items\" xml:\"list>items\"`")
+		}
+	}
+}
+
+func TestGenerator_goType(t *testing.T) {
+	a := assert.New(t)
+	g := newGenerator(loadDoc(a))
+
+	a.Equal(g.typedefs[g.doc.Types[0]], "User").
+		Equal(g.typedefs[g.doc.Types[1]], "Pet")
+
+	user := g.doc.Types[0]
+	a.Equal(g.fieldType("UserID", user.Items[0]), "int64").
+		Equal(g.fieldType("UserTags", user.Items[2]), "[]string").
+		Equal(g.fieldType("UserAvatar", user.Items[3]), "[]byte").
+		Equal(g.fieldType("UserAttrs", user.Items[4]), "map[string]float64").
+		Equal(g.fieldType("UserProfile", user.Items[5]), "*UserProfile2")
+}
+
+func TestWriteComment(t *testing.T) {
+	a := assert.New(t)
+
+	var buf errwrap.Buffer
+	writeComment(&buf, "\t", "Name", "summary\n\nline", &apidoc.VersionAttribute{})
+	a.Equal(buf.String(), "\t// Name summary\n\t//\n\t// line\n")
+
+	buf.Reset()
+	writeComment(&buf, "", "", "", nil)
+	a.Empty(buf.String())
+}
+
+func TestExportedName(t *testing.T) {
+	a := assert.New(t)
+
+	a.Equal(exportedName("user"), "User")
+	a.Equal(exportedName("user_id"), "UserID")
+	a.Equal(exportedName("create-user"), "CreateUser")
+	a.Equal(exportedName("html-url"), "HTMLURL")
+	a.Equal(exportedName("1st"), "T1st")
+
+	a.Equal(unexportedName("UserID"), "userID")
+	a.Equal(unexportedName("id"), "id")
+	a.Equal(unexportedName("url-path"), "urlPath")
+	a.Equal(unexportedName("type"), "typeParam")
+}
@@ -0,0 +1,315 @@
+// SPDX-License-Identifier: MIT
+
+package gocode
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/issue9/errwrap"
+
+	"github.com/caixw/apidoc/v7/internal/ast"
+)
+
+// 生成的方法中所用到的局部变量，参数不能与这些变量同名。
+var locals = []string{
+	"c", "ctx", "query", "body", "path", "q", "resp", "data", "err", "v", "e",
+	"h", "w", "r", "params", "s", "vs", "i",
+}
+
+// 根据 API 生成的方法，客户端和服务端采用相同的方法签名。
+type method struct {
+	api       *ast.API
+	name      string
+	segments  []*segment // 路径的各个组成部分
+	query     string     // 查询参数的结构体名称，为空表示没有查询参数。
+	fields    []*field   // 查询参数结构体的各个字段
+	body      string     // 请求报文的类型，为空表示没有报文。
+	mimetype  string     // 请求报文的 mimetype
+	ret       string     // 返回值的类型，为空表示仅返回 error。
+	responses []*response
+}
+
+// 路径的组成部分，字面量或是路径参数。
+type segment struct {
+	text string // 字面量的值或是路径参数在文档中的名称
+	arg  string // 路径参数在方法中的参数名，为空表示字面量。
+	typ  string
+}
+
+// 查询参数结构体中的字段
+type field struct {
+	param *ast.Param
+	name  string
+	typ   string
+}
+
+// 返回内容的状态码及其对应的类型
+type response struct {
+	status   int
+	mimetype string
+	typ      string // 为空表示没有报文
+	err      string // 错误类型的名称，仅在非 2xx 状态码时有值。
+}
+
+func (g *generator) newMethod(api *ast.API) *method {
+	m := &method{
+		api:  api,
+		name: g.unique(apiName(api)),
+	}
+
+	used := make(map[string]bool, len(locals)+len(api.Path.Params))
+	for _, name := range locals {
+		used[name] = true
+	}
+	m.segments = g.segments(api.Path, used)
+
+	if len(api.Path.Queries) > 0 {
+		m.query, m.fields = g.writeQuery(m.name, api.Path.Queries)
+	}
+
+	if req := g.request(api.Requests); req != nil {
+		m.mimetype = g.mimetype(req)
+		m.body = g.bodyType(g.unique(m.name+"Request"), "is the request body of "+m.name, req)
+	}
+
+	m.responses = g.responses(m.name, api.Responses)
+	for _, resp := range m.responses {
+		if isSuccess(resp.status) && resp.typ != "" {
+			m.ret = resp.typ
+			break
+		}
+	}
+
+	return m
+}
+
+// 方法的签名，不包含 func 关键字和接收者。
+func (m *method) signature() string {
+	args := []string{"ctx context.Context"}
+	for _, seg := range m.segments {
+		if seg.arg != "" {
+			args = append(args, seg.arg+" "+seg.typ)
+		}
+	}
+	if m.query != "" {
+		args = append(args, "query *"+m.query)
+	}
+	if m.body != "" {
+		args = append(args, "body "+m.body)
+	}
+
+	if m.ret == "" {
+		return m.name + "(" + strings.Join(args, ", ") + ") error"
+	}
+	return m.name + "(" + strings.Join(args, ", ") + ") (" + m.ret + ", error)"
+}
+
+// 返回错误时，错误之前的返回值，仅返回错误时为空。
+func (m *method) zero() string {
+	if m.ret == "" {
+		return ""
+	}
+	return "nil, "
+}
+
+// 方法的注释内容
+func (m *method) title() string {
+	title := m.api.Method.V() + " " + m.api.Path.Path.V()
+	if s := summary(m.api.Summary, m.api.Description); s != "" {
+		title = s + "\n\n" + title
+	}
+	return title
+}
+
+// 与返回值对应的返回内容，不存在时返回 nil。
+func (m *method) success() *response {
+	for _, resp := range m.responses {
+		if isSuccess(resp.status) && (m.ret == "" || resp.typ == m.ret) {
+			return resp
+		}
+	}
+	return nil
+}
+
+// 将路径拆分成字面量和参数
+func (g *generator) segments(path *ast.Path, used map[string]bool) []*segment {
+	var segments []*segment
+	p := path.Path.V()
+	for {
+		start := strings.IndexByte(p, '{')
+		end := strings.IndexByte(p, '}')
+		if start < 0 || end < start {
+			break
+		}
+
+		if start > 0 {
+			segments = append(segments, &segment{text: p[:start]})
+		}
+
+		name := p[start+1 : end]
+		arg := unexportedName(name)
+		for i := 2; used[arg]; i++ {
+			arg = unexportedName(name) + strconv.Itoa(i)
+		}
+		used[arg] = true
+
+		typ := "string"
+		for _, param := range path.Params {
+			if param.Name.V() == name {
+				typ = g.goType(arg, param.Resolve())
+				break
+			}
+		}
+		segments = append(segments, &segment{text: name, arg: arg, typ: typ})
+
+		p = p[end+1:]
+	}
+
+	if p != "" || len(segments) == 0 {
+		segments = append(segments, &segment{text: p})
+	}
+	return segments
+}
+
+// 声明查询参数的结构体并返回其名称和字段
+func (g *generator) writeQuery(name string, queries []*ast.Param) (string, []*field) {
+	name = g.unique(name + "Query")
+	fields := make([]*field, 0, len(queries))
+
+	var buf errwrap.Buffer
+	buf.Printf("// %s is the query of %s\n", name, name[:len(name)-len("Query")])
+	buf.Printf("type %s struct {\n", name)
+	for _, q := range queries {
+		f := &field{param: q, name: exportedName(q.Name.V())}
+		f.typ = g.fieldType(name+f.name, q)
+		fields = append(fields, f)
+
+		writeComment(&buf, "\t", "", summary(q.Summary, q.Description), q.Deprecated)
+		buf.Printf("\t%s %s\n", f.name, f.typ)
+	}
+	buf.WString("}\n\n")
+	g.decls = append(g.decls, buf.String())
+
+	return name, fields
+}
+
+// 返回第一个指定了类型的请求
+//
+// 不同 mimetype 的请求一般描述的是同一结构，只取其中之一。
+func (g *generator) request(requests []*ast.Request) *ast.Request {
+	for _, r := range requests {
+		if r.Type.V() != ast.TypeNone {
+			return r
+		}
+	}
+	return nil
+}
+
+// 报文的 mimetype，未指定时采用文档中的第一个 mimetype。
+func (g *generator) mimetype(r *ast.Request) string {
+	if mt := r.Mimetype.V(); mt != "" {
+		return mt
+	}
+	if len(g.doc.Mimetypes) > 0 {
+		return g.doc.Mimetypes[0].V()
+	}
+	return ""
+}
+
+// 声明报文的类型并返回作为参数或返回值时的类型
+//
+// text 为报文未指定描述信息时，所声明类型的注释内容。
+func (g *generator) bodyType(name, text string, r *ast.Request) string {
+	p := r.Param()
+	if isStruct(p) && p.Type.TypeDef() == nil {
+		if p.Array.V() {
+			pp := *p
+			pp.Array = nil
+			g.writeStruct(name, r.Name.V(), text, &pp, r.Deprecated)
+			return "[]" + name
+		}
+		g.writeStruct(name, r.Name.V(), text, p, r.Deprecated)
+		return "*" + name
+	}
+
+	p.Optional = nil
+	t := g.fieldType(name, p)
+	if !nillable(t) && !strings.HasPrefix(t, "*") {
+		t = "*" + t
+	}
+	return t
+}
+
+// 整理返回内容，相同状态码的只取第一个。
+//
+// name 为空表示文档中的公共返回内容。
+func (g *generator) responses(name string, requests []*ast.Request) []*response {
+	responses := make([]*response, 0, len(requests))
+
+LOOP:
+	for _, r := range requests {
+		status := r.Status.V()
+		for _, resp := range responses {
+			if resp.status == status {
+				continue LOOP
+			}
+		}
+
+		resp := &response{status: status, mimetype: g.mimetype(r)}
+		if isSuccess(status) {
+			if r.Type.V() != ast.TypeNone {
+				resp.typ = g.bodyType(g.unique(name+"Response"), "is the response body of "+name, r)
+			}
+		} else {
+			prefix := name
+			if prefix == "" {
+				prefix = "Status"
+			}
+			resp.err = g.unique(prefix + strconv.Itoa(status) + "Error")
+			if r.Type.V() != ast.TypeNone {
+				text := "is the response body of " + resp.err
+				resp.typ = g.bodyType(g.unique(prefix+"Response"+strconv.Itoa(status)), text, r)
+			}
+			g.writeError(resp, name)
+			g.errors = append(g.errors, resp)
+		}
+		responses = append(responses, resp)
+	}
+
+	return responses
+}
+
+// 声明非 2xx 状态码对应的错误类型
+func (g *generator) writeError(resp *response, api string) {
+	var buf errwrap.Buffer
+	if api == "" {
+		buf.Printf("// %s is returned when the status code is %d\n", resp.err, resp.status)
+	} else {
+		buf.Printf("// %s is returned by %s when the status code is %d\n", resp.err, api, resp.status)
+	}
+	buf.Printf("type %s struct {\n", resp.err)
+	if resp.typ != "" {
+		buf.Printf("\tBody %s\n", resp.typ)
+	}
+	buf.WString("}\n\n")
+
+	buf.Printf("func (e *%s) Error() string {\n", resp.err)
+	buf.Printf("\treturn %q\n}\n\n", strconv.Itoa(resp.status)+" "+http.StatusText(resp.status))
+
+	g.decls = append(g.decls, buf.String())
+}
+
+// 公共的返回内容中非 2xx 状态码的部分
+//
+// 文档中公共的返回内容适用于所有的 API，但只有错误类型才有意义。
+func (g *generator) errorResponses() []*response {
+	requests := make([]*ast.Request, 0, len(g.doc.Responses))
+	for _, r := range g.doc.Responses {
+		if !isSuccess(r.Status.V()) {
+			requests = append(requests, r)
+		}
+	}
+	return g.responses("", requests)
+}
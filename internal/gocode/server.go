@@ -0,0 +1,495 @@
+// SPDX-License-Identifier: MIT
+
+package gocode
+
+import (
+	"bytes"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/issue9/errwrap"
+	"github.com/issue9/pack"
+
+	"github.com/caixw/apidoc/v7/internal/ast"
+	"github.com/caixw/apidoc/v7/internal/xmlenc"
+)
+
+var serverImports = []string{
+	"encoding/json",
+	"encoding/xml",
+	"fmt",
+	"net/http",
+	"strconv",
+	"strings",
+	"apidoc github.com/caixw/apidoc/v7",
+	"github.com/caixw/apidoc/v7/core",
+}
+
+// 生成的服务端中，与 API 无关的代码
+const serverCode = `type route struct {
+	method   string
+	path     string // path in the document, e.g. /users/{id}
+	segments []string
+	handle   func(w http.ResponseWriter, r *http.Request, params map[string]string)
+}
+
+type handler struct {
+	server   Server
+	validate func(method, path string, r *http.Request) (int, error)
+	routes   []*route
+}
+
+func newRoute(method, path string, handle func(http.ResponseWriter, *http.Request, map[string]string)) *route {
+	return &route{
+		method:   method,
+		path:     path,
+		segments: strings.Split(strings.Trim(path, "/"), "/"),
+		handle:   handle,
+	}
+}
+
+// ServeHTTP validates the request by the document and dispatches it to the Server
+func (h *handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	segments := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+
+	var found *route
+	var params map[string]string
+	allowed := false
+	for _, rt := range h.routes {
+		ps, ok := match(rt.segments, segments)
+		if !ok {
+			continue
+		}
+		if rt.method != r.Method {
+			allowed = true
+			continue
+		}
+		if found == nil || len(ps) < len(params) { // static segments take precedence
+			found, params = rt, ps
+		}
+	}
+
+	switch {
+	case found != nil:
+	case allowed:
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	default:
+		http.NotFound(w, r)
+		return
+	}
+
+	if status, err := h.validate(found.method, found.path, r); err != nil {
+		http.Error(w, err.Error(), status)
+		return
+	}
+	found.handle(w, r, params)
+}
+
+// match returns the path params if segments match the pattern
+func match(pattern, segments []string) (map[string]string, bool) {
+	if len(pattern) != len(segments) {
+		return nil, false
+	}
+
+	params := make(map[string]string, len(pattern))
+	for i, p := range pattern {
+		if len(p) > 2 && p[0] == '{' && p[len(p)-1] == '}' {
+			params[p[1:len(p)-1]] = segments[i]
+			continue
+		}
+		if p != segments[i] {
+			return nil, false
+		}
+	}
+	return params, true
+}
+
+// decode decodes the body according to the content type of the request
+func decode(r *http.Request, v interface{}) error {
+	ct := r.Header.Get("Content-Type")
+	switch {
+	case strings.Contains(ct, "xml"):
+		return xml.NewDecoder(r.Body).Decode(v)
+	case strings.Contains(ct, "json"):
+		return json.NewDecoder(r.Body).Decode(v)
+	default:
+		return nil
+	}
+}
+
+// write encodes v according to the mimetype
+func write(w http.ResponseWriter, status int, mimetype string, v interface{}) {
+	var data []byte
+	var err error
+	if strings.Contains(mimetype, "xml") {
+		data, err = xml.Marshal(v)
+	} else {
+		data, err = json.Marshal(v)
+	}
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if mimetype != "" {
+		w.Header().Set("Content-Type", mimetype)
+	}
+	w.WriteHeader(status)
+	w.Write(data)
+}
+
+// parseValue converts the path or query param to v
+func parseValue(s string, v interface{}) (err error) {
+	switch v := v.(type) {
+	case *string:
+		*v = s
+	case *int64:
+		*v, err = strconv.ParseInt(s, 10, 64)
+	case *float64:
+		*v, err = strconv.ParseFloat(s, 64)
+	case *bool:
+		*v, err = strconv.ParseBool(s)
+	default:
+		err = fmt.Errorf("unsupported type %T", v)
+	}
+	return err
+}
+
+`
+
+type server struct {
+	*generator
+	code    errwrap.Buffer
+	methods []*method
+}
+
+// Server 生成 Go 语言的服务端代码
+//
+// pkg 为生成代码的包名。每个 API 对应接口 Server 中的一个方法，方法签名与 Client 相同；
+// NewHandler 根据 Server 生成 http.Handler，负责路由以及根据文档验证请求和解码参数；
+// 方法返回的错误如果是文档中定义的错误类型，会以相应的状态码和报文输出。
+//
+// 文档的内容会以注释的形式输出到代码中，对生成的代码执行 build 依然可以得到相同的文档。
+func Server(doc *ast.APIDoc, pkg string) ([]byte, error) {
+	s := &server{
+		generator: newGenerator(doc, "Server", "NewHandler"),
+	}
+
+	head, err := s.head()
+	if err != nil {
+		return nil, err
+	}
+
+	s.errorResponses()
+	s.methods = make([]*method, 0, len(doc.APIs))
+	for _, api := range doc.APIs {
+		s.methods = append(s.methods, s.newMethod(api))
+	}
+
+	if err := s.writeInterface(); err != nil {
+		return nil, err
+	}
+	if err := s.writeNewHandler(); err != nil {
+		return nil, err
+	}
+	s.code.WString(serverCode)
+	s.writeError()
+	for _, m := range s.methods {
+		s.writeHandle(m)
+	}
+
+	if s.code.Err != nil {
+		return nil, s.code.Err
+	}
+	imports := serverImports
+	if len(s.methods) > 0 {
+		imports = append([]string{"context"}, serverImports...)
+	}
+	return s.source(pkg, head, imports, s.code.String())
+}
+
+// 以注释的形式输出除 api 之外的文档内容
+func (s *server) head() (string, error) {
+	d := *s.doc
+	d.APIs = nil
+	d.Created = nil
+	d.APIDoc = nil
+	data, err := encodeXML(&d)
+	if err != nil {
+		return "", err
+	}
+
+	var buf errwrap.Buffer
+	writeXMLComment(&buf, "", data)
+	return buf.String(), buf.Err
+}
+
+func (s *server) writeInterface() error {
+	s.code.WString("// Server is implemented by the service, each method corresponds to an API\n")
+	s.code.WString("type Server interface {\n")
+	for i, m := range s.methods {
+		data, err := encodeXML(m.api)
+		if err != nil {
+			return err
+		}
+
+		if i > 0 {
+			s.code.WByte('\n')
+		}
+		writeXMLComment(&s.code, "\t", data)
+		s.code.Printf("\t%s\n", m.signature())
+	}
+	s.code.WString("}\n\n")
+	return nil
+}
+
+// 文档以 Pack 的格式保存在常量 doc 中，由 apidoc.Validator 负责验证请求。
+func (s *server) writeNewHandler() error {
+	d := *s.doc
+	if d.APIDoc == nil {
+		d.APIDoc = &ast.APIDocVersionAttribute{Value: xmlenc.String{Value: ast.Version}}
+	}
+	data, err := xmlenc.Encode("\t", &d, "", "")
+	if err != nil {
+		return err
+	}
+	packed, err := pack.String(string(trimCDATAIndent(data)))
+	if err != nil {
+		return err
+	}
+
+	s.code.WString("// doc is the document packed by apidoc.Pack\n")
+	s.code.Printf("const doc = `%s`\n\n", packed)
+
+	s.code.WString(`// NewHandler returns a http.Handler which validates the requests by the document
+// and dispatches them to s
+//
+// h receives the messages of parsing the document.
+func NewHandler(h *core.MessageHandler, s Server) (http.Handler, error) {
+	data, err := apidoc.Unpack(doc)
+	if err != nil {
+		return nil, err
+	}
+	validate, err := apidoc.Validator(h, []byte(data))
+	if err != nil {
+		return nil, err
+	}
+
+	srv := &handler{server: s, validate: validate}
+	srv.routes = []*route{
+`)
+	for _, m := range s.methods {
+		s.code.Printf("\t\tnewRoute(%q, %q, srv.handle%s),\n", m.api.Method.V(), m.api.Path.Path.V(), m.name)
+	}
+	s.code.WString("\t}\n\treturn srv, nil\n}\n\n")
+	return nil
+}
+
+// 将文档中定义的错误类型以相应的状态码输出，其它错误输出 500。
+func (s *server) writeError() {
+	s.code.WString("func (h *handler) writeError(w http.ResponseWriter, err error) {\n")
+	if len(s.errors) > 0 {
+		// 所有的错误类型都没有报文时，不能声明变量 e，否则编译器会报未使用的变量。
+		bind := false
+		for _, e := range s.errors {
+			bind = bind || e.typ != ""
+		}
+		if bind {
+			s.code.WString("\tswitch e := err.(type) {\n")
+		} else {
+			s.code.WString("\tswitch err.(type) {\n")
+		}
+
+		for _, e := range s.errors {
+			s.code.Printf("\tcase *%s:\n", e.err)
+			if e.typ == "" {
+				s.code.Printf("\t\tw.WriteHeader(%d)\n", e.status)
+			} else {
+				s.code.Printf("\t\twrite(w, %d, %q, e.Body)\n", e.status, e.mimetype)
+			}
+			s.code.WString("\t\treturn\n")
+		}
+		s.code.WString("\t}\n")
+	}
+	s.code.WString("\thttp.Error(w, err.Error(), http.StatusInternalServerError)\n}\n\n")
+}
+
+// 解码请求中的参数并调用 Server 中对应的方法
+func (s *server) writeHandle(m *method) {
+	s.code.Printf("func (h *handler) handle%s(w http.ResponseWriter, r *http.Request, params map[string]string) {\n", m.name)
+
+	args := []string{"r.Context()"}
+	for _, seg := range m.segments {
+		if seg.arg == "" {
+			continue
+		}
+		args = append(args, seg.arg)
+
+		s.code.Printf("\tvar %s %s\n", seg.arg, seg.typ)
+		if isScalar(seg.typ) {
+			s.code.Printf("\tif err := parseValue(params[%q], &%s); err != nil {\n", seg.text, seg.arg)
+			s.code.WString("\t\thttp.Error(w, err.Error(), http.StatusBadRequest)\n\t\treturn\n\t}\n")
+		}
+	}
+
+	if m.query != "" {
+		args = append(args, "query")
+		s.writeQueryValues(m)
+	}
+
+	if m.body != "" {
+		args = append(args, "body")
+		arg := "body"
+		if nillable(m.body) {
+			s.code.Printf("\tvar body %s\n", m.body)
+			arg = "&body"
+		} else {
+			s.code.Printf("\tbody := new(%s)\n", m.body[1:])
+		}
+		s.code.Printf("\tif err := decode(r, %s); err != nil {\n", arg)
+		s.code.WString("\t\thttp.Error(w, err.Error(), http.StatusBadRequest)\n\t\treturn\n\t}\n")
+	}
+
+	status, mimetype := http.StatusOK, ""
+	if resp := m.success(); resp != nil {
+		status, mimetype = resp.status, resp.mimetype
+	}
+
+	call := "h.server." + m.name + "(" + strings.Join(args, ", ") + ")"
+	if m.ret == "" {
+		s.code.Printf("\tif err := %s; err != nil {\n", call)
+		s.code.WString("\t\th.writeError(w, err)\n\t\treturn\n\t}\n")
+		s.code.Printf("\tw.WriteHeader(%d)\n}\n\n", status)
+		return
+	}
+
+	s.code.Printf("\tv, err := %s\n", call)
+	s.code.WString("\tif err != nil {\n\t\th.writeError(w, err)\n\t\treturn\n\t}\n")
+	s.code.Printf("\twrite(w, %d, %q, v)\n}\n\n", status, mimetype)
+}
+
+// 从 url.Values 中解析查询参数，仅支持基本类型及其数组。
+func (s *server) writeQueryValues(m *method) {
+	s.code.Printf("\tquery := &%s{}\n\tq := r.URL.Query()\n", m.query)
+	for _, f := range m.fields {
+		name := strconv.Quote(f.param.Name.V())
+		field := "query." + f.name
+		t := f.typ
+
+		switch {
+		case strings.HasPrefix(t, "[]") && isScalar(t[2:]):
+			s.code.Printf("\tif vs := q[%s]; len(vs) > 0 {\n", name)
+			if f.param.ArrayStyle.V() {
+				s.code.WString("\t\tvs = strings.Split(vs[0], \",\")\n")
+			}
+			s.code.Printf("\t\t%s = make(%s, len(vs))\n", field, t)
+			s.code.WString("\t\tfor i, s := range vs {\n")
+			s.code.Printf("\t\t\tif err := parseValue(s, &%s[i]); err != nil {\n", field)
+			s.code.WString("\t\t\t\thttp.Error(w, err.Error(), http.StatusBadRequest)\n\t\t\t\treturn\n\t\t\t}\n\t\t}\n\t}\n")
+		case strings.HasPrefix(t, "*") && isScalar(t[1:]):
+			s.code.Printf("\tif s := q.Get(%s); s != \"\" {\n", name)
+			s.code.Printf("\t\t%s = new(%s)\n", field, t[1:])
+			s.code.Printf("\t\tif err := parseValue(s, %s); err != nil {\n", field)
+			s.code.WString("\t\t\thttp.Error(w, err.Error(), http.StatusBadRequest)\n\t\t\treturn\n\t\t}\n\t}\n")
+		case isScalar(t):
+			s.code.Printf("\tif s := q.Get(%s); s != \"\" {\n", name)
+			s.code.Printf("\t\tif err := parseValue(s, &%s); err != nil {\n", field)
+			s.code.WString("\t\t\thttp.Error(w, err.Error(), http.StatusBadRequest)\n\t\t\treturn\n\t\t}\n\t}\n")
+		}
+	}
+}
+
+// 可以由 parseValue 解析的类型
+func isScalar(t string) bool {
+	return t == "string" || t == "int64" || t == "float64" || t == "bool"
+}
+
+// 将文档转换成用于注释的 XML 内容
+//
+// apidoc 的解析器不会对实体进行还原，而 > 在 XML 中本身就是合法的字符，
+// 还原成原始字符可以保证 xml-wrapped 等属性在重新解析之后依然保持不变。
+func encodeXML(v interface{}) ([]byte, error) {
+	data, err := xmlenc.Encode("\t", v, "", "")
+	if err != nil {
+		return nil, err
+	}
+	return trimCDATAIndent(bytes.ReplaceAll(data, []byte("&gt;"), []byte(">"))), nil
+}
+
+// 去掉 CDATA 中除首行之外各行的公共缩进，仅包含空白字符的行会被清空。
+//
+// 从生成的代码中解析文档时，CDATA 的各行都会带上注释符号所在列的缩进，
+// 去掉这些缩进才能保证 CDATA 的内容原样输出，多次生成的代码也保持一致。
+func trimCDATAIndent(data []byte) []byte {
+	const cdataStart, cdataEnd = "<![CDATA[", "]]>"
+
+	var buf bytes.Buffer
+	for {
+		start := bytes.Index(data, []byte(cdataStart))
+		if start < 0 {
+			break
+		}
+		start += len(cdataStart)
+		end := bytes.Index(data[start:], []byte(cdataEnd))
+		if end < 0 {
+			break
+		}
+		end += start
+
+		buf.Write(data[:start])
+		buf.WriteString(trimIndent(string(data[start:end])))
+		data = data[end:]
+	}
+	buf.Write(data)
+
+	return buf.Bytes()
+}
+
+func trimIndent(s string) string {
+	lines := strings.Split(s, "\n")
+	if len(lines) < 2 {
+		return s
+	}
+
+	var indent string
+	found := false
+	for _, line := range lines[1:] {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+
+		i := len(line) - len(strings.TrimLeft(line, " \t"))
+		if !found {
+			indent, found = line[:i], true
+			continue
+		}
+		for j := 0; j < len(indent); j++ {
+			if j >= i || indent[j] != line[j] {
+				indent = indent[:j]
+				break
+			}
+		}
+	}
+
+	for i, line := range lines[1:] {
+		if strings.TrimSpace(line) == "" {
+			lines[i+1] = ""
+		} else {
+			lines[i+1] = line[len(indent):]
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// 以单行注释的形式输出 XML 内容，空行仅输出注释符号。
+func writeXMLComment(buf *errwrap.Buffer, prefix string, data []byte) {
+	for _, line := range strings.Split(string(data), "\n") {
+		buf.WString(prefix).WString("//")
+		if line != "" {
+			buf.WByte(' ').WString(line)
+		}
+		buf.WByte('\n')
+	}
+}
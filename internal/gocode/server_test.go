@@ -0,0 +1,119 @@
+// SPDX-License-Identifier: MIT
+
+package gocode
+
+import (
+	"go/types"
+	"testing"
+
+	"github.com/issue9/assert"
+
+	"github.com/caixw/apidoc/v7/core"
+	"github.com/caixw/apidoc/v7/core/messagetest"
+	"github.com/caixw/apidoc/v7/internal/ast"
+	"github.com/caixw/apidoc/v7/internal/ast/asttest"
+	"github.com/caixw/apidoc/v7/internal/lang"
+	"github.com/caixw/apidoc/v7/internal/xmlenc"
+)
+
+func TestServer(t *testing.T) {
+	a := assert.New(t)
+
+	data, err := Server(asttest.Get(), "server")
+	a.NotError(err).NotEmpty(data)
+	check(a, data)
+
+	doc := loadDoc(a)
+	data, err = Server(doc, "users")
+	a.NotError(err).NotEmpty(data)
+	scope := check(a, data)
+
+	server := scope.Lookup("Server").Type().Underlying().(*types.Interface)
+	a.Equal(server.NumMethods(), 4)
+	method := func(name string) string {
+		obj, _, _ := types.LookupFieldOrMethod(server, false, nil, name)
+		a.NotNil(obj, name)
+		return obj.Type().String()
+	}
+	a.Equal(method("GetUsers"), "func(ctx context.Context, query *users.GetUsersQuery) ([]users.User, error)")
+	a.Equal(method("DeleteUsersByID"), "func(ctx context.Context, id int64) error")
+	a.NotNil(scope.Lookup("NewHandler"))
+
+	// 生成的代码中的注释可以还原成相同的文档
+	rslt := messagetest.NewMessageHandler()
+	d := &ast.APIDoc{}
+	d.ParseBlocks(rslt.Handler, func(blocks chan core.Block) {
+		lang.Parse(rslt.Handler, "go", core.Block{Data: data}, blocks)
+	})
+	rslt.Handler.Stop()
+	a.Empty(rslt.Errors)
+
+	want, err := xmlenc.Encode("\t", doc, "", "")
+	a.NotError(err)
+	got, err := xmlenc.Encode("\t", d, "", "")
+	a.NotError(err)
+	a.Equal(string(got), string(want))
+}
+
+func TestServer_empty(t *testing.T) {
+	a := assert.New(t)
+
+	rslt := messagetest.NewMessageHandler()
+	doc := &ast.APIDoc{}
+	doc.Parse(rslt.Handler, core.Block{Data: []byte(`<apidoc version="1.0.0">
+	<title>empty</title>
+	<mimetype>application/json</mimetype>
+	<response status="401" mimetype="application/json" />
+</apidoc>`)})
+	rslt.Handler.Stop()
+	a.Empty(rslt.Errors)
+
+	data, err := Server(doc, "empty")
+	a.NotError(err)
+	check(a, data)
+}
+
+// 由生成的代码再次生成的代码应该与之相同
+func TestServer_roundTrip(t *testing.T) {
+	a := assert.New(t)
+
+	rslt := messagetest.NewMessageHandler()
+	doc := &ast.APIDoc{}
+	doc.Parse(rslt.Handler, core.Block{Data: []byte(`<apidoc version="1.0.0">
+	<title>title</title>
+	<description type="markdown"><![CDATA[
+# title
+
+  - item
+	code
+]]></description>
+	<mimetype>application/json</mimetype>
+	<api method="GET">
+		<path path="/users" />
+		<description type="markdown"><![CDATA[line1
+    indented
+line3]]></description>
+		<response status="200" type="string" mimetype="application/json" />
+	</api>
+</apidoc>`)})
+	rslt.Handler.Stop()
+	a.Empty(rslt.Errors)
+
+	want, err := Server(doc, "users")
+	a.NotError(err)
+	check(a, want)
+	a.Contains(string(want), "// # title\n//\n//   - item\n// \tcode\n// ]]></description>\n").
+		Contains(string(want), "\t// \t<description type=\"markdown\"><![CDATA[line1\n\t//     indented\n\t// line3]]></description>\n")
+
+	rslt = messagetest.NewMessageHandler()
+	d := &ast.APIDoc{}
+	d.ParseBlocks(rslt.Handler, func(blocks chan core.Block) {
+		lang.Parse(rslt.Handler, "go", core.Block{Data: want}, blocks)
+	})
+	rslt.Handler.Stop()
+	a.Empty(rslt.Errors)
+
+	got, err := Server(d, "users")
+	a.NotError(err)
+	a.Equal(string(got), string(want))
+}
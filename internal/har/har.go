@@ -0,0 +1,82 @@
+// SPDX-License-Identifier: MIT
+
+// Package har 实现 HAR(HTTP Archive) 1.2 的相关数据类型及导入功能
+//
+// http://www.softwareishard.com/blog/har-12-spec/
+package har
+
+// HAR 文件的根对象
+type HAR struct {
+	Log *Log `json:"log"`
+}
+
+// Log 记录的所有内容
+type Log struct {
+	Version string   `json:"version"`
+	Creator *Creator `json:"creator,omitempty"`
+	Pages   []*Page  `json:"pages,omitempty"`
+	Entries []*Entry `json:"entries"`
+}
+
+// Creator 生成 HAR 文件的程序
+type Creator struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+}
+
+// Page 页面信息
+type Page struct {
+	ID    string `json:"id"`
+	Title string `json:"title"`
+}
+
+// Entry 一次请求的记录
+type Entry struct {
+	PageRef  string    `json:"pageref,omitempty"`
+	Request  *Request  `json:"request"`
+	Response *Response `json:"response"`
+
+	// 浏览器记录的资源类型，比如 xhr、fetch、document、script 等，
+	// 并非标准内容，Chrome 和 Firefox 等浏览器导出的文件中会包含该字段。
+	ResourceType string `json:"_resourceType,omitempty"`
+}
+
+// Request 请求内容
+type Request struct {
+	Method      string      `json:"method"`
+	URL         string      `json:"url"`
+	Headers     []*KeyValue `json:"headers"`
+	QueryString []*KeyValue `json:"queryString"`
+	PostData    *PostData   `json:"postData,omitempty"`
+}
+
+// PostData 请求的报文
+//
+// Params 和 Text 只能二选一，Params 用于表单内容。
+type PostData struct {
+	MimeType string      `json:"mimeType"`
+	Params   []*KeyValue `json:"params,omitempty"`
+	Text     string      `json:"text,omitempty"`
+}
+
+// Response 返回的内容
+type Response struct {
+	Status     int         `json:"status"`
+	StatusText string      `json:"statusText"`
+	Headers    []*KeyValue `json:"headers"`
+	Content    *Content    `json:"content"`
+}
+
+// Content 返回的报文
+type Content struct {
+	Size     int    `json:"size"`
+	MimeType string `json:"mimeType"`
+	Text     string `json:"text,omitempty"`
+	Encoding string `json:"encoding,omitempty"` // 仅支持 base64
+}
+
+// KeyValue 表示报头、查询参数等键值对
+type KeyValue struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
@@ -0,0 +1,146 @@
+// SPDX-License-Identifier: MIT
+
+package har
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/caixw/apidoc/v7/core"
+	"github.com/caixw/apidoc/v7/internal/ast"
+	"github.com/caixw/apidoc/v7/internal/infer"
+	"github.com/caixw/apidoc/v7/internal/locale"
+)
+
+// 被当作 API 请求的资源类型
+var apiResourceTypes = []string{"xhr", "fetch"}
+
+// Import 从 HAR 文件导入文档
+//
+// HAR 中记录了浏览器的所有请求，仅 xhr 和 fetch 类型的请求会被当作 API；
+// 未记录资源类型时，则以返回内容是否为 JSON 或 XML 以及请求方法是否为 GET 进行判断。
+func Import(h *core.MessageHandler, uri core.URI) (*ast.APIDoc, error) {
+	data, err := uri.ReadAll(nil)
+	if err != nil {
+		return nil, err
+	}
+
+	har := &HAR{}
+	if err := json.Unmarshal(data, har); err != nil {
+		return nil, core.Location{URI: uri}.WithError(err)
+	}
+	if har.Log == nil {
+		return nil, core.Location{URI: uri}.NewError(locale.ErrIsEmpty, "log").WithField("#/log")
+	}
+
+	title := strings.TrimSuffix(path.Base(string(uri)), path.Ext(string(uri)))
+	if len(har.Log.Pages) > 0 && har.Log.Pages[0].Title != "" {
+		title = har.Log.Pages[0].Title
+	}
+
+	i := &importer{
+		h:   h,
+		uri: uri,
+		b:   infer.New(h, uri, title),
+	}
+	for index, entry := range har.Log.Entries {
+		if isAPI(entry) {
+			field := "#/log/entries/" + strconv.Itoa(index)
+			if s := i.sample(field, entry); s != nil {
+				i.b.Add(field, s)
+			}
+		}
+	}
+
+	return i.b.Doc(), nil
+}
+
+type importer struct {
+	h   *core.MessageHandler
+	uri core.URI
+	b   *infer.Builder
+}
+
+func (i *importer) warning(field string) {
+	i.h.Warning(core.Location{URI: i.uri}.NewError(locale.ErrIgnored).WithField(field))
+}
+
+func isAPI(entry *Entry) bool {
+	if entry.Request == nil {
+		return false
+	}
+
+	if entry.ResourceType != "" {
+		for _, typ := range apiResourceTypes {
+			if typ == entry.ResourceType {
+				return true
+			}
+		}
+		return false
+	}
+
+	if entry.Request.Method != http.MethodGet {
+		return true
+	}
+	if resp := entry.Response; resp != nil && resp.Content != nil {
+		mt := resp.Content.MimeType
+		return strings.Contains(mt, "json") || strings.Contains(mt, "xml")
+	}
+	return false
+}
+
+// 地址无法解析时返回 nil
+func (i *importer) sample(field string, entry *Entry) *infer.Sample {
+	req := entry.Request
+
+	u, err := url.Parse(req.URL)
+	if err != nil {
+		i.warning(field + "/request/url")
+		return nil
+	}
+
+	s := &infer.Sample{
+		Method: req.Method,
+		Path:   u.Path,
+	}
+	if u.Host != "" {
+		s.Server = u.Scheme + "://" + u.Host
+	}
+
+	for _, kv := range req.QueryString {
+		s.Queries = append(s.Queries, &infer.KeyValue{Name: kv.Name, Value: kv.Value})
+	}
+
+	if post := req.PostData; post != nil {
+		s.Mimetype = post.MimeType
+		s.Body = post.Text
+		for _, kv := range post.Params {
+			s.Form = append(s.Form, &infer.KeyValue{Name: kv.Name, Value: kv.Value})
+		}
+	}
+
+	// 状态码为 0 表示请求未完成，没有返回内容。
+	if resp := entry.Response; resp != nil && resp.Status > 0 {
+		r := &infer.Response{Status: resp.Status}
+		if c := resp.Content; c != nil {
+			r.Mimetype = c.MimeType
+			r.Body = c.Text
+			if c.Encoding == "base64" {
+				if data, err := base64.StdEncoding.DecodeString(c.Text); err == nil {
+					r.Body = string(data)
+				} else {
+					r.Body = ""
+					i.warning(field + "/response/content/text")
+				}
+			}
+		}
+		s.Responses = []*infer.Response{r}
+	}
+
+	return s
+}
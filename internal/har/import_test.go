@@ -0,0 +1,74 @@
+// SPDX-License-Identifier: MIT
+
+package har
+
+import (
+	"testing"
+
+	"github.com/issue9/assert"
+
+	"github.com/caixw/apidoc/v7/core"
+	"github.com/caixw/apidoc/v7/core/messagetest"
+	"github.com/caixw/apidoc/v7/internal/ast"
+	"github.com/caixw/apidoc/v7/internal/xmlenc"
+)
+
+func TestImport(t *testing.T) {
+	a := assert.New(t)
+
+	rslt := messagetest.NewMessageHandler()
+	d, err := Import(rslt.Handler, core.FileURI("./testdata/capture.har"))
+	rslt.Handler.Stop()
+	a.NotError(err).NotNil(d)
+	a.Empty(rslt.Errors).Equal(1, len(rslt.Warns))
+	a.Equal(rslt.Warns[0].(*core.Error).Field, "#/log/entries/5/response/content/text")
+
+	a.Equal(d.Title.V(), "Admin").
+		Equal(1, len(d.Servers)).
+		Equal(d.Servers[0].URL.V(), "https://api.example.com").
+		Equal(3, len(d.APIs))
+
+	get := d.APIs[0]
+	a.Equal(get.Method.V(), "GET").
+		Equal(get.Path.Path.V(), "/orders/{id}").
+		Equal(1, len(get.Path.Queries)).
+		True(get.Path.Queries[0].Optional.V()).
+		Equal(2, len(get.Responses))
+	a.Equal(get.Responses[0].Items[1].Type.V(), ast.TypeFloat).
+		True(get.Responses[0].Items[2].Array.V())
+	a.Equal(get.Responses[1].Status.V(), 404).
+		Equal(get.Responses[1].Items[0].Name.V(), "message")
+
+	patch := d.APIs[1]
+	a.Equal(patch.Method.V(), "PATCH").
+		Equal(patch.Requests[0].Items[0].Name.V(), "status").
+		Empty(patch.Responses)
+
+	data, err := xmlenc.Encode("\t", d, "", "")
+	a.NotError(err).NotEmpty(data)
+	rslt = messagetest.NewMessageHandler()
+	doc := &ast.APIDoc{}
+	doc.Parse(rslt.Handler, core.Block{Data: data})
+	rslt.Handler.Stop()
+	a.Empty(rslt.Errors)
+
+	// 文件不存在
+	rslt = messagetest.NewMessageHandler()
+	d, err = Import(rslt.Handler, core.FileURI("./testdata/not-exists.har"))
+	rslt.Handler.Stop()
+	a.Error(err).Nil(d)
+}
+
+func TestIsAPI(t *testing.T) {
+	a := assert.New(t)
+
+	a.False(isAPI(&Entry{}))
+	a.True(isAPI(&Entry{ResourceType: "xhr", Request: &Request{Method: "GET"}}))
+	a.False(isAPI(&Entry{ResourceType: "script", Request: &Request{Method: "POST"}}))
+	a.True(isAPI(&Entry{Request: &Request{Method: "POST"}}))
+	a.False(isAPI(&Entry{Request: &Request{Method: "GET"}}))
+	a.True(isAPI(&Entry{
+		Request:  &Request{Method: "GET"},
+		Response: &Response{Content: &Content{MimeType: "application/xml"}},
+	}))
+}
@@ -0,0 +1,252 @@
+// SPDX-License-Identifier: MIT
+
+// Package html 将文档渲染成静态的 HTML 页面
+//
+// 与 apidoc+xml 依赖浏览器执行 XSLT 不同，页面内容在生成时即已渲染完成，
+// 页面结构与 apidoc.xsl 的输出相同，样式和脚本则以内联的方式嵌入页面，
+// 所以可以直接通过 file:// 打开。
+package html
+
+import (
+	"bytes"
+	"encoding/base64"
+	"html/template"
+	"strings"
+
+	"golang.org/x/text/language"
+	"golang.org/x/text/language/display"
+
+	"github.com/caixw/apidoc/v7/core"
+	"github.com/caixw/apidoc/v7/internal/ast"
+	"github.com/caixw/apidoc/v7/internal/docs"
+	"github.com/caixw/apidoc/v7/internal/locale"
+	"github.com/caixw/apidoc/v7/internal/slug"
+)
+
+// Ext 生成文件的扩展名
+const Ext = ".html"
+
+type builder struct {
+	doc   *ast.APIDoc
+	index string // 首页的文件名
+	tpl   *template.Template
+	files map[*ast.Tag]string // 各个标签对应的页面文件名
+
+	tags []language.Tag // 所有支持的语言
+	lang language.Tag   // 文档的语言，如果不被支持，则为 tags 的第一个元素。
+	tr   *translations  // 页面中的翻译内容，与 apidoc.xsl 共用 locales.xsl。
+
+	style  template.CSS
+	script template.JS
+	icon   template.URL
+}
+
+// HTML 将 doc 渲染成 HTML 页面
+//
+// index 为首页的文件名，标签页面通过该值链接回首页。
+// 返回的 page 为首页的内容，包含文档的基本信息、指向各个标签页面的链接以及未指定标签的 API；
+// files 为各个标签对应的页面，键名为标签名的 slug 加上 Ext 组成的文件名，
+// 各个标签的文件名互不相同，也不会与 index 相同。
+func HTML(doc *ast.APIDoc, index string) (page []byte, files map[string][]byte, err error) {
+	b, err := newBuilder(doc, index)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	apis := make([]*ast.API, 0, len(doc.APIs))
+	for _, api := range doc.APIs {
+		if len(api.Tags) == 0 {
+			apis = append(apis, api)
+		}
+	}
+	if page, err = b.render(nil, apis); err != nil {
+		return nil, nil, err
+	}
+
+	files = make(map[string][]byte, len(doc.Tags))
+	for _, tag := range doc.Tags {
+		apis := make([]*ast.API, 0, len(doc.APIs))
+		for _, api := range doc.APIs {
+			for _, t := range api.Tags {
+				if t.V() == tag.Name.V() {
+					apis = append(apis, api)
+					break
+				}
+			}
+		}
+
+		data, err := b.render(tag, apis)
+		if err != nil {
+			return nil, nil, err
+		}
+		files[b.filename(tag)] = data
+	}
+
+	return page, files, nil
+}
+
+func newBuilder(doc *ast.APIDoc, index string) (*builder, error) {
+	tags := locale.Tags()
+	_, i, _ := language.NewMatcher(tags).Match(language.Make(doc.Lang.V()))
+
+	b := &builder{
+		doc:   doc,
+		index: index,
+		tags:  tags,
+		lang:  tags[i],
+		files: make(map[*ast.Tag]string, len(doc.Tags)),
+	}
+
+	names := make([]string, 0, len(doc.Tags))
+	for _, tag := range doc.Tags {
+		names = append(names, tag.Name.V())
+	}
+	for i, name := range slug.Unique(names, "tag", strings.TrimSuffix(index, Ext)) {
+		b.files[doc.Tags[i]] = name + Ext
+	}
+
+	tr, err := loadTranslations()
+	if err != nil {
+		return nil, err
+	}
+	b.tr = tr
+
+	if f := docs.Embedded(ast.MajorVersion + "/apidoc.css"); f != nil {
+		b.style = template.CSS(f.Content)
+	}
+	if f := docs.Embedded(ast.MajorVersion + "/apidoc.js"); f != nil {
+		b.script = template.JS(f.Content)
+	}
+
+	if doc.Logo.V() != "" {
+		b.icon = template.URL(doc.Logo.V())
+	} else if f := docs.Embedded("icon.svg"); f != nil {
+		b.icon = template.URL("data:image/svg+xml;base64," + base64.StdEncoding.EncodeToString(f.Content))
+	}
+
+	tpl, err := template.New("page").Funcs(b.funcs()).Parse(tpl)
+	if err != nil {
+		return nil, err
+	}
+	b.tpl = tpl
+
+	return b, nil
+}
+
+func (b *builder) funcs() template.FuncMap {
+	return template.FuncMap{
+		"t":           b.t,
+		"text":        b.text,
+		"generator":   b.generator,
+		"richtext":    richtext,
+		"description": description,
+		"filename":    b.filename,
+	}
+}
+
+// 返回所有语言的翻译内容，非当前语言的内容处于隐藏状态，由 apidoc.js 负责切换。
+//
+// key 为 locales.xsl 中去掉 locale- 前缀之后的变量名。
+func (b *builder) t(key string) (template.HTML, error) {
+	texts, found := b.tr.texts[key]
+	if !found {
+		return "", locale.NewError(locale.ErrInvalidValue)
+	}
+
+	return b.locales(func(id string) string {
+		return template.HTMLEscapeString(texts[strings.ToLower(id)])
+	}), nil
+}
+
+// 返回当前语言的翻译内容，用于无法包含 HTML 的属性值中。
+func (b *builder) text(key string) (string, error) {
+	texts, found := b.tr.texts[key]
+	if !found {
+		return "", locale.NewError(locale.ErrInvalidValue)
+	}
+	return texts[strings.ToLower(b.lang.String())], nil
+}
+
+func (b *builder) generator() template.HTML {
+	link := `<a href="` + core.OfficialURL + `">` + core.Name + `</a>`
+	return b.locales(func(id string) string {
+		text := template.HTMLEscapeString(locale.Translate(id, locale.GeneratorBy, "%s"))
+		return strings.Replace(text, "%s", link, 1)
+	})
+}
+
+// 与 locales.xsl 中的 build-locale 相同，
+// data-locale 属性表示该元素是一个本地化信息元素，JS 代码通过该标记切换语言。
+func (b *builder) locales(f func(id string) string) template.HTML {
+	var buf strings.Builder
+	for _, tag := range b.tags {
+		class := "hidden"
+		if tag == b.lang {
+			class = ""
+		}
+		buf.WriteString(`<span data-locale="true" lang="` + tag.String() + `" class="` + class + `">`)
+		buf.WriteString(f(tag.String()))
+		buf.WriteString("</span>")
+	}
+	return template.HTML(buf.String())
+}
+
+// tag 为空表示首页
+func (b *builder) render(tag *ast.Tag, apis []*ast.API) ([]byte, error) {
+	p := &page{
+		Doc:    b.doc,
+		Lang:   b.lang.String(),
+		Title:  b.doc.Title.V(),
+		Tag:    tag,
+		Icon:   b.icon,
+		Style:  b.style,
+		Script: b.script,
+		APIs:   make([]*apiView, 0, len(apis)),
+	}
+
+	for _, t := range b.tags {
+		name := b.tr.names[strings.ToLower(t.String())]
+		if name == "" {
+			name = display.Self.Name(t)
+		}
+		p.Locales = append(p.Locales, &localeView{
+			ID:      t.String(),
+			Name:    name,
+			Checked: t == b.lang,
+		})
+	}
+
+	if tag != nil {
+		p.Index = b.index
+		if title := tag.Title.V(); title != "" {
+			p.Title += " - " + title
+		}
+	}
+
+	for _, api := range apis {
+		if !containsString(p.Methods, api.Method.V()) {
+			p.Methods = append(p.Methods, api.Method.V())
+		}
+		p.APIs = append(p.APIs, b.newAPIView(api))
+	}
+
+	buf := new(bytes.Buffer)
+	if err := b.tpl.Execute(buf, p); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// 标签对应的页面文件名
+func (b *builder) filename(tag *ast.Tag) string {
+	return b.files[tag]
+}
+
+func containsString(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,216 @@
+// SPDX-License-Identifier: MIT
+
+package html
+
+import (
+	"testing"
+
+	"github.com/issue9/assert"
+
+	"github.com/caixw/apidoc/v7/core"
+	"github.com/caixw/apidoc/v7/core/messagetest"
+	"github.com/caixw/apidoc/v7/internal/ast"
+	"github.com/caixw/apidoc/v7/internal/ast/asttest"
+)
+
+const testDoc = `<apidoc version="1.0.0" lang="cmn-Hant">
+	<title>title</title>
+	<description type="html"><![CDATA[<p>doc <strong>desc</strong></p>]]></description>
+	<mimetype>application/json</mimetype>
+	<server name="prod" url="https://example.com" summary="prod" />
+	<tag name="user" title="用户" />
+	<type name="User" type="object" summary="user">
+		<param name="id" type="number" summary="id" />
+		<param name="group" type="#Group" optional="true" summary="group" />
+	</type>
+	<type name="Group" type="object" summary="group">
+		<param name="name" type="string" summary="name" />
+	</type>
+	<api method="GET">
+		<path path="/users/{id}">
+			<param name="id" type="number" summary="id" />
+			<query name="sex" type="string" default="male" optional="true" summary="sex">
+				<enum value="male" summary="male" />
+				<enum value="female" deprecated="1.0.0" summary="female" />
+			</query>
+		</path>
+		<tag>user</tag>
+		<server>prod</server>
+		<description type="markdown"><![CDATA[**markdown** <desc>]]></description>
+		<response status="200" type="object" mimetype="application/json">
+			<param name="user" type="#User" summary="user" />
+			<param name="old" type="string" deprecated="1.0.0" summary="old" />
+			<example mimetype="application/json"><![CDATA[{"id":1}]]></example>
+		</response>
+	</api>
+	<api method="DELETE" deprecated="1.0.0">
+		<path path="/users" />
+		<response status="204" />
+	</api>
+</apidoc>`
+
+func getTestDoc(a *assert.Assertion) *ast.APIDoc {
+	rslt := messagetest.NewMessageHandler()
+	doc := &ast.APIDoc{}
+	doc.Parse(rslt.Handler, core.Block{Data: []byte(testDoc)})
+	rslt.Handler.Stop()
+	a.Empty(rslt.Errors, "%v", rslt.Errors)
+	return doc
+}
+
+func TestHTML(t *testing.T) {
+	a := assert.New(t)
+
+	page, files, err := HTML(asttest.Get(), "index.html")
+	a.NotError(err).NotEmpty(page).NotEmpty(files)
+
+	page, files, err = HTML(getTestDoc(a), "index.html")
+	a.NotError(err).NotEmpty(page)
+	a.Equal(1, len(files))
+
+	index := string(page)
+	a.Contains(index, `<html lang="cmn-Hant">`).
+		Contains(index, "<title>title</title>").
+		Contains(index, `<div class="content"><p>doc <strong>desc</strong></p></div>`).
+		Contains(index, `<li data-server="prod" role="menuitemcheckbox">`).
+		Contains(index, `<a href="user.html">用户</a>`).
+		Contains(index, `<details id="DELETE-users" class="api" data-method="DELETE" data-tag="" data-server="">`).
+		Contains(index, `<span class="del" title="1.0.0">/users</span>`).
+		NotContains(index, "/users/{id}")
+
+	// 本地化内容，当前语言可见，其它语言隐藏。
+	a.Contains(index, `<span data-locale="true" lang="cmn-Hant" class="">展開</span>`).
+		Contains(index, `<span data-locale="true" lang="cmn-Hans" class="hidden">展开</span>`).
+		Contains(index, `title="返回頂部"`)
+
+	// 内嵌的样式和脚本
+	a.Contains(index, "--method-get-color").
+		Contains(index, "function registerFilter(type)").
+		Contains(index, `src="data:image/svg&#43;xml;base64,`)
+
+	user := string(files["user.html"])
+	a.Contains(user, "<title>title - 用户</title>").
+		Contains(user, `<a href="index.html">title</a>`).
+		Contains(user, `<details id="prodGET-users-_id_" class="api" data-method="GET" data-tag="user" data-server="prod">`).
+		Contains(user, `<a class="link" href="#prodGET-users-_id_">`).
+		Contains(user, `<div class="description"><pre>**markdown** &lt;desc&gt;</pre></div>`).
+		Contains(user, `<th><span class="parent-type">user.group.</span>name</th>`).
+		Contains(user, `<tr class="del" title="1.0.0">`).
+		Contains(user, `<li class="del" title="1.0.0">female: female</li>`).
+		Contains(user, `<pre class="example">{&#34;id&#34;:1}</pre>`).
+		NotContains(user, `data-method="DELETE"`)
+}
+
+func TestHTML_filename(t *testing.T) {
+	a := assert.New(t)
+
+	rslt := messagetest.NewMessageHandler()
+	doc := &ast.APIDoc{}
+	doc.Parse(rslt.Handler, core.Block{Data: []byte(`<apidoc version="1.0.0" lang="cmn-Hans">
+	<title>title</title>
+	<mimetype>application/json</mimetype>
+	<tag name="a/b" title="a/b" />
+	<tag name="a-b" title="a-b" />
+	<tag name="index" title="index" />
+	<tag name="../x" title="x" />
+	<api method="GET">
+		<path path="/users" />
+		<tag>a/b</tag>
+		<response status="204" />
+	</api>
+</apidoc>`)})
+	rslt.Handler.Stop()
+	a.Empty(rslt.Errors, "%v", rslt.Errors)
+
+	page, files, err := HTML(doc, "index.html")
+	a.NotError(err).NotEmpty(page)
+	a.Equal(4, len(files))
+	a.NotNil(files["a-b.html"]).
+		NotNil(files["a-b-2.html"]).
+		NotNil(files["index-2.html"]).
+		NotNil(files["x.html"])
+
+	index := string(page)
+	a.Contains(index, `<a href="a-b.html">a/b</a>`).
+		Contains(index, `<a href="a-b-2.html">a-b</a>`).
+		Contains(index, `<a href="index-2.html">index</a>`).
+		Contains(index, `<a href="x.html">x</a>`)
+
+	ab := string(files["a-b.html"])
+	a.Contains(ab, `<a href="index.html">title</a>`).
+		Contains(ab, `data-tag="a/b"`)
+}
+
+func TestHTML_union(t *testing.T) {
+	a := assert.New(t)
+
+	rslt := messagetest.NewMessageHandler()
+	doc := &ast.APIDoc{}
+	doc.Parse(rslt.Handler, core.Block{Data: []byte(`<apidoc version="1.0.0" lang="cmn-Hans">
+	<title>title</title>
+	<mimetype>application/json</mimetype>
+	<type name="Square" type="object" summary="square">
+		<param name="side" type="number" summary="side" />
+	</type>
+	<type name="Shape" type="object" summary="shape" discriminator="kind">
+		<param name="kind" type="string" summary="kind" />
+		<one-of name="circle" type="object" summary="circle">
+			<param name="r" type="number" summary="r" />
+		</one-of>
+		<one-of name="square" type="#Square" summary="square" />
+	</type>
+	<api method="POST">
+		<path path="/shapes" />
+		<request type="object" mimetype="application/json">
+			<any-of name="circle" type="object" summary="circle">
+				<param name="r" type="number" summary="r" />
+			</any-of>
+			<any-of name="square" type="#Square" summary="square" />
+		</request>
+		<response status="200" type="object" mimetype="application/json">
+			<param name="shape" type="#Shape" summary="shape" />
+		</response>
+	</api>
+</apidoc>`)})
+	rslt.Handler.Stop()
+	a.Empty(rslt.Errors, "%v", rslt.Errors)
+
+	page, _, err := HTML(doc, "index.html")
+	a.NotError(err).NotEmpty(page)
+	index := string(page)
+
+	a.Contains(index, `<code>circle</code></h4>`).
+		Contains(index, `<code>square</code></h4>`).
+		Contains(index, `<th><span class="parent-type"></span>r</th>`).
+		Contains(index, `<th><span class="parent-type"></span>side</th>`)
+
+	a.Contains(index, `<th><span class="parent-type">shape.</span>kind</th>`).
+		Contains(index, `<code>shape.kind = circle</code></h4>`).
+		Contains(index, `<code>shape.kind = square</code></h4>`).
+		Contains(index, `<th><span class="parent-type">shape.</span>r</th>`).
+		Contains(index, `<th><span class="parent-type">shape.</span>side</th>`)
+}
+
+func TestLoadTranslations(t *testing.T) {
+	a := assert.New(t)
+
+	tr, err := loadTranslations()
+	a.NotError(err).NotNil(tr)
+	a.Equal(tr.names, map[string]string{"cmn-hans": "简体中文", "cmn-hant": "繁體中文"})
+
+	// 页面中用到的内容在各个语言中都有翻译
+	keys := []string{
+		"language", "server", "tag", "uncategorized", "expand", "method",
+		"request", "response", "callback", "path-param", "query", "header", "cookie", "body",
+		"example", "var", "type", "value", "goto-top", "description", "enum",
+	}
+	for _, key := range keys {
+		a.Equal(len(tr.texts[key]), 2, key)
+	}
+	a.Equal(tr.texts["language"]["cmn-hant"], "繁體中文").
+		Equal(tr.texts["server"]["cmn-hans"], "服务")
+
+	// 包含 XSLT 代码的变量会被忽略
+	_, found := tr.texts["generator"]
+	a.False(found)
+}
@@ -0,0 +1,120 @@
+// SPDX-License-Identifier: MIT
+
+package html
+
+import (
+	"encoding/xml"
+	"regexp"
+	"strings"
+
+	"github.com/caixw/apidoc/v7/internal/ast"
+	"github.com/caixw/apidoc/v7/internal/docs"
+	"github.com/caixw/apidoc/v7/internal/locale"
+)
+
+const (
+	localesFile     = ast.MajorVersion + "/locales.xsl"
+	localeVarPrefix = "locale-"
+)
+
+// 匹配 locale-language 中对 l:locales 的引用，比如 locale[@id='cmn-hans']。
+var localeIDRef = regexp.MustCompile(`@id='([^']+)'`)
+
+// locales.xsl 中与翻译相关的内容
+type stylesheet struct {
+	Locales struct {
+		Items []struct {
+			ID   string `xml:"id,attr"`
+			Name string `xml:",chardata"`
+		} `xml:"locale"`
+	} `xml:"urn:locale locales"`
+
+	Variables []struct {
+		Name  string `xml:"name,attr"`
+		Calls []struct {
+			Params []struct {
+				Name   string `xml:"name,attr"`
+				Select string `xml:"select,attr"`
+			} `xml:"http://www.w3.org/1999/XSL/Transform with-param"`
+		} `xml:"http://www.w3.org/1999/XSL/Transform call-template"`
+	} `xml:"http://www.w3.org/1999/XSL/Transform variable"`
+}
+
+// 页面中的翻译内容
+//
+// 与 apidoc.xsl 共用 locales.xsl 中的内容，texts 的键名为去掉 locale- 前缀之后的变量名，
+// 键值为语言 ID 与翻译内容的映射；names 为各语言的显示名称。语言 ID 一律为小写。
+type translations struct {
+	names map[string]string
+	texts map[string]map[string]string
+}
+
+// 从内嵌的 locales.xsl 中加载翻译内容
+//
+// 仅加载以字符串字面量或是 l:locales 中的语言名称作为内容的变量，
+// 像 locale-generator 这种包含了 XSLT 代码的变量会被忽略。
+func loadTranslations() (*translations, error) {
+	f := docs.Embedded(localesFile)
+	if f == nil {
+		return nil, locale.NewError(locale.ErrNotFound, localesFile)
+	}
+
+	s := &stylesheet{}
+	if err := xml.Unmarshal(f.Content, s); err != nil {
+		return nil, err
+	}
+
+	t := &translations{
+		names: make(map[string]string, len(s.Locales.Items)),
+		texts: make(map[string]map[string]string, len(s.Variables)),
+	}
+	for _, l := range s.Locales.Items {
+		t.names[strings.ToLower(l.ID)] = strings.TrimSpace(l.Name)
+	}
+
+	for _, v := range s.Variables {
+		if !strings.HasPrefix(v.Name, localeVarPrefix) {
+			continue
+		}
+
+		texts := make(map[string]string, len(v.Calls))
+		for _, call := range v.Calls {
+			var lang, text string
+			for _, p := range call.Params {
+				switch p.Name {
+				case "lang":
+					lang, _ = literal(p.Select)
+				case "text":
+					text = t.text(p.Select)
+				}
+			}
+			if lang != "" && text != "" {
+				texts[strings.ToLower(lang)] = text
+			}
+		}
+		if len(texts) > 0 {
+			t.texts[strings.TrimPrefix(v.Name, localeVarPrefix)] = texts
+		}
+	}
+
+	return t, nil
+}
+
+// 将 select 属性转换成对应的文字内容
+func (t *translations) text(sel string) string {
+	if v, ok := literal(sel); ok {
+		return v
+	}
+	if m := localeIDRef.FindStringSubmatch(sel); m != nil {
+		return t.names[strings.ToLower(m[1])]
+	}
+	return ""
+}
+
+// 返回 XPath 中以单引号表示的字符串字面量
+func literal(sel string) (string, bool) {
+	if len(sel) < 2 || sel[0] != '\'' || sel[len(sel)-1] != '\'' {
+		return "", false
+	}
+	return sel[1 : len(sel)-1], true
+}
@@ -0,0 +1,250 @@
+// SPDX-License-Identifier: MIT
+
+package html
+
+// 页面模板，结构与 docs/v6/apidoc.xsl 的输出相同，以便直接使用 apidoc.css 和 apidoc.js。
+//
+// 首页不显示标签过滤菜单，而是以链接的形式指向各个标签页面。
+const tpl = `<!DOCTYPE html>
+<html lang="{{.Lang}}">
+<head>
+    <title>{{.Title}}</title>
+    <meta charset="UTF-8" />
+    <meta name="viewport" content="width=device-width,initial-scale=1,maximum-scale=1" />
+    <meta name="generator" content="apidoc" />
+    {{- if .Icon}}
+    <link rel="icon" type="image/svg+xml" href="{{.Icon}}" />
+    {{- end}}
+    {{- with .Doc.License}}
+    <link rel="license" href="{{.URL.V}}" />
+    {{- end}}
+    <style>{{.Style}}</style>
+    <style>
+    main .tags ul { display: flex; flex-flow: wrap; padding: 0; }
+    main .tags li { margin-right: var(--padding); }
+    </style>
+    <script>{{.Script}}</script>
+</head>
+<body>
+<header>
+<div class="wrap">
+    <h1>
+        {{- if .Icon}}<img alt="logo" src="{{.Icon}}" />{{end}}
+        {{if .Index}}<a href="{{.Index}}">{{.Doc.Title.V}}</a>{{else}}{{.Doc.Title.V}}{{end}}
+        {{- with .Doc.Version.V}}<span class="version">&#160;({{.}})</span>{{end}}
+    </h1>
+
+    <div class="menus">
+        <label class="menu expand-selector" role="checkbox">
+            <input type="checkbox" />{{t "expand"}}
+        </label>
+
+        {{- if .Doc.Servers}}
+        <div class="menu server-selector" role="menu" aria-haspopup="true">
+            {{t "server"}}<span aria-hidden="true">&#160;&#x25bc;</span>
+            <ul role="menu" aria-hidden="true">
+                {{- range .Doc.Servers}}
+                <li data-server="{{.Name.V}}" role="menuitemcheckbox">
+                    <label><input type="checkbox" checked="checked" />&#160;{{.Name.V}}</label>
+                </li>
+                {{- end}}
+            </ul>
+        </div>
+        {{- end}}
+
+        {{- if .Methods}}
+        <div class="menu method-selector" role="menu" aria-haspopup="true">
+            {{t "method"}}<span aria-hidden="true">&#160;&#x25bc;</span>
+            <ul role="menu" aria-hidden="true">
+                {{- range .Methods}}
+                <li data-method="{{.}}" role="menuitemcheckbox">
+                    <label><input type="checkbox" checked="checked" />&#160;{{.}}</label>
+                </li>
+                {{- end}}
+            </ul>
+        </div>
+        {{- end}}
+
+        <div class="menu languages-selector" role="menu" aria-haspopup="true">
+            {{t "language"}}<span aria-hidden="true">&#160;&#x25bc;</span>
+            <ul role="menu" aria-hidden="true">
+                {{- range .Locales}}
+                <li lang="{{.ID}}" role="menuitemradio">
+                    <label><input type="radio" name="lang"{{if .Checked}} checked="checked"{{end}} />&#160;{{.Name}}</label>
+                </li>
+                {{- end}}
+            </ul>
+        </div>
+    </div>
+</div>
+</header>
+
+<main>
+    {{- if .Tag}}
+    <h2{{template "deprecated" .Tag.Deprecated}}>{{or .Tag.Title.V .Tag.Name.V}}</h2>
+    {{- else}}
+    {{- with .Doc.Description}}
+    <div class="content">{{richtext .}}</div>
+    {{- end}}
+
+    {{- if .Doc.Servers}}
+    <div class="servers">
+        {{- range .Doc.Servers}}
+        <div class="server">
+            <h4{{template "deprecated" .Deprecated}}>{{.Name.V}}</h4>
+            <p>{{.URL.V}}</p>
+            <div>{{description .Description .Summary}}</div>
+        </div>
+        {{- end}}
+    </div>
+    {{- end}}
+
+    {{- if .Doc.Tags}}
+    <div class="tags">
+        <h2>{{t "tag"}}</h2>
+        <ul>
+            {{- range .Doc.Tags}}
+            <li{{template "deprecated" .Deprecated}}><a href="{{filename .}}">{{or .Title.V .Name.V}}</a></li>
+            {{- end}}
+        </ul>
+    </div>
+    {{- if .APIs}}
+    <h2>{{t "uncategorized"}}</h2>
+    {{- end}}
+    {{- end}}
+    {{- end}}
+
+    {{- range .APIs}}
+    {{template "api" .}}
+    {{- end}}
+</main>
+
+<footer>
+    <div class="wrap"><p>{{generator}}</p></div>
+    <a href="#" class="goto-top" title="{{text "goto-top"}}" aria-label="{{text "goto-top"}}"></a>
+</footer>
+</body>
+</html>
+
+{{- define "api"}}
+<details id="{{.ID}}" class="api" data-method="{{.Method.V}}" data-tag="{{.DataTags}}" data-server="{{.DataServers}}">
+    <summary>
+        <div class="action">
+            <a class="link" href="#{{.ID}}">&#128279;</a>
+            <span class="method">{{.Method.V}}</span>
+            <span{{template "deprecated" .Deprecated}}>{{.Path.Path.V}}</span>
+        </div>
+        <div class="right">
+            <span class="srv">{{range $i, $srv := .API.Servers}}{{if $i}}, {{end}}{{$srv.V}}{{end}}</span>
+            <span class="summary">{{.Summary.V}}</span>
+        </div>
+    </summary>
+
+    {{- with .Description}}
+    <div class="description">{{richtext .}}</div>
+    {{- end}}
+
+    <div class="body">
+        <div class="requests">
+            <h4 class="header">{{t "request"}}</h4>
+            {{- template "section" .Requests}}
+        </div>
+        <div class="responses">
+            <h4 class="header">{{t "response"}}</h4>
+            {{- template "section" .Responses}}
+        </div>
+    </div>
+
+    {{- with .Callback}}
+    <div class="callback" data-method="{{.Method.V}}">
+        <h3{{template "deprecated" .Deprecated}}>{{t "callback"}}<span class="summary">{{.Summary.V}}</span></h3>
+        {{- with .Description}}
+        <div class="description">{{richtext .}}</div>
+        {{- end}}
+
+        <div class="body">
+            <div class="requests">
+                <h4 class="header">{{t "request"}}</h4>
+                {{- template "section" .Requests}}
+            </div>
+            {{- if .Responses.Groups}}
+            <div class="responses">
+                <h4 class="header">{{t "response"}}</h4>
+                {{- template "section" .Responses}}
+            </div>
+            {{- end}}
+        </div>
+    </div>
+    {{- end}}
+</details>
+{{- end}}
+
+{{- define "section"}}
+{{- range .Tables}}
+{{template "table" .}}
+{{- end}}
+{{- range .Groups}}
+<details>
+    <summary>{{.Mimetype}}</summary>
+    {{- range .Bodies}}
+    {{- if .Response}}
+    <h5 class="status">{{.Status}}</h5>
+    <div>{{.Description}}</div>
+    {{- end}}
+    {{- range .Tables}}
+    {{template "table" .}}
+    {{- end}}
+    {{- if .Examples}}
+    <h4 class="title">&#x27a4;&#160;{{t "example"}}</h4>
+    {{- range .Examples}}
+    {{- with .Content}}
+    <pre class="example">{{.Value.Value}}</pre>
+    {{- end}}
+    {{- end}}
+    {{- end}}
+    {{- end}}
+</details>
+{{- end}}
+{{- end}}
+
+{{- define "table"}}
+<div class="param">
+    <h4 class="title">&#x27a4;&#160;{{t .Title}}{{with .Label}} <code>{{.}}</code>{{end}}</h4>
+    {{- with .Description}}
+    <div>{{.}}</div>
+    {{- end}}
+    <table class="param-list">
+        <thead>
+            <tr>
+                <th>{{t "var"}}</th>
+                <th>{{t "type"}}</th>
+                <th>{{t "value"}}</th>
+                <th>{{t "description"}}</th>
+            </tr>
+        </thead>
+        <tbody>
+            {{- range .Rows}}
+            <tr{{if .Deprecated}} class="del" title="{{.Deprecated}}"{{end}}>
+                <th><span class="parent-type">{{.Parent}}</span>{{.Name}}</th>
+                <td>{{.Type}}</td>
+                <td>{{.Value}}</td>
+                <td>
+                    {{- .Description}}
+                    {{- if .Enums}}
+                    <p>{{t "enum"}}</p>
+                    <ul>
+                        {{- range .Enums}}
+                        <li{{if .Deprecated}} class="del" title="{{.Deprecated}}"{{end}}>{{.Value}}: {{.Description}}</li>
+                        {{- end}}
+                    </ul>
+                    {{- end}}
+                </td>
+            </tr>
+            {{- end}}
+        </tbody>
+    </table>
+</div>
+{{- end}}
+
+{{- define "deprecated"}}{{if .}} class="del" title="{{.V}}"{{end}}{{end}}
+`
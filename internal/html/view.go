@@ -0,0 +1,432 @@
+// SPDX-License-Identifier: MIT
+
+package html
+
+import (
+	"html/template"
+	"strings"
+
+	"github.com/caixw/apidoc/v7/internal/ast"
+)
+
+// 用于将 API 地址转换成合法的 ID 标记，与 apidoc.xsl 中的 id-from 和 id-to 相同。
+var idReplacer = strings.NewReplacer("{", "_", "}", "_", "/", "-")
+
+type (
+	// 单个页面的内容
+	page struct {
+		Doc     *ast.APIDoc
+		Lang    string
+		Title   string
+		Tag     *ast.Tag // 当前页面对应的标签，首页为空。
+		Index   string   // 首页的地址，首页本身为空。
+		Icon    template.URL
+		Style   template.CSS
+		Script  template.JS
+		Locales []*localeView
+		Methods []string
+		APIs    []*apiView
+	}
+
+	localeView struct {
+		ID      string
+		Name    string
+		Checked bool
+	}
+
+	apiView struct {
+		*ast.API
+		ID          string
+		DataTags    string // 以逗号分隔的标签列表，供 apidoc.js 过滤用。
+		DataServers string // 以逗号分隔的服务列表，供 apidoc.js 过滤用。
+		Requests    *section
+		Responses   *section
+		Callback    *callbackView
+	}
+
+	callbackView struct {
+		*ast.Callback
+		Requests  *section
+		Responses *section
+	}
+
+	// 请求或是返回的内容
+	//
+	// Tables 为与 mimetype 无关的参数，比如路径参数和公共报头，
+	// Groups 则为按 mimetype 分组之后的报文内容。
+	section struct {
+		Tables []*table
+		Groups []*group
+	}
+
+	group struct {
+		Mimetype string
+		Bodies   []*body
+	}
+
+	body struct {
+		Response    bool // 是否为返回对象，返回对象需要显示状态码及描述。
+		Status      int
+		Description template.HTML
+		Tables      []*table
+		Examples    []*ast.Example
+	}
+
+	table struct {
+		Title       string // 标题的本地化键名
+		Label       string // 联合类型的分支在标题之后附加的内容
+		Description template.HTML
+		Rows        []*row
+	}
+
+	// 联合类型及其在报文中的位置
+	union struct {
+		param   *ast.Param
+		parent  string
+		visited map[*ast.TypeDef]bool
+	}
+
+	row struct {
+		Parent      string
+		Name        string
+		Type        string
+		Value       string
+		Deprecated  string
+		Description template.HTML
+		Enums       []*enum
+	}
+
+	enum struct {
+		Value       string
+		Deprecated  string
+		Description template.HTML
+	}
+)
+
+func (b *builder) newAPIView(api *ast.API) *apiView {
+	tags := make([]string, 0, len(api.Tags))
+	for _, t := range api.Tags {
+		tags = append(tags, t.V())
+	}
+	servers := make([]string, 0, len(api.Servers))
+	for _, srv := range api.Servers {
+		servers = append(servers, srv.V())
+	}
+
+	headers := make([]*ast.Param, 0, len(api.Headers)+len(b.doc.Headers))
+	headers = append(append(headers, api.Headers...), b.doc.Headers...)
+	responses := make([]*ast.Request, 0, len(api.Responses)+len(b.doc.Responses))
+	responses = append(append(responses, api.Responses...), b.doc.Responses...)
+
+	v := &apiView{
+		API:         api,
+		ID:          apiID(api),
+		DataTags:    strings.Join(tags, ","),
+		DataServers: strings.Join(servers, ","),
+		Requests:    b.newRequests(api.Path, headers, api.Cookies, api.Requests),
+		Responses:   b.newResponses(responses),
+	}
+
+	if cb := api.Callback; cb != nil {
+		v.Callback = &callbackView{
+			Callback:  cb,
+			Requests:  b.newRequests(cb.Path, cb.Headers, nil, cb.Requests),
+			Responses: b.newResponses(cb.Responses),
+		}
+	}
+
+	return v
+}
+
+// 与 apidoc.xsl 生成的 ID 相同，保证两者的锚点可以通用。
+func apiID(api *ast.API) string {
+	var srv string
+	if len(api.Servers) > 0 {
+		srv = api.Servers[0].V()
+	}
+	return srv + api.Method.V() + idReplacer.Replace(api.Path.Path.V())
+}
+
+func (b *builder) newRequests(path *ast.Path, headers, cookies []*ast.Param, requests []*ast.Request) *section {
+	s := &section{}
+	if path != nil {
+		s.Tables = appendTable(s.Tables, "path-param", paramRows(path.Params))
+		s.Tables = appendTable(s.Tables, "query", paramRows(path.Queries))
+	}
+	s.Tables = appendTable(s.Tables, "header", paramRows(headers))
+	s.Tables = appendTable(s.Tables, "cookie", paramRows(cookies))
+
+	// 优先采用 mimetype 完全匹配的项，否则采用未指定 mimetype 的项。
+	for _, mimetype := range b.mimetypes(requests) {
+		var req, any *ast.Request
+		for _, r := range requests {
+			switch r.Mimetype.V() {
+			case mimetype:
+				req = r
+			case "":
+				any = r
+			}
+		}
+		if req == nil {
+			req = any
+		}
+
+		if req != nil {
+			s.Groups = append(s.Groups, &group{
+				Mimetype: mimetype,
+				Bodies:   []*body{newBody(req, mimetype, false)},
+			})
+		}
+	}
+
+	return s
+}
+
+func (b *builder) newResponses(responses []*ast.Request) *section {
+	s := &section{}
+	for _, mimetype := range b.mimetypes(responses) {
+		bodies := make([]*body, 0, len(responses))
+		for _, r := range responses {
+			if m := r.Mimetype.V(); m == mimetype || m == "" {
+				bodies = append(bodies, newBody(r, mimetype, true))
+			}
+		}
+
+		if len(bodies) > 0 {
+			s.Groups = append(s.Groups, &group{Mimetype: mimetype, Bodies: bodies})
+		}
+	}
+	return s
+}
+
+// 文档中定义的 mimetype 以及 requests 中额外指定的 mimetype
+func (b *builder) mimetypes(requests []*ast.Request) []string {
+	mimetypes := make([]string, 0, len(b.doc.Mimetypes)+len(requests))
+	for _, m := range b.doc.Mimetypes {
+		if !containsString(mimetypes, m.V()) {
+			mimetypes = append(mimetypes, m.V())
+		}
+	}
+	for _, r := range requests {
+		if m := r.Mimetype.V(); m != "" && !containsString(mimetypes, m) {
+			mimetypes = append(mimetypes, m)
+		}
+	}
+	return mimetypes
+}
+
+func newBody(r *ast.Request, mimetype string, resp bool) *body {
+	b := &body{
+		Response:    resp,
+		Status:      r.Status.V(),
+		Description: description(r.Description, r.Summary),
+	}
+
+	b.Tables = appendTable(b.Tables, "header", paramRows(r.Headers))
+	b.Tables = appendTable(b.Tables, "cookie", paramRows(r.Cookies))
+	if p := r.Param(); p.Type.V() != ast.TypeNone || r.Array.V() {
+		b.Tables = bodyTables(b.Tables, p)
+	}
+
+	for _, exp := range r.Examples {
+		if m := exp.Mimetype.V(); m == "" || m == mimetype {
+			b.Examples = append(b.Examples, exp)
+		}
+	}
+
+	return b
+}
+
+func appendTable(tables []*table, title string, rows []*row) []*table {
+	if len(rows) == 0 {
+		return tables
+	}
+	return append(tables, &table{Title: title, Rows: rows})
+}
+
+func paramRows(params []*ast.Param) []*row {
+	rows := make([]*row, 0, len(params))
+	for _, p := range params {
+		rows = append(rows, newRow(p, ""))
+	}
+	return rows
+}
+
+// 以树的形式展开报文的内容，子元素的 Parent 为父元素的完整名称。
+//
+// 联合类型的各个分支会以单独的表格追加在公共字段之后。
+func bodyTables(tables []*table, p *ast.Param) []*table {
+	resolved := p.Resolve()
+	if len(resolved.Items) == 0 && !isUnion(resolved) {
+		return appendTable(tables, "body", []*row{newRow(p, "")})
+	}
+
+	visited := make(map[*ast.TypeDef]bool, 5)
+	if t := p.Type.TypeDef(); t != nil {
+		visited[t] = true
+	}
+	return objectTables(tables, &table{Title: "body"}, resolved, "", visited)
+}
+
+// 将对象的字段写入 t，之后依次追加其中各个联合类型的分支。
+func objectTables(tables []*table, t *table, p *ast.Param, parent string, visited map[*ast.TypeDef]bool) []*table {
+	var unions []*union
+	if isUnion(p) {
+		unions = append(unions, &union{param: p, parent: parent, visited: copyVisited(visited)})
+	}
+
+	t.Rows, unions = itemRows(t.Rows, unions, p.Items, parent, visited)
+	if len(t.Rows) > 0 || t.Label != "" {
+		tables = append(tables, t)
+	}
+
+	for _, u := range unions {
+		tables = branchTables(tables, u)
+	}
+	return tables
+}
+
+// 每个分支以其 discriminator 的值或是分支名称作为标签，
+// 分支中的字段与联合类型的公共字段处于同一层级。
+func branchTables(tables []*table, u *union) []*table {
+	branches := u.param.OneOf
+	if len(branches) == 0 {
+		branches = u.param.AnyOf
+	}
+
+	for _, b := range branches {
+		t := &table{
+			Title:       "body",
+			Label:       branchLabel(u.param, b, u.parent),
+			Description: description(b.Description, b.Summary),
+		}
+
+		visited := u.visited
+		if td := b.Type.TypeDef(); td != nil {
+			if visited[td] {
+				tables = append(tables, t)
+				continue
+			}
+			visited = copyVisited(visited)
+			visited[td] = true
+		}
+		tables = objectTables(tables, t, b.Resolve(), u.parent, visited)
+	}
+	return tables
+}
+
+// visited 记录当前路径上已经展开的类型定义，防止循环引用。
+func itemRows(rows []*row, unions []*union, items []*ast.Param, parent string, visited map[*ast.TypeDef]bool) ([]*row, []*union) {
+	for _, item := range items {
+		rows = append(rows, newRow(item, parent))
+
+		t := item.Type.TypeDef()
+		if t != nil && visited[t] {
+			continue
+		}
+
+		resolved := item.Resolve()
+		if resolved.Type.V() == ast.TypeMap {
+			continue
+		}
+
+		if t != nil {
+			visited[t] = true
+		}
+		prefix := parent + item.Name.V() + "."
+		if isUnion(resolved) {
+			unions = append(unions, &union{param: resolved, parent: prefix, visited: copyVisited(visited)})
+		}
+		rows, unions = itemRows(rows, unions, resolved.Items, prefix, visited)
+		if t != nil {
+			delete(visited, t)
+		}
+	}
+	return rows, unions
+}
+
+func isUnion(p *ast.Param) bool {
+	return len(p.OneOf) > 0 || len(p.AnyOf) > 0
+}
+
+func copyVisited(visited map[*ast.TypeDef]bool) map[*ast.TypeDef]bool {
+	ret := make(map[*ast.TypeDef]bool, len(visited)+1)
+	for k, v := range visited {
+		ret[k] = v
+	}
+	return ret
+}
+
+// 分支的标签，指定了 discriminator 时为 discriminator = 分支名称，否则为分支名称。
+func branchLabel(p, branch *ast.Param, parent string) string {
+	if p.Discriminator != nil {
+		return parent + p.Discriminator.V() + " = " + branch.Name.V()
+	}
+	return parent + branch.Name.V()
+}
+
+// 与 apidoc.xsl 相同，值一列中以 O 表示可选，R 表示必须。
+func newRow(p *ast.Param, parent string) *row {
+	typ := p.Type.V()
+	if p.Array.V() {
+		typ += "[]"
+	}
+	if p.Nullable.V() {
+		typ += "?"
+	}
+
+	value := "R"
+	if p.Optional.V() {
+		value = "O"
+	}
+	if p.Default.V() != "" {
+		value += " " + p.Default.V()
+	}
+
+	r := &row{
+		Parent:      parent,
+		Name:        p.Name.V(),
+		Type:        typ,
+		Value:       value,
+		Deprecated:  deprecated(p.Deprecated),
+		Description: description(p.Description, p.Summary),
+	}
+
+	for _, e := range p.Enums {
+		r.Enums = append(r.Enums, &enum{
+			Value:       e.Value.V(),
+			Deprecated:  deprecated(e.Deprecated),
+			Description: description(e.Description, e.Summary),
+		})
+	}
+
+	return r
+}
+
+func deprecated(v *ast.VersionAttribute) string {
+	if v == nil {
+		return ""
+	}
+	return v.V()
+}
+
+// 返回富文本的 HTML 内容
+//
+// HTML 类型的内容直接输出，markdown 则与 apidoc.xsl 相同，以 pre 的形式原样输出。
+func richtext(r *ast.Richtext) template.HTML {
+	if r == nil || r.Text == nil {
+		return ""
+	}
+
+	if r.Type.V() == ast.RichtextTypeHTML {
+		return template.HTML(r.V())
+	}
+	return template.HTML("<pre>" + template.HTMLEscapeString(r.V()) + "</pre>")
+}
+
+func description(desc *ast.Richtext, summary *ast.Attribute) template.HTML {
+	if d := richtext(desc); d != "" {
+		return d
+	}
+	return template.HTML(template.HTMLEscapeString(summary.V()))
+}
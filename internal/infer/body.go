@@ -0,0 +1,330 @@
+// SPDX-License-Identifier: MIT
+
+package infer
+
+import (
+	"encoding/json"
+	"io"
+	"regexp"
+	"strings"
+
+	"github.com/caixw/apidoc/v7/internal/ast"
+	"github.com/caixw/apidoc/v7/internal/locale"
+	"github.com/caixw/apidoc/v7/internal/xmlenc"
+)
+
+var (
+	intValue   = regexp.MustCompile(`^-?[0-9]+$`)
+	floatValue = regexp.MustCompile(`^-?[0-9]*\.[0-9]+([eE][-+]?[0-9]+)?$`)
+)
+
+// 从样本中推断出来的值类型
+type value struct {
+	typ      string // 为空表示仅出现过 null，最终会被当作 string 处理。
+	array    bool
+	nullable bool
+	members  []*member // 对象的字段，按出现的顺序保存。
+	objects  int       // 合并过的对象数量，用于判断字段是否为可选。
+}
+
+type member struct {
+	name  string
+	value *value
+	count int // 该字段在多少个对象中出现过
+}
+
+// 根据报文推断出 ast.Request 对象
+//
+// 仅能推断 JSON 格式的报文，其它格式只会将报文作为示例代码；
+// body 和 form 都为空时返回 nil。
+func (b *Builder) request(field, mt, body string, form []*KeyValue) *ast.Request {
+	if len(form) > 0 {
+		if mt = mimetype(mt, ""); mt == "" {
+			mt = "application/x-www-form-urlencoded"
+		}
+		req := &ast.Request{
+			Type:     &ast.TypeAttribute{Value: xmlenc.String{Value: ast.TypeObject}},
+			Mimetype: newAttribute(mt),
+		}
+		for _, kv := range form {
+			if !hasParam(req.Items, kv.Name) {
+				req.Items = append(req.Items, newParam(kv.Name, valueType(kv.Value)))
+			}
+		}
+		return req
+	}
+
+	if strings.TrimSpace(body) == "" {
+		return nil
+	}
+
+	mt = mimetype(mt, body)
+	req := &ast.Request{
+		Mimetype: newAttribute(mt),
+		Examples: []*ast.Example{{
+			Mimetype: newAttribute(mt),
+			Content:  &ast.ExampleValue{Value: xmlenc.String{Value: body}},
+		}},
+	}
+	if !isJSON(mt) {
+		return req
+	}
+
+	v, err := b.decode(field, body)
+	if err != nil {
+		b.warning(field)
+		return req
+	}
+
+	req.Type = &ast.TypeAttribute{Value: xmlenc.String{Value: v.typ}}
+	if v.array {
+		req.Array = &ast.BoolAttribute{Value: ast.Bool{Value: true}}
+	}
+	if v.nullable {
+		req.Nullable = &ast.BoolAttribute{Value: ast.Bool{Value: true}}
+	}
+	req.Items = v.params()
+
+	// 顶层的空对象无法推断出任何字段，不再指定类型。
+	if v.typ == ast.TypeObject && len(req.Items) == 0 {
+		req.Type = nil
+	}
+
+	return req
+}
+
+func (b *Builder) decode(field, body string) (*value, error) {
+	d := json.NewDecoder(strings.NewReader(body))
+	d.UseNumber()
+
+	v, err := b.decodeValue(field, d)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := d.Token(); err != io.EOF { // 不能有多余的内容
+		return nil, locale.NewError(locale.ErrInvalidFormat)
+	}
+
+	v.finish()
+	return v, nil
+}
+
+func (b *Builder) decodeValue(field string, d *json.Decoder) (*value, error) {
+	token, err := d.Token()
+	if err != nil {
+		return nil, err
+	}
+
+	switch t := token.(type) {
+	case json.Delim:
+		if t == '{' {
+			return b.decodeObject(field, d)
+		}
+		return b.decodeArray(field, d)
+	case json.Number:
+		if intValue.MatchString(string(t)) {
+			return &value{typ: ast.TypeInt}, nil
+		}
+		return &value{typ: ast.TypeFloat}, nil
+	case bool:
+		return &value{typ: ast.TypeBool}, nil
+	case string:
+		return &value{typ: ast.TypeString}, nil
+	default: // nil
+		return &value{nullable: true}, nil
+	}
+}
+
+func (b *Builder) decodeObject(field string, d *json.Decoder) (*value, error) {
+	v := &value{typ: ast.TypeObject, objects: 1}
+
+	for d.More() {
+		token, err := d.Token()
+		if err != nil {
+			return nil, err
+		}
+		name := token.(string)
+
+		item, err := b.decodeValue(field+"/"+name, d)
+		if err != nil {
+			return nil, err
+		}
+
+		if f := v.member(name); f != nil { // 重复的键名
+			f.value.merge(item)
+		} else {
+			v.members = append(v.members, &member{name: name, value: item, count: 1})
+		}
+	}
+
+	if _, err := d.Token(); err != nil { // }
+		return nil, err
+	}
+
+	return v, nil
+}
+
+// 数组的类型由所有元素合并而来，对象元素中未在每个元素中都出现的字段被当作可选字段。
+func (b *Builder) decodeArray(field string, d *json.Decoder) (*value, error) {
+	var elem *value
+	for d.More() {
+		item, err := b.decodeValue(field, d)
+		if err != nil {
+			return nil, err
+		}
+
+		if elem == nil {
+			elem = item
+		} else {
+			elem.merge(item)
+		}
+	}
+
+	if _, err := d.Token(); err != nil { // ]
+		return nil, err
+	}
+
+	if elem == nil { // 空数组无法推断元素类型
+		elem = &value{typ: ast.TypeString}
+	} else if elem.array { // 不支持多维数组
+		b.warning(field)
+		elem = &value{typ: ast.TypeString, nullable: elem.nullable}
+	}
+	elem.array = true
+
+	return elem, nil
+}
+
+func (v *value) member(name string) *member {
+	for _, f := range v.members {
+		if f.name == name {
+			return f
+		}
+	}
+	return nil
+}
+
+// 将 v2 合并到 v 中
+//
+// 类型不同时，整数与浮点数合并为浮点数，其它情况以 v 为准。
+func (v *value) merge(v2 *value) {
+	v.nullable = v.nullable || v2.nullable
+
+	switch {
+	case v.typ == "":
+		v.typ, v.array, v.members, v.objects = v2.typ, v2.array, v2.members, v2.objects
+		return
+	case v2.typ == "":
+		return
+	case v.typ == ast.TypeInt && v2.typ == ast.TypeFloat:
+		v.typ = ast.TypeFloat
+		return
+	case v.typ != ast.TypeObject || v2.typ != ast.TypeObject:
+		return
+	}
+
+	v.objects += v2.objects
+	for _, f2 := range v2.members {
+		if f := v.member(f2.name); f != nil {
+			f.value.merge(f2.value)
+			f.count += f2.count
+		} else {
+			v.members = append(v.members, f2)
+		}
+	}
+}
+
+// 将仅出现过 null 的值当作字符串
+func (v *value) finish() {
+	if v.typ == "" {
+		v.typ = ast.TypeString
+	}
+	for _, f := range v.members {
+		f.value.finish()
+	}
+}
+
+// 转换成 ast.Param 列表
+//
+// 空对象无法推断字段，会被当作键值为字符串的 map。
+func (v *value) params() []*ast.Param {
+	if v.typ != ast.TypeObject {
+		return nil
+	}
+
+	params := make([]*ast.Param, 0, len(v.members))
+	for _, f := range v.members {
+		p := newParam(f.name, f.value.typ)
+		if f.value.array {
+			p.Array = &ast.BoolAttribute{Value: ast.Bool{Value: true}}
+		}
+		if f.value.nullable {
+			p.Nullable = &ast.BoolAttribute{Value: ast.Bool{Value: true}}
+		}
+		if f.count < v.objects {
+			p.Optional = &ast.BoolAttribute{Value: ast.Bool{Value: true}}
+		}
+
+		p.Items = f.value.params()
+		if f.value.typ == ast.TypeObject && len(p.Items) == 0 {
+			p.Type.Value.Value = ast.TypeMap
+			p.Items = []*ast.Param{newParam("value", ast.TypeString)}
+		}
+
+		params = append(params, p)
+	}
+	return params
+}
+
+// 去掉 mimetype 中的参数部分
+//
+// mimetype 为空时，根据 body 的内容进行简单的判断。
+func mimetype(mt, body string) string {
+	if index := strings.IndexByte(mt, ';'); index >= 0 {
+		mt = mt[:index]
+	}
+	mt = strings.ToLower(strings.TrimSpace(mt))
+	if mt != "" {
+		return mt
+	}
+
+	body = strings.TrimSpace(body)
+	switch {
+	case body == "":
+		return ""
+	case body[0] == '{' || body[0] == '[':
+		return "application/json"
+	case body[0] == '<':
+		return "application/xml"
+	default:
+		return "text/plain"
+	}
+}
+
+func isJSON(mt string) bool {
+	return mt == "application/json" || strings.HasSuffix(mt, "+json")
+}
+
+// 根据查询参数或表单中的值推断其类型
+func valueType(v string) string {
+	switch {
+	case intValue.MatchString(v):
+		return ast.TypeInt
+	case floatValue.MatchString(v):
+		return ast.TypeFloat
+	case v == "true" || v == "false":
+		return ast.TypeBool
+	default:
+		return ast.TypeString
+	}
+}
+
+func hasParam(params []*ast.Param, name string) bool {
+	for _, p := range params {
+		if p.Name.V() == name {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,69 @@
+// SPDX-License-Identifier: MIT
+
+package infer
+
+import (
+	"testing"
+
+	"github.com/issue9/assert"
+
+	"github.com/caixw/apidoc/v7/core/messagetest"
+	"github.com/caixw/apidoc/v7/internal/ast"
+)
+
+func TestBuilder_request(t *testing.T) {
+	a := assert.New(t)
+	rslt := messagetest.NewMessageHandler()
+	b := New(rslt.Handler, "file:///test.json", "test")
+
+	a.Nil(b.request("/0", "application/json", "  ", nil))
+
+	// 非 JSON 内容仅作为示例代码
+	req := b.request("/1", "application/xml", "<xml />", nil)
+	a.Nil(req.Type).Equal(1, len(req.Examples)).Equal(req.Examples[0].Mimetype.V(), "application/xml")
+
+	req = b.request("/2", "", `{}`, nil)
+	a.Nil(req.Type).Equal(req.Mimetype.V(), "application/json")
+
+	req = b.request("/3", "application/problem+json", `[1, 2.5]`, nil)
+	a.Equal(req.Type.V(), ast.TypeFloat).True(req.Array.V())
+
+	req = b.request("/4", "application/json", `null`, nil)
+	a.Equal(req.Type.V(), ast.TypeString).True(req.Nullable.V())
+
+	req = b.request("/5", "", `{"list":[[1]],"obj":{"k":true}}`, nil)
+	a.Equal(req.Items[0].Type.V(), ast.TypeString).
+		True(req.Items[0].Array.V()).
+		Equal(req.Items[1].Items[0].Type.V(), ast.TypeBool)
+
+	// 无效的 JSON
+	req = b.request("/6", "", `{"id":1`, nil)
+	a.Nil(req.Type).Equal(1, len(req.Examples))
+
+	req = b.request("/7", "", `{"id":1} {}`, nil)
+	a.Nil(req.Type)
+
+	rslt.Handler.Stop()
+	a.Equal(3, len(rslt.Warns))
+}
+
+func TestMimetype(t *testing.T) {
+	a := assert.New(t)
+
+	a.Equal(mimetype("Application/JSON; charset=utf-8", ""), "application/json")
+	a.Equal(mimetype("", ""), "")
+	a.Equal(mimetype("", " [1]"), "application/json")
+	a.Equal(mimetype("", "<xml />"), "application/xml")
+	a.Equal(mimetype("", "text"), "text/plain")
+}
+
+func TestValueType(t *testing.T) {
+	a := assert.New(t)
+
+	a.Equal(valueType("-12"), ast.TypeInt)
+	a.Equal(valueType("1.5"), ast.TypeFloat)
+	a.Equal(valueType("1e5"), ast.TypeString)
+	a.Equal(valueType("true"), ast.TypeBool)
+	a.Equal(valueType(""), ast.TypeString)
+	a.Equal(valueType("NaN"), ast.TypeString)
+}
@@ -0,0 +1,353 @@
+// SPDX-License-Identifier: MIT
+
+// Package infer 根据请求和返回的样本推断文档内容
+//
+// 供 postman、har 等记录了实际请求内容的格式导入时使用。
+package infer
+
+import (
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/caixw/apidoc/v7/core"
+	"github.com/caixw/apidoc/v7/internal/ast"
+	"github.com/caixw/apidoc/v7/internal/locale"
+	"github.com/caixw/apidoc/v7/internal/xmlenc"
+)
+
+// 推断出来的文档所采用的版本号
+const defaultVersion = "1.0.0"
+
+var numberSegment = regexp.MustCompile(`^[0-9]+$`)
+
+// 支持的请求方法，与 ast.MethodAttribute 的要求相同。
+var methods = []string{
+	http.MethodGet,
+	http.MethodPut,
+	http.MethodPost,
+	http.MethodDelete,
+	http.MethodOptions,
+	http.MethodHead,
+	http.MethodPatch,
+}
+
+// Sample 一次请求及其返回内容的样本
+type Sample struct {
+	Method      string
+	Server      string // 服务器地址，比如 https://example.com，可以为空。
+	Path        string // 不包含查询参数的路径部分
+	Queries     []*KeyValue
+	Tags        []string
+	Summary     string
+	Description string // markdown 格式的描述内容
+
+	// 请求的报文
+	//
+	// 如果 Form 不为空，表示报文为表单，Body 的内容将被忽略。
+	Mimetype string
+	Body     string
+	Form     []*KeyValue
+
+	Responses []*Response
+}
+
+// KeyValue 查询参数或是表单中的值
+type KeyValue struct {
+	Name  string
+	Value string
+}
+
+// Response 返回内容的样本
+type Response struct {
+	Status   int
+	Mimetype string
+	Body     string
+}
+
+// Builder 根据样本生成 ast.APIDoc
+type Builder struct {
+	h   *core.MessageHandler
+	uri core.URI
+	doc *ast.APIDoc
+}
+
+// New 声明 Builder 对象
+//
+// uri 为样本所在的文件，仅用于输出警告信息。
+func New(h *core.MessageHandler, uri core.URI, title string) *Builder {
+	return &Builder{
+		h:   h,
+		uri: uri,
+		doc: &ast.APIDoc{
+			APIDoc:  &ast.APIDocVersionAttribute{Value: xmlenc.String{Value: ast.Version}},
+			Version: &ast.VersionAttribute{Value: xmlenc.String{Value: defaultVersion}},
+			Title:   &ast.Element{Content: ast.Content{Value: title}},
+		},
+	}
+}
+
+func (b *Builder) warning(field string) {
+	b.h.Warning(core.Location{URI: b.uri}.NewError(locale.ErrIgnored).WithField(field))
+}
+
+// Add 添加一个样本
+//
+// 方法和路径相同的样本会被合并到同一个 API 中，
+// 路径中的纯数字部分会被当作参数。field 表示样本在原文档中的位置，
+// 样本中无法推断的内容会以该值作为 Field 输出警告信息。
+func (b *Builder) Add(field string, s *Sample) {
+	method := strings.ToUpper(s.Method)
+	if !isMethod(method) {
+		b.warning(field)
+		return
+	}
+
+	path, params := pathTemplate(s.Path)
+	api := b.findAPI(method, path)
+	if api == nil {
+		api = b.newAPI(method, path, params, s)
+		b.doc.APIs = append(b.doc.APIs, api)
+	} else {
+		b.mergeQueries(api, s.Queries)
+	}
+
+	if s.Server != "" {
+		name := b.server(s.Server)
+		if !hasServer(api, name) {
+			api.Servers = append(api.Servers, &ast.ServerValue{Content: ast.Content{Value: name}})
+		}
+	}
+
+	if len(api.Requests) == 0 {
+		if req := b.request(field+"/request", s.Mimetype, s.Body, s.Form); req != nil {
+			api.Requests = append(api.Requests, req)
+		}
+	}
+
+	for _, resp := range s.Responses {
+		if resp.Status < http.StatusContinue || resp.Status > http.StatusNetworkAuthenticationRequired {
+			b.warning(field + "/response")
+			continue
+		}
+		if !hasResponse(api, resp.Status, mimetype(resp.Mimetype, resp.Body)) {
+			r := b.request(field+"/response", resp.Mimetype, resp.Body, nil)
+			if r == nil {
+				r = &ast.Request{}
+			}
+			r.Status = &ast.StatusAttribute{Value: ast.Number{Int: resp.Status}}
+			api.Responses = append(api.Responses, r)
+		}
+	}
+}
+
+// Doc 返回根据样本生成的文档
+func (b *Builder) Doc() *ast.APIDoc {
+	doc := b.doc
+
+	mimetypes := make([]string, 0, 5)
+	add := func(r *ast.Request) {
+		if m := r.Mimetype.V(); m != "" && !containsString(mimetypes, m) {
+			mimetypes = append(mimetypes, m)
+		}
+	}
+	for _, api := range doc.APIs {
+		for _, r := range api.Requests {
+			add(r)
+		}
+		for _, r := range api.Responses {
+			add(r)
+		}
+	}
+	if len(mimetypes) == 0 {
+		mimetypes = append(mimetypes, "application/json")
+	}
+
+	doc.Mimetypes = doc.Mimetypes[:0]
+	for _, m := range mimetypes {
+		doc.Mimetypes = append(doc.Mimetypes, &ast.Element{Content: ast.Content{Value: m}})
+	}
+
+	return doc
+}
+
+func (b *Builder) findAPI(method, path string) *ast.API {
+	for _, api := range b.doc.APIs {
+		if api.Method.V() == method && api.Path.Path.V() == path {
+			return api
+		}
+	}
+	return nil
+}
+
+func (b *Builder) newAPI(method, path string, params []*ast.Param, s *Sample) *ast.API {
+	api := &ast.API{
+		Method: &ast.MethodAttribute{Value: xmlenc.String{Value: method}},
+		Path:   &ast.Path{Path: newAttribute(path), Params: params},
+	}
+
+	summary := s.Summary
+	if summary == "" {
+		summary = method + " " + path
+	}
+	api.Summary = newAttribute(summary)
+	if s.Description != "" {
+		api.Description = &ast.Richtext{
+			Type: newAttribute(ast.RichtextTypeMarkdown),
+			Text: &ast.CData{Value: xmlenc.String{Value: s.Description}},
+		}
+	}
+
+	for _, tag := range s.Tags {
+		if !hasTag(b.doc, tag) {
+			b.doc.Tags = append(b.doc.Tags, &ast.Tag{Name: newAttribute(tag), Title: newAttribute(tag)})
+		}
+		api.Tags = append(api.Tags, &ast.TagValue{Content: ast.Content{Value: tag}})
+	}
+
+	for _, q := range s.Queries {
+		api.Path.Queries = append(api.Path.Queries, newParam(q.Name, valueType(q.Value)))
+	}
+
+	return api
+}
+
+// 仅在部分样本中出现的查询参数被当作可选参数
+func (b *Builder) mergeQueries(api *ast.API, queries []*KeyValue) {
+	for _, p := range api.Path.Queries {
+		found := false
+		for _, q := range queries {
+			if q.Name == p.Name.V() {
+				found = true
+				break
+			}
+		}
+		if !found {
+			p.Optional = &ast.BoolAttribute{Value: ast.Bool{Value: true}}
+		}
+	}
+
+	for _, q := range queries {
+		found := false
+		for _, p := range api.Path.Queries {
+			if q.Name == p.Name.V() {
+				found = true
+				break
+			}
+		}
+		if !found {
+			p := newParam(q.Name, valueType(q.Value))
+			p.Optional = &ast.BoolAttribute{Value: ast.Bool{Value: true}}
+			api.Path.Queries = append(api.Path.Queries, p)
+		}
+	}
+}
+
+// 添加服务器并返回其名称
+//
+// 如果已经存在相同 URL 的服务器，则直接返回该服务器的名称。
+func (b *Builder) server(url string) string {
+	for _, s := range b.doc.Servers {
+		if s.URL.V() == url {
+			return s.Name.V()
+		}
+	}
+
+	name := "server" + strconv.Itoa(len(b.doc.Servers)+1)
+	b.doc.Servers = append(b.doc.Servers, &ast.Server{
+		Name:    newAttribute(name),
+		URL:     newAttribute(url),
+		Summary: newAttribute(url),
+	})
+	return name
+}
+
+// 将路径中的纯数字部分以及 :name 和 {{name}} 形式的变量转换成 {name} 形式的参数
+//
+// 纯数字的参数依次命名为 id、id2、id3 等。
+func pathTemplate(path string) (string, []*ast.Param) {
+	segs := strings.Split(strings.Trim(path, "/"), "/")
+	params := make([]*ast.Param, 0, 3)
+
+	for i, seg := range segs {
+		var name, typ string
+		switch {
+		case numberSegment.MatchString(seg):
+			name, typ = "id", ast.TypeInt
+		case len(seg) > 1 && seg[0] == ':':
+			name, typ = seg[1:], ast.TypeString
+		case len(seg) > 4 && strings.HasPrefix(seg, "{{") && strings.HasSuffix(seg, "}}"):
+			name, typ = seg[2:len(seg)-2], ast.TypeString
+		default:
+			continue
+		}
+
+		if hasParam(params, name) {
+			for n := 2; ; n++ {
+				if v := name + strconv.Itoa(n); !hasParam(params, v) {
+					name = v
+					break
+				}
+			}
+		}
+
+		segs[i] = "{" + name + "}"
+		params = append(params, newParam(name, typ))
+	}
+
+	return "/" + strings.Join(segs, "/"), params
+}
+
+func isMethod(method string) bool {
+	return containsString(methods, method)
+}
+
+func hasTag(doc *ast.APIDoc, name string) bool {
+	for _, tag := range doc.Tags {
+		if tag.Name.V() == name {
+			return true
+		}
+	}
+	return false
+}
+
+func hasServer(api *ast.API, name string) bool {
+	for _, srv := range api.Servers {
+		if srv.V() == name {
+			return true
+		}
+	}
+	return false
+}
+
+func hasResponse(api *ast.API, status int, mimetype string) bool {
+	for _, r := range api.Responses {
+		if r.Status.V() == status && r.Mimetype.V() == mimetype {
+			return true
+		}
+	}
+	return false
+}
+
+func containsString(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+func newAttribute(v string) *ast.Attribute {
+	return &ast.Attribute{Value: xmlenc.String{Value: v}}
+}
+
+// 推断出来的参数没有任何描述信息，以名称作为 summary。
+func newParam(name, typ string) *ast.Param {
+	return &ast.Param{
+		Name:    newAttribute(name),
+		Type:    &ast.TypeAttribute{Value: xmlenc.String{Value: typ}},
+		Summary: newAttribute(name),
+	}
+}
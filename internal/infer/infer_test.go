@@ -0,0 +1,134 @@
+// SPDX-License-Identifier: MIT
+
+package infer
+
+import (
+	"testing"
+
+	"github.com/issue9/assert"
+
+	"github.com/caixw/apidoc/v7/core"
+	"github.com/caixw/apidoc/v7/core/messagetest"
+	"github.com/caixw/apidoc/v7/internal/ast"
+	"github.com/caixw/apidoc/v7/internal/xmlenc"
+)
+
+func TestBuilder(t *testing.T) {
+	a := assert.New(t)
+
+	rslt := messagetest.NewMessageHandler()
+	b := New(rslt.Handler, "file:///test.json", "test")
+	b.Add("/0", &Sample{
+		Method:  "get",
+		Server:  "https://example.com",
+		Path:    "/users/1/posts/2",
+		Queries: []*KeyValue{{Name: "page", Value: "1"}, {Name: "q", Value: "abc"}},
+		Tags:    []string{"user"},
+		Responses: []*Response{
+			{Status: 200, Mimetype: "application/json; charset=utf-8", Body: `{"id":1,"title":"t1","tags":["t"],"author":{"id":1,"name":null}}`},
+			{Status: 404},
+		},
+	})
+	b.Add("/1", &Sample{
+		Method:  "GET",
+		Server:  "https://example.com",
+		Path:    "/users/5/posts/6",
+		Queries: []*KeyValue{{Name: "page", Value: "2"}, {Name: "size", Value: "1.5"}},
+		Tags:    []string{"user"},
+		Responses: []*Response{
+			{Status: 200, Mimetype: "application/json", Body: `{"id":2}`},
+			{Status: 500, Body: "error"},
+		},
+	})
+	b.Add("/2", &Sample{
+		Method:   "POST",
+		Server:   "https://dev.example.com",
+		Path:     "/users",
+		Mimetype: "application/json",
+		Body:     `[{"name":"n1","age":1},{"name":"n2","age":1.5,"extra":{}}]`,
+		Form:     nil,
+	})
+	b.Add("/3", &Sample{Method: "CONNECT", Path: "/users"})
+	b.Add("/4", &Sample{
+		Method: "PUT",
+		Path:   "/users/:id/{{name}}",
+		Form:   []*KeyValue{{Name: "name", Value: "n"}, {Name: "age", Value: "5"}},
+	})
+	doc := b.Doc()
+	rslt.Handler.Stop()
+	a.Empty(rslt.Errors).Equal(1, len(rslt.Warns))
+	a.Equal(rslt.Warns[0].(*core.Error).Field, "/3")
+
+	a.Equal(doc.Title.V(), "test").
+		Equal(2, len(doc.Servers)).
+		Equal(doc.Servers[1].URL.V(), "https://dev.example.com").
+		Equal(1, len(doc.Tags)).
+		Equal(3, len(doc.APIs)).
+		Equal(3, len(doc.Mimetypes))
+
+	get := doc.APIs[0]
+	a.Equal(get.Method.V(), "GET").
+		Equal(get.Path.Path.V(), "/users/{id}/posts/{id2}").
+		Equal(2, len(get.Path.Params)).
+		Equal(get.Path.Params[1].Type.V(), ast.TypeInt).
+		Equal(get.Summary.V(), "GET /users/{id}/posts/{id2}").
+		Equal(1, len(get.Servers))
+
+	queries := get.Path.Queries
+	a.Equal(3, len(queries)).
+		Equal(queries[0].Type.V(), ast.TypeInt).False(queries[0].Optional.V()).
+		Equal(queries[1].Type.V(), ast.TypeString).True(queries[1].Optional.V()).
+		Equal(queries[2].Type.V(), ast.TypeFloat).True(queries[2].Optional.V())
+
+	a.Empty(get.Requests).Equal(3, len(get.Responses))
+	resp := get.Responses[0]
+	a.Equal(resp.Status.V(), 200).
+		Equal(resp.Mimetype.V(), "application/json").
+		Equal(resp.Type.V(), ast.TypeObject).
+		Equal(4, len(resp.Items)).
+		True(resp.Items[2].Array.V()).
+		Equal(resp.Items[3].Items[1].Type.V(), ast.TypeString).
+		True(resp.Items[3].Items[1].Nullable.V())
+	a.Equal(get.Responses[1].Status.V(), 404).Nil(get.Responses[1].Type)
+	a.Equal(get.Responses[2].Mimetype.V(), "text/plain").Nil(get.Responses[2].Type)
+
+	post := doc.APIs[1]
+	a.Equal(post.Servers[0].V(), "server2").Equal(1, len(post.Requests))
+	req := post.Requests[0]
+	a.True(req.Array.V()).
+		Equal(req.Type.V(), ast.TypeObject).
+		Equal(3, len(req.Items)).
+		Equal(req.Items[1].Type.V(), ast.TypeFloat).
+		True(req.Items[2].Optional.V()).
+		Equal(req.Items[2].Type.V(), ast.TypeMap)
+
+	put := doc.APIs[2]
+	a.Equal(put.Path.Path.V(), "/users/{id}/{name}").
+		Equal(put.Requests[0].Mimetype.V(), "application/x-www-form-urlencoded").
+		Equal(put.Requests[0].Items[1].Type.V(), ast.TypeInt)
+
+	// 生成的文档可以被正确解析
+	data, err := xmlenc.Encode("\t", doc, "", "")
+	a.NotError(err).NotEmpty(data)
+	rslt = messagetest.NewMessageHandler()
+	d := &ast.APIDoc{}
+	d.Parse(rslt.Handler, core.Block{Data: data})
+	rslt.Handler.Stop()
+	a.Empty(rslt.Errors).Equal(3, len(d.APIs))
+}
+
+func TestPathTemplate(t *testing.T) {
+	a := assert.New(t)
+
+	path, params := pathTemplate("/users")
+	a.Equal(path, "/users").Empty(params)
+
+	path, params = pathTemplate("/")
+	a.Equal(path, "/").Empty(params)
+
+	path, params = pathTemplate("/users/1/posts/:id/{{id}}/2")
+	a.Equal(path, "/users/{id}/posts/{id2}/{id3}/{id4}").Equal(4, len(params))
+	a.Equal(params[0].Type.V(), ast.TypeInt).
+		Equal(params[1].Type.V(), ast.TypeString).
+		Equal(params[3].Type.V(), ast.TypeInt)
+}
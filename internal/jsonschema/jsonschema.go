@@ -0,0 +1,363 @@
+// SPDX-License-Identifier: MIT
+
+// Package jsonschema 根据文档生成请求和返回报文的 JSON Schema
+package jsonschema
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+
+	"github.com/caixw/apidoc/v7/internal/ast"
+	"github.com/caixw/apidoc/v7/internal/slug"
+)
+
+// Ext 生成文件的扩展名
+const Ext = ".json"
+
+// Draft 生成的 JSON Schema 所采用的版本
+const Draft = "https://json-schema.org/draft/2020-12/schema"
+
+// 引用 TypeDef 时的前缀
+const defsRef = "#/$defs/"
+
+// Schema JSON Schema 对象
+type Schema struct {
+	Schema      string        `json:"$schema,omitempty"`
+	Ref         string        `json:"$ref,omitempty"`
+	Title       string        `json:"title,omitempty"`
+	Description string        `json:"description,omitempty"`
+	Type        interface{}   `json:"type,omitempty"` // 字符串或是包含 null 的字符串数组
+	Format      string        `json:"format,omitempty"`
+	Enum        []interface{} `json:"enum,omitempty"`
+	Default     interface{}   `json:"default,omitempty"`
+	Deprecated  bool          `json:"deprecated,omitempty"`
+
+	Minimum   *float64 `json:"minimum,omitempty"`
+	Maximum   *float64 `json:"maximum,omitempty"`
+	MinLength int      `json:"minLength,omitempty"`
+	MaxLength int      `json:"maxLength,omitempty"`
+	Pattern   string   `json:"pattern,omitempty"`
+
+	Items    *Schema `json:"items,omitempty"`
+	MinItems int     `json:"minItems,omitempty"`
+	MaxItems int     `json:"maxItems,omitempty"`
+
+	Properties           map[string]*Schema `json:"properties,omitempty"`
+	Required             []string           `json:"required,omitempty"`
+	AdditionalProperties *Schema            `json:"additionalProperties,omitempty"`
+
+	OneOf []*Schema `json:"oneOf,omitempty"`
+	AnyOf []*Schema `json:"anyOf,omitempty"`
+
+	Defs map[string]*Schema `json:"$defs,omitempty"`
+}
+
+// Index 所有生成文件的索引
+type Index struct {
+	Title   string      `json:"title"`
+	Version string      `json:"version"`
+	APIs    []*IndexAPI `json:"apis"`
+}
+
+// IndexAPI 单个 API 对应的文件
+type IndexAPI struct {
+	ID        string       `json:"id,omitempty"`
+	Method    string       `json:"method"`
+	Path      string       `json:"path"`
+	Requests  []*IndexFile `json:"requests,omitempty"`
+	Responses []*IndexFile `json:"responses,omitempty"`
+}
+
+// IndexFile 单个报文对应的文件
+//
+// 文件名都是相对于索引文件所在的目录。
+type IndexFile struct {
+	Status   int    `json:"status,omitempty"` // 仅返回报文有此值
+	Mimetype string `json:"mimetype,omitempty"`
+	File     string `json:"file"`
+}
+
+type builder struct {
+	doc  *ast.APIDoc
+	defs map[string]*Schema // 当前文档中引用的 TypeDef
+}
+
+var formats = map[string]string{
+	ast.TypeEmail:    "email",
+	ast.TypeURL:      "uri",
+	ast.TypeDate:     "date",
+	ast.TypeTime:     "time",
+	ast.TypeDateTime: "date-time",
+}
+
+// JSONSchema 为 doc 中每个 API 的请求和返回报文生成 JSON Schema
+//
+// 每个 API 对应一个目录，目录名优先采用 api.id，否则由请求方法和路径组成；
+// 请求报文保存为目录下的 request.json，返回报文以状态码命名，比如 200.json，
+// 指定了 mimetype 的报文会在文件名中加上 mimetype，比如 request-application-json.json。
+// 未指定类型的报文不会生成文件。
+//
+// index 为所有文件的索引；files 的键名为以 / 分隔的相对路径。
+func JSONSchema(doc *ast.APIDoc) (index []byte, files map[string][]byte, err error) {
+	idx := &Index{
+		Title:   doc.Title.V(),
+		Version: doc.Version.V(),
+		APIs:    make([]*IndexAPI, 0, len(doc.APIs)),
+	}
+	files = make(map[string][]byte, len(doc.APIs)*2)
+	names := make([]string, 0, len(doc.APIs))
+	for _, api := range doc.APIs {
+		names = append(names, dirName(api))
+	}
+	dirs := slug.Unique(names, "api")
+
+	for i, api := range doc.APIs {
+		dir := dirs[i]
+
+		item := &IndexAPI{ID: api.ID.V(), Method: api.Method.V(), Path: api.Path.Path.V()}
+
+		for _, r := range api.Requests {
+			f, err := writeFile(files, doc, api, r, dir+"/request")
+			if err != nil {
+				return nil, nil, err
+			}
+			if f != nil {
+				item.Requests = append(item.Requests, f)
+			}
+		}
+
+		for _, r := range api.Responses {
+			f, err := writeFile(files, doc, api, r, dir+"/"+strconv.Itoa(r.Status.V()))
+			if err != nil {
+				return nil, nil, err
+			}
+			if f != nil {
+				f.Status = r.Status.V()
+				item.Responses = append(item.Responses, f)
+			}
+		}
+
+		idx.APIs = append(idx.APIs, item)
+	}
+
+	if index, err = json.MarshalIndent(idx, "", "\t"); err != nil {
+		return nil, nil, err
+	}
+	return index, files, nil
+}
+
+// 将 r 的内容写入 files，文件名由 prefix 与 r 的 mimetype 组成。
+//
+// 未指定类型或是文件名已经存在时，返回 nil。
+func writeFile(files map[string][]byte, doc *ast.APIDoc, api *ast.API, r *ast.Request, prefix string) (*IndexFile, error) {
+	if r.Type.V() == ast.TypeNone {
+		return nil, nil
+	}
+
+	name := prefix
+	if m := slug.Make(r.Mimetype.V()); m != "" {
+		name += "-" + m
+	}
+	name += Ext
+	if _, found := files[name]; found {
+		return nil, nil
+	}
+
+	data, err := marshal(doc, api, r)
+	if err != nil {
+		return nil, err
+	}
+	files[name] = data
+	return &IndexFile{Mimetype: r.Mimetype.V(), File: name}, nil
+}
+
+// 根据 r 生成一个完整的 JSON Schema 文档
+//
+// 引用的 TypeDef 保存在 $defs 中。
+func newSchema(doc *ast.APIDoc, r *ast.Request) *Schema {
+	b := &builder{doc: doc}
+	s := b.param(r.Param(), true)
+	s.Schema = Draft
+	if len(b.defs) > 0 {
+		s.Defs = b.defs
+	}
+	return s
+}
+
+func marshal(doc *ast.APIDoc, api *ast.API, r *ast.Request) ([]byte, error) {
+	s := newSchema(doc, r)
+	if s.Title == "" {
+		s.Title = api.Summary.V()
+	}
+	if api.Deprecated != nil {
+		s.Deprecated = true
+	}
+	return json.MarshalIndent(s, "", "\t")
+}
+
+// chkArray 是否需要检测当前类型是否为数组
+func (b *builder) param(p *ast.Param, chkArray bool) *Schema {
+	if chkArray && p.Array.V() {
+		item := *p
+		item.Nullable = nil // nullable 表示数组本身可以为 null，而不是元素。
+		s := &Schema{
+			Type:       "array",
+			Items:      b.param(&item, false),
+			MinItems:   p.MinItems.IntValue(),
+			MaxItems:   p.MaxItems.IntValue(),
+			Deprecated: p.Deprecated != nil,
+		}
+		if p.Nullable.V() {
+			s.Type = []string{"array", "null"}
+		}
+		return s
+	}
+
+	if t := p.Type.TypeDef(); t != nil {
+		b.typeDef(t)
+		s := &Schema{
+			Ref:        defsRef + t.Name.V(),
+			Title:      p.Summary.V(),
+			Deprecated: p.Deprecated != nil,
+		}
+		if p.Nullable.V() {
+			return &Schema{AnyOf: []*Schema{s, {Type: "null"}}}
+		}
+		return s
+	}
+	p = p.Resolve()
+
+	s := &Schema{
+		Title:      p.Summary.V(),
+		Type:       schemaType(p.Type.V()),
+		Format:     formats[p.Type.V()],
+		Deprecated: p.Deprecated != nil,
+		Minimum:    numberValue(p.Min),
+		Maximum:    numberValue(p.Max),
+		MinLength:  p.MinLength.IntValue(),
+		MaxLength:  p.MaxLength.IntValue(),
+		Pattern:    p.Pattern.V(),
+	}
+	if p.Description != nil {
+		s.Description = p.Description.V()
+	}
+	if p.Default != nil {
+		s.Default = value(p.Type.V(), p.Default.V())
+	}
+
+	if len(p.Enums) > 0 {
+		s.Enum = make([]interface{}, 0, len(p.Enums))
+		for _, e := range p.Enums {
+			s.Enum = append(s.Enum, value(p.Type.V(), e.Value.V()))
+		}
+	}
+
+	switch {
+	case len(p.OneOf) > 0 || len(p.AnyOf) > 0:
+		s.Type = nil
+		branches := make([]*Schema, 0, len(p.OneOf)+len(p.AnyOf))
+		for _, branch := range p.Branches() {
+			branches = append(branches, b.param(branch, false))
+		}
+		if len(p.OneOf) > 0 {
+			s.OneOf = branches
+		} else {
+			s.AnyOf = branches
+		}
+	case p.Type.V() == ast.TypeMap && len(p.Items) > 0:
+		s.AdditionalProperties = b.param(p.Items[0], true)
+	case len(p.Items) > 0:
+		s.Properties = make(map[string]*Schema, len(p.Items))
+		for _, item := range p.Items {
+			name := item.Name.V()
+			s.Properties[name] = b.param(item, true)
+			if !item.Optional.V() {
+				s.Required = append(s.Required, name)
+			}
+		}
+	}
+
+	if p.Nullable.V() {
+		if s.Type == nil {
+			return &Schema{AnyOf: []*Schema{s, {Type: "null"}}}
+		}
+		s.Type = []string{s.Type.(string), "null"}
+		if len(s.Enum) > 0 {
+			s.Enum = append(s.Enum, nil)
+		}
+	}
+
+	return s
+}
+
+// 将 TypeDef 添加到 $defs 中，已经存在的不会重复添加。
+func (b *builder) typeDef(t *ast.TypeDef) {
+	name := t.Name.V()
+	if _, found := b.defs[name]; found {
+		return
+	}
+	if b.defs == nil {
+		b.defs = make(map[string]*Schema, len(b.doc.Types))
+	}
+
+	b.defs[name] = nil // 先占位，防止递归引用时无限循环。
+	b.defs[name] = b.param(t.Param(), true)
+}
+
+// 返回 JSON Schema 中的类型名称，无法确定类型时返回 nil。
+func schemaType(t string) interface{} {
+	switch {
+	case t == ast.TypeBool:
+		return "boolean"
+	case t == ast.TypeInt:
+		return "integer"
+	case t == ast.TypeNumber || t == ast.TypeFloat:
+		return "number"
+	case t == ast.TypeString || strings.HasPrefix(t, ast.TypeString+"."):
+		return "string"
+	case t == ast.TypeObject || t == ast.TypeMap:
+		return "object"
+	default:
+		return nil
+	}
+}
+
+// 将文档中以字符串表示的值转换成与类型对应的 JSON 值，无法转换的按原样返回。
+func value(typ, v string) interface{} {
+	switch schemaType(typ) {
+	case "integer", "number":
+		if f, err := strconv.ParseFloat(v, 64); err == nil {
+			return f
+		}
+	case "boolean":
+		if b, err := strconv.ParseBool(v); err == nil {
+			return b
+		}
+	}
+	return v
+}
+
+// 未指定的值返回 nil，以区分值为 0 的情况。
+func numberValue(num *ast.NumberAttribute) *float64 {
+	if num == nil {
+		return nil
+	}
+	v := num.V()
+	return &v
+}
+
+// API 对应的目录名，优先采用 api.id，否则由小写的请求方法和路径组成，比如 get-users-id。
+//
+// api.id 会保留其大小写，以免仅大小写不同的 id 对应到同一个目录。
+func dirName(api *ast.API) string {
+	name := api.ID.V()
+	if name == "" {
+		name = strings.ToLower(api.Method.V()) + "/" + api.Path.Path.V()
+	}
+
+	if name = slug.Make(name); name == "" {
+		name = "api"
+	}
+	return name
+}
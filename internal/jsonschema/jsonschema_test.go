@@ -0,0 +1,163 @@
+// SPDX-License-Identifier: MIT
+
+package jsonschema
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/issue9/assert"
+
+	"github.com/caixw/apidoc/v7/core"
+	"github.com/caixw/apidoc/v7/core/messagetest"
+	"github.com/caixw/apidoc/v7/internal/ast"
+	"github.com/caixw/apidoc/v7/internal/ast/asttest"
+	"github.com/caixw/apidoc/v7/internal/xmlenc"
+)
+
+func TestJSONSchema(t *testing.T) {
+	a := assert.New(t)
+
+	index, files, err := JSONSchema(asttest.Get())
+	a.NotError(err).NotEmpty(index).NotEmpty(files)
+
+	rslt := messagetest.NewMessageHandler()
+	doc := &ast.APIDoc{}
+	doc.Parse(rslt.Handler, core.Block{Data: []byte(`<apidoc version="1.0.0">
+	<title>title</title>
+	<mimetype>application/json</mimetype>
+	<type name="user" type="object" summary="user">
+		<param name="id" type="number.int" summary="id" />
+		<param name="email" type="string.email" summary="email" deprecated="1.0.1" />
+		<param name="sex" type="string" optional="true" summary="sex">
+			<enum value="male" summary="male" />
+			<enum value="female" summary="female" />
+		</param>
+		<param name="tags" type="string" array="true" nullable="true" summary="tags" />
+	</type>
+	<api method="GET" summary="get user" deprecated="1.0.2">
+		<path path="/users/{id}"><param name="id" type="number" summary="id" /></path>
+		<response status="200" type="#user" mimetype="application/json" />
+	</api>
+	<api method="POST" id="create-user">
+		<path path="/users" />
+		<request type="object" mimetype="application/json">
+			<param name="name" type="string" summary="name" />
+			<param name="homepage" type="string.url" optional="true" summary="homepage" />
+			<param name="created" type="string.date-time" optional="true" summary="created" />
+		</request>
+		<request type="object" mimetype="application/xml">
+			<param name="name" type="string" summary="name" />
+		</request>
+		<response status="201" type="#user" array="true" mimetype="application/json" />
+		<response status="400" type="object" mimetype="application/json">
+			<param name="message" type="string" summary="message" />
+		</response>
+		<response status="400" type="object" mimetype="application/xml">
+			<param name="code" type="number" summary="code" />
+		</response>
+		<response status="500" type="string" />
+	</api>
+	<api method="DELETE">
+		<path path="/users/{id}"><param name="id" type="number" summary="id" /></path>
+		<response status="204" />
+	</api>
+</apidoc>`)})
+	rslt.Handler.Stop()
+	a.Empty(rslt.Errors)
+
+	index, files, err = JSONSchema(doc)
+	a.NotError(err)
+
+	idx := &Index{}
+	a.NotError(json.Unmarshal(index, idx))
+	a.Equal(idx.Title, "title").Equal(len(idx.APIs), 3)
+	for _, api := range idx.APIs {
+		switch api.Method {
+		case "GET":
+			a.Empty(api.Requests).
+				Equal(api.Responses, []*IndexFile{
+					{Status: 200, Mimetype: "application/json", File: "get-users-id/200-application-json.json"},
+				})
+		case "POST":
+			a.Equal(api.Requests, []*IndexFile{
+				{Mimetype: "application/json", File: "create-user/request-application-json.json"},
+				{Mimetype: "application/xml", File: "create-user/request-application-xml.json"},
+			}).
+				Equal(api.Responses, []*IndexFile{
+					{Status: 201, Mimetype: "application/json", File: "create-user/201-application-json.json"},
+					{Status: 400, Mimetype: "application/json", File: "create-user/400-application-json.json"},
+					{Status: 400, Mimetype: "application/xml", File: "create-user/400-application-xml.json"},
+					{Status: 500, File: "create-user/500.json"},
+				})
+		case "DELETE":
+			a.Empty(api.Requests).Empty(api.Responses)
+		}
+	}
+	a.Equal(len(files), 7)
+
+	load := func(name string) *Schema {
+		data, found := files[name]
+		a.True(found, name)
+		s := &Schema{}
+		a.NotError(json.Unmarshal(data, s))
+		a.Equal(s.Schema, Draft)
+		return s
+	}
+
+	s := load("get-users-id/200-application-json.json")
+	a.Equal(s.Ref, "#/$defs/user").True(s.Deprecated).Equal(s.Title, "get user")
+	user := s.Defs["user"]
+	a.NotNil(user).
+		Equal(user.Type, "object").
+		Equal(user.Required, []string{"id", "email", "tags"}).
+		Equal(user.Properties["id"].Type, "integer").
+		Equal(user.Properties["email"].Format, "email").
+		True(user.Properties["email"].Deprecated).
+		Equal(user.Properties["sex"].Enum, []interface{}{"male", "female"}).
+		Equal(user.Properties["tags"].Type, []interface{}{"array", "null"}).
+		Equal(user.Properties["tags"].Items.Type, "string")
+
+	s = load("create-user/request-application-json.json")
+	a.Equal(s.Type, "object").
+		Equal(s.Required, []string{"name"}).
+		Equal(s.Properties["homepage"].Format, "uri").
+		Equal(s.Properties["created"].Format, "date-time").
+		Nil(s.Defs)
+
+	s = load("create-user/201-application-json.json")
+	a.Equal(s.Type, "array").
+		Equal(s.Items.Ref, "#/$defs/user").
+		NotNil(s.Defs["user"])
+
+	s = load("create-user/400-application-json.json")
+	a.Equal(s.Required, []string{"message"})
+
+	s = load("create-user/400-application-xml.json")
+	a.Equal(s.Required, []string{"code"})
+
+	s = load("create-user/request-application-xml.json")
+	a.Equal(s.Required, []string{"name"})
+}
+
+func TestDirName(t *testing.T) {
+	a := assert.New(t)
+
+	api := &ast.API{
+		Method: &ast.MethodAttribute{Value: xmlenc.String{Value: "GET"}},
+		Path:   &ast.Path{Path: &ast.Attribute{Value: xmlenc.String{Value: "/users/{id}/posts"}}},
+	}
+	a.Equal(dirName(api), "get-users-id-posts")
+
+	api.ID = &ast.Attribute{Value: xmlenc.String{Value: "Get User"}}
+	a.Equal(dirName(api), "Get-User")
+
+	// 仅大小写不同的 id 对应不同的目录
+	api.ID = &ast.Attribute{Value: xmlenc.String{Value: "getUser"}}
+	a.Equal(dirName(api), "getUser")
+	api.ID = &ast.Attribute{Value: xmlenc.String{Value: "GetUser"}}
+	a.Equal(dirName(api), "GetUser")
+
+	api.ID = &ast.Attribute{Value: xmlenc.String{Value: ".."}}
+	a.Equal(dirName(api), "api")
+}
@@ -0,0 +1,116 @@
+// SPDX-License-Identifier: MIT
+
+// Package lint 对文档内容进行规范性的检测
+//
+// 与 ast 中的语法检测不同，此处的规则都是可选的，
+// 由用户在配置文件中根据团队的规范自行开启，并指定其级别。
+package lint
+
+import (
+	"sort"
+
+	"github.com/caixw/apidoc/v7/core"
+	"github.com/caixw/apidoc/v7/internal/ast"
+	"github.com/caixw/apidoc/v7/internal/locale"
+)
+
+// 规则的级别
+const (
+	LevelError   = "error"
+	LevelWarning = "warning"
+	LevelInfo    = "info"
+	LevelOff     = "off"
+)
+
+var levels = map[string]core.MessageType{
+	LevelError:   core.Erro,
+	LevelWarning: core.Warn,
+	LevelInfo:    core.Info,
+}
+
+// 所有的规则
+var rules = map[string]func(*linter){
+	"api-summary":            apiSummary,
+	"api-id":                 apiID,
+	"api-id-unique":          apiIDUnique,
+	"path-kebab-case":        pathKebabCase,
+	"api-4xx-response":       api4xxResponse,
+	"deprecated-description": deprecatedDescription,
+	"tag-unused":             tagUnused,
+	"query-case":             queryCase,
+}
+
+type linter struct {
+	h    *core.MessageHandler
+	doc  *ast.APIDoc
+	rule string
+	t    core.MessageType
+}
+
+// Rules 返回所有的规则名称
+func Rules() []string {
+	names := make([]string, 0, len(rules))
+	for name := range rules {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Validate 检测规则的配置是否正确
+//
+// conf 的键名为规则名称，键值为规则的级别。
+func Validate(conf map[string]string) error {
+	names := make([]string, 0, len(conf))
+	for name := range conf {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		if _, found := rules[name]; !found {
+			return core.NewError(locale.ErrInvalidValue).WithField(name)
+		}
+
+		if level := conf[name]; level != LevelOff {
+			if _, found := levels[level]; !found {
+				return core.NewError(locale.ErrInvalidValue).WithField(name)
+			}
+		}
+	}
+	return nil
+}
+
+// Lint 根据 conf 中开启的规则检测 doc 的内容
+//
+// conf 应该是已经通过 Validate 检测的值，未指定的规则均不会执行。
+// 检测结果按规则的级别输出到 h，同时以规则名称作为错误代码。
+func Lint(h *core.MessageHandler, doc *ast.APIDoc, conf map[string]string) {
+	for _, name := range Rules() {
+		t, found := levels[conf[name]]
+		if !found || disabled(doc.LintDisabled(), name) {
+			continue
+		}
+
+		rules[name](&linter{h: h, doc: doc, rule: name, t: t})
+	}
+}
+
+// 输出错误信息
+//
+// api 表示错误信息所在的 API，如果在该 API 中禁用了当前规则，则不输出。
+func (l *linter) report(api *ast.API, err *core.Error) {
+	if api != nil && disabled(api.LintDisabled(), l.rule) {
+		return
+	}
+	l.h.Message(l.t, err.WithCode(l.rule))
+}
+
+func disabled(names []string, rule string) bool {
+	for _, name := range names {
+		if name == rule || name == ast.LintDisableAll {
+			return true
+		}
+	}
+	return false
+}
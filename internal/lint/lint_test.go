@@ -0,0 +1,224 @@
+// SPDX-License-Identifier: MIT
+
+package lint
+
+import (
+	"testing"
+
+	"github.com/issue9/assert"
+
+	"github.com/caixw/apidoc/v7/core"
+	"github.com/caixw/apidoc/v7/core/messagetest"
+	"github.com/caixw/apidoc/v7/internal/ast"
+)
+
+func loadDoc(a *assert.Assertion, data ...string) *ast.APIDoc {
+	rslt := messagetest.NewMessageHandler()
+	doc := &ast.APIDoc{}
+	for _, item := range data {
+		doc.Parse(rslt.Handler, core.Block{Data: []byte(item), Location: core.Location{URI: "file:///lint.xml"}})
+	}
+	rslt.Handler.Stop()
+	a.Empty(rslt.Errors)
+	return doc
+}
+
+// 以规则的级别执行 Lint，并返回对应级别的错误信息。
+func lint(a *assert.Assertion, doc *ast.APIDoc, rule string) []*core.Error {
+	rslt := messagetest.NewMessageHandler()
+	Lint(rslt.Handler, doc, map[string]string{rule: LevelWarning})
+	rslt.Handler.Stop()
+	a.Empty(rslt.Errors).Empty(rslt.Infos)
+
+	errs := make([]*core.Error, 0, len(rslt.Warns))
+	for _, w := range rslt.Warns {
+		err, ok := w.(*core.Error)
+		a.True(ok).Equal(err.Code, rule)
+		errs = append(errs, err)
+	}
+	return errs
+}
+
+func TestRules(t *testing.T) {
+	a := assert.New(t)
+
+	names := Rules()
+	a.Equal(len(names), len(rules)).
+		Equal(names[0], "api-4xx-response")
+}
+
+func TestValidate(t *testing.T) {
+	a := assert.New(t)
+
+	a.NotError(Validate(nil))
+	a.NotError(Validate(map[string]string{"api-id": LevelError, "api-summary": LevelOff, "tag-unused": LevelInfo}))
+
+	err := Validate(map[string]string{"api-id": LevelError, "not-exists": LevelError})
+	serr, ok := err.(*core.Error)
+	a.True(ok).Equal(serr.Field, "not-exists")
+
+	err = Validate(map[string]string{"api-id": "fatal"})
+	serr, ok = err.(*core.Error)
+	a.True(ok).Equal(serr.Field, "api-id")
+}
+
+func TestLint(t *testing.T) {
+	a := assert.New(t)
+
+	doc := loadDoc(a, `<api method="GET"><path path="/users" /><response status="200" /></api>`)
+
+	rslt := messagetest.NewMessageHandler()
+	Lint(rslt.Handler, doc, map[string]string{"api-id": LevelError, "api-summary": LevelInfo, "tag-unused": LevelOff})
+	rslt.Handler.Stop()
+	a.Equal(1, len(rslt.Errors)).
+		Equal(1, len(rslt.Infos)).
+		Empty(rslt.Warns)
+
+	// 未指定任何规则
+	rslt = messagetest.NewMessageHandler()
+	Lint(rslt.Handler, doc, nil)
+	rslt.Handler.Stop()
+	a.Empty(rslt.Errors).Empty(rslt.Warns).Empty(rslt.Infos)
+
+	// 在 api 中禁用
+	doc = loadDoc(a,
+		`<api method="GET"><!-- lint-disable api-id --><path path="/users" /><response status="200" /></api>`,
+		`<api method="POST"><!-- lint-disable --><path path="/users" /><response status="200" /></api>`,
+		`<api method="DELETE"><path path="/users" /><response status="200" /></api>`,
+	)
+	a.Equal(1, len(lint(a, doc, "api-id"))).
+		Equal(2, len(lint(a, doc, "api-summary")))
+
+	// 在 apidoc 中禁用
+	doc = loadDoc(a,
+		`<apidoc version="1.0.0"><!-- lint-disable api-id --><title>title</title><mimetype>application/json</mimetype></apidoc>`,
+		`<api method="GET"><path path="/users" /><response status="200" /></api>`,
+	)
+	a.Empty(lint(a, doc, "api-id")).
+		Equal(1, len(lint(a, doc, "api-summary")))
+}
+
+func TestAPISummary_APIID(t *testing.T) {
+	a := assert.New(t)
+
+	doc := loadDoc(a,
+		`<api method="GET" id="get-users" summary="summary"><path path="/users" /></api>`,
+		`<api method="POST"><path path="/users" /></api>`,
+	)
+
+	errs := lint(a, doc, "api-summary")
+	a.Equal(1, len(errs)).Equal(errs[0].Field, "summary")
+
+	errs = lint(a, doc, "api-id")
+	a.Equal(1, len(errs)).Equal(errs[0].Field, "id")
+}
+
+func TestAPIIDUnique(t *testing.T) {
+	a := assert.New(t)
+
+	doc := loadDoc(a,
+		`<api method="GET" id="users"><path path="/users" /></api>`,
+		`<api method="POST" id="users"><path path="/users" /></api>`,
+		`<api method="DELETE" id="users"><path path="/users" /></api>`,
+		`<api method="PUT" id="put-users"><path path="/users" /></api>`,
+	)
+	errs := lint(a, doc, "api-id-unique")
+	a.Equal(1, len(errs)).
+		Equal(2, len(errs[0].Related))
+}
+
+func TestPathKebabCase(t *testing.T) {
+	a := assert.New(t)
+
+	a.True(isKebabPath("/users/{id}/order-items"))
+	a.True(isKebabPath("/v1/users/{userID}.json"))
+	a.True(isKebabPath("/"))
+	a.False(isKebabPath("/orderItems"))
+	a.False(isKebabPath("/order_items/{id}"))
+	a.False(isKebabPath("/users/-id"))
+
+	doc := loadDoc(a,
+		`<api method="GET"><path path="/order-items" /></api>`,
+		`<api method="POST"><path path="/orderItems" /></api>`,
+	)
+	errs := lint(a, doc, "path-kebab-case")
+	a.Equal(1, len(errs)).Equal(errs[0].Field, "path")
+}
+
+func TestAPI4xxResponse(t *testing.T) {
+	a := assert.New(t)
+
+	doc := loadDoc(a,
+		`<api method="GET"><path path="/users" /><response status="200" /><response status="404" /></api>`,
+		`<api method="POST"><path path="/users" /><response status="201" /><response status="500" /></api>`,
+	)
+	a.Equal(1, len(lint(a, doc, "api-4xx-response")))
+
+	// apidoc 中的 response 对所有 API 有效
+	doc = loadDoc(a,
+		`<apidoc version="1.0.0"><title>title</title><mimetype>application/json</mimetype><response status="400" /></apidoc>`,
+		`<api method="POST"><path path="/users" /><response status="201" /></api>`,
+	)
+	a.Empty(lint(a, doc, "api-4xx-response"))
+}
+
+func TestDeprecatedDescription(t *testing.T) {
+	a := assert.New(t)
+
+	doc := loadDoc(a,
+		`<api method="GET" deprecated="1.0.0"><path path="/users" /><description type="markdown"><![CDATA[使用 POST 代替]]></description></api>`,
+		`<api method="POST" deprecated="1.0.0"><path path="/users" /></api>`,
+		`<api method="PUT"><path path="/users" /></api>`,
+	)
+	errs := lint(a, doc, "deprecated-description")
+	a.Equal(1, len(errs)).Equal(errs[0].Field, "deprecated")
+}
+
+func TestTagUnused(t *testing.T) {
+	a := assert.New(t)
+
+	doc := loadDoc(a,
+		`<apidoc version="1.0.0"><title>title</title><mimetype>application/json</mimetype>
+	<tag name="t1" title="t1" />
+	<tag name="t2" title="t2" />
+</apidoc>`,
+		`<api method="GET"><path path="/users" /><tag>t1</tag></api>`,
+	)
+	errs := lint(a, doc, "tag-unused")
+	a.Equal(1, len(errs)).
+		Equal(errs[0].Types, []core.ErrorType{core.ErrorTypeUnused})
+}
+
+func TestQueryCase(t *testing.T) {
+	a := assert.New(t)
+
+	a.Equal(queryStyle("page"), -2).
+		Equal(queryStyle("pageSize"), 0).
+		Equal(queryStyle("page_size"), 1).
+		Equal(queryStyle("page-size"), 2).
+		Equal(queryStyle("PageSize"), 3).
+		Equal(queryStyle("page__size"), -1)
+
+	doc := loadDoc(a, `<api method="GET"><path path="/users">
+	<query name="page" type="number" summary="page" />
+	<query name="pageSize" type="number" summary="size" />
+	<query name="sortBy" type="string" summary="sort" />
+	<query name="order_by" type="string" summary="order" />
+</path></api>`)
+	errs := lint(a, doc, "query-case")
+	a.Equal(1, len(errs)).Equal(errs[0].Field, "name")
+
+	// 仅有单个单词
+	doc = loadDoc(a, `<api method="GET"><path path="/users">
+	<query name="page" type="number" summary="page" />
+	<query name="size" type="number" summary="size" />
+</path></api>`)
+	a.Empty(lint(a, doc, "query-case"))
+
+	// PascalCase 时，单个小写单词也不符合规则
+	doc = loadDoc(a, `<api method="GET"><path path="/users">
+	<query name="page" type="number" summary="page" />
+	<query name="PageSize" type="number" summary="size" />
+</path></api>`)
+	a.Equal(1, len(lint(a, doc, "query-case")))
+}
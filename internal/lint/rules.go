@@ -0,0 +1,216 @@
+// SPDX-License-Identifier: MIT
+
+package lint
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/caixw/apidoc/v7/core"
+	"github.com/caixw/apidoc/v7/internal/ast"
+	"github.com/caixw/apidoc/v7/internal/locale"
+)
+
+var (
+	kebabCase = regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)
+	pathParam = regexp.MustCompile(`{[^}]*}`)
+	lowerWord = regexp.MustCompile(`^[a-z][a-z0-9]*$`)
+)
+
+// 查询参数的命名风格，按优先级排序。
+var queryStyles = []struct {
+	name  string
+	exp   *regexp.Regexp
+	lower bool // 仅由小写字母和数字组成的单个单词是否符合该风格
+}{
+	{name: "camelCase", exp: regexp.MustCompile(`^[a-z][a-z0-9]*([A-Z][a-z0-9]*)+$`), lower: true},
+	{name: "snake_case", exp: regexp.MustCompile(`^[a-z][a-z0-9]*(_[a-z0-9]+)+$`), lower: true},
+	{name: "kebab-case", exp: regexp.MustCompile(`^[a-z][a-z0-9]*(-[a-z0-9]+)+$`), lower: true},
+	{name: "PascalCase", exp: regexp.MustCompile(`^([A-Z][a-z0-9]*)+$`)},
+}
+
+// api-summary 所有 API 都需要指定 summary
+func apiSummary(l *linter) {
+	for _, api := range l.doc.APIs {
+		if api.Summary.V() == "" {
+			l.report(api, api.StartTag.Location.NewError(locale.ErrIsEmpty, "summary").WithField("summary"))
+		}
+	}
+}
+
+// api-id 所有 API 都需要指定 id
+func apiID(l *linter) {
+	for _, api := range l.doc.APIs {
+		if api.ID.V() == "" {
+			l.report(api, api.StartTag.Location.NewError(locale.ErrIsEmpty, "id").WithField("id"))
+		}
+	}
+}
+
+// api-id-unique API 的 id 不能重复
+func apiIDUnique(l *linter) {
+	ids := make(map[string][]*ast.API, len(l.doc.APIs))
+	keys := make([]string, 0, len(l.doc.APIs))
+	for _, api := range l.doc.APIs {
+		id := api.ID.V()
+		if id == "" {
+			continue
+		}
+
+		if _, found := ids[id]; !found {
+			keys = append(keys, id)
+		}
+		ids[id] = append(ids[id], api)
+	}
+
+	for _, id := range keys {
+		apis := ids[id]
+		if len(apis) < 2 {
+			continue
+		}
+
+		err := apis[0].ID.Location.NewError(locale.ErrDuplicateValue).WithField("id")
+		for _, api := range apis[1:] {
+			err.Relate(api.ID.Location, locale.Sprintf(locale.ErrDuplicateValue))
+		}
+		l.report(apis[0], err)
+	}
+}
+
+// path-kebab-case 路径中除参数以外的部分都需要符合 kebab-case 命名规则
+//
+// 路径中的扩展名会被分开检测，比如 /users/{id}.json。
+func pathKebabCase(l *linter) {
+	for _, api := range l.doc.APIs {
+		if api.Path == nil || api.Path.Path == nil {
+			continue
+		}
+
+		path := api.Path.Path.V()
+		if !isKebabPath(path) {
+			l.report(api, api.Path.Path.Location.NewError(locale.LintPathNotKebabCase, path).WithField("path"))
+		}
+	}
+}
+
+func isKebabPath(path string) bool {
+	for _, seg := range strings.Split(pathParam.ReplaceAllString(path, ""), "/") {
+		for _, word := range strings.Split(seg, ".") {
+			if word != "" && !kebabCase.MatchString(word) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// api-4xx-response 所有 API 都需要声明 4xx 的返回内容
+//
+// apidoc 中声明的 response 同样作用于所有 API。
+func api4xxResponse(l *linter) {
+	if has4xx(l.doc.Responses) {
+		return
+	}
+
+	for _, api := range l.doc.APIs {
+		if !has4xx(api.Responses) {
+			l.report(api, api.StartTag.Location.NewError(locale.LintNo4xxResponse).WithField("response"))
+		}
+	}
+}
+
+func has4xx(resps []*ast.Request) bool {
+	for _, resp := range resps {
+		if status := resp.Status.V(); status >= 400 && status < 500 {
+			return true
+		}
+	}
+	return false
+}
+
+// deprecated-description 已弃用的 API 需要通过 description 说明弃用的原因或是替代方案
+func deprecatedDescription(l *linter) {
+	for _, api := range l.doc.APIs {
+		if api.Deprecated != nil && api.Description.V() == "" {
+			l.report(api, api.Deprecated.Location.NewError(locale.LintDeprecatedNoDescription).WithField("deprecated"))
+		}
+	}
+}
+
+// tag-unused apidoc 中定义的标签需要被 API 使用
+func tagUnused(l *linter) {
+	used := make(map[string]struct{}, len(l.doc.Tags))
+	for _, api := range l.doc.APIs {
+		for _, tag := range api.Tags {
+			used[tag.V()] = struct{}{}
+		}
+	}
+
+	for _, tag := range l.doc.Tags {
+		name := tag.Name.V()
+		if _, found := used[name]; !found {
+			err := tag.StartTag.Location.NewError(locale.LintTagUnused, name).WithField("tag").AddTypes(core.ErrorTypeUnused)
+			l.report(nil, err)
+		}
+	}
+}
+
+// query-case 查询参数采用统一的命名风格
+//
+// 以文档中使用最多的命名风格为准，仅由小写字母和数字组成的名称符合除 PascalCase 以外的所有风格，不参与统计。
+func queryCase(l *linter) {
+	type query struct {
+		api   *ast.API
+		param *ast.Param
+		style int // 在 queryStyles 中的下标，-1 表示不符合任何风格，-2 表示单个小写单词。
+	}
+
+	queries := make([]*query, 0, 10)
+	counts := make([]int, len(queryStyles))
+	for _, api := range l.doc.APIs {
+		if api.Path == nil {
+			continue
+		}
+
+		for _, q := range api.Path.Queries {
+			item := &query{api: api, param: q, style: queryStyle(q.Name.V())}
+			if item.style >= 0 {
+				counts[item.style]++
+			}
+			queries = append(queries, item)
+		}
+	}
+
+	style := -1
+	for index, cnt := range counts {
+		if cnt > 0 && (style == -1 || cnt > counts[style]) {
+			style = index
+		}
+	}
+	if style == -1 {
+		return
+	}
+
+	for _, q := range queries {
+		if q.style == style || (q.style == -2 && queryStyles[style].lower) {
+			continue
+		}
+
+		name := q.param.Name.V()
+		err := q.param.Name.Location.NewError(locale.LintQueryCase, name, queryStyles[style].name).WithField("name")
+		l.report(q.api, err)
+	}
+}
+
+func queryStyle(name string) int {
+	if lowerWord.MatchString(name) {
+		return -2
+	}
+
+	for index, style := range queryStyles {
+		if style.exp.MatchString(name) {
+			return index
+		}
+	}
+	return -1
+}
@@ -12,7 +12,6 @@ func init() {
 // 有关命令行的相关翻译项，其第一行数据会被提取出来同时作为官网的翻译数据，
 // 需要注释其第一行必须得是一个完整的句子。
 // 所有以 CmdXxUsage 的都是子命令的说明语句。
-//
 const (
 	// 与 flag 包相关的处理
 	CmdUsage       = "%s 是一个 RESTful API 文档生成工具\n"
@@ -33,11 +32,14 @@ mock 服务会根据接口定义检测用户提交的数据是否合法，并生
 对于数据只作检测是否合规，但是无法理解其内容，比如提交地址中添加了 size=20，
 只会检测 20 的类型是否符合 size 的要求，但是不会只返回给用户 20 条数据。
 `
-	CmdBuildUsage  = "生成文档内容\n"
-	CmdStaticUsage = "启用静态文件服务\n"
-	CmdLSPUsage    = "启动 language server protocol 服务\n"
-	Version        = "版本：%s\n文档：%s\nLSP：%s\nopenapi：%s\nGo：%s"
-	CmdNotFound    = "子命令 %s 未找到\n"
+	CmdBuildUsage   = "生成文档内容\n"
+	CmdStaticUsage  = "启用静态文件服务\n"
+	CmdLSPUsage     = "启动 language server protocol 服务\n"
+	CmdImportUsage  = "将其它格式的文档导入为 apidoc 文档\n"
+	CmdUpgradeUsage = "将旧版本的文档和配置文件升级到当前版本\n"
+	CmdDiffUsage    = "比较两个文档之间的差异\n\n用法为 diff [options] old new，old 和 new 为以 URI 形式表示的文档地址。\n存在不兼容的修改时会返回错误，可用于在 CI 中检测 API 是否被意外修改。\n"
+	Version         = "版本：%s\n文档：%s\nLSP：%s\nopenapi：%s\nGo：%s"
+	CmdNotFound     = "子命令 %s 未找到\n"
 
 	FlagSyntaxDirUsage         = "以 `URI` 形式表示测试项目地址"
 	FlagBuildDirUsage          = "以 `URI` 形式表示的项目地址"
@@ -69,6 +71,12 @@ mock 服务会根据接口定义检测用户提交的数据是否合法，并生
 	FlagLSPHeaderUsage         = "指定 LSP 传递内容是否带报头信息。"
 	FlagLSPTimeoutUsage        = "指定 LSP 每次读取客户端数据的超时时间，超进不会触发错误，只会再次读取。"
 	FlagVersionKindUsage       = "只显示该类型的版本号，可以是 apidoc、doc、lsp、openapi 和 all"
+	FlagImportTypeUsage        = "导入的文档类型，可以是 openapi、postman 和 har"
+	FlagImportInputUsage       = "以 `URI` 形式表示的待导入文档地址"
+	FlagImportOutputUsage      = "以 `URI` 形式表示的输出文档地址"
+	FlagUpgradeDirUsage        = "以 `URI` 形式表示的待升级项目地址"
+	FlagUpgradeDryRunUsage     = "不修改文件，仅以 diff 的格式输出需要修改的内容"
+	FlagDiffFormatUsage        = "输出的格式，可以是 text、json 和 markdown"
 
 	VersionInCompatible = "当前程序与配置文件中指定的版本号不兼容"
 	Complete            = "完成！文档保存在：%s，总用时：%v"
@@ -84,6 +92,69 @@ mock 服务会根据接口定义检测用户提交的数据是否合法，并生
 	ServerStart         = "服务启动，可通过 %s 访问"
 	UnimplementedRPC    = "未实现该 RPC 服务 %s"
 	PackFileHeader      = "文档由 %s 自动生成，请勿手动修改！"
+	UpgradeFileSummary  = "%s：移除 type=\"none\" %d 处，移除 xml-ns %d 处，添加 xml-namespace %d 处，修改版本号 %d 处"
+	UpgradeNoChanges    = "没有需要升级的内容"
+	DiffBreaking        = "不兼容"
+	DiffDeprecation     = "弃用"
+	DiffNonBreaking     = "兼容"
+	DiffTarget          = "位置"
+	DiffMessage         = "说明"
+	DiffSummary         = "不兼容的修改 %d 处，弃用 %d 处，兼容的修改 %d 处"
+	DiffAPIAdded        = "添加了该 API"
+	DiffAPIRemoved      = "删除了该 API"
+	DiffAPIChanged      = "API 由 %s 修改为 %s"
+	DiffDeprecated      = "将于 %s 弃用"
+	DiffTypeChanged     = "类型由 %s 修改为 %s"
+	DiffParamAdded      = "添加了该参数"
+	DiffParamRemoved    = "删除了该参数"
+	DiffParamRequired   = "由可选修改为必填"
+	DiffParamOptional   = "由必填修改为可选"
+	DiffEnumAdded       = "添加了枚举值 %s"
+	DiffEnumRemoved     = "删除了枚举值 %s"
+	DiffStatusAdded     = "添加了状态码 %s"
+	DiffStatusRemoved   = "删除了状态码 %s"
+	DiffMimetypeAdded   = "添加了 mimetype %s"
+	DiffMimetypeRemoved = "删除了 mimetype %s"
+	DiffMimetypeChanged = "mimetype 由 %s 修改为 %s"
+
+	DiffParamNullable     = "由不可为 null 修改为可为 null"
+	DiffParamNotNullable  = "由可为 null 修改为不可为 null"
+	DiffBranchAdded       = "添加了分支 %s"
+	DiffBranchRemoved     = "删除了分支 %s"
+	DiffConstraintAdded   = "添加了约束条件 %s"
+	DiffConstraintRemoved = "删除了约束条件 %s"
+	DiffConstraintChanged = "约束条件由 %s 修改为 %s"
+
+	LintPathNotKebabCase        = "路径 %s 不符合 kebab-case 命名规则"
+	LintNo4xxResponse           = "未声明 4xx 的返回内容"
+	LintDeprecatedNoDescription = "已弃用的 API 缺少描述内容"
+	LintTagUnused               = "标签 %s 未被任何 API 使用"
+	LintQueryCase               = "查询参数 %s 不符合 %s 命名规则"
+
+	// 导出文档时用到的标题等内容，与 docs/v6/locales.xsl 中的内容相对应。
+	DocVersion       = "doc-version"
+	DocLicense       = "doc-license"
+	DocContact       = "doc-contact"
+	DocServer        = "doc-server"
+	DocTag           = "doc-tag"
+	DocUncategorized = "doc-uncategorized"
+	DocName          = "doc-name"
+	DocURL           = "doc-url"
+	DocRequest       = "doc-request"
+	DocResponse      = "doc-response"
+	DocCallback      = "doc-callback"
+	DocPathParam     = "doc-path-param"
+	DocQuery         = "doc-query"
+	DocHeader        = "doc-header"
+	DocCookie        = "doc-cookie"
+	DocBody          = "doc-body"
+	DocExample       = "doc-example"
+	DocVar           = "doc-var"
+	DocType          = "doc-type"
+	DocValue         = "doc-value"
+	DocDescription   = "doc-description"
+	DocEnum          = "doc-enum"
+	DocDeprecated    = "doc-deprecated"
 
 	// 文档树中各个字段的介绍
 	UsageAPIDoc              = "usage-apidoc"
@@ -103,6 +174,8 @@ mock 服务会根据接口定义检测用户提交的数据是否合法，并生
 	UsageAPIDocResponses     = "usage-apidoc-responses"
 	UsageAPIDocMimetypes     = "usage-apidoc-mimetypes"
 	UsageAPIDocXMLNamespaces = "usage-apidoc-xml-namespaces"
+	UsageAPIDocTypes         = "usage-apidoc-types"
+	UsageAPIDocSecurities    = "usage-apidoc-securities"
 
 	UsageXMLNamespace       = "usage-xml-namespace"
 	UsageXMLNamespacePrefix = "usage-xml-namespace-prefix"
@@ -120,8 +193,10 @@ mock 服务会根据接口定义检测用户提交的数据是否合法，并生
 	UsageAPICallback    = "usage-api-callback"
 	UsageAPIDeprecated  = "usage-api-deprecated"
 	UsageAPIHeaders     = "usage-api-headers"
+	UsageAPICookies     = "usage-api-cookies"
 	UsageAPITags        = "usage-api-tags"
 	UsageAPIServers     = "usage-api-servers"
+	UsageAPISecurities  = "usage-api-securities"
 
 	UsageLink     = "usage-link"
 	UsageLinkText = "usage-link-text"
@@ -153,37 +228,46 @@ mock 服务会根据接口定义检测用户提交的数据是否合法，并生
 	UsageExampleSummary  = "usage-example-summary"
 	UsageExampleContent  = "usage-example-content"
 
-	UsageParam            = "usage-param"
-	UsageParamName        = "usage-param-name"
-	UsageParamType        = "usage-param-type"
-	UsageParamDeprecated  = "usage-param-deprecated"
-	UsageParamDefault     = "usage-param-default"
-	UsageParamOptional    = "usage-param-optional"
-	UsageParamArray       = "usage-param-array"
-	UsageParamItems       = "usage-param-items"
-	UsageParamSummary     = "usage-param-summary"
-	UsageParamEnums       = "usage-param-enums"
-	UsageParamDescription = "usage-param-description"
-	UsageParamArrayStyle  = "usage-param-array-style"
+	UsageParam              = "usage-param"
+	UsageParamName          = "usage-param-name"
+	UsageParamType          = "usage-param-type"
+	UsageParamDeprecated    = "usage-param-deprecated"
+	UsageParamDefault       = "usage-param-default"
+	UsageParamOptional      = "usage-param-optional"
+	UsageParamNullable      = "usage-param-nullable"
+	UsageParamArray         = "usage-param-array"
+	UsageParamItems         = "usage-param-items"
+	UsageParamSummary       = "usage-param-summary"
+	UsageParamEnums         = "usage-param-enums"
+	UsageParamDescription   = "usage-param-description"
+	UsageParamOneOf         = "usage-param-one-of"
+	UsageParamAnyOf         = "usage-param-any-of"
+	UsageParamDiscriminator = "usage-param-discriminator"
+	UsageParamArrayStyle    = "usage-param-array-style"
 
 	UsagePath        = "usage-path"
 	UsagePathPath    = "usage-path-path"
 	UsagePathParams  = "usage-path-params"
 	UsagePathQueries = "usage-path-queries"
 
-	UsageRequest            = "usage-request"
-	UsageRequestName        = "usage-request-name"
-	UsageRequestType        = "usage-request-type"
-	UsageRequestDeprecated  = "usage-request-deprecated"
-	UsageRequestArray       = "usage-request-array"
-	UsageRequestItems       = "usage-request-items"
-	UsageRequestSummary     = "usage-request-summary"
-	UsageRequestStatus      = "usage-request-status"
-	UsageRequestEnums       = "usage-request-enums"
-	UsageRequestDescription = "usage-request-description"
-	UsageRequestMimetype    = "usage-request-mimetype"
-	UsageRequestExamples    = "usage-request-examples"
-	UsageRequestHeaders     = "usage-request-headers"
+	UsageRequest              = "usage-request"
+	UsageRequestName          = "usage-request-name"
+	UsageRequestType          = "usage-request-type"
+	UsageRequestDeprecated    = "usage-request-deprecated"
+	UsageRequestNullable      = "usage-request-nullable"
+	UsageRequestArray         = "usage-request-array"
+	UsageRequestItems         = "usage-request-items"
+	UsageRequestSummary       = "usage-request-summary"
+	UsageRequestStatus        = "usage-request-status"
+	UsageRequestEnums         = "usage-request-enums"
+	UsageRequestDescription   = "usage-request-description"
+	UsageRequestOneOf         = "usage-request-one-of"
+	UsageRequestAnyOf         = "usage-request-any-of"
+	UsageRequestDiscriminator = "usage-request-discriminator"
+	UsageRequestMimetype      = "usage-request-mimetype"
+	UsageRequestExamples      = "usage-request-examples"
+	UsageRequestHeaders       = "usage-request-headers"
+	UsageRequestCookies       = "usage-request-cookies"
 
 	UsageRichtext     = "usage-richtext"
 	UsageRichtextType = "usage-richtext-type"
@@ -194,6 +278,18 @@ mock 服务会根据接口定义检测用户提交的数据是否合法，并生
 	UsageTagTitle      = "usage-tag-title"
 	UsageTagDeprecated = "usage-tag-deprecated"
 
+	UsageTypeDef              = "usage-typedef"
+	UsageTypeDefName          = "usage-typedef-name"
+	UsageTypeDefType          = "usage-typedef-type"
+	UsageTypeDefDeprecated    = "usage-typedef-deprecated"
+	UsageTypeDefItems         = "usage-typedef-items"
+	UsageTypeDefSummary       = "usage-typedef-summary"
+	UsageTypeDefEnums         = "usage-typedef-enums"
+	UsageTypeDefDescription   = "usage-typedef-description"
+	UsageTypeDefOneOf         = "usage-typedef-one-of"
+	UsageTypeDefAnyOf         = "usage-typedef-any-of"
+	UsageTypeDefDiscriminator = "usage-typedef-discriminator"
+
 	UsageServer            = "usage-server"
 	UsageServerName        = "usage-server-name"
 	UsageServerTitle       = "usage-server-title"
@@ -202,12 +298,47 @@ mock 服务会根据接口定义检测用户提交的数据是否合法，并生
 	UsageServerSummary     = "usage-server-summary"
 	UsageServerDescription = "usage-server-description"
 
+	UsageSecurity                 = "usage-security"
+	UsageSecurityName             = "usage-security-name"
+	UsageSecurityType             = "usage-security-type"
+	UsageSecuritySummary          = "usage-security-summary"
+	UsageSecurityDescription      = "usage-security-description"
+	UsageSecurityIn               = "usage-security-in"
+	UsageSecurityKey              = "usage-security-key"
+	UsageSecurityScheme           = "usage-security-scheme"
+	UsageSecurityBearerFormat     = "usage-security-bearer-format"
+	UsageSecurityFlows            = "usage-security-flows"
+	UsageSecurityOpenIDConnectURL = "usage-security-openid-connect-url"
+
+	UsageOAuthFlow                 = "usage-oauth-flow"
+	UsageOAuthFlowType             = "usage-oauth-flow-type"
+	UsageOAuthFlowAuthorizationURL = "usage-oauth-flow-authorization-url"
+	UsageOAuthFlowTokenURL         = "usage-oauth-flow-token-url"
+	UsageOAuthFlowRefreshURL       = "usage-oauth-flow-refresh-url"
+	UsageOAuthFlowScopes           = "usage-oauth-flow-scopes"
+
+	UsageScope        = "usage-scope"
+	UsageScopeName    = "usage-scope-name"
+	UsageScopeSummary = "usage-scope-summary"
+
+	UsageSecurityValue       = "usage-security-value"
+	UsageSecurityValueName   = "usage-security-value-name"
+	UsageSecurityValueScopes = "usage-security-value-scopes"
+
 	UsageXMLAttr    = "usage-xml-attr"
 	UsageXMLExtract = "usage-xml-extract"
 	UsageXMLCData   = "usage-xml-cdata"
 	UsageXMLPrefix  = "usage-xml-prefix"
 	UsageXMLWrapped = "usage-xml-wrapped"
 
+	UsageConstraintMin       = "usage-constraint-min"
+	UsageConstraintMax       = "usage-constraint-max"
+	UsageConstraintMinLength = "usage-constraint-min-length"
+	UsageConstraintMaxLength = "usage-constraint-max-length"
+	UsageConstraintPattern   = "usage-constraint-pattern"
+	UsageConstraintMinItems  = "usage-constraint-min-items"
+	UsageConstraintMaxItems  = "usage-constraint-max-items"
+
 	// 基本类型
 	UsageString  = "usage-string"
 	UsageNumber  = "usage-number"
@@ -232,6 +363,10 @@ mock 服务会根据接口定义检测用户提交的数据是否合法，并生
 	UsageConfigOutputStyle           = "usage-config-output.style"
 	UsageConfigOutputNamespace       = "usage-config-output.namespace"
 	UsageConfigOutputNamespacePrefix = "usage-config-output.namespace-prefix"
+	UsageConfigOutputInlineSchema    = "usage-config-output.inline-schema"
+	UsageConfigOutputSplit           = "usage-config-output.split"
+	UsageConfigOutputPackage         = "usage-config-output.package"
+	UsageConfigLint                  = "usage-config-lint"
 
 	// 错误信息，可能在地方用到
 	ErrInvalidUTF8Character      = "无效的 UTF8 字符"
@@ -261,6 +396,12 @@ mock 服务会根据接口定义检测用户提交的数据是否合法，并生
 	ErrInvalidURIScheme          = "无效的 URI 协议：%s"
 	ErrInvalidURI                = "无效的 URI：%s"
 	ErrFileNotFound              = "未找到文件 %s"
+	ErrIgnored                   = "无法转换该内容，已忽略"
+	ErrCyclicReference           = "存在循环引用"
+	ErrUnauthorized              = "未提供有效的身份验证信息"
+	ErrBreakingChanges           = "存在 %d 处不兼容的修改"
+	ErrAmbiguousRoute            = "路由存在歧义"
+	ErrOutsideDir                = "文件 %s 位于输出目录之外"
 
 	// logs
 	InfoPrefix    = "[INFO] "
@@ -22,11 +22,14 @@ mock 服务会根据接口定义检测用户提交的数据是否合法，并生
 对于数据只作检测是否合规，但是无法理解其内容，比如提交地址中添加了 size=20，
 只会检测 20 的类型是否符合 size 的要求，但是不会只返回给用户 20 条数据。
 `,
-	CmdBuildUsage:  "生成文档内容\n",
-	CmdStaticUsage: "启用静态文件服务\n",
-	CmdLSPUsage:    "启动 language server protocol 服务\n",
-	Version:        "版本：%s\n文档：%s\nLSP：%s\nopenapi：%s\nGo：%s",
-	CmdNotFound:    "子命令 %s 未找到\n",
+	CmdBuildUsage:   "生成文档内容\n",
+	CmdStaticUsage:  "启用静态文件服务\n",
+	CmdLSPUsage:     "启动 language server protocol 服务\n",
+	CmdImportUsage:  "将其它格式的文档导入为 apidoc 文档\n",
+	CmdUpgradeUsage: "将旧版本的文档和配置文件升级到当前版本\n",
+	CmdDiffUsage:    "比较两个文档之间的差异\n\n用法为 diff [options] old new，old 和 new 为以 URI 形式表示的文档地址。\n存在不兼容的修改时会返回错误，可用于在 CI 中检测 API 是否被意外修改。\n",
+	Version:         "版本：%s\n文档：%s\nLSP：%s\nopenapi：%s\nGo：%s",
+	CmdNotFound:     "子命令 %s 未找到\n",
 
 	FlagSyntaxDirUsage:         "以 `URI` 形式表示测试项目地址",
 	FlagBuildDirUsage:          "以 `URI` 形式表示的项目地址",
@@ -58,6 +61,12 @@ mock 服务会根据接口定义检测用户提交的数据是否合法，并生
 	FlagLSPHeaderUsage:         "指定 LSP 传递内容是否带报头信息",
 	FlagLSPTimeoutUsage:        "指定 LSP 每次读取客户端数据的超时时间，超时不会触发错误，只会再次读取。",
 	FlagVersionKindUsage:       "只显示该类型的版本号，可以是 apidoc、doc、lsp、openapi 和 all",
+	FlagImportTypeUsage:        "导入的文档类型，可以是 openapi、postman 和 har",
+	FlagImportInputUsage:       "以 `URI` 形式表示的待导入文档地址",
+	FlagImportOutputUsage:      "以 `URI` 形式表示的输出文档地址",
+	FlagUpgradeDirUsage:        "以 `URI` 形式表示的待升级项目地址",
+	FlagUpgradeDryRunUsage:     "不修改文件，仅以 diff 的格式输出需要修改的内容",
+	FlagDiffFormatUsage:        "输出的格式，可以是 text、json 和 markdown",
 
 	VersionInCompatible: "当前程序与配置文件中指定的版本号不兼容",
 	Complete:            "完成！文档保存在：%s，总用时：%v",
@@ -73,6 +82,68 @@ mock 服务会根据接口定义检测用户提交的数据是否合法，并生
 	ServerStart:         "服务启动，可通过 %s 访问",
 	UnimplementedRPC:    "未实现该 RPC 服务 %s",
 	PackFileHeader:      "文档由 %s 自动生成，请勿手动修改！",
+	UpgradeFileSummary:  "%s：移除 type=\"none\" %d 处，移除 xml-ns %d 处，添加 xml-namespace %d 处，修改版本号 %d 处",
+	UpgradeNoChanges:    "没有需要升级的内容",
+	DiffBreaking:        "不兼容",
+	DiffDeprecation:     "弃用",
+	DiffNonBreaking:     "兼容",
+	DiffTarget:          "位置",
+	DiffMessage:         "说明",
+	DiffSummary:         "不兼容的修改 %d 处，弃用 %d 处，兼容的修改 %d 处",
+	DiffAPIAdded:        "添加了该 API",
+	DiffAPIRemoved:      "删除了该 API",
+	DiffAPIChanged:      "API 由 %s 修改为 %s",
+	DiffDeprecated:      "将于 %s 弃用",
+	DiffTypeChanged:     "类型由 %s 修改为 %s",
+	DiffParamAdded:      "添加了该参数",
+	DiffParamRemoved:    "删除了该参数",
+	DiffParamRequired:   "由可选修改为必填",
+	DiffParamOptional:   "由必填修改为可选",
+	DiffEnumAdded:       "添加了枚举值 %s",
+	DiffEnumRemoved:     "删除了枚举值 %s",
+	DiffStatusAdded:     "添加了状态码 %s",
+	DiffStatusRemoved:   "删除了状态码 %s",
+	DiffMimetypeAdded:   "添加了 mimetype %s",
+	DiffMimetypeRemoved: "删除了 mimetype %s",
+	DiffMimetypeChanged: "mimetype 由 %s 修改为 %s",
+
+	DiffParamNullable:     "由不可为 null 修改为可为 null",
+	DiffParamNotNullable:  "由可为 null 修改为不可为 null",
+	DiffBranchAdded:       "添加了分支 %s",
+	DiffBranchRemoved:     "删除了分支 %s",
+	DiffConstraintAdded:   "添加了约束条件 %s",
+	DiffConstraintRemoved: "删除了约束条件 %s",
+	DiffConstraintChanged: "约束条件由 %s 修改为 %s",
+
+	LintPathNotKebabCase:        "路径 %s 不符合 kebab-case 命名规则",
+	LintNo4xxResponse:           "未声明 4xx 的返回内容",
+	LintDeprecatedNoDescription: "已弃用的 API 缺少描述内容",
+	LintTagUnused:               "标签 %s 未被任何 API 使用",
+	LintQueryCase:               "查询参数 %s 不符合 %s 命名规则",
+
+	DocVersion:       "版本",
+	DocLicense:       "授权",
+	DocContact:       "联系方式",
+	DocServer:        "服务",
+	DocTag:           "标签",
+	DocUncategorized: "未分类",
+	DocName:          "名称",
+	DocURL:           "地址",
+	DocRequest:       "请求",
+	DocResponse:      "返回",
+	DocCallback:      "回调",
+	DocPathParam:     "路径参数",
+	DocQuery:         "查询参数",
+	DocHeader:        "报头",
+	DocCookie:        "Cookie",
+	DocBody:          "报文",
+	DocExample:       "示例代码",
+	DocVar:           "变量",
+	DocType:          "类型",
+	DocValue:         "值",
+	DocDescription:   "描述",
+	DocEnum:          "枚举",
+	DocDeprecated:    "已于 %s 废弃",
 
 	// 文档树中各个字段的介绍
 	UsageAPIDoc:              "用于描述整个文档的相关内容，只能出现一次。",
@@ -92,6 +163,8 @@ mock 服务会根据接口定义检测用户提交的数据是否合法，并生
 	UsageAPIDocResponses:     "文档中所有 API 文档都需要支持的返回内容",
 	UsageAPIDocMimetypes:     "文档所支持的 mimetype",
 	UsageAPIDocXMLNamespaces: "针对 <var>application/xml</var> 类型的内容的命名空间设置",
+	UsageAPIDocTypes:         "文档中定义的可复用类型，可通过 <samp>#name</samp> 的形式在 <var>type</var> 属性中引用。",
+	UsageAPIDocSecurities:    "文档中定义的所有身份验证方式",
 
 	UsageXMLNamespace:       "为 <var>application/xml</var> 定义命名空间的相关属性",
 	UsageXMLNamespacePrefix: "命名空间的前缀，如果为空，则表示作为默认命名空间，命局只能有一个默认命名空间。",
@@ -109,8 +182,10 @@ mock 服务会根据接口定义检测用户提交的数据是否合法，并生
 	UsageAPICallback:    "定义回调接口内容",
 	UsageAPIDeprecated:  "在此版本之后将会被弃用",
 	UsageAPIHeaders:     "传递的报头内容，如果是某个 mimetype 专用的，可以放在 request 元素中。",
+	UsageAPICookies:     "传递的 cookie 内容，仅支持简单类型。",
 	UsageAPITags:        "关联的标签",
 	UsageAPIServers:     "关联的服务",
+	UsageAPISecurities:  "访问该接口需要的身份验证方式，满足其中任意一项即可。",
 
 	UsageLink:     "用于描述链接信息，一般转换为 HTML 的 <code>a</code> 标签。",
 	UsageLinkText: "链接的字面文字",
@@ -142,37 +217,46 @@ mock 服务会根据接口定义检测用户提交的数据是否合法，并生
 	UsageExampleSummary:  "示例代码的概要信息",
 	UsageExampleContent:  "示例代码的内容，需要使用 CDATA 包含代码。",
 
-	UsageParam:            "参数类型，基本上可以作为 request 的子集使用。",
-	UsageParamName:        "值的名称",
-	UsageParamType:        "值的类型",
-	UsageParamDeprecated:  "表示在大于等于该版本号时不再启作用",
-	UsageParamDefault:     "默认值",
-	UsageParamOptional:    "是否为可选的参数",
-	UsageParamArray:       "是否为数组",
-	UsageParamItems:       "子类型，比如对象的子元素。",
-	UsageParamSummary:     "简要介绍",
-	UsageParamEnums:       "当前参数可用的枚举值",
-	UsageParamDescription: "详细介绍，为 HTML 内容。",
-	UsageParamArrayStyle:  "以数组的方式展示数据",
+	UsageParam:              "参数类型，基本上可以作为 request 的子集使用。",
+	UsageParamName:          "值的名称",
+	UsageParamType:          "值的类型",
+	UsageParamDeprecated:    "表示在大于等于该版本号时不再启作用",
+	UsageParamDefault:       "默认值",
+	UsageParamOptional:      "是否为可选的参数",
+	UsageParamNullable:      "是否可以为 null，仅对 JSON 等支持 null 值的格式有效。如果是数组，表示数组本身可以为 null，而不是其元素。",
+	UsageParamArray:         "是否为数组",
+	UsageParamItems:         "子类型，比如对象的子元素。",
+	UsageParamSummary:       "简要介绍",
+	UsageParamEnums:         "当前参数可用的枚举值",
+	UsageParamDescription:   "详细介绍，为 HTML 内容。",
+	UsageParamOneOf:         "联合类型的各个分支，只能匹配其中之一，不能与 any-of 同时使用。",
+	UsageParamAnyOf:         "联合类型的各个分支，至少匹配其中之一，不能与 one-of 同时使用。",
+	UsageParamDiscriminator: "用于区分 one-of 分支的字段名，该字段的值即为分支的 name 值。",
+	UsageParamArrayStyle:    "以数组的方式展示数据",
 
 	UsagePath:        "用于定义请求时与路径相关的内容",
 	UsagePathPath:    "接口地址",
 	UsagePathParams:  "地址中的参数",
 	UsagePathQueries: "地址中的查询参数",
 
-	UsageRequest:            "定义了请求和返回的相关内容",
-	UsageRequestName:        "当 mimetype 为 <var>application/xml</var> 时，此值表示 XML 的顶层元素名称，否则无用。",
-	UsageRequestType:        "值的类型",
-	UsageRequestDeprecated:  "表示在大于等于该版本号时不再启作用",
-	UsageRequestArray:       "是否为数组",
-	UsageRequestItems:       "子类型，比如对象的子元素。",
-	UsageRequestSummary:     "简要介绍",
-	UsageRequestStatus:      "状态码。在 request 中，该值不可用，否则为必填项。",
-	UsageRequestEnums:       "当前参数可用的枚举值",
-	UsageRequestDescription: "详细介绍，为 HTML 内容。",
-	UsageRequestMimetype:    "媒体类型，比如 <var>application/json</var> 等。",
-	UsageRequestExamples:    "示例代码",
-	UsageRequestHeaders:     "传递的报头内容",
+	UsageRequest:              "定义了请求和返回的相关内容",
+	UsageRequestName:          "当 mimetype 为 <var>application/xml</var> 时，此值表示 XML 的顶层元素名称，否则无用。",
+	UsageRequestType:          "值的类型",
+	UsageRequestDeprecated:    "表示在大于等于该版本号时不再启作用",
+	UsageRequestNullable:      "是否可以为 null，仅对 JSON 等支持 null 值的格式有效。如果是数组，表示数组本身可以为 null，而不是其元素。",
+	UsageRequestArray:         "是否为数组",
+	UsageRequestItems:         "子类型，比如对象的子元素。",
+	UsageRequestSummary:       "简要介绍",
+	UsageRequestStatus:        "状态码。在 request 中，该值不可用，否则为必填项。",
+	UsageRequestEnums:         "当前参数可用的枚举值",
+	UsageRequestDescription:   "详细介绍，为 HTML 内容。",
+	UsageRequestOneOf:         "联合类型的各个分支，只能匹配其中之一，不能与 any-of 同时使用。",
+	UsageRequestAnyOf:         "联合类型的各个分支，至少匹配其中之一，不能与 one-of 同时使用。",
+	UsageRequestDiscriminator: "用于区分 one-of 分支的字段名，该字段的值即为分支的 name 值。",
+	UsageRequestMimetype:      "媒体类型，比如 <var>application/json</var> 等。",
+	UsageRequestExamples:      "示例代码",
+	UsageRequestHeaders:       "传递的报头内容",
+	UsageRequestCookies:       "传递的 cookie 内容，仅支持简单类型。如果是返回对象，表示需要设置的 cookie。",
 
 	UsageRichtext:     "富文本内容",
 	UsageRichtextType: "指定富文本内容的格式，目前支持 <var>html</var> 和 <var>markdown</var>。",
@@ -183,6 +267,18 @@ mock 服务会根据接口定义检测用户提交的数据是否合法，并生
 	UsageTagTitle:      "标签的字面名称",
 	UsageTagDeprecated: "该标签在大于该版本时被弃用",
 
+	UsageTypeDef:              "可复用的类型定义",
+	UsageTypeDefName:          "类型的唯一名称，引用时需要加上 <samp>#</samp> 前缀。",
+	UsageTypeDefType:          "值的类型",
+	UsageTypeDefDeprecated:    "表示在大于等于该版本号时不再启作用",
+	UsageTypeDefItems:         "子类型，比如对象的子元素。",
+	UsageTypeDefSummary:       "简要介绍",
+	UsageTypeDefEnums:         "当前类型可用的枚举值",
+	UsageTypeDefDescription:   "详细介绍，为 HTML 内容。",
+	UsageTypeDefOneOf:         "联合类型的各个分支，只能匹配其中之一，不能与 any-of 同时使用。",
+	UsageTypeDefAnyOf:         "联合类型的各个分支，至少匹配其中之一，不能与 one-of 同时使用。",
+	UsageTypeDefDiscriminator: "用于区分 one-of 分支的字段名，该字段的值即为分支的 name 值。",
+
 	UsageServer:            "用于指定各个 API 的服务器地址",
 	UsageServerName:        "服务唯一 ID",
 	UsageServerTitle:       "服务的字面名称",
@@ -191,6 +287,33 @@ mock 服务会根据接口定义检测用户提交的数据是否合法，并生
 	UsageServerSummary:     "服务的摘要信息",
 	UsageServerDescription: "服务的详细描述",
 
+	UsageSecurity:                 "定义身份验证的方式",
+	UsageSecurityName:             "身份验证方式的唯一 ID",
+	UsageSecurityType:             "身份验证的类型，可以是 <var>apikey</var>、<var>http</var>、<var>oauth2</var> 和 <var>openidconnect</var>。",
+	UsageSecuritySummary:          "简要介绍",
+	UsageSecurityDescription:      "详细介绍，为 HTML 内容。",
+	UsageSecurityIn:               "<var>apikey</var> 所在的位置，可以是 <var>header</var>、<var>query</var> 和 <var>cookie</var>。",
+	UsageSecurityKey:              "<var>apikey</var> 对应的参数名称",
+	UsageSecurityScheme:           "<var>http</var> 验证方式的名称，比如 <var>basic</var>、<var>bearer</var> 等。",
+	UsageSecurityBearerFormat:     "<var>bearer</var> 令牌的格式，比如 <var>JWT</var>。",
+	UsageSecurityFlows:            "<var>oauth2</var> 支持的授权流程",
+	UsageSecurityOpenIDConnectURL: "<var>openidconnect</var> 的配置地址",
+
+	UsageOAuthFlow:                 "<var>oauth2</var> 的授权流程",
+	UsageOAuthFlowType:             "授权流程的类型，可以是 <var>implicit</var>、<var>password</var>、<var>client-credentials</var> 和 <var>authorization-code</var>。",
+	UsageOAuthFlowAuthorizationURL: "授权地址，<var>implicit</var> 和 <var>authorization-code</var> 必须指定。",
+	UsageOAuthFlowTokenURL:         "获取令牌的地址，<var>password</var>、<var>client-credentials</var> 和 <var>authorization-code</var> 必须指定。",
+	UsageOAuthFlowRefreshURL:       "刷新令牌的地址",
+	UsageOAuthFlowScopes:           "可用的权限范围",
+
+	UsageScope:        "权限范围",
+	UsageScopeName:    "权限范围的名称",
+	UsageScopeSummary: "简要介绍",
+
+	UsageSecurityValue:       "引用 <var>apidoc</var> 中定义的身份验证方式",
+	UsageSecurityValueName:   "身份验证方式的 ID",
+	UsageSecurityValueScopes: "需要的权限范围，仅对 <var>oauth2</var> 和 <var>openidconnect</var> 有效。",
+
 	UsageXMLAttr:    "是否作为父元素的属性，仅作用于 XML 元素。是否作为父元素的属性，仅用于 XML 的请求。",
 	UsageXMLExtract: "将当前元素的内容作为父元素的内容，要求父元素必须为 <var>object</var>。",
 	UsageXMLCData:   "当前内容为 CDATA，与 <code>@xml-attr</code> 互斥。",
@@ -202,6 +325,14 @@ mock 服务会根据接口定义检测用户提交的数据是否合法，并生
 	<li><samp>&gt;name</samp>：表示将当前数组元素的名称改为 <var>name</var>；</li>
 	</ul>`,
 
+	UsageConstraintMin:       "数值的最小值，仅作用于 <var>number</var> 类型。",
+	UsageConstraintMax:       "数值的最大值，仅作用于 <var>number</var> 类型。",
+	UsageConstraintMinLength: "字符串的最小长度，仅作用于 <var>string</var> 类型。",
+	UsageConstraintMaxLength: "字符串的最大长度，仅作用于 <var>string</var> 类型。",
+	UsageConstraintPattern:   "字符串需要匹配的正则表达式，仅作用于 <var>string</var> 类型。",
+	UsageConstraintMinItems:  "数组的最小长度，仅在 <code>@array</code> 为 <var>true</var> 时有效。",
+	UsageConstraintMaxItems:  "数组的最大长度，仅在 <code>@array</code> 为 <var>true</var> 时有效。",
+
 	// 基本类型
 	UsageString:  "普通的字符串类型，特殊字符需要使用 XML 实体，比如 <samp>&lt;</samp> 需要使用 <samp>&amp;lt;</samp> 代替。",
 	UsageNumber:  "普通的数值类型，比如：<samp>1</samp>、<samp>-11.1</samp> 等。",
@@ -213,6 +344,7 @@ mock 服务会根据接口定义检测用户提交的数据是否合法，并生
 	<li>空值；</li>
 	<li><var>bool</var> 布尔值；</li>
 	<li><var>object</var> 对象；</li>
+	<li><var>map</var> 键名为任意字符串的对象，值的类型由唯一的子元素 <code>param</code> 描述；</li>
 	<li><var>number</var> 数值类型；</li>
 	<li><var>number.int</var> 整数类型的数值；</li>
 	<li><var>number.float</var> 浮点类型的数值；</li>
@@ -223,6 +355,8 @@ mock 服务会根据接口定义检测用户提交的数据是否合法，并生
 	<li><var>string.date</var> 表示 <a href="https://tools.ietf.org/html/rfc3339#section-5.6">RFC3339</a> 中的 <code>full-date</code> 日期格式，比如 <samp>2020-01-02</samp>；</li>
 	<li><var>string.time</var> 表示 <a href="https://tools.ietf.org/html/rfc3339#section-5.6">RFC3339</a> 中的 <code>full-time</code> 时间格式，比如 <samp>15:16:17Z</samp>、<samp>15:16:17+08:00</samp>；</li>
 	<li><var>string.date-time</var> 表示 <a href="https://tools.ietf.org/html/rfc3339#section-5.6">RFC3339</a> 中的 <code>date-time</code> 格式，比如 <samp>2020-01-02T15:16:17-08:00</samp>；</li>
+	<li><var>string.binary</var> 二进制内容，比如 <code>multipart/form-data</code> 中上传的文件；</li>
+	<li><var>#name</var> 引用文档中由 <code>type</code> 元素定义的类型；</li>
 	</ul>`,
 
 	// 以下是有关 build.Config 的字段说明
@@ -235,12 +369,16 @@ mock 服务会根据接口定义检测用户提交的数据是否合法，并生
 	UsageConfigInputsEncoding:        `编码，默认为 <var>utf-8</var>，值可以是 <a href="https://www.iana.org/assignments/character-sets/character-sets.xhtml">character-sets</a> 中的内容。`,
 	UsageConfigInputsIgnores:         "忽略的文件或目录，比如 node_modules 等。",
 	UsageConfigOutput:                "控制输出行为",
-	UsageConfigOutputType:            "输出的类型，目前可以 <var>apidoc+xml</var>、<var>openapi+json</var> 和 <var>openapi+yaml</var>。",
+	UsageConfigOutputType:            "输出的类型，目前可以 <var>apidoc+xml</var>、<var>openapi+json</var>、<var>openapi+yaml</var>、<var>openapi3.1+json</var>、<var>openapi3.1+yaml</var>、<var>swagger+json</var>、<var>swagger+yaml</var>、<var>markdown</var>、<var>html</var>、<var>postman+json</var>、<var>typescript</var>、<var>go-client</var>、<var>go-server</var> 和 <var>jsonschema</var>。",
 	UsageConfigOutputPath:            "指定输出的文件名，包含路径信息。",
 	UsageConfigOutputTags:            "只输出与这些标签相关联的文档，默认为全部。",
 	UsageConfigOutputStyle:           "为 XML 文件指定的 XSL 文件",
 	UsageConfigOutputNamespace:       "是否输出命名空间",
 	UsageConfigOutputNamespacePrefix: "如果输出了命名空间，还可以指定命名空间前缀。",
+	UsageConfigOutputInlineSchema:    "是否内联 openapi 中所有的 schema，对 swagger 无效，默认会将结构相同的对象提取到 components.schemas 中并以 $ref 引用。",
+	UsageConfigOutputSplit:           "是否按标签将文档拆分成多个文件，仅对 <var>markdown</var> 有效。为 true 时，path 指定的文件只包含文档的基本信息和未指定标签的 API，各个标签的 API 保存在同目录下以标签名命名的文件中。",
+	UsageConfigOutputPackage:         "生成的 Go 代码的包名，仅对 <var>go-client</var> 和 <var>go-server</var> 有效。默认取 path 所在目录的名称，无法作为包名时采用 client 或是 server。",
+	UsageConfigLint:                  "代码检查的规则及其级别，键名为规则名称，键值可以是 <var>error</var>、<var>warning</var>、<var>info</var> 和 <var>off</var>，未指定的规则均为 <var>off</var>。可用的规则有：<var>api-summary</var>、<var>api-id</var>、<var>api-id-unique</var>、<var>path-kebab-case</var>、<var>api-4xx-response</var>、<var>deprecated-description</var>、<var>tag-unused</var> 和 <var>query-case</var>。文档中可以通过 <code>&lt;!-- lint-disable api-id --&gt;</code> 形式的注释禁用指定的规则，未指定规则名称时禁用所有规则。在 api 中的注释仅对当前 API 有效，在 apidoc 中则对整个文档有效。",
 
 	// 错误信息，可能在地方用到
 	ErrInvalidUTF8Character:      "无效的 UTF8 字符",
@@ -270,6 +408,12 @@ mock 服务会根据接口定义检测用户提交的数据是否合法，并生
 	ErrInvalidURIScheme:          "无效的 URI 协议：%s",
 	ErrInvalidURI:                "无效的 URI：%s",
 	ErrFileNotFound:              "未找到文件 %s",
+	ErrIgnored:                   "无法转换该内容，已忽略",
+	ErrCyclicReference:           "存在循环引用",
+	ErrUnauthorized:              "未提供有效的身份验证信息",
+	ErrBreakingChanges:           "存在 %d 处不兼容的修改",
+	ErrAmbiguousRoute:            "路由存在歧义",
+	ErrOutsideDir:                "文件 %s 位于输出目录之外",
 
 	// logs
 	InfoPrefix:    "[信息] ",
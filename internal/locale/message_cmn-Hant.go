@@ -22,11 +22,14 @@ mock 服務會根據接口定義檢測用戶提交的數據是否合法，並生
 對於數據只作檢測是否合規，但是無法理解其內容，比如提交地址中添加了 size=20，
 只會檢測 20 的類型是否符合 size 的要求，但是不會只返回給用戶 20 條數據。
 `,
-	CmdBuildUsage:  "生成文檔內容\n",
-	CmdStaticUsage: "啟用靜態文件服務\n",
-	CmdLSPUsage:    "啟動 language server protocol 服務\n",
-	Version:        "版本：%s\n文檔：%s\nLSP：%s\nopenapi：%s\nGo：%s",
-	CmdNotFound:    "子命令 %s 未找到\n",
+	CmdBuildUsage:   "生成文檔內容\n",
+	CmdStaticUsage:  "啟用靜態文件服務\n",
+	CmdLSPUsage:     "啟動 language server protocol 服務\n",
+	CmdImportUsage:  "將其它格式的文檔導入為 apidoc 文檔\n",
+	CmdUpgradeUsage: "將舊版本的文檔和配置文件升級到當前版本\n",
+	CmdDiffUsage:    "比較兩個文檔之間的差異\n\n用法為 diff [options] old new，old 和 new 為以 URI 形式表示的文檔地址。\n存在不兼容的修改時會返回錯誤，可用於在 CI 中檢測 API 是否被意外修改。\n",
+	Version:         "版本：%s\n文檔：%s\nLSP：%s\nopenapi：%s\nGo：%s",
+	CmdNotFound:     "子命令 %s 未找到\n",
 
 	FlagSyntaxDirUsage:         "以 `URI` 形式表示的測試項目地址",
 	FlagBuildDirUsage:          "以 `URI` 形式表示的項目地址",
@@ -58,6 +61,12 @@ mock 服務會根據接口定義檢測用戶提交的數據是否合法，並生
 	FlagLSPHeaderUsage:         "指定 LSP 傳遞內容是否帶報頭信息。",
 	FlagLSPTimeoutUsage:        "指定 LSP 每次讀取客戶端數據的超時時間，超時不會觸發錯誤，只會再次讀取。",
 	FlagVersionKindUsage:       "只顯示該類型的版本號，可以是 apidoc、doc、lsp、openapi 和 all",
+	FlagImportTypeUsage:        "導入的文檔類型，可以是 openapi、postman 和 har",
+	FlagImportInputUsage:       "以 `URI` 形式表示的待導入文檔地址",
+	FlagImportOutputUsage:      "以 `URI` 形式表示的輸出文檔地址",
+	FlagUpgradeDirUsage:        "以 `URI` 形式表示的待升級項目地址",
+	FlagUpgradeDryRunUsage:     "不修改文件，僅以 diff 的格式輸出需要修改的內容",
+	FlagDiffFormatUsage:        "輸出的格式，可以是 text、json 和 markdown",
 
 	VersionInCompatible: "當前程序與配置文件中指定的版本號不兼容",
 	Complete:            "完成！文檔保存在：%s，總用時：%v",
@@ -73,6 +82,68 @@ mock 服務會根據接口定義檢測用戶提交的數據是否合法，並生
 	ServerStart:         "服務啟動，可通過 %s 訪問",
 	UnimplementedRPC:    "未實現該 RPC 服務 %s",
 	PackFileHeader:      "文檔由 %s 自動生成，請勿手動修改！",
+	UpgradeFileSummary:  "%s：移除 type=\"none\" %d 處，移除 xml-ns %d 處，添加 xml-namespace %d 處，修改版本號 %d 處",
+	UpgradeNoChanges:    "沒有需要升級的內容",
+	DiffBreaking:        "不兼容",
+	DiffDeprecation:     "棄用",
+	DiffNonBreaking:     "兼容",
+	DiffTarget:          "位置",
+	DiffMessage:         "說明",
+	DiffSummary:         "不兼容的修改 %d 處，棄用 %d 處，兼容的修改 %d 處",
+	DiffAPIAdded:        "添加了該 API",
+	DiffAPIRemoved:      "刪除了該 API",
+	DiffAPIChanged:      "API 由 %s 修改為 %s",
+	DiffDeprecated:      "將於 %s 棄用",
+	DiffTypeChanged:     "類型由 %s 修改為 %s",
+	DiffParamAdded:      "添加了該參數",
+	DiffParamRemoved:    "刪除了該參數",
+	DiffParamRequired:   "由可選修改為必填",
+	DiffParamOptional:   "由必填修改為可選",
+	DiffEnumAdded:       "添加了枚舉值 %s",
+	DiffEnumRemoved:     "刪除了枚舉值 %s",
+	DiffStatusAdded:     "添加了狀態碼 %s",
+	DiffStatusRemoved:   "刪除了狀態碼 %s",
+	DiffMimetypeAdded:   "添加了 mimetype %s",
+	DiffMimetypeRemoved: "刪除了 mimetype %s",
+	DiffMimetypeChanged: "mimetype 由 %s 修改為 %s",
+
+	DiffParamNullable:     "由不可為 null 修改為可為 null",
+	DiffParamNotNullable:  "由可為 null 修改為不可為 null",
+	DiffBranchAdded:       "添加了分支 %s",
+	DiffBranchRemoved:     "刪除了分支 %s",
+	DiffConstraintAdded:   "添加了約束條件 %s",
+	DiffConstraintRemoved: "刪除了約束條件 %s",
+	DiffConstraintChanged: "約束條件由 %s 修改為 %s",
+
+	LintPathNotKebabCase:        "路徑 %s 不符合 kebab-case 命名規則",
+	LintNo4xxResponse:           "未聲明 4xx 的返回內容",
+	LintDeprecatedNoDescription: "已棄用的 API 缺少描述內容",
+	LintTagUnused:               "標簽 %s 未被任何 API 使用",
+	LintQueryCase:               "查詢參數 %s 不符合 %s 命名規則",
+
+	DocVersion:       "版本",
+	DocLicense:       "授權",
+	DocContact:       "聯繫方式",
+	DocServer:        "服務",
+	DocTag:           "標簽",
+	DocUncategorized: "未分類",
+	DocName:          "名稱",
+	DocURL:           "地址",
+	DocRequest:       "請求",
+	DocResponse:      "返回",
+	DocCallback:      "回調",
+	DocPathParam:     "路徑參數",
+	DocQuery:         "查詢參數",
+	DocHeader:        "報頭",
+	DocCookie:        "Cookie",
+	DocBody:          "報文",
+	DocExample:       "示例代碼",
+	DocVar:           "變量",
+	DocType:          "類型",
+	DocValue:         "值",
+	DocDescription:   "描述",
+	DocEnum:          "枚舉",
+	DocDeprecated:    "已於 %s 廢棄",
 
 	// 文檔樹中各個字段的介紹
 	UsageAPIDoc:              "用於描述整個文檔的相關內容，只能出現壹次。",
@@ -92,6 +163,8 @@ mock 服務會根據接口定義檢測用戶提交的數據是否合法，並生
 	UsageAPIDocResponses:     "文檔中所有 API 文檔都需要支持的返回內容",
 	UsageAPIDocMimetypes:     "文檔所支持的 mimetype",
 	UsageAPIDocXMLNamespaces: "針對 <var>application/xml</var> 類型的內容的命名空間設置",
+	UsageAPIDocTypes:         "文檔中定義的可復用類型，可通過 <samp>#name</samp> 的形式在 <var>type</var> 屬性中引用。",
+	UsageAPIDocSecurities:    "文檔中定義的所有身份驗證方式",
 
 	UsageXMLNamespace:       "為 <var>application/xml</var> 定義命名空間的相關屬性",
 	UsageXMLNamespacePrefix: "命名空間的前綴，如果為空，則表示作為默認命名空間，命局只能有壹個默認命名空間。",
@@ -109,8 +182,10 @@ mock 服務會根據接口定義檢測用戶提交的數據是否合法，並生
 	UsageAPICallback:    "定義回調接口內容",
 	UsageAPIDeprecated:  "在此版本之後將會被棄用",
 	UsageAPIHeaders:     "傳遞的報頭內容，如果是某個 mimetype 專用的，可以放在 request 元素中。",
+	UsageAPICookies:     "傳遞的 cookie 內容，僅支持簡單類型。",
 	UsageAPITags:        "關聯的標簽",
 	UsageAPIServers:     "關聯的服務",
+	UsageAPISecurities:  "訪問該接口需要的身份驗證方式，滿足其中任意壹項即可。",
 
 	UsageLink:     "用於描述鏈接信息，壹般轉換為 HTML 的 <code>a</code> 標簽。",
 	UsageLinkText: "鏈接的字面文字",
@@ -142,37 +217,46 @@ mock 服務會根據接口定義檢測用戶提交的數據是否合法，並生
 	UsageExampleSummary:  "示例代碼的概要信息",
 	UsageExampleContent:  "示例代碼的內容，需要使用 CDATA 包含代碼。",
 
-	UsageParam:            "參數類型，基本上可以作為 request 的子集使用。",
-	UsageParamName:        "值的名稱",
-	UsageParamType:        "值的類型",
-	UsageParamDeprecated:  "表示在大於等於該版本號時不再啟作用",
-	UsageParamDefault:     "默認值",
-	UsageParamOptional:    "是否為可選的參數",
-	UsageParamArray:       "是否為數組",
-	UsageParamItems:       "子類型，比如對象的子元素。",
-	UsageParamSummary:     "簡要介紹",
-	UsageParamEnums:       "當前參數可用的枚舉值",
-	UsageParamDescription: "詳細介紹，為 HTML 內容。",
-	UsageParamArrayStyle:  "以數組的方式展示數據",
+	UsageParam:              "參數類型，基本上可以作為 request 的子集使用。",
+	UsageParamName:          "值的名稱",
+	UsageParamType:          "值的類型",
+	UsageParamDeprecated:    "表示在大於等於該版本號時不再啟作用",
+	UsageParamDefault:       "默認值",
+	UsageParamOptional:      "是否為可選的參數",
+	UsageParamNullable:      "是否可以為 null，僅對 JSON 等支持 null 值的格式有效。如果是數組，表示數組本身可以為 null，而不是其元素。",
+	UsageParamArray:         "是否為數組",
+	UsageParamItems:         "子類型，比如對象的子元素。",
+	UsageParamSummary:       "簡要介紹",
+	UsageParamEnums:         "當前參數可用的枚舉值",
+	UsageParamDescription:   "詳細介紹，為 HTML 內容。",
+	UsageParamOneOf:         "聯合類型的各個分支，只能匹配其中之壹，不能與 any-of 同時使用。",
+	UsageParamAnyOf:         "聯合類型的各個分支，至少匹配其中之壹，不能與 one-of 同時使用。",
+	UsageParamDiscriminator: "用於區分 one-of 分支的字段名，該字段的值即為分支的 name 值。",
+	UsageParamArrayStyle:    "以數組的方式展示數據",
 
 	UsagePath:        "用於定義請求時與路徑相關的內容",
 	UsagePathPath:    "接口地址",
 	UsagePathParams:  "地址中的參數",
 	UsagePathQueries: "地址中的查詢參數",
 
-	UsageRequest:            "定義了請求和返回的相關內容",
-	UsageRequestName:        "當 mimetype 為 <var>application/xml</var> 時，此值表示 XML 的頂層元素名稱，否則無用。",
-	UsageRequestType:        "值的類型",
-	UsageRequestDeprecated:  "表示在大於等於該版本號時不再啟作用",
-	UsageRequestArray:       "是否為數組",
-	UsageRequestItems:       "子類型，比如對象的子元素。",
-	UsageRequestSummary:     "簡要介紹",
-	UsageRequestStatus:      "狀態碼。在 request 中，該值不可用，否則為必填項。",
-	UsageRequestEnums:       "當前參數可用的枚舉值",
-	UsageRequestDescription: "詳細介紹，為 HTML 內容。",
-	UsageRequestMimetype:    "媒體類型，比如 <var>application/json</var> 等。",
-	UsageRequestExamples:    "示例代碼",
-	UsageRequestHeaders:     "傳遞的報頭內容",
+	UsageRequest:              "定義了請求和返回的相關內容",
+	UsageRequestName:          "當 mimetype 為 <var>application/xml</var> 時，此值表示 XML 的頂層元素名稱，否則無用。",
+	UsageRequestType:          "值的類型",
+	UsageRequestDeprecated:    "表示在大於等於該版本號時不再啟作用",
+	UsageRequestNullable:      "是否可以為 null，僅對 JSON 等支持 null 值的格式有效。如果是數組，表示數組本身可以為 null，而不是其元素。",
+	UsageRequestArray:         "是否為數組",
+	UsageRequestItems:         "子類型，比如對象的子元素。",
+	UsageRequestSummary:       "簡要介紹",
+	UsageRequestStatus:        "狀態碼。在 request 中，該值不可用，否則為必填項。",
+	UsageRequestEnums:         "當前參數可用的枚舉值",
+	UsageRequestDescription:   "詳細介紹，為 HTML 內容。",
+	UsageRequestOneOf:         "聯合類型的各個分支，只能匹配其中之壹，不能與 any-of 同時使用。",
+	UsageRequestAnyOf:         "聯合類型的各個分支，至少匹配其中之壹，不能與 one-of 同時使用。",
+	UsageRequestDiscriminator: "用於區分 one-of 分支的字段名，該字段的值即為分支的 name 值。",
+	UsageRequestMimetype:      "媒體類型，比如 <var>application/json</var> 等。",
+	UsageRequestExamples:      "示例代碼",
+	UsageRequestHeaders:       "傳遞的報頭內容",
+	UsageRequestCookies:       "傳遞的 cookie 內容，僅支持簡單類型。如果是返回對象，表示需要設置的 cookie。",
 
 	UsageRichtext:     "富文本內容",
 	UsageRichtextType: "指定富文本內容的格式，目前支持 <var>html</var> 和 <var>markdown</var>。",
@@ -183,6 +267,18 @@ mock 服務會根據接口定義檢測用戶提交的數據是否合法，並生
 	UsageTagTitle:      "標簽的字面名稱",
 	UsageTagDeprecated: "該標簽在大於該版本時被棄用",
 
+	UsageTypeDef:              "可復用的類型定義",
+	UsageTypeDefName:          "類型的唯一名稱，引用時需要加上 <samp>#</samp> 前綴。",
+	UsageTypeDefType:          "值的類型",
+	UsageTypeDefDeprecated:    "表示在大於等於該版本號時不再啟作用",
+	UsageTypeDefItems:         "子類型，比如對象的子元素。",
+	UsageTypeDefSummary:       "簡要介紹",
+	UsageTypeDefEnums:         "當前類型可用的枚舉值",
+	UsageTypeDefDescription:   "詳細介紹，為 HTML 內容。",
+	UsageTypeDefOneOf:         "聯合類型的各個分支，只能匹配其中之壹，不能與 any-of 同時使用。",
+	UsageTypeDefAnyOf:         "聯合類型的各個分支，至少匹配其中之壹，不能與 one-of 同時使用。",
+	UsageTypeDefDiscriminator: "用於區分 one-of 分支的字段名，該字段的值即為分支的 name 值。",
+
 	UsageServer:            "用於指定各個 API 的服務器地址",
 	UsageServerName:        "服務唯壹 ID",
 	UsageServerTitle:       "服務的字面名稱",
@@ -191,6 +287,33 @@ mock 服務會根據接口定義檢測用戶提交的數據是否合法，並生
 	UsageServerSummary:     "服務的摘要信息",
 	UsageServerDescription: "服務的詳細描述",
 
+	UsageSecurity:                 "定義身份驗證的方式",
+	UsageSecurityName:             "身份驗證方式的唯壹 ID",
+	UsageSecurityType:             "身份驗證的類型，可以是 <var>apikey</var>、<var>http</var>、<var>oauth2</var> 和 <var>openidconnect</var>。",
+	UsageSecuritySummary:          "簡要介紹",
+	UsageSecurityDescription:      "詳細介紹，為 HTML 內容。",
+	UsageSecurityIn:               "<var>apikey</var> 所在的位置，可以是 <var>header</var>、<var>query</var> 和 <var>cookie</var>。",
+	UsageSecurityKey:              "<var>apikey</var> 對應的參數名稱",
+	UsageSecurityScheme:           "<var>http</var> 驗證方式的名稱，比如 <var>basic</var>、<var>bearer</var> 等。",
+	UsageSecurityBearerFormat:     "<var>bearer</var> 令牌的格式，比如 <var>JWT</var>。",
+	UsageSecurityFlows:            "<var>oauth2</var> 支持的授權流程",
+	UsageSecurityOpenIDConnectURL: "<var>openidconnect</var> 的配置地址",
+
+	UsageOAuthFlow:                 "<var>oauth2</var> 的授權流程",
+	UsageOAuthFlowType:             "授權流程的類型，可以是 <var>implicit</var>、<var>password</var>、<var>client-credentials</var> 和 <var>authorization-code</var>。",
+	UsageOAuthFlowAuthorizationURL: "授權地址，<var>implicit</var> 和 <var>authorization-code</var> 必須指定。",
+	UsageOAuthFlowTokenURL:         "獲取令牌的地址，<var>password</var>、<var>client-credentials</var> 和 <var>authorization-code</var> 必須指定。",
+	UsageOAuthFlowRefreshURL:       "刷新令牌的地址",
+	UsageOAuthFlowScopes:           "可用的權限範圍",
+
+	UsageScope:        "權限範圍",
+	UsageScopeName:    "權限範圍的名稱",
+	UsageScopeSummary: "簡要介紹",
+
+	UsageSecurityValue:       "引用 <var>apidoc</var> 中定義的身份驗證方式",
+	UsageSecurityValueName:   "身份驗證方式的 ID",
+	UsageSecurityValueScopes: "需要的權限範圍，僅對 <var>oauth2</var> 和 <var>openidconnect</var> 有效。",
+
 	UsageXMLAttr:    "是否作為父元素的屬性，僅作用於 XML 元素。是否作為父元素的屬性，僅用於 XML 的請求。",
 	UsageXMLExtract: "將當前元素的內容作為父元素的內容，要求父元素必須為 <var>object</var>。",
 	UsageXMLCData:   "當前內容為 CDATA，與 <code>@xml-attr</code> 互斥。",
@@ -202,6 +325,14 @@ mock 服務會根據接口定義檢測用戶提交的數據是否合法，並生
 	<li><samp>&gt;name</samp>：表示將當前數組元素的名稱改為 <var>name</var>；</li>
 	</ul>`,
 
+	UsageConstraintMin:       "數值的最小值，僅作用於 <var>number</var> 類型。",
+	UsageConstraintMax:       "數值的最大值，僅作用於 <var>number</var> 類型。",
+	UsageConstraintMinLength: "字符串的最小長度，僅作用於 <var>string</var> 類型。",
+	UsageConstraintMaxLength: "字符串的最大長度，僅作用於 <var>string</var> 類型。",
+	UsageConstraintPattern:   "字符串需要匹配的正則表達式，僅作用於 <var>string</var> 類型。",
+	UsageConstraintMinItems:  "數組的最小長度，僅在 <code>@array</code> 為 <var>true</var> 時有效。",
+	UsageConstraintMaxItems:  "數組的最大長度，僅在 <code>@array</code> 為 <var>true</var> 時有效。",
+
 	// 基本类型
 	UsageString:  "普通的字符串類型，特殊字符需要使用 XML 實體，比如 <samp>&lt;</samp> 需要使用 <samp>&amp;lt;</samp> 代替。",
 	UsageNumber:  "普通的數值類型，比如：<samp>1</samp>、<samp>-11.1</samp> 等。",
@@ -213,6 +344,7 @@ mock 服務會根據接口定義檢測用戶提交的數據是否合法，並生
 	<li>空值；</li>
 	<li><var>bool</var> 布爾值；</li>
 	<li><var>object</var> 對象；</li>
+	<li><var>map</var> 鍵名為任意字符串的對象，值的類型由唯壹的子元素 <code>param</code> 描述；</li>
 	<li><var>number</var> 數值類型；</li>
 	<li><var>number.int</var> 整數類型的數值；</li>
 	<li><var>number.float</var> 浮點類型的數值；</li>
@@ -223,6 +355,8 @@ mock 服務會根據接口定義檢測用戶提交的數據是否合法，並生
 	<li><var>string.date</var> 表示 <a href="https://tools.ietf.org/html/rfc3339#section-5.6">RFC3339</a> 中的 <code>full-date</code> 日期格式，比如 <samp>2020-01-02</samp>；</li>
 	<li><var>string.time</var> 表示 <a href="https://tools.ietf.org/html/rfc3339#section-5.6">RFC3339</a> 中的 <code>full-time</code> 時間格式，比如 <samp>15:16:17Z</samp>、<samp>15:16:17+08:00</samp>；</li>
 	<li><var>string.date-time</var> 表示 <a href="https://tools.ietf.org/html/rfc3339#section-5.6">RFC3339</a> 中的 <code>date-time</code> 格式，比如 <samp>2020-01-02T15:16:17-08:00</samp>；</li>
+	<li><var>string.binary</var> 二進制內容，比如 <code>multipart/form-data</code> 中上傳的文件；</li>
+	<li><var>#name</var> 引用文檔中由 <code>type</code> 元素定義的類型；</li>
 	</ul>`,
 
 	// 以下是有关 build.Config 的字段说明
@@ -235,12 +369,16 @@ mock 服務會根據接口定義檢測用戶提交的數據是否合法，並生
 	UsageConfigInputsEncoding:        `編碼，默認為 <var>utf-8</var>，值可以是 <a href="https://www.iana.org/assignments/character-sets/character-sets.xhtml">character-sets</a> 中的內容。`,
 	UsageConfigInputsIgnores:         "忽略的文件或目錄，比如 node_modules 等。",
 	UsageConfigOutput:                "控制輸出行為",
-	UsageConfigOutputType:            "輸出的類型，目前可以 <var>apidoc+xml</var>、<var>openapi+json</var> 和 <var>openapi+yaml</var>。",
+	UsageConfigOutputType:            "輸出的類型，目前可以 <var>apidoc+xml</var>、<var>openapi+json</var>、<var>openapi+yaml</var>、<var>openapi3.1+json</var>、<var>openapi3.1+yaml</var>、<var>swagger+json</var>、<var>swagger+yaml</var>、<var>markdown</var>、<var>html</var>、<var>postman+json</var>、<var>typescript</var>、<var>go-client</var>、<var>go-server</var> 和 <var>jsonschema</var>。",
 	UsageConfigOutputPath:            "指定輸出的文件名，包含路徑信息。",
 	UsageConfigOutputTags:            "只輸出與這些標簽相關聯的文檔，默認為全部。",
 	UsageConfigOutputStyle:           "為 XML 文件指定的 XSL 文件",
 	UsageConfigOutputNamespace:       "是否輸出命名空間",
 	UsageConfigOutputNamespacePrefix: "如果輸出了命名空間，還可以指定命名空間前綴。",
+	UsageConfigOutputInlineSchema:    "是否內聯 openapi 中所有的 schema，對 swagger 無效，默認會將結構相同的對象提取到 components.schemas 中並以 $ref 引用。",
+	UsageConfigOutputSplit:           "是否按標簽將文檔拆分成多個文件，僅對 <var>markdown</var> 有效。為 true 時，path 指定的文件只包含文檔的基本信息和未指定標簽的 API，各個標簽的 API 保存在同目錄下以標簽名命名的文件中。",
+	UsageConfigOutputPackage:         "生成的 Go 代碼的包名，僅對 <var>go-client</var> 和 <var>go-server</var> 有效。默認取 path 所在目錄的名稱，無法作為包名時采用 client 或是 server。",
+	UsageConfigLint:                  "代碼檢查的規則及其級別，鍵名為規則名稱，鍵值可以是 <var>error</var>、<var>warning</var>、<var>info</var> 和 <var>off</var>，未指定的規則均為 <var>off</var>。可用的規則有：<var>api-summary</var>、<var>api-id</var>、<var>api-id-unique</var>、<var>path-kebab-case</var>、<var>api-4xx-response</var>、<var>deprecated-description</var>、<var>tag-unused</var> 和 <var>query-case</var>。文檔中可以通過 <code>&lt;!-- lint-disable api-id --&gt;</code> 形式的注釋禁用指定的規則，未指定規則名稱時禁用所有規則。在 api 中的注釋僅對當前 API 有效，在 apidoc 中則對整個文檔有效。",
 
 	// 錯誤信息，可能在地方用到
 	ErrInvalidUTF8Character:      "無效的 UTF8 字符",
@@ -270,6 +408,12 @@ mock 服務會根據接口定義檢測用戶提交的數據是否合法，並生
 	ErrInvalidURIScheme:          "無效的 URI 協議：%s",
 	ErrInvalidURI:                "無效的 URI：%s",
 	ErrFileNotFound:              "未找到文件 %s",
+	ErrIgnored:                   "無法轉換該內容，已忽略",
+	ErrCyclicReference:           "存在循環引用",
+	ErrUnauthorized:              "未提供有效的身份驗證信息",
+	ErrBreakingChanges:           "存在 %d 處不兼容的修改",
+	ErrAmbiguousRoute:            "路由存在歧義",
+	ErrOutsideDir:                "文件 %s 位於輸出目錄之外",
 
 	// logs
 	InfoPrefix:    "[信息] ",
@@ -0,0 +1,74 @@
+// SPDX-License-Identifier: MIT
+
+package lsp
+
+import (
+	"reflect"
+
+	"github.com/caixw/apidoc/v7/core"
+	"github.com/caixw/apidoc/v7/internal/locale"
+	"github.com/caixw/apidoc/v7/internal/lsp/protocol"
+	"github.com/caixw/apidoc/v7/internal/node"
+)
+
+// 表示 XML 标签
+type tagger interface {
+	core.Searcher
+	SelfClose() bool
+}
+
+var taggerType = reflect.TypeOf((*tagger)(nil)).Elem()
+
+// textDocument/completion
+//
+// 根据当前位置所在的标签，列出该标签可用的属性和子元素。
+//
+// https://microsoft.github.io/language-server-protocol/specifications/specification-current/#textDocument_completion
+func (s *server) textDocumentCompletion(notify bool, in *protocol.CompletionParams, out *protocol.CompletionList) error {
+	f := s.findFolder(in.TextDocument.URI)
+	if f == nil {
+		return nil
+	}
+
+	f.parsedMux.RLock()
+	defer f.parsedMux.RUnlock()
+
+	if tag := f.doc.Search(in.TextDocument.URI, in.TextDocumentPositionParams.Position, taggerType); tag != nil {
+		out.Items = completionItems(tag.(tagger))
+	}
+	return nil
+}
+
+// 自闭合的标签不会返回子元素
+func completionItems(tag tagger) []protocol.CompletionItem {
+	n := node.New("", reflect.New(node.RealType(reflect.TypeOf(tag))))
+
+	items := make([]protocol.CompletionItem, 0, len(n.Attributes)+len(n.Elements))
+	for _, attr := range n.Attributes {
+		items = append(items, newCompletionItem(attr, protocol.CompletionItemKindProperty))
+	}
+
+	if !tag.SelfClose() {
+		for _, elem := range n.Elements {
+			items = append(items, newCompletionItem(elem, protocol.CompletionItemKindStruct))
+		}
+	}
+
+	return items
+}
+
+func newCompletionItem(v *node.Value, kind protocol.CompletionItemKind) protocol.CompletionItem {
+	item := protocol.CompletionItem{
+		Label: v.Name,
+		Kind:  kind,
+	}
+
+	if v.Usage != "" {
+		item.Documentation = &protocol.MarkupContent{
+			Kind:  protocol.MarkupKindMarkdown,
+			Value: locale.Sprintf(v.Usage),
+		}
+	}
+
+	return item
+}
@@ -0,0 +1,94 @@
+// SPDX-License-Identifier: MIT
+
+package lsp
+
+import (
+	"io/ioutil"
+	"log"
+	"testing"
+
+	"github.com/issue9/assert"
+
+	"github.com/caixw/apidoc/v7/core"
+	"github.com/caixw/apidoc/v7/core/messagetest"
+	"github.com/caixw/apidoc/v7/internal/ast"
+	"github.com/caixw/apidoc/v7/internal/locale"
+	"github.com/caixw/apidoc/v7/internal/lsp/protocol"
+)
+
+func TestServer_textDocumentCompletion(t *testing.T) {
+	a := assert.New(t)
+	s := newTestServer(true, log.New(ioutil.Discard, "", 0), log.New(ioutil.Discard, "", 0))
+	list := &protocol.CompletionList{}
+	err := s.textDocumentCompletion(false, &protocol.CompletionParams{}, list)
+	a.NotError(err).Empty(list.Items)
+
+	const b = `<apidoc version="1.1.1">
+	<title>标题</title>
+	<mimetype>json</mimetype>
+	<type name="pet" type="object" summary="pet" discriminator="kind">
+		<param name="kind" type="string" summary="kind" />
+		<one-of name="dog" type="object" summary="dog">
+			<param name="bark" type="bool" summary="bark" />
+		</one-of>
+	</type>
+</apidoc>`
+	blk := core.Block{Data: []byte(b), Location: core.Location{URI: "file:///test/doc.go"}}
+	rslt := messagetest.NewMessageHandler()
+	doc := &ast.APIDoc{}
+	doc.Parse(rslt.Handler, blk)
+	rslt.Handler.Stop()
+	a.Empty(rslt.Errors)
+
+	s.folders = []*folder{
+		{
+			WorkspaceFolder: protocol.WorkspaceFolder{Name: "test", URI: "file:///test"},
+			doc:             doc,
+		},
+	}
+
+	find := func(items []protocol.CompletionItem, label string) *protocol.CompletionItem {
+		for _, item := range items {
+			if item.Label == label {
+				return &item
+			}
+		}
+		return nil
+	}
+
+	// one-of
+	list = &protocol.CompletionList{}
+	err = s.textDocumentCompletion(false, &protocol.CompletionParams{TextDocumentPositionParams: protocol.TextDocumentPositionParams{
+		TextDocument: protocol.TextDocumentIdentifier{URI: "file:///test/doc.go"},
+		Position:     core.Position{Line: 5, Character: 3},
+	}}, list)
+	a.NotError(err).NotEmpty(list.Items)
+	item := find(list.Items, "one-of")
+	a.NotNil(item).
+		Equal(item.Kind, protocol.CompletionItemKindStruct).
+		Equal(item.Documentation.Value, locale.Sprintf(locale.UsageParamOneOf))
+	item = find(list.Items, "discriminator")
+	a.NotNil(item).
+		Equal(item.Kind, protocol.CompletionItemKindProperty).
+		Equal(item.Documentation.Value, locale.Sprintf(locale.UsageParamDiscriminator))
+
+	// 自闭合标签不返回子元素
+	list = &protocol.CompletionList{}
+	err = s.textDocumentCompletion(false, &protocol.CompletionParams{TextDocumentPositionParams: protocol.TextDocumentPositionParams{
+		TextDocument: protocol.TextDocumentIdentifier{URI: "file:///test/doc.go"},
+		Position:     core.Position{Line: 4, Character: 3},
+	}}, list)
+	a.NotError(err).
+		NotNil(find(list.Items, "name")).
+		Nil(find(list.Items, "one-of"))
+
+	// type
+	list = &protocol.CompletionList{}
+	err = s.textDocumentCompletion(false, &protocol.CompletionParams{TextDocumentPositionParams: protocol.TextDocumentPositionParams{
+		TextDocument: protocol.TextDocumentIdentifier{URI: "file:///test/doc.go"},
+		Position:     core.Position{Line: 3, Character: 3},
+	}}, list)
+	a.NotError(err)
+	item = find(list.Items, "one-of")
+	a.NotNil(item).Equal(item.Documentation.Value, locale.Sprintf(locale.UsageTypeDefOneOf))
+}
@@ -13,7 +13,9 @@ import (
 	"github.com/caixw/apidoc/v7/build"
 	"github.com/caixw/apidoc/v7/core"
 	"github.com/caixw/apidoc/v7/internal/ast"
+	"github.com/caixw/apidoc/v7/internal/lint"
 	"github.com/caixw/apidoc/v7/internal/lsp/protocol"
+	"github.com/caixw/apidoc/v7/internal/mock"
 )
 
 // 表示项目文件夹
@@ -47,11 +49,20 @@ func (f *folder) messageHandler(msg *core.Message) {
 	}
 
 	if p, found := f.diagnostics[err.Location.URI]; found && p != nil {
-		cnt := sliceutil.Count(p.Diagnostics, func(i int) bool {
-			return p.Diagnostics[i].Range.Equal(err.Location.Range)
+		// 范围、代码和内容都相同才被认为是重复的，同一位置可能存在多个不同的错误。
+		size := len(p.Diagnostics)
+		p.AppendDiagnostic(err, msg.Type)
+		if len(p.Diagnostics) == size {
+			return
+		}
+
+		d := p.Diagnostics[size]
+		cnt := sliceutil.Count(p.Diagnostics[:size], func(i int) bool {
+			item := p.Diagnostics[i]
+			return item.Range.Equal(d.Range) && item.Code == d.Code && item.Message == d.Message
 		})
-		if cnt == 0 {
-			p.AppendDiagnostic(err, msg.Type)
+		if cnt > 0 {
+			p.Diagnostics = p.Diagnostics[:size]
 		}
 		return
 	}
@@ -109,6 +120,8 @@ func (f *folder) refresh(force bool) {
 	f.doc.ParseBlocks(f.h, func(blocks chan core.Block) {
 		build.ParseInputs(blocks, f.h, f.cfg.Inputs...)
 	})
+	mock.ValidExamples(f.h, f.doc)
+	lint.Lint(f.h, f.doc, f.cfg.Lint)
 
 	if err = f.srv.apidocOutline(f); err != nil {
 		f.srv.printErr(err)
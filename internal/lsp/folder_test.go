@@ -37,6 +37,19 @@ func TestFolder_messageHandler(t *testing.T) {
 	f.messageHandler(&core.Message{Type: core.Warn, Message: &core.Error{Location: core.Location{URI: "uri"}, Err: err}})
 	a.Equal(1, len(f.diagnostics))
 
+	// 相同范围内的不同错误都会被添加
+	f = &folder{srv: s, diagnostics: map[core.URI]*protocol.PublishDiagnosticsParams{}}
+	loc := core.Location{URI: "uri"}
+	f.messageHandler(&core.Message{Type: core.Erro, Message: &core.Error{Location: loc, Err: err}})
+	f.messageHandler(&core.Message{Type: core.Erro, Message: &core.Error{Location: loc, Err: err}})
+	a.Equal(1, len(f.diagnostics["uri"].Diagnostics))
+	f.messageHandler(&core.Message{Type: core.Erro, Message: &core.Error{Location: loc, Err: locale.NewError(locale.ErrInvalidXML)}})
+	a.Equal(2, len(f.diagnostics["uri"].Diagnostics))
+	f.messageHandler(&core.Message{Type: core.Erro, Message: &core.Error{Location: loc, Err: err, Code: "code"}})
+	a.Equal(3, len(f.diagnostics["uri"].Diagnostics))
+	f.messageHandler(&core.Message{Type: core.Succ, Message: &core.Error{Location: loc, Err: err}})
+	a.Equal(3, len(f.diagnostics["uri"].Diagnostics))
+
 	f = &folder{srv: s, diagnostics: map[core.URI]*protocol.PublishDiagnosticsParams{}}
 	a.PanicString(func() {
 		f.messageHandler(&core.Message{Message: &core.Error{}, Type: -100})
@@ -62,3 +62,43 @@ func TestServer_textDocumentHover(t *testing.T) {
 	})
 	a.Equal(h.Contents.Value, locale.Sprintf("usage-apidoc-title"))
 }
+
+func TestServer_textDocumentHover_cookie(t *testing.T) {
+	a := assert.New(t)
+	s := newTestServer(true, log.New(ioutil.Discard, "", 0), log.New(ioutil.Discard, "", 0))
+
+	const b = `<apidoc version="1.1.1">
+	<title>标题</title>
+	<mimetype>json</mimetype>
+	<api method="GET">
+		<path path="/users" />
+		<cookie name="sid" type="string" summary="sid" />
+		<response status="200" />
+	</api>
+</apidoc>`
+	blk := core.Block{Data: []byte(b), Location: core.Location{URI: "file:///test/doc.go"}}
+	rslt := messagetest.NewMessageHandler()
+	doc := &ast.APIDoc{}
+	doc.Parse(rslt.Handler, blk)
+	rslt.Handler.Stop()
+	a.Empty(rslt.Errors)
+
+	s.folders = []*folder{
+		{
+			WorkspaceFolder: protocol.WorkspaceFolder{Name: "test", URI: "file:///test"},
+			doc:             doc,
+		},
+	}
+
+	h := &protocol.Hover{}
+	err := s.textDocumentHover(false, &protocol.HoverParams{TextDocumentPositionParams: protocol.TextDocumentPositionParams{
+		TextDocument: protocol.TextDocumentIdentifier{URI: "file:///test/doc.go"},
+		Position:     core.Position{Line: 5, Character: 3},
+	}}, h)
+	a.NotError(err)
+	a.Equal(h.Range, core.Range{
+		Start: core.Position{Line: 5, Character: 2},
+		End:   core.Position{Line: 5, Character: 51},
+	})
+	a.Equal(h.Contents.Value, locale.Sprintf("usage-api-cookies"))
+}
@@ -144,6 +144,7 @@ func buildDiagnostic(err *core.Error, severity DiagnosticSeverity) Diagnostic {
 		Range:    err.Location.Range,
 		Message:  msg,
 		Severity: severity,
+		Code:     err.Code,
 		Source:   core.Name,
 	}
 	if len(tags) > 0 {
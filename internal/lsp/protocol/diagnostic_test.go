@@ -57,4 +57,8 @@ func TestBuildDiagnostic(t *testing.T) {
 	a.Equal(d.Range.Start.Line, 1).
 		Equal(1, len(d.RelatedInformation)).
 		Equal(d.RelatedInformation[0].Location, core.Location{URI: "relate.go"})
+
+	err = err.WithCode("api-id")
+	d = buildDiagnostic(err, DiagnosticSeverityWarning)
+	a.Equal(d.Code, "api-id")
 }
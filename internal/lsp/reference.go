@@ -47,7 +47,9 @@ func (s *server) textDocumentDefinition(notify bool, in *protocol.DefinitionPara
 	defer f.parsedMux.RUnlock()
 
 	if r := f.doc.Search(in.TextDocument.URI, in.TextDocumentPositionParams.Position, definitionerType); r != nil {
-		*out = []core.Location{r.(ast.Definitioner).Definition().Location}
+		if def := r.(ast.Definitioner).Definition(); def != nil { // 未引用任何内容，比如非 #name 形式的 type 属性。
+			*out = []core.Location{def.Location}
+		}
 	}
 	return nil
 }
@@ -32,6 +32,11 @@ func loadReferencesDoc(a *assert.Assertion) *ast.APIDoc {
 		<path path="/users" />
 		<response status="200" />
 	</api>
+	<type name="uid" type="number" summary="uid" />
+	<api method="DELETE">
+		<path path="/users/{id}"><param name="id" type="#uid" summary="id" /></path>
+		<response status="204" />
+	</api>
 </apidoc>`
 
 	blk := core.Block{Data: []byte(referenceDefinitionDoc), Location: core.Location{URI: "file:///root/doc.go"}}
@@ -98,6 +103,28 @@ func TestServer_textDocumentDefinition(t *testing.T) {
 			End:   core.Position{Line: 3, Character: 31},
 		},
 	})
+
+	// 引用 type
+	err = s.textDocumentDefinition(false, &protocol.DefinitionParams{TextDocumentPositionParams: protocol.TextDocumentPositionParams{
+		TextDocument: protocol.TextDocumentIdentifier{URI: "file:///root/doc.go"},
+		Position:     core.Position{Line: 18, Character: 46},
+	}}, &locs)
+	a.NotError(err).Equal(len(locs), 1)
+	a.Equal(locs[0], core.Location{
+		URI: "file:///root/doc.go",
+		Range: core.Range{
+			Start: core.Position{Line: 16, Character: 1},
+			End:   core.Position{Line: 16, Character: 48},
+		},
+	})
+
+	// 非引用类型的 type
+	locs = nil
+	err = s.textDocumentDefinition(false, &protocol.DefinitionParams{TextDocumentPositionParams: protocol.TextDocumentPositionParams{
+		TextDocument: protocol.TextDocumentIdentifier{URI: "file:///root/doc.go"},
+		Position:     core.Position{Line: 16, Character: 20},
+	}}, &locs)
+	a.NotError(err).Empty(locs)
 }
 
 func TestReferences(t *testing.T) {
@@ -122,4 +149,16 @@ func TestReferences(t *testing.T) {
 	pos = core.Position{Line: 3, Character: 16}
 	locs = references(doc, "file:///root/doc.go", pos, true)
 	a.Equal(len(locs), 3)
+
+	// type
+	pos = core.Position{Line: 16, Character: 5}
+	locs = references(doc, "file:///root/doc.go", pos, false)
+	a.Equal(len(locs), 1).
+		Equal(locs[0], core.Location{
+			URI: "file:///root/doc.go",
+			Range: core.Range{
+				Start: core.Position{Line: 18, Character: 44},
+				End:   core.Position{Line: 18, Character: 55},
+			},
+		})
 }
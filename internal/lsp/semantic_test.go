@@ -104,6 +104,7 @@ func TestSemanticTokens(t *testing.T) {
 
 	<api method="POST">
 		<path path="/users" />
+		<cookie name="sid" type="string" summary="sid" />
 		<response status="200" type="number" />
 	</api>
 </apidoc>`
@@ -153,6 +154,14 @@ func TestSemanticTokens(t *testing.T) {
 		0, 5, 4, 2, 0,
 		0, 6, 6, 3, 0,
 
+		1, 3, 6, 1, 0, // cookie
+		0, 7, 4, 2, 0,
+		0, 6, 3, 3, 0,
+		0, 5, 4, 2, 0,
+		0, 6, 6, 3, 0,
+		0, 8, 7, 2, 0,
+		0, 9, 3, 3, 0,
+
 		1, 3, 8, 1, 0, // response
 		0, 9, 6, 2, 0,
 		0, 8, 3, 3, 0,
@@ -11,7 +11,9 @@ import (
 	"github.com/caixw/apidoc/v7/core"
 	"github.com/caixw/apidoc/v7/internal/ast"
 	"github.com/caixw/apidoc/v7/internal/lang"
+	"github.com/caixw/apidoc/v7/internal/lint"
 	"github.com/caixw/apidoc/v7/internal/lsp/protocol"
+	"github.com/caixw/apidoc/v7/internal/mock"
 )
 
 // textDocument/didChange
@@ -34,6 +36,8 @@ func (s *server) textDocumentDidChange(notify bool, in *protocol.DidChangeTextDo
 	for _, blk := range in.Blocks() {
 		f.parseBlock(blk)
 	}
+	mock.ValidExamples(f.h, f.doc)
+	lint.Lint(f.h, f.doc, f.cfg.Lint)
 	f.srv.textDocumentPublishDiagnostics(f)
 
 	return nil
@@ -132,11 +136,3 @@ func (s *server) textDocumentFoldingRange(notify bool, in *protocol.FoldingRange
 
 	return nil
 }
-
-// textDocument/completion
-//
-// https://microsoft.github.io/language-server-protocol/specifications/specification-current/#textDocument_completion
-func (s *server) textDocumentCompletion(notify bool, in *protocol.CompletionParams, out *protocol.CompletionList) error {
-	// TODO
-	return nil
-}
@@ -0,0 +1,182 @@
+// SPDX-License-Identifier: MIT
+
+package markdown
+
+import (
+	"encoding/xml"
+	"errors"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var (
+	spaces   = regexp.MustCompile(`\s+`)
+	newlines = regexp.MustCompile(`\n{3,}`)
+)
+
+// HTML 文档的节点
+//
+// tag 为空表示文本节点，此时 text 为其内容。
+type htmlNode struct {
+	tag      string
+	attrs    map[string]string
+	text     string
+	children []*htmlNode
+}
+
+// 将 HTML 内容转换成 markdown
+//
+// 仅支持常用的标签，不认识的标签只保留其内容。如果内容无法解析，则原样返回。
+func fromHTML(html string) string {
+	root, err := parseHTML(html)
+	if err != nil {
+		return html
+	}
+
+	md := newlines.ReplaceAllString(root.markdown(), "\n\n")
+	return strings.TrimSpace(md)
+}
+
+// 采用 encoding/xml 的非严格模式解析 HTML
+func parseHTML(html string) (*htmlNode, error) {
+	d := xml.NewDecoder(strings.NewReader("<root>" + html + "</root>"))
+	d.Strict = false
+	d.AutoClose = xml.HTMLAutoClose
+	d.Entity = xml.HTMLEntity
+
+	root := &htmlNode{tag: "root"}
+	stack := []*htmlNode{root}
+	for {
+		token, err := d.Token()
+		if errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return nil, err
+		}
+
+		parent := stack[len(stack)-1]
+		switch t := token.(type) {
+		case xml.StartElement:
+			n := &htmlNode{tag: strings.ToLower(t.Name.Local), attrs: make(map[string]string, len(t.Attr))}
+			for _, attr := range t.Attr {
+				n.attrs[strings.ToLower(attr.Name.Local)] = attr.Value
+			}
+			parent.children = append(parent.children, n)
+			stack = append(stack, n)
+		case xml.EndElement:
+			if len(stack) > 1 {
+				stack = stack[:len(stack)-1]
+			}
+		case xml.CharData:
+			parent.children = append(parent.children, &htmlNode{text: string(t)})
+		}
+	}
+
+	return root, nil
+}
+
+func (n *htmlNode) markdown() string {
+	if n.tag == "" {
+		return spaces.ReplaceAllString(n.text, " ")
+	}
+
+	switch n.tag {
+	case "p", "div", "section", "article", "header", "footer", "table":
+		return block(n.inner())
+	case "br":
+		return "\n"
+	case "hr":
+		return block("---")
+	case "h1", "h2", "h3", "h4", "h5", "h6":
+		level := int(n.tag[1] - '0')
+		return block(strings.Repeat("#", level) + " " + strings.TrimSpace(n.inner()))
+	case "strong", "b":
+		return wrap(n.inner(), "**")
+	case "em", "i":
+		return wrap(n.inner(), "*")
+	case "del", "s":
+		return wrap(n.inner(), "~~")
+	case "code":
+		return wrap(n.rawText(), "`")
+	case "pre":
+		return block(fence(strings.Trim(n.rawText(), "\n"), ""))
+	case "a":
+		return "[" + strings.TrimSpace(n.inner()) + "](" + n.attrs["href"] + ")"
+	case "img":
+		return "![" + n.attrs["alt"] + "](" + n.attrs["src"] + ")"
+	case "ul", "ol":
+		return block(n.list())
+	case "blockquote":
+		content := newlines.ReplaceAllString(strings.TrimSpace(n.inner()), "\n\n")
+		lines := strings.Split(content, "\n")
+		for i, line := range lines {
+			lines[i] = strings.TrimRight("> "+line, " ")
+		}
+		return block(strings.Join(lines, "\n"))
+	default:
+		return n.inner()
+	}
+}
+
+func (n *htmlNode) inner() string {
+	var b strings.Builder
+	for _, c := range n.children {
+		b.WriteString(c.markdown())
+	}
+	return b.String()
+}
+
+// 不作任何转换的文本内容，用于 code 和 pre
+func (n *htmlNode) rawText() string {
+	if n.tag == "" {
+		return n.text
+	}
+
+	var b strings.Builder
+	for _, c := range n.children {
+		b.WriteString(c.rawText())
+	}
+	return b.String()
+}
+
+func (n *htmlNode) list() string {
+	lines := make([]string, 0, len(n.children))
+	var index int
+	for _, li := range n.children {
+		if li.tag != "li" {
+			continue
+		}
+		index++
+
+		marker := "- "
+		if n.tag == "ol" {
+			marker = strconv.Itoa(index) + ". "
+		}
+		indent := strings.Repeat(" ", len(marker))
+
+		content := newlines.ReplaceAllString(strings.TrimSpace(li.inner()), "\n\n")
+		for i, line := range strings.Split(content, "\n") {
+			switch {
+			case i == 0:
+				line = marker + line
+			case line != "":
+				line = indent + line
+			}
+			lines = append(lines, line)
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+func block(s string) string {
+	return "\n\n" + strings.TrimSpace(s) + "\n\n"
+}
+
+func wrap(s, mark string) string {
+	if strings.TrimSpace(s) == "" {
+		return s
+	}
+	return mark + strings.TrimSpace(s) + mark
+}
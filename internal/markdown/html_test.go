@@ -0,0 +1,37 @@
+// SPDX-License-Identifier: MIT
+
+package markdown
+
+import (
+	"testing"
+
+	"github.com/issue9/assert"
+)
+
+func TestFromHTML(t *testing.T) {
+	a := assert.New(t)
+
+	data := []*struct {
+		html, md string
+	}{
+		{html: "", md: ""},
+		{html: "text", md: "text"},
+		{html: "<p>p1</p><p>p2\n  line</p>", md: "p1\n\np2 line"},
+		{html: "<h2>title</h2>text", md: "## title\n\ntext"},
+		{html: "<strong>b</strong> <em>i</em> <code>c</code> <del>d</del>", md: "**b** *i* `c` ~~d~~"},
+		{html: `<a href="https://example.com">link</a>`, md: "[link](https://example.com)"},
+		{html: `<img src="a.png" alt="img">`, md: "![img](a.png)"},
+		{html: "line1<br>line2", md: "line1\nline2"},
+		{html: "<ul><li>l1</li><li>l2</li></ul>", md: "- l1\n- l2"},
+		{html: "<ol><li>l1</li><li>l2<ul><li>sub</li></ul></li></ol>", md: "1. l1\n2. l2\n\n   - sub"},
+		{html: "<blockquote><p>q1</p><p>q2</p></blockquote>", md: "> q1\n>\n> q2"},
+		{html: "<pre>func() {\n\treturn\n}</pre>", md: "```\nfunc() {\n\treturn\n}\n```"},
+		{html: "&lt;tag&gt; &amp; &nbsp;", md: "<tag> &"},
+		{html: "<span>unknown</span>", md: "unknown"},
+		{html: "<p>unclosed", md: "unclosed"},
+	}
+
+	for i, item := range data {
+		a.Equal(fromHTML(item.html), item.md, "not equal at %d\nv1=%q\nv2=%q", i, fromHTML(item.html), item.md)
+	}
+}
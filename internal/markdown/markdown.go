@@ -0,0 +1,589 @@
+// SPDX-License-Identifier: MIT
+
+// Package markdown 将文档转换成 markdown 格式
+package markdown
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/issue9/errwrap"
+	"golang.org/x/text/message"
+
+	"github.com/caixw/apidoc/v7/core"
+	"github.com/caixw/apidoc/v7/internal/ast"
+	"github.com/caixw/apidoc/v7/internal/locale"
+	"github.com/caixw/apidoc/v7/internal/slug"
+)
+
+// Ext 生成文件的扩展名
+const Ext = ".md"
+
+type writer struct {
+	errwrap.Buffer
+	doc    *ast.APIDoc
+	langID string
+	files  map[*ast.Tag]string // 各个标签对应的文档文件名，仅在拆分文档时有值。
+}
+
+// Markdown 将 doc 转换成 markdown 格式的文档
+//
+// API 按标签分组，未指定标签的 API 归类到未分类中，
+// 同时属于多个标签的 API 会在每个标签下都出现。
+func Markdown(doc *ast.APIDoc) ([]byte, error) {
+	w := newWriter(doc)
+	w.writeInfo(false)
+
+	for _, tag := range doc.Tags {
+		w.writeTag(tag, 2)
+	}
+	w.writeUncategorized(2)
+	w.writeFooter()
+
+	if w.Err != nil {
+		return nil, w.Err
+	}
+	return w.Bytes(), nil
+}
+
+// Split 将 doc 按标签拆分成多个 markdown 文档
+//
+// name 为首页的文件名，返回的 index 为首页的内容，包含文档的基本信息、指向各个标签文档的链接以及未指定标签的 API；
+// files 为各个标签对应的文档，键名为标签名的 slug 加上 Ext 组成的文件名，
+// 各个标签的文件名互不相同，也不会与 name 相同。
+func Split(doc *ast.APIDoc, name string) (index []byte, files map[string][]byte, err error) {
+	names := make([]string, 0, len(doc.Tags))
+	for _, tag := range doc.Tags {
+		names = append(names, tag.Name.V())
+	}
+	filenames := make(map[*ast.Tag]string, len(doc.Tags))
+	for i, n := range slug.Unique(names, "tag", strings.TrimSuffix(name, Ext)) {
+		filenames[doc.Tags[i]] = n + Ext
+	}
+
+	w := newWriter(doc)
+	w.files = filenames
+	w.writeInfo(true)
+	w.writeUncategorized(2)
+	w.writeFooter()
+	if w.Err != nil {
+		return nil, nil, w.Err
+	}
+	index = w.Bytes()
+
+	files = make(map[string][]byte, len(doc.Tags))
+	for _, tag := range doc.Tags {
+		w := newWriter(doc)
+		w.writeTag(tag, 1)
+		w.writeFooter()
+		if w.Err != nil {
+			return nil, nil, w.Err
+		}
+		files[filenames[tag]] = w.Bytes()
+	}
+
+	return index, files, nil
+}
+
+func newWriter(doc *ast.APIDoc) *writer {
+	langID := doc.Lang.V()
+	if langID == "" {
+		langID = "und"
+	}
+
+	return &writer{doc: doc, langID: langID}
+}
+
+func (w *writer) t(key message.Reference, v ...interface{}) string {
+	return locale.Translate(w.langID, key, v...)
+}
+
+func (w *writer) heading(level int, text string) {
+	if level > 6 {
+		level = 6
+	}
+	w.WString(strings.Repeat("#", level)).WByte(' ').WString(text).WString("\n\n")
+}
+
+func (w *writer) paragraph(text string) {
+	if text = strings.TrimSpace(text); text != "" {
+		w.WString(text).WString("\n\n")
+	}
+}
+
+// 输出文档的基本信息
+//
+// link 表示标签是否需要链接到对应的文档
+func (w *writer) writeInfo(link bool) {
+	doc := w.doc
+
+	w.heading(1, doc.Title.V())
+	if doc.Version.V() != "" {
+		w.Printf("- %s: %s\n", w.t(locale.DocVersion), doc.Version.V())
+	}
+	if doc.License != nil {
+		w.Printf("- %s: [%s](%s)\n", w.t(locale.DocLicense), doc.License.Text.V(), doc.License.URL.V())
+	}
+	if c := doc.Contact; c != nil {
+		w.Printf("- %s: %s", w.t(locale.DocContact), c.Name.V())
+		if c.Email.V() != "" {
+			w.Printf(" <%s>", c.Email.V())
+		}
+		if c.URL.V() != "" {
+			w.Printf(" %s", c.URL.V())
+		}
+		w.WByte('\n')
+	}
+	w.WByte('\n')
+
+	w.paragraph(richtext(doc.Description))
+
+	if len(doc.Servers) > 0 {
+		w.heading(2, w.t(locale.DocServer))
+		w.tableHeader(w.t(locale.DocName), w.t(locale.DocURL), w.t(locale.DocDescription))
+		for _, srv := range doc.Servers {
+			name := srv.Name.V()
+			if srv.Deprecated != nil {
+				name = "~~" + name + "~~"
+			}
+			w.tableRow(name, srv.URL.V(), description(srv.Description, srv.Summary))
+		}
+		w.WByte('\n')
+	}
+
+	if len(doc.Tags) > 0 {
+		w.heading(2, w.t(locale.DocTag))
+		w.tableHeader(w.t(locale.DocName), w.t(locale.DocDescription))
+		for _, tag := range doc.Tags {
+			name := tag.Name.V()
+			if link {
+				name = "[" + name + "](" + w.files[tag] + ")"
+			}
+			if tag.Deprecated != nil {
+				name = "~~" + name + "~~"
+			}
+			w.tableRow(name, tag.Title.V())
+		}
+		w.WByte('\n')
+	}
+
+	if len(doc.Headers) > 0 {
+		w.heading(2, w.t(locale.DocHeader))
+		w.writeParams(doc.Headers)
+	}
+
+	if len(doc.Responses) > 0 {
+		w.heading(2, w.t(locale.DocResponse))
+		w.writeRequests(doc.Responses, 3, true)
+	}
+}
+
+func (w *writer) writeFooter() {
+	w.WString("---\n\n").
+		WString(w.t(locale.GeneratorBy, "["+core.Name+"]("+core.OfficialURL+")")).
+		WByte('\n')
+}
+
+func (w *writer) writeTag(tag *ast.Tag, level int) {
+	title := tag.Title.V()
+	if title == "" {
+		title = tag.Name.V()
+	}
+	w.heading(level, title)
+
+	for _, api := range w.doc.APIs {
+		for _, t := range api.Tags {
+			if t.V() == tag.Name.V() {
+				w.writeAPI(api, level+1)
+				break
+			}
+		}
+	}
+}
+
+func (w *writer) writeUncategorized(level int) {
+	apis := make([]*ast.API, 0, len(w.doc.APIs))
+	for _, api := range w.doc.APIs {
+		if len(api.Tags) == 0 {
+			apis = append(apis, api)
+		}
+	}
+	if len(apis) == 0 {
+		return
+	}
+
+	w.heading(level, w.t(locale.DocUncategorized))
+	for _, api := range apis {
+		w.writeAPI(api, level+1)
+	}
+}
+
+func (w *writer) writeAPI(api *ast.API, level int) {
+	w.heading(level, api.Method.V()+" "+api.Path.Path.V())
+	w.writeDeprecated(api.Deprecated)
+	w.paragraph(api.Summary.V())
+	w.paragraph(richtext(api.Description))
+
+	if len(api.Servers) > 0 {
+		servers := make([]string, 0, len(api.Servers))
+		for _, srv := range api.Servers {
+			servers = append(servers, srv.V())
+		}
+		w.paragraph(w.t(locale.DocServer) + ": " + strings.Join(servers, ", "))
+	}
+
+	w.writePath(api.Path, level+1)
+	if len(api.Headers) > 0 {
+		w.heading(level+1, w.t(locale.DocHeader))
+		w.writeParams(api.Headers)
+	}
+	if len(api.Cookies) > 0 {
+		w.heading(level+1, w.t(locale.DocCookie))
+		w.writeParams(api.Cookies)
+	}
+
+	if len(api.Requests) > 0 {
+		w.heading(level+1, w.t(locale.DocRequest))
+		w.writeRequests(api.Requests, level+2, false)
+	}
+
+	if len(api.Responses) > 0 {
+		w.heading(level+1, w.t(locale.DocResponse))
+		w.writeRequests(api.Responses, level+2, true)
+	}
+
+	if cb := api.Callback; cb != nil {
+		title := w.t(locale.DocCallback) + ": " + cb.Method.V()
+		if cb.Path != nil && cb.Path.Path.V() != "" {
+			title += " " + cb.Path.Path.V()
+		}
+		w.heading(level+1, title)
+		w.writeDeprecated(cb.Deprecated)
+		w.paragraph(cb.Summary.V())
+		w.paragraph(richtext(cb.Description))
+		w.writePath(cb.Path, level+2)
+		if len(cb.Headers) > 0 {
+			w.heading(level+2, w.t(locale.DocHeader))
+			w.writeParams(cb.Headers)
+		}
+		if len(cb.Requests) > 0 {
+			w.heading(level+2, w.t(locale.DocRequest))
+			w.writeRequests(cb.Requests, level+3, false)
+		}
+		if len(cb.Responses) > 0 {
+			w.heading(level+2, w.t(locale.DocResponse))
+			w.writeRequests(cb.Responses, level+3, true)
+		}
+	}
+}
+
+func (w *writer) writeDeprecated(v *ast.VersionAttribute) {
+	if v != nil {
+		w.paragraph("> **" + w.t(locale.DocDeprecated, v.V()) + "**")
+	}
+}
+
+func (w *writer) writePath(path *ast.Path, level int) {
+	if path == nil {
+		return
+	}
+
+	if len(path.Params) > 0 {
+		w.heading(level, w.t(locale.DocPathParam))
+		w.writeParams(path.Params)
+	}
+	if len(path.Queries) > 0 {
+		w.heading(level, w.t(locale.DocQuery))
+		w.writeParams(path.Queries)
+	}
+}
+
+// resp 表示是否为返回对象，返回对象以状态码作为标题。
+func (w *writer) writeRequests(requests []*ast.Request, level int, resp bool) {
+	for _, r := range requests {
+		title := r.Mimetype.V()
+		if resp {
+			title = strings.TrimSpace(strconv.Itoa(r.Status.V()) + " " + title)
+		}
+		if title != "" {
+			w.heading(level, title)
+		}
+		w.writeDeprecated(r.Deprecated)
+		w.paragraph(description(r.Description, r.Summary))
+
+		if len(r.Headers) > 0 {
+			w.paragraph("**" + w.t(locale.DocHeader) + "**")
+			w.writeParams(r.Headers)
+		}
+		if len(r.Cookies) > 0 {
+			w.paragraph("**" + w.t(locale.DocCookie) + "**")
+			w.writeParams(r.Cookies)
+		}
+
+		if p := r.Param(); p.Type.V() != ast.TypeNone || r.Array.V() {
+			w.paragraph("**" + w.t(locale.DocBody) + "**")
+			w.writeBody(p)
+		}
+
+		for _, exp := range r.Examples {
+			title := w.t(locale.DocExample) + " " + exp.Mimetype.V()
+			if exp.Summary.V() != "" {
+				title += ": " + exp.Summary.V()
+			}
+			w.paragraph("**" + title + "**")
+			w.WString(fence(strings.Trim(exp.Content.Value.Value, "\n"), lang(exp.Mimetype.V()))).WString("\n\n")
+		}
+	}
+}
+
+func (w *writer) writeParams(params []*ast.Param) {
+	w.paramsHeader()
+	for _, p := range params {
+		w.writeParam(p, "")
+	}
+	w.WByte('\n')
+}
+
+// 以树的形式输出报文的内容，子元素的名称会加上父元素的名称作为前缀。
+//
+// 联合类型的各个分支会以单独的表格输出在公共字段之后。
+func (w *writer) writeBody(p *ast.Param) {
+	resolved := p.Resolve()
+	if len(resolved.Items) == 0 && !isUnion(resolved) {
+		w.paramsHeader()
+		w.writeParam(p, "")
+		w.WByte('\n')
+		return
+	}
+
+	visited := make(map[*ast.TypeDef]bool, 5)
+	if t := p.Type.TypeDef(); t != nil {
+		visited[t] = true
+	}
+	w.writeObject(resolved, "", visited)
+}
+
+// 联合类型及其在报文中的位置
+type union struct {
+	param   *ast.Param
+	parent  string
+	visited map[*ast.TypeDef]bool
+}
+
+// 输出对象的字段，之后依次输出其中各个联合类型的分支。
+func (w *writer) writeObject(p *ast.Param, parent string, visited map[*ast.TypeDef]bool) {
+	var unions []*union
+	if isUnion(p) {
+		unions = append(unions, &union{param: p, parent: parent, visited: copyVisited(visited)})
+	}
+
+	if len(p.Items) > 0 {
+		w.paramsHeader()
+		unions = w.writeItems(unions, p.Items, parent, visited)
+		w.WByte('\n')
+	}
+
+	for _, u := range unions {
+		w.writeBranches(u)
+	}
+}
+
+// 每个分支以其 discriminator 的值或是分支名称作为标题，
+// 分支中的字段与联合类型的公共字段处于同一层级。
+func (w *writer) writeBranches(u *union) {
+	branches := u.param.OneOf
+	if len(branches) == 0 {
+		branches = u.param.AnyOf
+	}
+
+	for _, b := range branches {
+		w.paragraph("**" + w.t(locale.DocBody) + "** `" + branchLabel(u.param, b, u.parent) + "`")
+		w.paragraph(description(b.Description, b.Summary))
+
+		visited := u.visited
+		if t := b.Type.TypeDef(); t != nil {
+			if visited[t] {
+				continue
+			}
+			visited = copyVisited(visited)
+			visited[t] = true
+		}
+		w.writeObject(b.Resolve(), u.parent, visited)
+	}
+}
+
+// visited 记录当前路径上已经展开的类型定义，防止循环引用。
+//
+// 返回值为在 unions 基础上追加了 items 中的联合类型之后的内容。
+func (w *writer) writeItems(unions []*union, items []*ast.Param, parent string, visited map[*ast.TypeDef]bool) []*union {
+	for _, item := range items {
+		w.writeParam(item, parent)
+
+		t := item.Type.TypeDef()
+		if t != nil && visited[t] {
+			continue
+		}
+
+		resolved := item.Resolve()
+		if resolved.Type.V() == ast.TypeMap {
+			continue
+		}
+
+		if t != nil {
+			visited[t] = true
+		}
+		prefix := parent + item.Name.V() + "."
+		if isUnion(resolved) {
+			unions = append(unions, &union{param: resolved, parent: prefix, visited: copyVisited(visited)})
+		}
+		unions = w.writeItems(unions, resolved.Items, prefix, visited)
+		if t != nil {
+			delete(visited, t)
+		}
+	}
+	return unions
+}
+
+func isUnion(p *ast.Param) bool {
+	return len(p.OneOf) > 0 || len(p.AnyOf) > 0
+}
+
+func copyVisited(visited map[*ast.TypeDef]bool) map[*ast.TypeDef]bool {
+	ret := make(map[*ast.TypeDef]bool, len(visited)+1)
+	for k, v := range visited {
+		ret[k] = v
+	}
+	return ret
+}
+
+// 分支的标题，指定了 discriminator 时为 discriminator = 分支名称，否则为分支名称。
+func branchLabel(p, branch *ast.Param, parent string) string {
+	if p.Discriminator != nil {
+		return parent + p.Discriminator.V() + " = " + branch.Name.V()
+	}
+	return parent + branch.Name.V()
+}
+
+func (w *writer) paramsHeader() {
+	w.tableHeader(w.t(locale.DocVar), w.t(locale.DocType), w.t(locale.DocValue), w.t(locale.DocDescription))
+}
+
+// 与 apidoc.xsl 相同，值一列中以 O 表示可选，R 表示必须。
+func (w *writer) writeParam(p *ast.Param, parent string) {
+	name := parent + p.Name.V()
+	if p.Deprecated != nil {
+		name = "~~" + name + "~~"
+	}
+
+	typ := p.Type.V()
+	if p.Array.V() {
+		typ += "[]"
+	}
+	if p.Nullable.V() {
+		typ += "?"
+	}
+
+	value := "R"
+	if p.Optional.V() {
+		value = "O"
+	}
+	if p.Default.V() != "" {
+		value += " " + p.Default.V()
+	}
+
+	desc := description(p.Description, p.Summary)
+	if p.Deprecated != nil {
+		desc = "*" + w.t(locale.DocDeprecated, p.Deprecated.V()) + "*\n" + desc
+	}
+	if len(p.Enums) > 0 {
+		enums := make([]string, 0, len(p.Enums)+1)
+		enums = append(enums, w.t(locale.DocEnum)+":")
+		for _, e := range p.Enums {
+			v := "`" + e.Value.V() + "`"
+			if e.Deprecated != nil {
+				v = "~~" + v + "~~"
+			}
+			enums = append(enums, "- "+v+": "+description(e.Description, e.Summary))
+		}
+		desc = strings.TrimSpace(desc + "\n" + strings.Join(enums, "\n"))
+	}
+
+	w.tableRow(name, typ, value, desc)
+}
+
+func (w *writer) tableHeader(cols ...string) {
+	w.tableRow(cols...)
+	w.WString(strings.Repeat("| --- ", len(cols))).WString("|\n")
+}
+
+func (w *writer) tableRow(cols ...string) {
+	for _, col := range cols {
+		w.WString("| ").WString(cell(col)).WByte(' ')
+	}
+	w.WString("|\n")
+}
+
+// 表格中的内容不能换行，也不能包含未转义的 |
+var cellReplacer = strings.NewReplacer("\r\n", "<br>", "\n", "<br>", "|", `\|`)
+
+func cell(s string) string {
+	return cellReplacer.Replace(strings.TrimSpace(s))
+}
+
+// 返回 markdown 格式的富文本内容
+func richtext(r *ast.Richtext) string {
+	if r == nil || r.Text == nil {
+		return ""
+	}
+
+	if r.Type.V() == ast.RichtextTypeHTML {
+		return fromHTML(r.V())
+	}
+	return strings.TrimSpace(r.V())
+}
+
+func description(desc *ast.Richtext, summary *ast.Attribute) string {
+	if d := richtext(desc); d != "" {
+		return d
+	}
+	return summary.V()
+}
+
+// 根据 mimetype 获取代码块的语言名称
+func lang(mimetype string) string {
+	switch {
+	case strings.HasSuffix(mimetype, "json"):
+		return "json"
+	case strings.HasSuffix(mimetype, "xml"):
+		return "xml"
+	case strings.HasSuffix(mimetype, "yaml"):
+		return "yaml"
+	case strings.HasPrefix(mimetype, "text/html"):
+		return "html"
+	default:
+		return ""
+	}
+}
+
+// 生成代码块，代码块的标记长度会比内容中最长的连续反引号多一个。
+func fence(code, lang string) string {
+	var max, count int
+	for _, r := range code {
+		if r == '`' {
+			count++
+			if count > max {
+				max = count
+			}
+		} else {
+			count = 0
+		}
+	}
+
+	if max < 3 {
+		max = 3
+	} else {
+		max++
+	}
+	mark := strings.Repeat("`", max)
+	return mark + lang + "\n" + code + "\n" + mark
+}
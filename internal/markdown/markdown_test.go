@@ -0,0 +1,192 @@
+// SPDX-License-Identifier: MIT
+
+package markdown
+
+import (
+	"testing"
+
+	"github.com/issue9/assert"
+
+	"github.com/caixw/apidoc/v7/core"
+	"github.com/caixw/apidoc/v7/core/messagetest"
+	"github.com/caixw/apidoc/v7/internal/ast"
+	"github.com/caixw/apidoc/v7/internal/ast/asttest"
+)
+
+const testDoc = `<apidoc version="1.0.0" lang="cmn-Hans">
+	<title>title</title>
+	<description type="html"><![CDATA[<p>doc <strong>desc</strong></p>]]></description>
+	<mimetype>application/json</mimetype>
+	<server name="prod" url="https://example.com" summary="prod" />
+	<tag name="user" title="用户" />
+	<type name="User" type="object" summary="user">
+		<param name="id" type="number" summary="id" />
+		<param name="group" type="#Group" optional="true" summary="group" />
+	</type>
+	<type name="Group" type="object" summary="group">
+		<param name="name" type="string" summary="name" />
+	</type>
+	<api method="GET">
+		<path path="/users/{id}">
+			<param name="id" type="number" summary="id" />
+			<query name="sex" type="string" default="male" optional="true" summary="sex">
+				<enum value="male" summary="male" />
+				<enum value="female" deprecated="1.0.0" summary="female" />
+			</query>
+		</path>
+		<tag>user</tag>
+		<description type="markdown"><![CDATA[**markdown** | desc]]></description>
+		<response status="200" type="object" mimetype="application/json">
+			<param name="user" type="#User" summary="user" />
+			<param name="old" type="string" deprecated="1.0.0" summary="old" />
+			<example mimetype="application/json"><![CDATA[{"id":1}]]></example>
+		</response>
+	</api>
+	<api method="DELETE" deprecated="1.0.0">
+		<path path="/users" />
+		<response status="204" />
+	</api>
+</apidoc>`
+
+func getTestDoc(a *assert.Assertion) *ast.APIDoc {
+	rslt := messagetest.NewMessageHandler()
+	doc := &ast.APIDoc{}
+	doc.Parse(rslt.Handler, core.Block{Data: []byte(testDoc)})
+	rslt.Handler.Stop()
+	a.Empty(rslt.Errors, "%v", rslt.Errors)
+	return doc
+}
+
+func TestMarkdown(t *testing.T) {
+	a := assert.New(t)
+
+	data, err := Markdown(asttest.Get())
+	a.NotError(err).NotEmpty(data)
+
+	data, err = Markdown(getTestDoc(a))
+	a.NotError(err).NotEmpty(data)
+	md := string(data)
+
+	a.Contains(md, "# title\n\n- 版本: 1.0.0\n\ndoc **desc**\n\n")
+	a.Contains(md, "| prod | https://example.com | prod |\n")
+	a.Contains(md, "| user | 用户 |\n")
+
+	// 按标签分组
+	a.Contains(md, "## 用户\n\n### GET /users/{id}\n\n**markdown** | desc\n\n")
+	a.Contains(md, "## 未分类\n\n### DELETE /users\n\n> **已于 1.0.0 废弃**\n\n")
+
+	// 参数
+	a.Contains(md, "#### 路径参数\n\n| 变量 | 类型 | 值 | 描述 |\n| --- | --- | --- | --- |\n| id | number | R | id |\n")
+	a.Contains(md, "| sex | string | O male | sex<br>枚举:<br>- `male`: male<br>- ~~`female`~~: female |\n")
+
+	// 报文，引用的类型会被展开。
+	a.Contains(md, "| user | #User | R | user |\n| user.id | number | R | id |\n| user.group | #Group | O | group |\n| user.group.name | string | R | name |\n| ~~old~~ |")
+
+	a.Contains(md, "```json\n{\"id\":1}\n```")
+	a.Contains(md, "[apidoc](https://apidoc.tools)")
+}
+
+func TestSplit(t *testing.T) {
+	a := assert.New(t)
+
+	index, files, err := Split(getTestDoc(a), "index.md")
+	a.NotError(err).NotEmpty(index)
+	a.Equal(1, len(files))
+
+	md := string(index)
+	a.Contains(md, "| [user](user.md) | 用户 |\n").
+		Contains(md, "### DELETE /users").
+		NotContains(md, "GET /users/{id}")
+
+	md = string(files["user.md"])
+	a.Contains(md, "# 用户\n\n## GET /users/{id}\n\n").
+		NotContains(md, "DELETE /users")
+
+	rslt := messagetest.NewMessageHandler()
+	doc := &ast.APIDoc{}
+	doc.Parse(rslt.Handler, core.Block{Data: []byte(`<apidoc version="1.0.0" lang="cmn-Hans">
+	<title>title</title>
+	<mimetype>application/json</mimetype>
+	<tag name="a/b" title="a/b" />
+	<tag name="a-b" title="a-b" />
+	<tag name="index" title="index" />
+	<tag name="../x" title="x" />
+	<api method="GET">
+		<path path="/users" />
+		<tag>a/b</tag>
+		<response status="204" />
+	</api>
+</apidoc>`)})
+	rslt.Handler.Stop()
+	a.Empty(rslt.Errors, "%v", rslt.Errors)
+
+	index, files, err = Split(doc, "index.md")
+	a.NotError(err).NotEmpty(index)
+	a.Equal(4, len(files))
+	a.NotNil(files["a-b.md"]).
+		NotNil(files["a-b-2.md"]).
+		NotNil(files["index-2.md"]).
+		NotNil(files["x.md"])
+
+	md = string(index)
+	a.Contains(md, "| [a/b](a-b.md) | a/b |\n").
+		Contains(md, "| [a-b](a-b-2.md) | a-b |\n").
+		Contains(md, "| [index](index-2.md) | index |\n").
+		Contains(md, "| [../x](x.md) | x |\n")
+	a.Contains(string(files["a-b.md"]), "## GET /users")
+}
+
+func TestFence(t *testing.T) {
+	a := assert.New(t)
+
+	a.Equal(fence("code", "go"), "```go\ncode\n```")
+	a.Equal(fence("```code```", ""), "````\n```code```\n````")
+}
+
+func TestMarkdown_union(t *testing.T) {
+	a := assert.New(t)
+
+	rslt := messagetest.NewMessageHandler()
+	doc := &ast.APIDoc{}
+	doc.Parse(rslt.Handler, core.Block{Data: []byte(`<apidoc version="1.0.0" lang="cmn-Hans">
+	<title>title</title>
+	<mimetype>application/json</mimetype>
+	<type name="Square" type="object" summary="square">
+		<param name="side" type="number" summary="side" />
+	</type>
+	<type name="Shape" type="object" summary="shape" discriminator="kind">
+		<param name="kind" type="string" summary="kind" />
+		<one-of name="circle" type="object" summary="circle">
+			<param name="r" type="number" summary="r" />
+		</one-of>
+		<one-of name="square" type="#Square" summary="square" />
+	</type>
+	<api method="POST">
+		<path path="/shapes" />
+		<request type="object" mimetype="application/json">
+			<any-of name="circle" type="object" summary="circle">
+				<param name="r" type="number" summary="r" />
+			</any-of>
+			<any-of name="square" type="#Square" summary="square" />
+		</request>
+		<response status="200" type="object" mimetype="application/json">
+			<param name="shape" type="#Shape" summary="shape" />
+		</response>
+	</api>
+</apidoc>`)})
+	rslt.Handler.Stop()
+	a.Empty(rslt.Errors, "%v", rslt.Errors)
+
+	data, err := Markdown(doc)
+	a.NotError(err).NotEmpty(data)
+	md := string(data)
+
+	// 没有公共字段的联合类型，直接输出各个分支。
+	a.Contains(md, "**报文**\n\n**报文** `circle`\n\ncircle\n\n| 变量 | 类型 | 值 | 描述 |\n| --- | --- | --- | --- |\n| r | number | R | r |\n").
+		Contains(md, "**报文** `square`\n\nsquare\n\n| 变量 | 类型 | 值 | 描述 |\n| --- | --- | --- | --- |\n| side | number | R | side |\n")
+
+	// 公共字段之后以 discriminator 的值区分各个分支
+	a.Contains(md, "| shape | #Shape | R | shape |\n| shape.kind | string | R | kind |\n\n").
+		Contains(md, "**报文** `shape.kind = circle`\n\ncircle\n\n| 变量 | 类型 | 值 | 描述 |\n| --- | --- | --- | --- |\n| shape.r | number | R | r |\n").
+		Contains(md, "**报文** `shape.kind = square`\n\nsquare\n\n| 变量 | 类型 | 值 | 描述 |\n| --- | --- | --- | --- |\n| shape.side | number | R | side |\n")
+}
@@ -3,11 +3,13 @@
 package mock
 
 import (
-	"fmt"
+	"bytes"
 	"io/ioutil"
+	"mime"
 	"net/http"
 	"strconv"
 	"strings"
+	"unicode/utf8"
 
 	"github.com/issue9/is"
 	"github.com/issue9/qheader"
@@ -24,28 +26,50 @@ func (m *mock) buildAPI(api *ast.API) http.Handler {
 			m.h.Locale(core.Warn, locale.DeprecatedWarn, r.Method, r.URL.Path, api.Deprecated.V())
 		}
 
-		if err := validQueries(api.Path.Queries, r); err != nil {
-			m.handleError(w, r, "", err)
+		if status, field, err := validAPI(m.doc.XMLNamespaces, api, r); err != nil {
+			if status == http.StatusUnauthorized {
+				if scheme := authenticateScheme(api.Securities); scheme != "" {
+					w.Header().Set("WWW-Authenticate", scheme)
+				}
+			}
+			m.handleErrorWithStatus(w, r, status, field, err)
 			return
 		}
 
-		for _, header := range api.Headers {
-			field := "headers[" + header.Name.V() + "]"
-			if err := validSimpleParam(header, field, r.Header.Get(header.Name.V())); err != nil {
-				m.handleError(w, r, field, err)
-				return
-			}
+		m.renderResponse(api, w, r)
+	})
+}
+
+// 验证请求是否符合 api 的定义
+//
+// 返回验证失败时的状态码以及出错的字段前缀。
+func validAPI(ns []*ast.XMLNamespace, api *ast.API, r *http.Request) (status int, field string, err error) {
+	if err := validSecurities(api.Securities, r); err != nil {
+		return http.StatusUnauthorized, "security", err
+	}
+
+	if err := validQueries(api.Path.Queries, r); err != nil {
+		return http.StatusBadRequest, "", err
+	}
+
+	for _, header := range api.Headers {
+		field := "headers[" + header.Name.V() + "]"
+		if err := validSimpleParam(header, field, r.Header.Get(header.Name.V())); err != nil {
+			return http.StatusBadRequest, field, err
 		}
+	}
 
-		if len(api.Requests) > 0 { // GET、OPTIONS 之类的可能没有 body
-			if err := validRequest(m.doc.XMLNamespaces, api.Requests, r); err != nil {
-				m.handleError(w, r, "request.body.", err)
-				return
-			}
+	if err := validCookies(api.Cookies, r); err != nil {
+		return http.StatusBadRequest, "", err
+	}
+
+	if len(api.Requests) > 0 { // GET、OPTIONS 之类的可能没有 body
+		if err := validRequest(ns, api.Requests, r); err != nil {
+			return http.StatusBadRequest, "request.body.", err
 		}
+	}
 
-		m.renderResponse(api, w, r)
-	})
+	return 0, "", nil
 }
 
 func validRequest(ns []*ast.XMLNamespace, requests []*ast.Request, r *http.Request) error {
@@ -53,7 +77,11 @@ func validRequest(ns []*ast.XMLNamespace, requests []*ast.Request, r *http.Reque
 	if ct == "" || ct == "*/*" || strings.HasSuffix(ct, "/*") { // 用户提交的 content-type 必须是明确的值
 		return core.NewError(locale.ErrInvalidValue).WithField("headers[content-type]")
 	}
-	req := findRequestByContentType(requests, ct)
+	mt, params, err := mime.ParseMediaType(ct)
+	if err != nil {
+		return core.NewError(locale.ErrInvalidValue).WithField("headers[content-type]")
+	}
+	req := findRequestByContentType(requests, mt)
 	if req == nil {
 		return core.NewError(locale.ErrInvalidValue).WithField("headers[content-type]")
 	}
@@ -63,6 +91,9 @@ func validRequest(ns []*ast.XMLNamespace, requests []*ast.Request, r *http.Reque
 			return err
 		}
 	}
+	if err := validCookies(req.Cookies, r); err != nil {
+		return err
+	}
 
 	content, err := ioutil.ReadAll(r.Body)
 	if err != nil {
@@ -71,12 +102,17 @@ func validRequest(ns []*ast.XMLNamespace, requests []*ast.Request, r *http.Reque
 	if err = r.Body.Close(); err != nil {
 		return err
 	}
+	r.Body = ioutil.NopCloser(bytes.NewReader(content)) // 验证之后依然可以读取报文
 
-	switch ct {
+	switch mt {
 	case "application/json":
 		return validJSON(req, content)
 	case "application/xml", "text/xml":
 		return validXML(ns, req, content)
+	case formMimetype:
+		return validForm(req, content)
+	case multipartMimetype:
+		return validMultipart(req, params["boundary"], content)
 	default:
 		return core.NewError(locale.ErrInvalidValue).WithField("headers[content-type]")
 	}
@@ -95,26 +131,32 @@ func (m *mock) renderResponse(api *ast.API, w http.ResponseWriter, r *http.Reque
 		}
 	}
 
-	data, err := m.buildResponse(resp, r)
+	data, ct, err := m.buildResponse(resp, r)
 	if err != nil {
 		m.handleError(w, r, "response.body.", err)
 		return
 	}
+	if ct == "" {
+		ct = accept
+	}
 
-	w.Header().Set("Content-Type", accept)
+	w.Header().Set("Content-Type", ct)
 	w.Header().Set("Server", core.Name)
 	for _, item := range resp.Headers {
-		switch primitive, _ := ast.ParseType(item.Type.V()); primitive {
-		case ast.TypeBool:
-			w.Header().Set(item.Name.V(), strconv.FormatBool(m.gen.generateBool()))
-		case ast.TypeNumber:
-			w.Header().Set(item.Name.V(), fmt.Sprint(m.gen.generateNumber(item)))
-		case ast.TypeString:
-			w.Header().Set(item.Name.V(), m.gen.generateString(item))
-		default:
+		v, ok := m.gen.generateSimpleValue(item.Resolve())
+		if !ok {
 			m.handleError(w, r, "response.headers", locale.NewError(locale.ErrInvalidFormat))
 			return
 		}
+		w.Header().Set(item.Name.V(), v)
+	}
+	for _, item := range resp.Cookies {
+		v, ok := m.gen.generateSimpleValue(item.Resolve())
+		if !ok {
+			m.handleError(w, r, "response.cookies", locale.NewError(locale.ErrInvalidFormat))
+			return
+		}
+		http.SetCookie(w, &http.Cookie{Name: item.Name.V(), Value: v})
 	}
 
 	w.WriteHeader(resp.Status.V())
@@ -185,6 +227,11 @@ func matchContentType(ct string, accepts []*qheader.Header) bool {
 
 // 处理 serveHTTP 中的错误
 func (m *mock) handleError(w http.ResponseWriter, r *http.Request, field string, err error) {
+	m.handleErrorWithStatus(w, r, http.StatusBadRequest, field, err)
+}
+
+// 处理 serveHTTP 中的错误，并以 status 作为状态码输出
+func (m *mock) handleErrorWithStatus(w http.ResponseWriter, r *http.Request, status int, field string, err error) {
 	// 这并不是一个真实存在的 URI
 	file := core.URI(r.Method + ": " + r.URL.Path)
 
@@ -201,7 +248,79 @@ func (m *mock) handleError(w http.ResponseWriter, r *http.Request, field string,
 	}
 
 	m.h.Error(err)
-	w.WriteHeader(http.StatusBadRequest)
+	w.WriteHeader(status)
+}
+
+// 验证请求是否带有 securities 中要求的身份验证信息
+//
+// securities 中的各项之间为或的关系，只要满足其中一项即可。
+// 仅验证验证信息是否存在，并不验证其值的正确性。
+func validSecurities(securities []*ast.SecurityValue, r *http.Request) error {
+	if len(securities) == 0 {
+		return nil
+	}
+
+	for _, v := range securities {
+		s := v.Security()
+		if s == nil || hasCredential(s, r) { // 引用不存在的错误已经在 Sanitize 中处理
+			return nil
+		}
+	}
+
+	return core.NewError(locale.ErrUnauthorized)
+}
+
+func hasCredential(s *ast.Security, r *http.Request) bool {
+	switch s.Type.V() {
+	case ast.SecurityTypeAPIKey:
+		key := s.Key.V()
+		switch s.In.V() {
+		case ast.SecurityInHeader:
+			return r.Header.Get(key) != ""
+		case ast.SecurityInQuery:
+			return r.URL.Query().Get(key) != ""
+		case ast.SecurityInCookie:
+			c, err := r.Cookie(key)
+			return err == nil && c.Value != ""
+		}
+	case ast.SecurityTypeHTTP:
+		return hasAuthorization(r, s.Scheme.V())
+	case ast.SecurityTypeOAuth2, ast.SecurityTypeOpenIDConnect:
+		return hasAuthorization(r, "bearer")
+	}
+	return false
+}
+
+// 报头 Authorization 是否为 scheme 格式的内容，scheme 不区分大小写。
+func hasAuthorization(r *http.Request, scheme string) bool {
+	auth := r.Header.Get("Authorization")
+	if len(auth) <= len(scheme)+1 || auth[len(scheme)] != ' ' {
+		return false
+	}
+	return strings.EqualFold(auth[:len(scheme)], scheme)
+}
+
+// 返回报头 WWW-Authenticate 的值
+//
+// 仅从 securities 中查找第一个基于 Authorization 报头的验证方式，
+// 找不到则返回空值。
+func authenticateScheme(securities []*ast.SecurityValue) string {
+	for _, v := range securities {
+		s := v.Security()
+		if s == nil {
+			continue
+		}
+
+		switch s.Type.V() {
+		case ast.SecurityTypeHTTP:
+			if scheme := s.Scheme.V(); scheme != "" {
+				return strings.ToUpper(scheme[:1]) + scheme[1:]
+			}
+		case ast.SecurityTypeOAuth2, ast.SecurityTypeOpenIDConnect:
+			return "Bearer"
+		}
+	}
+	return ""
 }
 
 func validQueries(queries []*ast.Param, r *http.Request) error {
@@ -224,18 +343,52 @@ func validQueries(queries []*ast.Param, r *http.Request) error {
 			if err := r.ParseForm(); err != nil {
 				return err
 			}
-			for _, v := range r.Form[query.Name.V()] {
+			values := r.Form[query.Name.V()]
+			for _, v := range values {
 				if err := valid(query, v); err != nil {
 					return err
 				}
 			}
+			if err := validItemsSize(query.Resolve(), len(values)); err != nil {
+				return err.WithField("queries[" + query.Name.V() + "]")
+			}
 		} else {
-			values := strings.Split(r.FormValue(query.Name.V()), ",")
+			var values []string // 空值表示没有元素，与 form 格式保持一致。
+			if v := r.FormValue(query.Name.V()); v != "" {
+				values = strings.Split(v, ",")
+			}
 			for _, v := range values {
 				if err := valid(query, v); err != nil {
 					return err
 				}
 			}
+			if err := validItemsSize(query.Resolve(), len(values)); err != nil {
+				return err.WithField("queries[" + query.Name.V() + "]")
+			}
+		}
+	}
+
+	return nil
+}
+
+// 验证请求中的 cookie 是否符合 cookies 的要求
+func validCookies(cookies []*ast.Param, r *http.Request) error {
+	for _, cookie := range cookies {
+		field := "cookies[" + cookie.Name.V() + "]"
+
+		c, err := r.Cookie(cookie.Name.V())
+		if err != nil { // 不存在，与值为空的情况区分开来
+			if cookie.Optional.V() || cookie.Default != nil {
+				continue
+			}
+			return core.NewError(locale.ErrIsEmpty, field).WithField(field)
+		}
+
+		err = validSimpleParam(cookie, field, c.Value)
+		if serr, ok := err.(*core.Error); ok {
+			return serr.WithField(field)
+		} else if err != nil {
+			return err
 		}
 	}
 
@@ -247,6 +400,7 @@ func validSimpleParam(p *ast.Param, name, val string) error {
 	if p == nil {
 		return nil
 	}
+	p = p.Resolve()
 
 	if val == "" && p.Type.V() != ast.TypeString { // 字符串的默认值可以为 “”
 		if (p.Optional != nil && p.Optional.V()) ||
@@ -266,13 +420,19 @@ func validSimpleParam(p *ast.Param, name, val string) error {
 			return core.NewError(locale.ErrInvalidFormat)
 		}
 	case ast.TypeString:
-	case ast.TypeObject:
+	case ast.TypeObject, ast.TypeMap:
 	case ast.TypeNone:
 		if val != "" {
 			return core.NewError(locale.ErrInvalidValue)
 		}
 	}
 
+	if val != "" {
+		if err := validConstraint(p, val); err != nil {
+			return err
+		}
+	}
+
 	if isEnum(p) {
 		found := false
 		for _, e := range p.Enums {
@@ -290,14 +450,60 @@ func validSimpleParam(p *ast.Param, name, val string) error {
 	return nil
 }
 
-func (m *mock) buildResponse(p *ast.Request, r *http.Request) ([]byte, error) {
+// 验证 val 是否符合 p 中的约束条件
+//
+// 仅验证与值相关的约束条件，数组的长度由 validItemsSize 验证。
+func validConstraint(p *ast.Param, val string) *core.Error {
+	switch primitive, _ := ast.ParseType(p.Type.V()); primitive {
+	case ast.TypeNumber:
+		if p.Min == nil && p.Max == nil {
+			return nil
+		}
+
+		v, err := strconv.ParseFloat(val, 64)
+		if err != nil {
+			return core.NewError(locale.ErrInvalidFormat)
+		}
+		if (p.Min != nil && v < p.Min.V()) || (p.Max != nil && v > p.Max.V()) {
+			return core.NewError(locale.ErrInvalidValue)
+		}
+	case ast.TypeString:
+		size := utf8.RuneCountInString(val)
+		if (p.MinLength != nil && size < p.MinLength.IntValue()) ||
+			(p.MaxLength != nil && size > p.MaxLength.IntValue()) {
+			return core.NewError(locale.ErrInvalidValue)
+		}
+
+		// 加载时已经编译过正则表达式，此处直接使用编译后的内容。
+		if expr := p.Regexp(); expr != nil && !expr.MatchString(val) {
+			return core.NewError(locale.ErrInvalidFormat)
+		}
+	}
+
+	return nil
+}
+
+// 验证数组的元素数量是否符合 p 中的约束条件
+func validItemsSize(p *ast.Param, size int) *core.Error {
+	if (p.MinItems != nil && size < p.MinItems.IntValue()) ||
+		(p.MaxItems != nil && size > p.MaxItems.IntValue()) {
+		return core.NewError(locale.ErrInvalidValue)
+	}
+	return nil
+}
+
+// 生成返回的内容
+//
+// 如果输出时需要指定与 accept 不同的 content-type，比如 multipart/form-data
+// 需要带上 boundary 参数，则通过 ct 返回，否则 ct 为空。
+func (m *mock) buildResponse(p *ast.Request, r *http.Request) (data []byte, ct string, err error) {
 	if p == nil {
-		return nil, nil
+		return nil, "", nil
 	}
 
 	for _, header := range p.Headers {
 		if err := validSimpleParam(header, "headers["+header.Name.V()+"]", r.Header.Get(header.Name.V())); err != nil {
-			return nil, err
+			return nil, "", err
 		}
 	}
 
@@ -305,10 +511,17 @@ func (m *mock) buildResponse(p *ast.Request, r *http.Request) ([]byte, error) {
 	for _, h := range headers {
 		switch strings.ToLower(h.Value) {
 		case "application/json", "*/*":
-			return buildJSON(p, m.indent, m.gen)
+			data, err = buildJSON(p, m.indent, m.gen)
+			return data, "", err
 		case "application/xml", "text/xml":
-			return buildXML(m.doc.XMLNamespaces, p, m.indent, m.gen)
+			data, err = buildXML(m.doc.XMLNamespaces, p, m.indent, m.gen)
+			return data, "", err
+		case formMimetype:
+			data, err = buildForm(p, m.gen)
+			return data, "", err
+		case multipartMimetype:
+			return buildMultipart(p, m.gen)
 		}
 	}
-	return nil, core.NewError(locale.ErrInvalidValue).WithField("headers[accept]")
+	return nil, "", core.NewError(locale.ErrInvalidValue).WithField("headers[accept]")
 }
@@ -233,6 +233,21 @@ func TestValidRequest(t *testing.T) {
 	r.Header.Set("encoding", "yyy")
 	a.NotError(validRequest(nil, []*ast.Request{dataWithHeader.Type}, r))
 
+	// 匹配 application/x-www-form-urlencoded
+	form := newFormRequest()
+	form.Mimetype = &ast.Attribute{Value: xmlenc.String{Value: formMimetype}}
+	r = httptest.NewRequest(http.MethodPost, "/path", bytes.NewBufferString("name=n&tags=1"))
+	r.Header.Set("content-type", formMimetype+"; charset=utf-8")
+	a.NotError(validRequest(nil, []*ast.Request{dataWithHeader.Type, form}, r))
+
+	// 匹配 multipart/form-data
+	form.Mimetype = &ast.Attribute{Value: xmlenc.String{Value: multipartMimetype}}
+	data, ct, err := buildMultipart(form, testOptions)
+	a.NotError(err)
+	r = httptest.NewRequest(http.MethodPost, "/path", bytes.NewBuffer(data))
+	r.Header.Set("content-type", ct)
+	a.NotError(validRequest(nil, []*ast.Request{dataWithHeader.Type, form}, r))
+
 	// 无法匹配 content-type
 	body = bytes.NewBufferString(dataWithHeader.JSON)
 	r = httptest.NewRequest(http.MethodGet, "/path", body)
@@ -250,28 +265,28 @@ func TestBuildResponse(t *testing.T) {
 		doc:    &ast.APIDoc{},
 	}
 
-	resp, err := m.buildResponse(nil, nil)
+	resp, _, err := m.buildResponse(nil, nil)
 	a.NotError(err).Nil(resp)
 
 	// 匹配 json
 	r := httptest.NewRequest(http.MethodGet, "/path", nil)
 	r.Header.Set("accept", "application/json")
 	r.Header.Set("encoding", "xxx")
-	resp, err = m.buildResponse(dataWithHeader.Type, r)
+	resp, _, err = m.buildResponse(dataWithHeader.Type, r)
 	a.NotError(err).Equal(string(resp), dataWithHeader.JSON)
 
 	// 匹配 xml
 	r = httptest.NewRequest(http.MethodGet, "/path", nil)
 	r.Header.Set("accept", "application/json;q=0.1,application/xml")
 	r.Header.Set("encoding", "yyy")
-	resp, err = m.buildResponse(dataWithHeader.Type, r)
+	resp, _, err = m.buildResponse(dataWithHeader.Type, r)
 	a.NotError(err).Equal(string(resp), dataWithHeader.XML)
 
 	// 无法匹配 content-type
 	r = httptest.NewRequest(http.MethodGet, "/path", nil)
 	r.Header.Set("content-type", "not-exists")
 	r.Header.Set("encoding", "xxx")
-	resp, err = m.buildResponse(dataWithHeader.Type, r)
+	resp, _, err = m.buildResponse(dataWithHeader.Type, r)
 	a.Error(err).Nil(resp)
 }
 
@@ -477,6 +492,33 @@ func TestValidQueries(t *testing.T) {
 			r:   httptest.NewRequest(http.MethodGet, "/users?k1=1&k2=2,3,not-number", nil),
 			err: true,
 		},
+		{
+			title: "数组-array-style，空值",
+			p: []*ast.Param{
+				{
+					Name:       &ast.Attribute{Value: xmlenc.String{Value: "k2"}},
+					Type:       &ast.TypeAttribute{Value: xmlenc.String{Value: ast.TypeString}},
+					Array:      &ast.BoolAttribute{Value: ast.Bool{Value: true}},
+					ArrayStyle: &ast.BoolAttribute{Value: ast.Bool{Value: true}},
+					Constraint: ast.Constraint{MaxItems: &ast.NumberAttribute{Value: ast.Number{Int: 0}}},
+				},
+			},
+			r: httptest.NewRequest(http.MethodGet, "/users?k2=", nil),
+		},
+		{
+			title: "数组-array-style，空值不满足 min-items",
+			p: []*ast.Param{
+				{
+					Name:       &ast.Attribute{Value: xmlenc.String{Value: "k2"}},
+					Type:       &ast.TypeAttribute{Value: xmlenc.String{Value: ast.TypeString}},
+					Array:      &ast.BoolAttribute{Value: ast.Bool{Value: true}},
+					ArrayStyle: &ast.BoolAttribute{Value: ast.Bool{Value: true}},
+					Constraint: ast.Constraint{MinItems: &ast.NumberAttribute{Value: ast.Number{Int: 1}}},
+				},
+			},
+			r:   httptest.NewRequest(http.MethodGet, "/users?k2=", nil),
+			err: true,
+		},
 	}
 
 	for _, item := range data {
@@ -484,7 +526,7 @@ func TestValidQueries(t *testing.T) {
 		if item.err {
 			a.Error(err, "not error at %s", item.title)
 		} else {
-			a.NotError("err %s at %s", err, item.title)
+			a.NotError(err, "err %s at %s", err, item.title)
 		}
 	}
 }
@@ -0,0 +1,109 @@
+// SPDX-License-Identifier: MIT
+
+package mock
+
+import (
+	"mime"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/caixw/apidoc/v7/core"
+	"github.com/caixw/apidoc/v7/internal/ast"
+)
+
+// ValidExamples 验证文档中的示例代码是否与其所属的 request 或 response 相匹配
+//
+// 仅验证 mimetype 为 JSON 和 XML 的示例代码，验证规则与 mock 对请求内容的验证相同。
+// 不匹配的内容以错误的形式输出到 h，并定位到 CDATA 中出错的位置。
+func ValidExamples(h *core.MessageHandler, d *ast.APIDoc) {
+	ns := d.XMLNamespaces
+
+	validRequestsExamples(h, ns, d.Responses)
+	for _, api := range d.APIs {
+		validRequestsExamples(h, ns, api.Requests)
+		validRequestsExamples(h, ns, api.Responses)
+
+		if api.Callback != nil {
+			validRequestsExamples(h, ns, api.Callback.Requests)
+			validRequestsExamples(h, ns, api.Callback.Responses)
+		}
+	}
+}
+
+func validRequestsExamples(h *core.MessageHandler, ns []*ast.XMLNamespace, requests []*ast.Request) {
+	for _, r := range requests {
+		for _, exp := range r.Examples {
+			if err := validExample(ns, r, exp); err != nil {
+				h.Error(err)
+			}
+		}
+	}
+}
+
+func validExample(ns []*ast.XMLNamespace, r *ast.Request, exp *ast.Example) *core.Error {
+	if exp.Content == nil {
+		return nil
+	}
+	content := []byte(exp.Content.Value.Value)
+
+	var start, end int64
+	var err error
+	switch exampleFormat(exp.Mimetype.V()) {
+	case "json":
+		start, end, err = validJSONRange(r, content)
+	case "xml":
+		start, end, err = validXMLRange(ns, r, content)
+	}
+	if err == nil {
+		return nil
+	}
+
+	loc := exp.Content.Value.Location
+	begin := loc.Range.Start
+	loc.Range = core.Range{
+		Start: offsetPosition(begin, content[:start]),
+		End:   offsetPosition(begin, content[:end]),
+	}
+
+	serr, ok := err.(*core.Error)
+	if !ok {
+		return loc.WithError(err)
+	}
+	serr.Location = loc
+	return serr
+}
+
+// 根据 mimetype 返回需要验证的格式，json 或是 xml，其它格式返回空值。
+func exampleFormat(mimetype string) string {
+	mt, _, err := mime.ParseMediaType(mimetype)
+	if err != nil {
+		return ""
+	}
+
+	switch {
+	case mt == "application/json" || strings.HasSuffix(mt, "+json"):
+		return "json"
+	case mt == "application/xml" || mt == "text/xml" || strings.HasSuffix(mt, "+xml"):
+		return "xml"
+	default:
+		return ""
+	}
+}
+
+// 返回 data 之后的位置，pos 为 data 的起始位置。
+//
+// 与 lexer 的计算方式相同，Character 表示的是字符而不是字节的偏移量。
+func offsetPosition(pos core.Position, data []byte) core.Position {
+	for len(data) > 0 {
+		r, size := utf8.DecodeRune(data)
+		data = data[size:]
+
+		if r == '\n' {
+			pos.Line++
+			pos.Character = 0
+		} else {
+			pos.Character++
+		}
+	}
+	return pos
+}
@@ -0,0 +1,93 @@
+// SPDX-License-Identifier: MIT
+
+package mock
+
+import (
+	"testing"
+
+	"github.com/issue9/assert"
+
+	"github.com/caixw/apidoc/v7/core"
+	"github.com/caixw/apidoc/v7/core/messagetest"
+	"github.com/caixw/apidoc/v7/internal/ast"
+)
+
+func TestValidExamples(t *testing.T) {
+	a := assert.New(t)
+
+	rslt := messagetest.NewMessageHandler()
+	d := &ast.APIDoc{}
+	d.Parse(rslt.Handler, core.Block{
+		Location: core.Location{URI: "file:///example.xml"},
+		Data: []byte(`<apidoc version="1.0.0">
+	<title>title</title>
+	<mimetype>application/json</mimetype>
+	<response status="400" type="object">
+		<param name="code" type="number" summary="code" />
+		<example mimetype="application/json"><![CDATA[{"code": 400}]]></example>
+	</response>
+	<api method="POST">
+		<path path="/users" />
+		<request name="user" type="object" mimetype="application/json">
+			<param name="id" type="number" summary="id" />
+			<param name="name" type="string" summary="name" />
+			<example mimetype="application/json"><![CDATA[
+{
+	"id": 1,
+	"name": 2
+}
+]]></example>
+		</request>
+		<response status="200" name="user" type="object">
+			<param name="id" type="number" xml-attr="true" summary="id" />
+			<example mimetype="application/xml"><![CDATA[<user id="str" />]]></example>
+			<example mimetype="text/plain"><![CDATA[不验证的内容]]></example>
+		</response>
+	</api>
+</apidoc>`),
+	})
+	rslt.Handler.Stop()
+	a.Empty(rslt.Errors)
+
+	rslt = messagetest.NewMessageHandler()
+	ValidExamples(rslt.Handler, d)
+	rslt.Handler.Stop()
+	a.Equal(2, len(rslt.Errors))
+
+	// 顺序固定：先 request，后 response
+	err, ok := rslt.Errors[0].(*core.Error)
+	a.True(ok).
+		Equal(err.Field, "name").
+		Equal(err.Location.URI, "file:///example.xml").
+		Equal(err.Location.Range, core.Range{
+			Start: core.Position{Line: 15, Character: 9},
+			End:   core.Position{Line: 15, Character: 10},
+		})
+
+	err, ok = rslt.Errors[1].(*core.Error)
+	a.True(ok).
+		Equal(err.Location.Range, core.Range{
+			Start: core.Position{Line: 21, Character: 48},
+			End:   core.Position{Line: 21, Character: 65},
+		})
+}
+
+func TestExampleFormat(t *testing.T) {
+	a := assert.New(t)
+
+	a.Equal(exampleFormat("application/json"), "json").
+		Equal(exampleFormat("application/problem+json; charset=utf-8"), "json").
+		Equal(exampleFormat("text/xml"), "xml").
+		Equal(exampleFormat("application/atom+xml"), "xml").
+		Equal(exampleFormat("text/plain"), "").
+		Equal(exampleFormat(""), "")
+}
+
+func TestOffsetPosition(t *testing.T) {
+	a := assert.New(t)
+
+	pos := core.Position{Line: 1, Character: 5}
+	a.Equal(offsetPosition(pos, nil), pos).
+		Equal(offsetPosition(pos, []byte("中文")), core.Position{Line: 1, Character: 7}).
+		Equal(offsetPosition(pos, []byte("{\n\t\"id\"")), core.Position{Line: 2, Character: 5})
+}
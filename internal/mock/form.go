@@ -0,0 +1,218 @@
+// SPDX-License-Identifier: MIT
+
+package mock
+
+import (
+	"bytes"
+	"mime/multipart"
+	"net/url"
+
+	"github.com/caixw/apidoc/v7/core"
+	"github.com/caixw/apidoc/v7/internal/ast"
+	"github.com/caixw/apidoc/v7/internal/locale"
+)
+
+const (
+	formMimetype      = "application/x-www-form-urlencoded"
+	multipartMimetype = "multipart/form-data"
+)
+
+// 解析 multipart 时可占用的最大内存，超出部分会写入临时文件。
+const multipartMaxMemory = 32 << 20
+
+// 返回表单中的字段列表
+//
+// 表单只能表示扁平的键值对，所以 p 只能是对象或是空值，
+// 对象的子元素也只能是简单类型或是由简单类型组成的数组。
+func formItems(p *ast.Request) ([]*ast.Param, error) {
+	pp := p.Param().Resolve()
+	switch {
+	case pp.Type.V() == ast.TypeNone:
+		return nil, nil
+	case pp.Type.V() == ast.TypeObject && !pp.Array.V():
+		return pp.Items, nil
+	default:
+		return nil, core.NewError(locale.ErrInvalidValue).WithField("type")
+	}
+}
+
+func findFormItem(items []*ast.Param, name string) *ast.Param {
+	for _, item := range items {
+		if item.Name.V() == name {
+			return item
+		}
+	}
+	return nil
+}
+
+// 验证 application/x-www-form-urlencoded 格式的内容
+func validForm(p *ast.Request, content []byte) error {
+	values, err := url.ParseQuery(string(content))
+	if err != nil {
+		return core.NewError(locale.ErrInvalidFormat)
+	}
+	return validFormValues(p, values, nil)
+}
+
+// 验证 multipart/form-data 格式的内容
+//
+// string.binary 类型的字段可以是文件，其它类型的字段只能是普通的值。
+func validMultipart(p *ast.Request, boundary string, content []byte) error {
+	if boundary == "" {
+		return core.NewError(locale.ErrInvalidValue).WithField("headers[content-type]")
+	}
+
+	form, err := multipart.NewReader(bytes.NewReader(content), boundary).ReadForm(multipartMaxMemory)
+	if err != nil {
+		return core.NewError(locale.ErrInvalidFormat)
+	}
+	defer form.RemoveAll()
+
+	files := form.File
+	if files == nil {
+		files = map[string][]*multipart.FileHeader{}
+	}
+	return validFormValues(p, form.Value, files)
+}
+
+// files 为 nil 表示内容不支持文件，即 application/x-www-form-urlencoded 格式，
+// 否则 string.binary 类型的字段只能以文件的形式提交。
+func validFormValues(p *ast.Request, values map[string][]string, files map[string][]*multipart.FileHeader) error {
+	items, err := formItems(p)
+	if err != nil {
+		return err
+	}
+
+	for name := range values {
+		if findFormItem(items, name) == nil {
+			return core.NewError(locale.ErrNotFound).WithField(name)
+		}
+	}
+	for name := range files {
+		if findFormItem(items, name) == nil {
+			return core.NewError(locale.ErrNotFound).WithField(name)
+		}
+	}
+
+	for _, item := range items {
+		name := item.Name.V()
+		vals := values[name]
+		size := len(vals) + len(files[name])
+
+		if size == 0 {
+			if item.Optional.V() || item.Default != nil {
+				continue
+			}
+			return core.NewError(locale.ErrIsEmpty, name).WithField(name)
+		}
+
+		isBinary := item.Resolve().Type.V() == ast.TypeBinary
+		if len(files[name]) > 0 && !isBinary {
+			return core.NewError(locale.ErrInvalidFormat).WithField(name)
+		}
+		if files != nil && isBinary && len(vals) > 0 {
+			return core.NewError(locale.ErrInvalidValue).WithField(name)
+		}
+
+		if !item.Array.V() && size > 1 {
+			return core.NewError(locale.ErrInvalidValue).WithField(name)
+		}
+		if item.Array.V() {
+			if err := validItemsSize(item, size); err != nil {
+				return err.WithField(name)
+			}
+		}
+
+		for _, v := range vals {
+			if err := validSimpleParam(item, name, v); err != nil {
+				if serr, ok := err.(*core.Error); ok {
+					return serr.WithField(name)
+				}
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// 生成 application/x-www-form-urlencoded 格式的内容
+func buildForm(p *ast.Request, g *GenOptions) ([]byte, error) {
+	items, err := formItems(p)
+	if err != nil {
+		return nil, err
+	}
+
+	values := url.Values{}
+	for _, item := range items {
+		vals, err := generateFormValues(item, g)
+		if err != nil {
+			return nil, err
+		}
+		values[item.Name.V()] = vals
+	}
+	return []byte(values.Encode()), nil
+}
+
+// 生成 multipart/form-data 格式的内容
+//
+// ct 返回包含了 boundary 参数的 content-type 值。
+func buildMultipart(p *ast.Request, g *GenOptions) (data []byte, ct string, err error) {
+	items, err := formItems(p)
+	if err != nil {
+		return nil, "", err
+	}
+
+	buf := new(bytes.Buffer)
+	w := multipart.NewWriter(buf)
+	for _, item := range items {
+		name := item.Name.V()
+		vals, err := generateFormValues(item, g)
+		if err != nil {
+			return nil, "", err
+		}
+
+		if item.Resolve().Type.V() != ast.TypeBinary {
+			for _, v := range vals {
+				if err := w.WriteField(name, v); err != nil {
+					return nil, "", err
+				}
+			}
+			continue
+		}
+
+		for _, v := range vals {
+			fw, err := w.CreateFormFile(name, name)
+			if err != nil {
+				return nil, "", err
+			}
+			if _, err := fw.Write([]byte(v)); err != nil {
+				return nil, "", err
+			}
+		}
+	}
+
+	if err := w.Close(); err != nil {
+		return nil, "", err
+	}
+	return buf.Bytes(), w.FormDataContentType(), nil
+}
+
+// 生成表单中单个字段的值
+func generateFormValues(p *ast.Param, g *GenOptions) ([]string, error) {
+	size := 1
+	if p.Array.V() {
+		size = g.generateSliceSize(p)
+	}
+
+	p = p.Resolve()
+	vals := make([]string, 0, size)
+	for i := 0; i < size; i++ {
+		v, ok := g.generateSimpleValue(p)
+		if !ok {
+			return nil, core.NewError(locale.ErrInvalidFormat).WithField(p.Name.V())
+		}
+		vals = append(vals, v)
+	}
+	return vals, nil
+}
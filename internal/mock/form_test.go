@@ -0,0 +1,145 @@
+// SPDX-License-Identifier: MIT
+
+package mock
+
+import (
+	"bytes"
+	"mime"
+	"mime/multipart"
+	"net/url"
+	"testing"
+
+	"github.com/issue9/assert"
+
+	"github.com/caixw/apidoc/v7/core"
+	"github.com/caixw/apidoc/v7/internal/ast"
+	"github.com/caixw/apidoc/v7/internal/locale"
+	"github.com/caixw/apidoc/v7/internal/xmlenc"
+)
+
+func newFormRequest() *ast.Request {
+	return &ast.Request{
+		Type: &ast.TypeAttribute{Value: xmlenc.String{Value: ast.TypeObject}},
+		Items: []*ast.Param{
+			{
+				Name: &ast.Attribute{Value: xmlenc.String{Value: "name"}},
+				Type: &ast.TypeAttribute{Value: xmlenc.String{Value: ast.TypeString}},
+			},
+			{
+				Name:     &ast.Attribute{Value: xmlenc.String{Value: "age"}},
+				Type:     &ast.TypeAttribute{Value: xmlenc.String{Value: ast.TypeNumber}},
+				Optional: &ast.BoolAttribute{Value: ast.Bool{Value: true}},
+			},
+			{
+				Name:       &ast.Attribute{Value: xmlenc.String{Value: "tags"}},
+				Type:       &ast.TypeAttribute{Value: xmlenc.String{Value: ast.TypeString}},
+				Array:      &ast.BoolAttribute{Value: ast.Bool{Value: true}},
+				Constraint: ast.Constraint{MaxItems: &ast.NumberAttribute{Value: ast.Number{Int: 2}}},
+				Optional:   &ast.BoolAttribute{Value: ast.Bool{Value: true}},
+			},
+			{
+				Name:     &ast.Attribute{Value: xmlenc.String{Value: "avatar"}},
+				Type:     &ast.TypeAttribute{Value: xmlenc.String{Value: ast.TypeBinary}},
+				Optional: &ast.BoolAttribute{Value: ast.Bool{Value: true}},
+			},
+		},
+	}
+}
+
+func TestValidForm(t *testing.T) {
+	a := assert.New(t)
+	r := newFormRequest()
+
+	a.NotError(validForm(r, []byte("name=n&age=5&tags=t1&tags=t2")))
+	a.NotError(validForm(r, []byte("name=n")))
+
+	err := validForm(r, []byte("name=n&age=xx"))
+	a.Error(err)
+	cerr, ok := err.(*core.Error)
+	a.True(ok).Equal(cerr.Field, "age")
+
+	a.Error(validForm(r, []byte("age=5")))                       // 缺少 name
+	a.Error(validForm(r, []byte("name=n&name=n2")))              // 非数组
+	a.Error(validForm(r, []byte("name=n&tags=1&tags=2&tags=3"))) // maxItems
+	a.Error(validForm(r, []byte("name=n&not-exists=1")))         // 不存在的字段
+	a.Error(validForm(r, []byte("name=%zz")))                    // 格式错误
+	a.Error(validForm(&ast.Request{Type: r.Items[0].Type}, nil)) // 非对象
+	a.NotError(validForm(&ast.Request{}, nil))                   // 空值
+}
+
+func TestValidMultipart(t *testing.T) {
+	a := assert.New(t)
+	r := newFormRequest()
+
+	buf := new(bytes.Buffer)
+	w := multipart.NewWriter(buf)
+	a.NotError(w.WriteField("name", "n"))
+	fw, err := w.CreateFormFile("avatar", "avatar.png")
+	a.NotError(err)
+	_, err = fw.Write([]byte("png"))
+	a.NotError(err)
+	a.NotError(w.Close())
+	a.NotError(validMultipart(r, w.Boundary(), buf.Bytes()))
+	a.Error(validMultipart(r, "", buf.Bytes()))
+
+	// 文件只能是 string.binary
+	buf.Reset()
+	w = multipart.NewWriter(buf)
+	fw, err = w.CreateFormFile("name", "name.txt")
+	a.NotError(err)
+	_, err = fw.Write([]byte("n"))
+	a.NotError(err)
+	a.NotError(w.Close())
+	err = validMultipart(r, w.Boundary(), buf.Bytes())
+	a.Error(err)
+	cerr, ok := err.(*core.Error)
+	a.True(ok).Equal(cerr.Field, "name")
+
+	// string.binary 只能是文件
+	buf.Reset()
+	w = multipart.NewWriter(buf)
+	a.NotError(w.WriteField("name", "n"))
+	a.NotError(w.WriteField("avatar", "png"))
+	a.NotError(w.Close())
+	err = validMultipart(r, w.Boundary(), buf.Bytes())
+	a.Error(err)
+	cerr, ok = err.(*core.Error)
+	a.True(ok).
+		Equal(cerr.Field, "avatar").
+		Equal(cerr.Err, locale.NewError(locale.ErrInvalidValue))
+}
+
+func TestBuildForm(t *testing.T) {
+	a := assert.New(t)
+	r := newFormRequest()
+
+	data, err := buildForm(r, testOptions)
+	a.NotError(err)
+	values, err := url.ParseQuery(string(data))
+	a.NotError(err)
+	a.Equal(values.Get("name"), "1024").
+		Equal(values.Get("age"), "1024").
+		Equal(len(values["tags"]), 2)
+	a.NotError(validForm(r, data))
+
+	// 不支持的类型
+	r.Items[0].Type = &ast.TypeAttribute{Value: xmlenc.String{Value: ast.TypeObject}}
+	data, err = buildForm(r, testOptions)
+	a.Error(err).Nil(data)
+}
+
+func TestBuildMultipart(t *testing.T) {
+	a := assert.New(t)
+	r := newFormRequest()
+
+	data, ct, err := buildMultipart(r, testOptions)
+	a.NotError(err).NotEmpty(data)
+	mt, params, err := mime.ParseMediaType(ct)
+	a.NotError(err).Equal(mt, multipartMimetype)
+	a.NotError(validMultipart(r, params["boundary"], data))
+
+	form, err := multipart.NewReader(bytes.NewReader(data), params["boundary"]).ReadForm(1024)
+	a.NotError(err)
+	a.Equal(form.Value["name"], []string{"1024"}).
+		Equal(1, len(form.File["avatar"]))
+}
@@ -27,36 +27,164 @@ type jsonValidator struct {
 	states []byte
 
 	names []string // 按顺序保存变量名称
+
+	arrays []*jsonArray // 按顺序保存正在验证的数组
+
+	offset int64 // 最后读取的 token 之前的位置，用于定位出错的内容。
+}
+
+// 记录数组的元素数量，用于验证数组长度的约束条件
+type jsonArray struct {
+	param *ast.Param
+	field string
+	size  int
 }
 
 func validJSON(p *ast.Request, content []byte) error {
+	_, _, err := validJSONRange(p, content)
+	return err
+}
+
+// 验证 JSON 内容，出错时同时返回出错内容在 content 中的范围。
+func validJSONRange(p *ast.Request, content []byte) (start, end int64, err error) {
+	end = int64(len(content))
+
 	if p == nil {
 		if bytes.Equal(content, []byte("null")) {
-			return nil
+			return 0, 0, nil
 		}
-		return core.NewError(locale.ErrInvalidFormat)
+		return 0, end, core.NewError(locale.ErrInvalidFormat)
 	} else if p.Type.V() == ast.TypeNone && len(content) == 0 {
-		return nil
+		return 0, 0, nil
 	}
 
 	if !json.Valid(content) {
-		return core.NewError(locale.ErrInvalidFormat)
+		var v interface{}
+		var serr *json.SyntaxError
+		if errors.As(json.Unmarshal(content, &v), &serr) && serr.Offset > 0 {
+			start, end = serr.Offset-1, serr.Offset
+		}
+		return start, end, core.NewError(locale.ErrInvalidFormat)
 	}
 
 	validator := newJSONValidator(p)
-	return validator.valid(json.NewDecoder(bytes.NewReader(content)))
+	if isUnion(validator.param) {
+		return 0, end, validUnionJSON(validator.param, content)
+	}
+
+	d := json.NewDecoder(bytes.NewReader(content))
+	if err = validator.valid(d); err != nil {
+		start = validator.offset
+		for start < d.InputOffset() && strings.IndexByte(" \t\r\n,:", content[start]) >= 0 { // 跳过 token 之前的空白和分隔符
+			start++
+		}
+		return start, d.InputOffset(), err
+	}
+	return 0, 0, nil
 }
 
 func newJSONValidator(r *ast.Request) *jsonValidator {
+	return newParamJSONValidator(r.Param().Resolve())
+}
+
+func newParamJSONValidator(p *ast.Param) *jsonValidator {
 	return &jsonValidator{
-		param:  r.Param(),
+		param:  p,
 		states: []byte{0}, // 状态有默认值
 		names:  []string{},
 	}
 }
 
+// 验证联合类型的内容
+//
+// 指定了 discriminator 的，根据其值选择分支进行验证；
+// 否则 one-of 必须有且仅有一个分支验证通过，any-of 至少一个分支验证通过。
+func validUnionJSON(p *ast.Param, content []byte) error {
+	if bytes.Equal(content, []byte("null")) {
+		if p.Nullable.V() {
+			return nil
+		}
+		return core.NewError(locale.ErrInvalidValue)
+	}
+
+	if p.Array.V() {
+		items := make([]json.RawMessage, 0, 10)
+		if err := json.Unmarshal(content, &items); err != nil {
+			return core.NewError(locale.ErrInvalidFormat)
+		}
+		if err := validItemsSize(p, len(items)); err != nil {
+			return err
+		}
+
+		pp := *p
+		pp.Array, pp.Nullable = nil, nil
+		for _, item := range items {
+			if err := validUnionJSON(&pp, item); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	branches := p.Branches()
+
+	if p.Discriminator != nil {
+		obj := make(map[string]json.RawMessage, len(p.Items))
+		if err := json.Unmarshal(content, &obj); err != nil {
+			return core.NewError(locale.ErrInvalidFormat)
+		}
+
+		field := p.Discriminator.V()
+		var name string
+		if err := json.Unmarshal(obj[field], &name); err != nil {
+			return core.NewError(locale.ErrInvalidValue).WithField(field)
+		}
+		for index, b := range p.OneOf {
+			if b.Name.V() == name {
+				return newParamJSONValidator(branches[index]).valid(json.NewDecoder(bytes.NewReader(content)))
+			}
+		}
+		return core.NewError(locale.ErrInvalidValue).WithField(field)
+	}
+
+	var matched int
+	var err error
+	for _, b := range branches {
+		if e := newParamJSONValidator(b).valid(json.NewDecoder(bytes.NewReader(content))); e != nil {
+			err = e
+			continue
+		}
+		matched++
+	}
+
+	switch {
+	case matched == 0:
+		return err
+	case matched > 1 && len(p.OneOf) > 0:
+		return core.NewError(locale.ErrInvalidValue)
+	default:
+		return nil
+	}
+}
+
+// 为 err 的字段名添加前缀 field
+func prefixField(err error, field string) error {
+	var e *core.Error
+	if field == "" || !errors.As(err, &e) {
+		return err
+	}
+
+	if e.Field == "" {
+		e.Field = field
+	} else {
+		e.Field = field + "." + e.Field
+	}
+	return e
+}
+
 func (validator *jsonValidator) valid(d *json.Decoder) error {
 	for {
+		validator.offset = d.InputOffset()
 		token, err := d.Token()
 		if errors.Is(err, io.EOF) && token == nil { // 正常结束
 			return nil
@@ -65,7 +193,16 @@ func (validator *jsonValidator) valid(d *json.Decoder) error {
 			return err
 		}
 		if token == nil { // 对应 JSON null
-			return nil
+			validator.countItem()
+			err = validator.validNull()
+			if validator.state() != '[' {
+				validator.popState()
+				validator.popName()
+			}
+			if err != nil {
+				return err
+			}
+			continue
 		}
 
 		switch v := token.(type) {
@@ -76,6 +213,7 @@ func (validator *jsonValidator) valid(d *json.Decoder) error {
 				validator.popState()
 				validator.popName()
 			case '[':
+				validator.countItem()
 				err = validator.validValue(ast.TypeString, v)
 			case 0: // 表示数据为单个值，比如 "str"
 				err = validator.validValue(ast.TypeString, v)
@@ -83,6 +221,18 @@ func (validator *jsonValidator) valid(d *json.Decoder) error {
 			default: // case '{' 属性名
 				validator.pushState(':')
 				validator.pushName(v)
+
+				// 联合类型需要完整的内容才能判断其所属的分支
+				if p := validator.find(); p != nil && isUnion(p) {
+					var raw json.RawMessage
+					validator.offset = d.InputOffset()
+					if err = d.Decode(&raw); err != nil {
+						return err
+					}
+					err = prefixField(validUnionJSON(p, raw), strings.Join(validator.names, "."))
+					validator.popState()
+					validator.popName()
+				}
 			}
 
 			if err != nil {
@@ -91,31 +241,37 @@ func (validator *jsonValidator) valid(d *json.Decoder) error {
 		case json.Delim: // [、]、{、}
 			switch v {
 			case '[':
+				validator.pushArray()
 				validator.pushState('[')
 			case ']':
-				validator.popName()
+				if err := validator.popArray(); err != nil {
+					return err
+				}
 
 				validator.popState()
-				if validator.state() == ':' { // {xx: [] } 类似这种格式，需要同时弹出两个状态
+				if validator.state() == ':' { // {xx: [] } 类似这种格式，需要同时弹出两个状态以及键名
 					validator.popState()
+					validator.popName()
 				}
 			case '{':
+				validator.countItem()
 				validator.pushState('{')
 			case '}':
-				validator.popName()
-
 				validator.popState()
-				if validator.state() == ':' {
+				if validator.state() == ':' { // 作为数组元素的对象，不需要弹出键名
 					validator.popState()
+					validator.popName()
 				}
 			}
 		case bool: // json bool
+			validator.countItem()
 			err = validator.validValue(ast.TypeBool, v)
 			if validator.state() != '[' {
 				validator.popState()
 				validator.popName()
 			}
 		case float64, json.Number: // json number
+			validator.countItem()
 			err = validator.validValue(ast.TypeNumber, v)
 			if validator.state() != '[' { // 只有键值对结束时，才弹出键名
 				validator.popState()
@@ -183,6 +339,10 @@ func (validator *jsonValidator) validValue(t string, v interface{}) error {
 	case ast.TypeInt, ast.TypeFloat: // 数值类型都被 json 解释为 float64，无法判断值是浮点还是整数。
 	}
 
+	if err := validConstraint(p, fmt.Sprint(v)); err != nil {
+		return err.WithField(field)
+	}
+
 	if isEnum(p) {
 		for _, enum := range p.Enums {
 			if enum.Value.V() == fmt.Sprint(v) {
@@ -195,6 +355,23 @@ func (validator *jsonValidator) validValue(t string, v interface{}) error {
 	return nil
 }
 
+// 验证当前位置是否可以为 null
+//
+// 数组的 nullable 表示数组本身可以为 null，其元素不能为 null。
+func (validator *jsonValidator) validNull() error {
+	field := strings.Join(validator.names, ".")
+
+	p := validator.find()
+	if p == nil {
+		return core.NewError(locale.ErrNotFound).WithField(field)
+	}
+
+	if validator.state() == '[' || !p.Nullable.V() {
+		return core.NewError(locale.ErrInvalidValue).WithField(field)
+	}
+	return nil
+}
+
 // 返回当前的状态
 func (validator *jsonValidator) state() byte {
 	if len(validator.states) > 0 {
@@ -223,15 +400,51 @@ func (validator *jsonValidator) popName() {
 	}
 }
 
+func (validator *jsonValidator) pushArray() {
+	validator.arrays = append(validator.arrays, &jsonArray{
+		param: validator.find(),
+		field: strings.Join(validator.names, "."),
+	})
+}
+
+// 弹出最后一个数组，并验证其元素数量
+func (validator *jsonValidator) popArray() error {
+	if len(validator.arrays) == 0 {
+		return nil
+	}
+
+	arr := validator.arrays[len(validator.arrays)-1]
+	validator.arrays = validator.arrays[:len(validator.arrays)-1]
+
+	if arr.param != nil {
+		if err := validItemsSize(arr.param, arr.size); err != nil {
+			return err.WithField(arr.field)
+		}
+	}
+	return nil
+}
+
+// 如果当前处于数组中，则增加数组的元素数量
+func (validator *jsonValidator) countItem() {
+	if validator.state() == '[' && len(validator.arrays) > 0 {
+		validator.arrays[len(validator.arrays)-1].size++
+	}
+}
+
 // 如果 names 为空，返回 validator.param
 func (validator *jsonValidator) find() *ast.Param {
 	p := validator.param
 
 LOOP:
 	for _, name := range validator.names {
+		if p.Type.V() == ast.TypeMap { // map 的键名可以是任意值
+			p = p.Items[0].Resolve()
+			continue
+		}
+
 		for _, pp := range p.Items {
 			if pp.Name.V() == name {
-				p = pp
+				p = pp.Resolve()
 				continue LOOP
 			}
 		}
@@ -268,12 +481,17 @@ func (builder *jsonBuilder) encode(p *ast.Param, chkArray bool, g *GenOptions) e
 	if p == nil {
 		return builder.writeValue(nil).w.Err
 	}
+	p = p.Resolve()
+
+	if chkArray && g.generateNull(p) {
+		return builder.writeValue(nil).w.Err
+	}
 
 	if p.Array.V() && chkArray {
 		builder.w.WString("[\n")
 		builder.deep++
 
-		size := g.generateSliceSize()
+		size := g.generateSliceSize(p)
 		last := size - 1
 		for i := 0; i < size; i++ {
 			if err := builder.writeIndent().encode(p, false, g); err != nil {
@@ -291,6 +509,10 @@ func (builder *jsonBuilder) encode(p *ast.Param, chkArray bool, g *GenOptions) e
 		return builder.writeIndent().w.WString("]").Err
 	}
 
+	if isUnion(p) {
+		p = g.generateBranch(p)
+	}
+
 	switch primitive, _ := ast.ParseType(p.Type.V()); primitive {
 	case ast.TypeNone:
 		builder.writeValue(nil)
@@ -319,6 +541,28 @@ func (builder *jsonBuilder) encode(p *ast.Param, chkArray bool, g *GenOptions) e
 			}
 		}
 
+		builder.deep--
+		builder.writeIndent().w.WString("}")
+	case ast.TypeMap:
+		builder.w.WString("{\n")
+		builder.deep++
+
+		keys := g.generateMapKeys()
+		last := len(keys) - 1
+		for index, key := range keys {
+			builder.writeIndent().w.WString(`"`).WString(key).WString(`"`).WString(": ")
+
+			if err := builder.encode(p.Items[0], true, g); err != nil {
+				return err
+			}
+
+			if index < last {
+				builder.w.WString(",\n")
+			} else {
+				builder.w.WString("\n")
+			}
+		}
+
 		builder.deep--
 		builder.writeIndent().w.WString("}")
 	}
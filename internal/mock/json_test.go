@@ -9,6 +9,7 @@ import (
 
 	"github.com/issue9/assert"
 
+	"github.com/caixw/apidoc/v7/core"
 	"github.com/caixw/apidoc/v7/internal/ast"
 	"github.com/caixw/apidoc/v7/internal/xmlenc"
 )
@@ -34,6 +35,89 @@ func TestJSONValidator_valid(t *testing.T) {
 	a.Error(v.valid(d))
 }
 
+// 数组中包含多个对象时，对象结束时不应该弹出数组的键名
+func TestJSONValidator_valid_objectArray(t *testing.T) {
+	a := assert.New(t)
+
+	r := &ast.Request{
+		Type:  &ast.TypeAttribute{Value: xmlenc.String{Value: ast.TypeObject}},
+		Array: &ast.BoolAttribute{Value: ast.Bool{Value: true}},
+		Items: []*ast.Param{
+			{
+				Name: &ast.Attribute{Value: xmlenc.String{Value: "id"}},
+				Type: &ast.TypeAttribute{Value: xmlenc.String{Value: ast.TypeNumber}},
+			},
+			{
+				Name:  &ast.Attribute{Value: xmlenc.String{Value: "tags"}},
+				Type:  &ast.TypeAttribute{Value: xmlenc.String{Value: ast.TypeString}},
+				Array: &ast.BoolAttribute{Value: ast.Bool{Value: true}},
+			},
+		},
+	}
+	v := newJSONValidator(r)
+	d := json.NewDecoder(strings.NewReader(`[{"id":1,"tags":["t1"]},{"id":2,"tags":[]},{"id":3}]`))
+	a.NotError(v.valid(d))
+	a.Empty(v.names)
+
+	// 嵌套在对象中的对象数组
+	r = &ast.Request{
+		Type: &ast.TypeAttribute{Value: xmlenc.String{Value: ast.TypeObject}},
+		Items: []*ast.Param{
+			{
+				Name:  &ast.Attribute{Value: xmlenc.String{Value: "list"}},
+				Type:  &ast.TypeAttribute{Value: xmlenc.String{Value: ast.TypeObject}},
+				Array: &ast.BoolAttribute{Value: ast.Bool{Value: true}},
+				Items: []*ast.Param{
+					{
+						Name: &ast.Attribute{Value: xmlenc.String{Value: "id"}},
+						Type: &ast.TypeAttribute{Value: xmlenc.String{Value: ast.TypeNumber}},
+					},
+				},
+			},
+			{
+				Name: &ast.Attribute{Value: xmlenc.String{Value: "count"}},
+				Type: &ast.TypeAttribute{Value: xmlenc.String{Value: ast.TypeNumber}},
+			},
+		},
+	}
+	v = newJSONValidator(r)
+	d = json.NewDecoder(strings.NewReader(`{"list":[{"id":1},{"id":2}],"count":2}`))
+	a.NotError(v.valid(d))
+	a.Empty(v.names)
+
+	v = newJSONValidator(r)
+	d = json.NewDecoder(strings.NewReader(`{"list":[{"id":1},{"id":"2"}],"count":2}`))
+	a.Error(v.valid(d))
+}
+
+func TestValidJSONRange(t *testing.T) {
+	a := assert.New(t)
+
+	r := &ast.Request{
+		Type: &ast.TypeAttribute{Value: xmlenc.String{Value: ast.TypeObject}},
+		Items: []*ast.Param{
+			{
+				Name: &ast.Attribute{Value: xmlenc.String{Value: "id"}},
+				Type: &ast.TypeAttribute{Value: xmlenc.String{Value: ast.TypeNumber}},
+			},
+		},
+	}
+
+	start, end, err := validJSONRange(r, []byte(`{"id": 1}`))
+	a.NotError(err).Equal(start, 0).Equal(end, 0)
+
+	start, end, err = validJSONRange(r, []byte(`{"id": "1"}`))
+	a.Error(err).Equal(start, 7).Equal(end, 10)
+
+	// 未定义的字段，定位到其值
+	start, end, err = validJSONRange(r, []byte(`{"id": 1, "name": "n"}`))
+	a.Error(err).Equal(start, 18).Equal(end, 21)
+
+	// 格式错误
+	start, end, err = validJSONRange(r, []byte(`{id: 1}`))
+	a.Error(err).Equal(start, 1).Equal(end, 2)
+}
+
 func TestJSONValidator_find(t *testing.T) {
 	a := assert.New(t)
 
@@ -130,3 +214,126 @@ func TestBuildJSON(t *testing.T) {
 			Equal(string(data), item.JSON, "测试 %s 失败 v1:%s,v2:%s", item.Title, string(data), item.JSON)
 	}
 }
+
+func TestValidUnionJSON(t *testing.T) {
+	a := assert.New(t)
+
+	r := data[len(data)-1].Type // one-of
+	a.NotError(validJSON(r, []byte(`{"kind":"cat","meow":"meow"}`)))
+	a.Error(validJSON(r, []byte(`{"kind":"cat","bark":1}`)))
+	a.Error(validJSON(r, []byte(`{"kind":"bird","bark":1}`)))
+	a.Error(validJSON(r, []byte(`{"bark":1}`)))
+
+	// 未指定 discriminator
+	p := r.Param()
+	p.Discriminator = nil
+	a.NotError(validUnionJSON(p, []byte(`{"meow":"meow"}`)))
+	a.NotError(validUnionJSON(p, []byte(`{"bark":1}`)))
+	a.Error(validUnionJSON(p, []byte(`{"bark":"1"}`)))
+	a.Error(validUnionJSON(p, []byte(`{"bark":1,"meow":"meow"}`)))
+
+	// any-of
+	p.OneOf, p.AnyOf = nil, p.OneOf
+	a.NotError(validUnionJSON(p, []byte(`{"bark":1}`)))
+
+	// 数组
+	p.Array = &ast.BoolAttribute{Value: ast.Bool{Value: true}}
+	a.NotError(validUnionJSON(p, []byte(`[{"bark":1},{"meow":"meow"}]`)))
+	a.Error(validUnionJSON(p, []byte(`[{"bark":1},{"meow":1}]`)))
+
+	// 作为对象的字段
+	r = &ast.Request{
+		Type:  &ast.TypeAttribute{Value: xmlenc.String{Value: ast.TypeObject}},
+		Items: []*ast.Param{p},
+	}
+	p.Name = &ast.Attribute{Value: xmlenc.String{Value: "pets"}}
+	a.NotError(validJSON(r, []byte(`{"pets":[{"bark":1},{"meow":"meow"}]}`)))
+	err := validJSON(r, []byte(`{"pets":[{"bark":"1"}]}`))
+	a.Error(err)
+	cerr, ok := err.(*core.Error)
+	a.True(ok).Equal(cerr.Field, "pets.bark")
+}
+
+func TestValidJSON_map(t *testing.T) {
+	a := assert.New(t)
+
+	r := &ast.Request{
+		Type: &ast.TypeAttribute{Value: xmlenc.String{Value: ast.TypeMap}},
+		Items: []*ast.Param{
+			{
+				Name: &ast.Attribute{Value: xmlenc.String{Value: "value"}},
+				Type: &ast.TypeAttribute{Value: xmlenc.String{Value: ast.TypeNumber}},
+			},
+		},
+	}
+	a.NotError(validJSON(r, []byte(`{"zh-CN":1,"en":2}`)))
+	a.NotError(validJSON(r, []byte(`{}`)))
+	a.Error(validJSON(r, []byte(`{"zh-CN":"1"}`)))
+	a.Error(validJSON(r, []byte(`"str"`)))
+}
+
+func TestValidJSON_nullable(t *testing.T) {
+	a := assert.New(t)
+
+	r := &ast.Request{
+		Type: &ast.TypeAttribute{Value: xmlenc.String{Value: ast.TypeObject}},
+		Items: []*ast.Param{
+			{
+				Name:     &ast.Attribute{Value: xmlenc.String{Value: "name"}},
+				Type:     &ast.TypeAttribute{Value: xmlenc.String{Value: ast.TypeString}},
+				Nullable: &ast.BoolAttribute{Value: ast.Bool{Value: true}},
+			},
+			{
+				Name: &ast.Attribute{Value: xmlenc.String{Value: "age"}},
+				Type: &ast.TypeAttribute{Value: xmlenc.String{Value: ast.TypeNumber}},
+			},
+			{
+				Name:     &ast.Attribute{Value: xmlenc.String{Value: "tags"}},
+				Type:     &ast.TypeAttribute{Value: xmlenc.String{Value: ast.TypeString}},
+				Array:    &ast.BoolAttribute{Value: ast.Bool{Value: true}},
+				Nullable: &ast.BoolAttribute{Value: ast.Bool{Value: true}},
+			},
+		},
+	}
+	a.NotError(validJSON(r, []byte(`{"name":null,"age":1,"tags":null}`)))
+	a.NotError(validJSON(r, []byte(`{"name":"n","age":1,"tags":["1"]}`)))
+
+	err := validJSON(r, []byte(`{"name":"n","age":null}`))
+	a.Error(err)
+	cerr, ok := err.(*core.Error)
+	a.True(ok).Equal(cerr.Field, "age")
+
+	// 数组元素不能为 null
+	a.Error(validJSON(r, []byte(`{"tags":["1",null]}`)))
+
+	// null 之后的内容依然需要验证
+	a.Error(validJSON(r, []byte(`{"name":null,"age":"1"}`)))
+
+	// 根元素
+	a.Error(validJSON(r, []byte(`null`)))
+	r.Nullable = &ast.BoolAttribute{Value: ast.Bool{Value: true}}
+	a.NotError(validJSON(r, []byte(`null`)))
+}
+
+func TestBuildJSON_nullable(t *testing.T) {
+	a := assert.New(t)
+
+	p := &ast.Request{
+		Type: &ast.TypeAttribute{Value: xmlenc.String{Value: ast.TypeObject}},
+		Items: []*ast.Param{
+			{
+				Name:     &ast.Attribute{Value: xmlenc.String{Value: "name"}},
+				Type:     &ast.TypeAttribute{Value: xmlenc.String{Value: ast.TypeString}},
+				Nullable: &ast.BoolAttribute{Value: ast.Bool{Value: true}},
+			},
+			{
+				Name: &ast.Attribute{Value: xmlenc.String{Value: "age"}},
+				Type: &ast.TypeAttribute{Value: xmlenc.String{Value: ast.TypeNumber}},
+			},
+		},
+	}
+	data, err := buildJSON(p, "", testOptions)
+	a.NotError(err)
+	a.Equal(string(data), "{\n\"name\": null,\n\"age\": 1024\n}")
+	a.NotError(validJSON(p, data))
+}
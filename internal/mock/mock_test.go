@@ -649,6 +649,89 @@ var data = []*tester{
             <name>1024</name>
         </tags>
     </group>
+</root>`,
+	},
+
+	{
+		Title: "map",
+		Type: &ast.Request{
+			Name: &ast.Attribute{Value: xmlenc.String{Value: "root"}},
+			Type: &ast.TypeAttribute{Value: xmlenc.String{Value: ast.TypeObject}},
+			Items: []*ast.Param{
+				{
+					Name: &ast.Attribute{Value: xmlenc.String{Value: "titles"}},
+					Type: &ast.TypeAttribute{Value: xmlenc.String{Value: ast.TypeMap}},
+					Items: []*ast.Param{
+						{
+							Name: &ast.Attribute{Value: xmlenc.String{Value: "value"}},
+							Type: &ast.TypeAttribute{Value: xmlenc.String{Value: ast.TypeString}},
+						},
+					},
+				},
+			},
+		},
+		JSON: `{
+    "titles": {
+        "key1": "1024",
+        "key2": "1024",
+        "key3": "1024",
+        "key4": "1024",
+        "key5": "1024"
+    }
+}`,
+		XML: `<root>
+    <titles>
+        <key1>1024</key1>
+        <key2>1024</key2>
+        <key3>1024</key3>
+        <key4>1024</key4>
+        <key5>1024</key5>
+    </titles>
+</root>`,
+	},
+
+	{
+		Title: "one-of",
+		Type: &ast.Request{
+			Name:          &ast.Attribute{Value: xmlenc.String{Value: "root"}},
+			Type:          &ast.TypeAttribute{Value: xmlenc.String{Value: ast.TypeObject}},
+			Discriminator: &ast.Attribute{Value: xmlenc.String{Value: "kind"}},
+			Items: []*ast.Param{
+				{
+					Name: &ast.Attribute{Value: xmlenc.String{Value: "kind"}},
+					Type: &ast.TypeAttribute{Value: xmlenc.String{Value: ast.TypeString}},
+					XML:  ast.XML{XMLAttr: &ast.BoolAttribute{Value: ast.Bool{Value: true}}},
+				},
+			},
+			OneOf: []*ast.Param{
+				{
+					Name: &ast.Attribute{Value: xmlenc.String{Value: "dog"}},
+					Type: &ast.TypeAttribute{Value: xmlenc.String{Value: ast.TypeObject}},
+					Items: []*ast.Param{
+						{
+							Name: &ast.Attribute{Value: xmlenc.String{Value: "bark"}},
+							Type: &ast.TypeAttribute{Value: xmlenc.String{Value: ast.TypeNumber}},
+						},
+					},
+				},
+				{
+					Name: &ast.Attribute{Value: xmlenc.String{Value: "cat"}},
+					Type: &ast.TypeAttribute{Value: xmlenc.String{Value: ast.TypeObject}},
+					Items: []*ast.Param{
+						{
+							Name: &ast.Attribute{Value: xmlenc.String{Value: "meow"}},
+							Type: &ast.TypeAttribute{Value: xmlenc.String{Value: ast.TypeString}},
+						},
+					},
+				},
+			},
+		},
+		JSON: `{
+    "kind": "dog",
+    "bark": 1024
+}`,
+		XML: `<root kind="dog">
+    <bark>1024</bark>
 </root>`,
 	},
 }
@@ -790,3 +873,191 @@ func TestIsValidRFC3339DateTime(t *testing.T) {
 	a.False(isValidRFC3339DateTime("2020-01-02T17:18:79Z")) // 错误的日期
 	a.False(isValidRFC3339DateTime("2020-01-32T17:18:19Z")) // 错误的日期
 }
+
+func TestTypeDef(t *testing.T) {
+	a := assert.New(t)
+
+	rslt := messagetest.NewMessageHandler()
+	d := &ast.APIDoc{}
+	d.Parse(rslt.Handler, core.Block{Data: []byte(`<apidoc version="1.0.0">
+	<title>title</title>
+	<mimetype>application/json</mimetype>
+	<type name="group" type="object" summary="group">
+		<param name="id" type="#id" summary="id" />
+	</type>
+	<type name="id" type="number.int" summary="id" />
+	<api method="GET">
+		<path path="/users" />
+		<response status="200" name="root" type="object">
+			<param name="id" type="#id" xml-attr="true" summary="id" />
+			<param name="group" type="#group" summary="group" />
+		</response>
+	</api>
+</apidoc>`)})
+	rslt.Handler.Stop()
+	a.Empty(rslt.Errors)
+	resp := d.APIs[0].Responses[0]
+
+	data, err := buildJSON(resp, indent, testOptions)
+	a.NotError(err).Equal(string(data), `{
+    "id": 1024,
+    "group": {
+        "id": 1024
+    }
+}`)
+	a.NotError(validJSON(resp, data))
+	a.Error(validJSON(resp, []byte(`{"id":1024,"group":{"id":"1024"}}`)))
+
+	data, err = buildXML(nil, resp, indent, testOptions)
+	a.NotError(err).Equal(string(data), `<root id="1024">
+    <group>
+        <id>1024</id>
+    </group>
+</root>`)
+	a.NotError(validXML(nil, resp, data))
+	a.Error(validXML(nil, resp, []byte(`<root id="1024"><group><id>str</id></group></root>`)))
+}
+
+func TestSecurity(t *testing.T) {
+	a := assert.New(t)
+
+	rslt := messagetest.NewMessageHandler()
+	d := &ast.APIDoc{APIDoc: &ast.APIDocVersionAttribute{Value: xmlenc.String{Value: ast.Version}}}
+	d.Parse(rslt.Handler, core.Block{Data: []byte(`<apidoc version="1.0.0">
+	<title>title</title>
+	<mimetype>application/json</mimetype>
+	<security name="token" type="apikey" in="header" key="x-token" />
+	<security name="bearer" type="http" scheme="bearer" />
+	<security name="cookie" type="apikey" in="cookie" key="sid" />
+	<api method="GET">
+		<path path="/users" />
+		<security name="token" />
+		<security name="bearer" />
+		<response status="200" type="string" />
+	</api>
+	<api method="GET">
+		<path path="/session" />
+		<security name="cookie" />
+		<response status="200" type="string" />
+	</api>
+</apidoc>`)})
+	rslt.Handler.Stop()
+	a.Empty(rslt.Errors)
+
+	rslt = messagetest.NewMessageHandler()
+	mock, err := New(rslt.Handler, d, indent, "", nil, testOptions)
+	a.NotError(err).NotNil(mock)
+	srv := rest.NewServer(t, mock, nil)
+	defer srv.Close()
+
+	srv.Get("/users").Header("accept", "application/json").Do().
+		Status(http.StatusUnauthorized).
+		Header("WWW-Authenticate", "Bearer")
+	srv.Get("/users").Header("accept", "application/json").Header("x-token", "abc").Do().
+		Status(http.StatusOK)
+	srv.Get("/users").Header("accept", "application/json").Header("Authorization", "bearer abc").Do().
+		Status(http.StatusOK)
+	srv.Get("/users").Header("accept", "application/json").Header("Authorization", "Basic abc").Do().
+		Status(http.StatusUnauthorized)
+
+	srv.Get("/session").Header("accept", "application/json").Do().
+		Status(http.StatusUnauthorized).
+		Header("WWW-Authenticate", "")
+	srv.Get("/session").Header("accept", "application/json").Header("Cookie", "sid=abc").Do().
+		Status(http.StatusOK)
+
+	rslt.Handler.Stop()
+	a.NotEmpty(rslt.Errors)
+}
+
+func TestCookies(t *testing.T) {
+	a := assert.New(t)
+
+	rslt := messagetest.NewMessageHandler()
+	d := &ast.APIDoc{APIDoc: &ast.APIDocVersionAttribute{Value: xmlenc.String{Value: ast.Version}}}
+	d.Parse(rslt.Handler, core.Block{Data: []byte(`<apidoc version="1.0.0">
+	<title>title</title>
+	<mimetype>application/json</mimetype>
+	<api method="GET">
+		<path path="/session" />
+		<cookie name="sid" type="string" summary="sid" />
+		<cookie name="page" type="number" optional="true" summary="page" />
+		<response status="200" type="string">
+			<cookie name="sid" type="string" summary="sid" />
+		</response>
+	</api>
+</apidoc>`)})
+	rslt.Handler.Stop()
+	a.Empty(rslt.Errors)
+
+	rslt = messagetest.NewMessageHandler()
+	mock, err := New(rslt.Handler, d, indent, "", nil, testOptions)
+	a.NotError(err).NotNil(mock)
+	srv := rest.NewServer(t, mock, nil)
+	defer srv.Close()
+
+	srv.Get("/session").Header("accept", "application/json").Do().
+		Status(http.StatusBadRequest)
+	srv.Get("/session").Header("accept", "application/json").Header("Cookie", "sid=abc; page=x").Do().
+		Status(http.StatusBadRequest)
+	srv.Get("/session").Header("accept", "application/json").Header("Cookie", "sid=abc; page=5").Do().
+		Status(http.StatusOK).
+		Header("Set-Cookie", "sid=1024")
+
+	rslt.Handler.Stop()
+	a.Equal(2, len(rslt.Errors))
+}
+
+func TestConstraint(t *testing.T) {
+	a := assert.New(t)
+
+	rslt := messagetest.NewMessageHandler()
+	d := &ast.APIDoc{}
+	d.Parse(rslt.Handler, core.Block{Data: []byte(`<apidoc version="1.0.0">
+	<title>title</title>
+	<mimetype>application/json</mimetype>
+	<api method="GET">
+		<path path="/users">
+			<query name="size" type="number.int" min="1" max="100" summary="size" />
+			<query name="id" type="number" array="true" max-items="2" summary="id" />
+		</path>
+		<response status="200" name="root" type="object">
+			<param name="id" type="number.int" min="2000" xml-attr="true" summary="id" />
+			<param name="name" type="string" pattern="^[a-z]{2,4}$" summary="name" />
+			<param name="tags" type="string" array="true" min-items="1" max-items="3" max-length="2" summary="tags" />
+		</response>
+	</api>
+</apidoc>`)})
+	rslt.Handler.Stop()
+	a.Empty(rslt.Errors)
+	resp := d.APIs[0].Responses[0]
+
+	data, err := buildJSON(resp, indent, testOptions)
+	a.NotError(err).Equal(string(data), `{
+    "id": 3024,
+    "name": "aa",
+    "tags": [
+        "10",
+        "10",
+        "10"
+    ]
+}`)
+	a.NotError(validJSON(resp, data))
+	a.Error(validJSON(resp, []byte(`{"id":1024,"name":"aa","tags":["1"]}`)))
+	a.Error(validJSON(resp, []byte(`{"id":2024,"name":"a1","tags":["1"]}`)))
+	a.Error(validJSON(resp, []byte(`{"id":2024,"name":"aa","tags":[]}`)))
+	a.Error(validJSON(resp, []byte(`{"id":2024,"name":"aa","tags":["1","2","3","4"]}`)))
+	a.Error(validJSON(resp, []byte(`{"id":2024,"name":"aa","tags":["123"]}`)))
+
+	data, err = buildXML(nil, resp, indent, testOptions)
+	a.NotError(err)
+	a.NotError(validXML(nil, resp, data))
+	a.Error(validXML(nil, resp, []byte(`<root id="1024"><name>aa</name><tags>1</tags></root>`)))
+	a.Error(validXML(nil, resp, []byte(`<root id="2024"><name>a1</name><tags>1</tags></root>`)))
+	a.Error(validXML(nil, resp, []byte(`<root id="2024"><name>aa</name><tags>123</tags></root>`)))
+
+	queries := d.APIs[0].Path.Queries
+	a.NotError(validQueries(queries, httptest.NewRequest(http.MethodGet, "/users?size=10&id=1&id=2", nil)))
+	a.Error(validQueries(queries, httptest.NewRequest(http.MethodGet, "/users?size=101", nil)))
+	a.Error(validQueries(queries, httptest.NewRequest(http.MethodGet, "/users?size=10&id=1&id=2&id=3", nil)))
+}
@@ -3,6 +3,8 @@
 package mock
 
 import (
+	"fmt"
+	"math"
 	"strconv"
 
 	"github.com/caixw/apidoc/v7/internal/ast"
@@ -26,6 +28,11 @@ type GenOptions struct {
 	// 该数值被用于声明 slice 长度，所以必须为正整数。
 	SliceSize func() int
 
+	// 是否生成 null 值
+	//
+	// 仅对指定了 nullable 的值有效。
+	Null func() bool
+
 	// 返回一个介于 [0, max] 之间的数值
 	//
 	// 该数值被用于从数组中获取其中的某个元素。
@@ -36,6 +43,21 @@ func isEnum(p *ast.Param) bool {
 	return len(p.Enums) > 0
 }
 
+func isUnion(p *ast.Param) bool {
+	return len(p.OneOf) > 0 || len(p.AnyOf) > 0
+}
+
+// 随机返回联合类型的一个分支
+func (g *GenOptions) generateBranch(p *ast.Param) *ast.Param {
+	branches := p.Branches()
+	return branches[g.Index(len(branches))]
+}
+
+// 对于可以为 null 的值，随机决定是否生成 null
+func (g *GenOptions) generateNull(p *ast.Param) bool {
+	return p.Nullable.V() && g.Null()
+}
+
 func (g *GenOptions) generateBool() bool {
 	return g.Bool()
 }
@@ -49,16 +71,125 @@ func (g *GenOptions) generateNumber(p *ast.Param) interface{} {
 		}
 		return v
 	}
-	return g.Number(p)
+	return g.numberInRange(p, g.Number(p))
+}
+
+// 让随机生成的数值 v 落在 p 的 min 和 max 之间
+func (g *GenOptions) numberInRange(p *ast.Param, v interface{}) interface{} {
+	if p.Min == nil && p.Max == nil {
+		return v
+	}
+
+	var f float64
+	isInt := true
+	switch vv := v.(type) {
+	case int:
+		f = float64(vv)
+	case int64:
+		f = float64(vv)
+	case float32:
+		f, isInt = float64(vv), false
+	case float64:
+		f, isInt = vv, false
+	default:
+		return v
+	}
+
+	if (p.Min == nil || f >= p.Min.V()) && (p.Max == nil || f <= p.Max.V()) {
+		return v
+	}
+
+	min, max := p.Min.V(), p.Max.V()
+	if isInt {
+		min, max = math.Ceil(min), math.Floor(max)
+	}
+
+	switch {
+	case p.Min != nil && p.Max != nil:
+		if isInt && max >= min {
+			return int(min) + g.Index(int(max-min)+1)
+		}
+		f = min + (max-min)*float64(g.Index(1000))/1000
+	case p.Min != nil:
+		f = min + math.Abs(f)
+	default:
+		f = max - math.Abs(f)
+	}
+
+	if isInt {
+		return int(f)
+	}
+	return f
 }
 
+// 生成字符串
+//
+// 如果指定了 pattern，则生成与之匹配且长度符合要求的字符串。
 func (g *GenOptions) generateString(p *ast.Param) string {
 	if isEnum(p) {
 		return p.Enums[g.Index(len(p.Enums))].Value.V()
 	}
-	return g.String(p)
+
+	if p.Pattern != nil {
+		min, max := -1, -1
+		if p.MinLength != nil {
+			min = p.MinLength.IntValue()
+		}
+		if p.MaxLength != nil {
+			max = p.MaxLength.IntValue()
+		}
+		return g.generatePattern(p.Pattern.V(), min, max)
+	}
+
+	runes := []rune(g.String(p))
+	if p.MaxLength != nil && len(runes) > p.MaxLength.IntValue() {
+		runes = runes[:p.MaxLength.IntValue()]
+	}
+	if p.MinLength != nil && len(runes) < p.MinLength.IntValue() {
+		if len(runes) == 0 {
+			runes = append(runes, 'a')
+		}
+		for i := 0; len(runes) < p.MinLength.IntValue(); i++ {
+			runes = append(runes, runes[i])
+		}
+	}
+	return string(runes)
+}
+
+// 生成简单类型的值
+//
+// 仅支持布尔、数值和字符串等可以直接转换成字符串的类型，
+// 比如报头和表单中的值，其它类型返回 false。
+func (g *GenOptions) generateSimpleValue(p *ast.Param) (string, bool) {
+	switch primitive, _ := ast.ParseType(p.Type.V()); primitive {
+	case ast.TypeBool:
+		return strconv.FormatBool(g.generateBool()), true
+	case ast.TypeNumber:
+		return fmt.Sprint(g.generateNumber(p)), true
+	case ast.TypeString:
+		return g.generateString(p), true
+	default:
+		return "", false
+	}
 }
 
-func (g *GenOptions) generateSliceSize() int {
-	return g.SliceSize()
+// 生成 map 的键名
+func (g *GenOptions) generateMapKeys() []string {
+	size := g.SliceSize()
+	keys := make([]string, 0, size)
+	for i := 1; i <= size; i++ {
+		keys = append(keys, "key"+strconv.Itoa(i))
+	}
+	return keys
+}
+
+func (g *GenOptions) generateSliceSize(p *ast.Param) int {
+	size := g.SliceSize()
+	if p.MaxItems != nil && size > p.MaxItems.IntValue() {
+		size = p.MaxItems.IntValue()
+	}
+	if p.MinItems != nil && size < p.MinItems.IntValue() {
+		size = p.MinItems.IntValue()
+	}
+	return size
 }
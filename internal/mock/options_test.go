@@ -2,7 +2,15 @@
 
 package mock
 
-import "github.com/caixw/apidoc/v7/internal/ast"
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/issue9/assert"
+
+	"github.com/caixw/apidoc/v7/internal/ast"
+	"github.com/caixw/apidoc/v7/internal/xmlenc"
+)
 
 const indent = "    "
 
@@ -25,5 +33,93 @@ var testOptions = &GenOptions{
 	},
 	Bool:      func() bool { return true },
 	SliceSize: func() int { return 5 },
+	Null:      func() bool { return true },
 	Index:     func(max int) int { return 0 },
 }
+
+func TestGenOptions_numberInRange(t *testing.T) {
+	a := assert.New(t)
+
+	num := func(v float64) *ast.NumberAttribute {
+		return &ast.NumberAttribute{Value: ast.Number{Float: v, IsFloat: true}}
+	}
+
+	p := &ast.Param{}
+	a.Equal(testOptions.numberInRange(p, 1024), 1024)
+
+	p = &ast.Param{Constraint: ast.Constraint{Min: num(1), Max: num(2000)}}
+	a.Equal(testOptions.numberInRange(p, 1024), 1024)
+
+	p = &ast.Param{Constraint: ast.Constraint{Min: num(1.5), Max: num(10)}}
+	a.Equal(testOptions.numberInRange(p, 1024), 2).
+		Equal(testOptions.numberInRange(p, float32(1024)), 1.5)
+
+	p = &ast.Param{Constraint: ast.Constraint{Min: num(2000)}}
+	a.Equal(testOptions.numberInRange(p, 1024), 3024)
+
+	p = &ast.Param{Constraint: ast.Constraint{Max: num(-1)}}
+	a.Equal(testOptions.numberInRange(p, 1024), -1025)
+}
+
+func TestGenOptions_generateString(t *testing.T) {
+	a := assert.New(t)
+
+	num := func(v int) *ast.NumberAttribute { return &ast.NumberAttribute{Value: ast.Number{Int: v}} }
+	p := &ast.Param{Type: &ast.TypeAttribute{Value: xmlenc.String{Value: ast.TypeString}}}
+	a.Equal(testOptions.generateString(p), "1024")
+
+	p.MaxLength = num(2)
+	a.Equal(testOptions.generateString(p), "10")
+
+	p.MaxLength = nil
+	p.MinLength = num(10)
+	a.Equal(testOptions.generateString(p), "1024102410")
+
+	p.Pattern = &ast.Attribute{Value: xmlenc.String{Value: "^[x-z]{2}$"}}
+	a.Equal(testOptions.generateString(p), "xx")
+
+	// 生成的值需要通过其自身约束条件的验证
+	g := &GenOptions{
+		String: func(p *ast.Param) string { return "1024" },
+		Index:  func(max int) int { return rand.Intn(max) },
+	}
+	params := []*ast.Param{
+		{
+			Type:       &ast.TypeAttribute{Value: xmlenc.String{Value: ast.TypeString}},
+			Constraint: ast.Constraint{MaxLength: num(5), Pattern: &ast.Attribute{Value: xmlenc.String{Value: "^[a-z]+$"}}},
+		},
+		{
+			Type:       &ast.TypeAttribute{Value: xmlenc.String{Value: ast.TypeString}},
+			Constraint: ast.Constraint{MinLength: num(15), Pattern: &ast.Attribute{Value: xmlenc.String{Value: "^[a-z]+-\\d+$"}}},
+		},
+		{
+			Type: &ast.TypeAttribute{Value: xmlenc.String{Value: ast.TypeString}},
+			Constraint: ast.Constraint{
+				MinLength: num(3),
+				MaxLength: num(4),
+				Pattern:   &ast.Attribute{Value: xmlenc.String{Value: "^(x|yy)+$"}},
+			},
+		},
+	}
+	for _, p := range params {
+		for i := 0; i < 100; i++ {
+			v := g.generateString(p)
+			a.True(validConstraint(p, v) == nil, "%s 无法通过 %s 的验证", v, p.Pattern.V())
+		}
+	}
+}
+
+func TestGenOptions_generateSliceSize(t *testing.T) {
+	a := assert.New(t)
+
+	num := func(v int) *ast.NumberAttribute { return &ast.NumberAttribute{Value: ast.Number{Int: v}} }
+	p := &ast.Param{}
+	a.Equal(testOptions.generateSliceSize(p), 5)
+
+	p.MaxItems = num(3)
+	a.Equal(testOptions.generateSliceSize(p), 3)
+
+	p.MaxItems = nil
+	p.MinItems = num(7)
+	a.Equal(testOptions.generateSliceSize(p), 7)
+}
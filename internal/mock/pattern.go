@@ -0,0 +1,277 @@
+// SPDX-License-Identifier: MIT
+
+package mock
+
+import (
+	"regexp/syntax"
+	"strings"
+)
+
+// 对于未指定上限的重复，最多重复的次数
+const maxPatternRepeat = 10
+
+// 生成 . 之类的任意字符时可用的字符
+const patternAlpha = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"
+
+// 生成与正则表达式 pattern 相匹配的随机字符串
+//
+// min 和 max 为字符串长度的下限和上限，小于 0 表示不作限制。
+// 如果 pattern 无法生成该长度范围内的字符串，则忽略长度的限制。
+// 加载文档时已经验证过 pattern 的正确性，此处出错则直接 panic。
+func (g *GenOptions) generatePattern(pattern string, min, max int) string {
+	re, err := syntax.Parse(pattern, syntax.Perl)
+	if err != nil {
+		panic(err)
+	}
+	re = re.Simplify()
+
+	b := &strings.Builder{}
+	if min < 0 && max < 0 {
+		g.writePattern(b, re)
+		return b.String()
+	}
+
+	lo, hi := patternLength(re)
+	if min > lo {
+		lo = min
+	}
+	if max >= 0 && (hi < 0 || max < hi) {
+		hi = max
+	}
+	if hi < 0 {
+		hi = lo + maxPatternRepeat
+	}
+
+	if lo > hi { // 无法满足长度的要求
+		g.writePattern(b, re)
+	} else {
+		g.writePatternLength(b, re, lo+g.Index(hi-lo+1))
+	}
+	return b.String()
+}
+
+func (g *GenOptions) writePattern(b *strings.Builder, re *syntax.Regexp) {
+	switch re.Op {
+	case syntax.OpLiteral:
+		for _, r := range re.Rune {
+			b.WriteRune(r)
+		}
+	case syntax.OpCharClass:
+		if r, ok := g.charClassRune(re.Rune); ok {
+			b.WriteRune(r)
+		}
+	case syntax.OpAnyChar, syntax.OpAnyCharNotNL:
+		b.WriteByte(patternAlpha[g.Index(len(patternAlpha))])
+	case syntax.OpCapture:
+		g.writePattern(b, re.Sub[0])
+	case syntax.OpConcat:
+		for _, sub := range re.Sub {
+			g.writePattern(b, sub)
+		}
+	case syntax.OpAlternate:
+		g.writePattern(b, re.Sub[g.Index(len(re.Sub))])
+	case syntax.OpStar, syntax.OpPlus, syntax.OpQuest, syntax.OpRepeat:
+		min, max := repeatRange(re)
+		if max < 0 {
+			max = min + maxPatternRepeat
+		}
+
+		size := min + g.Index(max-min+1)
+		for i := 0; i < size; i++ {
+			g.writePattern(b, re.Sub[0])
+		}
+	} // 其它的诸如 ^、$ 和 \b 等都不需要生成内容
+}
+
+// 生成长度为 size 的字符串，size 必须在 patternLength(re) 的范围之内。
+func (g *GenOptions) writePatternLength(b *strings.Builder, re *syntax.Regexp, size int) {
+	switch re.Op {
+	case syntax.OpCapture:
+		g.writePatternLength(b, re.Sub[0], size)
+	case syntax.OpConcat:
+		ranges := make([][2]int, 0, len(re.Sub))
+		for _, sub := range re.Sub {
+			lo, hi := patternLength(sub)
+			ranges = append(ranges, [2]int{lo, hi})
+		}
+		for index, n := range g.splitLength(size, ranges) {
+			g.writePatternLength(b, re.Sub[index], n)
+		}
+	case syntax.OpAlternate:
+		subs := make([]*syntax.Regexp, 0, len(re.Sub))
+		for _, sub := range re.Sub {
+			if lo, hi := patternLength(sub); lo <= size && (hi < 0 || hi >= size) {
+				subs = append(subs, sub)
+			}
+		}
+		if len(subs) == 0 {
+			g.writePattern(b, re)
+			return
+		}
+		g.writePatternLength(b, subs[g.Index(len(subs))], size)
+	case syntax.OpStar, syntax.OpPlus, syntax.OpQuest, syntax.OpRepeat:
+		min, max := repeatRange(re)
+		lo, hi := patternLength(re.Sub[0])
+		if max < 0 {
+			max = min + maxPatternRepeat + size
+		}
+
+		counts := make([]int, 0, max-min+1)
+		for n := min; n <= max; n++ {
+			if n*lo <= size && (hi < 0 || n*hi >= size) {
+				counts = append(counts, n)
+			}
+		}
+		if len(counts) == 0 {
+			g.writePattern(b, re)
+			return
+		}
+
+		n := counts[g.Index(len(counts))]
+		ranges := make([][2]int, n)
+		for i := range ranges {
+			ranges[i] = [2]int{lo, hi}
+		}
+		for _, l := range g.splitLength(size, ranges) {
+			g.writePatternLength(b, re.Sub[0], l)
+		}
+	default: // 字面量和字符类的长度是固定的
+		g.writePattern(b, re)
+	}
+}
+
+// 将长度 size 随机分配给 ranges 中的各个部分
+//
+// ranges 中的每个元素表示对应部分的长度下限和上限，上限小于 0 表示不限制。
+func (g *GenOptions) splitLength(size int, ranges [][2]int) []int {
+	sizes := make([]int, len(ranges))
+	for i, r := range ranges {
+		sizes[i] = r[0]
+		size -= r[0]
+	}
+
+	indexes := make([]int, 0, len(ranges))
+	for ; size > 0; size-- {
+		indexes = indexes[:0]
+		for i, r := range ranges {
+			if r[1] < 0 || sizes[i] < r[1] {
+				indexes = append(indexes, i)
+			}
+		}
+		if len(indexes) == 0 {
+			break
+		}
+		sizes[indexes[g.Index(len(indexes))]]++
+	}
+
+	return sizes
+}
+
+// 返回 re 可以生成的字符串的长度范围，hi 小于 0 表示没有上限。
+func patternLength(re *syntax.Regexp) (lo, hi int) {
+	switch re.Op {
+	case syntax.OpLiteral:
+		return len(re.Rune), len(re.Rune)
+	case syntax.OpCharClass:
+		if len(re.Rune) == 0 {
+			return 0, 0
+		}
+		return 1, 1
+	case syntax.OpAnyChar, syntax.OpAnyCharNotNL:
+		return 1, 1
+	case syntax.OpCapture:
+		return patternLength(re.Sub[0])
+	case syntax.OpConcat:
+		for _, sub := range re.Sub {
+			l, h := patternLength(sub)
+			lo += l
+			if hi >= 0 {
+				if h < 0 {
+					hi = -1
+				} else {
+					hi += h
+				}
+			}
+		}
+		return lo, hi
+	case syntax.OpAlternate:
+		for index, sub := range re.Sub {
+			l, h := patternLength(sub)
+			if index == 0 || l < lo {
+				lo = l
+			}
+			if hi >= 0 && (h < 0 || h > hi) {
+				hi = h
+			}
+		}
+		return lo, hi
+	case syntax.OpStar, syntax.OpPlus, syntax.OpQuest, syntax.OpRepeat:
+		min, max := repeatRange(re)
+		l, h := patternLength(re.Sub[0])
+		switch {
+		case h == 0:
+			return 0, 0
+		case max < 0 || h < 0:
+			return min * l, -1
+		default:
+			return min * l, max * h
+		}
+	default: // ^、$ 和 \b 等
+		return 0, 0
+	}
+}
+
+// 返回重复的次数范围，max 小于 0 表示没有上限。
+func repeatRange(re *syntax.Regexp) (min, max int) {
+	switch re.Op {
+	case syntax.OpStar:
+		return 0, -1
+	case syntax.OpPlus:
+		return 1, -1
+	case syntax.OpQuest:
+		return 0, 1
+	default:
+		return re.Min, re.Max
+	}
+}
+
+// 从字符类 ranges 中选取一个字符
+//
+// ranges 的格式与 syntax.Regexp.Rune 相同，由成对的上下限组成。
+// 优先选择可打印的 ASCII 字符。
+func (g *GenOptions) charClassRune(ranges []rune) (rune, bool) {
+	printable := make([]rune, 0, len(ranges))
+	for i := 0; i < len(ranges); i += 2 {
+		lo, hi := ranges[i], ranges[i+1]
+		if lo < ' ' {
+			lo = ' '
+		}
+		if hi > '~' {
+			hi = '~'
+		}
+		if lo <= hi {
+			printable = append(printable, lo, hi)
+		}
+	}
+	if len(printable) > 0 {
+		ranges = printable
+	}
+
+	count := 0
+	for i := 0; i < len(ranges); i += 2 {
+		count += int(ranges[i+1]-ranges[i]) + 1
+	}
+	if count == 0 {
+		return 0, false
+	}
+
+	n := g.Index(count)
+	for i := 0; i < len(ranges); i += 2 {
+		size := int(ranges[i+1]-ranges[i]) + 1
+		if n < size {
+			return ranges[i] + rune(n), true
+		}
+		n -= size
+	}
+	return 0, false
+}
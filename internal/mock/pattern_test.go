@@ -0,0 +1,68 @@
+// SPDX-License-Identifier: MIT
+
+package mock
+
+import (
+	"math/rand"
+	"regexp"
+	"testing"
+
+	"github.com/issue9/assert"
+)
+
+func TestGenOptions_generatePattern(t *testing.T) {
+	a := assert.New(t)
+
+	a.Equal(testOptions.generatePattern(`^[a-z]{3,5}$`, -1, -1), "aaa")
+	a.Equal(testOptions.generatePattern(`^(abc|def)\d+$`, -1, -1), "abc0")
+	a.Equal(testOptions.generatePattern(`x?y*`, -1, -1), "")
+	a.Equal(testOptions.generatePattern(`[^\x00-\x1f]`, -1, -1), " ")
+
+	g := &GenOptions{Index: func(max int) int { return rand.Intn(max) }}
+	patterns := []string{
+		`^[a-z]{3,5}$`,
+		`^(abc|def)\d+$`,
+		`^\w+@example\.com$`,
+		`^[^a-z]+.?$`,
+		`^(?i)abc$`,
+		`^1[3-9]\d{9}$`,
+	}
+	for _, pattern := range patterns {
+		re := regexp.MustCompile(pattern)
+		for i := 0; i < 100; i++ {
+			v := g.generatePattern(pattern, -1, -1)
+			a.True(re.MatchString(v), "%s 无法匹配 %s", v, pattern)
+		}
+	}
+
+	// 指定长度范围
+	a.Equal(testOptions.generatePattern(`^[a-z]+$`, 3, 5), "aaa").
+		Equal(testOptions.generatePattern(`^[a-z]+$`, -1, 2), "a").
+		Equal(testOptions.generatePattern(`^(abc|de)\d*$`, 2, 2), "de").
+		Equal(testOptions.generatePattern(`^[a-z]{3}$`, 5, -1), "aaa") // 无法满足长度要求
+
+	lengths := []struct {
+		pattern  string
+		min, max int
+	}{
+		{`^[a-z]+$`, -1, 5},
+		{`^[a-z]+$`, 20, -1},
+		{`^[a-z]+$`, 8, 12},
+		{`^(abc|def)\d+$`, 4, 6},
+		{`^\w+@example\.com$`, -1, 16},
+		{`^x?y*z{2,}$`, 3, 30},
+	}
+	for _, item := range lengths {
+		re := regexp.MustCompile(item.pattern)
+		for i := 0; i < 100; i++ {
+			v := g.generatePattern(item.pattern, item.min, item.max)
+			a.True(re.MatchString(v), "%s 无法匹配 %s", v, item.pattern).
+				True(item.min < 0 || len(v) >= item.min, "%s 的长度小于 %d", v, item.min).
+				True(item.max < 0 || len(v) <= item.max, "%s 的长度大于 %d", v, item.max)
+		}
+	}
+
+	a.Panic(func() {
+		testOptions.generatePattern(`[a-z`, -1, -1)
+	})
+}
@@ -0,0 +1,63 @@
+// SPDX-License-Identifier: MIT
+
+package mock
+
+import (
+	"net/http"
+
+	"github.com/issue9/version"
+
+	"github.com/caixw/apidoc/v7/core"
+	"github.com/caixw/apidoc/v7/internal/ast"
+	"github.com/caixw/apidoc/v7/internal/locale"
+)
+
+// Validator 根据文档验证请求的内容
+//
+// 与 Mock 的验证规则相同，但是不负责路由以及生成返回内容，
+// 一般用于由文档生成的服务端代码。
+type Validator struct {
+	doc  *ast.APIDoc
+	apis map[string]*ast.API // 以请求方法和文档中的路径作为键名
+}
+
+// NewValidator 声明 Validator 对象
+func NewValidator(d *ast.APIDoc) (*Validator, error) {
+	c, err := version.SemVerCompatible(d.APIDoc.V(), ast.Version)
+	if err != nil {
+		return nil, err
+	}
+	if !c {
+		return nil, locale.NewError(locale.VersionInCompatible)
+	}
+
+	apis := make(map[string]*ast.API, len(d.APIs))
+	for _, api := range d.APIs {
+		apis[api.Method.V()+" "+api.Path.Path.V()] = api
+	}
+
+	return &Validator{doc: d, apis: apis}, nil
+}
+
+// Valid 验证 r 是否符合文档中由 method 和 path 指定的 API
+//
+// path 为文档中定义的路径，比如 /users/{id}，而不是请求的实际路径；
+// 验证失败时返回相应的状态码，身份验证失败为 401，其它为 400；
+// 验证之后依然可以从 r.Body 中读取报文内容。
+func (v *Validator) Valid(method, path string, r *http.Request) (int, error) {
+	api, found := v.apis[method+" "+path]
+	if !found {
+		return http.StatusNotFound, core.NewError(locale.ErrNotFound).WithField("path")
+	}
+
+	status, field, err := validAPI(v.doc.XMLNamespaces, api, r)
+	if err == nil {
+		return 0, nil
+	}
+
+	if serr, ok := err.(*core.Error); ok {
+		serr.Field = field + serr.Field
+		return status, serr
+	}
+	return status, core.WithError(err).WithField(field)
+}
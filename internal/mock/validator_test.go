@@ -0,0 +1,87 @@
+// SPDX-License-Identifier: MIT
+
+package mock
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/issue9/assert"
+
+	"github.com/caixw/apidoc/v7/core"
+	"github.com/caixw/apidoc/v7/core/messagetest"
+	"github.com/caixw/apidoc/v7/internal/ast"
+)
+
+func TestValidator_Valid(t *testing.T) {
+	a := assert.New(t)
+
+	rslt := messagetest.NewMessageHandler()
+	d := &ast.APIDoc{}
+	d.Parse(rslt.Handler, core.Block{Data: []byte(`<apidoc apidoc="` + ast.Version + `" version="1.0.0">
+	<title>test</title>
+	<mimetype>application/json</mimetype>
+	<security name="token" type="apikey" in="header" key="x-token" />
+	<api method="POST">
+		<path path="/users/{id}">
+			<param name="id" type="number" summary="id" />
+			<query name="page" type="number" optional="true" summary="page" />
+		</path>
+		<request type="object" mimetype="application/json">
+			<param name="name" type="string" summary="name" />
+		</request>
+		<response status="201" />
+	</api>
+	<api method="DELETE">
+		<path path="/users/{id}"><param name="id" type="number" summary="id" /></path>
+		<security name="token" />
+		<response status="204" />
+	</api>
+</apidoc>`)})
+	rslt.Handler.Stop()
+	a.Empty(rslt.Errors)
+
+	v, err := NewValidator(d)
+	a.NotError(err).NotNil(v)
+
+	r := httptest.NewRequest(http.MethodPost, "/users/1?page=2", bytes.NewBufferString(`{"name":"n"}`))
+	r.Header.Set("Content-Type", "application/json")
+	status, err := v.Valid(http.MethodPost, "/users/{id}", r)
+	a.NotError(err).Equal(status, 0)
+	body, err := ioutil.ReadAll(r.Body)
+	a.NotError(err).Equal(string(body), `{"name":"n"}`)
+
+	// 无效的查询参数
+	r = httptest.NewRequest(http.MethodPost, "/users/1?page=x", bytes.NewBufferString(`{"name":"n"}`))
+	r.Header.Set("Content-Type", "application/json")
+	status, err = v.Valid(http.MethodPost, "/users/{id}", r)
+	a.Error(err).Equal(status, http.StatusBadRequest)
+
+	// 无效的报文
+	r = httptest.NewRequest(http.MethodPost, "/users/1", bytes.NewBufferString(`{"name":1}`))
+	r.Header.Set("Content-Type", "application/json")
+	status, err = v.Valid(http.MethodPost, "/users/{id}", r)
+	a.Error(err).Equal(status, http.StatusBadRequest)
+	serr, ok := err.(*core.Error)
+	a.True(ok).Equal(serr.Field, "request.body.name")
+
+	// 缺少身份验证
+	r = httptest.NewRequest(http.MethodDelete, "/users/1", nil)
+	status, err = v.Valid(http.MethodDelete, "/users/{id}", r)
+	a.Error(err).Equal(status, http.StatusUnauthorized)
+
+	r.Header.Set("x-token", "xx")
+	status, err = v.Valid(http.MethodDelete, "/users/{id}", r)
+	a.NotError(err).Equal(status, 0)
+
+	// 不存在的 API
+	status, err = v.Valid(http.MethodGet, "/users/{id}", r)
+	a.Error(err).Equal(status, http.StatusNotFound)
+
+	d.APIDoc.Value.Value = "1.0.0"
+	v, err = NewValidator(d)
+	a.Error(err).Nil(v)
+}
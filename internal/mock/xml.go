@@ -16,27 +16,42 @@ import (
 	"github.com/caixw/apidoc/v7/core"
 	"github.com/caixw/apidoc/v7/internal/ast"
 	"github.com/caixw/apidoc/v7/internal/locale"
+	"github.com/caixw/apidoc/v7/internal/xmlenc"
 )
 
 type xmlValidator struct {
 	namespaces []*ast.XMLNamespace
 	decoder    *xml.Decoder
+	offset     int64 // 最后读取的 token 的起始位置，用于定位出错的内容。
 }
 
 func validXML(ns []*ast.XMLNamespace, p *ast.Request, content []byte) error {
+	_, _, err := validXMLRange(ns, p, content)
+	return err
+}
+
+// 验证 XML 内容，出错时同时返回出错内容在 content 中的范围。
+func validXMLRange(ns []*ast.XMLNamespace, p *ast.Request, content []byte) (start, end int64, err error) {
 	if len(content) == 0 {
 		if p == nil || p.Type.V() == ast.TypeNone {
-			return nil
+			return 0, 0, nil
 		}
-		return core.NewError(locale.ErrInvalidFormat)
+		return 0, 0, core.NewError(locale.ErrInvalidFormat)
 	}
 
 	validator := &xmlValidator{
 		namespaces: ns,
 		decoder:    xml.NewDecoder(bytes.NewReader(content)),
 	}
+	if err = validator.valid(p); err != nil {
+		return validator.offset, validator.decoder.InputOffset(), err
+	}
+	return 0, 0, nil
+}
+
+func (v *xmlValidator) valid(p *ast.Request) error {
 	for {
-		token, err := validator.decoder.Token()
+		token, err := v.token()
 		if errors.Is(err, io.EOF) && token == nil { // 正常结束
 			return nil
 		}
@@ -46,16 +61,22 @@ func validXML(ns []*ast.XMLNamespace, p *ast.Request, content []byte) error {
 
 		switch elem := token.(type) {
 		case xml.StartElement:
-			if err := validator.validXMLNamespaces(elem); err != nil {
+			if err := v.validXMLNamespaces(elem); err != nil {
 				return err
 			}
-			return validator.validXMLElement(elem, p.Param(), true, elem.Name.Local)
+			return v.validXMLElement(elem, p.Param(), true, elem.Name.Local)
 		case xml.EndElement:
 			return core.NewError(locale.ErrInvalidFormat)
 		}
 	}
 }
 
+// 读取下一个 token，并记录其起始位置。
+func (v *xmlValidator) token() (xml.Token, error) {
+	v.offset = v.decoder.InputOffset()
+	return v.decoder.Token()
+}
+
 func (v *xmlValidator) validXMLNamespaces(start xml.StartElement) error {
 ATTR:
 	for _, attr := range start.Attr {
@@ -140,6 +161,15 @@ func parseXMLWrappedName(p *ast.Param, parent bool) (name string) {
 }
 
 func (v *xmlValidator) validXMLElement(start xml.StartElement, p *ast.Param, chkArray bool, field string) error {
+	p = p.Resolve()
+	if isUnion(p) && !(chkArray && p.Array.V()) {
+		pp, err := xmlBranch(start, p, field)
+		if err != nil {
+			return err
+		}
+		p = pp
+	}
+
 	if err := v.validStartElement(start, p, chkArray, field); err != nil {
 		return err
 	}
@@ -148,7 +178,7 @@ func (v *xmlValidator) validXMLElement(start xml.StartElement, p *ast.Param, chk
 	var started bool
 LOOP:
 	for {
-		token, err := v.decoder.Token()
+		token, err := v.token()
 		if errors.Is(err, io.EOF) && token == nil { // 正常结束
 			return nil
 		}
@@ -167,6 +197,16 @@ LOOP:
 				continue LOOP
 			}
 
+			if p.Type.V() == ast.TypeMap { // map 的子元素名称即为键名
+				pp := xmlMapValue(p, elem.Name.Local)
+				if err = v.validXMLElement(elem, pp, false, buildXMLField(field, pp)); err != nil {
+					return err
+				}
+				chardata = nil
+				started = true
+				continue LOOP
+			}
+
 			for _, pp := range p.Items {
 				if v.validXMLName(elem.Name, pp, true) {
 					if pp.XMLExtract.V() {
@@ -216,6 +256,46 @@ func (v *xmlValidator) validStartElement(start xml.StartElement, p *ast.Param, c
 	return nil
 }
 
+// 返回联合类型 p 中与 start 相匹配的分支
+//
+// 只有 discriminator 以属性的形式出现时，才能在读取 start 时确定分支，
+// 其它情况下，将所有分支的字段合并为一个对象进行验证。
+func xmlBranch(start xml.StartElement, p *ast.Param, field string) (*ast.Param, error) {
+	branches := p.Branches()
+
+	if p.Discriminator != nil {
+		for _, attr := range start.Attr {
+			if attr.Name.Local != p.Discriminator.V() {
+				continue
+			}
+
+			for index, b := range p.OneOf {
+				if b.Name.V() == attr.Value {
+					return branches[index], nil
+				}
+			}
+			return nil, core.NewError(locale.ErrInvalidValue).WithField(field + "@" + attr.Name.Local)
+		}
+	}
+
+	pp := *p
+	pp.OneOf, pp.AnyOf, pp.Discriminator = nil, nil, nil
+	pp.Items = make([]*ast.Param, 0, len(p.Items)+len(branches))
+	pp.Items = append(pp.Items, p.Items...)
+	for _, b := range branches { // 分支的公共字段位于最前面
+		pp.Items = append(pp.Items, b.Items[len(p.Items):]...)
+	}
+	return &pp, nil
+}
+
+// 以 name 作为元素名称返回 map 的值类型
+func xmlMapValue(p *ast.Param, name string) *ast.Param {
+	v := *p.Items[0].Resolve()
+	v.Name = &ast.Attribute{Value: xmlenc.String{Value: name}}
+	v.XMLWrapped = nil
+	return &v
+}
+
 func buildXMLField(field string, p *ast.Param) string {
 	if p.XMLAttr.V() {
 		return field + "@" + p.Name.V()
@@ -226,6 +306,7 @@ func buildXMLField(field string, p *ast.Param) string {
 // 验证 p 描述的类型与 v 是否匹配，如果不匹配返回错误信息。
 // field 表示 p 在整个对象中的位置信息。
 func validXMLValue(p *ast.Param, field, v string) error {
+	p = p.Resolve()
 	switch p.Type.V() {
 	case ast.TypeNone:
 		if v != "" {
@@ -267,12 +348,21 @@ func validXMLValue(p *ast.Param, field, v string) error {
 		if !isValidRFC3339DateTime(v) {
 			return core.NewError(locale.ErrInvalidFormat).WithField(field)
 		}
-	case ast.TypeString, ast.TypeObject, ast.TypeImage:
+	case ast.TypeString:
+		if err := validConstraint(p, v); err != nil {
+			return err.WithField(field)
+		}
+		return nil
+	case ast.TypeObject, ast.TypeMap, ast.TypeImage, ast.TypeBinary:
 		return nil
 	default:
 		panic(fmt.Sprintf("文档中类型定义错误 %s", p.Type.V()))
 	}
 
+	if err := validConstraint(p, v); err != nil {
+		return err.WithField(field)
+	}
+
 	if isEnum(p) {
 		for _, enum := range p.Enums {
 			if enum.Value.V() == v {
@@ -319,6 +409,7 @@ func buildXML(ns []*ast.XMLNamespace, p *ast.Request, indent string, g *GenOptio
 }
 
 func parseXML(ns []*ast.XMLNamespace, p *ast.Param, chkArray, root bool, g *GenOptions) (*xmlBuilder, error) {
+	p = p.Resolve()
 	builder := &xmlBuilder{
 		start: xml.StartElement{
 			Name: buildXMLName(p, chkArray),
@@ -338,6 +429,29 @@ func parseXML(ns []*ast.XMLNamespace, p *ast.Param, chkArray, root bool, g *GenO
 		goto RET
 	}
 
+	if isUnion(p) {
+		p = g.generateBranch(p)
+	}
+
+	if p.Type.V() == ast.TypeMap {
+		for _, key := range g.generateMapKeys() {
+			v := xmlMapValue(p, key)
+			if v.Array.V() {
+				if err := parseXMLArray(ns, v, builder, g); err != nil {
+					return nil, err
+				}
+				continue
+			}
+
+			b, err := parseXML(ns, v, true, false, g)
+			if err != nil {
+				return nil, err
+			}
+			builder.items = append(builder.items, b)
+		}
+		goto RET
+	}
+
 	if p.Type.V() != ast.TypeObject {
 		builder.chardata = genXMLValue(g, p)
 		goto RET
@@ -403,7 +517,8 @@ func parseXMLArray(ns []*ast.XMLNamespace, p *ast.Param, parent *xmlBuilder, g *
 		parent.items = append(parent.items, b)
 	}
 
-	for i := 0; i < g.generateSliceSize(); i++ {
+	size := g.generateSliceSize(p)
+	for i := 0; i < size; i++ {
 		bb, err := parseXML(ns, p, false, false, g)
 		if err != nil {
 			return err
@@ -439,6 +554,7 @@ func (builder *xmlBuilder) encode(e *xml.Encoder) error {
 }
 
 func genXMLValue(g *GenOptions, p *ast.Param) interface{} {
+	p = p.Resolve()
 	switch primitive, _ := ast.ParseType(p.Type.V()); primitive {
 	case ast.TypeNone:
 		return ""
@@ -394,3 +394,29 @@ func TestGenXMLValue(t *testing.T) {
 		v = genXMLValue(testOptions, &ast.Param{Type: &ast.TypeAttribute{Value: xmlenc.String{Value: "not-exists"}}})
 	})
 }
+
+func TestValidXML_union(t *testing.T) {
+	a := assert.New(t)
+
+	r := data[len(data)-1].Type // one-of
+	a.NotError(validXML(nil, r, []byte(`<root kind="cat"><meow>meow</meow></root>`)))
+	a.Error(validXML(nil, r, []byte(`<root kind="bird"><meow>meow</meow></root>`)))
+	a.Error(validXML(nil, r, []byte(`<root kind="dog"><bark>bark</bark></root>`)))
+}
+
+func TestValidXML_map(t *testing.T) {
+	a := assert.New(t)
+
+	r := &ast.Request{
+		Name: &ast.Attribute{Value: xmlenc.String{Value: "root"}},
+		Type: &ast.TypeAttribute{Value: xmlenc.String{Value: ast.TypeMap}},
+		Items: []*ast.Param{
+			{
+				Name: &ast.Attribute{Value: xmlenc.String{Value: "value"}},
+				Type: &ast.TypeAttribute{Value: xmlenc.String{Value: ast.TypeNumber}},
+			},
+		},
+	}
+	a.NotError(validXML(nil, r, []byte(`<root><zh-CN>1</zh-CN><en>2</en></root>`)))
+	a.Error(validXML(nil, r, []byte(`<root><zh-CN>str</zh-CN></root>`)))
+}
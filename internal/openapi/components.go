@@ -0,0 +1,317 @@
+// SPDX-License-Identifier: MIT
+
+package openapi
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"encoding/json"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/issue9/sliceutil"
+)
+
+const componentsSchemasRef = "#/components/schemas/"
+
+// 用于提取结构相同的 schema 到 components.schemas
+type schemaRefs struct {
+	schemas []*schemaRef         // 按查找顺序保存的所有对象
+	counts  map[string]int       // 每个 hash 出现的次数
+	hints   map[string]string    // 每个 hash 首次出现时的名称
+	names   map[string]string    // hash 对应的 components.schemas 中的名称
+	refs    map[string]*Schema   // hash 对应的 components.schemas 中的对象
+	seen    map[*Schema]struct{} // 防止同一对象被多次处理
+
+	reserved []string // components.schemas 中已经存在的名称，比如由 ast.TypeDef 生成的对象。
+}
+
+type schemaRef struct {
+	schema *Schema
+	hash   string
+}
+
+// 将结构相同的 schema 提取到 components.schemas 中，并将原来的位置改为 $ref 引用。
+//
+// 仅处理包含子元素的对象类型。生成的名称基于首次出现时的字段名，
+// 如果多个不同的结构对应相同的名称，则会在名称之后添加 hash 值加以区分。
+func (oa *OpenAPI) extractSchemas() {
+	refs := &schemaRefs{
+		schemas: make([]*schemaRef, 0, 100),
+		counts:  make(map[string]int, 100),
+		hints:   make(map[string]string, 100),
+		seen:    make(map[*Schema]struct{}, 100),
+	}
+
+	if c := oa.Components; c != nil {
+		for _, key := range responseKeys(c.Responses) {
+			refs.response(c.Responses[key])
+		}
+		for _, key := range parameterKeys(c.Parameters) {
+			refs.schema(key, c.Parameters[key].Schema)
+		}
+	}
+
+	paths := make([]string, 0, len(oa.Paths))
+	for p := range oa.Paths {
+		paths = append(paths, p)
+	}
+	sort.Strings(paths)
+	for _, p := range paths {
+		refs.pathItem(oa.Paths[p])
+	}
+
+	if oa.Components != nil {
+		for name := range oa.Components.Schemas {
+			refs.reserved = append(refs.reserved, name)
+		}
+	}
+
+	refs.replace()
+	if len(refs.refs) == 0 {
+		return
+	}
+
+	if oa.Components == nil {
+		oa.Components = &Components{}
+	}
+	if oa.Components.Schemas == nil {
+		oa.Components.Schemas = make(map[string]*Schema, len(refs.refs))
+	}
+	for hash, s := range refs.refs {
+		oa.Components.Schemas[refs.names[hash]] = s
+	}
+}
+
+// 将联合类型的各个分支提取到 components.schemas 中，并生成 discriminator.mapping。
+//
+// discriminator 只能通过 $ref 确定其对应的分支，所以分支都需要以引用的形式存在。
+// 生成的名称基于分支的名称，结构相同的分支共用同一个对象，
+// 名称相同但结构不同的，会在名称之后添加 hash 值加以区分。
+func (oa *OpenAPI) extractBranches() {
+	unions := make([]*Schema, 0, 10)
+	oa.walkSchemas(func(s *Schema) {
+		if len(s.OneOf) > 0 || len(s.AnyOf) > 0 {
+			unions = append(unions, s)
+		}
+	})
+	if len(unions) == 0 {
+		return
+	}
+
+	if oa.Components == nil {
+		oa.Components = &Components{}
+	}
+	if oa.Components.Schemas == nil {
+		oa.Components.Schemas = make(map[string]*Schema, len(unions)*2)
+	}
+
+	names := make(map[string]string, len(unions)*2) // hash 对应的名称
+	extract := func(branches []*Schema) {
+		for index, b := range branches {
+			if b.Ref != "" {
+				continue
+			}
+
+			data, err := json.Marshal(b)
+			if err != nil { // 由 convert 生成的对象，不可能出错
+				panic(err)
+			}
+			sum := sha1.Sum(data)
+			hash := hex.EncodeToString(sum[:])
+
+			name, found := names[hash]
+			if !found {
+				name = schemaName(b.branch)
+				if _, exists := oa.Components.Schemas[name]; exists {
+					name += "_" + hash[:8]
+				}
+				names[hash] = name
+				oa.Components.Schemas[name] = b
+			}
+
+			branches[index] = &Schema{Ref: componentsSchemasRef + name, branch: b.branch}
+		}
+	}
+
+	// walkSchemas 中父元素先于子元素，倒序处理可以保证分支中嵌套的联合类型先被提取。
+	for i := len(unions) - 1; i >= 0; i-- {
+		s := unions[i]
+		extract(s.OneOf)
+		extract(s.AnyOf)
+
+		if s.Discriminator == nil {
+			continue
+		}
+		s.Discriminator.Mapping = make(map[string]string, len(s.OneOf)+len(s.AnyOf))
+		for _, branches := range [][]*Schema{s.OneOf, s.AnyOf} {
+			for _, b := range branches {
+				if b.branch != "" {
+					s.Discriminator.Mapping[b.branch] = b.Ref
+				}
+			}
+		}
+	}
+}
+
+func (refs *schemaRefs) pathItem(p *PathItem) {
+	for _, method := range importMethods {
+		o := pathOperation(p, method)
+		if o == nil {
+			continue
+		}
+
+		for _, param := range o.Parameters {
+			refs.schema(param.Name, param.Schema)
+		}
+
+		if o.RequestBody != nil {
+			for _, key := range mediaTypeKeys(o.RequestBody.Content) {
+				refs.schema("", o.RequestBody.Content[key].Schema)
+			}
+		}
+
+		for _, key := range responseKeys(o.Responses) {
+			refs.response(o.Responses[key])
+		}
+
+		names := make([]string, 0, len(o.Callbacks))
+		for name := range o.Callbacks {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			c := o.Callbacks[name]
+			exps := make([]string, 0, len(c))
+			for exp := range c {
+				exps = append(exps, exp)
+			}
+			sort.Strings(exps)
+			for _, exp := range exps {
+				refs.pathItem(c[exp])
+			}
+		}
+	}
+}
+
+func (refs *schemaRefs) response(r *Response) {
+	for _, key := range headerKeys(r.Headers) {
+		refs.schema(key, r.Headers[key].Schema)
+	}
+
+	for _, key := range mediaTypeKeys(r.Content) {
+		refs.schema("", r.Content[key].Schema)
+	}
+}
+
+// 深度优先，子元素先于父元素被记录。
+func (refs *schemaRefs) schema(name string, s *Schema) {
+	if s == nil {
+		return
+	}
+	if _, found := refs.seen[s]; found {
+		return
+	}
+	refs.seen[s] = struct{}{}
+
+	if s.XML != nil && s.XML.Name != "" {
+		name = s.XML.Name
+	}
+
+	refs.schema(name, s.Items)
+	for _, key := range schemaKeys(s.Properties) {
+		refs.schema(key, s.Properties[key])
+	}
+	if s.AdditionalProperties != nil {
+		refs.schema(name, s.AdditionalProperties.Schema)
+	}
+	for _, item := range s.OneOf {
+		refs.schema(name, item)
+	}
+	for _, item := range s.AnyOf {
+		refs.schema(name, item)
+	}
+
+	if len(s.Properties) == 0 {
+		return
+	}
+
+	data, err := json.Marshal(s)
+	if err != nil { // 由 convert 生成的对象，不可能出错
+		panic(err)
+	}
+	sum := sha1.Sum(data)
+	hash := hex.EncodeToString(sum[:])
+
+	refs.schemas = append(refs.schemas, &schemaRef{schema: s, hash: hash})
+	refs.counts[hash]++
+	if _, found := refs.hints[hash]; !found {
+		refs.hints[hash] = schemaName(name)
+	}
+}
+
+// 将出现多次的 schema 替换为 $ref
+//
+// refs.schemas 中子元素先于父元素，所以在复制父元素时，
+// 其引用的子元素已经被替换为 $ref。
+func (refs *schemaRefs) replace() {
+	refs.names = make(map[string]string, len(refs.counts))
+	refs.refs = make(map[string]*Schema, len(refs.counts))
+
+	// 统计各个名称被多少个不同的结构使用
+	hints := make(map[string]int, len(refs.hints))
+	for hash, cnt := range refs.counts {
+		if cnt > 1 {
+			hints[refs.hints[hash]]++
+		}
+	}
+
+	for hash, cnt := range refs.counts {
+		if cnt < 2 {
+			continue
+		}
+
+		name := refs.hints[hash]
+		if hints[name] > 1 || sliceutil.Count(refs.reserved, func(i int) bool { return refs.reserved[i] == name }) > 0 {
+			name += "_" + hash[:8]
+		}
+		refs.names[hash] = name
+	}
+
+	for _, item := range refs.schemas {
+		name, found := refs.names[item.hash]
+		if !found {
+			continue
+		}
+
+		if _, found := refs.refs[item.hash]; !found {
+			s := *item.schema
+			refs.refs[item.hash] = &s
+		}
+		*item.schema = Schema{Ref: componentsSchemasRef + name}
+	}
+}
+
+// 将字段名转换成 components 中可用的名称
+func schemaName(name string) string {
+	var b strings.Builder
+	upper := true
+	for _, r := range name {
+		switch {
+		case r < unicode.MaxASCII && (unicode.IsLetter(r) || unicode.IsDigit(r)):
+			if upper {
+				r = unicode.ToUpper(r)
+				upper = false
+			}
+			b.WriteRune(r)
+		default:
+			upper = true
+		}
+	}
+
+	if b.Len() == 0 {
+		return "Schema"
+	}
+	return b.String()
+}
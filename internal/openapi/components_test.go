@@ -0,0 +1,101 @@
+// SPDX-License-Identifier: MIT
+
+package openapi
+
+import (
+	"testing"
+
+	"github.com/issue9/assert"
+
+	"github.com/caixw/apidoc/v7/internal/ast/asttest"
+)
+
+func TestOpenAPI_extractSchemas(t *testing.T) {
+	a := assert.New(t)
+
+	user := func(title string) *Schema {
+		return &Schema{
+			Title: title,
+			Properties: map[string]*Schema{
+				"id":   {Type: TypeLong},
+				"name": {Type: TypeString},
+				"address": {Properties: map[string]*Schema{
+					"city": {Type: TypeString},
+				}},
+			},
+		}
+	}
+	content := func(s *Schema) map[string]*MediaType {
+		return map[string]*MediaType{"application/json": {Schema: s}}
+	}
+
+	oa := &OpenAPI{
+		Paths: map[string]*PathItem{
+			"/users": {
+				Get: &Operation{Responses: map[string]*Response{
+					"200": {Content: content(&Schema{Type: TypeArray, Items: user("user")})},
+				}},
+				Post: &Operation{
+					RequestBody: &RequestBody{Content: content(user("user"))},
+					Responses: map[string]*Response{
+						"201": {Content: content(user("user"))},
+					},
+				},
+			},
+			"/admins": {
+				Get: &Operation{Responses: map[string]*Response{
+					"200": {Content: content(user("admin"))}, // title 不同
+				}},
+			},
+		},
+	}
+
+	oa.extractSchemas()
+	a.NotNil(oa.Components).Equal(2, len(oa.Components.Schemas))
+
+	u := oa.Components.Schemas["Schema"]
+	a.NotNil(u).
+		Equal(u.Title, "user").
+		Equal(u.Properties["address"].Ref, "#/components/schemas/Address")
+	a.NotNil(oa.Components.Schemas["Address"])
+
+	a.Equal(oa.Paths["/users"].Get.Responses["200"].Content["application/json"].Schema.Items.Ref, "#/components/schemas/Schema")
+	a.Equal(oa.Paths["/users"].Post.RequestBody.Content["application/json"].Schema.Ref, "#/components/schemas/Schema")
+
+	admin := oa.Paths["/admins"].Get.Responses["200"].Content["application/json"].Schema
+	a.Empty(admin.Ref).
+		Equal(admin.Title, "admin").
+		Equal(admin.Properties["address"].Ref, "#/components/schemas/Address")
+
+	// 与 components.schemas 中已有的名称相同
+	oa = &OpenAPI{
+		Components: &Components{Schemas: map[string]*Schema{"Address": {Type: TypeString}}},
+		Paths: map[string]*PathItem{
+			"/users": {
+				Get:  &Operation{RequestBody: &RequestBody{Content: content(user("user"))}},
+				Post: &Operation{RequestBody: &RequestBody{Content: content(user("user"))}},
+			},
+		},
+	}
+	oa.extractSchemas()
+	a.Equal(3, len(oa.Components.Schemas)).
+		Equal(oa.Components.Schemas["Address"].Type, TypeString)
+	addr := oa.Components.Schemas["Schema"].Properties["address"].Ref
+	a.NotEqual(addr, "#/components/schemas/Address").
+		NotNil(oa.Components.Schemas[addr[len(componentsSchemasRef):]])
+
+	// 没有重复的内容
+	oa, err := convert(asttest.Get(), true)
+	a.NotError(err).NotNil(oa)
+	a.Nil(oa.Components)
+}
+
+func TestSchemaName(t *testing.T) {
+	a := assert.New(t)
+
+	a.Equal(schemaName(""), "Schema")
+	a.Equal(schemaName("user"), "User")
+	a.Equal(schemaName("user-info"), "UserInfo")
+	a.Equal(schemaName("用户"), "Schema")
+	a.Equal(schemaName("list_2"), "List2")
+}
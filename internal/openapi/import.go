@@ -0,0 +1,1424 @@
+// SPDX-License-Identifier: MIT
+
+package openapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/http"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/issue9/version"
+	"gopkg.in/yaml.v2"
+
+	"github.com/caixw/apidoc/v7/core"
+	"github.com/caixw/apidoc/v7/internal/ast"
+	"github.com/caixw/apidoc/v7/internal/locale"
+	"github.com/caixw/apidoc/v7/internal/xmlenc"
+)
+
+// 导入时，如果 info.version 无法转换成 semver 格式所采用的默认值
+const defaultImportVersion = "1.0.0"
+
+// 按此顺序导出 PathItem 中的各个请求方法
+var importMethods = []string{
+	http.MethodGet,
+	http.MethodPut,
+	http.MethodPost,
+	http.MethodDelete,
+	http.MethodOptions,
+	http.MethodHead,
+	http.MethodPatch,
+	http.MethodTrace,
+}
+
+var pointerReplacer = strings.NewReplacer("~", "~0", "/", "~1")
+
+var refReplacer = strings.NewReplacer("~1", "/", "~0", "~")
+
+type importer struct {
+	h   *core.MessageHandler
+	uri core.URI
+
+	openapi   *OpenAPI
+	doc       *ast.APIDoc
+	mimetypes []string
+
+	// 正在展开的 schema 引用，用于检测循环引用
+	schemas map[string]bool
+
+	// 由 components.schemas 转换而来的 ast.TypeDef，值为 nil 表示该 schema 无法转换。
+	types map[string]*ast.TypeDef
+
+	// 已经输出过警告信息的 JSON Pointer，
+	// components 中的对象可能被多次引用，同一位置仅警告一次。
+	warned map[string]bool
+}
+
+// Import 从 uri 导入 openapi 文档并转换成 ast.APIDoc
+//
+// 根据扩展名判断内容为 JSON 还是 YAML，除 .json 之外都被当作 YAML 处理。
+// 所有无法在 ast.APIDoc 中表示的内容都以警告的形式输出到 h，
+// 警告信息的 Field 为该内容在原文档中的 JSON Pointer。
+func Import(h *core.MessageHandler, uri core.URI) (*ast.APIDoc, error) {
+	data, err := uri.ReadAll(nil)
+	if err != nil {
+		return nil, err
+	}
+
+	openapi := &OpenAPI{}
+	if strings.ToLower(path.Ext(string(uri))) == ".json" {
+		err = json.Unmarshal(data, openapi)
+	} else {
+		err = yaml.Unmarshal(data, openapi)
+	}
+	if err != nil {
+		return nil, core.Location{URI: uri}.WithError(err)
+	}
+
+	if !strings.HasPrefix(openapi.OpenAPI, "3.") {
+		return nil, core.Location{URI: uri}.NewError(locale.ErrInvalidValue).WithField("#/openapi")
+	}
+	if openapi.Info == nil {
+		return nil, core.Location{URI: uri}.NewError(locale.ErrIsEmpty, "info").WithField("#/info")
+	}
+
+	i := &importer{
+		h:         h,
+		uri:       uri,
+		openapi:   openapi,
+		mimetypes: make([]string, 0, 5),
+		schemas:   make(map[string]bool, 10),
+		types:     make(map[string]*ast.TypeDef, 10),
+		warned:    make(map[string]bool, 10),
+	}
+	return i.convert(), nil
+}
+
+func (i *importer) warning(ptr string) {
+	if i.warned[ptr] {
+		return
+	}
+	i.warned[ptr] = true
+
+	i.h.Warning(core.Location{URI: i.uri}.NewError(locale.ErrIgnored).WithField(ptr))
+}
+
+func pointer(ptr string, keys ...string) string {
+	for _, key := range keys {
+		ptr += "/" + pointerReplacer.Replace(key)
+	}
+	return ptr
+}
+
+func (i *importer) convert() *ast.APIDoc {
+	info := i.openapi.Info
+
+	i.doc = &ast.APIDoc{
+		APIDoc:  &ast.APIDocVersionAttribute{Value: xmlenc.String{Value: ast.Version}},
+		Version: &ast.VersionAttribute{Value: xmlenc.String{Value: i.version(info.Version)}},
+		Title:   &ast.Element{Content: ast.Content{Value: info.Title}},
+	}
+
+	if info.Description != "" {
+		i.doc.Description = newRichtext(info.Description)
+	}
+	if info.TermsOfService != "" {
+		i.warning("#/info/termsOfService")
+	}
+
+	if c := info.Contact; c != nil {
+		i.doc.Contact = &ast.Contact{Name: newAttribute(c.Name)}
+		if c.URL != "" {
+			i.doc.Contact.URL = &ast.Element{Content: ast.Content{Value: c.URL}}
+		}
+		if c.Email != "" {
+			i.doc.Contact.Email = &ast.Element{Content: ast.Content{Value: c.Email}}
+		}
+	}
+
+	if l := info.License; l != nil {
+		i.doc.License = &ast.Link{Text: newAttribute(l.Name), URL: newAttribute(l.URL)}
+	}
+
+	for index, tag := range i.openapi.Tags {
+		title := tag.Description
+		if title == "" {
+			title = tag.Name
+		}
+		i.doc.Tags = append(i.doc.Tags, &ast.Tag{
+			Name:  newAttribute(tag.Name),
+			Title: newAttribute(title),
+		})
+
+		if tag.ExternalDocs != nil {
+			i.warning(pointer("#/tags", strconv.Itoa(index), "externalDocs"))
+		}
+	}
+
+	for index, srv := range i.openapi.Servers {
+		i.server(pointer("#/servers", strconv.Itoa(index)), srv)
+	}
+
+	if i.openapi.ExternalDocs != nil {
+		i.warning("#/externalDocs")
+	}
+
+	i.securities()
+	i.paths()
+
+	sort.SliceStable(i.doc.Types, func(m, n int) bool {
+		return i.doc.Types[m].Name.V() < i.doc.Types[n].Name.V()
+	})
+
+	if len(i.mimetypes) == 0 {
+		i.mimetypes = append(i.mimetypes, "application/json")
+	}
+	sort.Strings(i.mimetypes)
+	for _, mt := range i.mimetypes {
+		i.doc.Mimetypes = append(i.doc.Mimetypes, &ast.Element{Content: ast.Content{Value: mt}})
+	}
+
+	return i.doc
+}
+
+// 将 v 转换成 semver 格式，无法转换的会被替换成 defaultImportVersion。
+func (i *importer) version(v string) string {
+	if version.SemVerValid(v) {
+		return v
+	}
+
+	if vv := strings.TrimPrefix(v, "v"); vv != "" {
+		if cnt := strings.Count(vv, "."); cnt < 2 {
+			vv += strings.Repeat(".0", 2-cnt)
+		}
+		if version.SemVerValid(vv) {
+			return vv
+		}
+	}
+
+	i.warning("#/info/version")
+	return defaultImportVersion
+}
+
+// 添加服务器并返回其名称
+//
+// 如果已经存在相同 URL 的服务器，则直接返回该服务器的名称。
+func (i *importer) server(ptr string, srv *Server) string {
+	for _, s := range i.doc.Servers {
+		if s.URL.V() == srv.URL {
+			return s.Name.V()
+		}
+	}
+
+	name := "server" + strconv.Itoa(len(i.doc.Servers)+1)
+	s := &ast.Server{
+		Name: newAttribute(name),
+		URL:  newAttribute(srv.URL),
+	}
+	if srv.Description != "" {
+		s.Summary, s.Description = newText(srv.Description)
+	}
+	i.doc.Servers = append(i.doc.Servers, s)
+
+	if len(srv.Variables) > 0 {
+		i.warning(pointer(ptr, "variables"))
+	}
+
+	return name
+}
+
+// 将 components.securitySchemes 转换成 doc.Securities
+func (i *importer) securities() {
+	c := i.openapi.Components
+	if c == nil {
+		return
+	}
+
+	for _, name := range securitySchemeKeys(c.SecuritySchemes) {
+		if s := i.security(pointer("#/components/securitySchemes", name), name, c.SecuritySchemes[name]); s != nil {
+			i.doc.Securities = append(i.doc.Securities, s)
+		}
+	}
+}
+
+func (i *importer) security(ptr, name string, scheme *SecurityScheme) *ast.Security {
+	if scheme.Ref != "" {
+		i.warning(pointer(ptr, "$ref"))
+		return nil
+	}
+
+	s := &ast.Security{Name: newAttribute(name)}
+	if scheme.Description != "" {
+		s.Summary, s.Description = newText(scheme.Description)
+	}
+
+	switch scheme.Type {
+	case SecurityTypeAPIKey:
+		s.Type = newAttribute(ast.SecurityTypeAPIKey)
+		s.In = newAttribute(scheme.IN)
+		s.Key = newAttribute(scheme.Name)
+	case SecurityTypeHTTP:
+		s.Type = newAttribute(ast.SecurityTypeHTTP)
+		s.Scheme = newAttribute(scheme.Scheme)
+		if scheme.BearerFormat != "" {
+			s.BearerFormat = newAttribute(scheme.BearerFormat)
+		}
+	case SecurityTypeOAuth2:
+		s.Type = newAttribute(ast.SecurityTypeOAuth2)
+		if scheme.Flows != nil {
+			s.Flows = i.oauthFlows(scheme.Flows)
+		}
+		if len(s.Flows) == 0 {
+			i.warning(pointer(ptr, "flows"))
+			return nil
+		}
+	case SecurityTypeOpenIDConnect:
+		s.Type = newAttribute(ast.SecurityTypeOpenIDConnect)
+		s.OpenIDConnectURL = newAttribute(scheme.OpenIDConnectURL)
+	default:
+		i.warning(pointer(ptr, "type"))
+		return nil
+	}
+
+	return s
+}
+
+func (i *importer) oauthFlows(flows *OAuthFlows) []*ast.OAuthFlow {
+	list := []struct {
+		typ  string
+		flow *OAuthFlow
+	}{
+		{ast.OAuthFlowImplicit, flows.Implicit},
+		{ast.OAuthFlowPassword, flows.Password},
+		{ast.OAuthFlowClientCredentials, flows.ClientCredentials},
+		{ast.OAuthFlowAuthorizationCode, flows.AuthorizationCode},
+	}
+
+	ret := make([]*ast.OAuthFlow, 0, len(list))
+	for _, item := range list {
+		if item.flow == nil {
+			continue
+		}
+
+		f := &ast.OAuthFlow{Type: newAttribute(item.typ)}
+		if item.flow.AuthorizationURL != "" {
+			f.AuthorizationURL = newAttribute(item.flow.AuthorizationURL)
+		}
+		if item.flow.TokenURL != "" {
+			f.TokenURL = newAttribute(item.flow.TokenURL)
+		}
+		if item.flow.RefreshURL != "" {
+			f.RefreshURL = newAttribute(item.flow.RefreshURL)
+		}
+		for _, name := range stringKeys(item.flow.Scopes) {
+			summary := item.flow.Scopes[name]
+			if summary == "" {
+				summary = name
+			}
+			f.Scopes = append(f.Scopes, &ast.Scope{Name: newAttribute(name), Summary: newAttribute(summary)})
+		}
+		ret = append(ret, f)
+	}
+	return ret
+}
+
+// 将 SecurityRequirement 转换成 api.Securities
+//
+// ast.SecurityValue 之间是或的关系，同时要求多种验证方式的 SecurityRequirement 无法表示；
+// 空的 SecurityRequirement 表示验证是可选的，同样无法表示。
+func (i *importer) securityValues(ptr string, reqs []*SecurityRequirement) []*ast.SecurityValue {
+	values := make([]*ast.SecurityValue, 0, len(reqs))
+	for index, req := range reqs {
+		rptr := pointer(ptr, strconv.Itoa(index))
+		if req == nil || len(*req) != 1 {
+			i.warning(rptr)
+			continue
+		}
+
+		for name, scopes := range *req {
+			if !i.hasSecurity(name) {
+				i.warning(pointer(rptr, name))
+				continue
+			}
+
+			v := &ast.SecurityValue{Name: newAttribute(name)}
+			for _, scope := range scopes {
+				v.Scopes = append(v.Scopes, &ast.Element{Content: ast.Content{Value: scope}})
+			}
+			values = append(values, v)
+		}
+	}
+
+	if len(values) == 0 {
+		return nil
+	}
+	return values
+}
+
+func (i *importer) hasSecurity(name string) bool {
+	for _, s := range i.doc.Securities {
+		if s.Name.V() == name {
+			return true
+		}
+	}
+	return false
+}
+
+func (i *importer) addMimetype(mt string) {
+	for _, m := range i.mimetypes {
+		if m == mt {
+			return
+		}
+	}
+	i.mimetypes = append(i.mimetypes, mt)
+}
+
+func (i *importer) paths() {
+	paths := make([]string, 0, len(i.openapi.Paths))
+	for p := range i.openapi.Paths {
+		paths = append(paths, p)
+	}
+	sort.Strings(paths)
+
+	for _, p := range paths {
+		item := i.openapi.Paths[p]
+		ptr := pointer("#/paths", p)
+
+		if item.Ref != "" {
+			i.warning(pointer(ptr, "$ref"))
+			continue
+		}
+
+		for _, method := range importMethods {
+			operation := pathOperation(item, method)
+			if operation == nil {
+				continue
+			}
+
+			optr := pointer(ptr, strings.ToLower(method))
+			if method == http.MethodTrace {
+				i.warning(optr)
+				continue
+			}
+
+			i.doc.APIs = append(i.doc.APIs, i.api(optr, p, method, item, operation))
+		}
+	}
+}
+
+func pathOperation(item *PathItem, method string) *Operation {
+	switch method {
+	case http.MethodGet:
+		return item.Get
+	case http.MethodPut:
+		return item.Put
+	case http.MethodPost:
+		return item.Post
+	case http.MethodDelete:
+		return item.Delete
+	case http.MethodOptions:
+		return item.Options
+	case http.MethodHead:
+		return item.Head
+	case http.MethodPatch:
+		return item.Patch
+	case http.MethodTrace:
+		return item.Trace
+	default:
+		return nil
+	}
+}
+
+func (i *importer) api(ptr, p, method string, item *PathItem, o *Operation) *ast.API {
+	api := &ast.API{
+		Method: &ast.MethodAttribute{Value: xmlenc.String{Value: method}},
+		Path:   &ast.Path{Path: newAttribute(p)},
+	}
+
+	if o.OperationID != "" {
+		api.ID = newAttribute(o.OperationID)
+	}
+
+	summary, description := o.Summary, o.Description
+	if summary == "" {
+		summary = item.Summary
+	}
+	if description == "" {
+		description = item.Description
+	}
+	if summary != "" {
+		api.Summary = newAttribute(summary)
+	}
+	if description != "" {
+		api.Description = newRichtext(description)
+	}
+
+	if o.Deprecated {
+		api.Deprecated = &ast.VersionAttribute{Value: xmlenc.String{Value: i.doc.Version.V()}}
+	}
+
+	for _, tag := range o.Tags {
+		api.Tags = append(api.Tags, &ast.TagValue{Content: ast.Content{Value: tag}})
+		if i.findTag(tag) == nil {
+			i.doc.Tags = append(i.doc.Tags, &ast.Tag{Name: newAttribute(tag), Title: newAttribute(tag)})
+		}
+	}
+
+	servers, sptr := o.Servers, pointer(ptr, "servers")
+	if len(servers) == 0 {
+		servers, sptr = item.Servers, pointer("#/paths", p, "servers")
+	}
+	for index, srv := range servers {
+		name := i.server(pointer(sptr, strconv.Itoa(index)), srv)
+		api.Servers = append(api.Servers, &ast.ServerValue{Content: ast.Content{Value: name}})
+	}
+
+	i.parameters(ptr, p, api, item, o)
+
+	if o.RequestBody != nil {
+		api.Requests = i.requestBody(pointer(ptr, "requestBody"), o.RequestBody)
+	}
+
+	i.responses(pointer(ptr, "responses"), api, o.Responses)
+
+	if len(o.Callbacks) > 0 {
+		i.warning(pointer(ptr, "callbacks"))
+	}
+	// 未指定 security 的继承 #/security 中的值，空数组表示不需要验证。
+	if o.Security != nil {
+		api.Securities = i.securityValues(pointer(ptr, "security"), o.Security)
+	} else {
+		api.Securities = i.securityValues("#/security", i.openapi.Security)
+	}
+	if o.ExternalDocs != nil {
+		i.warning(pointer(ptr, "externalDocs"))
+	}
+
+	return api
+}
+
+func (i *importer) findTag(name string) *ast.Tag {
+	for _, tag := range i.doc.Tags {
+		if tag.Name.V() == name {
+			return tag
+		}
+	}
+	return nil
+}
+
+// 合并 PathItem 和 Operation 中的参数，Operation 中的同名参数会覆盖 PathItem 中的。
+func (i *importer) parameters(ptr, p string, api *ast.API, item *PathItem, o *Operation) {
+	type param struct {
+		ptr string
+		p   *Parameter
+	}
+	params := make([]*param, 0, len(item.Parameters)+len(o.Parameters))
+
+	add := func(ptr string, p *Parameter) {
+		for _, item := range params {
+			if item.p.Name == p.Name && item.p.IN == p.IN {
+				item.ptr, item.p = ptr, p
+				return
+			}
+		}
+		params = append(params, &param{ptr: ptr, p: p})
+	}
+
+	for index, param := range item.Parameters {
+		pptr := pointer("#/paths", p, "parameters", strconv.Itoa(index))
+		if param = i.resolveParameter(pptr, param); param != nil {
+			add(pptr, param)
+		}
+	}
+	for index, param := range o.Parameters {
+		pptr := pointer(ptr, "parameters", strconv.Itoa(index))
+		if param = i.resolveParameter(pptr, param); param != nil {
+			add(pptr, param)
+		}
+	}
+
+	for _, item := range params {
+		param := i.parameter(item.ptr, item.p)
+		if param == nil {
+			continue
+		}
+
+		switch item.p.IN {
+		case ParameterINPath:
+			api.Path.Params = append(api.Path.Params, param)
+		case ParameterINQuery:
+			api.Path.Queries = append(api.Path.Queries, param)
+		case ParameterINHeader:
+			api.Headers = append(api.Headers, param)
+		case ParameterINCookie:
+			api.Cookies = append(api.Cookies, param)
+		default:
+			i.warning(pointer(item.ptr, "in"))
+		}
+	}
+}
+
+func (i *importer) resolveParameter(ptr string, p *Parameter) *Parameter {
+	if p.Ref == "" {
+		return p
+	}
+
+	name, found := i.ref(pointer(ptr, "$ref"), p.Ref, "parameters")
+	if !found {
+		return nil
+	}
+
+	if i.openapi.Components != nil {
+		if param, found := i.openapi.Components.Parameters[name]; found {
+			return param
+		}
+	}
+
+	i.warning(pointer(ptr, "$ref"))
+	return nil
+}
+
+func (i *importer) parameter(ptr string, p *Parameter) *ast.Param {
+	if p.Schema == nil {
+		i.warning(pointer(ptr, "content"))
+		return nil
+	}
+
+	param := i.param(pointer(ptr, "schema"), p.Name, p.Schema, p.Required || p.IN == ParameterINPath)
+	if param == nil {
+		return nil
+	}
+
+	if p.Description != "" {
+		param.Summary, param.Description = newText(p.Description)
+	}
+	if p.Deprecated && param.Deprecated == nil {
+		param.Deprecated = &ast.VersionAttribute{Value: xmlenc.String{Value: i.doc.Version.V()}}
+	}
+	if p.IN == ParameterINQuery && param.Array.V() && p.Style.Style == StyleForm && !p.Style.Explode {
+		param.ArrayStyle = &ast.BoolAttribute{Value: ast.Bool{Value: true}}
+	}
+
+	if i.paramType(param) == ast.TypeObject {
+		i.warning(pointer(ptr, "schema"))
+		return nil
+	}
+
+	return param
+}
+
+// 返回 p 的实际类型，引用的 ast.TypeDef 会被展开。
+func (i *importer) paramType(p *ast.Param) string {
+	typ := p.Type.V()
+	for strings.HasPrefix(typ, "#") {
+		t := i.types[typ[1:]]
+		if t == nil {
+			return ""
+		}
+		typ = t.Type.V()
+	}
+	return typ
+}
+
+func (i *importer) requestBody(ptr string, body *RequestBody) []*ast.Request {
+	if body.Ref != "" {
+		name, found := i.ref(pointer(ptr, "$ref"), body.Ref, "requestBodies")
+		if !found {
+			return nil
+		}
+
+		var b *RequestBody
+		if i.openapi.Components != nil {
+			b = i.openapi.Components.RequestBodies[name]
+		}
+		if b == nil {
+			i.warning(pointer(ptr, "$ref"))
+			return nil
+		}
+		body = b
+	}
+
+	mimetypes := mediaTypeKeys(body.Content)
+	requests := make([]*ast.Request, 0, len(mimetypes))
+	for _, mt := range mimetypes {
+		req := i.request(pointer(ptr, "content", mt), mt, body.Content[mt])
+		if body.Description != "" {
+			req.Summary, req.Description = newText(body.Description)
+		}
+		requests = append(requests, req)
+	}
+
+	return requests
+}
+
+func (i *importer) responses(ptr string, api *ast.API, responses map[string]*Response) {
+	for _, status := range responseKeys(responses) {
+		rptr := pointer(ptr, status)
+
+		code, err := strconv.Atoi(status)
+		if err != nil || code < http.StatusContinue || code > http.StatusNetworkAuthenticationRequired {
+			i.warning(rptr)
+			continue
+		}
+
+		resp := responses[status]
+		if resp.Ref != "" {
+			name, found := i.ref(pointer(rptr, "$ref"), resp.Ref, "responses")
+			if !found {
+				continue
+			}
+
+			var r *Response
+			if i.openapi.Components != nil {
+				r = i.openapi.Components.Responses[name]
+			}
+			if r == nil {
+				i.warning(pointer(rptr, "$ref"))
+				continue
+			}
+			resp = r
+		}
+
+		if len(resp.Links) > 0 {
+			i.warning(pointer(rptr, "links"))
+		}
+
+		headers := i.headers(pointer(rptr, "headers"), resp.Headers)
+		summary, description := newText(resp.Description)
+
+		if len(resp.Content) == 0 {
+			api.Responses = append(api.Responses, &ast.Request{
+				Status:      &ast.StatusAttribute{Value: ast.Number{Int: code}},
+				Summary:     summary,
+				Description: description,
+				Headers:     headers,
+			})
+			continue
+		}
+
+		for _, mt := range mediaTypeKeys(resp.Content) {
+			req := i.request(pointer(rptr, "content", mt), mt, resp.Content[mt])
+			req.Status = &ast.StatusAttribute{Value: ast.Number{Int: code}}
+			req.Headers = headers
+			if req.Summary == nil && req.Description == nil {
+				req.Summary, req.Description = summary, description
+			}
+			api.Responses = append(api.Responses, req)
+		}
+	}
+}
+
+func (i *importer) headers(ptr string, headers map[string]*Header) []*ast.Param {
+	params := make([]*ast.Param, 0, len(headers))
+
+	for _, name := range headerKeys(headers) {
+		hptr := pointer(ptr, name)
+		p := i.resolveParameter(hptr, (*Parameter)(headers[name]))
+		if p == nil {
+			continue
+		}
+
+		header := *p // 可能是 components 中的对象，不能直接修改
+		header.Name = name
+		header.IN = ParameterINHeader
+		if param := i.parameter(hptr, &header); param != nil {
+			params = append(params, param)
+		}
+	}
+
+	return params
+}
+
+func (i *importer) request(ptr, mimetype string, mt *MediaType) *ast.Request {
+	i.addMimetype(mimetype)
+
+	req := &ast.Request{Mimetype: newAttribute(mimetype)}
+
+	if mt.Schema != nil {
+		if p := i.param(pointer(ptr, "schema"), "", mt.Schema, true); p != nil {
+			req.XML = p.XML
+			req.Name = p.Name
+			req.Type = p.Type
+			req.Deprecated = p.Deprecated
+			req.Nullable = p.Nullable
+			req.Enums = p.Enums
+			req.Array = p.Array
+			req.Items = p.Items
+			if p.Summary.V() != "" {
+				req.Summary = p.Summary
+			}
+			req.Description = p.Description
+
+			// 顶层对象可以不包含子元素
+			if req.Type.V() == ast.TypeObject && len(req.Items) == 0 {
+				req.Type = nil
+			}
+		}
+	}
+
+	if mt.Example != "" {
+		req.Examples = append(req.Examples, &ast.Example{
+			Mimetype: newAttribute(mimetype),
+			Content:  &ast.ExampleValue{Value: xmlenc.String{Value: string(mt.Example)}},
+		})
+	}
+
+	for _, name := range exampleKeys(mt.Examples) {
+		exp := mt.Examples[name]
+		if exp.Ref != "" || exp.ExternalValue != "" {
+			i.warning(pointer(ptr, "examples", name))
+			continue
+		}
+
+		e := &ast.Example{
+			Mimetype: newAttribute(mimetype),
+			Content:  &ast.ExampleValue{Value: xmlenc.String{Value: string(exp.Value)}},
+		}
+		if exp.Summary != "" {
+			e.Summary = newAttribute(exp.Summary)
+		}
+		req.Examples = append(req.Examples, e)
+	}
+
+	// 表单的 encoding 可以由 schema 推导，不需要额外处理。
+	if len(mt.Encoding) > 0 && mimetype != formMimetype && mimetype != multipartMimetype {
+		i.warning(pointer(ptr, "encoding"))
+	}
+
+	return req
+}
+
+// 将 s 转换成 ast.Param
+//
+// 返回 nil 表示该值无法转换。
+func (i *importer) param(ptr, name string, s *Schema, required bool) *ast.Param {
+	if s.Ref != "" {
+		return i.refParam(ptr, name, s.Ref, false, required)
+	}
+
+	// 导出时，nullable 的引用会被包装成 {allOf: [{$ref}], nullable: true} 的形式
+	if len(s.AllOf) == 1 && s.AllOf[0].Ref != "" && s.Type == "" && len(s.Properties) == 0 && s.AdditionalProperties == nil {
+		return i.refParam(pointer(ptr, "allOf", "0"), name, s.AllOf[0].Ref, s.Nullable, required)
+	}
+
+	i.unsupportedKeywords(ptr, s)
+
+	p := &ast.Param{Name: newAttribute(name)}
+	if !required {
+		p.Optional = &ast.BoolAttribute{Value: ast.Bool{Value: true}}
+	}
+	if s.Deprecated {
+		p.Deprecated = &ast.VersionAttribute{Value: xmlenc.String{Value: i.doc.Version.V()}}
+	}
+	if s.Nullable {
+		p.Nullable = &ast.BoolAttribute{Value: ast.Bool{Value: true}}
+	}
+	if s.Default != nil {
+		p.Default = newAttribute(stringValue(s.Default))
+	}
+
+	if s.Title != "" {
+		p.Summary = newAttribute(s.Title)
+		if s.Description != "" {
+			p.Description = newRichtext(s.Description)
+		}
+	} else if s.Description != "" {
+		p.Summary, p.Description = newText(s.Description)
+	}
+	if p.Summary.V() == "" && p.Description.V() == "" && name != "" {
+		p.Summary = newAttribute(name)
+	}
+
+	if s.XML != nil {
+		i.xml(pointer(ptr, "xml"), p, s.XML)
+	}
+
+	typ := s.Type
+	if typ == "" && (len(s.Properties) > 0 || len(s.AllOf) > 0 || isMapSchema(s)) {
+		typ = "object"
+	}
+
+	switch typ {
+	case TypeArray:
+		if s.Items == nil {
+			i.warning(pointer(ptr, "items"))
+			return nil
+		}
+
+		if s.Items.Type == TypeArray {
+			i.warning(pointer(ptr, "items"))
+			return nil
+		}
+
+		item := i.param(pointer(ptr, "items"), name, s.Items, required)
+		if item == nil {
+			return nil
+		}
+		if item.Array.V() { // 引用了数组类型的 schema
+			i.warning(pointer(ptr, "items"))
+			return nil
+		}
+		item.Array = &ast.BoolAttribute{Value: ast.Bool{Value: true}}
+		item.Optional = p.Optional
+		if p.Summary != nil || p.Description != nil {
+			item.Summary, item.Description = p.Summary, p.Description
+		}
+		if p.Deprecated != nil {
+			item.Deprecated = p.Deprecated
+		}
+		item.Nullable = p.Nullable // 元素无法表示 nullable，以数组本身的值为准。
+		if p.XMLWrapped != nil {
+			item.XMLWrapped = p.XMLWrapped
+		}
+		i.constraint(ptr, item, s, true)
+		return item
+	case "object":
+		if isMapSchema(s) {
+			value := i.param(pointer(ptr, "additionalProperties"), "value", s.AdditionalProperties.Schema, true)
+			if value == nil {
+				return nil
+			}
+			p.Type = &ast.TypeAttribute{Value: xmlenc.String{Value: ast.TypeMap}}
+			p.Items = []*ast.Param{value}
+			return p
+		}
+
+		p.Type = &ast.TypeAttribute{Value: xmlenc.String{Value: ast.TypeObject}}
+		i.properties(ptr, p, s)
+		i.constraint(ptr, p, s, false)
+		return p
+	}
+
+	t := i.typ(ptr, typ, s.Format)
+	if t == "" {
+		return nil
+	}
+	p.Type = &ast.TypeAttribute{Value: xmlenc.String{Value: t}}
+
+	for _, e := range s.Enum {
+		v := stringValue(e)
+		p.Enums = append(p.Enums, &ast.Enum{
+			Value:   newAttribute(v),
+			Summary: newAttribute(v),
+		})
+	}
+	i.constraint(ptr, p, s, false)
+
+	return p
+}
+
+// 将对 components.schemas 的引用转换成 ast.Param
+//
+// 可以表示为 ast.TypeDef 的 schema 会被添加到 doc.Types 中，并以 type="#name" 的形式引用，
+// 数组等无法表示为 ast.TypeDef 的 schema 则直接展开。
+// 循环引用无法在文档中表示，会以警告的形式输出并忽略。
+func (i *importer) refParam(ptr, name, ref string, nullable, required bool) *ast.Param {
+	ref, schema := i.resolveSchema(pointer(ptr, "$ref"), ref)
+	if schema == nil {
+		return nil
+	}
+	sptr := pointer("#/components/schemas", ref)
+
+	t := i.typeDef(sptr, ref, schema)
+	if t == nil {
+		i.schemas[ref] = true
+		defer delete(i.schemas, ref)
+
+		p := i.param(sptr, name, schema, required)
+		if p != nil && nullable {
+			p.Nullable = &ast.BoolAttribute{Value: ast.Bool{Value: true}}
+		}
+		return p
+	}
+
+	p := &ast.Param{
+		Name: newAttribute(name),
+		Type: &ast.TypeAttribute{Value: xmlenc.String{Value: "#" + ref}},
+	}
+	if name != "" {
+		p.Summary = newAttribute(name)
+	}
+	if !required {
+		p.Optional = &ast.BoolAttribute{Value: ast.Bool{Value: true}}
+	}
+	if nullable || schema.Nullable {
+		p.Nullable = &ast.BoolAttribute{Value: ast.Bool{Value: true}}
+	}
+	if schema.XML != nil {
+		i.xml(pointer(sptr, "xml"), p, schema.XML)
+	}
+	return p
+}
+
+// 将 s 转换成 ast.Param，如果 s 是引用，则展开其内容而不是引用 ast.TypeDef。
+func (i *importer) inlineParam(ptr string, s *Schema) *ast.Param {
+	if s.Ref == "" {
+		return i.param(ptr, "", s, true)
+	}
+
+	ref, schema := i.resolveSchema(pointer(ptr, "$ref"), s.Ref)
+	if schema == nil {
+		return nil
+	}
+
+	i.schemas[ref] = true
+	defer delete(i.schemas, ref)
+	return i.param(pointer("#/components/schemas", ref), "", schema, true)
+}
+
+// 返回 ref 指向的 components.schemas 中的对象及其名称
+//
+// 无法找到或是存在循环引用时，返回 nil。
+func (i *importer) resolveSchema(ptr, ref string) (string, *Schema) {
+	name, found := i.ref(ptr, ref, "schemas")
+	if !found {
+		return "", nil
+	}
+
+	if i.schemas[name] { // 循环引用
+		i.warning(ptr)
+		return "", nil
+	}
+
+	var schema *Schema
+	if i.openapi.Components != nil {
+		schema = i.openapi.Components.Schemas[name]
+	}
+	if schema == nil {
+		i.warning(ptr)
+		return "", nil
+	}
+	return name, schema
+}
+
+// 将 components.schemas 中的 s 转换成 ast.TypeDef
+//
+// ast.TypeDef 无法表示数组，也无法表示 nullable 和 xml 等由引用方决定的属性，
+// 所以数组会返回 nil，nullable 和 xml 则由引用方处理。
+func (i *importer) typeDef(ptr, name string, s *Schema) *ast.TypeDef {
+	if t, found := i.types[name]; found {
+		return t
+	}
+
+	if s.Type == TypeArray {
+		i.types[name] = nil
+		return nil
+	}
+
+	i.schemas[name] = true
+	p := i.param(ptr, "", s, true)
+	delete(i.schemas, name)
+
+	if p == nil || p.Array.V() || (p.Type.V() == ast.TypeObject && len(p.Items) == 0) {
+		i.types[name] = nil
+		return nil
+	}
+
+	t := &ast.TypeDef{
+		Constraint:  p.Constraint,
+		Name:        newAttribute(name),
+		Type:        p.Type,
+		Deprecated:  p.Deprecated,
+		Items:       p.Items,
+		Summary:     p.Summary,
+		Enums:       p.Enums,
+		Description: p.Description,
+	}
+	i.types[name] = t
+	i.doc.Types = append(i.doc.Types, t)
+	return t
+}
+
+// 将 s 中的约束条件写入 p
+//
+// isArray 表示 s 是否为数组，数组仅处理与元素数量相关的约束条件，
+// 其它与 p 的类型不相符的约束条件都以警告的形式输出。
+func (i *importer) constraint(ptr string, p *ast.Param, s *Schema, isArray bool) {
+	primitive, _ := ast.ParseType(p.Type.V())
+	isNumber := !isArray && primitive == ast.TypeNumber
+	isString := !isArray && primitive == ast.TypeString
+
+	set := func(name string, isSet, allowed bool, f func()) {
+		if !isSet {
+			return
+		}
+		if !allowed {
+			i.warning(pointer(ptr, name))
+			return
+		}
+		f()
+	}
+
+	set("minimum", s.Minimum != nil, isNumber, func() { p.Min = newNumberAttribute(*s.Minimum) })
+	set("maximum", s.Maximum != nil, isNumber, func() { p.Max = newNumberAttribute(*s.Maximum) })
+	set("minLength", s.MinLength != 0, isString, func() { p.MinLength = newNumberAttribute(float64(s.MinLength)) })
+	set("maxLength", s.MaxLength != 0, isString, func() { p.MaxLength = newNumberAttribute(float64(s.MaxLength)) })
+	set("pattern", s.Pattern != "", isString, func() { p.Pattern = newAttribute(s.Pattern) })
+	set("minItems", s.MinItems != 0, isArray, func() { p.MinItems = newNumberAttribute(float64(s.MinItems)) })
+	set("maxItems", s.MaxItems != 0, isArray, func() { p.MaxItems = newNumberAttribute(float64(s.MaxItems)) })
+}
+
+// 将 s.Properties 和 s.AllOf 中的内容写入 p.Items
+func (i *importer) properties(ptr string, p *ast.Param, s *Schema) {
+	for _, name := range schemaKeys(s.Properties) {
+		required := false
+		for _, r := range s.Required {
+			if r == name {
+				required = true
+				break
+			}
+		}
+
+		item := i.param(pointer(ptr, "properties", name), name, s.Properties[name], required)
+		if item == nil {
+			continue
+		}
+
+		if item.Type.V() == ast.TypeObject && len(item.Items) == 0 {
+			i.warning(pointer(ptr, "properties", name))
+			continue
+		}
+		p.Items = append(p.Items, item)
+	}
+
+	for index, sub := range s.AllOf {
+		aptr := pointer(ptr, "allOf", strconv.Itoa(index))
+		item := i.inlineParam(aptr, sub)
+		if item == nil {
+			continue
+		}
+
+		if item.Type.V() != ast.TypeObject || item.Array.V() {
+			i.warning(aptr)
+			continue
+		}
+
+	LOOP:
+		for _, v := range item.Items {
+			for _, exists := range p.Items {
+				if exists.Name.V() == v.Name.V() {
+					continue LOOP
+				}
+			}
+			p.Items = append(p.Items, v)
+		}
+	}
+}
+
+func (i *importer) typ(ptr, t, format string) string {
+	switch t {
+	case "integer":
+		return ast.TypeInt
+	case "number":
+		if format == TypeFloat || format == TypeDouble {
+			return ast.TypeFloat
+		}
+		return ast.TypeNumber
+	case "boolean", TypeBool:
+		return ast.TypeBool
+	case TypeString:
+		switch format {
+		case "email":
+			return ast.TypeEmail
+		case "uri", "url":
+			return ast.TypeURL
+		case "date":
+			return ast.TypeDate
+		case "time":
+			return ast.TypeTime
+		case "date-time":
+			return ast.TypeDateTime
+		case FormatBinary:
+			return ast.TypeBinary
+		}
+		return ast.TypeString
+	default:
+		i.warning(pointer(ptr, "type"))
+		return ""
+	}
+}
+
+func (i *importer) xml(ptr string, p *ast.Param, x *XML) {
+	if x.Attribute {
+		p.XMLAttr = &ast.BoolAttribute{Value: ast.Bool{Value: true}}
+	}
+
+	if x.Wrapped {
+		name := x.Name
+		if name == "" {
+			name = p.Name.V()
+		}
+		p.XMLWrapped = newAttribute(name)
+	} else if x.Name != "" {
+		if p.Name.V() == "" {
+			p.Name = newAttribute(x.Name)
+		} else if p.Name.V() != x.Name {
+			i.warning(pointer(ptr, "name"))
+		}
+	}
+
+	if x.Prefix == "" && x.Namespace == "" {
+		return
+	}
+	p.XMLNSPrefix = newAttribute(x.Prefix)
+
+	if ns := i.doc.XMLNamespace(x.Prefix); ns != nil {
+		if ns.URN.V() != x.Namespace {
+			i.warning(pointer(ptr, "namespace"))
+		}
+		return
+	}
+
+	if x.Namespace == "" {
+		i.warning(pointer(ptr, "namespace"))
+		return
+	}
+	ns := &ast.XMLNamespace{URN: newAttribute(x.Namespace)}
+	if x.Prefix != "" {
+		ns.Prefix = newAttribute(x.Prefix)
+	}
+	i.doc.XMLNamespaces = append(i.doc.XMLNamespaces, ns)
+}
+
+// s 是否可以表示为 ast.TypeMap
+//
+// 只有未指定 properties 且 additionalProperties 为 Schema 的对象才能转换为 map。
+func isMapSchema(s *Schema) bool {
+	return s.AdditionalProperties != nil && s.AdditionalProperties.Schema != nil &&
+		len(s.Properties) == 0 && len(s.AllOf) == 0
+}
+
+// 报告 s 中无法在 ast.Param 中表示的字段
+func (i *importer) unsupportedKeywords(ptr string, s *Schema) {
+	// additionalProperties 为 true 时与默认行为相同，无需警告
+	additional := s.AdditionalProperties != nil && !isMapSchema(s) &&
+		(s.AdditionalProperties.Schema != nil || !s.AdditionalProperties.Bool)
+
+	keywords := []struct {
+		name  string
+		isSet bool
+	}{
+		{"multipleOf", s.MultipleOf != 0},
+		{"exclusiveMaximum", s.ExclusiveMaximum},
+		{"exclusiveMinimum", s.ExclusiveMinimum},
+		{"uniqueItems", s.UniqueItems},
+		{"maxProperties", s.MaxProperties != 0},
+		{"minProperties", s.MinProperties != 0},
+		{"patternProperties", len(s.PatternProperties) > 0},
+		{"additionalProperties", additional},
+		{"anyOf", len(s.AnyOf) > 0},
+		{"oneOf", len(s.OneOf) > 0},
+		{"not", s.Not != nil},
+		{"discriminator", s.Discriminator != nil},
+		{"readOnly", s.ReadOnly},
+		{"writeOnly", s.WriteOnly},
+		{"externalDocs", s.ExternalDocs != nil},
+	}
+
+	for _, k := range keywords {
+		if k.isSet {
+			i.warning(pointer(ptr, k.name))
+		}
+	}
+}
+
+// 从 ref 中获取指定 components 中的对象名称
+//
+// 仅支持 #/components/{kind}/{name} 形式的本地引用。
+func (i *importer) ref(ptr, ref, kind string) (string, bool) {
+	prefix := "#/components/" + kind + "/"
+	if !strings.HasPrefix(ref, prefix) {
+		i.warning(ptr)
+		return "", false
+	}
+	return refReplacer.Replace(ref[len(prefix):]), true
+}
+
+func newAttribute(v string) *ast.Attribute {
+	return &ast.Attribute{Value: xmlenc.String{Value: v}}
+}
+
+func newNumberAttribute(v float64) *ast.NumberAttribute {
+	if v == math.Trunc(v) {
+		return &ast.NumberAttribute{Value: ast.Number{Int: int(v)}}
+	}
+	return &ast.NumberAttribute{Value: ast.Number{Float: v, IsFloat: true}}
+}
+
+func newRichtext(v string) *ast.Richtext {
+	return &ast.Richtext{
+		Type: newAttribute(ast.RichtextTypeMarkdown),
+		Text: &ast.CData{Value: xmlenc.String{Value: v}},
+	}
+}
+
+// 单行内容作为 summary，多行内容作为 description。
+func newText(v string) (*ast.Attribute, *ast.Richtext) {
+	v = strings.TrimSpace(v)
+	if v == "" {
+		return nil, nil
+	}
+
+	if strings.ContainsRune(v, '\n') {
+		return nil, newRichtext(v)
+	}
+	return newAttribute(v), nil
+}
+
+// 以下函数返回 m 中按字母顺序排序的键名，用于保证输出内容的顺序。
+
+func stringKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func mediaTypeKeys(m map[string]*MediaType) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func responseKeys(m map[string]*Response) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func headerKeys(m map[string]*Header) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func exampleKeys(m map[string]*Example) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func schemaKeys(m map[string]*Schema) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func parameterKeys(m map[string]*Parameter) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func requestBodyKeys(m map[string]*RequestBody) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func callbackKeys(m map[string]Callback) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func pathItemKeys(m map[string]*PathItem) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func encodingKeys(m map[string]*Encoding) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func securitySchemeKeys(m map[string]*SecurityScheme) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// 将 YAML 解析后的内容转换成可由 encoding/json 处理的值
+func jsonValue(v interface{}) interface{} {
+	switch val := v.(type) {
+	case map[interface{}]interface{}:
+		m := make(map[string]interface{}, len(val))
+		for k, item := range val {
+			m[fmt.Sprint(k)] = jsonValue(item)
+		}
+		return m
+	case map[string]interface{}:
+		m := make(map[string]interface{}, len(val))
+		for k, item := range val {
+			m[k] = jsonValue(item)
+		}
+		return m
+	case []interface{}:
+		s := make([]interface{}, 0, len(val))
+		for _, item := range val {
+			s = append(s, jsonValue(item))
+		}
+		return s
+	default:
+		return v
+	}
+}
+
+func stringValue(v interface{}) string {
+	switch val := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return val
+	case bool, int, int64, uint64, float64:
+		return fmt.Sprint(val)
+	}
+
+	data, err := json.Marshal(jsonValue(v))
+	if err != nil {
+		return fmt.Sprint(v)
+	}
+	return string(data)
+}
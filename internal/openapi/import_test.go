@@ -0,0 +1,165 @@
+// SPDX-License-Identifier: MIT
+
+package openapi
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/issue9/assert"
+	"gopkg.in/yaml.v2"
+
+	"github.com/caixw/apidoc/v7/core"
+	"github.com/caixw/apidoc/v7/core/messagetest"
+	"github.com/caixw/apidoc/v7/internal/ast"
+	"github.com/caixw/apidoc/v7/internal/xmlenc"
+)
+
+func TestImport(t *testing.T) {
+	a := assert.New(t)
+
+	rslt := messagetest.NewMessageHandler()
+	d, err := Import(rslt.Handler, core.FileURI("./testdata/petstore.yaml"))
+	rslt.Handler.Stop()
+	a.NotError(err).NotNil(d)
+	a.Empty(rslt.Errors)
+
+	fields := make([]string, 0, len(rslt.Warns))
+	for _, w := range rslt.Warns {
+		fields = append(fields, w.(*core.Error).Field)
+	}
+	a.Equal(fields, []string{
+		"#/components/schemas/Pet/properties/parent/$ref",
+		"#/paths/~1pets/get/responses/default",
+		"#/paths/~1pets/post/callbacks",
+		"#/paths/~1pets/post/security/1",
+	})
+
+	a.Equal(d.Title.V(), "Petstore").
+		Equal(d.Version.V(), "1.0.0").
+		Equal(d.License.Text.V(), "MIT").
+		Equal(1, len(d.Servers)).
+		Equal(1, len(d.Tags)).
+		Equal(2, len(d.Mimetypes)).
+		Equal(3, len(d.APIs))
+
+	// components.schemas 转换成 type
+	a.Equal(3, len(d.Types))
+	a.Equal(d.Types[0].Name.V(), "Error").
+		Equal(d.Types[0].Type.V(), ast.TypeObject).
+		Equal(2, len(d.Types[0].Items))
+	a.Equal(d.Types[1].Name.V(), "Owner")
+	pt := d.Types[2]
+	a.Equal(pt.Name.V(), "Pet").
+		Equal(pt.Type.V(), ast.TypeObject).
+		Equal(6, len(pt.Items)) // parent 为循环引用，被忽略
+	labels := pt.Items[2]
+	a.Equal(labels.Name.V(), "labels").
+		Equal(labels.Type.V(), ast.TypeMap).
+		True(labels.Nullable.V()).
+		Equal(1, len(labels.Items)).
+		Equal(labels.Items[0].Type.V(), ast.TypeString)
+	owner := pt.Items[4]
+	a.Equal(owner.Name.V(), "owner").
+		Equal(owner.Type.V(), "#Owner").
+		True(owner.Nullable.V()).
+		True(owner.Optional.V())
+
+	// components.securitySchemes 转换成 security
+	a.Equal(3, len(d.Securities))
+	a.Equal(d.Securities[0].Name.V(), "apiKey").
+		Equal(d.Securities[0].Type.V(), ast.SecurityTypeAPIKey).
+		Equal(d.Securities[0].In.V(), ast.SecurityInHeader).
+		Equal(d.Securities[0].Key.V(), "X-API-Key")
+	a.Equal(d.Securities[1].Type.V(), ast.SecurityTypeHTTP).
+		Equal(d.Securities[1].Scheme.V(), "basic").
+		Equal(d.Securities[1].Summary.V(), "basic auth")
+	oauth := d.Securities[2]
+	a.Equal(oauth.Type.V(), ast.SecurityTypeOAuth2).
+		Equal(1, len(oauth.Flows)).
+		Equal(oauth.Flows[0].Type.V(), ast.OAuthFlowAuthorizationCode).
+		Equal(2, len(oauth.Flows[0].Scopes))
+
+	list := d.APIs[0]
+	a.Equal(list.Method.V(), http.MethodGet).
+		Equal(list.ID.V(), "listPets").
+		Equal(list.Path.Path.V(), "/pets").
+		Equal(1, len(list.Path.Queries)).
+		Equal(list.Path.Queries[0].Type.V(), ast.TypeInt).
+		Equal(list.Path.Queries[0].Max.V(), 100.0).
+		True(list.Path.Queries[0].Optional.V()).
+		Equal(1, len(list.Cookies)).
+		Equal(list.Cookies[0].Name.V(), "session").
+		True(list.Cookies[0].Optional.V()).
+		Equal(1, len(list.Securities)). // 继承自 #/security
+		Equal(list.Securities[0].Name.V(), "apiKey")
+	a.Equal(1, len(list.Responses))
+	resp := list.Responses[0]
+	a.Equal(resp.Status.V(), http.StatusOK).
+		True(resp.Array.V()).
+		Equal(resp.Type.V(), "#Pet").
+		Empty(resp.Items).
+		Equal(1, len(resp.Headers)).
+		Equal(1, len(resp.Examples))
+
+	create := d.APIs[1]
+	a.Equal(create.Method.V(), http.MethodPost).
+		NotNil(create.Deprecated).
+		Equal(1, len(create.Requests)).
+		Equal(create.Requests[0].Mimetype.V(), "application/json").
+		Equal(1, len(create.Securities)).
+		Equal(create.Securities[0].Name.V(), "petstore").
+		Equal(create.Securities[0].Scopes[0].V(), "write:pets")
+
+	show := d.APIs[2]
+	a.Equal(1, len(show.Path.Params)).
+		Equal(show.Path.Params[0].Name.V(), "petId").
+		Equal(3, len(show.Responses)).
+		Empty(show.Securities) // security: []
+	pet := show.Responses[1]
+	a.Equal(pet.Mimetype.V(), "application/xml").
+		Equal(pet.Name.V(), "pet").
+		Equal(pet.Type.V(), "#Pet")
+
+	// 导出的内容可以被正常解析
+	data, err := xmlenc.Encode("\t", d, "", "")
+	a.NotError(err).NotNil(data)
+	rslt = messagetest.NewMessageHandler()
+	doc := &ast.APIDoc{}
+	doc.Parse(rslt.Handler, core.Block{Data: data})
+	rslt.Handler.Stop()
+	a.Empty(rslt.Errors).Equal(3, len(doc.APIs))
+
+	// 再次导出时保留 components.schemas 和 components.securitySchemes
+	oa, err := convert(doc, false)
+	a.NotError(err).NotNil(oa.Components)
+	a.Equal(3, len(oa.Components.Schemas)).
+		Equal(3, len(oa.Components.SecuritySchemes)).
+		Equal(oa.Components.SecuritySchemes["petstore"].Type, SecurityTypeOAuth2)
+	a.Equal(oa.Paths["/pets"].Get.Responses["200"].Content["application/json"].Schema.Items.Ref, "#/components/schemas/Pet").
+		Equal(oa.Paths["/pets"].Post.Security, []*SecurityRequirement{{"petstore": {"write:pets"}}})
+
+	// 非 3.x 版本
+	rslt = messagetest.NewMessageHandler()
+	d, err = Import(rslt.Handler, core.FileURI("./testdata/swagger.json"))
+	rslt.Handler.Stop()
+	a.Error(err).Nil(d)
+}
+
+func TestExampleValue(t *testing.T) {
+	a := assert.New(t)
+
+	v := &struct {
+		Value ExampleValue `json:"value" yaml:"value"`
+	}{}
+	a.NotError(json.Unmarshal([]byte(`{"value":"str"}`), v))
+	a.Equal(v.Value, "str")
+	a.NotError(json.Unmarshal([]byte(`{"value":{"id":1}}`), v))
+	a.Equal(v.Value, "{\n\t\"id\": 1\n}")
+
+	a.NotError(yaml.Unmarshal([]byte("value: str"), v))
+	a.Equal(v.Value, "str")
+	a.NotError(yaml.Unmarshal([]byte("value:\n  id: 1"), v))
+	a.Equal(v.Value, "{\n\t\"id\": 1\n}")
+}
@@ -6,6 +6,8 @@
 package openapi
 
 import (
+	"bytes"
+	"encoding/json"
 	"strconv"
 
 	"github.com/issue9/is"
@@ -16,19 +18,25 @@ import (
 	"github.com/caixw/apidoc/v7/internal/locale"
 )
 
-// LatestVersion openapi 最新的版本号
+// LatestVersion openapi 3.0 最新的版本号
 const LatestVersion = "3.0.3"
 
+// Version31 openapi 3.1 的版本号
+//
+// 3.1 与 3.0 的结构基本相同，但是 schema 采用了 JSON Schema 2020-12 的语义。
+const Version31 = "3.1.0"
+
 // OpenAPI openAPI 的根对象
 type OpenAPI struct {
-	OpenAPI      string                 `json:"openapi" yaml:"openapi"`
-	Info         *Info                  `json:"info" yaml:"info"`
-	Servers      []*Server              `json:"servers,omitempty" yaml:"servers,omitempty"`
-	Paths        map[string]*PathItem   `json:"paths" yaml:"paths"`
-	Components   *Components            `json:"components,omitempty" yaml:"components,omitempty"`
-	Security     []*SecurityRequirement `json:"security,omitempty" yaml:"security,omitempty"`
-	Tags         []*Tag                 `json:"tags,omitempty" yaml:"tags,omitempty"`
-	ExternalDocs *ExternalDocumentation `json:"externalDocs,omitempty" yaml:"externalDocs,omitempty"`
+	OpenAPI           string                 `json:"openapi" yaml:"openapi"`
+	JSONSchemaDialect string                 `json:"jsonSchemaDialect,omitempty" yaml:"jsonSchemaDialect,omitempty"` // 仅 3.1 及之后的版本可用
+	Info              *Info                  `json:"info" yaml:"info"`
+	Servers           []*Server              `json:"servers,omitempty" yaml:"servers,omitempty"`
+	Paths             map[string]*PathItem   `json:"paths" yaml:"paths"`
+	Components        *Components            `json:"components,omitempty" yaml:"components,omitempty"`
+	Security          []*SecurityRequirement `json:"security,omitempty" yaml:"security,omitempty"`
+	Tags              []*Tag                 `json:"tags,omitempty" yaml:"tags,omitempty"`
+	ExternalDocs      *ExternalDocumentation `json:"externalDocs,omitempty" yaml:"externalDocs,omitempty"`
 }
 
 // Components 可复用的对象
@@ -41,7 +49,7 @@ type Components struct {
 	Headers         map[string]*Header         `json:"headers,omitempty" yaml:"headers,omitempty"`
 	SecuritySchemes map[string]*SecurityScheme `json:"securitySchemes,omitempty" yaml:"securitySchemes,omitempty"`
 	Links           map[string]*Link           `json:"links,omitempty" yaml:"links,omitempty"`
-	Callbacks       map[string]*Callback       `json:"callbacks,omitempty" yaml:"callbacks,omitempty"`
+	Callbacks       map[string]Callback        `json:"callbacks,omitempty" yaml:"callbacks,omitempty"`
 }
 
 // ExternalDocumentation 引用外部资源的扩展文档
@@ -80,8 +88,46 @@ type Example struct {
 }
 
 // ExampleValue 表示示例的内容类型。
+//
+// 解码时，非字符串的值会被转换成 JSON 格式的字符串。
 type ExampleValue string
 
+// UnmarshalJSON json.Unmarshaler
+func (v *ExampleValue) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err == nil {
+		*v = ExampleValue(s)
+		return nil
+	}
+
+	buf := new(bytes.Buffer)
+	if err := json.Indent(buf, data, "", "\t"); err != nil {
+		return err
+	}
+	*v = ExampleValue(buf.String())
+	return nil
+}
+
+// UnmarshalYAML yaml.Unmarshaler
+func (v *ExampleValue) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var val interface{}
+	if err := unmarshal(&val); err != nil {
+		return err
+	}
+
+	if s, ok := val.(string); ok {
+		*v = ExampleValue(s)
+		return nil
+	}
+
+	data, err := json.MarshalIndent(jsonValue(val), "", "\t")
+	if err != nil {
+		return err
+	}
+	*v = ExampleValue(data)
+	return nil
+}
+
 func newTag(tag *ast.Tag) *Tag {
 	return &Tag{
 		Name:        tag.Name.V(),
@@ -197,6 +243,13 @@ func (c *Components) sanitize() *core.Error {
 		}
 	}
 
+	for key, item := range c.Callbacks {
+		if err := item.sanitize(); err != nil {
+			err.Field = "callbacks[" + key + "]." + err.Field
+			return err
+		}
+	}
+
 	return nil
 }
 
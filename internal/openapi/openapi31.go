@@ -0,0 +1,233 @@
+// SPDX-License-Identifier: MIT
+
+package openapi
+
+import (
+	"encoding/json"
+
+	"gopkg.in/yaml.v2"
+
+	"github.com/caixw/apidoc/v7/core"
+	"github.com/caixw/apidoc/v7/internal/ast"
+)
+
+// openapi 3.1 默认的 JSON Schema 方言
+const jsonSchemaDialect = "https://spec.openapis.org/oas/3.1/dialect/base"
+
+// 将 doc.APIDoc 转换成 openapi 3.1
+//
+// 先转换成 3.0 的对象，再将其中的 schema 改为 JSON Schema 2020-12 的语义。
+// 3.1 是 3.0 的超集，所以不存在转换时丢失的内容，h 仅为了与其它版本保持一致。
+func convert31(h *core.MessageHandler, doc *ast.APIDoc, ref bool) (*OpenAPI, error) {
+	openapi, err := convert(doc, ref)
+	if err != nil {
+		return nil, err
+	}
+
+	openapi.OpenAPI = Version31
+	openapi.JSONSchemaDialect = jsonSchemaDialect
+	openapi.walkSchemas(func(s *Schema) { s.toJSONSchema2020() })
+	return openapi, nil
+}
+
+// 将 3.0 中的 schema 转换成 JSON Schema 2020-12 的语义
+func (s *Schema) toJSONSchema2020() {
+	s.normalizeType()
+
+	if s.Format == FormatBinary {
+		s.Format = ""
+		s.ContentMediaType = "application/octet-stream"
+	}
+
+	if !s.Nullable {
+		return
+	}
+	s.Nullable = false
+
+	switch {
+	case len(s.AllOf) == 1 && s.Type == "": // 3.0 中通过 allOf 包装的可以为 null 的引用
+		s.AnyOf = []*Schema{s.AllOf[0], {Type: TypeNull}}
+		s.AllOf = nil
+	case len(s.OneOf) > 0:
+		s.OneOf = append(s.OneOf, &Schema{Type: TypeNull})
+	case len(s.AnyOf) > 0:
+		s.AnyOf = append(s.AnyOf, &Schema{Type: TypeNull})
+	default:
+		if s.Type == "" {
+			s.Type = TypeObject
+		}
+		if len(s.Enum) > 0 {
+			s.Enum = append(s.Enum, nil)
+		}
+		s.null = true
+	}
+}
+
+// 将 3.0 导出时采用的类型名称改为 JSON Schema 中的类型名称
+func (s *Schema) normalizeType() {
+	switch s.Type {
+	case TypeDouble, TypeFloat:
+		s.Type, s.Format = TypeNumber, s.Type
+	case TypeLong:
+		s.Type, s.Format = TypeInt, "int64"
+	case TypeBool:
+		s.Type = TypeBoolean
+	}
+}
+
+// 遍历文档中的所有 schema，每个对象仅访问一次。
+func (oa *OpenAPI) walkSchemas(f func(*Schema)) {
+	w := &schemaWalker{f: f, seen: make(map[*Schema]struct{}, 100)}
+
+	if c := oa.Components; c != nil {
+		for _, key := range schemaKeys(c.Schemas) {
+			w.schema(c.Schemas[key])
+		}
+		for _, key := range parameterKeys(c.Parameters) {
+			w.parameter(c.Parameters[key])
+		}
+		for _, key := range headerKeys(c.Headers) {
+			w.parameter((*Parameter)(c.Headers[key]))
+		}
+		for _, key := range responseKeys(c.Responses) {
+			w.response(c.Responses[key])
+		}
+		for _, key := range requestBodyKeys(c.RequestBodies) {
+			w.content(c.RequestBodies[key].Content)
+		}
+		for _, key := range callbackKeys(c.Callbacks) {
+			w.callback(c.Callbacks[key])
+		}
+	}
+
+	for _, key := range pathItemKeys(oa.Paths) {
+		w.pathItem(oa.Paths[key])
+	}
+}
+
+type schemaWalker struct {
+	f    func(*Schema)
+	seen map[*Schema]struct{}
+}
+
+func (w *schemaWalker) pathItem(p *PathItem) {
+	for _, param := range p.Parameters {
+		w.parameter(param)
+	}
+
+	for _, method := range importMethods {
+		o := pathOperation(p, method)
+		if o == nil {
+			continue
+		}
+
+		for _, param := range o.Parameters {
+			w.parameter(param)
+		}
+		if o.RequestBody != nil {
+			w.content(o.RequestBody.Content)
+		}
+		for _, key := range responseKeys(o.Responses) {
+			w.response(o.Responses[key])
+		}
+		for _, key := range callbackKeys(o.Callbacks) {
+			w.callback(o.Callbacks[key])
+		}
+	}
+}
+
+func (w *schemaWalker) callback(c Callback) {
+	for _, key := range pathItemKeys(c) {
+		w.pathItem(c[key])
+	}
+}
+
+func (w *schemaWalker) parameter(p *Parameter) {
+	w.schema(p.Schema)
+	w.content(p.Content)
+}
+
+func (w *schemaWalker) response(r *Response) {
+	for _, key := range headerKeys(r.Headers) {
+		w.parameter((*Parameter)(r.Headers[key]))
+	}
+	w.content(r.Content)
+}
+
+func (w *schemaWalker) content(content map[string]*MediaType) {
+	for _, key := range mediaTypeKeys(content) {
+		mt := content[key]
+		w.schema(mt.Schema)
+		for _, name := range encodingKeys(mt.Encoding) {
+			for _, h := range headerKeys(mt.Encoding[name].Headers) {
+				w.parameter((*Parameter)(mt.Encoding[name].Headers[h]))
+			}
+		}
+	}
+}
+
+func (w *schemaWalker) schema(s *Schema) {
+	if s == nil {
+		return
+	}
+	if _, found := w.seen[s]; found {
+		return
+	}
+	w.seen[s] = struct{}{}
+
+	w.f(s)
+
+	w.schema(s.Items)
+	w.schema(s.AdditionalItems)
+	w.schema(s.Contains)
+	w.schema(s.Not)
+	w.schema(s.PropertyNames)
+	if s.AdditionalProperties != nil {
+		w.schema(s.AdditionalProperties.Schema)
+	}
+	for _, key := range schemaKeys(s.Properties) {
+		w.schema(s.Properties[key])
+	}
+	for _, key := range schemaKeys(s.PatternProperties) {
+		w.schema(s.PatternProperties[key])
+	}
+	for _, key := range schemaKeys(s.Dependencies) {
+		w.schema(s.Dependencies[key])
+	}
+	for _, key := range schemaKeys(s.Definitions) {
+		w.schema(s.Definitions[key])
+	}
+	for _, item := range s.AllOf {
+		w.schema(item)
+	}
+	for _, item := range s.AnyOf {
+		w.schema(item)
+	}
+	for _, item := range s.OneOf {
+		w.schema(item)
+	}
+}
+
+// JSON31 输出 openapi 3.1 的 JSON 格式数据
+//
+// ref 表示是否将结构相同的 schema 提取到 components.schemas 中，并以 $ref 的形式引用。
+func JSON31(h *core.MessageHandler, doc *ast.APIDoc, ref bool) ([]byte, error) {
+	openapi, err := convert31(h, doc, ref)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.MarshalIndent(openapi, "", "\t")
+}
+
+// YAML31 输出 openapi 3.1 的 YAML 格式数据
+//
+// ref 表示是否将结构相同的 schema 提取到 components.schemas 中，并以 $ref 的形式引用。
+func YAML31(h *core.MessageHandler, doc *ast.APIDoc, ref bool) ([]byte, error) {
+	openapi, err := convert31(h, doc, ref)
+	if err != nil {
+		return nil, err
+	}
+
+	return yaml.Marshal(openapi)
+}
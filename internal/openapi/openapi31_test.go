@@ -0,0 +1,93 @@
+// SPDX-License-Identifier: MIT
+
+package openapi
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/issue9/assert"
+	"gopkg.in/yaml.v2"
+
+	"github.com/caixw/apidoc/v7/core"
+	"github.com/caixw/apidoc/v7/core/messagetest"
+	"github.com/caixw/apidoc/v7/internal/ast"
+)
+
+func TestConvert31(t *testing.T) {
+	a := assert.New(t)
+
+	rslt := messagetest.NewMessageHandler()
+	doc := &ast.APIDoc{}
+	doc.Parse(rslt.Handler, core.Block{Data: []byte(`<apidoc version="1.0.0">
+	<title>title</title>
+	<mimetype>application/json</mimetype>
+	<type name="User" type="object" summary="user">
+		<param name="id" type="number.int" summary="id" />
+	</type>
+	<api method="POST">
+		<path path="/users" />
+		<request type="object" mimetype="multipart/form-data">
+			<param name="avatar" type="string.binary" summary="avatar" />
+		</request>
+		<response status="200" type="object" mimetype="application/json">
+			<param name="name" type="string" nullable="true" summary="name" />
+			<param name="sex" type="string" nullable="true" summary="sex">
+				<enum value="male" summary="male" />
+				<enum value="female" summary="female" />
+			</param>
+			<param name="tags" type="string" array="true" nullable="true" summary="tags" />
+			<param name="user" type="#User" nullable="true" summary="user" />
+			<param name="ok" type="bool" summary="ok" />
+			<param name="score" type="number" summary="score" />
+		</response>
+	</api>
+</apidoc>`)})
+	rslt.Handler.Stop()
+	a.Empty(rslt.Errors)
+
+	rslt = messagetest.NewMessageHandler()
+	openapi, err := convert31(rslt.Handler, doc, false)
+	rslt.Handler.Stop()
+	a.NotError(err).NotNil(openapi).Empty(rslt.Warns)
+	a.Equal(openapi.OpenAPI, Version31).
+		Equal(openapi.JSONSchemaDialect, jsonSchemaDialect)
+
+	a.Equal(openapi.Components.Schemas["User"].Properties["id"].Type, TypeInt).
+		Equal(openapi.Components.Schemas["User"].Properties["id"].Format, "int64")
+
+	avatar := openapi.Paths["/users"].Post.RequestBody.Content[multipartMimetype].Schema.Properties["avatar"]
+	a.Equal(avatar.Type, TypeString).
+		Empty(avatar.Format).
+		Equal(avatar.ContentMediaType, "application/octet-stream")
+
+	props := openapi.Paths["/users"].Post.Responses["200"].Content["application/json"].Schema.Properties
+	a.Equal(props["ok"].Type, TypeBoolean)
+	a.Equal(props["score"].Type, TypeNumber).Equal(props["score"].Format, TypeDouble)
+
+	data, err := json.Marshal(props["name"])
+	a.NotError(err).Equal(string(data), `{"title":"name","default":"","type":["string","null"]}`)
+
+	data, err = json.Marshal(props["sex"])
+	a.NotError(err).Equal(string(data), `{"enum":["male","female",null],"title":"sex","default":"","type":["string","null"]}`)
+
+	data, err = json.Marshal(props["tags"])
+	a.NotError(err).Equal(string(data), `{"items":{"type":"string","title":"tags","default":"","xml":{"name":"tags"}},"type":["array","null"]}`)
+
+	user := props["user"]
+	a.False(user.Nullable).
+		Empty(user.AllOf).
+		Equal(2, len(user.AnyOf)).
+		Equal(user.AnyOf[0].Ref, "#/components/schemas/User").
+		Equal(user.AnyOf[1].Type, TypeNull)
+
+	data, err = yaml.Marshal(props["name"])
+	a.NotError(err).Equal(string(data), "type:\n- string\n- \"null\"\ntitle: name\ndefault: \"\"\n")
+
+	// 输出的内容可以被正常解析
+	data, err = JSON31(rslt.Handler, doc, true)
+	a.NotError(err).NotEmpty(data)
+	data, err = YAML31(rslt.Handler, doc, true)
+	a.NotError(err).NotEmpty(data)
+	a.NotError(yaml.Unmarshal(data, &map[string]interface{}{}))
+}
@@ -37,6 +37,10 @@ type Parameter struct {
 }
 
 func (p *Parameter) sanitize() *core.Error {
+	if p.Ref != "" {
+		return nil
+	}
+
 	if err := p.Style.sanitize(); err != nil {
 		return err
 	}
@@ -51,6 +55,10 @@ func (p *Parameter) sanitize() *core.Error {
 }
 
 func (h *Header) sanitize() *core.Error {
+	if h.Ref != "" {
+		return nil
+	}
+
 	if err := h.Style.sanitize(); err != nil {
 		return err
 	}
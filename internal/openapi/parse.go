@@ -4,6 +4,7 @@ package openapi
 
 import (
 	"encoding/json"
+	"net/http"
 	"strconv"
 	"strings"
 
@@ -15,7 +16,9 @@ import (
 )
 
 // 将 doc.APIDoc 转换成 openapi
-func convert(doc *ast.APIDoc) (*OpenAPI, error) {
+//
+// ref 表示是否将结构相同的 schema 提取到 components.schemas 中。
+func convert(doc *ast.APIDoc, ref bool) (*OpenAPI, error) {
 	langID := doc.Lang.V()
 	if langID == "" {
 		langID = "und"
@@ -47,16 +50,32 @@ func convert(doc *ast.APIDoc) (*OpenAPI, error) {
 		openapi.Tags = append(openapi.Tags, newTag(tag))
 	}
 
+	openapi.Components = newComponents(doc)
+
 	if err := parsePaths(openapi, doc); err != nil {
 		return nil, err
 	}
 
+	openapi.extractBranches()
+	if ref {
+		openapi.extractSchemas()
+	}
+
 	if err := openapi.sanitize(); err != nil {
 		return nil, err
 	}
 	return openapi, nil
 }
 
+// 未指定 callback.path 时，回调地址由请求方在请求内容中指定。
+const callbackExpression = "{$request.body#/callback}"
+
+// 公共内容在 components 中的引用地址前缀
+const (
+	componentsResponsesRef  = "#/components/responses/"
+	componentsParametersRef = "#/components/parameters/"
+)
+
 func parsePaths(openapi *OpenAPI, d *ast.APIDoc) *core.Error {
 	for _, api := range d.APIs {
 		p := openapi.Paths[api.Path.Path.V()]
@@ -87,7 +106,14 @@ func parsePaths(openapi *OpenAPI, d *ast.APIDoc) *core.Error {
 		if api.Description != nil {
 			operation.Description = api.Description.V()
 		}
-		setOperationParams(d, operation, api)
+		setOperationParams(d, operation, api.Path, api.Headers, api.Cookies, api.Requests)
+
+		// 公共报头
+		for _, header := range d.Headers {
+			operation.Parameters = append(operation.Parameters, &Parameter{
+				Ref: componentsParametersRef + header.Name.V(),
+			})
+		}
 
 		// servers
 		// 不为 PathItem 设置 servers，直接写在 operation
@@ -113,92 +139,264 @@ func parsePaths(openapi *OpenAPI, d *ast.APIDoc) *core.Error {
 			}
 		}
 
-		// requests
-		if len(api.Requests) > 0 {
-			content := make(map[string]*MediaType, len(api.Requests))
-			for _, r := range api.Requests {
-				examples := make(map[string]*Example, len(r.Examples))
-				for _, exp := range r.Examples {
-					examples[exp.Mimetype.V()] = &Example{
-						Value: ExampleValue(exp.Content.Value.Value),
-					}
-				}
+		operation.Security = newSecurityRequirements(api.Securities)
+		operation.RequestBody = newRequestBody(d, api.Requests)
+		operation.Responses = newResponses(d, api.Responses)
 
-				content[r.Mimetype.V()] = &MediaType{
-					Schema:   newSchemaFromRequest(d, r, true),
-					Examples: examples,
+		// 与 mock 的规则相同，仅在 api.Responses 中找不到时，才采用 doc.Responses 中的内容。
+		if openapi.Components != nil {
+			for status := range openapi.Components.Responses {
+				if _, found := operation.Responses[status]; !found {
+					operation.Responses[status] = &Response{Ref: componentsResponsesRef + status}
 				}
 			}
+		}
+
+		if api.Callback != nil {
+			operation.Callbacks = map[string]Callback{"callback": newCallback(d, api.Callback)}
+		}
+	} // end for doc.Apis
+
+	return nil
+}
+
+// 将 doc.Types、doc.Securities、doc.Headers 和 doc.Responses 写入 components
+func newComponents(doc *ast.APIDoc) *Components {
+	if len(doc.Types) == 0 && len(doc.Headers) == 0 && len(doc.Responses) == 0 && len(doc.Securities) == 0 {
+		return nil
+	}
+
+	c := &Components{}
+
+	if len(doc.Securities) > 0 {
+		c.SecuritySchemes = make(map[string]*SecurityScheme, len(doc.Securities))
+		for _, s := range doc.Securities {
+			c.SecuritySchemes[s.Name.V()] = newSecurityScheme(s)
+		}
+	}
 
-			operation.RequestBody = &RequestBody{
-				Content: content,
+	if len(doc.Types) > 0 {
+		c.Schemas = make(map[string]*Schema, len(doc.Types))
+		for _, t := range doc.Types {
+			p := t.Param()
+			p.Name = nil // 类型定义的名称仅用于引用，不作为 XML 的元素名称。
+			c.Schemas[t.Name.V()] = newSchema(doc, p, true)
+		}
+	}
+
+	if len(doc.Headers) > 0 {
+		c.Parameters = make(map[string]*Parameter, len(doc.Headers))
+		for _, header := range doc.Headers {
+			c.Parameters[header.Name.V()] = newHeaderParameter(doc, header)
+		}
+	}
+
+	if len(doc.Responses) > 0 {
+		c.Responses = newResponses(doc, doc.Responses)
+	}
+
+	return c
+}
+
+func newCallback(doc *ast.APIDoc, callback *ast.Callback) Callback {
+	p := &PathItem{}
+	operation, _ := setOperation(p, callback.Method.V()) // 新的 PathItem 不会有重复的错误
+
+	operation.Summary = callback.Summary.V()
+	operation.Description = callback.Description.V()
+	operation.Deprecated = callback.Deprecated != nil
+	setOperationParams(doc, operation, callback.Path, callback.Headers, nil, callback.Requests)
+	operation.RequestBody = newRequestBody(doc, callback.Requests)
+	operation.Responses = newResponses(doc, callback.Responses)
+
+	exp := callbackExpression
+	if callback.Path != nil && callback.Path.Path.V() != "" {
+		exp = callback.Path.Path.V()
+	}
+	return Callback{exp: p}
+}
+
+func newRequestBody(doc *ast.APIDoc, requests []*ast.Request) *RequestBody {
+	if len(requests) == 0 {
+		return nil
+	}
+
+	content := make(map[string]*MediaType, len(requests))
+	for _, r := range requests {
+		examples := make(map[string]*Example, len(r.Examples))
+		for _, exp := range r.Examples {
+			examples[exp.Mimetype.V()] = &Example{
+				Value: ExampleValue(exp.Content.Value.Value),
 			}
 		}
 
-		// responses
-		operation.Responses = make(map[string]*Response, len(api.Responses))
-		for _, resp := range api.Responses {
-			status := strconv.Itoa(resp.Status.V())
-			r, found := operation.Responses[status]
-			if !found {
-				r = &Response{
-					Description: getDescription(resp.Description, resp.Summary),
-					Headers:     make(map[string]*Header, 10),
-					Content:     make(map[string]*MediaType, 10),
-				}
-				operation.Responses[status] = r
+		content[r.Mimetype.V()] = &MediaType{
+			Schema:   newSchemaFromRequest(doc, r, true),
+			Examples: examples,
+			Encoding: newEncoding(r),
+		}
+	}
+
+	return &RequestBody{Content: content}
+}
+
+func newResponses(doc *ast.APIDoc, responses []*ast.Request) map[string]*Response {
+	ret := make(map[string]*Response, len(responses))
+
+	for _, resp := range responses {
+		status := strconv.Itoa(resp.Status.V())
+		r, found := ret[status]
+		if !found {
+			desc := getDescription(resp.Description, resp.Summary)
+			if desc == "" { // description 是必须的
+				desc = http.StatusText(resp.Status.V())
 			}
 
-			for _, h := range resp.Headers {
-				r.Headers[h.Name.V()] = &Header{
-					Style:       Style{Style: StyleSimple},
-					Description: getDescription(h.Description, h.Summary),
-				}
+			r = &Response{
+				Description: desc,
+				Headers:     make(map[string]*Header, 10),
+				Content:     make(map[string]*MediaType, 10),
 			}
+			ret[status] = r
+		}
 
-			examples := make(map[string]*Example, len(resp.Examples))
-			for _, exp := range resp.Examples {
-				examples[exp.Mimetype.V()] = &Example{
-					Summary: exp.Summary.V(),
-					Value:   ExampleValue(exp.Content.Value.Value),
-				}
+		for _, h := range resp.Headers {
+			r.Headers[h.Name.V()] = &Header{
+				Style:       Style{Style: StyleSimple},
+				Description: getDescription(h.Description, h.Summary),
+				Schema:      newSchema(doc, h, true),
 			}
-			r.Content[resp.Mimetype.V()] = &MediaType{
-				Schema:   newSchemaFromRequest(d, resp, true),
-				Examples: examples,
+		}
+		if len(resp.Cookies) > 0 {
+			r.Headers["Set-Cookie"] = newSetCookieHeader(resp.Cookies)
+		}
+
+		examples := make(map[string]*Example, len(resp.Examples))
+		for _, exp := range resp.Examples {
+			examples[exp.Mimetype.V()] = &Example{
+				Summary: exp.Summary.V(),
+				Value:   ExampleValue(exp.Content.Value.Value),
 			}
 		}
-	} // end for doc.Apis
+		r.Content[resp.Mimetype.V()] = &MediaType{
+			Schema:   newSchemaFromRequest(doc, resp, true),
+			Examples: examples,
+			Encoding: newEncoding(resp),
+		}
+	}
 
-	return nil
+	return ret
+}
+
+// 为表单类型的内容生成 encoding 对象
+//
+// 数组以重复字段名的方式提交，string.binary 类型的字段在 multipart/form-data
+// 中以文件的方式提交，其它情况下返回 nil。
+func newEncoding(r *ast.Request) map[string]*Encoding {
+	mimetype := r.Mimetype.V()
+	if mimetype != formMimetype && mimetype != multipartMimetype {
+		return nil
+	}
+
+	p := r.Param().Resolve()
+	if p.Type.V() != ast.TypeObject {
+		return nil
+	}
+
+	encoding := make(map[string]*Encoding, len(p.Items))
+	for _, item := range p.Items {
+		en := &Encoding{Style: Style{Style: StyleForm, Explode: true}}
+		switch {
+		case mimetype == multipartMimetype && item.Resolve().Type.V() == ast.TypeBinary:
+			en.ContentType = "application/octet-stream"
+		case item.Array.V():
+		default:
+			continue
+		}
+		encoding[item.Name.V()] = en
+	}
+
+	if len(encoding) == 0 {
+		return nil
+	}
+	return encoding
+}
+
+// openapi 无法描述返回的 cookie，只能将其说明合并到 Set-Cookie 报头中。
+func newSetCookieHeader(cookies []*ast.Param) *Header {
+	desc := make([]string, 0, len(cookies))
+	for _, c := range cookies {
+		desc = append(desc, c.Name.V()+": "+getDescription(c.Description, c.Summary))
+	}
+
+	return &Header{
+		Style:       Style{Style: StyleSimple},
+		Description: strings.Join(desc, "\n"),
+		Schema:      &Schema{Type: TypeString},
+	}
+}
+
+func newHeaderParameter(doc *ast.APIDoc, param *ast.Param) *Parameter {
+	return &Parameter{
+		Style:       Style{Style: StyleSimple},
+		Name:        param.Name.V(),
+		IN:          ParameterINHeader,
+		Description: getDescription(param.Description, param.Summary),
+		Required:    !param.Optional.V(),
+		Schema:      newSchema(doc, param, true),
+	}
+}
+
+func newCookieParameter(doc *ast.APIDoc, param *ast.Param) *Parameter {
+	return &Parameter{
+		Style:       Style{Style: StyleForm},
+		Name:        param.Name.V(),
+		IN:          ParameterINCookie,
+		Description: getDescription(param.Description, param.Summary),
+		Required:    !param.Optional.V(),
+		Schema:      newSchema(doc, param, true),
+	}
 }
 
-func setOperationParams(doc *ast.APIDoc, operation *Operation, api *ast.API) {
-	l := len(api.Path.Params) + len(api.Path.Queries)
-	operation.Parameters = make([]*Parameter, 0, l)
+func setOperationParams(doc *ast.APIDoc, operation *Operation, path *ast.Path, headers, cookies []*ast.Param, requests []*ast.Request) {
+	var l int
+	if path != nil {
+		l = len(path.Params) + len(path.Queries)
+	}
+	operation.Parameters = make([]*Parameter, 0, l+len(headers)+len(cookies))
+
+	if path != nil {
+		for _, param := range path.Params {
+			operation.Parameters = append(operation.Parameters, &Parameter{
+				Name:        param.Name.V(),
+				IN:          ParameterINPath,
+				Description: getDescription(param.Description, param.Summary),
+				Required:    !param.Optional.V(),
+				Schema:      newSchema(doc, param, true),
+			})
+		}
+
+		for _, param := range path.Queries {
+			operation.Parameters = append(operation.Parameters, &Parameter{
+				Name:        param.Name.V(),
+				IN:          ParameterINQuery,
+				Description: getDescription(param.Description, param.Summary),
+				Required:    !param.Optional.V(),
+				Schema:      newSchema(doc, param, true),
+			})
+		}
+	}
 
-	for _, param := range api.Path.Params {
-		operation.Parameters = append(operation.Parameters, &Parameter{
-			Name:        param.Name.V(),
-			IN:          ParameterINPath,
-			Description: getDescription(param.Description, param.Summary),
-			Required:    !param.Optional.V(),
-			Schema:      newSchema(doc, param, true),
-		})
+	for _, param := range headers {
+		operation.Parameters = append(operation.Parameters, newHeaderParameter(doc, param))
 	}
 
-	for _, param := range api.Path.Queries {
-		operation.Parameters = append(operation.Parameters, &Parameter{
-			Name:        param.Name.V(),
-			IN:          ParameterINQuery,
-			Description: getDescription(param.Description, param.Summary),
-			Required:    !param.Optional.V(),
-			Schema:      newSchema(doc, param, true),
-		})
+	for _, param := range cookies {
+		operation.Parameters = append(operation.Parameters, newCookieParameter(doc, param))
 	}
 
 	// 将各个类型的 Request 中的报头都集中到 operation.Parameters
-	for _, r := range api.Requests {
+	for _, r := range requests {
 		for _, param := range r.Headers {
 			operation.Parameters = append(operation.Parameters, &Parameter{
 				Style:       Style{Style: StyleSimple},
@@ -207,6 +405,10 @@ func setOperationParams(doc *ast.APIDoc, operation *Operation, api *ast.API) {
 				Description: getDescription(param.Description, param.Summary),
 			})
 		}
+
+		for _, param := range r.Cookies {
+			operation.Parameters = append(operation.Parameters, newCookieParameter(doc, param))
+		}
 	}
 }
 
@@ -272,8 +474,10 @@ func setOperation(path *PathItem, method string) (*Operation, *core.Error) {
 }
 
 // JSON 输出 JSON 格式数据
-func JSON(doc *ast.APIDoc) ([]byte, error) {
-	openapi, err := convert(doc)
+//
+// ref 表示是否将结构相同的 schema 提取到 components.schemas 中，并以 $ref 的形式引用。
+func JSON(doc *ast.APIDoc, ref bool) ([]byte, error) {
+	openapi, err := convert(doc, ref)
 	if err != nil {
 		return nil, err
 	}
@@ -282,8 +486,10 @@ func JSON(doc *ast.APIDoc) ([]byte, error) {
 }
 
 // YAML 输出 YAML 格式数据
-func YAML(doc *ast.APIDoc) ([]byte, error) {
-	openapi, err := convert(doc)
+//
+// ref 表示是否将结构相同的 schema 提取到 components.schemas 中，并以 $ref 的形式引用。
+func YAML(doc *ast.APIDoc, ref bool) ([]byte, error) {
+	openapi, err := convert(doc, ref)
 	if err != nil {
 		return nil, err
 	}
@@ -6,17 +6,21 @@ import (
 	"encoding/json"
 	"net/http"
 	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/issue9/assert"
 
 	"github.com/caixw/apidoc/v7/core"
+	"github.com/caixw/apidoc/v7/core/messagetest"
+	"github.com/caixw/apidoc/v7/internal/ast"
 	"github.com/caixw/apidoc/v7/internal/ast/asttest"
+	"github.com/caixw/apidoc/v7/internal/xmlenc"
 )
 
 func TestJSON(t *testing.T) {
 	a := assert.New(t)
-	data, err := JSON(asttest.Get())
+	data, err := JSON(asttest.Get(), false)
 	a.NotError(err).NotNil(data)
 
 	openapi := &OpenAPI{}
@@ -40,6 +44,330 @@ func TestJSON(t *testing.T) {
 
 func TestYAML(t *testing.T) {
 	a := assert.New(t)
-	data, err := YAML(asttest.Get())
+	data, err := YAML(asttest.Get(), true)
 	a.NotError(err).NotNil(data)
 }
+
+func TestConvert_components(t *testing.T) {
+	a := assert.New(t)
+
+	doc := asttest.Get()
+	doc.Headers = []*ast.Param{
+		{
+			Type:    &ast.TypeAttribute{Value: xmlenc.String{Value: ast.TypeString}},
+			Name:    &ast.Attribute{Value: xmlenc.String{Value: "x-token"}},
+			Summary: &ast.Attribute{Value: xmlenc.String{Value: "token"}},
+		},
+	}
+	doc.Responses = []*ast.Request{
+		{
+			Status:   &ast.StatusAttribute{Value: ast.Number{Int: http.StatusInternalServerError}},
+			Mimetype: &ast.Attribute{Value: xmlenc.String{Value: "application/json"}},
+			Type:     &ast.TypeAttribute{Value: xmlenc.String{Value: ast.TypeString}},
+		},
+		{ // 与 api 中的状态码相同，不会被引用
+			Status: &ast.StatusAttribute{Value: ast.Number{Int: http.StatusOK}},
+			Type:   &ast.TypeAttribute{Value: xmlenc.String{Value: ast.TypeString}},
+		},
+	}
+	doc.APIs[0].Callback = &ast.Callback{
+		Method: &ast.MethodAttribute{Value: xmlenc.String{Value: http.MethodPost}},
+		Requests: []*ast.Request{
+			{
+				Mimetype: &ast.Attribute{Value: xmlenc.String{Value: "application/json"}},
+				Type:     &ast.TypeAttribute{Value: xmlenc.String{Value: ast.TypeString}},
+			},
+		},
+		Responses: []*ast.Request{
+			{
+				Status: &ast.StatusAttribute{Value: ast.Number{Int: http.StatusOK}},
+				Type:   &ast.TypeAttribute{Value: xmlenc.String{Value: ast.TypeString}},
+			},
+		},
+	}
+
+	openapi, err := convert(doc, false)
+	a.NotError(err).NotNil(openapi)
+
+	a.NotNil(openapi.Components).
+		Equal(2, len(openapi.Components.Responses)).
+		Equal(1, len(openapi.Components.Parameters)).
+		Equal(openapi.Components.Responses["500"].Description, http.StatusText(http.StatusInternalServerError))
+
+	get := openapi.Paths["/users"].Get
+	a.Equal(get.Responses["500"].Ref, "#/components/responses/500").
+		Empty(get.Responses["200"].Ref)
+	last := get.Parameters[len(get.Parameters)-1]
+	a.Equal(last.Ref, "#/components/parameters/x-token")
+
+	a.Equal(1, len(get.Callbacks))
+	callback := get.Callbacks["callback"][callbackExpression]
+	a.NotNil(callback).
+		NotNil(callback.Post).
+		NotNil(callback.Post.RequestBody).
+		Equal(1, len(callback.Post.Responses))
+
+	post := openapi.Paths["/users"].Post
+	a.Equal(post.Responses["500"].Ref, "#/components/responses/500").
+		Equal(post.Responses["200"].Ref, "#/components/responses/200").
+		Empty(post.Callbacks)
+}
+
+func TestConvert_types(t *testing.T) {
+	a := assert.New(t)
+
+	rslt := messagetest.NewMessageHandler()
+	doc := &ast.APIDoc{}
+	doc.Parse(rslt.Handler, core.Block{Data: []byte(`<apidoc version="1.0.0">
+	<title>title</title>
+	<mimetype>application/json</mimetype>
+	<type name="User" type="object" summary="user">
+		<param name="id" type="number" summary="id" />
+		<param name="name" type="string" summary="name" />
+	</type>
+	<api method="GET">
+		<path path="/users" />
+		<response status="200" type="#User" array="true" mimetype="application/json" />
+	</api>
+	<api method="POST">
+		<path path="/users" />
+		<request type="#User" mimetype="application/json" />
+		<response status="201" type="object" mimetype="application/json">
+			<param name="user" type="#User" summary="user" />
+		</response>
+	</api>
+</apidoc>`)})
+	rslt.Handler.Stop()
+	a.Empty(rslt.Errors)
+
+	openapi, err := convert(doc, true)
+	a.NotError(err).NotNil(openapi)
+
+	a.NotNil(openapi.Components).
+		Equal(1, len(openapi.Components.Schemas))
+	user := openapi.Components.Schemas["User"]
+	a.NotNil(user).
+		Equal(2, len(user.Properties)).
+		Nil(user.XML)
+
+	get := openapi.Paths["/users"].Get
+	s := get.Responses["200"].Content["application/json"].Schema
+	a.Equal(s.Type, TypeArray).Equal(s.Items.Ref, "#/components/schemas/User")
+
+	post := openapi.Paths["/users"].Post
+	a.Equal(post.RequestBody.Content["application/json"].Schema.Ref, "#/components/schemas/User")
+	s = post.Responses["201"].Content["application/json"].Schema
+	a.Equal(s.Properties["user"].Ref, "#/components/schemas/User")
+}
+
+func TestConvert_union(t *testing.T) {
+	a := assert.New(t)
+
+	rslt := messagetest.NewMessageHandler()
+	doc := &ast.APIDoc{}
+	doc.Parse(rslt.Handler, core.Block{Data: []byte(`<apidoc version="1.0.0">
+	<title>title</title>
+	<mimetype>application/json</mimetype>
+	<type name="Dog" type="object" summary="dog">
+		<param name="bark" type="bool" summary="bark" />
+	</type>
+	<type name="Pet" type="object" summary="pet" discriminator="kind">
+		<param name="kind" type="string" summary="kind" />
+		<one-of name="dog" type="#Dog" summary="dog" />
+		<one-of name="cat" type="object" summary="cat">
+			<param name="meow" type="bool" summary="meow" />
+		</one-of>
+	</type>
+	<api method="GET">
+		<path path="/pets" />
+		<response status="200" type="object" mimetype="application/json">
+			<any-of name="dog" type="#Dog" summary="dog" />
+			<any-of name="pet" type="#Pet" summary="pet" />
+		</response>
+	</api>
+	<api method="POST">
+		<path path="/pets" />
+		<request type="object" mimetype="application/json" discriminator="kind">
+			<param name="kind" type="string" summary="kind" />
+			<one-of name="cat" type="object" summary="cat">
+				<param name="meow" type="bool" summary="meow" />
+			</one-of>
+			<one-of name="dog" type="object" summary="dog">
+				<param name="name" type="string" summary="name" />
+			</one-of>
+		</request>
+	</api>
+</apidoc>`)})
+	rslt.Handler.Stop()
+	a.Empty(rslt.Errors)
+
+	openapi, err := convert(doc, true)
+	a.NotError(err).NotNil(openapi)
+
+	pet := openapi.Components.Schemas["Pet"]
+	a.NotNil(pet).
+		Equal(1, len(pet.Properties)).
+		Equal(2, len(pet.OneOf)).
+		Equal(pet.OneOf[0].Ref, "#/components/schemas/Dog").
+		Equal(pet.OneOf[1].Ref, "#/components/schemas/Cat").
+		Equal(pet.Discriminator, &Discriminator{
+			PropertyName: "kind",
+			Mapping: map[string]string{
+				"dog": "#/components/schemas/Dog",
+				"cat": "#/components/schemas/Cat",
+			},
+		})
+	a.Equal(1, len(openapi.Components.Schemas["Cat"].Properties)).
+		NotNil(openapi.Components.Schemas["Cat"].Properties["meow"])
+
+	s := openapi.Paths["/pets"].Get.Responses["200"].Content["application/json"].Schema
+	a.Empty(s.OneOf).
+		Equal(2, len(s.AnyOf)).
+		Equal(s.AnyOf[1].Ref, "#/components/schemas/Pet").
+		Nil(s.Discriminator)
+
+	// 结构相同的分支共用同一个对象，名称相同但结构不同的分支添加 hash 值
+	s = openapi.Paths["/pets"].Post.RequestBody.Content["application/json"].Schema
+	a.Equal(2, len(s.OneOf)).
+		Equal(s.OneOf[0].Ref, "#/components/schemas/Cat").
+		True(strings.HasPrefix(s.OneOf[1].Ref, "#/components/schemas/Dog_")).
+		Equal(s.Discriminator.Mapping, map[string]string{
+			"cat": "#/components/schemas/Cat",
+			"dog": s.OneOf[1].Ref,
+		})
+	a.NotNil(openapi.Components.Schemas[strings.TrimPrefix(s.OneOf[1].Ref, componentsSchemasRef)])
+}
+
+func TestConvert_form(t *testing.T) {
+	a := assert.New(t)
+
+	rslt := messagetest.NewMessageHandler()
+	doc := &ast.APIDoc{}
+	doc.Parse(rslt.Handler, core.Block{Data: []byte(`<apidoc version="1.0.0">
+	<title>title</title>
+	<mimetype>application/json</mimetype>
+	<api method="POST">
+		<path path="/upload" />
+		<request type="object" mimetype="multipart/form-data">
+			<param name="name" type="string" summary="name" />
+			<param name="tags" type="string" array="true" summary="tags" />
+			<param name="file" type="string.binary" summary="file" />
+		</request>
+		<request type="object" mimetype="application/x-www-form-urlencoded">
+			<param name="name" type="string" summary="name" />
+			<param name="file" type="string.binary" summary="file" />
+		</request>
+		<response status="200" type="string" mimetype="application/json" />
+	</api>
+</apidoc>`)})
+	rslt.Handler.Stop()
+	a.Empty(rslt.Errors)
+
+	openapi, err := convert(doc, true)
+	a.NotError(err).NotNil(openapi)
+
+	content := openapi.Paths["/upload"].Post.RequestBody.Content
+	multipart := content["multipart/form-data"]
+	a.NotNil(multipart).
+		Equal(multipart.Schema.Properties["file"].Format, FormatBinary).
+		Equal(2, len(multipart.Encoding)).
+		Equal(multipart.Encoding["file"].ContentType, "application/octet-stream").
+		Equal(multipart.Encoding["tags"].Style, Style{Style: StyleForm, Explode: true})
+
+	form := content["application/x-www-form-urlencoded"]
+	a.NotNil(form).Nil(form.Encoding)
+
+	a.Nil(openapi.Paths["/upload"].Post.Responses["200"].Content["application/json"].Encoding)
+}
+
+func TestConvert_cookies(t *testing.T) {
+	a := assert.New(t)
+
+	rslt := messagetest.NewMessageHandler()
+	doc := &ast.APIDoc{}
+	doc.Parse(rslt.Handler, core.Block{Data: []byte(`<apidoc version="1.0.0">
+	<title>title</title>
+	<mimetype>application/json</mimetype>
+	<api method="GET">
+		<path path="/session" />
+		<cookie name="sid" type="string" summary="sid" />
+		<request type="string" mimetype="application/json">
+			<cookie name="lang" type="string" optional="true" summary="lang" />
+		</request>
+		<response status="200" type="string" mimetype="application/json">
+			<cookie name="sid" type="string" summary="new sid" />
+			<cookie name="uid" type="number" summary="uid" />
+		</response>
+	</api>
+</apidoc>`)})
+	rslt.Handler.Stop()
+	a.Empty(rslt.Errors)
+
+	openapi, err := convert(doc, true)
+	a.NotError(err).NotNil(openapi)
+
+	get := openapi.Paths["/session"].Get
+	a.Equal(2, len(get.Parameters))
+	sid := get.Parameters[0]
+	a.Equal(sid.Name, "sid").
+		Equal(sid.IN, ParameterINCookie).
+		True(sid.Required).
+		Equal(sid.Schema.Type, TypeString)
+	lang := get.Parameters[1]
+	a.Equal(lang.Name, "lang").
+		Equal(lang.IN, ParameterINCookie).
+		False(lang.Required)
+
+	header := get.Responses["200"].Headers["Set-Cookie"]
+	a.NotNil(header).Equal(header.Description, "sid: new sid\nuid: uid")
+}
+
+func TestConvert_securities(t *testing.T) {
+	a := assert.New(t)
+
+	rslt := messagetest.NewMessageHandler()
+	doc := &ast.APIDoc{}
+	doc.Parse(rslt.Handler, core.Block{Data: []byte(`<apidoc version="1.0.0">
+	<title>title</title>
+	<mimetype>application/json</mimetype>
+	<security name="token" type="apikey" in="header" key="x-token" summary="token" />
+	<security name="bearer" type="http" scheme="bearer" bearer-format="JWT" />
+	<security name="oauth" type="oauth2">
+		<flow type="authorization-code" authorization-url="https://example.com/auth" token-url="https://example.com/token">
+			<scope name="read" summary="read" />
+			<scope name="write" summary="write" />
+		</flow>
+	</security>
+	<api method="GET">
+		<path path="/users" />
+		<security name="token" />
+		<security name="oauth"><scope>read</scope></security>
+		<response status="200" type="string" mimetype="application/json" />
+	</api>
+</apidoc>`)})
+	rslt.Handler.Stop()
+	a.Empty(rslt.Errors)
+
+	openapi, err := convert(doc, true)
+	a.NotError(err).NotNil(openapi)
+
+	schemes := openapi.Components.SecuritySchemes
+	a.Equal(3, len(schemes))
+	a.Equal(schemes["token"].Type, SecurityTypeAPIKey).
+		Equal(schemes["token"].IN, SecurityInHeader).
+		Equal(schemes["token"].Name, "x-token").
+		Equal(schemes["token"].Description, "token")
+	a.Equal(schemes["bearer"].Type, SecurityTypeHTTP).
+		Equal(schemes["bearer"].Scheme, "bearer").
+		Equal(schemes["bearer"].BearerFormat, "JWT")
+	flow := schemes["oauth"].Flows.AuthorizationCode
+	a.NotNil(flow).
+		Equal(flow.TokenURL, "https://example.com/token").
+		Equal(2, len(flow.Scopes))
+
+	get := openapi.Paths["/users"].Get
+	a.Equal(get.Security, []*SecurityRequirement{
+		{"token": []string{}},
+		{"oauth": []string{"read"}},
+	})
+}
@@ -36,7 +36,7 @@ type Operation struct {
 	Parameters   []*Parameter           `json:"parameters,omitempty" yaml:"parameters,omitempty"`
 	RequestBody  *RequestBody           `json:"requestBody,omitempty" yaml:"requestBody,omitempty"`
 	Responses    map[string]*Response   `json:"responses" yaml:"responses"`
-	Callbacks    map[string]*Callback   `json:"callbacks,omitempty" yaml:"callbacks,omitempty"`
+	Callbacks    map[string]Callback    `json:"callbacks,omitempty" yaml:"callbacks,omitempty"`
 	Deprecated   bool                   `json:"deprecated,omitempty" yaml:"deprecated,omitempty"`
 	Security     []*SecurityRequirement `json:"security,omitempty" yaml:"security,omitempty"`
 	Servers      []*Server              `json:"servers,omitempty" yaml:"servers,omitempty"`
@@ -59,6 +59,12 @@ type MediaType struct {
 	Encoding map[string]*Encoding `json:"encoding,omitempty" yaml:"encoding,omitempty"`
 }
 
+// 表单类型的 mimetype，仅此类内容的 MediaType 需要 Encoding
+const (
+	formMimetype      = "application/x-www-form-urlencoded"
+	multipartMimetype = "multipart/form-data"
+)
+
 // Encoding 定义编码
 //
 // 对父对象中的 Schema 中的一些字段的特殊定义
@@ -69,7 +75,9 @@ type Encoding struct {
 }
 
 // Callback Object
-type Callback PathItem
+//
+// 键名为运行时表达式，用于计算回调的地址。
+type Callback map[string]*PathItem
 
 // Response 每个 API 的返回信息
 type Response struct {
@@ -135,8 +143,7 @@ func (o *Operation) sanitize() *core.Error {
 	}
 
 	for name, call := range o.Callbacks {
-		p := (*PathItem)(call)
-		if err := p.sanitize(); err != nil {
+		if err := call.sanitize(); err != nil {
 			err.Field = "callbacks[" + name + "]." + err.Field
 			return err
 		}
@@ -152,6 +159,17 @@ func (o *Operation) sanitize() *core.Error {
 	return nil
 }
 
+func (c Callback) sanitize() *core.Error {
+	for exp, path := range c {
+		if err := path.sanitize(); err != nil {
+			err.Field = "[" + exp + "]." + err.Field
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (req *RequestBody) sanitize() *core.Error {
 	if len(req.Content) == 0 {
 		return core.NewError(locale.ErrIsEmpty, "content").WithField("content")
@@ -168,6 +186,10 @@ func (req *RequestBody) sanitize() *core.Error {
 }
 
 func (resp *Response) sanitize() *core.Error {
+	if resp.Ref != "" {
+		return nil
+	}
+
 	if resp.Description == "" {
 		return core.NewError(locale.ErrIsEmpty, "description").WithField("description")
 	}
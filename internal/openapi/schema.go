@@ -3,6 +3,12 @@
 package openapi
 
 import (
+	"encoding/json"
+	"reflect"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+
 	"github.com/caixw/apidoc/v7/core"
 	"github.com/caixw/apidoc/v7/internal/ast"
 )
@@ -17,6 +23,17 @@ const (
 	TypeBool     = "bool"
 	TypePassword = "password"
 	TypeArray    = "array"
+	TypeObject   = "object"
+
+	// 以下为 JSON Schema 中的类型，openapi 3.1 和 swagger 中会用到。
+	TypeNumber  = "number"
+	TypeBoolean = "boolean"
+	TypeNull    = "null"
+)
+
+// Schema.Format 的可选值
+const (
+	FormatBinary = "binary"
 )
 
 var typeMaps = map[string]string{
@@ -31,6 +48,7 @@ var typeMaps = map[string]string{
 	ast.TypeDate:     TypeString,
 	ast.TypeTime:     TypeString,
 	ast.TypeDateTime: TypeString,
+	ast.TypeBinary:   TypeString,
 }
 
 func fromDocType(t string) string {
@@ -39,15 +57,16 @@ func fromDocType(t string) string {
 
 // Schema 定义了输出和输出的数据类型
 type Schema struct {
-	Type string        `json:"type,omitempty" yaml:"type,omitempty"`
-	Enum []interface{} `json:"enum,omitempty" yaml:"enum,omitempty"`
+	Type   string        `json:"type,omitempty" yaml:"type,omitempty"`
+	Format string        `json:"format,omitempty" yaml:"format,omitempty"`
+	Enum   []interface{} `json:"enum,omitempty" yaml:"enum,omitempty"`
 
 	// 数值验证
-	MultipleOf       int  `json:"multipleOf,omitempty" yaml:"multipleOf,omitempty"`
-	Maximum          int  `json:"maximum,omitempty" yaml:"maximum,omitempty"`
-	ExclusiveMaximum bool `json:"exclusiveMaximum,omitempty" yaml:"exclusiveMaximum,omitempty"`
-	Minimum          int  `json:"minimum,omitempty" yaml:"minimum,omitempty"`
-	ExclusiveMinimum bool `json:"exclusiveMinimum,omitempty" yaml:"exclusiveMinimum,omitempty"`
+	MultipleOf       int      `json:"multipleOf,omitempty" yaml:"multipleOf,omitempty"`
+	Maximum          *float64 `json:"maximum,omitempty" yaml:"maximum,omitempty"`
+	ExclusiveMaximum bool     `json:"exclusiveMaximum,omitempty" yaml:"exclusiveMaximum,omitempty"`
+	Minimum          *float64 `json:"minimum,omitempty" yaml:"minimum,omitempty"`
+	ExclusiveMinimum bool     `json:"exclusiveMinimum,omitempty" yaml:"exclusiveMinimum,omitempty"`
 
 	// 字符串验证
 	MaxLength int    `json:"maxLength,omitempty" yaml:"maxLength,omitempty"`
@@ -63,14 +82,14 @@ type Schema struct {
 	Contains        *Schema `json:"contains,omitempty" yaml:"contains,omitempty"`
 
 	// 对象验证
-	MaxProperties        int                `json:"maxProperties,omitempty" yaml:"maxProperties,omitempty"`
-	MinProperties        int                `json:"minProperties,omitempty" yaml:"minProperties,omitempty"`
-	Required             []string           `json:"required,omitempty" yaml:"required,omitempty"`
-	Properties           map[string]*Schema `json:"properties,omitempty" yaml:"properties,omitempty"`
-	PatternProperties    map[string]*Schema `json:"patternProperties,omitempty" yaml:"patternProperties,omitempty"`
-	AdditionalProperties map[string]*Schema `json:"additionalProperties,omitempty" yaml:"additionalProperties,omitempty"`
-	Dependencies         map[string]*Schema `json:"dependencies,omitempty" yaml:"dependencies,omitempty"`
-	PropertyNames        *Schema            `json:"propertyNames,omitempty" yaml:"propertyNames,omitempty"`
+	MaxProperties        int                   `json:"maxProperties,omitempty" yaml:"maxProperties,omitempty"`
+	MinProperties        int                   `json:"minProperties,omitempty" yaml:"minProperties,omitempty"`
+	Required             []string              `json:"required,omitempty" yaml:"required,omitempty"`
+	Properties           map[string]*Schema    `json:"properties,omitempty" yaml:"properties,omitempty"`
+	PatternProperties    map[string]*Schema    `json:"patternProperties,omitempty" yaml:"patternProperties,omitempty"`
+	AdditionalProperties *AdditionalProperties `json:"additionalProperties,omitempty" yaml:"additionalProperties,omitempty"`
+	Dependencies         map[string]*Schema    `json:"dependencies,omitempty" yaml:"dependencies,omitempty"`
+	PropertyNames        *Schema               `json:"propertyNames,omitempty" yaml:"propertyNames,omitempty"`
 
 	AllOf []*Schema `json:"allOf,omitempty" yaml:"allOf,omitempty"`
 	AnyOf []*Schema `json:"anyOf,omitempty" yaml:"anyOf,omitempty"`
@@ -91,6 +110,126 @@ type Schema struct {
 	ExternalDocs  *ExternalDocumentation `json:"externalDocs,omitempty" yaml:"externalDocs,omitempty"`
 	Example       ExampleValue           `json:"example,omitempty" yaml:"example,omitempty"`
 	Deprecated    bool                   `json:"deprecated,omitempty" yaml:"deprecated,omitempty"`
+	Nullable      bool                   `json:"nullable,omitempty" yaml:"nullable,omitempty"`
+
+	// openapi 3.1 中用于描述二进制内容的类型
+	ContentMediaType string `json:"contentMediaType,omitempty" yaml:"contentMediaType,omitempty"`
+
+	// 是否以数组的形式输出 type，并在其中包含 null。
+	//
+	// openapi 3.1 采用 JSON Schema 2020-12 的语义，不再支持 nullable。
+	null bool
+
+	// 作为联合类型的分支时，该分支的名称，即 discriminator 对应的值。
+	//
+	// 由 OpenAPI.extractBranches 根据此值将分支提取到 components.schemas 中。
+	branch string
+}
+
+// MarshalJSON json.Marshaler
+func (s *Schema) MarshalJSON() ([]byte, error) {
+	type schema Schema
+	if !s.null {
+		return json.Marshal((*schema)(s))
+	}
+
+	// 外层的 Type 会覆盖 schema 中的同名字段
+	return json.Marshal(&struct {
+		*schema
+		Type []string `json:"type"`
+	}{schema: (*schema)(s), Type: s.types()})
+}
+
+// MarshalYAML yaml.Marshaler
+func (s *Schema) MarshalYAML() (interface{}, error) {
+	type schema Schema
+	if !s.null {
+		return (*schema)(s), nil
+	}
+
+	// yaml 不允许 inline 的对象中存在同名字段，只能通过 MapSlice 替换 type 的值。
+	ms := yaml.MapSlice{{Key: "type", Value: s.types()}}
+	v := reflect.ValueOf(s).Elem()
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		tag := f.Tag.Get("yaml")
+		if f.PkgPath != "" || tag == "" || tag == "-" { // 未导出或是不需要输出的字段
+			continue
+		}
+
+		name := strings.Split(tag, ",")[0]
+		if name == "type" {
+			continue
+		}
+
+		fv := v.Field(i)
+		if strings.HasSuffix(tag, ",omitempty") && isEmptyValue(fv) {
+			continue
+		}
+		ms = append(ms, yaml.MapItem{Key: name, Value: fv.Interface()})
+	}
+	return ms, nil
+}
+
+func isEmptyValue(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Slice, reflect.Map:
+		return v.Len() == 0
+	default:
+		return v.IsZero()
+	}
+}
+
+func (s *Schema) types() []string {
+	if s.Type == "" {
+		return []string{TypeNull}
+	}
+	return []string{s.Type, TypeNull}
+}
+
+// AdditionalProperties 表示 Schema.AdditionalProperties 的值
+//
+// 可以是布尔值或是 Schema 对象，Schema 不为空时，忽略 Bool 的值。
+type AdditionalProperties struct {
+	Bool   bool
+	Schema *Schema
+}
+
+// MarshalJSON json.Marshaler
+func (p AdditionalProperties) MarshalJSON() ([]byte, error) {
+	if p.Schema != nil {
+		return json.Marshal(p.Schema)
+	}
+	return json.Marshal(p.Bool)
+}
+
+// MarshalYAML yaml.Marshaler
+func (p AdditionalProperties) MarshalYAML() (interface{}, error) {
+	if p.Schema != nil {
+		return p.Schema, nil
+	}
+	return p.Bool, nil
+}
+
+// UnmarshalJSON json.Unmarshaler
+func (p *AdditionalProperties) UnmarshalJSON(data []byte) error {
+	if err := json.Unmarshal(data, &p.Bool); err == nil {
+		return nil
+	}
+
+	p.Schema = &Schema{}
+	return json.Unmarshal(data, p.Schema)
+}
+
+// UnmarshalYAML yaml.Unmarshaler
+func (p *AdditionalProperties) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if err := unmarshal(&p.Bool); err == nil {
+		return nil
+	}
+
+	p.Schema = &Schema{}
+	return unmarshal(p.Schema)
 }
 
 // XML 将 Schema 转换为 XML 的相关声明
@@ -103,8 +242,6 @@ type XML struct {
 }
 
 // Discriminator Object
-//
-// NOTE: 暂时未用到。
 type Discriminator struct {
 	PropertyName string            `json:"propertyName" yaml:"propertyName"`
 	Mapping      map[string]string `json:"mapping,omitempty" yaml:"mapping,omitempty"`
@@ -141,25 +278,46 @@ func newXML(doc *ast.APIDoc, p *ast.Param) *XML {
 	if xmlns := doc.XMLNamespace(prefix); xmlns != nil {
 		ns = xmlns.URN.V()
 	}
-	return &XML{
+	x := &XML{
 		Name:      p.Name.V(),
 		Namespace: ns,
 		Prefix:    prefix,
 		Attribute: p.XMLAttr.V(),
 		Wrapped:   p.XMLWrapped != nil && p.XMLWrapped.V() != "",
 	}
+	if *x == (XML{}) {
+		return nil
+	}
+	return x
 }
 
 // chkArray 是否需要检测当前类型是否为数组
 func newSchema(doc *ast.APIDoc, p *ast.Param, chkArray bool) *Schema {
 	if chkArray && p.Array.V() {
+		item := *p
+		item.Nullable = nil // nullable 表示数组本身可以为 null，而不是元素。
 		return &Schema{
-			Type:  TypeArray,
-			Items: newSchema(doc, p, false),
-			XML:   newXML(doc, p),
+			Type:     TypeArray,
+			Items:    newSchema(doc, &item, false),
+			XML:      newXML(doc, p),
+			MinItems: p.MinItems.IntValue(),
+			MaxItems: p.MaxItems.IntValue(),
+			Nullable: p.Nullable.V(),
 		}
 	}
 
+	// 引用了 TypeDef，指向 components.schemas 中的对象
+	if t := p.Type.TypeDef(); t != nil {
+		ref := &Schema{Ref: componentsSchemasRef + t.Name.V()}
+		if !p.Nullable.V() {
+			return ref
+		}
+
+		// $ref 会忽略同级的其它字段，只能通过 allOf 包装之后再指定 nullable。
+		return &Schema{AllOf: []*Schema{ref}, Nullable: true}
+	}
+	p = p.Resolve() // 无法解析的引用
+
 	s := &Schema{
 		Type:        fromDocType(p.Type.V()),
 		Title:       p.Summary.V(),
@@ -168,6 +326,16 @@ func newSchema(doc *ast.APIDoc, p *ast.Param, chkArray bool) *Schema {
 		Deprecated:  p.Deprecated != nil,
 		Required:    make([]string, 0, len(p.Items)),
 		XML:         newXML(doc, p),
+		Minimum:     numberValue(p.Min),
+		Maximum:     numberValue(p.Max),
+		MinLength:   p.MinLength.IntValue(),
+		MaxLength:   p.MaxLength.IntValue(),
+		Pattern:     p.Pattern.V(),
+		Nullable:    p.Nullable.V(),
+	}
+
+	if p.Type.V() == ast.TypeBinary {
+		s.Format = FormatBinary
 	}
 
 	// enum
@@ -178,6 +346,14 @@ func newSchema(doc *ast.APIDoc, p *ast.Param, chkArray bool) *Schema {
 		}
 	}
 
+	// AdditionalProperties
+	if p.Type.V() == ast.TypeMap {
+		s.Type = TypeObject
+		s.Required = nil
+		s.AdditionalProperties = &AdditionalProperties{Schema: newSchema(doc, p.Items[0], true)}
+		return s
+	}
+
 	// Properties / Required
 	if len(p.Items) > 0 { // 如果是对象，类型改为空
 		s.Type = ""
@@ -189,16 +365,53 @@ func newSchema(doc *ast.APIDoc, p *ast.Param, chkArray bool) *Schema {
 				name = item.XMLWrapped.V()
 			}
 
-			s.Properties[name] = newSchema(doc, item, true)
+			prop := newSchema(doc, item, true)
+			if prop.XML != nil && prop.XML.Name == name { // 与字段名相同，可以省略。
+				prop.XML.Name = ""
+				if *prop.XML == (XML{}) {
+					prop.XML = nil
+				}
+			}
+			s.Properties[name] = prop
 			if !item.Optional.V() {
 				s.Required = append(s.Required, item.Name.V())
 			}
 		}
 	}
 
+	// OneOf / AnyOf / Discriminator
+	s.OneOf = newBranchSchemas(doc, p.OneOf)
+	s.AnyOf = newBranchSchemas(doc, p.AnyOf)
+	if p.Discriminator != nil { // mapping 由 OpenAPI.extractBranches 生成
+		s.Discriminator = &Discriminator{PropertyName: p.Discriminator.V()}
+	}
+
 	return s
 }
 
+func newBranchSchemas(doc *ast.APIDoc, branches []*ast.Param) []*Schema {
+	if len(branches) == 0 {
+		return nil
+	}
+
+	schemas := make([]*Schema, 0, len(branches))
+	for _, b := range branches {
+		s := newSchema(doc, b, false)
+		s.branch = b.Name.V()
+		schemas = append(schemas, s)
+	}
+	return schemas
+}
+
+// 未指定的值返回 nil，以区分值为 0 的情况。
+func numberValue(num *ast.NumberAttribute) *float64 {
+	if num == nil {
+		return nil
+	}
+	v := num.V()
+	return &v
+}
+
 // chkArray 是否需要检测当前类型是否为数组
 func newSchemaFromRequest(doc *ast.APIDoc, p *ast.Request, chkArray bool) *Schema {
 	return newSchema(doc, p.Param(), chkArray)
@@ -3,9 +3,11 @@
 package openapi
 
 import (
+	"encoding/json"
 	"testing"
 
 	"github.com/issue9/assert"
+	"gopkg.in/yaml.v2"
 
 	"github.com/caixw/apidoc/v7/internal/ast"
 	"github.com/caixw/apidoc/v7/internal/xmlenc"
@@ -75,3 +77,115 @@ func TestNewSchema(t *testing.T) {
 
 	a.NotError(output.sanitize())
 }
+
+func TestNewSchema_constraint(t *testing.T) {
+	a := assert.New(t)
+
+	d := &ast.APIDoc{}
+	input := &ast.Param{
+		Constraint: ast.Constraint{
+			Min:      &ast.NumberAttribute{Value: ast.Number{Int: 0}},
+			Max:      &ast.NumberAttribute{Value: ast.Number{Float: 9.5, IsFloat: true}},
+			MinItems: &ast.NumberAttribute{Value: ast.Number{Int: 1}},
+			MaxItems: &ast.NumberAttribute{Value: ast.Number{Int: 10}},
+		},
+		Name:    &ast.Attribute{Value: xmlenc.String{Value: "name"}},
+		Type:    &ast.TypeAttribute{Value: xmlenc.String{Value: ast.TypeNumber}},
+		Array:   &ast.BoolAttribute{Value: ast.Bool{Value: true}},
+		Summary: &ast.Attribute{Value: xmlenc.String{Value: "summary"}},
+	}
+	output := newSchema(d, input, true)
+	a.Equal(output.Type, TypeArray).
+		Equal(output.MinItems, 1).
+		Equal(output.MaxItems, 10).
+		Nil(output.Minimum)
+	a.Equal(*output.Items.Minimum, 0.0).
+		Equal(*output.Items.Maximum, 9.5).
+		Equal(output.Items.MinItems, 0)
+
+	input = &ast.Param{
+		Constraint: ast.Constraint{
+			MinLength: &ast.NumberAttribute{Value: ast.Number{Int: 1}},
+			MaxLength: &ast.NumberAttribute{Value: ast.Number{Int: 10}},
+			Pattern:   &ast.Attribute{Value: xmlenc.String{Value: "^[a-z]+$"}},
+		},
+		Name:    &ast.Attribute{Value: xmlenc.String{Value: "name"}},
+		Type:    &ast.TypeAttribute{Value: xmlenc.String{Value: ast.TypeString}},
+		Summary: &ast.Attribute{Value: xmlenc.String{Value: "summary"}},
+	}
+	output = newSchema(d, input, true)
+	a.Equal(output.Type, TypeString).
+		Equal(output.MinLength, 1).
+		Equal(output.MaxLength, 10).
+		Equal(output.Pattern, "^[a-z]+$").
+		Nil(output.Minimum).
+		Nil(output.Maximum)
+}
+
+func TestNewSchema_map(t *testing.T) {
+	a := assert.New(t)
+
+	d := &ast.APIDoc{}
+	input := &ast.Param{
+		Name:    &ast.Attribute{Value: xmlenc.String{Value: "titles"}},
+		Type:    &ast.TypeAttribute{Value: xmlenc.String{Value: ast.TypeMap}},
+		Summary: &ast.Attribute{Value: xmlenc.String{Value: "summary"}},
+		Items: []*ast.Param{
+			{
+				Name:  &ast.Attribute{Value: xmlenc.String{Value: "value"}},
+				Type:  &ast.TypeAttribute{Value: xmlenc.String{Value: ast.TypeNumber}},
+				Array: &ast.BoolAttribute{Value: ast.Bool{Value: true}},
+			},
+		},
+	}
+	output := newSchema(d, input, true)
+	a.Equal(output.Type, TypeObject).
+		Empty(output.Properties).
+		Empty(output.Required).
+		NotNil(output.AdditionalProperties).
+		Equal(output.AdditionalProperties.Schema.Type, TypeArray).
+		Equal(output.AdditionalProperties.Schema.Items.Type, TypeDouble)
+}
+
+func TestNewSchema_nullable(t *testing.T) {
+	a := assert.New(t)
+
+	d := &ast.APIDoc{}
+	input := &ast.Param{
+		Name:     &ast.Attribute{Value: xmlenc.String{Value: "name"}},
+		Type:     &ast.TypeAttribute{Value: xmlenc.String{Value: ast.TypeString}},
+		Nullable: &ast.BoolAttribute{Value: ast.Bool{Value: true}},
+	}
+	output := newSchema(d, input, true)
+	a.Equal(output.Type, TypeString).True(output.Nullable)
+
+	// 数组
+	input.Array = &ast.BoolAttribute{Value: ast.Bool{Value: true}}
+	output = newSchema(d, input, true)
+	a.Equal(output.Type, TypeArray).
+		True(output.Nullable).
+		Equal(output.Items.Type, TypeString).
+		False(output.Items.Nullable)
+}
+
+func TestAdditionalProperties(t *testing.T) {
+	a := assert.New(t)
+
+	s := &Schema{}
+	a.NotError(json.Unmarshal([]byte(`{"additionalProperties":false}`), s))
+	a.False(s.AdditionalProperties.Bool).Nil(s.AdditionalProperties.Schema)
+	a.NotError(json.Unmarshal([]byte(`{"additionalProperties":{"type":"string"}}`), s))
+	a.Equal(s.AdditionalProperties.Schema.Type, TypeString)
+
+	data, err := json.Marshal(s)
+	a.NotError(err).Equal(string(data), `{"additionalProperties":{"type":"string"}}`)
+
+	s = &Schema{}
+	a.NotError(yaml.Unmarshal([]byte("additionalProperties: true"), s))
+	a.True(s.AdditionalProperties.Bool).Nil(s.AdditionalProperties.Schema)
+	a.NotError(yaml.Unmarshal([]byte("additionalProperties:\n  type: string"), s))
+	a.Equal(s.AdditionalProperties.Schema.Type, TypeString)
+
+	data, err = yaml.Marshal(&Schema{AdditionalProperties: &AdditionalProperties{Bool: true}})
+	a.NotError(err).Equal(string(data), "additionalProperties: true\n")
+}
@@ -2,6 +2,8 @@
 
 package openapi
 
+import "github.com/caixw/apidoc/v7/internal/ast"
+
 // SecurityScheme.IN 的可选值
 const (
 	SecurityInQuery  = "query"
@@ -11,7 +13,7 @@ const (
 
 // Security.Type 的可选值
 const (
-	SecurityTypeAPIKey        = "apikey"
+	SecurityTypeAPIKey        = "apiKey"
 	SecurityTypeHTTP          = "http"
 	SecurityTypeOAuth2        = "oauth2"
 	SecurityTypeOpenIDConnect = "openIdConnect"
@@ -28,12 +30,12 @@ type SecurityRequirement map[string][]string
 type SecurityScheme struct {
 	Type             string      `json:"type" yaml:"type"`
 	Description      string      `json:"description,omitempty" yaml:"description,omitempty"`
-	Name             string      `json:"name" yaml:"name"` // 报头或是 cookie 的名称
-	IN               string      `json:"in" yaml:"in"`     // 位置, header, query 和 cookie
-	Scheme           string      `json:"scheme" yaml:"scheme"`
+	Name             string      `json:"name,omitempty" yaml:"name,omitempty"` // 报头或是 cookie 的名称
+	IN               string      `json:"in,omitempty" yaml:"in,omitempty"`     // 位置, header, query 和 cookie
+	Scheme           string      `json:"scheme,omitempty" yaml:"scheme,omitempty"`
 	BearerFormat     string      `json:"bearerFormat,omitempty" yaml:"bearerFormat,omitempty"`
-	Flows            *OAuthFlows `json:"flows" yaml:"flows"`
-	OpenIDConnectURL string      `json:"openIdConnectUrl" yaml:"openIdConnectUrl"`
+	Flows            *OAuthFlows `json:"flows,omitempty" yaml:"flows,omitempty"`
+	OpenIDConnectURL string      `json:"openIdConnectUrl,omitempty" yaml:"openIdConnectUrl,omitempty"`
 
 	Ref string `json:"$ref,omitempty" yaml:"$ref,omitempty"`
 }
@@ -53,3 +55,65 @@ type OAuthFlow struct {
 	RefreshURL       string            `json:"refreshUrl,omitempty" yaml:"refreshUrl,omitempty"`
 	Scopes           map[string]string `json:"scopes,omitempty" yaml:"scopes,omitempty"`
 }
+
+func newSecurityScheme(s *ast.Security) *SecurityScheme {
+	scheme := &SecurityScheme{Description: getDescription(s.Description, s.Summary)}
+
+	switch s.Type.V() {
+	case ast.SecurityTypeAPIKey:
+		scheme.Type = SecurityTypeAPIKey
+		scheme.Name = s.Key.V()
+		scheme.IN = s.In.V()
+	case ast.SecurityTypeHTTP:
+		scheme.Type = SecurityTypeHTTP
+		scheme.Scheme = s.Scheme.V()
+		scheme.BearerFormat = s.BearerFormat.V()
+	case ast.SecurityTypeOAuth2:
+		scheme.Type = SecurityTypeOAuth2
+		scheme.Flows = &OAuthFlows{}
+		for _, f := range s.Flows {
+			flow := &OAuthFlow{
+				AuthorizationURL: f.AuthorizationURL.V(),
+				TokenURL:         f.TokenURL.V(),
+				RefreshURL:       f.RefreshURL.V(),
+				Scopes:           make(map[string]string, len(f.Scopes)),
+			}
+			for _, scope := range f.Scopes {
+				flow.Scopes[scope.Name.V()] = scope.Summary.V()
+			}
+
+			switch f.Type.V() {
+			case ast.OAuthFlowImplicit:
+				scheme.Flows.Implicit = flow
+			case ast.OAuthFlowPassword:
+				scheme.Flows.Password = flow
+			case ast.OAuthFlowClientCredentials:
+				scheme.Flows.ClientCredentials = flow
+			case ast.OAuthFlowAuthorizationCode:
+				scheme.Flows.AuthorizationCode = flow
+			}
+		}
+	case ast.SecurityTypeOpenIDConnect:
+		scheme.Type = SecurityTypeOpenIDConnect
+		scheme.OpenIDConnectURL = s.OpenIDConnectURL.V()
+	}
+
+	return scheme
+}
+
+// 每个 ast.SecurityValue 对应一个 SecurityRequirement，满足其中任意一个即可。
+func newSecurityRequirements(securities []*ast.SecurityValue) []*SecurityRequirement {
+	if len(securities) == 0 {
+		return nil
+	}
+
+	reqs := make([]*SecurityRequirement, 0, len(securities))
+	for _, s := range securities {
+		scopes := make([]string, 0, len(s.Scopes))
+		for _, scope := range s.Scopes {
+			scopes = append(scopes, scope.V())
+		}
+		reqs = append(reqs, &SecurityRequirement{s.Name.V(): scopes})
+	}
+	return reqs
+}
@@ -0,0 +1,787 @@
+// SPDX-License-Identifier: MIT
+
+package openapi
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+
+	"github.com/caixw/apidoc/v7/core"
+	"github.com/caixw/apidoc/v7/internal/ast"
+	"github.com/caixw/apidoc/v7/internal/locale"
+)
+
+// SwaggerVersion swagger 的版本号
+const SwaggerVersion = "2.0"
+
+// swagger 中可复用对象的引用地址前缀
+const (
+	swaggerDefinitionsRef = "#/definitions/"
+	swaggerParametersRef  = "#/parameters/"
+	swaggerResponsesRef   = "#/responses/"
+)
+
+// SwaggerParameter.IN 在 swagger 中独有的值
+const (
+	SwaggerINBody     = "body"
+	SwaggerINFormData = "formData"
+)
+
+// SwaggerItems.CollectionFormat 的可选值
+const (
+	CollectionFormatCSV   = "csv"
+	CollectionFormatMulti = "multi"
+)
+
+// SwaggerSecurityScheme.Type 的可选值
+const (
+	SwaggerSecurityTypeBasic  = "basic"
+	SwaggerSecurityTypeAPIKey = "apiKey"
+	SwaggerSecurityTypeOAuth2 = "oauth2"
+)
+
+// swagger 中 oauth2 的 flow 名称与 ast 中的对应关系
+var swaggerFlows = map[string]string{
+	ast.OAuthFlowImplicit:          "implicit",
+	ast.OAuthFlowPassword:          "password",
+	ast.OAuthFlowClientCredentials: "application",
+	ast.OAuthFlowAuthorizationCode: "accessCode",
+}
+
+// Swagger swagger 2.0 的根对象
+//
+// https://github.com/OAI/OpenAPI-Specification/blob/master/versions/2.0.md
+type Swagger struct {
+	Swagger             string                            `json:"swagger" yaml:"swagger"`
+	Info                *Info                             `json:"info" yaml:"info"`
+	Host                string                            `json:"host,omitempty" yaml:"host,omitempty"`
+	BasePath            string                            `json:"basePath,omitempty" yaml:"basePath,omitempty"`
+	Schemes             []string                          `json:"schemes,omitempty" yaml:"schemes,omitempty"`
+	Consumes            []string                          `json:"consumes,omitempty" yaml:"consumes,omitempty"`
+	Produces            []string                          `json:"produces,omitempty" yaml:"produces,omitempty"`
+	Paths               map[string]*SwaggerPathItem       `json:"paths" yaml:"paths"`
+	Definitions         map[string]*Schema                `json:"definitions,omitempty" yaml:"definitions,omitempty"`
+	Parameters          map[string]*SwaggerParameter      `json:"parameters,omitempty" yaml:"parameters,omitempty"`
+	Responses           map[string]*SwaggerResponse       `json:"responses,omitempty" yaml:"responses,omitempty"`
+	SecurityDefinitions map[string]*SwaggerSecurityScheme `json:"securityDefinitions,omitempty" yaml:"securityDefinitions,omitempty"`
+	Security            []*SecurityRequirement            `json:"security,omitempty" yaml:"security,omitempty"`
+	Tags                []*Tag                            `json:"tags,omitempty" yaml:"tags,omitempty"`
+	ExternalDocs        *ExternalDocumentation            `json:"externalDocs,omitempty" yaml:"externalDocs,omitempty"`
+}
+
+// SwaggerPathItem 每一条路径的详细描述信息
+//
+// 与 openapi 3 不同，swagger 不支持 TRACE 请求方法。
+type SwaggerPathItem struct {
+	Get        *SwaggerOperation   `json:"get,omitempty" yaml:"get,omitempty"`
+	Put        *SwaggerOperation   `json:"put,omitempty" yaml:"put,omitempty"`
+	Post       *SwaggerOperation   `json:"post,omitempty" yaml:"post,omitempty"`
+	Delete     *SwaggerOperation   `json:"delete,omitempty" yaml:"delete,omitempty"`
+	Options    *SwaggerOperation   `json:"options,omitempty" yaml:"options,omitempty"`
+	Head       *SwaggerOperation   `json:"head,omitempty" yaml:"head,omitempty"`
+	Patch      *SwaggerOperation   `json:"patch,omitempty" yaml:"patch,omitempty"`
+	Parameters []*SwaggerParameter `json:"parameters,omitempty" yaml:"parameters,omitempty"`
+}
+
+// SwaggerOperation 描述对某一个资源的操作具体操作
+type SwaggerOperation struct {
+	Tags        []string                    `json:"tags,omitempty" yaml:"tags,omitempty"`
+	Summary     string                      `json:"summary,omitempty" yaml:"summary,omitempty"`
+	Description string                      `json:"description,omitempty" yaml:"description,omitempty"`
+	OperationID string                      `json:"operationId,omitempty" yaml:"operationId,omitempty"`
+	Consumes    []string                    `json:"consumes,omitempty" yaml:"consumes,omitempty"`
+	Produces    []string                    `json:"produces,omitempty" yaml:"produces,omitempty"`
+	Parameters  []*SwaggerParameter         `json:"parameters,omitempty" yaml:"parameters,omitempty"`
+	Responses   map[string]*SwaggerResponse `json:"responses" yaml:"responses"`
+	Deprecated  bool                        `json:"deprecated,omitempty" yaml:"deprecated,omitempty"`
+	Security    []*SecurityRequirement      `json:"security,omitempty" yaml:"security,omitempty"`
+}
+
+// SwaggerItems 非 body 参数的类型描述
+//
+// swagger 中除 body 之外的参数和报头都只能是简单类型，不能使用 Schema。
+type SwaggerItems struct {
+	Type             string        `json:"type,omitempty" yaml:"type,omitempty"`
+	Format           string        `json:"format,omitempty" yaml:"format,omitempty"`
+	Items            *SwaggerItems `json:"items,omitempty" yaml:"items,omitempty"`
+	CollectionFormat string        `json:"collectionFormat,omitempty" yaml:"collectionFormat,omitempty"`
+	Default          interface{}   `json:"default,omitempty" yaml:"default,omitempty"`
+	Maximum          *float64      `json:"maximum,omitempty" yaml:"maximum,omitempty"`
+	Minimum          *float64      `json:"minimum,omitempty" yaml:"minimum,omitempty"`
+	MaxLength        int           `json:"maxLength,omitempty" yaml:"maxLength,omitempty"`
+	MinLength        int           `json:"minLength,omitempty" yaml:"minLength,omitempty"`
+	Pattern          string        `json:"pattern,omitempty" yaml:"pattern,omitempty"`
+	MaxItems         int           `json:"maxItems,omitempty" yaml:"maxItems,omitempty"`
+	MinItems         int           `json:"minItems,omitempty" yaml:"minItems,omitempty"`
+	Enum             []interface{} `json:"enum,omitempty" yaml:"enum,omitempty"`
+}
+
+// SwaggerParameter 参数信息
+//
+// IN 为 body 时，仅 Schema 有效；否则通过 SwaggerItems 描述参数的类型。
+type SwaggerParameter struct {
+	SwaggerItems `yaml:",inline"`
+	Name         string  `json:"name,omitempty" yaml:"name,omitempty"`
+	IN           string  `json:"in,omitempty" yaml:"in,omitempty"`
+	Description  string  `json:"description,omitempty" yaml:"description,omitempty"`
+	Required     bool    `json:"required,omitempty" yaml:"required,omitempty"`
+	Schema       *Schema `json:"schema,omitempty" yaml:"schema,omitempty"`
+
+	Ref string `json:"$ref,omitempty" yaml:"$ref,omitempty"`
+}
+
+// SwaggerHeader 返回的报头
+type SwaggerHeader struct {
+	SwaggerItems `yaml:",inline"`
+	Description  string `json:"description,omitempty" yaml:"description,omitempty"`
+}
+
+// SwaggerResponse 每个 API 的返回信息
+//
+// Examples 的键名为 mimetype。
+type SwaggerResponse struct {
+	Description string                    `json:"description,omitempty" yaml:"description,omitempty"`
+	Schema      *Schema                   `json:"schema,omitempty" yaml:"schema,omitempty"`
+	Headers     map[string]*SwaggerHeader `json:"headers,omitempty" yaml:"headers,omitempty"`
+	Examples    map[string]ExampleValue   `json:"examples,omitempty" yaml:"examples,omitempty"`
+
+	Ref string `json:"$ref,omitempty" yaml:"$ref,omitempty"`
+}
+
+// SwaggerSecurityScheme 验证方式
+type SwaggerSecurityScheme struct {
+	Type             string            `json:"type" yaml:"type"`
+	Description      string            `json:"description,omitempty" yaml:"description,omitempty"`
+	Name             string            `json:"name,omitempty" yaml:"name,omitempty"`
+	IN               string            `json:"in,omitempty" yaml:"in,omitempty"`
+	Flow             string            `json:"flow,omitempty" yaml:"flow,omitempty"`
+	AuthorizationURL string            `json:"authorizationUrl,omitempty" yaml:"authorizationUrl,omitempty"`
+	TokenURL         string            `json:"tokenUrl,omitempty" yaml:"tokenUrl,omitempty"`
+	Scopes           map[string]string `json:"scopes,omitempty" yaml:"scopes,omitempty"`
+}
+
+// 将 ast.APIDoc 转换成 swagger 对象
+//
+// swagger 无法表达的内容会被忽略，并以警告的形式输出到 h。
+type swaggerConverter struct {
+	h   *core.MessageHandler
+	doc *ast.APIDoc
+}
+
+func convertSwagger(h *core.MessageHandler, doc *ast.APIDoc) (*Swagger, error) {
+	langID := doc.Lang.V()
+	if langID == "" {
+		langID = "und"
+	}
+
+	c := &swaggerConverter{h: h, doc: doc}
+
+	s := &Swagger{
+		Swagger: SwaggerVersion,
+		Info: &Info{
+			Title:       doc.Title.Content.Value,
+			Description: doc.Description.V(),
+			Contact:     newContact(doc.Contact),
+			License:     newLicense(doc.License),
+			Version:     doc.Version.V(),
+		},
+		Paths: make(map[string]*SwaggerPathItem, len(doc.APIs)),
+		Tags:  make([]*Tag, 0, len(doc.Tags)),
+		ExternalDocs: &ExternalDocumentation{
+			Description: locale.Translate(langID, locale.GeneratorBy, core.Name),
+			URL:         core.OfficialURL,
+		},
+	}
+
+	for _, tag := range doc.Tags {
+		s.Tags = append(s.Tags, newTag(tag))
+	}
+
+	if len(doc.Mimetypes) > 0 {
+		mimetypes := make([]string, 0, len(doc.Mimetypes))
+		for _, m := range doc.Mimetypes {
+			mimetypes = append(mimetypes, m.Content.Value)
+		}
+		s.Consumes = mimetypes
+		s.Produces = mimetypes
+	}
+
+	c.setServer(s)
+	c.setDefinitions(s)
+
+	if err := c.setPaths(s); err != nil {
+		return nil, err
+	}
+
+	if err := s.sanitize(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func (c *swaggerConverter) ignore(loc core.Location, field string) {
+	c.h.Warning(loc.NewError(locale.ErrIgnored).WithField(field))
+}
+
+// swagger 只能指定一个服务器，采用第一个服务器的地址。
+func (c *swaggerConverter) setServer(s *Swagger) {
+	if len(c.doc.Servers) == 0 {
+		return
+	}
+
+	for _, srv := range c.doc.Servers[1:] {
+		c.ignore(srv.Location, "server")
+	}
+
+	u, err := url.Parse(c.doc.Servers[0].URL.V())
+	if err != nil { // 非正常的地址，忽略
+		c.ignore(c.doc.Servers[0].Location, "url")
+		return
+	}
+
+	s.Host = u.Host
+	s.BasePath = u.Path
+	if u.Scheme != "" {
+		s.Schemes = []string{u.Scheme}
+	}
+}
+
+// 将 doc.Types、doc.Securities、doc.Headers 和 doc.Responses 写入根对象的对应字段
+func (c *swaggerConverter) setDefinitions(s *Swagger) {
+	if len(c.doc.Types) > 0 {
+		s.Definitions = make(map[string]*Schema, len(c.doc.Types))
+		for _, t := range c.doc.Types {
+			p := t.Param()
+			p.Name = nil // 类型定义的名称仅用于引用，不作为 XML 的元素名称。
+			s.Definitions[t.Name.V()] = c.newSchema(p)
+		}
+	}
+
+	if len(c.doc.Securities) > 0 {
+		s.SecurityDefinitions = make(map[string]*SwaggerSecurityScheme, len(c.doc.Securities))
+		for _, sec := range c.doc.Securities {
+			if scheme := c.newSecurityScheme(sec); scheme != nil {
+				s.SecurityDefinitions[sec.Name.V()] = scheme
+			}
+		}
+	}
+
+	if len(c.doc.Headers) > 0 {
+		s.Parameters = make(map[string]*SwaggerParameter, len(c.doc.Headers))
+		for _, header := range c.doc.Headers {
+			s.Parameters[header.Name.V()] = c.newParameter(header, ParameterINHeader)
+		}
+	}
+
+	if len(c.doc.Responses) > 0 {
+		s.Responses = c.newResponses(c.doc.Responses)
+	}
+}
+
+func (c *swaggerConverter) setPaths(s *Swagger) *core.Error {
+	for _, api := range c.doc.APIs {
+		p := s.Paths[api.Path.Path.V()]
+		if p == nil {
+			p = &SwaggerPathItem{}
+			s.Paths[api.Path.Path.V()] = p
+		}
+
+		method := strings.ToLower(api.Method.V())
+		ptr := p.operation(method)
+		if ptr == nil {
+			c.ignore(api.Location, "method")
+			continue
+		}
+		if *ptr != nil {
+			return core.NewError(locale.ErrDuplicateValue).WithField("paths." + method)
+		}
+
+		operation := &SwaggerOperation{
+			Summary:     api.Summary.V(),
+			Description: api.Description.V(),
+			OperationID: api.ID.V(),
+			Deprecated:  api.Deprecated != nil,
+			Parameters:  c.newParameters(api),
+			Responses:   c.newResponses(api.Responses),
+			Security:    newSwaggerSecurityRequirements(s, api.Securities),
+			Consumes:    requestMimetypes(api.Requests),
+			Produces:    requestMimetypes(api.Responses),
+		}
+		*ptr = operation
+
+		if len(api.Tags) > 0 {
+			operation.Tags = make([]string, 0, len(api.Tags))
+			for _, tag := range api.Tags {
+				operation.Tags = append(operation.Tags, tag.Content.Value)
+			}
+		}
+
+		// 与 mock 的规则相同，仅在 api.Responses 中找不到时，才采用 doc.Responses 中的内容。
+		for status := range s.Responses {
+			if _, found := operation.Responses[status]; !found {
+				operation.Responses[status] = &SwaggerResponse{Ref: swaggerResponsesRef + status}
+			}
+		}
+
+		if len(api.Servers) > 0 {
+			c.ignore(api.Servers[0].Location, "server")
+		}
+
+		if api.Callback != nil {
+			c.ignore(api.Callback.Location, "callback")
+		}
+	}
+
+	return nil
+}
+
+func (path *SwaggerPathItem) operation(method string) **SwaggerOperation {
+	switch strings.ToUpper(method) {
+	case http.MethodGet:
+		return &path.Get
+	case http.MethodPut:
+		return &path.Put
+	case http.MethodPost:
+		return &path.Post
+	case http.MethodDelete:
+		return &path.Delete
+	case http.MethodOptions:
+		return &path.Options
+	case http.MethodHead:
+		return &path.Head
+	case http.MethodPatch:
+		return &path.Patch
+	}
+	return nil
+}
+
+func (c *swaggerConverter) newParameters(api *ast.API) []*SwaggerParameter {
+	params := make([]*SwaggerParameter, 0, 10)
+
+	if api.Path != nil {
+		for _, p := range api.Path.Params {
+			params = append(params, c.newParameter(p, ParameterINPath))
+		}
+		for _, p := range api.Path.Queries {
+			params = append(params, c.newParameter(p, ParameterINQuery))
+		}
+	}
+
+	for _, p := range api.Headers {
+		params = append(params, c.newParameter(p, ParameterINHeader))
+	}
+
+	// swagger 不支持 cookie 参数
+	for _, p := range api.Cookies {
+		c.ignore(p.Location, "cookie")
+	}
+
+	for _, r := range api.Requests {
+		for _, p := range r.Headers {
+			params = append(params, c.newParameter(p, ParameterINHeader))
+		}
+		for _, p := range r.Cookies {
+			c.ignore(p.Location, "cookie")
+		}
+	}
+
+	params = append(params, c.newBodyParameters(api.Requests)...)
+
+	// 公共报头
+	for _, header := range c.doc.Headers {
+		params = append(params, &SwaggerParameter{Ref: swaggerParametersRef + header.Name.V()})
+	}
+
+	return params
+}
+
+// 生成请求内容对应的参数
+//
+// swagger 只能描述一种请求内容，以第一个 request 为准，
+// 结构与其不同的 request 会被忽略。
+func (c *swaggerConverter) newBodyParameters(requests []*ast.Request) []*SwaggerParameter {
+	if len(requests) == 0 {
+		return nil
+	}
+
+	first := requests[0]
+	for _, r := range requests[1:] {
+		if isFormMimetype(r.Mimetype.V()) != isFormMimetype(first.Mimetype.V()) ||
+			!sameSchema(newSchemaFromRequest(c.doc, r, true), newSchemaFromRequest(c.doc, first, true)) {
+			c.ignore(r.Location, "request")
+		}
+	}
+
+	// swagger 的 body 参数无法指定示例代码
+	for _, r := range requests {
+		for _, exp := range r.Examples {
+			c.ignore(exp.Location, "example")
+		}
+	}
+
+	p := first.Param()
+	if isFormMimetype(first.Mimetype.V()) && !first.Array.V() {
+		if pp := p.Resolve(); pp.Type.V() == ast.TypeObject {
+			c.checkParam(p)
+			params := make([]*SwaggerParameter, 0, len(pp.Items))
+			for _, item := range pp.Items {
+				param := c.newParameter(item, SwaggerINFormData)
+				if item.Resolve().Type.V() == ast.TypeBinary {
+					param.Type = "file"
+					param.Format = ""
+				}
+				params = append(params, param)
+			}
+			return params
+		}
+	}
+
+	if first.Type.V() == ast.TypeNone && !first.Array.V() {
+		return nil
+	}
+
+	name := first.Name.V()
+	if name == "" {
+		name = "body"
+	}
+	return []*SwaggerParameter{{
+		Name:        name,
+		IN:          SwaggerINBody,
+		Description: getDescription(first.Description, first.Summary),
+		Schema:      c.newSchema(p),
+	}}
+}
+
+// 生成非 body 的参数
+func (c *swaggerConverter) newParameter(p *ast.Param, in string) *SwaggerParameter {
+	c.checkParam(p)
+
+	param := &SwaggerParameter{
+		SwaggerItems: *newSwaggerItems(newSchema(c.doc, p.Resolve(), true)),
+		Name:         p.Name.V(),
+		IN:           in,
+		Description:  getDescription(p.Description, p.Summary),
+		Required:     !p.Optional.V(),
+	}
+
+	if param.Items != nil {
+		switch {
+		case p.ArrayStyle.V():
+			param.CollectionFormat = CollectionFormatCSV
+		case in == ParameterINQuery || in == SwaggerINFormData:
+			param.CollectionFormat = CollectionFormatMulti
+		default:
+			param.CollectionFormat = CollectionFormatCSV
+		}
+	}
+
+	return param
+}
+
+func newSwaggerItems(s *Schema) *SwaggerItems {
+	if s == nil {
+		return nil
+	}
+
+	s.normalizeType()
+	items := &SwaggerItems{
+		Type:      s.Type,
+		Format:    s.Format,
+		Items:     newSwaggerItems(s.Items),
+		Maximum:   s.Maximum,
+		Minimum:   s.Minimum,
+		MaxLength: s.MaxLength,
+		MinLength: s.MinLength,
+		Pattern:   s.Pattern,
+		MaxItems:  s.MaxItems,
+		MinItems:  s.MinItems,
+		Enum:      s.Enum,
+	}
+	if s.Default != "" {
+		items.Default = s.Default
+	}
+	return items
+}
+
+func (c *swaggerConverter) newResponses(responses []*ast.Request) map[string]*SwaggerResponse {
+	ret := make(map[string]*SwaggerResponse, len(responses))
+
+	for _, resp := range responses {
+		status := strconv.Itoa(resp.Status.V())
+		r, found := ret[status]
+		if found {
+			// swagger 中每个状态码只能对应一种返回结构
+			if !sameSchema(r.Schema, c.newResponseSchema(resp, false)) {
+				c.ignore(resp.Location, "response")
+				continue
+			}
+		} else {
+			desc := getDescription(resp.Description, resp.Summary)
+			if desc == "" { // description 是必须的
+				desc = http.StatusText(resp.Status.V())
+			}
+
+			r = &SwaggerResponse{
+				Description: desc,
+				Schema:      c.newResponseSchema(resp, true),
+				Headers:     make(map[string]*SwaggerHeader, len(resp.Headers)),
+				Examples:    make(map[string]ExampleValue, len(resp.Examples)),
+			}
+			ret[status] = r
+		}
+
+		for _, h := range resp.Headers {
+			c.checkParam(h)
+			r.Headers[h.Name.V()] = &SwaggerHeader{
+				SwaggerItems: *newSwaggerItems(newSchema(c.doc, h.Resolve(), true)),
+				Description:  getDescription(h.Description, h.Summary),
+			}
+		}
+		if len(resp.Cookies) > 0 {
+			r.Headers["Set-Cookie"] = &SwaggerHeader{
+				SwaggerItems: SwaggerItems{Type: TypeString},
+				Description:  newSetCookieHeader(resp.Cookies).Description,
+			}
+		}
+
+		for _, exp := range resp.Examples {
+			r.Examples[exp.Mimetype.V()] = ExampleValue(exp.Content.Value.Value)
+		}
+	}
+
+	return ret
+}
+
+// check 表示是否需要检测其中无法转换的内容
+func (c *swaggerConverter) newResponseSchema(resp *ast.Request, check bool) *Schema {
+	if resp.Type.V() == ast.TypeNone && !resp.Array.V() {
+		return nil
+	}
+
+	if check {
+		return c.newSchema(resp.Param())
+	}
+	s := newSchemaFromRequest(c.doc, resp, true)
+	downgradeSchema(s)
+	return s
+}
+
+func (c *swaggerConverter) newSchema(p *ast.Param) *Schema {
+	c.checkParam(p)
+	s := newSchema(c.doc, p, true)
+	downgradeSchema(s)
+	return s
+}
+
+// 检测 p 中 swagger 无法表达的内容
+//
+// 引用的 TypeDef 在生成 definitions 时检测，不会重复检测。
+func (c *swaggerConverter) checkParam(p *ast.Param) {
+	if p.Nullable.V() {
+		c.ignore(p.Nullable.Location, "nullable")
+	}
+	if p.Deprecated != nil {
+		c.ignore(p.Deprecated.Location, "deprecated")
+	}
+	if len(p.OneOf) > 0 {
+		c.ignore(p.OneOf[0].Location, "one-of")
+	}
+	if len(p.AnyOf) > 0 {
+		c.ignore(p.AnyOf[0].Location, "any-of")
+	}
+
+	if p.Type.TypeDef() != nil {
+		return
+	}
+	for _, item := range p.Items {
+		c.checkParam(item)
+	}
+}
+
+func (c *swaggerConverter) newSecurityScheme(s *ast.Security) *SwaggerSecurityScheme {
+	scheme := &SwaggerSecurityScheme{Description: getDescription(s.Description, s.Summary)}
+
+	switch s.Type.V() {
+	case ast.SecurityTypeAPIKey:
+		if s.In.V() == ast.SecurityInCookie {
+			c.ignore(s.Location, "in")
+			return nil
+		}
+		scheme.Type = SwaggerSecurityTypeAPIKey
+		scheme.Name = s.Key.V()
+		scheme.IN = s.In.V()
+	case ast.SecurityTypeHTTP:
+		if strings.ToLower(s.Scheme.V()) != "basic" {
+			c.ignore(s.Location, "scheme")
+			return nil
+		}
+		scheme.Type = SwaggerSecurityTypeBasic
+	case ast.SecurityTypeOAuth2:
+		if len(s.Flows) == 0 {
+			c.ignore(s.Location, "flow")
+			return nil
+		}
+
+		// swagger 的每个 oauth2 验证只能指定一种 flow
+		for _, f := range s.Flows[1:] {
+			c.ignore(f.Location, "flow")
+		}
+
+		f := s.Flows[0]
+		scheme.Type = SwaggerSecurityTypeOAuth2
+		scheme.Flow = swaggerFlows[f.Type.V()]
+		scheme.AuthorizationURL = f.AuthorizationURL.V()
+		scheme.TokenURL = f.TokenURL.V()
+		scheme.Scopes = make(map[string]string, len(f.Scopes))
+		for _, scope := range f.Scopes {
+			scheme.Scopes[scope.Name.V()] = scope.Summary.V()
+		}
+	default: // openidconnect
+		c.ignore(s.Location, "type")
+		return nil
+	}
+
+	return scheme
+}
+
+// 仅保留在 securityDefinitions 中存在的验证方式
+func newSwaggerSecurityRequirements(s *Swagger, securities []*ast.SecurityValue) []*SecurityRequirement {
+	reqs := newSecurityRequirements(securities)
+	if len(reqs) == 0 {
+		return nil
+	}
+
+	ret := make([]*SecurityRequirement, 0, len(reqs))
+	for _, req := range reqs {
+		for name := range *req {
+			if _, found := s.SecurityDefinitions[name]; found {
+				ret = append(ret, req)
+			}
+		}
+	}
+	return ret
+}
+
+func requestMimetypes(requests []*ast.Request) []string {
+	mimetypes := make([]string, 0, len(requests))
+	for _, r := range requests {
+		m := r.Mimetype.V()
+		if m == "" || inStrings(mimetypes, m) {
+			continue
+		}
+		mimetypes = append(mimetypes, m)
+	}
+
+	if len(mimetypes) == 0 {
+		return nil
+	}
+	return mimetypes
+}
+
+func inStrings(list []string, v string) bool {
+	for _, item := range list {
+		if item == v {
+			return true
+		}
+	}
+	return false
+}
+
+func isFormMimetype(mimetype string) bool {
+	return mimetype == formMimetype || mimetype == multipartMimetype
+}
+
+func sameSchema(s1, s2 *Schema) bool {
+	data1, err1 := json.Marshal(s1)
+	data2, err2 := json.Marshal(s2)
+	return err1 == nil && err2 == nil && string(data1) == string(data2)
+}
+
+// 将 openapi 3 的 schema 降级为 swagger 可用的 schema
+//
+// swagger 不支持 oneOf、anyOf、nullable 和 deprecated 等字段，直接去掉；
+// 引用地址也需要改为指向 definitions。
+func downgradeSchema(s *Schema) {
+	w := &schemaWalker{f: (*Schema).toSwagger, seen: make(map[*Schema]struct{}, 10)}
+	w.schema(s)
+}
+
+func (s *Schema) toSwagger() {
+	s.normalizeType()
+
+	if len(s.AllOf) == 1 && s.Type == "" && s.Nullable { // 3.0 中通过 allOf 包装的可以为 null 的引用
+		s.Ref = s.AllOf[0].Ref
+		s.AllOf = nil
+	}
+	if strings.HasPrefix(s.Ref, componentsSchemasRef) {
+		s.Ref = swaggerDefinitionsRef + strings.TrimPrefix(s.Ref, componentsSchemasRef)
+	}
+
+	if s.Default == "" {
+		s.Default = nil
+	}
+	s.Nullable = false
+	s.Deprecated = false
+	s.OneOf = nil
+	s.AnyOf = nil
+	s.Discriminator = nil
+}
+
+func (s *Swagger) sanitize() *core.Error {
+	if s.Info == nil {
+		return core.NewError(locale.ErrIsEmpty, "info").WithField("info")
+	}
+	if err := s.Info.sanitize(); err != nil {
+		err.Field = "info." + err.Field
+		return err
+	}
+
+	if len(s.Paths) == 0 {
+		return core.NewError(locale.ErrIsEmpty, "paths").WithField("paths")
+	}
+
+	for index, item := range s.Tags {
+		if err := item.sanitize(); err != nil {
+			err.Field = "tags[" + strconv.Itoa(index) + "]." + err.Field
+			return err
+		}
+	}
+
+	if s.ExternalDocs != nil {
+		if err := s.ExternalDocs.sanitize(); err != nil {
+			err.Field = "externalDocs." + err.Field
+			return err
+		}
+	}
+
+	return nil
+}
+
+// SwaggerJSON 输出 swagger 2.0 的 JSON 格式数据
+//
+// swagger 无法表达的内容会被忽略，并以警告的形式输出到 h。
+func SwaggerJSON(h *core.MessageHandler, doc *ast.APIDoc) ([]byte, error) {
+	s, err := convertSwagger(h, doc)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.MarshalIndent(s, "", "\t")
+}
+
+// SwaggerYAML 输出 swagger 2.0 的 YAML 格式数据
+//
+// swagger 无法表达的内容会被忽略，并以警告的形式输出到 h。
+func SwaggerYAML(h *core.MessageHandler, doc *ast.APIDoc) ([]byte, error) {
+	s, err := convertSwagger(h, doc)
+	if err != nil {
+		return nil, err
+	}
+
+	return yaml.Marshal(s)
+}
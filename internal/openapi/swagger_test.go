@@ -0,0 +1,175 @@
+// SPDX-License-Identifier: MIT
+
+package openapi
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/issue9/assert"
+	"gopkg.in/yaml.v2"
+
+	"github.com/caixw/apidoc/v7/core"
+	"github.com/caixw/apidoc/v7/core/messagetest"
+	"github.com/caixw/apidoc/v7/internal/ast"
+	"github.com/caixw/apidoc/v7/internal/ast/asttest"
+)
+
+func TestSwaggerJSON(t *testing.T) {
+	a := assert.New(t)
+
+	rslt := messagetest.NewMessageHandler()
+	data, err := SwaggerJSON(rslt.Handler, asttest.Get())
+	rslt.Handler.Stop()
+	a.NotError(err).NotNil(data)
+
+	s := &Swagger{}
+	a.NotError(json.Unmarshal(data, s)).
+		Equal(s.Swagger, SwaggerVersion).
+		Equal(3, len(s.Tags)).
+		Equal(1, len(s.Paths)).
+		Equal(s.ExternalDocs.URL, core.OfficialURL)
+
+	path := s.Paths["/users"]
+	a.NotNil(path).NotNil(path.Post).NotNil(path.Get).Nil(path.Patch)
+	a.True(path.Post.Deprecated)
+
+	rslt = messagetest.NewMessageHandler()
+	data, err = SwaggerYAML(rslt.Handler, asttest.Get())
+	rslt.Handler.Stop()
+	a.NotError(err).NotNil(data)
+	s = &Swagger{}
+	a.NotError(yaml.Unmarshal(data, s)).
+		Equal(s.Swagger, SwaggerVersion).
+		Equal(1, len(s.Paths))
+}
+
+func TestConvertSwagger(t *testing.T) {
+	a := assert.New(t)
+
+	rslt := messagetest.NewMessageHandler()
+	doc := &ast.APIDoc{}
+	doc.Parse(rslt.Handler, core.Block{Data: []byte(`<apidoc version="1.0.0">
+	<title>title</title>
+	<mimetype>application/json</mimetype>
+	<server name="prod" url="https://example.com/v1" summary="prod" />
+	<server name="dev" url="https://dev.example.com/v1" summary="dev" />
+	<type name="User" type="object" summary="user">
+		<param name="id" type="number.int" summary="id" />
+		<param name="name" type="string" nullable="true" summary="name" />
+	</type>
+	<security name="token" type="apikey" in="cookie" key="token" summary="token" />
+	<security name="basic" type="http" scheme="basic" summary="basic" />
+	<security name="oauth" type="oauth2">
+		<flow type="client-credentials" token-url="https://example.com/token">
+			<scope name="read" summary="read" />
+		</flow>
+	</security>
+	<api method="POST">
+		<path path="/users/{id}">
+			<param name="id" type="number.int" summary="id" />
+			<query name="tags" type="string" array="true" summary="tags" />
+		</path>
+		<cookie name="sid" type="string" summary="sid" />
+		<security name="token" />
+		<security name="basic" />
+		<security name="oauth"><scope>read</scope></security>
+		<request type="#User" mimetype="application/json" />
+		<request type="string" mimetype="text/plain" />
+		<response status="200" type="#User" nullable="true" mimetype="application/json">
+			<cookie name="sid" type="string" summary="new sid" />
+		</response>
+		<callback method="POST">
+			<request type="string" mimetype="application/json" />
+		</callback>
+	</api>
+	<api method="PUT">
+		<path path="/avatar" />
+		<request type="object" mimetype="multipart/form-data">
+			<param name="file" type="string.binary" summary="file" />
+			<param name="labels" type="string" array="true" summary="labels" />
+		</request>
+		<response status="204" />
+	</api>
+</apidoc>`)})
+	rslt.Handler.Stop()
+	a.Empty(rslt.Errors)
+
+	rslt = messagetest.NewMessageHandler()
+	s, err := convertSwagger(rslt.Handler, doc)
+	rslt.Handler.Stop()
+	a.NotError(err).NotNil(s)
+	a.Empty(rslt.Errors)
+
+	fields := make([]string, 0, len(rslt.Warns))
+	for _, w := range rslt.Warns {
+		fields = append(fields, w.(*core.Error).Field)
+	}
+	a.Equal(fields, []string{
+		"server",   // 第二个 server
+		"nullable", // User.name
+		"in",       // cookie 中的 apikey
+		"cookie",
+		"request", // 结构不同的第二个 request
+		"nullable",
+		"callback",
+	})
+
+	a.Equal(s.Host, "example.com").
+		Equal(s.BasePath, "/v1").
+		Equal(s.Schemes, []string{"https"}).
+		Equal(s.Consumes, []string{"application/json"})
+
+	// definitions
+	user := s.Definitions["User"]
+	a.NotNil(user).
+		Equal(user.Properties["id"].Type, TypeInt).
+		Equal(user.Properties["id"].Format, "int64").
+		False(user.Properties["name"].Nullable).
+		Nil(user.Properties["name"].Default)
+
+	// securityDefinitions
+	a.Equal(2, len(s.SecurityDefinitions))
+	a.Equal(s.SecurityDefinitions["basic"].Type, SwaggerSecurityTypeBasic)
+	a.Equal(s.SecurityDefinitions["oauth"].Flow, "application").
+		Equal(s.SecurityDefinitions["oauth"].TokenURL, "https://example.com/token")
+
+	post := s.Paths["/users/{id}"].Post
+	a.NotNil(post).
+		Equal(post.Consumes, []string{"application/json", "text/plain"}).
+		Equal(post.Security, []*SecurityRequirement{
+			{"basic": []string{}},
+			{"oauth": []string{"read"}},
+		})
+	a.Equal(3, len(post.Parameters))
+	id := post.Parameters[0]
+	a.Equal(id.IN, ParameterINPath).
+		Equal(id.Type, TypeInt).
+		True(id.Required)
+	tags := post.Parameters[1]
+	a.Equal(tags.IN, ParameterINQuery).
+		Equal(tags.Type, TypeArray).
+		Equal(tags.Items.Type, TypeString).
+		Equal(tags.CollectionFormat, CollectionFormatMulti)
+	body := post.Parameters[2]
+	a.Equal(body.IN, SwaggerINBody).
+		Equal(body.Schema.Ref, swaggerDefinitionsRef+"User")
+
+	resp := post.Responses["200"]
+	a.NotNil(resp).
+		Equal(resp.Schema.Ref, swaggerDefinitionsRef+"User").
+		Nil(resp.Schema.AllOf).
+		Equal(resp.Headers["Set-Cookie"].Description, "sid: new sid")
+
+	// 表单
+	put := s.Paths["/avatar"].Put
+	a.NotNil(put).Equal(2, len(put.Parameters))
+	file := put.Parameters[0]
+	a.Equal(file.IN, SwaggerINFormData).
+		Equal(file.Type, "file").
+		Empty(file.Format)
+	labels := put.Parameters[1]
+	a.Equal(labels.IN, SwaggerINFormData).
+		Equal(labels.CollectionFormat, CollectionFormatMulti)
+	a.Nil(put.Responses["204"].Schema)
+}
@@ -0,0 +1,254 @@
+// SPDX-License-Identifier: MIT
+
+package postman
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/caixw/apidoc/v7/internal/ast"
+)
+
+// JSON 将 doc 转换成 Postman Collection v2.1 格式的 JSON 数据
+//
+// API 按标签分组到同名的文件夹中，未指定标签的 API 则直接放在集合的顶层；
+// server 会被转换成集合的变量，API 的地址通过 {{name}} 的形式引用这些变量。
+func JSON(doc *ast.APIDoc) ([]byte, error) {
+	return json.MarshalIndent(convert(doc), "", "\t")
+}
+
+func convert(doc *ast.APIDoc) *Collection {
+	c := &Collection{
+		Info: &Info{
+			Name:        doc.Title.V(),
+			Description: newDescription(doc.Description, nil),
+			Version:     doc.Version.V(),
+			Schema:      Schema,
+		},
+		Items:    make([]*Item, 0, len(doc.Tags)+len(doc.APIs)),
+		Variable: make([]*Variable, 0, len(doc.Servers)),
+	}
+
+	for _, srv := range doc.Servers {
+		c.Variable = append(c.Variable, &Variable{
+			Key:         srv.Name.V(),
+			Value:       srv.URL.V(),
+			Type:        "string",
+			Description: newDescription(srv.Description, srv.Summary),
+		})
+	}
+
+	for _, tag := range doc.Tags {
+		name := tag.Title.V()
+		if name == "" {
+			name = tag.Name.V()
+		}
+		folder := &Item{Name: name, Items: make([]*Item, 0, 10)}
+
+		for _, api := range doc.APIs {
+			for _, t := range api.Tags {
+				if t.V() == tag.Name.V() {
+					folder.Items = append(folder.Items, newItem(doc, api))
+					break
+				}
+			}
+		}
+
+		if len(folder.Items) > 0 {
+			c.Items = append(c.Items, folder)
+		}
+	}
+
+	for _, api := range doc.APIs {
+		if len(api.Tags) == 0 {
+			c.Items = append(c.Items, newItem(doc, api))
+		}
+	}
+
+	return c
+}
+
+func newItem(doc *ast.APIDoc, api *ast.API) *Item {
+	name := api.Summary.V()
+	if name == "" {
+		name = api.Method.V() + " " + api.Path.Path.V()
+	}
+
+	req := newRequest(doc, api)
+	item := &Item{
+		Name:    name,
+		Request: req,
+	}
+
+	responses := make([]*ast.Request, 0, len(api.Responses)+len(doc.Responses))
+	responses = append(append(responses, api.Responses...), doc.Responses...)
+	for _, resp := range responses {
+		for _, exp := range resp.Examples {
+			item.Responses = append(item.Responses, newResponse(req, resp, exp))
+		}
+	}
+
+	return item
+}
+
+func newRequest(doc *ast.APIDoc, api *ast.API) *Request {
+	req := &Request{
+		Method:      api.Method.V(),
+		URL:         newURL(doc, api),
+		Description: newDescription(api.Description, api.Summary),
+	}
+
+	headers := make([]*ast.Param, 0, len(api.Headers)+len(doc.Headers))
+	headers = append(append(headers, api.Headers...), doc.Headers...)
+	req.Headers = newKeyValues(headers)
+
+	// 以第一个示例代码作为请求的报文
+	for _, r := range api.Requests {
+		if len(r.Examples) == 0 {
+			continue
+		}
+
+		exp := r.Examples[0]
+		req.Body = &Body{
+			Mode:    BodyModeRaw,
+			Raw:     exampleContent(exp),
+			Options: &BodyOptions{Raw: &RawOptions{Language: language(exp.Mimetype.V())}},
+		}
+		if !hasHeader(req.Headers, "Content-Type") {
+			req.Headers = append(req.Headers, &KeyValue{Key: "Content-Type", Value: exp.Mimetype.V()})
+		}
+		break
+	}
+
+	return req
+}
+
+// 地址中的服务器部分以变量的形式引用，
+// 优先采用 API 指定的第一个 server，否则采用文档中的第一个 server。
+func newURL(doc *ast.APIDoc, api *ast.API) *URL {
+	var srv string
+	if len(api.Servers) > 0 {
+		srv = api.Servers[0].V()
+	} else if len(doc.Servers) > 0 {
+		srv = doc.Servers[0].Name.V()
+	}
+
+	u := &URL{}
+	var raw strings.Builder
+	if srv != "" {
+		host := "{{" + srv + "}}"
+		u.Host = []string{host}
+		raw.WriteString(host)
+	}
+
+	path := strings.Trim(api.Path.Path.V(), "/")
+	if path != "" {
+		for _, seg := range strings.Split(path, "/") {
+			if len(seg) > 2 && seg[0] == '{' && seg[len(seg)-1] == '}' {
+				seg = ":" + seg[1:len(seg)-1]
+			}
+			u.Path = append(u.Path, seg)
+		}
+	}
+	raw.WriteString("/" + strings.Join(u.Path, "/"))
+
+	for _, p := range api.Path.Params {
+		u.Variables = append(u.Variables, &Variable{
+			Key:         p.Name.V(),
+			Value:       p.Default.V(),
+			Description: newDescription(p.Description, p.Summary),
+		})
+	}
+
+	u.Query = newKeyValues(api.Path.Queries)
+	var sep byte = '?'
+	for _, q := range u.Query {
+		if q.Disabled {
+			continue
+		}
+		raw.WriteByte(sep)
+		raw.WriteString(q.Key + "=" + q.Value)
+		sep = '&'
+	}
+
+	u.Raw = raw.String()
+	return u
+}
+
+func newResponse(req *Request, resp *ast.Request, exp *ast.Example) *Response {
+	status := resp.Status.V()
+
+	name := exp.Summary.V()
+	if name == "" {
+		name = resp.Summary.V()
+	}
+	if name == "" {
+		name = strings.TrimSpace(strconv.Itoa(status) + " " + http.StatusText(status))
+	}
+
+	headers := make([]*KeyValue, 0, len(resp.Headers)+1)
+	headers = append(headers, &KeyValue{Key: "Content-Type", Value: exp.Mimetype.V()})
+	for _, kv := range newKeyValues(resp.Headers) {
+		if !strings.EqualFold(kv.Key, "Content-Type") {
+			headers = append(headers, kv)
+		}
+	}
+
+	return &Response{
+		Name:            name,
+		OriginalRequest: req,
+		Status:          http.StatusText(status),
+		Code:            status,
+		PreviewLanguage: language(exp.Mimetype.V()),
+		Headers:         headers,
+		Body:            exampleContent(exp),
+	}
+}
+
+// 可选的参数默认处于禁用状态，值则采用参数的默认值。
+func newKeyValues(params []*ast.Param) []*KeyValue {
+	kvs := make([]*KeyValue, 0, len(params))
+	for _, p := range params {
+		kvs = append(kvs, &KeyValue{
+			Key:         p.Name.V(),
+			Value:       p.Default.V(),
+			Description: newDescription(p.Description, p.Summary),
+			Disabled:    p.Optional.V(),
+		})
+	}
+	return kvs
+}
+
+func hasHeader(headers []*KeyValue, key string) bool {
+	for _, h := range headers {
+		if strings.EqualFold(h.Key, key) {
+			return true
+		}
+	}
+	return false
+}
+
+func exampleContent(exp *ast.Example) string {
+	if exp.Content == nil {
+		return ""
+	}
+	return strings.TrimSpace(exp.Content.Value.Value)
+}
+
+// 富文本转换成带类型的描述内容，否则采用 summary 作为描述内容。
+func newDescription(desc *ast.Richtext, summary *ast.Attribute) *Description {
+	if desc != nil && desc.V() != "" {
+		typ := DescriptionTypeMarkdown
+		if desc.Type.V() == ast.RichtextTypeHTML {
+			typ = DescriptionTypeHTML
+		}
+		return &Description{Content: desc.V(), Type: typ}
+	}
+
+	if summary.V() != "" {
+		return &Description{Content: summary.V()}
+	}
+	return nil
+}
@@ -0,0 +1,122 @@
+// SPDX-License-Identifier: MIT
+
+package postman
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/issue9/assert"
+
+	"github.com/caixw/apidoc/v7/core"
+	"github.com/caixw/apidoc/v7/core/messagetest"
+	"github.com/caixw/apidoc/v7/internal/ast"
+	"github.com/caixw/apidoc/v7/internal/ast/asttest"
+)
+
+func TestJSON(t *testing.T) {
+	a := assert.New(t)
+
+	data, err := JSON(asttest.Get())
+	a.NotError(err).NotEmpty(data)
+
+	c := &Collection{}
+	a.NotError(json.Unmarshal(data, c)).
+		Equal(c.Info.Schema, Schema).
+		Equal(2, len(c.Variable))
+}
+
+func TestConvert(t *testing.T) {
+	a := assert.New(t)
+
+	rslt := messagetest.NewMessageHandler()
+	doc := &ast.APIDoc{}
+	doc.Parse(rslt.Handler, core.Block{Data: []byte(`<apidoc version="1.0.0">
+	<title>title</title>
+	<description type="markdown"><![CDATA[**desc**]]></description>
+	<mimetype>application/json</mimetype>
+	<server name="prod" url="https://example.com" summary="prod" />
+	<server name="dev" url="https://dev.example.com" summary="dev" />
+	<tag name="user" title="用户" />
+	<tag name="empty" title="empty" />
+	<header name="Authorization" type="string" summary="token" />
+	<api method="POST" summary="create user">
+		<path path="/users/{id}">
+			<param name="id" type="number" default="1" summary="id" />
+			<query name="page" type="number" default="1" summary="page" />
+			<query name="size" type="number" optional="true" summary="size" />
+		</path>
+		<tag>user</tag>
+		<server>dev</server>
+		<request type="object" mimetype="application/json">
+			<param name="name" type="string" summary="name" />
+			<example mimetype="application/json"><![CDATA[{"name":"n1"}]]></example>
+			<example mimetype="application/json"><![CDATA[{"name":"n2"}]]></example>
+		</request>
+		<response status="201" type="object" mimetype="application/json">
+			<header name="Location" type="string" summary="location" />
+			<param name="id" type="number" summary="id" />
+			<example mimetype="application/json" summary="created"><![CDATA[{"id":1}]]></example>
+		</response>
+	</api>
+	<api method="DELETE">
+		<path path="/users" />
+		<response status="204" />
+	</api>
+</apidoc>`)})
+	rslt.Handler.Stop()
+	a.Empty(rslt.Errors)
+
+	c := convert(doc)
+	a.Equal(c.Info.Name, "title").
+		Equal(c.Info.Version, "1.0.0").
+		Equal(c.Info.Description, &Description{Content: "**desc**", Type: DescriptionTypeMarkdown})
+
+	a.Equal(c.Variable, []*Variable{
+		{Key: "prod", Value: "https://example.com", Type: "string", Description: &Description{Content: "prod"}},
+		{Key: "dev", Value: "https://dev.example.com", Type: "string", Description: &Description{Content: "dev"}},
+	})
+
+	// 空的标签不会生成文件夹，未指定标签的 API 放在顶层。
+	a.Equal(2, len(c.Items))
+	folder := c.Items[0]
+	a.Equal(folder.Name, "用户").Equal(1, len(folder.Items))
+	del := c.Items[1]
+	a.Equal(del.Name, "DELETE /users").
+		Nil(del.Items).
+		Equal(del.Request.URL.Raw, "{{prod}}/users").
+		Empty(del.Responses)
+
+	post := folder.Items[0]
+	a.Equal(post.Name, "create user")
+	req := post.Request
+	a.Equal(req.Method, "POST").
+		Equal(req.URL.Raw, "{{dev}}/users/:id?page=1").
+		Equal(req.URL.Host, []string{"{{dev}}"}).
+		Equal(req.URL.Path, []string{"users", ":id"}).
+		Equal(req.URL.Variables[0].Key, "id").
+		Equal(req.URL.Variables[0].Value, "1").
+		Equal(2, len(req.URL.Query)).
+		True(req.URL.Query[1].Disabled)
+
+	a.Equal(req.Headers, []*KeyValue{
+		{Key: "Authorization", Description: &Description{Content: "token"}},
+		{Key: "Content-Type", Value: "application/json"},
+	})
+	a.Equal(req.Body, &Body{
+		Mode:    BodyModeRaw,
+		Raw:     `{"name":"n1"}`,
+		Options: &BodyOptions{Raw: &RawOptions{Language: "json"}},
+	})
+
+	a.Equal(1, len(post.Responses))
+	resp := post.Responses[0]
+	a.Equal(resp.Name, "created").
+		Equal(resp.Code, 201).
+		Equal(resp.Status, "Created").
+		Equal(resp.PreviewLanguage, "json").
+		Equal(resp.Body, `{"id":1}`).
+		Equal(resp.OriginalRequest, req).
+		Equal(resp.Headers[0], &KeyValue{Key: "Content-Type", Value: "application/json"}).
+		Equal(resp.Headers[1].Key, "Location")
+}
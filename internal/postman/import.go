@@ -0,0 +1,234 @@
+// SPDX-License-Identifier: MIT
+
+package postman
+
+import (
+	"encoding/json"
+	"net/http"
+	neturl "net/url"
+	"strconv"
+	"strings"
+
+	"github.com/caixw/apidoc/v7/core"
+	"github.com/caixw/apidoc/v7/internal/ast"
+	"github.com/caixw/apidoc/v7/internal/infer"
+	"github.com/caixw/apidoc/v7/internal/locale"
+	"github.com/caixw/apidoc/v7/internal/xmlenc"
+)
+
+// 报文的语言类型与 mimetype 的对应关系，与 language 函数的作用相反。
+var languageMimetypes = map[string]string{
+	"json":       "application/json",
+	"xml":        "application/xml",
+	"html":       "text/html",
+	"javascript": "application/javascript",
+	"text":       "text/plain",
+}
+
+type importer struct {
+	b         *infer.Builder
+	variables map[string]string
+}
+
+// Import 从 Postman Collection v2.1 导入文档
+//
+// Collection 中仅有请求的示例，所以 API 的参数都是根据示例推断出来的，
+// 文件夹的名称会被作为标签，集合中的变量会被替换成实际的值。
+func Import(h *core.MessageHandler, uri core.URI) (*ast.APIDoc, error) {
+	data, err := uri.ReadAll(nil)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &Collection{}
+	if err := json.Unmarshal(data, c); err != nil {
+		return nil, core.Location{URI: uri}.WithError(err)
+	}
+	if c.Info == nil {
+		return nil, core.Location{URI: uri}.NewError(locale.ErrIsEmpty, "info").WithField("#/info")
+	}
+
+	i := &importer{
+		b:         infer.New(h, uri, c.Info.Name),
+		variables: make(map[string]string, len(c.Variable)),
+	}
+	for _, v := range c.Variable {
+		i.variables[v.Key] = v.Value
+	}
+	i.items("#/item", "", c.Items)
+
+	doc := i.b.Doc()
+	if c.Info.Version != "" {
+		doc.Version.Value.Value = c.Info.Version
+	}
+	doc.Description = newRichtext(c.Info.Description)
+	return doc, nil
+}
+
+func (i *importer) items(field, tag string, items []*Item) {
+	for index, item := range items {
+		f := field + "/" + strconv.Itoa(index)
+		if item.Request == nil { // 文件夹，以最内层的文件夹作为标签。
+			i.items(f+"/item", item.Name, item.Items)
+			continue
+		}
+		i.b.Add(f, i.sample(tag, item))
+	}
+}
+
+func (i *importer) sample(tag string, item *Item) *infer.Sample {
+	req := item.Request
+
+	s := &infer.Sample{
+		Method:      req.Method,
+		Summary:     item.Name,
+		Description: descriptionContent(req.Description),
+	}
+	if s.Method == "" {
+		s.Method = http.MethodGet
+	}
+	if s.Description == "" {
+		s.Description = descriptionContent(item.Description)
+	}
+	if tag != "" {
+		s.Tags = []string{tag}
+	}
+
+	if req.URL != nil {
+		s.Server, s.Path, s.Queries = i.url(req.URL)
+	}
+
+	if body := req.Body; body != nil {
+		s.Mimetype = header(req.Headers, "Content-Type")
+		switch body.Mode {
+		case BodyModeURLEncoded:
+			s.Form = i.keyValues(body.URLEncoded)
+			if s.Mimetype == "" {
+				s.Mimetype = "application/x-www-form-urlencoded"
+			}
+		case BodyModeFormData:
+			s.Form = i.keyValues(body.FormData)
+			if s.Mimetype == "" {
+				s.Mimetype = "multipart/form-data"
+			}
+		case BodyModeRaw:
+			s.Body = i.replace(body.Raw)
+			if s.Mimetype == "" && body.Options != nil && body.Options.Raw != nil {
+				s.Mimetype = languageMimetypes[body.Options.Raw.Language]
+			}
+		}
+	}
+
+	for _, resp := range item.Responses {
+		mt := header(resp.Headers, "Content-Type")
+		if mt == "" && resp.Body != "" {
+			mt = languageMimetypes[resp.PreviewLanguage]
+		}
+		s.Responses = append(s.Responses, &infer.Response{
+			Status:   resp.Code,
+			Mimetype: mt,
+			Body:     resp.Body,
+		})
+	}
+
+	return s
+}
+
+// 从地址中分离出服务器、路径和查询参数
+//
+// 仅采用 URL.Raw 的值，变量会被替换成实际的值，无法替换的变量会原样保留。
+func (i *importer) url(u *URL) (server, path string, queries []*infer.KeyValue) {
+	raw := i.replace(u.Raw)
+	if index := strings.IndexByte(raw, '#'); index >= 0 {
+		raw = raw[:index]
+	}
+
+	var query string
+	if index := strings.IndexByte(raw, '?'); index >= 0 {
+		raw, query = raw[:index], raw[index+1:]
+	}
+
+	start := 0
+	if index := strings.Index(raw, "://"); index >= 0 {
+		start = index + 3
+	}
+	if index := strings.IndexByte(raw[start:], '/'); index >= 0 {
+		server, path = raw[:start+index], raw[start+index:]
+	} else {
+		server, path = raw, "/"
+	}
+
+	if len(u.Query) > 0 {
+		queries = i.keyValues(u.Query)
+	} else if query != "" {
+		for _, kv := range strings.Split(query, "&") {
+			if kv == "" {
+				continue
+			}
+			var v string
+			if index := strings.IndexByte(kv, '='); index >= 0 {
+				kv, v = kv[:index], kv[index+1:]
+			}
+			queries = append(queries, &infer.KeyValue{Name: unescape(kv), Value: unescape(v)})
+		}
+	}
+
+	return server, path, queries
+}
+
+// 替换内容中 {{name}} 形式的变量
+func (i *importer) replace(s string) string {
+	for k, v := range i.variables {
+		s = strings.ReplaceAll(s, "{{"+k+"}}", v)
+	}
+	return s
+}
+
+// 被禁用的值不会出现在实际的请求中，直接忽略。
+func (i *importer) keyValues(kvs []*KeyValue) []*infer.KeyValue {
+	ret := make([]*infer.KeyValue, 0, len(kvs))
+	for _, kv := range kvs {
+		if !kv.Disabled {
+			ret = append(ret, &infer.KeyValue{Name: kv.Key, Value: i.replace(kv.Value)})
+		}
+	}
+	return ret
+}
+
+func header(headers []*KeyValue, key string) string {
+	for _, h := range headers {
+		if !h.Disabled && strings.EqualFold(h.Key, key) {
+			return h.Value
+		}
+	}
+	return ""
+}
+
+func unescape(s string) string {
+	if v, err := neturl.QueryUnescape(s); err == nil {
+		return v
+	}
+	return s
+}
+
+func descriptionContent(d *Description) string {
+	if d == nil {
+		return ""
+	}
+	return d.Content
+}
+
+func newRichtext(d *Description) *ast.Richtext {
+	if d == nil || d.Content == "" {
+		return nil
+	}
+
+	typ := ast.RichtextTypeMarkdown
+	if d.Type == DescriptionTypeHTML {
+		typ = ast.RichtextTypeHTML
+	}
+	return &ast.Richtext{
+		Type: &ast.Attribute{Value: xmlenc.String{Value: typ}},
+		Text: &ast.CData{Value: xmlenc.String{Value: d.Content}},
+	}
+}
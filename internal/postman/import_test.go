@@ -0,0 +1,80 @@
+// SPDX-License-Identifier: MIT
+
+package postman
+
+import (
+	"testing"
+
+	"github.com/issue9/assert"
+
+	"github.com/caixw/apidoc/v7/core"
+	"github.com/caixw/apidoc/v7/core/messagetest"
+	"github.com/caixw/apidoc/v7/internal/ast"
+	"github.com/caixw/apidoc/v7/internal/xmlenc"
+)
+
+func TestImport(t *testing.T) {
+	a := assert.New(t)
+
+	rslt := messagetest.NewMessageHandler()
+	d, err := Import(rslt.Handler, core.FileURI("./testdata/collection.json"))
+	rslt.Handler.Stop()
+	a.NotError(err).NotNil(d)
+	a.Empty(rslt.Errors).Equal(1, len(rslt.Warns))
+	a.Equal(rslt.Warns[0].(*core.Error).Field, "#/item/2")
+
+	a.Equal(d.Title.V(), "Users").
+		Equal(d.Description.V(), "internal **user** service").
+		Equal(1, len(d.Servers)).
+		Equal(d.Servers[0].URL.V(), "https://api.example.com").
+		Equal(1, len(d.Tags)).
+		Equal(d.Tags[0].Name.V(), "user").
+		Equal(4, len(d.APIs))
+
+	list := d.APIs[0]
+	a.Equal(list.Summary.V(), "list users").
+		Equal(list.Path.Path.V(), "/users").
+		Equal(2, len(list.Path.Queries)).
+		Equal(list.Path.Queries[1].Type.V(), ast.TypeInt).
+		Equal(list.Tags[0].V(), "user")
+	resp := list.Responses[0]
+	a.Equal(resp.Mimetype.V(), "application/json").
+		True(resp.Array.V()).
+		Equal(4, len(resp.Items)).
+		True(resp.Items[2].Nullable.V()).
+		True(resp.Items[3].Optional.V())
+
+	// /users/1 和 /users/:id 被合并到同一个 API
+	get := d.APIs[1]
+	a.Equal(get.Path.Path.V(), "/users/{id}").
+		Equal(get.Path.Params[0].Type.V(), ast.TypeInt).
+		Equal(2, len(get.Responses)).
+		Equal(get.Responses[0].Mimetype.V(), "application/json").
+		Equal(get.Responses[0].Items[2].Items[1].Type.V(), ast.TypeFloat).
+		Equal(get.Responses[1].Status.V(), 404)
+
+	create := d.APIs[2]
+	a.Equal(create.Method.V(), "POST").
+		Equal(create.Requests[0].Mimetype.V(), "application/json").
+		True(create.Requests[0].Items[1].Array.V()).
+		Equal(create.Responses[0].Status.V(), 201)
+
+	login := d.APIs[3]
+	a.Empty(login.Tags).
+		Equal(login.Requests[0].Mimetype.V(), "application/x-www-form-urlencoded").
+		Equal(2, len(login.Requests[0].Items))
+
+	data, err := xmlenc.Encode("\t", d, "", "")
+	a.NotError(err).NotEmpty(data)
+	rslt = messagetest.NewMessageHandler()
+	doc := &ast.APIDoc{}
+	doc.Parse(rslt.Handler, core.Block{Data: data})
+	rslt.Handler.Stop()
+	a.Empty(rslt.Errors)
+
+	// 文件不存在
+	rslt = messagetest.NewMessageHandler()
+	d, err = Import(rslt.Handler, core.FileURI("./testdata/not-exists.json"))
+	rslt.Handler.Stop()
+	a.Error(err).Nil(d)
+}
@@ -0,0 +1,200 @@
+// SPDX-License-Identifier: MIT
+
+// Package postman 实现 Postman Collection v2.1 的相关数据类型
+//
+// https://schema.postman.com/json/collection/v2.1.0/docs/index.html
+package postman
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+)
+
+// Schema 当前实现的 Postman Collection 版本
+const Schema = "https://schema.getpostman.com/json/collection/v2.1.0/collection.json"
+
+// 描述内容的类型
+const (
+	DescriptionTypeHTML     = "text/html"
+	DescriptionTypeMarkdown = "text/markdown"
+)
+
+// 请求报文的类型
+const (
+	BodyModeRaw        = "raw"
+	BodyModeURLEncoded = "urlencoded"
+	BodyModeFormData   = "formdata"
+	BodyModeFile       = "file"
+)
+
+// Collection Postman 集合的根对象
+type Collection struct {
+	Info     *Info       `json:"info"`
+	Items    []*Item     `json:"item"`
+	Variable []*Variable `json:"variable,omitempty"`
+}
+
+// Info 集合的基本信息
+type Info struct {
+	Name        string       `json:"name"`
+	Description *Description `json:"description,omitempty"`
+	Version     string       `json:"version,omitempty"`
+	Schema      string       `json:"schema"`
+}
+
+// Item 表示集合中的一个元素
+//
+// 如果 Items 不为空，表示一个文件夹，否则为单个请求。
+type Item struct {
+	Name        string       `json:"name"`
+	Description *Description `json:"description,omitempty"`
+	Items       []*Item      `json:"item,omitempty"`
+	Request     *Request     `json:"request,omitempty"`
+	Responses   []*Response  `json:"response,omitempty"`
+}
+
+// Request 请求的内容
+//
+// 在 JSON 中也可以是一个表示地址的字符串，此时请求方法为 GET。
+type Request struct {
+	Method      string       `json:"method"`
+	URL         *URL         `json:"url"`
+	Headers     []*KeyValue  `json:"header,omitempty"`
+	Body        *Body        `json:"body,omitempty"`
+	Description *Description `json:"description,omitempty"`
+}
+
+// URL 请求地址
+//
+// 在 JSON 中可以是一个字符串，也可以是一个对象，
+// 为字符串时，仅 Raw 字段有值。
+type URL struct {
+	Raw       string      `json:"raw"`
+	Protocol  string      `json:"protocol,omitempty"`
+	Host      []string    `json:"host,omitempty"`
+	Path      []string    `json:"path,omitempty"`
+	Query     []*KeyValue `json:"query,omitempty"`
+	Variables []*Variable `json:"variable,omitempty"`
+}
+
+// KeyValue 表示报头、查询参数等键值对
+type KeyValue struct {
+	Key         string       `json:"key"`
+	Value       string       `json:"value"`
+	Description *Description `json:"description,omitempty"`
+	Disabled    bool         `json:"disabled,omitempty"`
+}
+
+// Variable 变量
+//
+// 可以是集合级别的变量，也可以是 URL 中的路径参数。
+type Variable struct {
+	Key         string       `json:"key"`
+	Value       string       `json:"value"`
+	Type        string       `json:"type,omitempty"`
+	Description *Description `json:"description,omitempty"`
+}
+
+// Body 请求的报文内容
+type Body struct {
+	Mode       string       `json:"mode"`
+	Raw        string       `json:"raw,omitempty"`
+	URLEncoded []*KeyValue  `json:"urlencoded,omitempty"`
+	FormData   []*KeyValue  `json:"formdata,omitempty"`
+	Options    *BodyOptions `json:"options,omitempty"`
+}
+
+// BodyOptions 报文的额外设置
+type BodyOptions struct {
+	Raw *RawOptions `json:"raw,omitempty"`
+}
+
+// RawOptions mode 为 raw 时的设置项
+type RawOptions struct {
+	Language string `json:"language"`
+}
+
+// Response 保存的返回示例
+type Response struct {
+	Name            string      `json:"name"`
+	OriginalRequest *Request    `json:"originalRequest,omitempty"`
+	Status          string      `json:"status,omitempty"`
+	Code            int         `json:"code,omitempty"`
+	PreviewLanguage string      `json:"_postman_previewlanguage,omitempty"`
+	Headers         []*KeyValue `json:"header,omitempty"`
+	Body            string      `json:"body,omitempty"`
+}
+
+// Description 描述内容
+//
+// 在 JSON 中可以是一个字符串，也可以是包含 content 和 type 的对象，
+// Type 为空时以字符串的形式输出。
+type Description struct {
+	Content string `json:"content"`
+	Type    string `json:"type,omitempty"`
+}
+
+type description Description
+
+// MarshalJSON json.Marshaler
+func (d *Description) MarshalJSON() ([]byte, error) {
+	if d.Type == "" {
+		return json.Marshal(d.Content)
+	}
+	return json.Marshal((*description)(d))
+}
+
+// UnmarshalJSON json.Unmarshaler
+func (d *Description) UnmarshalJSON(data []byte) error {
+	if len(data) > 0 && data[0] == '"' {
+		return json.Unmarshal(data, &d.Content)
+	}
+	return json.Unmarshal(data, (*description)(d))
+}
+
+type request Request
+
+// UnmarshalJSON json.Unmarshaler
+func (r *Request) UnmarshalJSON(data []byte) error {
+	if len(data) > 0 && data[0] == '"' {
+		r.Method = http.MethodGet
+		r.URL = &URL{}
+		return json.Unmarshal(data, &r.URL.Raw)
+	}
+	return json.Unmarshal(data, (*request)(r))
+}
+
+type url URL
+
+// MarshalJSON json.Marshaler
+func (u *URL) MarshalJSON() ([]byte, error) {
+	if len(u.Host) == 0 && len(u.Path) == 0 && len(u.Query) == 0 && len(u.Variables) == 0 {
+		return json.Marshal(u.Raw)
+	}
+	return json.Marshal((*url)(u))
+}
+
+// UnmarshalJSON json.Unmarshaler
+func (u *URL) UnmarshalJSON(data []byte) error {
+	if len(data) > 0 && data[0] == '"' {
+		return json.Unmarshal(data, &u.Raw)
+	}
+	return json.Unmarshal(data, (*url)(u))
+}
+
+// 根据 mimetype 获取报文的语言类型，同时用于 RawOptions.Language 和 Response.PreviewLanguage。
+func language(mimetype string) string {
+	switch {
+	case strings.Contains(mimetype, "json"):
+		return "json"
+	case strings.Contains(mimetype, "xml"):
+		return "xml"
+	case strings.Contains(mimetype, "html"):
+		return "html"
+	case strings.Contains(mimetype, "javascript"):
+		return "javascript"
+	default:
+		return "text"
+	}
+}
@@ -0,0 +1,56 @@
+// SPDX-License-Identifier: MIT
+
+package postman
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/issue9/assert"
+)
+
+func TestDescription(t *testing.T) {
+	a := assert.New(t)
+
+	data, err := json.Marshal(&Description{Content: "desc"})
+	a.NotError(err).Equal(string(data), `"desc"`)
+
+	data, err = json.Marshal(&Description{Content: "desc", Type: DescriptionTypeHTML})
+	a.NotError(err).Equal(string(data), `{"content":"desc","type":"text/html"}`)
+
+	d := &Description{}
+	a.NotError(json.Unmarshal([]byte(`"desc"`), d)).
+		Equal(d, &Description{Content: "desc"})
+
+	d = &Description{}
+	a.NotError(json.Unmarshal([]byte(`{"content":"desc","type":"text/markdown"}`), d)).
+		Equal(d, &Description{Content: "desc", Type: DescriptionTypeMarkdown})
+}
+
+func TestURL(t *testing.T) {
+	a := assert.New(t)
+
+	data, err := json.Marshal(&URL{Raw: "https://example.com"})
+	a.NotError(err).Equal(string(data), `"https://example.com"`)
+
+	data, err = json.Marshal(&URL{Raw: "{{srv}}/users", Host: []string{"{{srv}}"}, Path: []string{"users"}})
+	a.NotError(err).Equal(string(data), `{"raw":"{{srv}}/users","host":["{{srv}}"],"path":["users"]}`)
+
+	u := &URL{}
+	a.NotError(json.Unmarshal([]byte(`"https://example.com/users?id=1"`), u)).
+		Equal(u.Raw, "https://example.com/users?id=1")
+
+	u = &URL{}
+	a.NotError(json.Unmarshal([]byte(`{"raw":"https://example.com/users","path":["users"]}`), u)).
+		Equal(u.Raw, "https://example.com/users").
+		Equal(u.Path, []string{"users"})
+}
+
+func TestLanguage(t *testing.T) {
+	a := assert.New(t)
+
+	a.Equal(language("application/json"), "json")
+	a.Equal(language("application/problem+json"), "json")
+	a.Equal(language("text/xml"), "xml")
+	a.Equal(language("text/plain"), "text")
+}
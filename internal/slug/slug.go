@@ -0,0 +1,59 @@
+// SPDX-License-Identifier: MIT
+
+// Package slug 将任意字符串转换成可以作为文件名的字符串
+package slug
+
+import (
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// Make 将 name 转换成可以作为文件名的字符串
+//
+// 保留字母的大小写，连续的非法字符以单个 - 代替，去掉首尾的 - 和 .，
+// 比如 application/json 转换成 application-json，../x 转换成 x。
+// 转换后的内容不会包含路径分隔符，也不会是 . 或 ..。
+func Make(name string) string {
+	var b strings.Builder
+	dash := false
+	for _, r := range name {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_' || r == '.' {
+			if dash && b.Len() > 0 {
+				b.WriteByte('-')
+			}
+			b.WriteRune(r)
+			dash = false
+			continue
+		}
+		dash = true
+	}
+	return strings.Trim(b.String(), ".-")
+}
+
+// Unique 为 names 中的每个元素生成互不相同的 slug
+//
+// 返回值与 names 一一对应。转换后为空的以 def 代替，
+// 与之前的元素或是 reserved 中的值重复时，依次加上 -2、-3 等后缀。
+func Unique(names []string, def string, reserved ...string) []string {
+	used := make(map[string]bool, len(names)+len(reserved))
+	for _, r := range reserved {
+		used[r] = true
+	}
+
+	ret := make([]string, 0, len(names))
+	for _, name := range names {
+		base := Make(name)
+		if base == "" {
+			base = def
+		}
+
+		s := base
+		for i := 2; used[s]; i++ {
+			s = base + "-" + strconv.Itoa(i)
+		}
+		used[s] = true
+		ret = append(ret, s)
+	}
+	return ret
+}
@@ -0,0 +1,29 @@
+// SPDX-License-Identifier: MIT
+
+package slug
+
+import (
+	"testing"
+
+	"github.com/issue9/assert"
+)
+
+func TestMake(t *testing.T) {
+	a := assert.New(t)
+
+	a.Equal(Make("application/json"), "application-json")
+	a.Equal(Make("Get User"), "Get-User")
+	a.Equal(Make("a/b"), "a-b")
+	a.Equal(Make(`a\b`), "a-b")
+	a.Equal(Make("../x"), "x")
+	a.Equal(Make("用户"), "用户")
+	a.Equal(Make(".."), "")
+	a.Equal(Make("/"), "")
+}
+
+func TestUnique(t *testing.T) {
+	a := assert.New(t)
+
+	a.Equal(Unique([]string{"a/b", "a-b", "a b", "..", ""}, "tag"), []string{"a-b", "a-b-2", "a-b-3", "tag", "tag-2"})
+	a.Equal(Unique([]string{"index", "x"}, "tag", "index"), []string{"index-2", "x"})
+}
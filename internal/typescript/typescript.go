@@ -0,0 +1,334 @@
+// SPDX-License-Identifier: MIT
+
+// Package typescript 根据文档生成 TypeScript 的类型定义
+package typescript
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/issue9/errwrap"
+
+	"github.com/caixw/apidoc/v7/core"
+	"github.com/caixw/apidoc/v7/internal/ast"
+)
+
+// Ext 生成文件的扩展名
+const Ext = ".d.ts"
+
+const indent = "  "
+
+var identifier = regexp.MustCompile(`^[A-Za-z_$][A-Za-z0-9_$]*$`)
+
+type writer struct {
+	errwrap.Buffer
+	doc   *ast.APIDoc
+	names map[string]bool // 已经使用的类型名称，防止重名。
+}
+
+// TypeScript 将 doc 转换成 TypeScript 的类型定义
+//
+// 每个 type 元素生成一个同名的类型；每个 API 的请求和返回报文分别生成
+// 以 API 名称加上 Request 和 Response 命名的类型，API 名称优先采用 api.id，
+// 否则由请求方法和路径组成。有多个返回状态码时，以 Response 加上状态码命名。
+func TypeScript(doc *ast.APIDoc) ([]byte, error) {
+	w := &writer{
+		doc:   doc,
+		names: make(map[string]bool, len(doc.Types)+len(doc.APIs)*2),
+	}
+
+	w.WString("// Code generated by ").WString(core.Name).WString(". DO NOT EDIT.\n")
+	w.WString("// ").WString(doc.Title.V()).WString(" ").WString(doc.Version.V()).WString("\n")
+
+	for _, t := range doc.Types {
+		w.names[typeName(t.Name.V())] = true
+	}
+	for _, t := range doc.Types {
+		w.writeTypeDef(t)
+	}
+
+	for _, api := range doc.APIs {
+		w.writeAPI(api)
+	}
+
+	if w.Err != nil {
+		return nil, w.Err
+	}
+	return w.Bytes(), nil
+}
+
+func (w *writer) writeTypeDef(t *ast.TypeDef) {
+	w.WByte('\n')
+	w.writeComment("", summary(t.Summary, t.Description), t.Deprecated)
+	w.writeDeclaration(typeName(t.Name.V()), t.Param())
+}
+
+func (w *writer) writeAPI(api *ast.API) {
+	name := w.uniqueName(apiName(api))
+	title := api.Method.V() + " " + api.Path.Path.V()
+	if s := summary(api.Summary, api.Description); s != "" {
+		title = s + "\n\n" + title
+	}
+
+	if req := body(api.Requests); req != nil {
+		w.WByte('\n')
+		w.writeComment("", title, api.Deprecated)
+		w.writeDeclaration(w.uniqueName(name+"Request"), req.Param())
+	}
+
+	responses := make([]*ast.Request, 0, len(api.Responses))
+	for _, resp := range api.Responses {
+		if resp.Type.V() != ast.TypeNone && !hasStatus(responses, resp.Status.V()) {
+			responses = append(responses, resp)
+		}
+	}
+	for _, resp := range responses {
+		n := name + "Response"
+		if len(responses) > 1 {
+			n += strconv.Itoa(resp.Status.V())
+		}
+
+		w.WByte('\n')
+		w.writeComment("", title, api.Deprecated)
+		w.writeDeclaration(w.uniqueName(n), resp.Param())
+	}
+}
+
+// 对象类型输出为 interface，其它类型输出为 type。
+func (w *writer) writeDeclaration(name string, p *ast.Param) {
+	if p.Type.V() == ast.TypeObject && len(p.OneOf) == 0 && len(p.AnyOf) == 0 && !p.Array.V() && !p.Nullable.V() {
+		w.WString("export interface ").WString(name).WByte(' ')
+		w.writeObject("", p.Items)
+		w.WByte('\n')
+		return
+	}
+
+	w.WString("export type ").WString(name).WString(" = ")
+	w.writeType("", p)
+	w.WString(";\n")
+}
+
+func (w *writer) writeObject(prefix string, items []*ast.Param) {
+	w.WString("{\n")
+	for _, item := range items {
+		pp := prefix + indent
+		w.writeComment(pp, summary(item.Summary, item.Description), item.Deprecated)
+		w.WString(pp).WString(propertyName(item.Name.V()))
+		if item.Optional.V() {
+			w.WByte('?')
+		}
+		w.WString(": ")
+		w.writeType(pp, item)
+		w.WString(";\n")
+	}
+	w.WString(prefix).WByte('}')
+}
+
+// 输出 p 所表示的类型表达式，prefix 为当前行的缩进。
+func (w *writer) writeType(prefix string, p *ast.Param) {
+	array := p.Array.V()
+	nullable := p.Nullable.V()
+
+	// 联合类型在数组和 null 中需要加括号
+	union := len(p.Enums) > 1 || len(p.OneOf) > 0 || len(p.AnyOf) > 0
+	if union && array {
+		w.WByte('(')
+	}
+
+	switch {
+	case len(p.Enums) > 0:
+		for i, e := range p.Enums {
+			if i > 0 {
+				w.WString(" | ")
+			}
+			w.WString(enumValue(p.Resolve().Type.V(), e.Value.V()))
+		}
+	case len(p.OneOf) > 0 || len(p.AnyOf) > 0:
+		for i, b := range p.Branches() {
+			if i > 0 {
+				w.WString(" | ")
+			}
+			w.writeObject(prefix, b.Items)
+		}
+	case p.Type.TypeDef() != nil:
+		w.WString(typeName(p.Type.TypeDef().Name.V()))
+	case p.Type.V() == ast.TypeObject:
+		w.writeObject(prefix, p.Items)
+	case p.Type.V() == ast.TypeMap && len(p.Items) > 0:
+		w.WString("Record<string, ")
+		w.writeType(prefix, p.Items[0])
+		w.WByte('>')
+	default:
+		w.WString(primitive(p.Type.V()))
+	}
+
+	if union && array {
+		w.WByte(')')
+	}
+	if array {
+		w.WString("[]")
+	}
+	if nullable {
+		w.WString(" | null")
+	}
+}
+
+// 输出 JSDoc 格式的注释，text 和 deprecated 都为空时不输出任何内容。
+func (w *writer) writeComment(prefix, text string, deprecated *ast.VersionAttribute) {
+	var lines []string
+	if text != "" {
+		lines = strings.Split(strings.ReplaceAll(text, "*/", "*\\/"), "\n")
+	}
+	if deprecated != nil && deprecated.V() != "" {
+		lines = append(lines, "@deprecated "+deprecated.V())
+	}
+
+	switch len(lines) {
+	case 0:
+		return
+	case 1:
+		w.WString(prefix).WString("/** ").WString(lines[0]).WString(" */\n")
+		return
+	}
+
+	w.WString(prefix).WString("/**\n")
+	for _, line := range lines {
+		w.WString(prefix).WString(" *")
+		if line = strings.TrimRightFunc(line, unicode.IsSpace); line != "" {
+			w.WByte(' ').WString(line)
+		}
+		w.WByte('\n')
+	}
+	w.WString(prefix).WString(" */\n")
+}
+
+// 在 name 已经被使用的情况下，添加数字后缀。
+func (w *writer) uniqueName(name string) string {
+	n := name
+	for i := 2; w.names[n]; i++ {
+		n = name + strconv.Itoa(i)
+	}
+	w.names[n] = true
+	return n
+}
+
+// 返回第一个指定了类型的请求
+//
+// 不同 mimetype 的请求一般描述的是同一结构，只取其中之一。
+func body(requests []*ast.Request) *ast.Request {
+	for _, r := range requests {
+		if r.Type.V() != ast.TypeNone {
+			return r
+		}
+	}
+	return nil
+}
+
+func hasStatus(responses []*ast.Request, status int) bool {
+	for _, r := range responses {
+		if r.Status.V() == status {
+			return true
+		}
+	}
+	return false
+}
+
+// 由 api.id 或是请求方法和路径组成 API 的名称
+//
+// 路径中的参数以 By 作为前缀，比如 GET /users/{id} 转换成 GetUsersById。
+func apiName(api *ast.API) string {
+	if id := api.ID.V(); id != "" {
+		return typeName(id)
+	}
+
+	name := pascal(strings.ToLower(api.Method.V()))
+	for _, seg := range strings.Split(api.Path.Path.V(), "/") {
+		if len(seg) > 2 && seg[0] == '{' && seg[len(seg)-1] == '}' {
+			seg = "by-" + seg[1:len(seg)-1]
+		}
+		name += pascal(seg)
+	}
+	return name
+}
+
+// 将任意字符串转换成以大写字母开头的标识符
+//
+// 转换之后为空或是以数字开头的，会加上 T 作为前缀。
+func typeName(s string) string {
+	name := pascal(s)
+	if name == "" || unicode.IsDigit([]rune(name)[0]) {
+		name = "T" + name
+	}
+	return name
+}
+
+// 非字母和数字的字符被当作分隔符，分隔之后的每一段首字母大写。
+func pascal(s string) string {
+	var b strings.Builder
+	upper := true
+	for _, r := range s {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			upper = true
+			continue
+		}
+
+		if upper {
+			r = unicode.ToUpper(r)
+			upper = false
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+func propertyName(name string) string {
+	if identifier.MatchString(name) {
+		return name
+	}
+	return strconv.Quote(name)
+}
+
+func primitive(typ string) string {
+	switch {
+	case typ == ast.TypeBool:
+		return "boolean"
+	case typ == ast.TypeNumber || strings.HasPrefix(typ, ast.TypeNumber+"."):
+		return "number"
+	case typ == ast.TypeBinary:
+		return "Blob"
+	case typ == ast.TypeString || strings.HasPrefix(typ, ast.TypeString+"."):
+		return "string"
+	case typ == ast.TypeMap:
+		return "Record<string, unknown>"
+	default:
+		return "unknown"
+	}
+}
+
+// 枚举值根据类型转换成对应的字面量
+func enumValue(typ, v string) string {
+	switch primitive(typ) {
+	case "number":
+		if _, err := strconv.ParseFloat(v, 64); err == nil {
+			return v
+		}
+	case "boolean":
+		if v == "true" || v == "false" {
+			return v
+		}
+	}
+	return strconv.Quote(v)
+}
+
+// 优先采用 summary，否则采用 markdown 格式的描述内容。
+func summary(s *ast.Attribute, desc *ast.Richtext) string {
+	if v := s.V(); v != "" {
+		return v
+	}
+	if desc != nil && desc.Type.V() == ast.RichtextTypeMarkdown {
+		return strings.TrimSpace(desc.V())
+	}
+	return ""
+}
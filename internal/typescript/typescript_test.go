@@ -0,0 +1,189 @@
+// SPDX-License-Identifier: MIT
+
+package typescript
+
+import (
+	"testing"
+
+	"github.com/issue9/assert"
+
+	"github.com/caixw/apidoc/v7/core"
+	"github.com/caixw/apidoc/v7/core/messagetest"
+	"github.com/caixw/apidoc/v7/internal/ast"
+	"github.com/caixw/apidoc/v7/internal/ast/asttest"
+)
+
+func TestTypeScript(t *testing.T) {
+	a := assert.New(t)
+
+	data, err := TypeScript(asttest.Get())
+	a.NotError(err).NotEmpty(data)
+
+	rslt := messagetest.NewMessageHandler()
+	doc := &ast.APIDoc{}
+	doc.Parse(rslt.Handler, core.Block{Data: []byte(`<apidoc version="1.0.0">
+	<title>title</title>
+	<mimetype>application/json</mimetype>
+	<type name="user" type="object" summary="user">
+		<param name="id" type="number.int" summary="id" />
+		<param name="name" type="string" summary="name" deprecated="1.0.1" />
+		<param name="sex" type="string" optional="true" summary="sex">
+			<enum value="male" summary="male" />
+			<enum value="female" summary="female" />
+		</param>
+		<param name="tags" type="string" array="true" nullable="true" summary="tags" />
+		<param name="x-attrs" type="map" summary="attrs"><param name="value" type="number" summary="value" /></param>
+	</type>
+	<api method="GET" summary="get user" deprecated="1.0.2">
+		<path path="/users/{id}"><param name="id" type="number" summary="id" /></path>
+		<response status="200" type="#user" mimetype="application/json" />
+	</api>
+	<api method="POST" id="create-user">
+		<path path="/users" />
+		<request type="object" mimetype="application/json">
+			<param name="name" type="string" summary="name" />
+			<param name="profile" type="object" optional="true" summary="profile">
+				<param name="avatar" type="string.image" summary="avatar" />
+			</param>
+		</request>
+		<response status="201" type="#user" array="true" mimetype="application/json" />
+		<response status="400" type="object" mimetype="application/json">
+			<param name="message" type="string" summary="message" />
+		</response>
+		<response status="400" type="object" mimetype="application/xml">
+			<param name="message" type="string" summary="message" />
+		</response>
+	</api>
+	<api method="DELETE">
+		<path path="/users/{id}"><param name="id" type="number" summary="id" /></path>
+		<response status="204" />
+	</api>
+</apidoc>`)})
+	rslt.Handler.Stop()
+	a.Empty(rslt.Errors)
+
+	data, err = TypeScript(doc)
+	a.NotError(err)
+	a.Equal(string(data), `// Code generated by apidoc. DO NOT EDIT.
+// title 1.0.0
+
+/** user */
+export interface User {
+  /** id */
+  id: number;
+  /**
+   * name
+   * @deprecated 1.0.1
+   */
+  name: string;
+  /** sex */
+  sex?: "male" | "female";
+  /** tags */
+  tags: string[] | null;
+  /** attrs */
+  "x-attrs": Record<string, number>;
+}
+
+/** POST /users */
+export interface CreateUserRequest {
+  /** name */
+  name: string;
+  /** profile */
+  profile?: {
+    /** avatar */
+    avatar: string;
+  };
+}
+
+/** POST /users */
+export type CreateUserResponse201 = User[];
+
+/** POST /users */
+export interface CreateUserResponse400 {
+  /** message */
+  message: string;
+}
+
+/**
+ * get user
+ *
+ * GET /users/{id}
+ * @deprecated 1.0.2
+ */
+export type GetUsersByIdResponse = User;
+`)
+}
+
+func TestTypeName(t *testing.T) {
+	a := assert.New(t)
+
+	a.Equal(typeName("user"), "User")
+	a.Equal(typeName("create-user"), "CreateUser")
+	a.Equal(typeName("get_user_by id"), "GetUserById")
+	a.Equal(typeName("1user"), "T1user")
+	a.Equal(typeName(""), "T")
+}
+
+func TestPropertyName(t *testing.T) {
+	a := assert.New(t)
+
+	a.Equal(propertyName("id"), "id")
+	a.Equal(propertyName("$id"), "$id")
+	a.Equal(propertyName("x-id"), `"x-id"`)
+	a.Equal(propertyName("1id"), `"1id"`)
+}
+
+func TestEnumValue(t *testing.T) {
+	a := assert.New(t)
+
+	a.Equal(enumValue(ast.TypeInt, "1"), "1")
+	a.Equal(enumValue(ast.TypeInt, "x"), `"x"`)
+	a.Equal(enumValue(ast.TypeBool, "true"), "true")
+	a.Equal(enumValue(ast.TypeString, `a"b`), `"a\"b"`)
+}
+
+func TestTypeScript_union(t *testing.T) {
+	a := assert.New(t)
+
+	rslt := messagetest.NewMessageHandler()
+	doc := &ast.APIDoc{}
+	doc.Parse(rslt.Handler, core.Block{Data: []byte(`<apidoc version="1.0.0">
+	<title>title</title>
+	<mimetype>application/json</mimetype>
+	<type name="dog" type="object" summary="dog">
+		<param name="bark" type="bool" summary="bark" />
+	</type>
+	<type name="pet" type="object" summary="pet" discriminator="kind">
+		<param name="kind" type="string" summary="kind" />
+		<one-of name="dog" type="#dog" summary="dog" />
+		<one-of name="cat" type="object" summary="cat"><param name="meow" type="bool" summary="meow" /></one-of>
+	</type>
+</apidoc>`)})
+	rslt.Handler.Stop()
+	a.Empty(rslt.Errors)
+
+	data, err := TypeScript(doc)
+	a.NotError(err)
+	a.Equal(string(data), `// Code generated by apidoc. DO NOT EDIT.
+// title 1.0.0
+
+/** dog */
+export interface Dog {
+  /** bark */
+  bark: boolean;
+}
+
+/** pet */
+export type Pet = {
+  /** kind */
+  kind: "dog";
+  /** bark */
+  bark: boolean;
+} | {
+  /** kind */
+  kind: "cat";
+  /** meow */
+  meow: boolean;
+};
+`)
+}
@@ -0,0 +1,35 @@
+// SPDX-License-Identifier: MIT
+
+package upgrade
+
+import (
+	"regexp"
+
+	"github.com/issue9/version"
+
+	"github.com/caixw/apidoc/v7/core"
+	"github.com/caixw/apidoc/v7/internal/ast"
+)
+
+// 配置文件中顶层的 version 字段，保留其引号和之后的注释等内容。
+var configVersion = regexp.MustCompile(`(?m)^(version:[ \t]*["']?)([^"'\s#]+)`)
+
+// Config 升级配置文件的内容
+//
+// 仅修改其中的 version 字段，其它内容保持不变。
+// 如果不需要修改，返回的 File.Summary.Total() 为 0。
+func Config(uri core.URI, data []byte) *File {
+	f := newFile(uri, data)
+
+	loc := configVersion.FindSubmatchIndex(data)
+	if loc == nil {
+		return f
+	}
+
+	v, err := version.SemVerCompare(string(data[loc[4]:loc[5]]), ast.Version)
+	if err == nil && v < 0 {
+		f.addEdit(loc[4], loc[5], ast.Version)
+		f.Summary.Version++
+	}
+	return f
+}
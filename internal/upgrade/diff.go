@@ -0,0 +1,196 @@
+// SPDX-License-Identifier: MIT
+
+package upgrade
+
+import (
+	"bytes"
+	"strconv"
+
+	"github.com/issue9/errwrap"
+)
+
+// 每个区块前后保留的上下文行数
+const contextLines = 3
+
+type operation int8
+
+const (
+	opEqual operation = iota
+	opDelete
+	opInsert
+)
+
+type diffLine struct {
+	op   operation
+	text []byte
+}
+
+// Diff 返回升级前后内容的 unified diff 格式
+//
+// name 为输出在 --- 和 +++ 之后的文件名。没有修改时返回 nil。
+func (f *File) Diff(name string) []byte {
+	lines := diffLines(splitLines(f.Data), splitLines(f.Bytes()))
+
+	var buf errwrap.Buffer
+	var oldLine, newLine int // 当前行在原始内容和新内容中的行号，从 0 开始。
+	for i := 0; i < len(lines); {
+		if lines[i].op == opEqual {
+			oldLine++
+			newLine++
+			i++
+			continue
+		}
+
+		// 确定区块的范围，两处修改之间的相同内容不超过 2*contextLines 的合并为一个区块。
+		start := i - contextLines
+		if start < 0 {
+			start = 0
+		}
+		end := i
+		for j := i; j < len(lines); j++ {
+			if lines[j].op != opEqual {
+				end = j + 1
+				continue
+			}
+			if j-end >= 2*contextLines {
+				break
+			}
+		}
+		if end += contextLines; end > len(lines) {
+			end = len(lines)
+		}
+
+		oldStart, newStart := oldLine-(i-start), newLine-(i-start)
+		var oldCount, newCount int
+		for _, l := range lines[start:end] {
+			if l.op != opInsert {
+				oldCount++
+			}
+			if l.op != opDelete {
+				newCount++
+			}
+		}
+
+		if buf.Len() == 0 {
+			buf.Printf("--- a/%s\n+++ b/%s\n", name, name)
+		}
+		buf.Printf("@@ -%s +%s @@\n", hunkRange(oldStart, oldCount), hunkRange(newStart, newCount))
+		for _, l := range lines[start:end] {
+			switch l.op {
+			case opEqual:
+				buf.WByte(' ')
+			case opDelete:
+				buf.WByte('-')
+			case opInsert:
+				buf.WByte('+')
+			}
+			buf.WBytes(l.text)
+			if len(l.text) == 0 || l.text[len(l.text)-1] != '\n' {
+				buf.WString("\n\\ No newline at end of file\n")
+			}
+		}
+
+		oldLine += oldCount - (i - start)
+		newLine += newCount - (i - start)
+		i = end
+	}
+
+	if buf.Len() == 0 {
+		return nil
+	}
+	return buf.Bytes()
+}
+
+func hunkRange(start, count int) string {
+	if count == 0 {
+		return strconv.Itoa(start) + ",0"
+	}
+	return strconv.Itoa(start+1) + "," + strconv.Itoa(count)
+}
+
+// 按行拆分，每一行都包含换行符。
+func splitLines(data []byte) [][]byte {
+	lines := make([][]byte, 0, bytes.Count(data, []byte{'\n'})+1)
+	for len(data) > 0 {
+		index := bytes.IndexByte(data, '\n')
+		if index < 0 {
+			lines = append(lines, data)
+			break
+		}
+		lines = append(lines, data[:index+1])
+		data = data[index+1:]
+	}
+	return lines
+}
+
+// 采用 Myers 算法比较 a 和 b 的差异
+//
+// 升级时的修改都比较少，所以不需要考虑线性空间的优化。
+func diffLines(a, b [][]byte) []*diffLine {
+	n, m := len(a), len(b)
+	max := n + m
+	v := make([]int, 2*max+2)
+	trace := make([][]int, 0, 10)
+
+LOOP:
+	for d := 0; d <= max; d++ {
+		vc := make([]int, len(v))
+		copy(vc, v)
+		trace = append(trace, vc)
+
+		for k := -d; k <= d; k += 2 {
+			var x int
+			if k == -d || (k != d && v[max+k-1] < v[max+k+1]) {
+				x = v[max+k+1]
+			} else {
+				x = v[max+k-1] + 1
+			}
+			y := x - k
+			for x < n && y < m && bytes.Equal(a[x], b[y]) {
+				x++
+				y++
+			}
+			v[max+k] = x
+			if x >= n && y >= m {
+				break LOOP
+			}
+		}
+	}
+
+	// 回溯生成结果
+	lines := make([]*diffLine, 0, n+m)
+	x, y := n, m
+	for d := len(trace) - 1; d >= 0; d-- {
+		v := trace[d]
+		k := x - y
+
+		var prevK int
+		if k == -d || (k != d && v[max+k-1] < v[max+k+1]) {
+			prevK = k + 1
+		} else {
+			prevK = k - 1
+		}
+		prevX := v[max+prevK]
+		prevY := prevX - prevK
+
+		for x > prevX && y > prevY {
+			x--
+			y--
+			lines = append(lines, &diffLine{op: opEqual, text: a[x]})
+		}
+		if d > 0 {
+			if x == prevX {
+				y--
+				lines = append(lines, &diffLine{op: opInsert, text: b[y]})
+			} else {
+				x--
+				lines = append(lines, &diffLine{op: opDelete, text: a[x]})
+			}
+		}
+	}
+
+	for i, j := 0, len(lines)-1; i < j; i, j = i+1, j-1 {
+		lines[i], lines[j] = lines[j], lines[i]
+	}
+	return lines
+}
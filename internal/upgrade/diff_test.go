@@ -0,0 +1,52 @@
+// SPDX-License-Identifier: MIT
+
+package upgrade
+
+import (
+	"testing"
+
+	"github.com/issue9/assert"
+)
+
+func TestFile_Diff(t *testing.T) {
+	a := assert.New(t)
+
+	f := newFile("doc.go", []byte("1\n2\n3\n4\n5\n6\n7\n8\n9\n10\n11\n12\n13\n14\n15\n16\n17\n18\n19\n20"))
+	a.Nil(f.Diff("doc.go"))
+
+	f.addEdit(f.lines[1], f.lines[2], "") // 删除第 2 行
+	f.addEdit(f.lines[3]+1, f.lines[3]+1, "\n3.5")
+	f.addEdit(f.lines[17], f.lines[17]+2, "x18")
+	f.addEdit(f.lines[19]+2, f.lines[19]+2, "\n")
+	a.Equal(string(f.Diff("doc.go")), `--- a/doc.go
++++ b/doc.go
+@@ -1,7 +1,7 @@
+ 1
+-2
+ 3
+ 4
++3.5
+ 5
+ 6
+ 7
+@@ -15,6 +15,6 @@
+ 15
+ 16
+ 17
+-18
++x18
+ 19
+-20
+\ No newline at end of file
++20
+`)
+
+	f = newFile("doc.go", []byte("1\n2\n"))
+	f.addEdit(0, 4, "")
+	a.Equal(string(f.Diff("doc.go")), `--- a/doc.go
++++ b/doc.go
+@@ -1,2 +0,0 @@
+-1
+-2
+`)
+}
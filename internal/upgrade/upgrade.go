@@ -0,0 +1,359 @@
+// SPDX-License-Identifier: MIT
+
+// Package upgrade 将旧版本的文档和配置文件升级到当前版本
+//
+// 所有的修改都是在原始内容上进行的局部替换，不会改变缩进和注释符号，
+// 目前包含以下规则：
+//   - 去掉 type="none"，v6 之后以不指定 type 表示没有内容；
+//   - 去掉 xml-ns 属性，改为在 apidoc 元素中声明 xml-namespace；
+//   - 将 apidoc 元素的 apidoc 属性以及配置文件的 version 改为当前的版本号。
+package upgrade
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"sort"
+	"unicode/utf8"
+
+	"github.com/issue9/version"
+
+	"github.com/caixw/apidoc/v7/core"
+	"github.com/caixw/apidoc/v7/internal/ast"
+	"github.com/caixw/apidoc/v7/internal/lang"
+	"github.com/caixw/apidoc/v7/internal/locale"
+	"github.com/caixw/apidoc/v7/internal/xmlenc"
+)
+
+// Upgrader 升级多个文件中的文档
+//
+// xml-ns 与 apidoc 元素可能位于不同的文件，
+// 所以需要在分析完所有的文件之后才能确定最终的修改内容。
+type Upgrader struct {
+	files      []*File
+	namespaces []*namespace // 从 xml-ns 中收集的命名空间
+	declared   []*namespace // 文档中已经声明的 xml-namespace
+	apidoc     *insertion   // 添加 xml-namespace 的位置
+}
+
+// File 单个文件的升级内容
+type File struct {
+	URI     core.URI
+	Data    []byte // 原始内容
+	Summary Summary
+
+	lines []int // 每一行的起始偏移量
+	edits []*edit
+}
+
+// Summary 各类修改的数量
+type Summary struct {
+	TypeNone  int // 去掉的 type="none"
+	XMLNS     int // 去掉的 xml-ns
+	Namespace int // 添加的 xml-namespace
+	Version   int // 修改的版本号
+}
+
+type namespace struct {
+	location core.Location
+	prefix   string
+	urn      string
+}
+
+// apidoc 中添加 xml-namespace 的位置
+type insertion struct {
+	file   *File
+	offset int
+	prefix string // 为空表示与 apidoc 在同一行，否则为新行的前缀内容，包括注释符号和缩进。
+}
+
+// 将 Data[start:end] 替换为 text
+type edit struct {
+	start, end int
+	text       string
+}
+
+// New 声明 Upgrader 对象
+func New() *Upgrader {
+	return &Upgrader{}
+}
+
+// Parse 分析 data 中由 langID 指定的语言的注释块
+//
+// uri 仅用于输出错误信息时的定位。
+func (u *Upgrader) Parse(h *core.MessageHandler, langID string, uri core.URI, data []byte) {
+	f := newFile(uri, data)
+	u.files = append(u.files, f)
+
+	blocks := make(chan core.Block, 10)
+	go func() {
+		lang.Parse(h, langID, core.Block{Location: core.Location{URI: uri}, Data: data}, blocks)
+		close(blocks)
+	}()
+
+	for block := range blocks {
+		u.parseBlock(h, f, block)
+	}
+}
+
+// Files 返回所有需要修改的文件
+//
+// 无法添加 xml-namespace 或是命名空间前缀有冲突的会以警告的形式输出到 h。
+func (u *Upgrader) Files(h *core.MessageHandler) []*File {
+	u.insertNamespaces(h)
+
+	files := make([]*File, 0, len(u.files))
+	for _, f := range u.files {
+		if len(f.edits) > 0 {
+			files = append(files, f)
+		}
+	}
+	return files
+}
+
+func (u *Upgrader) insertNamespaces(h *core.MessageHandler) {
+	declared := make(map[string]string, len(u.declared)+len(u.namespaces))
+	for _, ns := range u.declared {
+		declared[ns.prefix] = ns.urn
+	}
+
+	var text string
+	for _, ns := range u.namespaces {
+		if urn, found := declared[ns.prefix]; found {
+			if urn != ns.urn {
+				h.Warning(ns.location.NewError(locale.ErrDuplicateValue).WithField("xml-ns"))
+			}
+			continue
+		}
+		declared[ns.prefix] = ns.urn
+
+		if u.apidoc == nil {
+			h.Warning(ns.location.NewError(locale.ErrNotFound).WithField("apidoc"))
+			continue
+		}
+
+		elem := `<xml-namespace urn="` + ns.urn + `" />`
+		if ns.prefix != "" {
+			elem = `<xml-namespace prefix="` + ns.prefix + `" urn="` + ns.urn + `" />`
+		}
+		if u.apidoc.prefix == "" {
+			text += elem
+		} else {
+			text += elem + "\n" + u.apidoc.prefix
+		}
+		u.apidoc.file.Summary.Namespace++
+	}
+
+	if text != "" {
+		u.apidoc.file.addEdit(u.apidoc.offset, u.apidoc.offset, text)
+	}
+}
+
+func (u *Upgrader) parseBlock(h *core.MessageHandler, f *File, b core.Block) {
+	if data := bytes.TrimSpace(b.Data); len(data) == 0 || data[0] != '<' { // 与 ast 相同，文档必须以 < 开头。
+		return
+	}
+
+	p, err := xmlenc.NewParser(h, b)
+	if err != nil {
+		return
+	}
+	base := f.offset(b.Location.Range.Start)
+
+	var root string
+	var depth int
+	var apidoc *xmlenc.StartElement // 等待确定 xml-namespace 插入位置的 apidoc 元素
+	for {
+		t, _, err := p.Token()
+		if errors.Is(err, io.EOF) {
+			return
+		} else if err != nil {
+			if root != "" { // 非文档内容的注释块出错是正常的，不需要输出。
+				h.Warning(err)
+			}
+			return
+		}
+
+		if apidoc != nil {
+			var start core.Position
+			switch elem := t.(type) {
+			case *xmlenc.StartElement:
+				start = elem.Range.Start
+			case *xmlenc.EndElement:
+				start = elem.Range.Start
+			default:
+				continue
+			}
+			u.setInsertion(f, b, base, apidoc, start)
+			apidoc = nil
+		}
+
+		if _, ok := t.(*xmlenc.EndElement); ok {
+			if depth--; depth <= 0 { // 根元素之后的内容不属于文档
+				return
+			}
+			continue
+		}
+
+		elem, ok := t.(*xmlenc.StartElement)
+		if !ok {
+			continue
+		}
+		if !elem.SelfClose {
+			depth++
+		}
+
+		isRoot := root == ""
+		if isRoot {
+			if root = elem.Name.Local.Value; root != "apidoc" && root != "api" {
+				return
+			}
+			if root == "apidoc" && !elem.SelfClose && u.apidoc == nil {
+				apidoc = elem
+			}
+		}
+
+		u.parseElement(f, b, base, elem, isRoot && root == "apidoc")
+		if depth == 0 { // 自闭合的根元素
+			return
+		}
+	}
+}
+
+func (u *Upgrader) parseElement(f *File, b core.Block, base int, elem *xmlenc.StartElement, isRoot bool) {
+	var prefix string
+	var xmlns *xmlenc.Attribute
+	for _, attr := range elem.Attributes {
+		switch attr.Name.Local.Value {
+		case "type":
+			if attr.Value.Value == "none" {
+				f.removeAttr(b, base, attr)
+				f.Summary.TypeNone++
+			}
+		case "xml-ns":
+			xmlns = attr
+		case "xml-ns-prefix":
+			prefix = attr.Value.Value
+		case "apidoc":
+			if !isRoot {
+				continue
+			}
+			if v, err := version.SemVerCompare(attr.Value.Value, ast.Version); err == nil && v < 0 {
+				f.addEdit(f.offset(attr.Value.Range.Start), f.offset(attr.Value.Range.End), ast.Version)
+				f.Summary.Version++
+			}
+		}
+	}
+
+	if xmlns != nil {
+		f.removeAttr(b, base, xmlns)
+		f.Summary.XMLNS++
+		u.namespaces = append(u.namespaces, &namespace{location: xmlns.Location, prefix: prefix, urn: xmlns.Value.Value})
+	}
+
+	if elem.Name.Local.Value == "xml-namespace" {
+		ns := &namespace{location: elem.Location}
+		for _, attr := range elem.Attributes {
+			switch attr.Name.Local.Value {
+			case "prefix":
+				ns.prefix = attr.Value.Value
+			case "urn":
+				ns.urn = attr.Value.Value
+			}
+		}
+		u.declared = append(u.declared, ns)
+	}
+}
+
+// 确定在 apidoc 中添加 xml-namespace 的位置
+//
+// 如果 apidoc 之后的元素位于新行，则添加在该元素之前并采用与其相同的缩进，
+// 否则直接添加在 apidoc 的开始标签之后。
+func (u *Upgrader) setInsertion(f *File, b core.Block, base int, apidoc *xmlenc.StartElement, next core.Position) {
+	end := f.offset(apidoc.Range.End)
+	start := f.offset(next)
+
+	for i := end - base; i < start-base; i++ {
+		if b.Data[i] == '\n' {
+			line := f.lines[next.Line]
+			u.apidoc = &insertion{file: f, offset: start, prefix: string(f.Data[line:start])}
+			return
+		}
+	}
+	u.apidoc = &insertion{file: f, offset: end}
+}
+
+func newFile(uri core.URI, data []byte) *File {
+	lines := []int{0}
+	for i, b := range data {
+		if b == '\n' {
+			lines = append(lines, i+1)
+		}
+	}
+	return &File{URI: uri, Data: data, lines: lines}
+}
+
+// 将 core.Position 转换成 Data 中的字节偏移量
+func (f *File) offset(pos core.Position) int {
+	offset := f.lines[pos.Line]
+	for i := 0; i < pos.Character && offset < len(f.Data); i++ {
+		_, size := utf8.DecodeRune(f.Data[offset:])
+		offset += size
+	}
+	return offset
+}
+
+// 删除属性及其前导的空格
+//
+// 如果属性独占一行，则删除整行；如果位于行首，则删除其之后的空格。
+// 注释符号在 b.Data 中已经被替换为空格，所以需要根据 b.Data 判断是否位于行首。
+func (f *File) removeAttr(b core.Block, base int, attr *xmlenc.Attribute) {
+	start := f.offset(attr.Range.Start) - base
+	end := f.offset(attr.Range.End) - base
+
+	s := start
+	for s > 0 && isSpace(b.Data[s-1]) {
+		s--
+	}
+	if s > 0 && b.Data[s-1] != '\n' {
+		f.addEdit(base+s, base+end, "")
+		return
+	}
+
+	e := end
+	for e < len(b.Data) && isSpace(b.Data[e]) {
+		e++
+	}
+	if e < len(b.Data) && b.Data[e] == '\n' { // 独占一行
+		f.addEdit(base+s, base+e+1, "")
+		return
+	}
+	f.addEdit(base+start, base+e, "")
+}
+
+func isSpace(b byte) bool {
+	return b == ' ' || b == '\t' || b == '\r'
+}
+
+func (f *File) addEdit(start, end int, text string) {
+	f.edits = append(f.edits, &edit{start: start, end: end, text: text})
+}
+
+// Bytes 返回升级之后的内容
+func (f *File) Bytes() []byte {
+	sort.SliceStable(f.edits, func(i, j int) bool { return f.edits[i].start < f.edits[j].start })
+
+	data := make([]byte, 0, len(f.Data))
+	var last int
+	for _, e := range f.edits {
+		data = append(data, f.Data[last:e.start]...)
+		data = append(data, e.text...)
+		last = e.end
+	}
+	return append(data, f.Data[last:]...)
+}
+
+// Total 修改的总数
+func (s Summary) Total() int {
+	return s.TypeNone + s.XMLNS + s.Namespace + s.Version
+}
@@ -0,0 +1,147 @@
+// SPDX-License-Identifier: MIT
+
+package upgrade
+
+import (
+	"testing"
+
+	"github.com/issue9/assert"
+
+	"github.com/caixw/apidoc/v7/core"
+	"github.com/caixw/apidoc/v7/core/messagetest"
+	"github.com/caixw/apidoc/v7/internal/ast"
+)
+
+func TestUpgrader(t *testing.T) {
+	a := assert.New(t)
+
+	apidoc := `package doc
+
+// <apidoc version="1.0.0" apidoc="5.0.0">
+//     <title>test</title>
+// </apidoc>
+`
+	api := `package doc
+
+/*
+ * <api method="POST">
+ *     <path path="/users" />
+ *     <request type="object" mimetype="application/xml"
+ *         xml-ns="urn:user"
+ *         xml-ns-prefix="u">
+ *         <param name="name" type="string" xml-ns="urn:user" xml-ns-prefix="u" summary="name" />
+ *     </request>
+ *     <response status="204" type="none" />
+ * </api>
+ */
+func f() {
+	s := "<api type=\"none\" />"
+}
+`
+
+	rslt := messagetest.NewMessageHandler()
+	u := New()
+	u.Parse(rslt.Handler, "go", "apidoc.go", []byte(apidoc))
+	u.Parse(rslt.Handler, "go", "api.go", []byte(api))
+	files := u.Files(rslt.Handler)
+	rslt.Handler.Stop()
+	a.Empty(rslt.Errors).Empty(rslt.Warns).Equal(2, len(files))
+
+	a.Equal(files[0].URI, "apidoc.go").
+		Equal(files[0].Summary, Summary{Namespace: 1, Version: 1}).
+		Equal(string(files[0].Bytes()), `package doc
+
+// <apidoc version="1.0.0" apidoc="`+ast.Version+`">
+//     <xml-namespace prefix="u" urn="urn:user" />
+//     <title>test</title>
+// </apidoc>
+`)
+
+	a.Equal(files[1].URI, "api.go").
+		Equal(files[1].Summary, Summary{TypeNone: 1, XMLNS: 2}).
+		Equal(string(files[1].Bytes()), `package doc
+
+/*
+ * <api method="POST">
+ *     <path path="/users" />
+ *     <request type="object" mimetype="application/xml"
+ *         xml-ns-prefix="u">
+ *         <param name="name" type="string" xml-ns-prefix="u" summary="name" />
+ *     </request>
+ *     <response status="204" />
+ * </api>
+ */
+func f() {
+	s := "<api type=\"none\" />"
+}
+`)
+
+	// apidoc 的子元素与其位于同一行，已经声明的命名空间不再添加。
+	rslt = messagetest.NewMessageHandler()
+	u = New()
+	u.Parse(rslt.Handler, "go", "doc.go", []byte(`// <apidoc version="1.0.0"><xml-namespace prefix="a" urn="urn:a" /><title>test</title></apidoc>
+
+// <api method="GET" xml-ns="urn:a" xml-ns-prefix="a"><path path="/" /></api>
+
+// <api method="POST"><request type="object" xml-ns="urn:b" xml-ns-prefix="b" /></api>
+`))
+	files = u.Files(rslt.Handler)
+	rslt.Handler.Stop()
+	a.Empty(rslt.Errors).Empty(rslt.Warns).Equal(1, len(files))
+	a.Equal(string(files[0].Bytes()), `// <apidoc version="1.0.0"><xml-namespace prefix="b" urn="urn:b" /><xml-namespace prefix="a" urn="urn:a" /><title>test</title></apidoc>
+
+// <api method="GET" xml-ns-prefix="a"><path path="/" /></api>
+
+// <api method="POST"><request type="object" xml-ns-prefix="b" /></api>
+`)
+
+	// 前缀冲突以及找不到 apidoc
+	rslt = messagetest.NewMessageHandler()
+	u = New()
+	u.Parse(rslt.Handler, "go", "doc.go", []byte(`// <api method="GET" xml-ns="urn:a" xml-ns-prefix="a"><path path="/" /></api>
+
+// <api method="POST" xml-ns="urn:b" xml-ns-prefix="a"><path path="/" /></api>
+`))
+	files = u.Files(rslt.Handler)
+	rslt.Handler.Stop()
+	a.Empty(rslt.Errors).Equal(2, len(rslt.Warns)).Equal(1, len(files))
+	a.Equal(files[0].Summary, Summary{XMLNS: 2})
+
+	// 不需要修改，根元素之后以及非文档的内容都会被忽略。
+	rslt = messagetest.NewMessageHandler()
+	u = New()
+	u.Parse(rslt.Handler, "go", "doc.go", []byte(`// <apidoc version="1.0.0" apidoc="`+ast.Version+`"><title>test</title></apidoc>
+// <div type="none" />
+
+// 非文档内容 <api type="none" />
+`))
+	files = u.Files(rslt.Handler)
+	rslt.Handler.Stop()
+	a.Empty(rslt.Errors).Empty(rslt.Warns).Empty(files)
+}
+
+func TestConfig(t *testing.T) {
+	a := assert.New(t)
+
+	f := Config(core.FileURI(".apidoc.yaml"), []byte(`version: "5.0.0" # version
+inputs:
+- lang: go
+  dir: ./
+`))
+	a.Equal(f.Summary, Summary{Version: 1}).
+		Equal(string(f.Bytes()), `version: "`+ast.Version+`" # version
+inputs:
+- lang: go
+  dir: ./
+`)
+
+	f = Config(core.FileURI(".apidoc.yaml"), []byte("inputs: []\nversion: 5.0.0\n"))
+	a.Equal(f.Summary, Summary{Version: 1}).
+		Equal(string(f.Bytes()), "inputs: []\nversion: "+ast.Version+"\n")
+
+	f = Config(core.FileURI(".apidoc.yaml"), []byte("version: "+ast.Version+"\n"))
+	a.Equal(f.Summary.Total(), 0)
+
+	f = Config(core.FileURI(".apidoc.yaml"), []byte("inputs: []\n"))
+	a.Equal(f.Summary.Total(), 0)
+}
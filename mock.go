@@ -36,6 +36,8 @@ type MockOptions struct {
 	NumberSize  Range // 指定用于生成数值数据的范围
 	EnableFloat bool  // 是否允许生成浮点数
 
+	NullProbability float64 // 可以为 null 的字段生成 null 值的概率，取值范围 [0,1]，默认为 0.1
+
 	StringSize  Range  // 指定生成随机字符串的长度范围
 	StringAlpha []byte // 指定生成字符串可用的字符
 
@@ -57,6 +59,8 @@ var defaultMockOptions = &MockOptions{
 	NumberSize:  Range{Min: 100, Max: 10000},
 	EnableFloat: false,
 
+	NullProbability: 0.1,
+
 	StringSize:  Range{Min: 50, Max: 1024},
 	StringAlpha: rands.AlphaNumber,
 
@@ -91,6 +95,10 @@ func (o *MockOptions) sanitize() *core.Error {
 		return err
 	}
 
+	if o.NullProbability < 0 || o.NullProbability > 1 {
+		return core.NewError(locale.ErrInvalidValue).WithField("NullProbability")
+	}
+
 	if len(o.StringAlpha) == 0 {
 		return core.NewError(locale.ErrIsEmpty, "StringAlpha").WithField("StringAlpha")
 	}
@@ -160,6 +168,10 @@ func (o *MockOptions) gen() (*mock.GenOptions, error) {
 			return rand.Int()%2 == 0
 		},
 
+		Null: func() bool {
+			return rand.Float64() < o.NullProbability
+		},
+
 		SliceSize: func() int {
 			return rand.Intn(o.SliceSize.Max-o.SliceSize.Min) + o.SliceSize.Min
 		},
@@ -246,3 +258,19 @@ func MockFile(h *core.MessageHandler, path core.URI, o *MockOptions) (http.Handl
 
 	return mock.Load(h, path, o.Indent, o.ImageBasePrefix, o.Servers, g)
 }
+
+// Validator 根据文档数据生成验证请求的函数
+//
+// data 为文档内容，验证规则与 Mock 相同；
+// 返回的函数用于验证 r 是否符合文档中由 method 和 path 指定的 API，
+// path 为文档中定义的路径，比如 /users/{id}，验证失败时同时返回对应的状态码。
+func Validator(h *core.MessageHandler, data []byte) (func(method, path string, r *http.Request) (int, error), error) {
+	d := &ast.APIDoc{}
+	d.Parse(h, core.Block{Data: data})
+
+	v, err := mock.NewValidator(d)
+	if err != nil {
+		return nil, err
+	}
+	return v.Valid, nil
+}
@@ -4,6 +4,7 @@ package apidoc
 
 import (
 	"net/http"
+	"net/http/httptest"
 	"strings"
 	"testing"
 	"time"
@@ -70,6 +71,22 @@ func TestMockOptions_gen(t *testing.T) {
 			True(size >= 0)
 	}
 
+	// Null
+	o0 := *defaultMockOptions
+	o0.NullProbability = 0
+	g0, err := o0.gen()
+	a.NotError(err)
+	o1 := *defaultMockOptions
+	o1.NullProbability = 1
+	g1, err := o1.gen()
+	a.NotError(err)
+	for i := 0; i < count; i++ {
+		a.False(g0.Null()).True(g1.Null())
+	}
+	o1.NullProbability = 1.5
+	_, err = o1.gen()
+	a.Error(err)
+
 	// String
 	for i := 0; i < count; i++ {
 		str := g.String(&ast.Param{})
@@ -202,6 +219,26 @@ func TestMock(t *testing.T) {
 	srv.Close()
 }
 
+func TestValidator(t *testing.T) {
+	a := assert.New(t)
+
+	rslt := messagetest.NewMessageHandler()
+	valid, err := Validator(rslt.Handler, asttest.XML(a))
+	a.NotError(err).NotNil(valid)
+
+	r := httptest.NewRequest(http.MethodGet, "/users", nil)
+	r.Header.Set("authorization", "xxx")
+	r.Header.Set("content-type", "application/json")
+	status, err := valid(http.MethodGet, "/users", r)
+	a.NotError(err).Equal(status, 0)
+
+	r = httptest.NewRequest(http.MethodPost, "/users", nil)
+	status, err = valid(http.MethodPost, "/users", r)
+	a.Error(err).NotEqual(status, 0)
+
+	rslt.Handler.Stop()
+}
+
 func TestMockFile(t *testing.T) {
 	a := assert.New(t)
 